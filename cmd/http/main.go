@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/DeluxeOwl/cogniboard/internal/postgres"
 	"github.com/DeluxeOwl/cogniboard/internal/project"
@@ -71,6 +72,8 @@ func main() {
 		setupHTTPHandlers(api, app, logger)
 
 		hooks.OnStart(func() {
+			go adapters.NewScheduler(app, time.Minute).Run(ctx)
+
 			logger.Info("Server started", "host", options.Host)
 			saveOpenAPISpec(api)
 			http.ListenAndServe(options.Host, e)
@@ -101,8 +104,11 @@ func setupEcho() *echo.Echo {
 			"Content-Length",
 			"Accept-Encoding",
 			"Authorization",
+			adapters.ActorHeader,
+			"If-Match",
 		},
-		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodOptions},
+		ExposeHeaders: []string{"ETag"},
+		AllowMethods:  []string{http.MethodGet, http.MethodPost, http.MethodOptions},
 	}))
 	v1 := e.Group("/" + APIVersion)
 
@@ -161,12 +167,77 @@ func setupApplication(ctx context.Context,
 	if err != nil {
 		panic(err)
 	}
+	adapters.ReportUnavailableStorage(db)
 
 	repo, err := adapters.NewPostgresTaskRepository(db)
 	if err != nil {
 		panic(err)
 	}
 
+	boardRepo, err := adapters.NewPostgresBoardRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := boardRepo.EnsureDefaultBoard(ctx); err != nil {
+		panic(err)
+	}
+
+	labelRepo, err := adapters.NewPostgresLabelRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	sprintRepo, err := adapters.NewPostgresSprintRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	commentRepo, err := adapters.NewPostgresCommentRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	memberRepo, err := adapters.NewPostgresMemberRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	recurringRepo, err := adapters.NewPostgresRecurringTaskRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	templateRepo, err := adapters.NewPostgresTaskTemplateRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	customFieldRepo, err := adapters.NewPostgresCustomFieldRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	worklogRepo, err := adapters.NewPostgresWorklogRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	epicRepo, err := adapters.NewPostgresEpicRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	// Tasks used to have a single assignee, stored as a free-text name before members existed
+	if err := memberRepo.MigrateAssignees(ctx); err != nil {
+		panic(err)
+	}
+
+	// Tasks created before they could be ordered within their column
+	if err := repo.MigrateRanks(ctx); err != nil {
+		panic(err)
+	}
+
 	openaiClient := openai.NewClient(
 		option.WithAPIKey(llmAPIKey),
 		option.WithBaseURL(openAICompatibleEndpoint),
@@ -186,7 +257,33 @@ func setupApplication(ctx context.Context,
 		panic(err)
 	}
 
-	app, err := app.New(repo, logger, fileStorage, chatService, embeddingStore, chatService)
+	// Documents embedded before boards existed can't be searched on their board
+	fileBoards, err := repo.FileBoards(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if err := embeddingStore.MigrateBoards(ctx, fileBoards); err != nil {
+		panic(err)
+	}
+
+	app, err := app.New(
+		repo,
+		boardRepo,
+		labelRepo,
+		sprintRepo,
+		commentRepo,
+		memberRepo,
+		recurringRepo,
+		templateRepo,
+		customFieldRepo,
+		worklogRepo,
+		epicRepo,
+		logger,
+		fileStorage,
+		chatService,
+		embeddingStore,
+		chatService,
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// Activity is the model entity for the Activity schema.
+type Activity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Source holds the value of the "source" field.
+	Source activity.Source `json:"source,omitempty"`
+	// Field holds the value of the "field" field.
+	Field string `json:"field,omitempty"`
+	// FromValue holds the value of the "from_value" field.
+	FromValue *string `json:"from_value,omitempty"`
+	// ToValue holds the value of the "to_value" field.
+	ToValue *string `json:"to_value,omitempty"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ActivityQuery when eager-loading is set.
+	Edges        ActivityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ActivityEdges holds the relations/edges for other nodes in the graph.
+type ActivityEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ActivityEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Activity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case activity.FieldID, activity.FieldTaskID, activity.FieldActor, activity.FieldSource, activity.FieldField, activity.FieldFromValue, activity.FieldToValue:
+			values[i] = new(sql.NullString)
+		case activity.FieldChangedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Activity fields.
+func (a *Activity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case activity.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				a.ID = value.String
+			}
+		case activity.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				a.TaskID = value.String
+			}
+		case activity.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				a.Actor = value.String
+			}
+		case activity.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				a.Source = activity.Source(value.String)
+			}
+		case activity.FieldField:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field", values[i])
+			} else if value.Valid {
+				a.Field = value.String
+			}
+		case activity.FieldFromValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_value", values[i])
+			} else if value.Valid {
+				a.FromValue = new(string)
+				*a.FromValue = value.String
+			}
+		case activity.FieldToValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_value", values[i])
+			} else if value.Valid {
+				a.ToValue = new(string)
+				*a.ToValue = value.String
+			}
+		case activity.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				a.ChangedAt = value.Time
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Activity.
+// This includes values selected through modifiers, order, etc.
+func (a *Activity) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the Activity entity.
+func (a *Activity) QueryTask() *TaskQuery {
+	return NewActivityClient(a.config).QueryTask(a)
+}
+
+// Update returns a builder for updating this Activity.
+// Note that you need to call Activity.Unwrap() before calling this method if this Activity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Activity) Update() *ActivityUpdateOne {
+	return NewActivityClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Activity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Activity) Unwrap() *Activity {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Activity is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Activity) String() string {
+	var builder strings.Builder
+	builder.WriteString("Activity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(a.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(a.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", a.Source))
+	builder.WriteString(", ")
+	builder.WriteString("field=")
+	builder.WriteString(a.Field)
+	builder.WriteString(", ")
+	if v := a.FromValue; v != nil {
+		builder.WriteString("from_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := a.ToValue; v != nil {
+		builder.WriteString("to_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(a.ChangedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Activities is a parsable slice of Activity.
+type Activities []*Activity
@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package activity
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the activity type in the database.
+	Label = "activity"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldField holds the string denoting the field field in the database.
+	FieldField = "field"
+	// FieldFromValue holds the string denoting the from_value field in the database.
+	FieldFromValue = "from_value"
+	// FieldToValue holds the string denoting the to_value field in the database.
+	FieldToValue = "to_value"
+	// FieldChangedAt holds the string denoting the changed_at field in the database.
+	FieldChangedAt = "changed_at"
+	// EdgeTask holds the string denoting the task edge name in mutations.
+	EdgeTask = "task"
+	// Table holds the table name of the activity in the database.
+	Table = "activities"
+	// TaskTable is the table that holds the task relation/edge.
+	TaskTable = "activities"
+	// TaskInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TaskInverseTable = "tasks"
+	// TaskColumn is the table column denoting the task relation/edge.
+	TaskColumn = "task_id"
+)
+
+// Columns holds all SQL columns for activity fields.
+var Columns = []string{
+	FieldID,
+	FieldTaskID,
+	FieldActor,
+	FieldSource,
+	FieldField,
+	FieldFromValue,
+	FieldToValue,
+	FieldChangedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultChangedAt holds the default value on creation for the "changed_at" field.
+	DefaultChangedAt func() time.Time
+)
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// Source values.
+const (
+	SourceAPI       Source = "api"
+	SourceAssistant Source = "assistant"
+	SourceScheduler Source = "scheduler"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceAPI, SourceAssistant, SourceScheduler:
+		return nil
+	default:
+		return fmt.Errorf("activity: invalid enum value for source field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Activity queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByField orders the results by the field field.
+func ByField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldField, opts...).ToFunc()
+}
+
+// ByFromValue orders the results by the from_value field.
+func ByFromValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromValue, opts...).ToFunc()
+}
+
+// ByToValue orders the results by the to_value field.
+func ByToValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToValue, opts...).ToFunc()
+}
+
+// ByChangedAt orders the results by the changed_at field.
+func ByChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedAt, opts...).ToFunc()
+}
+
+// ByTaskField orders the results by task field.
+func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTaskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+	)
+}
@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package activity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Activity {
+	return predicate.Activity(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Activity {
+	return predicate.Activity(sql.FieldContainsFold(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldTaskID, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldActor, v))
+}
+
+// Field applies equality check predicate on the "field" field. It's identical to FieldEQ.
+func Field(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldField, v))
+}
+
+// FromValue applies equality check predicate on the "from_value" field. It's identical to FromValueEQ.
+func FromValue(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldFromValue, v))
+}
+
+// ToValue applies equality check predicate on the "to_value" field. It's identical to ToValueEQ.
+func ToValue(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldToValue, v))
+}
+
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDContains applies the Contains predicate on the "task_id" field.
+func TaskIDContains(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContains(FieldTaskID, v))
+}
+
+// TaskIDHasPrefix applies the HasPrefix predicate on the "task_id" field.
+func TaskIDHasPrefix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasPrefix(FieldTaskID, v))
+}
+
+// TaskIDHasSuffix applies the HasSuffix predicate on the "task_id" field.
+func TaskIDHasSuffix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasSuffix(FieldTaskID, v))
+}
+
+// TaskIDEqualFold applies the EqualFold predicate on the "task_id" field.
+func TaskIDEqualFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEqualFold(FieldTaskID, v))
+}
+
+// TaskIDContainsFold applies the ContainsFold predicate on the "task_id" field.
+func TaskIDContainsFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContainsFold(FieldTaskID, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContainsFold(FieldActor, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// FieldEQ applies the EQ predicate on the "field" field.
+func FieldEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldField, v))
+}
+
+// FieldNEQ applies the NEQ predicate on the "field" field.
+func FieldNEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldField, v))
+}
+
+// FieldIn applies the In predicate on the "field" field.
+func FieldIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldField, vs...))
+}
+
+// FieldNotIn applies the NotIn predicate on the "field" field.
+func FieldNotIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldField, vs...))
+}
+
+// FieldGT applies the GT predicate on the "field" field.
+func FieldGT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldField, v))
+}
+
+// FieldGTE applies the GTE predicate on the "field" field.
+func FieldGTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldField, v))
+}
+
+// FieldLT applies the LT predicate on the "field" field.
+func FieldLT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldField, v))
+}
+
+// FieldLTE applies the LTE predicate on the "field" field.
+func FieldLTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldField, v))
+}
+
+// FieldContains applies the Contains predicate on the "field" field.
+func FieldContains(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContains(FieldField, v))
+}
+
+// FieldHasPrefix applies the HasPrefix predicate on the "field" field.
+func FieldHasPrefix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasPrefix(FieldField, v))
+}
+
+// FieldHasSuffix applies the HasSuffix predicate on the "field" field.
+func FieldHasSuffix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasSuffix(FieldField, v))
+}
+
+// FieldEqualFold applies the EqualFold predicate on the "field" field.
+func FieldEqualFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEqualFold(FieldField, v))
+}
+
+// FieldContainsFold applies the ContainsFold predicate on the "field" field.
+func FieldContainsFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContainsFold(FieldField, v))
+}
+
+// FromValueEQ applies the EQ predicate on the "from_value" field.
+func FromValueEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldFromValue, v))
+}
+
+// FromValueNEQ applies the NEQ predicate on the "from_value" field.
+func FromValueNEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldFromValue, v))
+}
+
+// FromValueIn applies the In predicate on the "from_value" field.
+func FromValueIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldFromValue, vs...))
+}
+
+// FromValueNotIn applies the NotIn predicate on the "from_value" field.
+func FromValueNotIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldFromValue, vs...))
+}
+
+// FromValueGT applies the GT predicate on the "from_value" field.
+func FromValueGT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldFromValue, v))
+}
+
+// FromValueGTE applies the GTE predicate on the "from_value" field.
+func FromValueGTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldFromValue, v))
+}
+
+// FromValueLT applies the LT predicate on the "from_value" field.
+func FromValueLT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldFromValue, v))
+}
+
+// FromValueLTE applies the LTE predicate on the "from_value" field.
+func FromValueLTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldFromValue, v))
+}
+
+// FromValueContains applies the Contains predicate on the "from_value" field.
+func FromValueContains(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContains(FieldFromValue, v))
+}
+
+// FromValueHasPrefix applies the HasPrefix predicate on the "from_value" field.
+func FromValueHasPrefix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasPrefix(FieldFromValue, v))
+}
+
+// FromValueHasSuffix applies the HasSuffix predicate on the "from_value" field.
+func FromValueHasSuffix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasSuffix(FieldFromValue, v))
+}
+
+// FromValueIsNil applies the IsNil predicate on the "from_value" field.
+func FromValueIsNil() predicate.Activity {
+	return predicate.Activity(sql.FieldIsNull(FieldFromValue))
+}
+
+// FromValueNotNil applies the NotNil predicate on the "from_value" field.
+func FromValueNotNil() predicate.Activity {
+	return predicate.Activity(sql.FieldNotNull(FieldFromValue))
+}
+
+// FromValueEqualFold applies the EqualFold predicate on the "from_value" field.
+func FromValueEqualFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEqualFold(FieldFromValue, v))
+}
+
+// FromValueContainsFold applies the ContainsFold predicate on the "from_value" field.
+func FromValueContainsFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContainsFold(FieldFromValue, v))
+}
+
+// ToValueEQ applies the EQ predicate on the "to_value" field.
+func ToValueEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldToValue, v))
+}
+
+// ToValueNEQ applies the NEQ predicate on the "to_value" field.
+func ToValueNEQ(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldToValue, v))
+}
+
+// ToValueIn applies the In predicate on the "to_value" field.
+func ToValueIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldToValue, vs...))
+}
+
+// ToValueNotIn applies the NotIn predicate on the "to_value" field.
+func ToValueNotIn(vs ...string) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldToValue, vs...))
+}
+
+// ToValueGT applies the GT predicate on the "to_value" field.
+func ToValueGT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldToValue, v))
+}
+
+// ToValueGTE applies the GTE predicate on the "to_value" field.
+func ToValueGTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldToValue, v))
+}
+
+// ToValueLT applies the LT predicate on the "to_value" field.
+func ToValueLT(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldToValue, v))
+}
+
+// ToValueLTE applies the LTE predicate on the "to_value" field.
+func ToValueLTE(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldToValue, v))
+}
+
+// ToValueContains applies the Contains predicate on the "to_value" field.
+func ToValueContains(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContains(FieldToValue, v))
+}
+
+// ToValueHasPrefix applies the HasPrefix predicate on the "to_value" field.
+func ToValueHasPrefix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasPrefix(FieldToValue, v))
+}
+
+// ToValueHasSuffix applies the HasSuffix predicate on the "to_value" field.
+func ToValueHasSuffix(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldHasSuffix(FieldToValue, v))
+}
+
+// ToValueIsNil applies the IsNil predicate on the "to_value" field.
+func ToValueIsNil() predicate.Activity {
+	return predicate.Activity(sql.FieldIsNull(FieldToValue))
+}
+
+// ToValueNotNil applies the NotNil predicate on the "to_value" field.
+func ToValueNotNil() predicate.Activity {
+	return predicate.Activity(sql.FieldNotNull(FieldToValue))
+}
+
+// ToValueEqualFold applies the EqualFold predicate on the "to_value" field.
+func ToValueEqualFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldEqualFold(FieldToValue, v))
+}
+
+// ToValueContainsFold applies the ContainsFold predicate on the "to_value" field.
+func ToValueContainsFold(v string) predicate.Activity {
+	return predicate.Activity(sql.FieldContainsFold(FieldToValue, v))
+}
+
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldNEQ(FieldChangedAt, v))
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldNotIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldGT(FieldChangedAt, v))
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldGTE(FieldChangedAt, v))
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldLT(FieldChangedAt, v))
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.Activity {
+	return predicate.Activity(sql.FieldLTE(FieldChangedAt, v))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.Activity {
+	return predicate.Activity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.Activity {
+	return predicate.Activity(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Activity) predicate.Activity {
+	return predicate.Activity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Activity) predicate.Activity {
+	return predicate.Activity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Activity) predicate.Activity {
+	return predicate.Activity(sql.NotPredicates(p))
+}
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// ActivityCreate is the builder for creating a Activity entity.
+type ActivityCreate struct {
+	config
+	mutation *ActivityMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (ac *ActivityCreate) SetTaskID(s string) *ActivityCreate {
+	ac.mutation.SetTaskID(s)
+	return ac
+}
+
+// SetActor sets the "actor" field.
+func (ac *ActivityCreate) SetActor(s string) *ActivityCreate {
+	ac.mutation.SetActor(s)
+	return ac
+}
+
+// SetSource sets the "source" field.
+func (ac *ActivityCreate) SetSource(a activity.Source) *ActivityCreate {
+	ac.mutation.SetSource(a)
+	return ac
+}
+
+// SetField sets the "field" field.
+func (ac *ActivityCreate) SetField(s string) *ActivityCreate {
+	ac.mutation.SetFieldField(s)
+	return ac
+}
+
+// SetFromValue sets the "from_value" field.
+func (ac *ActivityCreate) SetFromValue(s string) *ActivityCreate {
+	ac.mutation.SetFromValue(s)
+	return ac
+}
+
+// SetNillableFromValue sets the "from_value" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableFromValue(s *string) *ActivityCreate {
+	if s != nil {
+		ac.SetFromValue(*s)
+	}
+	return ac
+}
+
+// SetToValue sets the "to_value" field.
+func (ac *ActivityCreate) SetToValue(s string) *ActivityCreate {
+	ac.mutation.SetToValue(s)
+	return ac
+}
+
+// SetNillableToValue sets the "to_value" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableToValue(s *string) *ActivityCreate {
+	if s != nil {
+		ac.SetToValue(*s)
+	}
+	return ac
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (ac *ActivityCreate) SetChangedAt(t time.Time) *ActivityCreate {
+	ac.mutation.SetChangedAt(t)
+	return ac
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableChangedAt(t *time.Time) *ActivityCreate {
+	if t != nil {
+		ac.SetChangedAt(*t)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *ActivityCreate) SetID(s string) *ActivityCreate {
+	ac.mutation.SetID(s)
+	return ac
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (ac *ActivityCreate) SetTask(t *Task) *ActivityCreate {
+	return ac.SetTaskID(t.ID)
+}
+
+// Mutation returns the ActivityMutation object of the builder.
+func (ac *ActivityCreate) Mutation() *ActivityMutation {
+	return ac.mutation
+}
+
+// Save creates the Activity in the database.
+func (ac *ActivityCreate) Save(ctx context.Context) (*Activity, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *ActivityCreate) SaveX(ctx context.Context) *Activity {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *ActivityCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *ActivityCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *ActivityCreate) defaults() {
+	if _, ok := ac.mutation.ChangedAt(); !ok {
+		v := activity.DefaultChangedAt()
+		ac.mutation.SetChangedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *ActivityCreate) check() error {
+	if _, ok := ac.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "Activity.task_id"`)}
+	}
+	if _, ok := ac.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "Activity.actor"`)}
+	}
+	if _, ok := ac.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Activity.source"`)}
+	}
+	if v, ok := ac.mutation.Source(); ok {
+		if err := activity.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Activity.source": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.GetField(); !ok {
+		return &ValidationError{Name: "field", err: errors.New(`ent: missing required field "Activity.field"`)}
+	}
+	if _, ok := ac.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "Activity.changed_at"`)}
+	}
+	if len(ac.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "Activity.task"`)}
+	}
+	return nil
+}
+
+func (ac *ActivityCreate) sqlSave(ctx context.Context) (*Activity, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Activity.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Activity{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(activity.Table, sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString))
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ac.mutation.Actor(); ok {
+		_spec.SetField(activity.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := ac.mutation.Source(); ok {
+		_spec.SetField(activity.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := ac.mutation.GetField(); ok {
+		_spec.SetField(activity.FieldField, field.TypeString, value)
+		_node.Field = value
+	}
+	if value, ok := ac.mutation.FromValue(); ok {
+		_spec.SetField(activity.FieldFromValue, field.TypeString, value)
+		_node.FromValue = &value
+	}
+	if value, ok := ac.mutation.ToValue(); ok {
+		_spec.SetField(activity.FieldToValue, field.TypeString, value)
+		_node.ToValue = &value
+	}
+	if value, ok := ac.mutation.ChangedAt(); ok {
+		_spec.SetField(activity.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if nodes := ac.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   activity.TaskTable,
+			Columns: []string{activity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ActivityCreateBulk is the builder for creating many Activity entities in bulk.
+type ActivityCreateBulk struct {
+	config
+	err      error
+	builders []*ActivityCreate
+}
+
+// Save creates the Activity entities in the database.
+func (acb *ActivityCreateBulk) Save(ctx context.Context) ([]*Activity, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Activity, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ActivityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *ActivityCreateBulk) SaveX(ctx context.Context) []*Activity {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *ActivityCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *ActivityCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
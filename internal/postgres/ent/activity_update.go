@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ActivityUpdate is the builder for updating Activity entities.
+type ActivityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ActivityMutation
+}
+
+// Where appends a list predicates to the ActivityUpdate builder.
+func (au *ActivityUpdate) Where(ps ...predicate.Activity) *ActivityUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// Mutation returns the ActivityMutation object of the builder.
+func (au *ActivityUpdate) Mutation() *ActivityMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *ActivityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *ActivityUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *ActivityUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *ActivityUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *ActivityUpdate) check() error {
+	if au.mutation.TaskCleared() && len(au.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Activity.task"`)
+	}
+	return nil
+}
+
+func (au *ActivityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(activity.Table, activity.Columns, sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if au.mutation.FromValueCleared() {
+		_spec.ClearField(activity.FieldFromValue, field.TypeString)
+	}
+	if au.mutation.ToValueCleared() {
+		_spec.ClearField(activity.FieldToValue, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{activity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// ActivityUpdateOne is the builder for updating a single Activity entity.
+type ActivityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ActivityMutation
+}
+
+// Mutation returns the ActivityMutation object of the builder.
+func (auo *ActivityUpdateOne) Mutation() *ActivityMutation {
+	return auo.mutation
+}
+
+// Where appends a list predicates to the ActivityUpdate builder.
+func (auo *ActivityUpdateOne) Where(ps ...predicate.Activity) *ActivityUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *ActivityUpdateOne) Select(field string, fields ...string) *ActivityUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Activity entity.
+func (auo *ActivityUpdateOne) Save(ctx context.Context) (*Activity, error) {
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *ActivityUpdateOne) SaveX(ctx context.Context) *Activity {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *ActivityUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *ActivityUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *ActivityUpdateOne) check() error {
+	if auo.mutation.TaskCleared() && len(auo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Activity.task"`)
+	}
+	return nil
+}
+
+func (auo *ActivityUpdateOne) sqlSave(ctx context.Context) (_node *Activity, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(activity.Table, activity.Columns, sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Activity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, activity.FieldID)
+		for _, f := range fields {
+			if !activity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != activity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if auo.mutation.FromValueCleared() {
+		_spec.ClearField(activity.FieldFromValue, field.TypeString)
+	}
+	if auo.mutation.ToValueCleared() {
+		_spec.ClearField(activity.FieldToValue, field.TypeString)
+	}
+	_node = &Activity{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{activity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+)
+
+// Board is the model entity for the Board schema.
+type Board struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Statuses holds the value of the "statuses" field.
+	Statuses []string `json:"statuses,omitempty"`
+	// Transitions holds the value of the "transitions" field.
+	Transitions map[string][]string `json:"transitions,omitempty"`
+	// WipLimits holds the value of the "wip_limits" field.
+	WipLimits map[string]int `json:"wip_limits,omitempty"`
+	// AssigneeWipLimits holds the value of the "assignee_wip_limits" field.
+	AssigneeWipLimits map[string]int `json:"assignee_wip_limits,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BoardQuery when eager-loading is set.
+	Edges        BoardEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BoardEdges holds the relations/edges for other nodes in the graph.
+type BoardEdges struct {
+	// Tasks holds the value of the tasks edge.
+	Tasks []*Task `json:"tasks,omitempty"`
+	// Labels holds the value of the labels edge.
+	Labels []*Label `json:"labels,omitempty"`
+	// Sprints holds the value of the sprints edge.
+	Sprints []*Sprint `json:"sprints,omitempty"`
+	// Epics holds the value of the epics edge.
+	Epics []*Epic `json:"epics,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*Member `json:"members,omitempty"`
+	// RecurringTasks holds the value of the recurring_tasks edge.
+	RecurringTasks []*RecurringTask `json:"recurring_tasks,omitempty"`
+	// TaskTemplates holds the value of the task_templates edge.
+	TaskTemplates []*TaskTemplate `json:"task_templates,omitempty"`
+	// CustomFields holds the value of the custom_fields edge.
+	CustomFields []*CustomField `json:"custom_fields,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [8]bool
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[0] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// LabelsOrErr returns the Labels value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) LabelsOrErr() ([]*Label, error) {
+	if e.loadedTypes[1] {
+		return e.Labels, nil
+	}
+	return nil, &NotLoadedError{edge: "labels"}
+}
+
+// SprintsOrErr returns the Sprints value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) SprintsOrErr() ([]*Sprint, error) {
+	if e.loadedTypes[2] {
+		return e.Sprints, nil
+	}
+	return nil, &NotLoadedError{edge: "sprints"}
+}
+
+// EpicsOrErr returns the Epics value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) EpicsOrErr() ([]*Epic, error) {
+	if e.loadedTypes[3] {
+		return e.Epics, nil
+	}
+	return nil, &NotLoadedError{edge: "epics"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) MembersOrErr() ([]*Member, error) {
+	if e.loadedTypes[4] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// RecurringTasksOrErr returns the RecurringTasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) RecurringTasksOrErr() ([]*RecurringTask, error) {
+	if e.loadedTypes[5] {
+		return e.RecurringTasks, nil
+	}
+	return nil, &NotLoadedError{edge: "recurring_tasks"}
+}
+
+// TaskTemplatesOrErr returns the TaskTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) TaskTemplatesOrErr() ([]*TaskTemplate, error) {
+	if e.loadedTypes[6] {
+		return e.TaskTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "task_templates"}
+}
+
+// CustomFieldsOrErr returns the CustomFields value or an error if the edge
+// was not loaded in eager-loading.
+func (e BoardEdges) CustomFieldsOrErr() ([]*CustomField, error) {
+	if e.loadedTypes[7] {
+		return e.CustomFields, nil
+	}
+	return nil, &NotLoadedError{edge: "custom_fields"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Board) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case board.FieldStatuses, board.FieldTransitions, board.FieldWipLimits, board.FieldAssigneeWipLimits:
+			values[i] = new([]byte)
+		case board.FieldID, board.FieldName:
+			values[i] = new(sql.NullString)
+		case board.FieldCreatedAt, board.FieldUpdatedAt, board.FieldArchivedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Board fields.
+func (b *Board) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case board.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				b.ID = value.String
+			}
+		case board.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				b.Name = value.String
+			}
+		case board.FieldStatuses:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field statuses", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.Statuses); err != nil {
+					return fmt.Errorf("unmarshal field statuses: %w", err)
+				}
+			}
+		case board.FieldTransitions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transitions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.Transitions); err != nil {
+					return fmt.Errorf("unmarshal field transitions: %w", err)
+				}
+			}
+		case board.FieldWipLimits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field wip_limits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.WipLimits); err != nil {
+					return fmt.Errorf("unmarshal field wip_limits: %w", err)
+				}
+			}
+		case board.FieldAssigneeWipLimits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_wip_limits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.AssigneeWipLimits); err != nil {
+					return fmt.Errorf("unmarshal field assignee_wip_limits: %w", err)
+				}
+			}
+		case board.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case board.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				b.UpdatedAt = value.Time
+			}
+		case board.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				b.ArchivedAt = new(time.Time)
+				*b.ArchivedAt = value.Time
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Board.
+// This includes values selected through modifiers, order, etc.
+func (b *Board) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryTasks queries the "tasks" edge of the Board entity.
+func (b *Board) QueryTasks() *TaskQuery {
+	return NewBoardClient(b.config).QueryTasks(b)
+}
+
+// QueryLabels queries the "labels" edge of the Board entity.
+func (b *Board) QueryLabels() *LabelQuery {
+	return NewBoardClient(b.config).QueryLabels(b)
+}
+
+// QuerySprints queries the "sprints" edge of the Board entity.
+func (b *Board) QuerySprints() *SprintQuery {
+	return NewBoardClient(b.config).QuerySprints(b)
+}
+
+// QueryEpics queries the "epics" edge of the Board entity.
+func (b *Board) QueryEpics() *EpicQuery {
+	return NewBoardClient(b.config).QueryEpics(b)
+}
+
+// QueryMembers queries the "members" edge of the Board entity.
+func (b *Board) QueryMembers() *MemberQuery {
+	return NewBoardClient(b.config).QueryMembers(b)
+}
+
+// QueryRecurringTasks queries the "recurring_tasks" edge of the Board entity.
+func (b *Board) QueryRecurringTasks() *RecurringTaskQuery {
+	return NewBoardClient(b.config).QueryRecurringTasks(b)
+}
+
+// QueryTaskTemplates queries the "task_templates" edge of the Board entity.
+func (b *Board) QueryTaskTemplates() *TaskTemplateQuery {
+	return NewBoardClient(b.config).QueryTaskTemplates(b)
+}
+
+// QueryCustomFields queries the "custom_fields" edge of the Board entity.
+func (b *Board) QueryCustomFields() *CustomFieldQuery {
+	return NewBoardClient(b.config).QueryCustomFields(b)
+}
+
+// Update returns a builder for updating this Board.
+// Note that you need to call Board.Unwrap() before calling this method if this Board
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Board) Update() *BoardUpdateOne {
+	return NewBoardClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Board entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Board) Unwrap() *Board {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Board is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Board) String() string {
+	var builder strings.Builder
+	builder.WriteString("Board(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("name=")
+	builder.WriteString(b.Name)
+	builder.WriteString(", ")
+	builder.WriteString("statuses=")
+	builder.WriteString(fmt.Sprintf("%v", b.Statuses))
+	builder.WriteString(", ")
+	builder.WriteString("transitions=")
+	builder.WriteString(fmt.Sprintf("%v", b.Transitions))
+	builder.WriteString(", ")
+	builder.WriteString("wip_limits=")
+	builder.WriteString(fmt.Sprintf("%v", b.WipLimits))
+	builder.WriteString(", ")
+	builder.WriteString("assignee_wip_limits=")
+	builder.WriteString(fmt.Sprintf("%v", b.AssigneeWipLimits))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(b.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := b.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Boards is a parsable slice of Board.
+type Boards []*Board
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package board
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the board type in the database.
+	Label = "board"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStatuses holds the string denoting the statuses field in the database.
+	FieldStatuses = "statuses"
+	// FieldTransitions holds the string denoting the transitions field in the database.
+	FieldTransitions = "transitions"
+	// FieldWipLimits holds the string denoting the wip_limits field in the database.
+	FieldWipLimits = "wip_limits"
+	// FieldAssigneeWipLimits holds the string denoting the assignee_wip_limits field in the database.
+	FieldAssigneeWipLimits = "assignee_wip_limits"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
+	// EdgeTasks holds the string denoting the tasks edge name in mutations.
+	EdgeTasks = "tasks"
+	// EdgeLabels holds the string denoting the labels edge name in mutations.
+	EdgeLabels = "labels"
+	// EdgeSprints holds the string denoting the sprints edge name in mutations.
+	EdgeSprints = "sprints"
+	// EdgeEpics holds the string denoting the epics edge name in mutations.
+	EdgeEpics = "epics"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgeRecurringTasks holds the string denoting the recurring_tasks edge name in mutations.
+	EdgeRecurringTasks = "recurring_tasks"
+	// EdgeTaskTemplates holds the string denoting the task_templates edge name in mutations.
+	EdgeTaskTemplates = "task_templates"
+	// EdgeCustomFields holds the string denoting the custom_fields edge name in mutations.
+	EdgeCustomFields = "custom_fields"
+	// Table holds the table name of the board in the database.
+	Table = "boards"
+	// TasksTable is the table that holds the tasks relation/edge.
+	TasksTable = "tasks"
+	// TasksInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TasksInverseTable = "tasks"
+	// TasksColumn is the table column denoting the tasks relation/edge.
+	TasksColumn = "board_id"
+	// LabelsTable is the table that holds the labels relation/edge.
+	LabelsTable = "labels"
+	// LabelsInverseTable is the table name for the Label entity.
+	// It exists in this package in order to avoid circular dependency with the "label" package.
+	LabelsInverseTable = "labels"
+	// LabelsColumn is the table column denoting the labels relation/edge.
+	LabelsColumn = "board_id"
+	// SprintsTable is the table that holds the sprints relation/edge.
+	SprintsTable = "sprints"
+	// SprintsInverseTable is the table name for the Sprint entity.
+	// It exists in this package in order to avoid circular dependency with the "sprint" package.
+	SprintsInverseTable = "sprints"
+	// SprintsColumn is the table column denoting the sprints relation/edge.
+	SprintsColumn = "board_id"
+	// EpicsTable is the table that holds the epics relation/edge.
+	EpicsTable = "epics"
+	// EpicsInverseTable is the table name for the Epic entity.
+	// It exists in this package in order to avoid circular dependency with the "epic" package.
+	EpicsInverseTable = "epics"
+	// EpicsColumn is the table column denoting the epics relation/edge.
+	EpicsColumn = "board_id"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "members"
+	// MembersInverseTable is the table name for the Member entity.
+	// It exists in this package in order to avoid circular dependency with the "member" package.
+	MembersInverseTable = "members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "board_id"
+	// RecurringTasksTable is the table that holds the recurring_tasks relation/edge.
+	RecurringTasksTable = "recurring_tasks"
+	// RecurringTasksInverseTable is the table name for the RecurringTask entity.
+	// It exists in this package in order to avoid circular dependency with the "recurringtask" package.
+	RecurringTasksInverseTable = "recurring_tasks"
+	// RecurringTasksColumn is the table column denoting the recurring_tasks relation/edge.
+	RecurringTasksColumn = "board_id"
+	// TaskTemplatesTable is the table that holds the task_templates relation/edge.
+	TaskTemplatesTable = "task_templates"
+	// TaskTemplatesInverseTable is the table name for the TaskTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "tasktemplate" package.
+	TaskTemplatesInverseTable = "task_templates"
+	// TaskTemplatesColumn is the table column denoting the task_templates relation/edge.
+	TaskTemplatesColumn = "board_id"
+	// CustomFieldsTable is the table that holds the custom_fields relation/edge.
+	CustomFieldsTable = "custom_fields"
+	// CustomFieldsInverseTable is the table name for the CustomField entity.
+	// It exists in this package in order to avoid circular dependency with the "customfield" package.
+	CustomFieldsInverseTable = "custom_fields"
+	// CustomFieldsColumn is the table column denoting the custom_fields relation/edge.
+	CustomFieldsColumn = "board_id"
+)
+
+// Columns holds all SQL columns for board fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldStatuses,
+	FieldTransitions,
+	FieldWipLimits,
+	FieldAssigneeWipLimits,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldArchivedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Board queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
+// ByTasksCount orders the results by tasks count.
+func ByTasksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTasksStep(), opts...)
+	}
+}
+
+// ByTasks orders the results by tasks terms.
+func ByTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLabelsCount orders the results by labels count.
+func ByLabelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLabelsStep(), opts...)
+	}
+}
+
+// ByLabels orders the results by labels terms.
+func ByLabels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLabelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySprintsCount orders the results by sprints count.
+func BySprintsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSprintsStep(), opts...)
+	}
+}
+
+// BySprints orders the results by sprints terms.
+func BySprints(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSprintsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEpicsCount orders the results by epics count.
+func ByEpicsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEpicsStep(), opts...)
+	}
+}
+
+// ByEpics orders the results by epics terms.
+func ByEpics(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEpicsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecurringTasksCount orders the results by recurring_tasks count.
+func ByRecurringTasksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecurringTasksStep(), opts...)
+	}
+}
+
+// ByRecurringTasks orders the results by recurring_tasks terms.
+func ByRecurringTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurringTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTaskTemplatesCount orders the results by task_templates count.
+func ByTaskTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTaskTemplatesStep(), opts...)
+	}
+}
+
+// ByTaskTemplates orders the results by task_templates terms.
+func ByTaskTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCustomFieldsCount orders the results by custom_fields count.
+func ByCustomFieldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCustomFieldsStep(), opts...)
+	}
+}
+
+// ByCustomFields orders the results by custom_fields terms.
+func ByCustomFields(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomFieldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTasksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TasksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TasksTable, TasksColumn),
+	)
+}
+func newLabelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LabelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LabelsTable, LabelsColumn),
+	)
+}
+func newSprintsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SprintsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SprintsTable, SprintsColumn),
+	)
+}
+func newEpicsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EpicsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EpicsTable, EpicsColumn),
+	)
+}
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+	)
+}
+func newRecurringTasksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurringTasksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecurringTasksTable, RecurringTasksColumn),
+	)
+}
+func newTaskTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TaskTemplatesTable, TaskTemplatesColumn),
+	)
+}
+func newCustomFieldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomFieldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CustomFieldsTable, CustomFieldsColumn),
+	)
+}
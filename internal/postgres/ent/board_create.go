@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
+)
+
+// BoardCreate is the builder for creating a Board entity.
+type BoardCreate struct {
+	config
+	mutation *BoardMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (bc *BoardCreate) SetName(s string) *BoardCreate {
+	bc.mutation.SetName(s)
+	return bc
+}
+
+// SetStatuses sets the "statuses" field.
+func (bc *BoardCreate) SetStatuses(s []string) *BoardCreate {
+	bc.mutation.SetStatuses(s)
+	return bc
+}
+
+// SetTransitions sets the "transitions" field.
+func (bc *BoardCreate) SetTransitions(m map[string][]string) *BoardCreate {
+	bc.mutation.SetTransitions(m)
+	return bc
+}
+
+// SetWipLimits sets the "wip_limits" field.
+func (bc *BoardCreate) SetWipLimits(m map[string]int) *BoardCreate {
+	bc.mutation.SetWipLimits(m)
+	return bc
+}
+
+// SetAssigneeWipLimits sets the "assignee_wip_limits" field.
+func (bc *BoardCreate) SetAssigneeWipLimits(m map[string]int) *BoardCreate {
+	bc.mutation.SetAssigneeWipLimits(m)
+	return bc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BoardCreate) SetCreatedAt(t time.Time) *BoardCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BoardCreate) SetNillableCreatedAt(t *time.Time) *BoardCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bc *BoardCreate) SetUpdatedAt(t time.Time) *BoardCreate {
+	bc.mutation.SetUpdatedAt(t)
+	return bc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bc *BoardCreate) SetNillableUpdatedAt(t *time.Time) *BoardCreate {
+	if t != nil {
+		bc.SetUpdatedAt(*t)
+	}
+	return bc
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (bc *BoardCreate) SetArchivedAt(t time.Time) *BoardCreate {
+	bc.mutation.SetArchivedAt(t)
+	return bc
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (bc *BoardCreate) SetNillableArchivedAt(t *time.Time) *BoardCreate {
+	if t != nil {
+		bc.SetArchivedAt(*t)
+	}
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BoardCreate) SetID(s string) *BoardCreate {
+	bc.mutation.SetID(s)
+	return bc
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (bc *BoardCreate) AddTaskIDs(ids ...string) *BoardCreate {
+	bc.mutation.AddTaskIDs(ids...)
+	return bc
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (bc *BoardCreate) AddTasks(t ...*Task) *BoardCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bc.AddTaskIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (bc *BoardCreate) AddLabelIDs(ids ...string) *BoardCreate {
+	bc.mutation.AddLabelIDs(ids...)
+	return bc
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (bc *BoardCreate) AddLabels(l ...*Label) *BoardCreate {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return bc.AddLabelIDs(ids...)
+}
+
+// AddSprintIDs adds the "sprints" edge to the Sprint entity by IDs.
+func (bc *BoardCreate) AddSprintIDs(ids ...string) *BoardCreate {
+	bc.mutation.AddSprintIDs(ids...)
+	return bc
+}
+
+// AddSprints adds the "sprints" edges to the Sprint entity.
+func (bc *BoardCreate) AddSprints(s ...*Sprint) *BoardCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bc.AddSprintIDs(ids...)
+}
+
+// AddEpicIDs adds the "epics" edge to the Epic entity by IDs.
+func (bc *BoardCreate) AddEpicIDs(ids ...string) *BoardCreate {
+	bc.mutation.AddEpicIDs(ids...)
+	return bc
+}
+
+// AddEpics adds the "epics" edges to the Epic entity.
+func (bc *BoardCreate) AddEpics(e ...*Epic) *BoardCreate {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return bc.AddEpicIDs(ids...)
+}
+
+// AddMemberIDs adds the "members" edge to the Member entity by IDs.
+func (bc *BoardCreate) AddMemberIDs(ids ...string) *BoardCreate {
+	bc.mutation.AddMemberIDs(ids...)
+	return bc
+}
+
+// AddMembers adds the "members" edges to the Member entity.
+func (bc *BoardCreate) AddMembers(m ...*Member) *BoardCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return bc.AddMemberIDs(ids...)
+}
+
+// AddRecurringTaskIDs adds the "recurring_tasks" edge to the RecurringTask entity by IDs.
+func (bc *BoardCreate) AddRecurringTaskIDs(ids ...string) *BoardCreate {
+	bc.mutation.AddRecurringTaskIDs(ids...)
+	return bc
+}
+
+// AddRecurringTasks adds the "recurring_tasks" edges to the RecurringTask entity.
+func (bc *BoardCreate) AddRecurringTasks(r ...*RecurringTask) *BoardCreate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bc.AddRecurringTaskIDs(ids...)
+}
+
+// AddTaskTemplateIDs adds the "task_templates" edge to the TaskTemplate entity by IDs.
+func (bc *BoardCreate) AddTaskTemplateIDs(ids ...string) *BoardCreate {
+	bc.mutation.AddTaskTemplateIDs(ids...)
+	return bc
+}
+
+// AddTaskTemplates adds the "task_templates" edges to the TaskTemplate entity.
+func (bc *BoardCreate) AddTaskTemplates(t ...*TaskTemplate) *BoardCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bc.AddTaskTemplateIDs(ids...)
+}
+
+// AddCustomFieldIDs adds the "custom_fields" edge to the CustomField entity by IDs.
+func (bc *BoardCreate) AddCustomFieldIDs(ids ...string) *BoardCreate {
+	bc.mutation.AddCustomFieldIDs(ids...)
+	return bc
+}
+
+// AddCustomFields adds the "custom_fields" edges to the CustomField entity.
+func (bc *BoardCreate) AddCustomFields(c ...*CustomField) *BoardCreate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return bc.AddCustomFieldIDs(ids...)
+}
+
+// Mutation returns the BoardMutation object of the builder.
+func (bc *BoardCreate) Mutation() *BoardMutation {
+	return bc.mutation
+}
+
+// Save creates the Board in the database.
+func (bc *BoardCreate) Save(ctx context.Context) (*Board, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BoardCreate) SaveX(ctx context.Context) *Board {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BoardCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BoardCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BoardCreate) defaults() {
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := board.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		v := board.DefaultUpdatedAt()
+		bc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BoardCreate) check() error {
+	if _, ok := bc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Board.name"`)}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Board.created_at"`)}
+	}
+	if _, ok := bc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Board.updated_at"`)}
+	}
+	return nil
+}
+
+func (bc *BoardCreate) sqlSave(ctx context.Context) (*Board, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Board.ID type: %T", _spec.ID.Value)
+		}
+	}
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BoardCreate) createSpec() (*Board, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Board{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(board.Table, sqlgraph.NewFieldSpec(board.FieldID, field.TypeString))
+	)
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bc.mutation.Name(); ok {
+		_spec.SetField(board.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := bc.mutation.Statuses(); ok {
+		_spec.SetField(board.FieldStatuses, field.TypeJSON, value)
+		_node.Statuses = value
+	}
+	if value, ok := bc.mutation.Transitions(); ok {
+		_spec.SetField(board.FieldTransitions, field.TypeJSON, value)
+		_node.Transitions = value
+	}
+	if value, ok := bc.mutation.WipLimits(); ok {
+		_spec.SetField(board.FieldWipLimits, field.TypeJSON, value)
+		_node.WipLimits = value
+	}
+	if value, ok := bc.mutation.AssigneeWipLimits(); ok {
+		_spec.SetField(board.FieldAssigneeWipLimits, field.TypeJSON, value)
+		_node.AssigneeWipLimits = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.SetField(board.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bc.mutation.UpdatedAt(); ok {
+		_spec.SetField(board.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := bc.mutation.ArchivedAt(); ok {
+		_spec.SetField(board.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if nodes := bc.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TasksTable,
+			Columns: []string{board.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.LabelsTable,
+			Columns: []string{board.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.SprintsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.SprintsTable,
+			Columns: []string{board.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.EpicsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.EpicsTable,
+			Columns: []string{board.EpicsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.MembersTable,
+			Columns: []string{board.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.RecurringTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RecurringTasksTable,
+			Columns: []string{board.RecurringTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.TaskTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TaskTemplatesTable,
+			Columns: []string{board.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.CustomFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.CustomFieldsTable,
+			Columns: []string{board.CustomFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BoardCreateBulk is the builder for creating many Board entities in bulk.
+type BoardCreateBulk struct {
+	config
+	err      error
+	builders []*BoardCreate
+}
+
+// Save creates the Board entities in the database.
+func (bcb *BoardCreateBulk) Save(ctx context.Context) ([]*Board, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Board, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BoardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BoardCreateBulk) SaveX(ctx context.Context) []*Board {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BoardCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BoardCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
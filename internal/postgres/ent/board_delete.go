@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// BoardDelete is the builder for deleting a Board entity.
+type BoardDelete struct {
+	config
+	hooks    []Hook
+	mutation *BoardMutation
+}
+
+// Where appends a list predicates to the BoardDelete builder.
+func (bd *BoardDelete) Where(ps ...predicate.Board) *BoardDelete {
+	bd.mutation.Where(ps...)
+	return bd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bd *BoardDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bd.sqlExec, bd.mutation, bd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bd *BoardDelete) ExecX(ctx context.Context) int {
+	n, err := bd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bd *BoardDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(board.Table, sqlgraph.NewFieldSpec(board.FieldID, field.TypeString))
+	if ps := bd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bd.mutation.done = true
+	return affected, err
+}
+
+// BoardDeleteOne is the builder for deleting a single Board entity.
+type BoardDeleteOne struct {
+	bd *BoardDelete
+}
+
+// Where appends a list predicates to the BoardDelete builder.
+func (bdo *BoardDeleteOne) Where(ps ...predicate.Board) *BoardDeleteOne {
+	bdo.bd.mutation.Where(ps...)
+	return bdo
+}
+
+// Exec executes the deletion query.
+func (bdo *BoardDeleteOne) Exec(ctx context.Context) error {
+	n, err := bdo.bd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{board.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bdo *BoardDeleteOne) ExecX(ctx context.Context) {
+	if err := bdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
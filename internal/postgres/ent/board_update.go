@@ -0,0 +1,1803 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
+)
+
+// BoardUpdate is the builder for updating Board entities.
+type BoardUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BoardMutation
+}
+
+// Where appends a list predicates to the BoardUpdate builder.
+func (bu *BoardUpdate) Where(ps ...predicate.Board) *BoardUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetName sets the "name" field.
+func (bu *BoardUpdate) SetName(s string) *BoardUpdate {
+	bu.mutation.SetName(s)
+	return bu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableName(s *string) *BoardUpdate {
+	if s != nil {
+		bu.SetName(*s)
+	}
+	return bu
+}
+
+// SetStatuses sets the "statuses" field.
+func (bu *BoardUpdate) SetStatuses(s []string) *BoardUpdate {
+	bu.mutation.SetStatuses(s)
+	return bu
+}
+
+// AppendStatuses appends s to the "statuses" field.
+func (bu *BoardUpdate) AppendStatuses(s []string) *BoardUpdate {
+	bu.mutation.AppendStatuses(s)
+	return bu
+}
+
+// ClearStatuses clears the value of the "statuses" field.
+func (bu *BoardUpdate) ClearStatuses() *BoardUpdate {
+	bu.mutation.ClearStatuses()
+	return bu
+}
+
+// SetTransitions sets the "transitions" field.
+func (bu *BoardUpdate) SetTransitions(m map[string][]string) *BoardUpdate {
+	bu.mutation.SetTransitions(m)
+	return bu
+}
+
+// ClearTransitions clears the value of the "transitions" field.
+func (bu *BoardUpdate) ClearTransitions() *BoardUpdate {
+	bu.mutation.ClearTransitions()
+	return bu
+}
+
+// SetWipLimits sets the "wip_limits" field.
+func (bu *BoardUpdate) SetWipLimits(m map[string]int) *BoardUpdate {
+	bu.mutation.SetWipLimits(m)
+	return bu
+}
+
+// ClearWipLimits clears the value of the "wip_limits" field.
+func (bu *BoardUpdate) ClearWipLimits() *BoardUpdate {
+	bu.mutation.ClearWipLimits()
+	return bu
+}
+
+// SetAssigneeWipLimits sets the "assignee_wip_limits" field.
+func (bu *BoardUpdate) SetAssigneeWipLimits(m map[string]int) *BoardUpdate {
+	bu.mutation.SetAssigneeWipLimits(m)
+	return bu
+}
+
+// ClearAssigneeWipLimits clears the value of the "assignee_wip_limits" field.
+func (bu *BoardUpdate) ClearAssigneeWipLimits() *BoardUpdate {
+	bu.mutation.ClearAssigneeWipLimits()
+	return bu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bu *BoardUpdate) SetCreatedAt(t time.Time) *BoardUpdate {
+	bu.mutation.SetCreatedAt(t)
+	return bu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableCreatedAt(t *time.Time) *BoardUpdate {
+	if t != nil {
+		bu.SetCreatedAt(*t)
+	}
+	return bu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bu *BoardUpdate) SetUpdatedAt(t time.Time) *BoardUpdate {
+	bu.mutation.SetUpdatedAt(t)
+	return bu
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (bu *BoardUpdate) SetArchivedAt(t time.Time) *BoardUpdate {
+	bu.mutation.SetArchivedAt(t)
+	return bu
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (bu *BoardUpdate) SetNillableArchivedAt(t *time.Time) *BoardUpdate {
+	if t != nil {
+		bu.SetArchivedAt(*t)
+	}
+	return bu
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (bu *BoardUpdate) ClearArchivedAt() *BoardUpdate {
+	bu.mutation.ClearArchivedAt()
+	return bu
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (bu *BoardUpdate) AddTaskIDs(ids ...string) *BoardUpdate {
+	bu.mutation.AddTaskIDs(ids...)
+	return bu
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (bu *BoardUpdate) AddTasks(t ...*Task) *BoardUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bu.AddTaskIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (bu *BoardUpdate) AddLabelIDs(ids ...string) *BoardUpdate {
+	bu.mutation.AddLabelIDs(ids...)
+	return bu
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (bu *BoardUpdate) AddLabels(l ...*Label) *BoardUpdate {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return bu.AddLabelIDs(ids...)
+}
+
+// AddSprintIDs adds the "sprints" edge to the Sprint entity by IDs.
+func (bu *BoardUpdate) AddSprintIDs(ids ...string) *BoardUpdate {
+	bu.mutation.AddSprintIDs(ids...)
+	return bu
+}
+
+// AddSprints adds the "sprints" edges to the Sprint entity.
+func (bu *BoardUpdate) AddSprints(s ...*Sprint) *BoardUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bu.AddSprintIDs(ids...)
+}
+
+// AddEpicIDs adds the "epics" edge to the Epic entity by IDs.
+func (bu *BoardUpdate) AddEpicIDs(ids ...string) *BoardUpdate {
+	bu.mutation.AddEpicIDs(ids...)
+	return bu
+}
+
+// AddEpics adds the "epics" edges to the Epic entity.
+func (bu *BoardUpdate) AddEpics(e ...*Epic) *BoardUpdate {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return bu.AddEpicIDs(ids...)
+}
+
+// AddMemberIDs adds the "members" edge to the Member entity by IDs.
+func (bu *BoardUpdate) AddMemberIDs(ids ...string) *BoardUpdate {
+	bu.mutation.AddMemberIDs(ids...)
+	return bu
+}
+
+// AddMembers adds the "members" edges to the Member entity.
+func (bu *BoardUpdate) AddMembers(m ...*Member) *BoardUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return bu.AddMemberIDs(ids...)
+}
+
+// AddRecurringTaskIDs adds the "recurring_tasks" edge to the RecurringTask entity by IDs.
+func (bu *BoardUpdate) AddRecurringTaskIDs(ids ...string) *BoardUpdate {
+	bu.mutation.AddRecurringTaskIDs(ids...)
+	return bu
+}
+
+// AddRecurringTasks adds the "recurring_tasks" edges to the RecurringTask entity.
+func (bu *BoardUpdate) AddRecurringTasks(r ...*RecurringTask) *BoardUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bu.AddRecurringTaskIDs(ids...)
+}
+
+// AddTaskTemplateIDs adds the "task_templates" edge to the TaskTemplate entity by IDs.
+func (bu *BoardUpdate) AddTaskTemplateIDs(ids ...string) *BoardUpdate {
+	bu.mutation.AddTaskTemplateIDs(ids...)
+	return bu
+}
+
+// AddTaskTemplates adds the "task_templates" edges to the TaskTemplate entity.
+func (bu *BoardUpdate) AddTaskTemplates(t ...*TaskTemplate) *BoardUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bu.AddTaskTemplateIDs(ids...)
+}
+
+// AddCustomFieldIDs adds the "custom_fields" edge to the CustomField entity by IDs.
+func (bu *BoardUpdate) AddCustomFieldIDs(ids ...string) *BoardUpdate {
+	bu.mutation.AddCustomFieldIDs(ids...)
+	return bu
+}
+
+// AddCustomFields adds the "custom_fields" edges to the CustomField entity.
+func (bu *BoardUpdate) AddCustomFields(c ...*CustomField) *BoardUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return bu.AddCustomFieldIDs(ids...)
+}
+
+// Mutation returns the BoardMutation object of the builder.
+func (bu *BoardUpdate) Mutation() *BoardMutation {
+	return bu.mutation
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (bu *BoardUpdate) ClearTasks() *BoardUpdate {
+	bu.mutation.ClearTasks()
+	return bu
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (bu *BoardUpdate) RemoveTaskIDs(ids ...string) *BoardUpdate {
+	bu.mutation.RemoveTaskIDs(ids...)
+	return bu
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (bu *BoardUpdate) RemoveTasks(t ...*Task) *BoardUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bu.RemoveTaskIDs(ids...)
+}
+
+// ClearLabels clears all "labels" edges to the Label entity.
+func (bu *BoardUpdate) ClearLabels() *BoardUpdate {
+	bu.mutation.ClearLabels()
+	return bu
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (bu *BoardUpdate) RemoveLabelIDs(ids ...string) *BoardUpdate {
+	bu.mutation.RemoveLabelIDs(ids...)
+	return bu
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (bu *BoardUpdate) RemoveLabels(l ...*Label) *BoardUpdate {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return bu.RemoveLabelIDs(ids...)
+}
+
+// ClearSprints clears all "sprints" edges to the Sprint entity.
+func (bu *BoardUpdate) ClearSprints() *BoardUpdate {
+	bu.mutation.ClearSprints()
+	return bu
+}
+
+// RemoveSprintIDs removes the "sprints" edge to Sprint entities by IDs.
+func (bu *BoardUpdate) RemoveSprintIDs(ids ...string) *BoardUpdate {
+	bu.mutation.RemoveSprintIDs(ids...)
+	return bu
+}
+
+// RemoveSprints removes "sprints" edges to Sprint entities.
+func (bu *BoardUpdate) RemoveSprints(s ...*Sprint) *BoardUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bu.RemoveSprintIDs(ids...)
+}
+
+// ClearEpics clears all "epics" edges to the Epic entity.
+func (bu *BoardUpdate) ClearEpics() *BoardUpdate {
+	bu.mutation.ClearEpics()
+	return bu
+}
+
+// RemoveEpicIDs removes the "epics" edge to Epic entities by IDs.
+func (bu *BoardUpdate) RemoveEpicIDs(ids ...string) *BoardUpdate {
+	bu.mutation.RemoveEpicIDs(ids...)
+	return bu
+}
+
+// RemoveEpics removes "epics" edges to Epic entities.
+func (bu *BoardUpdate) RemoveEpics(e ...*Epic) *BoardUpdate {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return bu.RemoveEpicIDs(ids...)
+}
+
+// ClearMembers clears all "members" edges to the Member entity.
+func (bu *BoardUpdate) ClearMembers() *BoardUpdate {
+	bu.mutation.ClearMembers()
+	return bu
+}
+
+// RemoveMemberIDs removes the "members" edge to Member entities by IDs.
+func (bu *BoardUpdate) RemoveMemberIDs(ids ...string) *BoardUpdate {
+	bu.mutation.RemoveMemberIDs(ids...)
+	return bu
+}
+
+// RemoveMembers removes "members" edges to Member entities.
+func (bu *BoardUpdate) RemoveMembers(m ...*Member) *BoardUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return bu.RemoveMemberIDs(ids...)
+}
+
+// ClearRecurringTasks clears all "recurring_tasks" edges to the RecurringTask entity.
+func (bu *BoardUpdate) ClearRecurringTasks() *BoardUpdate {
+	bu.mutation.ClearRecurringTasks()
+	return bu
+}
+
+// RemoveRecurringTaskIDs removes the "recurring_tasks" edge to RecurringTask entities by IDs.
+func (bu *BoardUpdate) RemoveRecurringTaskIDs(ids ...string) *BoardUpdate {
+	bu.mutation.RemoveRecurringTaskIDs(ids...)
+	return bu
+}
+
+// RemoveRecurringTasks removes "recurring_tasks" edges to RecurringTask entities.
+func (bu *BoardUpdate) RemoveRecurringTasks(r ...*RecurringTask) *BoardUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return bu.RemoveRecurringTaskIDs(ids...)
+}
+
+// ClearTaskTemplates clears all "task_templates" edges to the TaskTemplate entity.
+func (bu *BoardUpdate) ClearTaskTemplates() *BoardUpdate {
+	bu.mutation.ClearTaskTemplates()
+	return bu
+}
+
+// RemoveTaskTemplateIDs removes the "task_templates" edge to TaskTemplate entities by IDs.
+func (bu *BoardUpdate) RemoveTaskTemplateIDs(ids ...string) *BoardUpdate {
+	bu.mutation.RemoveTaskTemplateIDs(ids...)
+	return bu
+}
+
+// RemoveTaskTemplates removes "task_templates" edges to TaskTemplate entities.
+func (bu *BoardUpdate) RemoveTaskTemplates(t ...*TaskTemplate) *BoardUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bu.RemoveTaskTemplateIDs(ids...)
+}
+
+// ClearCustomFields clears all "custom_fields" edges to the CustomField entity.
+func (bu *BoardUpdate) ClearCustomFields() *BoardUpdate {
+	bu.mutation.ClearCustomFields()
+	return bu
+}
+
+// RemoveCustomFieldIDs removes the "custom_fields" edge to CustomField entities by IDs.
+func (bu *BoardUpdate) RemoveCustomFieldIDs(ids ...string) *BoardUpdate {
+	bu.mutation.RemoveCustomFieldIDs(ids...)
+	return bu
+}
+
+// RemoveCustomFields removes "custom_fields" edges to CustomField entities.
+func (bu *BoardUpdate) RemoveCustomFields(c ...*CustomField) *BoardUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return bu.RemoveCustomFieldIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BoardUpdate) Save(ctx context.Context) (int, error) {
+	bu.defaults()
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BoardUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BoardUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BoardUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bu *BoardUpdate) defaults() {
+	if _, ok := bu.mutation.UpdatedAt(); !ok {
+		v := board.UpdateDefaultUpdatedAt()
+		bu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (bu *BoardUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(board.Table, board.Columns, sqlgraph.NewFieldSpec(board.FieldID, field.TypeString))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Name(); ok {
+		_spec.SetField(board.FieldName, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Statuses(); ok {
+		_spec.SetField(board.FieldStatuses, field.TypeJSON, value)
+	}
+	if value, ok := bu.mutation.AppendedStatuses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, board.FieldStatuses, value)
+		})
+	}
+	if bu.mutation.StatusesCleared() {
+		_spec.ClearField(board.FieldStatuses, field.TypeJSON)
+	}
+	if value, ok := bu.mutation.Transitions(); ok {
+		_spec.SetField(board.FieldTransitions, field.TypeJSON, value)
+	}
+	if bu.mutation.TransitionsCleared() {
+		_spec.ClearField(board.FieldTransitions, field.TypeJSON)
+	}
+	if value, ok := bu.mutation.WipLimits(); ok {
+		_spec.SetField(board.FieldWipLimits, field.TypeJSON, value)
+	}
+	if bu.mutation.WipLimitsCleared() {
+		_spec.ClearField(board.FieldWipLimits, field.TypeJSON)
+	}
+	if value, ok := bu.mutation.AssigneeWipLimits(); ok {
+		_spec.SetField(board.FieldAssigneeWipLimits, field.TypeJSON, value)
+	}
+	if bu.mutation.AssigneeWipLimitsCleared() {
+		_spec.ClearField(board.FieldAssigneeWipLimits, field.TypeJSON)
+	}
+	if value, ok := bu.mutation.CreatedAt(); ok {
+		_spec.SetField(board.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := bu.mutation.UpdatedAt(); ok {
+		_spec.SetField(board.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bu.mutation.ArchivedAt(); ok {
+		_spec.SetField(board.FieldArchivedAt, field.TypeTime, value)
+	}
+	if bu.mutation.ArchivedAtCleared() {
+		_spec.ClearField(board.FieldArchivedAt, field.TypeTime)
+	}
+	if bu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TasksTable,
+			Columns: []string{board.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedTasksIDs(); len(nodes) > 0 && !bu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TasksTable,
+			Columns: []string{board.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TasksTable,
+			Columns: []string{board.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.LabelsTable,
+			Columns: []string{board.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !bu.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.LabelsTable,
+			Columns: []string{board.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.LabelsTable,
+			Columns: []string{board.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.SprintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.SprintsTable,
+			Columns: []string{board.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedSprintsIDs(); len(nodes) > 0 && !bu.mutation.SprintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.SprintsTable,
+			Columns: []string{board.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.SprintsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.SprintsTable,
+			Columns: []string{board.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.EpicsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.EpicsTable,
+			Columns: []string{board.EpicsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedEpicsIDs(); len(nodes) > 0 && !bu.mutation.EpicsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.EpicsTable,
+			Columns: []string{board.EpicsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.EpicsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.EpicsTable,
+			Columns: []string{board.EpicsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.MembersTable,
+			Columns: []string{board.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !bu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.MembersTable,
+			Columns: []string{board.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.MembersTable,
+			Columns: []string{board.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.RecurringTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RecurringTasksTable,
+			Columns: []string{board.RecurringTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedRecurringTasksIDs(); len(nodes) > 0 && !bu.mutation.RecurringTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RecurringTasksTable,
+			Columns: []string{board.RecurringTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RecurringTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RecurringTasksTable,
+			Columns: []string{board.RecurringTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.TaskTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TaskTemplatesTable,
+			Columns: []string{board.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedTaskTemplatesIDs(); len(nodes) > 0 && !bu.mutation.TaskTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TaskTemplatesTable,
+			Columns: []string{board.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.TaskTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TaskTemplatesTable,
+			Columns: []string{board.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.CustomFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.CustomFieldsTable,
+			Columns: []string{board.CustomFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedCustomFieldsIDs(); len(nodes) > 0 && !bu.mutation.CustomFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.CustomFieldsTable,
+			Columns: []string{board.CustomFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.CustomFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.CustomFieldsTable,
+			Columns: []string{board.CustomFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{board.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BoardUpdateOne is the builder for updating a single Board entity.
+type BoardUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BoardMutation
+}
+
+// SetName sets the "name" field.
+func (buo *BoardUpdateOne) SetName(s string) *BoardUpdateOne {
+	buo.mutation.SetName(s)
+	return buo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableName(s *string) *BoardUpdateOne {
+	if s != nil {
+		buo.SetName(*s)
+	}
+	return buo
+}
+
+// SetStatuses sets the "statuses" field.
+func (buo *BoardUpdateOne) SetStatuses(s []string) *BoardUpdateOne {
+	buo.mutation.SetStatuses(s)
+	return buo
+}
+
+// AppendStatuses appends s to the "statuses" field.
+func (buo *BoardUpdateOne) AppendStatuses(s []string) *BoardUpdateOne {
+	buo.mutation.AppendStatuses(s)
+	return buo
+}
+
+// ClearStatuses clears the value of the "statuses" field.
+func (buo *BoardUpdateOne) ClearStatuses() *BoardUpdateOne {
+	buo.mutation.ClearStatuses()
+	return buo
+}
+
+// SetTransitions sets the "transitions" field.
+func (buo *BoardUpdateOne) SetTransitions(m map[string][]string) *BoardUpdateOne {
+	buo.mutation.SetTransitions(m)
+	return buo
+}
+
+// ClearTransitions clears the value of the "transitions" field.
+func (buo *BoardUpdateOne) ClearTransitions() *BoardUpdateOne {
+	buo.mutation.ClearTransitions()
+	return buo
+}
+
+// SetWipLimits sets the "wip_limits" field.
+func (buo *BoardUpdateOne) SetWipLimits(m map[string]int) *BoardUpdateOne {
+	buo.mutation.SetWipLimits(m)
+	return buo
+}
+
+// ClearWipLimits clears the value of the "wip_limits" field.
+func (buo *BoardUpdateOne) ClearWipLimits() *BoardUpdateOne {
+	buo.mutation.ClearWipLimits()
+	return buo
+}
+
+// SetAssigneeWipLimits sets the "assignee_wip_limits" field.
+func (buo *BoardUpdateOne) SetAssigneeWipLimits(m map[string]int) *BoardUpdateOne {
+	buo.mutation.SetAssigneeWipLimits(m)
+	return buo
+}
+
+// ClearAssigneeWipLimits clears the value of the "assignee_wip_limits" field.
+func (buo *BoardUpdateOne) ClearAssigneeWipLimits() *BoardUpdateOne {
+	buo.mutation.ClearAssigneeWipLimits()
+	return buo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (buo *BoardUpdateOne) SetCreatedAt(t time.Time) *BoardUpdateOne {
+	buo.mutation.SetCreatedAt(t)
+	return buo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableCreatedAt(t *time.Time) *BoardUpdateOne {
+	if t != nil {
+		buo.SetCreatedAt(*t)
+	}
+	return buo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (buo *BoardUpdateOne) SetUpdatedAt(t time.Time) *BoardUpdateOne {
+	buo.mutation.SetUpdatedAt(t)
+	return buo
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (buo *BoardUpdateOne) SetArchivedAt(t time.Time) *BoardUpdateOne {
+	buo.mutation.SetArchivedAt(t)
+	return buo
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (buo *BoardUpdateOne) SetNillableArchivedAt(t *time.Time) *BoardUpdateOne {
+	if t != nil {
+		buo.SetArchivedAt(*t)
+	}
+	return buo
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (buo *BoardUpdateOne) ClearArchivedAt() *BoardUpdateOne {
+	buo.mutation.ClearArchivedAt()
+	return buo
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (buo *BoardUpdateOne) AddTaskIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.AddTaskIDs(ids...)
+	return buo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (buo *BoardUpdateOne) AddTasks(t ...*Task) *BoardUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return buo.AddTaskIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (buo *BoardUpdateOne) AddLabelIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.AddLabelIDs(ids...)
+	return buo
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (buo *BoardUpdateOne) AddLabels(l ...*Label) *BoardUpdateOne {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return buo.AddLabelIDs(ids...)
+}
+
+// AddSprintIDs adds the "sprints" edge to the Sprint entity by IDs.
+func (buo *BoardUpdateOne) AddSprintIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.AddSprintIDs(ids...)
+	return buo
+}
+
+// AddSprints adds the "sprints" edges to the Sprint entity.
+func (buo *BoardUpdateOne) AddSprints(s ...*Sprint) *BoardUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return buo.AddSprintIDs(ids...)
+}
+
+// AddEpicIDs adds the "epics" edge to the Epic entity by IDs.
+func (buo *BoardUpdateOne) AddEpicIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.AddEpicIDs(ids...)
+	return buo
+}
+
+// AddEpics adds the "epics" edges to the Epic entity.
+func (buo *BoardUpdateOne) AddEpics(e ...*Epic) *BoardUpdateOne {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return buo.AddEpicIDs(ids...)
+}
+
+// AddMemberIDs adds the "members" edge to the Member entity by IDs.
+func (buo *BoardUpdateOne) AddMemberIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.AddMemberIDs(ids...)
+	return buo
+}
+
+// AddMembers adds the "members" edges to the Member entity.
+func (buo *BoardUpdateOne) AddMembers(m ...*Member) *BoardUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return buo.AddMemberIDs(ids...)
+}
+
+// AddRecurringTaskIDs adds the "recurring_tasks" edge to the RecurringTask entity by IDs.
+func (buo *BoardUpdateOne) AddRecurringTaskIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.AddRecurringTaskIDs(ids...)
+	return buo
+}
+
+// AddRecurringTasks adds the "recurring_tasks" edges to the RecurringTask entity.
+func (buo *BoardUpdateOne) AddRecurringTasks(r ...*RecurringTask) *BoardUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return buo.AddRecurringTaskIDs(ids...)
+}
+
+// AddTaskTemplateIDs adds the "task_templates" edge to the TaskTemplate entity by IDs.
+func (buo *BoardUpdateOne) AddTaskTemplateIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.AddTaskTemplateIDs(ids...)
+	return buo
+}
+
+// AddTaskTemplates adds the "task_templates" edges to the TaskTemplate entity.
+func (buo *BoardUpdateOne) AddTaskTemplates(t ...*TaskTemplate) *BoardUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return buo.AddTaskTemplateIDs(ids...)
+}
+
+// AddCustomFieldIDs adds the "custom_fields" edge to the CustomField entity by IDs.
+func (buo *BoardUpdateOne) AddCustomFieldIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.AddCustomFieldIDs(ids...)
+	return buo
+}
+
+// AddCustomFields adds the "custom_fields" edges to the CustomField entity.
+func (buo *BoardUpdateOne) AddCustomFields(c ...*CustomField) *BoardUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return buo.AddCustomFieldIDs(ids...)
+}
+
+// Mutation returns the BoardMutation object of the builder.
+func (buo *BoardUpdateOne) Mutation() *BoardMutation {
+	return buo.mutation
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (buo *BoardUpdateOne) ClearTasks() *BoardUpdateOne {
+	buo.mutation.ClearTasks()
+	return buo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (buo *BoardUpdateOne) RemoveTaskIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.RemoveTaskIDs(ids...)
+	return buo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (buo *BoardUpdateOne) RemoveTasks(t ...*Task) *BoardUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return buo.RemoveTaskIDs(ids...)
+}
+
+// ClearLabels clears all "labels" edges to the Label entity.
+func (buo *BoardUpdateOne) ClearLabels() *BoardUpdateOne {
+	buo.mutation.ClearLabels()
+	return buo
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (buo *BoardUpdateOne) RemoveLabelIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.RemoveLabelIDs(ids...)
+	return buo
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (buo *BoardUpdateOne) RemoveLabels(l ...*Label) *BoardUpdateOne {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return buo.RemoveLabelIDs(ids...)
+}
+
+// ClearSprints clears all "sprints" edges to the Sprint entity.
+func (buo *BoardUpdateOne) ClearSprints() *BoardUpdateOne {
+	buo.mutation.ClearSprints()
+	return buo
+}
+
+// RemoveSprintIDs removes the "sprints" edge to Sprint entities by IDs.
+func (buo *BoardUpdateOne) RemoveSprintIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.RemoveSprintIDs(ids...)
+	return buo
+}
+
+// RemoveSprints removes "sprints" edges to Sprint entities.
+func (buo *BoardUpdateOne) RemoveSprints(s ...*Sprint) *BoardUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return buo.RemoveSprintIDs(ids...)
+}
+
+// ClearEpics clears all "epics" edges to the Epic entity.
+func (buo *BoardUpdateOne) ClearEpics() *BoardUpdateOne {
+	buo.mutation.ClearEpics()
+	return buo
+}
+
+// RemoveEpicIDs removes the "epics" edge to Epic entities by IDs.
+func (buo *BoardUpdateOne) RemoveEpicIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.RemoveEpicIDs(ids...)
+	return buo
+}
+
+// RemoveEpics removes "epics" edges to Epic entities.
+func (buo *BoardUpdateOne) RemoveEpics(e ...*Epic) *BoardUpdateOne {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return buo.RemoveEpicIDs(ids...)
+}
+
+// ClearMembers clears all "members" edges to the Member entity.
+func (buo *BoardUpdateOne) ClearMembers() *BoardUpdateOne {
+	buo.mutation.ClearMembers()
+	return buo
+}
+
+// RemoveMemberIDs removes the "members" edge to Member entities by IDs.
+func (buo *BoardUpdateOne) RemoveMemberIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.RemoveMemberIDs(ids...)
+	return buo
+}
+
+// RemoveMembers removes "members" edges to Member entities.
+func (buo *BoardUpdateOne) RemoveMembers(m ...*Member) *BoardUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return buo.RemoveMemberIDs(ids...)
+}
+
+// ClearRecurringTasks clears all "recurring_tasks" edges to the RecurringTask entity.
+func (buo *BoardUpdateOne) ClearRecurringTasks() *BoardUpdateOne {
+	buo.mutation.ClearRecurringTasks()
+	return buo
+}
+
+// RemoveRecurringTaskIDs removes the "recurring_tasks" edge to RecurringTask entities by IDs.
+func (buo *BoardUpdateOne) RemoveRecurringTaskIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.RemoveRecurringTaskIDs(ids...)
+	return buo
+}
+
+// RemoveRecurringTasks removes "recurring_tasks" edges to RecurringTask entities.
+func (buo *BoardUpdateOne) RemoveRecurringTasks(r ...*RecurringTask) *BoardUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return buo.RemoveRecurringTaskIDs(ids...)
+}
+
+// ClearTaskTemplates clears all "task_templates" edges to the TaskTemplate entity.
+func (buo *BoardUpdateOne) ClearTaskTemplates() *BoardUpdateOne {
+	buo.mutation.ClearTaskTemplates()
+	return buo
+}
+
+// RemoveTaskTemplateIDs removes the "task_templates" edge to TaskTemplate entities by IDs.
+func (buo *BoardUpdateOne) RemoveTaskTemplateIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.RemoveTaskTemplateIDs(ids...)
+	return buo
+}
+
+// RemoveTaskTemplates removes "task_templates" edges to TaskTemplate entities.
+func (buo *BoardUpdateOne) RemoveTaskTemplates(t ...*TaskTemplate) *BoardUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return buo.RemoveTaskTemplateIDs(ids...)
+}
+
+// ClearCustomFields clears all "custom_fields" edges to the CustomField entity.
+func (buo *BoardUpdateOne) ClearCustomFields() *BoardUpdateOne {
+	buo.mutation.ClearCustomFields()
+	return buo
+}
+
+// RemoveCustomFieldIDs removes the "custom_fields" edge to CustomField entities by IDs.
+func (buo *BoardUpdateOne) RemoveCustomFieldIDs(ids ...string) *BoardUpdateOne {
+	buo.mutation.RemoveCustomFieldIDs(ids...)
+	return buo
+}
+
+// RemoveCustomFields removes "custom_fields" edges to CustomField entities.
+func (buo *BoardUpdateOne) RemoveCustomFields(c ...*CustomField) *BoardUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return buo.RemoveCustomFieldIDs(ids...)
+}
+
+// Where appends a list predicates to the BoardUpdate builder.
+func (buo *BoardUpdateOne) Where(ps ...predicate.Board) *BoardUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BoardUpdateOne) Select(field string, fields ...string) *BoardUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Board entity.
+func (buo *BoardUpdateOne) Save(ctx context.Context) (*Board, error) {
+	buo.defaults()
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BoardUpdateOne) SaveX(ctx context.Context) *Board {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BoardUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BoardUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (buo *BoardUpdateOne) defaults() {
+	if _, ok := buo.mutation.UpdatedAt(); !ok {
+		v := board.UpdateDefaultUpdatedAt()
+		buo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (buo *BoardUpdateOne) sqlSave(ctx context.Context) (_node *Board, err error) {
+	_spec := sqlgraph.NewUpdateSpec(board.Table, board.Columns, sqlgraph.NewFieldSpec(board.FieldID, field.TypeString))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Board.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, board.FieldID)
+		for _, f := range fields {
+			if !board.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != board.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Name(); ok {
+		_spec.SetField(board.FieldName, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Statuses(); ok {
+		_spec.SetField(board.FieldStatuses, field.TypeJSON, value)
+	}
+	if value, ok := buo.mutation.AppendedStatuses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, board.FieldStatuses, value)
+		})
+	}
+	if buo.mutation.StatusesCleared() {
+		_spec.ClearField(board.FieldStatuses, field.TypeJSON)
+	}
+	if value, ok := buo.mutation.Transitions(); ok {
+		_spec.SetField(board.FieldTransitions, field.TypeJSON, value)
+	}
+	if buo.mutation.TransitionsCleared() {
+		_spec.ClearField(board.FieldTransitions, field.TypeJSON)
+	}
+	if value, ok := buo.mutation.WipLimits(); ok {
+		_spec.SetField(board.FieldWipLimits, field.TypeJSON, value)
+	}
+	if buo.mutation.WipLimitsCleared() {
+		_spec.ClearField(board.FieldWipLimits, field.TypeJSON)
+	}
+	if value, ok := buo.mutation.AssigneeWipLimits(); ok {
+		_spec.SetField(board.FieldAssigneeWipLimits, field.TypeJSON, value)
+	}
+	if buo.mutation.AssigneeWipLimitsCleared() {
+		_spec.ClearField(board.FieldAssigneeWipLimits, field.TypeJSON)
+	}
+	if value, ok := buo.mutation.CreatedAt(); ok {
+		_spec.SetField(board.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := buo.mutation.UpdatedAt(); ok {
+		_spec.SetField(board.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := buo.mutation.ArchivedAt(); ok {
+		_spec.SetField(board.FieldArchivedAt, field.TypeTime, value)
+	}
+	if buo.mutation.ArchivedAtCleared() {
+		_spec.ClearField(board.FieldArchivedAt, field.TypeTime)
+	}
+	if buo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TasksTable,
+			Columns: []string{board.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !buo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TasksTable,
+			Columns: []string{board.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TasksTable,
+			Columns: []string{board.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.LabelsTable,
+			Columns: []string{board.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !buo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.LabelsTable,
+			Columns: []string{board.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.LabelsTable,
+			Columns: []string{board.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.SprintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.SprintsTable,
+			Columns: []string{board.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedSprintsIDs(); len(nodes) > 0 && !buo.mutation.SprintsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.SprintsTable,
+			Columns: []string{board.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.SprintsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.SprintsTable,
+			Columns: []string{board.SprintsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.EpicsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.EpicsTable,
+			Columns: []string{board.EpicsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedEpicsIDs(); len(nodes) > 0 && !buo.mutation.EpicsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.EpicsTable,
+			Columns: []string{board.EpicsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.EpicsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.EpicsTable,
+			Columns: []string{board.EpicsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.MembersTable,
+			Columns: []string{board.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !buo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.MembersTable,
+			Columns: []string{board.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.MembersTable,
+			Columns: []string{board.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.RecurringTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RecurringTasksTable,
+			Columns: []string{board.RecurringTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedRecurringTasksIDs(); len(nodes) > 0 && !buo.mutation.RecurringTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RecurringTasksTable,
+			Columns: []string{board.RecurringTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RecurringTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.RecurringTasksTable,
+			Columns: []string{board.RecurringTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.TaskTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TaskTemplatesTable,
+			Columns: []string{board.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedTaskTemplatesIDs(); len(nodes) > 0 && !buo.mutation.TaskTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TaskTemplatesTable,
+			Columns: []string{board.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.TaskTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.TaskTemplatesTable,
+			Columns: []string{board.TaskTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.CustomFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.CustomFieldsTable,
+			Columns: []string{board.CustomFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedCustomFieldsIDs(); len(nodes) > 0 && !buo.mutation.CustomFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.CustomFieldsTable,
+			Columns: []string{board.CustomFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.CustomFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   board.CustomFieldsTable,
+			Columns: []string{board.CustomFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Board{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{board.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// ChecklistItem is the model entity for the ChecklistItem schema.
+type ChecklistItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Done holds the value of the "done" field.
+	Done bool `json:"done,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChecklistItemQuery when eager-loading is set.
+	Edges        ChecklistItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChecklistItemEdges holds the relations/edges for other nodes in the graph.
+type ChecklistItemEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChecklistItemEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChecklistItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checklistitem.FieldDone:
+			values[i] = new(sql.NullBool)
+		case checklistitem.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case checklistitem.FieldID, checklistitem.FieldTaskID, checklistitem.FieldText:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChecklistItem fields.
+func (ci *ChecklistItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checklistitem.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ci.ID = value.String
+			}
+		case checklistitem.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				ci.TaskID = value.String
+			}
+		case checklistitem.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				ci.Text = value.String
+			}
+		case checklistitem.FieldDone:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field done", values[i])
+			} else if value.Valid {
+				ci.Done = value.Bool
+			}
+		case checklistitem.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				ci.Position = int(value.Int64)
+			}
+		default:
+			ci.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChecklistItem.
+// This includes values selected through modifiers, order, etc.
+func (ci *ChecklistItem) Value(name string) (ent.Value, error) {
+	return ci.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the ChecklistItem entity.
+func (ci *ChecklistItem) QueryTask() *TaskQuery {
+	return NewChecklistItemClient(ci.config).QueryTask(ci)
+}
+
+// Update returns a builder for updating this ChecklistItem.
+// Note that you need to call ChecklistItem.Unwrap() before calling this method if this ChecklistItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ci *ChecklistItem) Update() *ChecklistItemUpdateOne {
+	return NewChecklistItemClient(ci.config).UpdateOne(ci)
+}
+
+// Unwrap unwraps the ChecklistItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ci *ChecklistItem) Unwrap() *ChecklistItem {
+	_tx, ok := ci.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChecklistItem is not a transactional entity")
+	}
+	ci.config.driver = _tx.drv
+	return ci
+}
+
+// String implements the fmt.Stringer.
+func (ci *ChecklistItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChecklistItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ci.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(ci.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(ci.Text)
+	builder.WriteString(", ")
+	builder.WriteString("done=")
+	builder.WriteString(fmt.Sprintf("%v", ci.Done))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", ci.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChecklistItems is a parsable slice of ChecklistItem.
+type ChecklistItems []*ChecklistItem
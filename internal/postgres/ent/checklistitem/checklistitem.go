@@ -0,0 +1,100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checklistitem
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the checklistitem type in the database.
+	Label = "checklist_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldText holds the string denoting the text field in the database.
+	FieldText = "text"
+	// FieldDone holds the string denoting the done field in the database.
+	FieldDone = "done"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// EdgeTask holds the string denoting the task edge name in mutations.
+	EdgeTask = "task"
+	// Table holds the table name of the checklistitem in the database.
+	Table = "checklist_items"
+	// TaskTable is the table that holds the task relation/edge.
+	TaskTable = "checklist_items"
+	// TaskInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TaskInverseTable = "tasks"
+	// TaskColumn is the table column denoting the task relation/edge.
+	TaskColumn = "task_id"
+)
+
+// Columns holds all SQL columns for checklistitem fields.
+var Columns = []string{
+	FieldID,
+	FieldTaskID,
+	FieldText,
+	FieldDone,
+	FieldPosition,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDone holds the default value on creation for the "done" field.
+	DefaultDone bool
+)
+
+// OrderOption defines the ordering options for the ChecklistItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByText orders the results by the text field.
+func ByText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldText, opts...).ToFunc()
+}
+
+// ByDone orders the results by the done field.
+func ByDone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDone, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByTaskField orders the results by task field.
+func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTaskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+	)
+}
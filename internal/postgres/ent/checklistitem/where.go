@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checklistitem
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldContainsFold(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldTaskID, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldText, v))
+}
+
+// Done applies equality check predicate on the "done" field. It's identical to DoneEQ.
+func Done(v bool) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldDone, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldPosition, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDContains applies the Contains predicate on the "task_id" field.
+func TaskIDContains(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldContains(FieldTaskID, v))
+}
+
+// TaskIDHasPrefix applies the HasPrefix predicate on the "task_id" field.
+func TaskIDHasPrefix(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldHasPrefix(FieldTaskID, v))
+}
+
+// TaskIDHasSuffix applies the HasSuffix predicate on the "task_id" field.
+func TaskIDHasSuffix(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldHasSuffix(FieldTaskID, v))
+}
+
+// TaskIDEqualFold applies the EqualFold predicate on the "task_id" field.
+func TaskIDEqualFold(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEqualFold(FieldTaskID, v))
+}
+
+// TaskIDContainsFold applies the ContainsFold predicate on the "task_id" field.
+func TaskIDContainsFold(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldContainsFold(FieldTaskID, v))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldContainsFold(FieldText, v))
+}
+
+// DoneEQ applies the EQ predicate on the "done" field.
+func DoneEQ(v bool) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldDone, v))
+}
+
+// DoneNEQ applies the NEQ predicate on the "done" field.
+func DoneNEQ(v bool) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldNEQ(FieldDone, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.FieldLTE(FieldPosition, v))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.ChecklistItem {
+	return predicate.ChecklistItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.ChecklistItem {
+	return predicate.ChecklistItem(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChecklistItem) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChecklistItem) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChecklistItem) predicate.ChecklistItem {
+	return predicate.ChecklistItem(sql.NotPredicates(p))
+}
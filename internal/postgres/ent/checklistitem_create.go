@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// ChecklistItemCreate is the builder for creating a ChecklistItem entity.
+type ChecklistItemCreate struct {
+	config
+	mutation *ChecklistItemMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (cic *ChecklistItemCreate) SetTaskID(s string) *ChecklistItemCreate {
+	cic.mutation.SetTaskID(s)
+	return cic
+}
+
+// SetText sets the "text" field.
+func (cic *ChecklistItemCreate) SetText(s string) *ChecklistItemCreate {
+	cic.mutation.SetText(s)
+	return cic
+}
+
+// SetDone sets the "done" field.
+func (cic *ChecklistItemCreate) SetDone(b bool) *ChecklistItemCreate {
+	cic.mutation.SetDone(b)
+	return cic
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (cic *ChecklistItemCreate) SetNillableDone(b *bool) *ChecklistItemCreate {
+	if b != nil {
+		cic.SetDone(*b)
+	}
+	return cic
+}
+
+// SetPosition sets the "position" field.
+func (cic *ChecklistItemCreate) SetPosition(i int) *ChecklistItemCreate {
+	cic.mutation.SetPosition(i)
+	return cic
+}
+
+// SetID sets the "id" field.
+func (cic *ChecklistItemCreate) SetID(s string) *ChecklistItemCreate {
+	cic.mutation.SetID(s)
+	return cic
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (cic *ChecklistItemCreate) SetTask(t *Task) *ChecklistItemCreate {
+	return cic.SetTaskID(t.ID)
+}
+
+// Mutation returns the ChecklistItemMutation object of the builder.
+func (cic *ChecklistItemCreate) Mutation() *ChecklistItemMutation {
+	return cic.mutation
+}
+
+// Save creates the ChecklistItem in the database.
+func (cic *ChecklistItemCreate) Save(ctx context.Context) (*ChecklistItem, error) {
+	cic.defaults()
+	return withHooks(ctx, cic.sqlSave, cic.mutation, cic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cic *ChecklistItemCreate) SaveX(ctx context.Context) *ChecklistItem {
+	v, err := cic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cic *ChecklistItemCreate) Exec(ctx context.Context) error {
+	_, err := cic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cic *ChecklistItemCreate) ExecX(ctx context.Context) {
+	if err := cic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cic *ChecklistItemCreate) defaults() {
+	if _, ok := cic.mutation.Done(); !ok {
+		v := checklistitem.DefaultDone
+		cic.mutation.SetDone(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cic *ChecklistItemCreate) check() error {
+	if _, ok := cic.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "ChecklistItem.task_id"`)}
+	}
+	if _, ok := cic.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "ChecklistItem.text"`)}
+	}
+	if _, ok := cic.mutation.Done(); !ok {
+		return &ValidationError{Name: "done", err: errors.New(`ent: missing required field "ChecklistItem.done"`)}
+	}
+	if _, ok := cic.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ChecklistItem.position"`)}
+	}
+	if len(cic.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "ChecklistItem.task"`)}
+	}
+	return nil
+}
+
+func (cic *ChecklistItemCreate) sqlSave(ctx context.Context) (*ChecklistItem, error) {
+	if err := cic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ChecklistItem.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cic.mutation.id = &_node.ID
+	cic.mutation.done = true
+	return _node, nil
+}
+
+func (cic *ChecklistItemCreate) createSpec() (*ChecklistItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChecklistItem{config: cic.config}
+		_spec = sqlgraph.NewCreateSpec(checklistitem.Table, sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString))
+	)
+	if id, ok := cic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cic.mutation.Text(); ok {
+		_spec.SetField(checklistitem.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := cic.mutation.Done(); ok {
+		_spec.SetField(checklistitem.FieldDone, field.TypeBool, value)
+		_node.Done = value
+	}
+	if value, ok := cic.mutation.Position(); ok {
+		_spec.SetField(checklistitem.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := cic.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TaskTable,
+			Columns: []string{checklistitem.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ChecklistItemCreateBulk is the builder for creating many ChecklistItem entities in bulk.
+type ChecklistItemCreateBulk struct {
+	config
+	err      error
+	builders []*ChecklistItemCreate
+}
+
+// Save creates the ChecklistItem entities in the database.
+func (cicb *ChecklistItemCreateBulk) Save(ctx context.Context) ([]*ChecklistItem, error) {
+	if cicb.err != nil {
+		return nil, cicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cicb.builders))
+	nodes := make([]*ChecklistItem, len(cicb.builders))
+	mutators := make([]Mutator, len(cicb.builders))
+	for i := range cicb.builders {
+		func(i int, root context.Context) {
+			builder := cicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChecklistItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cicb *ChecklistItemCreateBulk) SaveX(ctx context.Context) []*ChecklistItem {
+	v, err := cicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cicb *ChecklistItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := cicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cicb *ChecklistItemCreateBulk) ExecX(ctx context.Context) {
+	if err := cicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ChecklistItemDelete is the builder for deleting a ChecklistItem entity.
+type ChecklistItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChecklistItemMutation
+}
+
+// Where appends a list predicates to the ChecklistItemDelete builder.
+func (cid *ChecklistItemDelete) Where(ps ...predicate.ChecklistItem) *ChecklistItemDelete {
+	cid.mutation.Where(ps...)
+	return cid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cid *ChecklistItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cid.sqlExec, cid.mutation, cid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cid *ChecklistItemDelete) ExecX(ctx context.Context) int {
+	n, err := cid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cid *ChecklistItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(checklistitem.Table, sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString))
+	if ps := cid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cid.mutation.done = true
+	return affected, err
+}
+
+// ChecklistItemDeleteOne is the builder for deleting a single ChecklistItem entity.
+type ChecklistItemDeleteOne struct {
+	cid *ChecklistItemDelete
+}
+
+// Where appends a list predicates to the ChecklistItemDelete builder.
+func (cido *ChecklistItemDeleteOne) Where(ps ...predicate.ChecklistItem) *ChecklistItemDeleteOne {
+	cido.cid.mutation.Where(ps...)
+	return cido
+}
+
+// Exec executes the deletion query.
+func (cido *ChecklistItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := cido.cid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{checklistitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cido *ChecklistItemDeleteOne) ExecX(ctx context.Context) {
+	if err := cido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
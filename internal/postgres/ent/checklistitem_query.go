@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// ChecklistItemQuery is the builder for querying ChecklistItem entities.
+type ChecklistItemQuery struct {
+	config
+	ctx        *QueryContext
+	order      []checklistitem.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ChecklistItem
+	withTask   *TaskQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ChecklistItemQuery builder.
+func (ciq *ChecklistItemQuery) Where(ps ...predicate.ChecklistItem) *ChecklistItemQuery {
+	ciq.predicates = append(ciq.predicates, ps...)
+	return ciq
+}
+
+// Limit the number of records to be returned by this query.
+func (ciq *ChecklistItemQuery) Limit(limit int) *ChecklistItemQuery {
+	ciq.ctx.Limit = &limit
+	return ciq
+}
+
+// Offset to start from.
+func (ciq *ChecklistItemQuery) Offset(offset int) *ChecklistItemQuery {
+	ciq.ctx.Offset = &offset
+	return ciq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ciq *ChecklistItemQuery) Unique(unique bool) *ChecklistItemQuery {
+	ciq.ctx.Unique = &unique
+	return ciq
+}
+
+// Order specifies how the records should be ordered.
+func (ciq *ChecklistItemQuery) Order(o ...checklistitem.OrderOption) *ChecklistItemQuery {
+	ciq.order = append(ciq.order, o...)
+	return ciq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (ciq *ChecklistItemQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: ciq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ciq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ciq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(checklistitem.Table, checklistitem.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, checklistitem.TaskTable, checklistitem.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ciq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ChecklistItem entity from the query.
+// Returns a *NotFoundError when no ChecklistItem was found.
+func (ciq *ChecklistItemQuery) First(ctx context.Context) (*ChecklistItem, error) {
+	nodes, err := ciq.Limit(1).All(setContextOp(ctx, ciq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{checklistitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) FirstX(ctx context.Context) *ChecklistItem {
+	node, err := ciq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ChecklistItem ID from the query.
+// Returns a *NotFoundError when no ChecklistItem ID was found.
+func (ciq *ChecklistItemQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ciq.Limit(1).IDs(setContextOp(ctx, ciq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{checklistitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) FirstIDX(ctx context.Context) string {
+	id, err := ciq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ChecklistItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ChecklistItem entity is found.
+// Returns a *NotFoundError when no ChecklistItem entities are found.
+func (ciq *ChecklistItemQuery) Only(ctx context.Context) (*ChecklistItem, error) {
+	nodes, err := ciq.Limit(2).All(setContextOp(ctx, ciq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{checklistitem.Label}
+	default:
+		return nil, &NotSingularError{checklistitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) OnlyX(ctx context.Context) *ChecklistItem {
+	node, err := ciq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ChecklistItem ID in the query.
+// Returns a *NotSingularError when more than one ChecklistItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ciq *ChecklistItemQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ciq.Limit(2).IDs(setContextOp(ctx, ciq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{checklistitem.Label}
+	default:
+		err = &NotSingularError{checklistitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ciq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ChecklistItems.
+func (ciq *ChecklistItemQuery) All(ctx context.Context) ([]*ChecklistItem, error) {
+	ctx = setContextOp(ctx, ciq.ctx, ent.OpQueryAll)
+	if err := ciq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ChecklistItem, *ChecklistItemQuery]()
+	return withInterceptors[[]*ChecklistItem](ctx, ciq, qr, ciq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) AllX(ctx context.Context) []*ChecklistItem {
+	nodes, err := ciq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ChecklistItem IDs.
+func (ciq *ChecklistItemQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if ciq.ctx.Unique == nil && ciq.path != nil {
+		ciq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ciq.ctx, ent.OpQueryIDs)
+	if err = ciq.Select(checklistitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) IDsX(ctx context.Context) []string {
+	ids, err := ciq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ciq *ChecklistItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ciq.ctx, ent.OpQueryCount)
+	if err := ciq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ciq, querierCount[*ChecklistItemQuery](), ciq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) CountX(ctx context.Context) int {
+	count, err := ciq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ciq *ChecklistItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ciq.ctx, ent.OpQueryExist)
+	switch _, err := ciq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ciq *ChecklistItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := ciq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ChecklistItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ciq *ChecklistItemQuery) Clone() *ChecklistItemQuery {
+	if ciq == nil {
+		return nil
+	}
+	return &ChecklistItemQuery{
+		config:     ciq.config,
+		ctx:        ciq.ctx.Clone(),
+		order:      append([]checklistitem.OrderOption{}, ciq.order...),
+		inters:     append([]Interceptor{}, ciq.inters...),
+		predicates: append([]predicate.ChecklistItem{}, ciq.predicates...),
+		withTask:   ciq.withTask.Clone(),
+		// clone intermediate query.
+		sql:  ciq.sql.Clone(),
+		path: ciq.path,
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (ciq *ChecklistItemQuery) WithTask(opts ...func(*TaskQuery)) *ChecklistItemQuery {
+	query := (&TaskClient{config: ciq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ciq.withTask = query
+	return ciq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ChecklistItem.Query().
+//		GroupBy(checklistitem.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ciq *ChecklistItemQuery) GroupBy(field string, fields ...string) *ChecklistItemGroupBy {
+	ciq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ChecklistItemGroupBy{build: ciq}
+	grbuild.flds = &ciq.ctx.Fields
+	grbuild.label = checklistitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//	}
+//
+//	client.ChecklistItem.Query().
+//		Select(checklistitem.FieldTaskID).
+//		Scan(ctx, &v)
+func (ciq *ChecklistItemQuery) Select(fields ...string) *ChecklistItemSelect {
+	ciq.ctx.Fields = append(ciq.ctx.Fields, fields...)
+	sbuild := &ChecklistItemSelect{ChecklistItemQuery: ciq}
+	sbuild.label = checklistitem.Label
+	sbuild.flds, sbuild.scan = &ciq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ChecklistItemSelect configured with the given aggregations.
+func (ciq *ChecklistItemQuery) Aggregate(fns ...AggregateFunc) *ChecklistItemSelect {
+	return ciq.Select().Aggregate(fns...)
+}
+
+func (ciq *ChecklistItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ciq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ciq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ciq.ctx.Fields {
+		if !checklistitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ciq.path != nil {
+		prev, err := ciq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ciq.sql = prev
+	}
+	return nil
+}
+
+func (ciq *ChecklistItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ChecklistItem, error) {
+	var (
+		nodes       = []*ChecklistItem{}
+		_spec       = ciq.querySpec()
+		loadedTypes = [1]bool{
+			ciq.withTask != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ChecklistItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ChecklistItem{config: ciq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ciq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ciq.withTask; query != nil {
+		if err := ciq.loadTask(ctx, query, nodes, nil,
+			func(n *ChecklistItem, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ciq *ChecklistItemQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*ChecklistItem, init func(*ChecklistItem), assign func(*ChecklistItem, *Task)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ChecklistItem)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ciq *ChecklistItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ciq.querySpec()
+	_spec.Node.Columns = ciq.ctx.Fields
+	if len(ciq.ctx.Fields) > 0 {
+		_spec.Unique = ciq.ctx.Unique != nil && *ciq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ciq.driver, _spec)
+}
+
+func (ciq *ChecklistItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(checklistitem.Table, checklistitem.Columns, sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString))
+	_spec.From = ciq.sql
+	if unique := ciq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ciq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ciq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checklistitem.FieldID)
+		for i := range fields {
+			if fields[i] != checklistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ciq.withTask != nil {
+			_spec.Node.AddColumnOnce(checklistitem.FieldTaskID)
+		}
+	}
+	if ps := ciq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ciq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ciq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ciq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ciq *ChecklistItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ciq.driver.Dialect())
+	t1 := builder.Table(checklistitem.Table)
+	columns := ciq.ctx.Fields
+	if len(columns) == 0 {
+		columns = checklistitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ciq.sql != nil {
+		selector = ciq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ciq.ctx.Unique != nil && *ciq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ciq.predicates {
+		p(selector)
+	}
+	for _, p := range ciq.order {
+		p(selector)
+	}
+	if offset := ciq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ciq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ChecklistItemGroupBy is the group-by builder for ChecklistItem entities.
+type ChecklistItemGroupBy struct {
+	selector
+	build *ChecklistItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cigb *ChecklistItemGroupBy) Aggregate(fns ...AggregateFunc) *ChecklistItemGroupBy {
+	cigb.fns = append(cigb.fns, fns...)
+	return cigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cigb *ChecklistItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cigb.build.ctx, ent.OpQueryGroupBy)
+	if err := cigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChecklistItemQuery, *ChecklistItemGroupBy](ctx, cigb.build, cigb, cigb.build.inters, v)
+}
+
+func (cigb *ChecklistItemGroupBy) sqlScan(ctx context.Context, root *ChecklistItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cigb.fns))
+	for _, fn := range cigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cigb.flds)+len(cigb.fns))
+		for _, f := range *cigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ChecklistItemSelect is the builder for selecting fields of ChecklistItem entities.
+type ChecklistItemSelect struct {
+	*ChecklistItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cis *ChecklistItemSelect) Aggregate(fns ...AggregateFunc) *ChecklistItemSelect {
+	cis.fns = append(cis.fns, fns...)
+	return cis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cis *ChecklistItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cis.ctx, ent.OpQuerySelect)
+	if err := cis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChecklistItemQuery, *ChecklistItemSelect](ctx, cis.ChecklistItemQuery, cis, cis.inters, v)
+}
+
+func (cis *ChecklistItemSelect) sqlScan(ctx context.Context, root *ChecklistItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cis.fns))
+	for _, fn := range cis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
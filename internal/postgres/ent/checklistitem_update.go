@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// ChecklistItemUpdate is the builder for updating ChecklistItem entities.
+type ChecklistItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChecklistItemMutation
+}
+
+// Where appends a list predicates to the ChecklistItemUpdate builder.
+func (ciu *ChecklistItemUpdate) Where(ps ...predicate.ChecklistItem) *ChecklistItemUpdate {
+	ciu.mutation.Where(ps...)
+	return ciu
+}
+
+// SetTaskID sets the "task_id" field.
+func (ciu *ChecklistItemUpdate) SetTaskID(s string) *ChecklistItemUpdate {
+	ciu.mutation.SetTaskID(s)
+	return ciu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (ciu *ChecklistItemUpdate) SetNillableTaskID(s *string) *ChecklistItemUpdate {
+	if s != nil {
+		ciu.SetTaskID(*s)
+	}
+	return ciu
+}
+
+// SetText sets the "text" field.
+func (ciu *ChecklistItemUpdate) SetText(s string) *ChecklistItemUpdate {
+	ciu.mutation.SetText(s)
+	return ciu
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (ciu *ChecklistItemUpdate) SetNillableText(s *string) *ChecklistItemUpdate {
+	if s != nil {
+		ciu.SetText(*s)
+	}
+	return ciu
+}
+
+// SetDone sets the "done" field.
+func (ciu *ChecklistItemUpdate) SetDone(b bool) *ChecklistItemUpdate {
+	ciu.mutation.SetDone(b)
+	return ciu
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (ciu *ChecklistItemUpdate) SetNillableDone(b *bool) *ChecklistItemUpdate {
+	if b != nil {
+		ciu.SetDone(*b)
+	}
+	return ciu
+}
+
+// SetPosition sets the "position" field.
+func (ciu *ChecklistItemUpdate) SetPosition(i int) *ChecklistItemUpdate {
+	ciu.mutation.ResetPosition()
+	ciu.mutation.SetPosition(i)
+	return ciu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (ciu *ChecklistItemUpdate) SetNillablePosition(i *int) *ChecklistItemUpdate {
+	if i != nil {
+		ciu.SetPosition(*i)
+	}
+	return ciu
+}
+
+// AddPosition adds i to the "position" field.
+func (ciu *ChecklistItemUpdate) AddPosition(i int) *ChecklistItemUpdate {
+	ciu.mutation.AddPosition(i)
+	return ciu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (ciu *ChecklistItemUpdate) SetTask(t *Task) *ChecklistItemUpdate {
+	return ciu.SetTaskID(t.ID)
+}
+
+// Mutation returns the ChecklistItemMutation object of the builder.
+func (ciu *ChecklistItemUpdate) Mutation() *ChecklistItemMutation {
+	return ciu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (ciu *ChecklistItemUpdate) ClearTask() *ChecklistItemUpdate {
+	ciu.mutation.ClearTask()
+	return ciu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ciu *ChecklistItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ciu.sqlSave, ciu.mutation, ciu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ciu *ChecklistItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := ciu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ciu *ChecklistItemUpdate) Exec(ctx context.Context) error {
+	_, err := ciu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ciu *ChecklistItemUpdate) ExecX(ctx context.Context) {
+	if err := ciu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ciu *ChecklistItemUpdate) check() error {
+	if ciu.mutation.TaskCleared() && len(ciu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChecklistItem.task"`)
+	}
+	return nil
+}
+
+func (ciu *ChecklistItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ciu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checklistitem.Table, checklistitem.Columns, sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString))
+	if ps := ciu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ciu.mutation.Text(); ok {
+		_spec.SetField(checklistitem.FieldText, field.TypeString, value)
+	}
+	if value, ok := ciu.mutation.Done(); ok {
+		_spec.SetField(checklistitem.FieldDone, field.TypeBool, value)
+	}
+	if value, ok := ciu.mutation.Position(); ok {
+		_spec.SetField(checklistitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := ciu.mutation.AddedPosition(); ok {
+		_spec.AddField(checklistitem.FieldPosition, field.TypeInt, value)
+	}
+	if ciu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TaskTable,
+			Columns: []string{checklistitem.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ciu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TaskTable,
+			Columns: []string{checklistitem.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ciu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checklistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ciu.mutation.done = true
+	return n, nil
+}
+
+// ChecklistItemUpdateOne is the builder for updating a single ChecklistItem entity.
+type ChecklistItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChecklistItemMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (ciuo *ChecklistItemUpdateOne) SetTaskID(s string) *ChecklistItemUpdateOne {
+	ciuo.mutation.SetTaskID(s)
+	return ciuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (ciuo *ChecklistItemUpdateOne) SetNillableTaskID(s *string) *ChecklistItemUpdateOne {
+	if s != nil {
+		ciuo.SetTaskID(*s)
+	}
+	return ciuo
+}
+
+// SetText sets the "text" field.
+func (ciuo *ChecklistItemUpdateOne) SetText(s string) *ChecklistItemUpdateOne {
+	ciuo.mutation.SetText(s)
+	return ciuo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (ciuo *ChecklistItemUpdateOne) SetNillableText(s *string) *ChecklistItemUpdateOne {
+	if s != nil {
+		ciuo.SetText(*s)
+	}
+	return ciuo
+}
+
+// SetDone sets the "done" field.
+func (ciuo *ChecklistItemUpdateOne) SetDone(b bool) *ChecklistItemUpdateOne {
+	ciuo.mutation.SetDone(b)
+	return ciuo
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (ciuo *ChecklistItemUpdateOne) SetNillableDone(b *bool) *ChecklistItemUpdateOne {
+	if b != nil {
+		ciuo.SetDone(*b)
+	}
+	return ciuo
+}
+
+// SetPosition sets the "position" field.
+func (ciuo *ChecklistItemUpdateOne) SetPosition(i int) *ChecklistItemUpdateOne {
+	ciuo.mutation.ResetPosition()
+	ciuo.mutation.SetPosition(i)
+	return ciuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (ciuo *ChecklistItemUpdateOne) SetNillablePosition(i *int) *ChecklistItemUpdateOne {
+	if i != nil {
+		ciuo.SetPosition(*i)
+	}
+	return ciuo
+}
+
+// AddPosition adds i to the "position" field.
+func (ciuo *ChecklistItemUpdateOne) AddPosition(i int) *ChecklistItemUpdateOne {
+	ciuo.mutation.AddPosition(i)
+	return ciuo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (ciuo *ChecklistItemUpdateOne) SetTask(t *Task) *ChecklistItemUpdateOne {
+	return ciuo.SetTaskID(t.ID)
+}
+
+// Mutation returns the ChecklistItemMutation object of the builder.
+func (ciuo *ChecklistItemUpdateOne) Mutation() *ChecklistItemMutation {
+	return ciuo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (ciuo *ChecklistItemUpdateOne) ClearTask() *ChecklistItemUpdateOne {
+	ciuo.mutation.ClearTask()
+	return ciuo
+}
+
+// Where appends a list predicates to the ChecklistItemUpdate builder.
+func (ciuo *ChecklistItemUpdateOne) Where(ps ...predicate.ChecklistItem) *ChecklistItemUpdateOne {
+	ciuo.mutation.Where(ps...)
+	return ciuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ciuo *ChecklistItemUpdateOne) Select(field string, fields ...string) *ChecklistItemUpdateOne {
+	ciuo.fields = append([]string{field}, fields...)
+	return ciuo
+}
+
+// Save executes the query and returns the updated ChecklistItem entity.
+func (ciuo *ChecklistItemUpdateOne) Save(ctx context.Context) (*ChecklistItem, error) {
+	return withHooks(ctx, ciuo.sqlSave, ciuo.mutation, ciuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ciuo *ChecklistItemUpdateOne) SaveX(ctx context.Context) *ChecklistItem {
+	node, err := ciuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ciuo *ChecklistItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := ciuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ciuo *ChecklistItemUpdateOne) ExecX(ctx context.Context) {
+	if err := ciuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ciuo *ChecklistItemUpdateOne) check() error {
+	if ciuo.mutation.TaskCleared() && len(ciuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChecklistItem.task"`)
+	}
+	return nil
+}
+
+func (ciuo *ChecklistItemUpdateOne) sqlSave(ctx context.Context) (_node *ChecklistItem, err error) {
+	if err := ciuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checklistitem.Table, checklistitem.Columns, sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString))
+	id, ok := ciuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChecklistItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ciuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checklistitem.FieldID)
+		for _, f := range fields {
+			if !checklistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != checklistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ciuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ciuo.mutation.Text(); ok {
+		_spec.SetField(checklistitem.FieldText, field.TypeString, value)
+	}
+	if value, ok := ciuo.mutation.Done(); ok {
+		_spec.SetField(checklistitem.FieldDone, field.TypeBool, value)
+	}
+	if value, ok := ciuo.mutation.Position(); ok {
+		_spec.SetField(checklistitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := ciuo.mutation.AddedPosition(); ok {
+		_spec.AddField(checklistitem.FieldPosition, field.TypeInt, value)
+	}
+	if ciuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TaskTable,
+			Columns: []string{checklistitem.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ciuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checklistitem.TaskTable,
+			Columns: []string{checklistitem.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ChecklistItem{config: ciuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ciuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checklistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ciuo.mutation.done = true
+	return _node, nil
+}
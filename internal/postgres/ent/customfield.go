@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+)
+
+// CustomField is the model entity for the CustomField schema.
+type CustomField struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID string `json:"board_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Type holds the value of the "type" field.
+	Type customfield.Type `json:"type,omitempty"`
+	// Options holds the value of the "options" field.
+	Options []string `json:"options,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CustomFieldQuery when eager-loading is set.
+	Edges        CustomFieldEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CustomFieldEdges holds the relations/edges for other nodes in the graph.
+type CustomFieldEdges struct {
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// Values holds the value of the values edge.
+	Values []*CustomFieldValue `json:"values,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomFieldEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// ValuesOrErr returns the Values value or an error if the edge
+// was not loaded in eager-loading.
+func (e CustomFieldEdges) ValuesOrErr() ([]*CustomFieldValue, error) {
+	if e.loadedTypes[1] {
+		return e.Values, nil
+	}
+	return nil, &NotLoadedError{edge: "values"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CustomField) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customfield.FieldOptions:
+			values[i] = new([]byte)
+		case customfield.FieldID, customfield.FieldBoardID, customfield.FieldName, customfield.FieldType:
+			values[i] = new(sql.NullString)
+		case customfield.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CustomField fields.
+func (cf *CustomField) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customfield.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				cf.ID = value.String
+			}
+		case customfield.FieldBoardID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				cf.BoardID = value.String
+			}
+		case customfield.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cf.Name = value.String
+			}
+		case customfield.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				cf.Type = customfield.Type(value.String)
+			}
+		case customfield.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cf.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case customfield.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cf.CreatedAt = value.Time
+			}
+		default:
+			cf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CustomField.
+// This includes values selected through modifiers, order, etc.
+func (cf *CustomField) Value(name string) (ent.Value, error) {
+	return cf.selectValues.Get(name)
+}
+
+// QueryBoard queries the "board" edge of the CustomField entity.
+func (cf *CustomField) QueryBoard() *BoardQuery {
+	return NewCustomFieldClient(cf.config).QueryBoard(cf)
+}
+
+// QueryValues queries the "values" edge of the CustomField entity.
+func (cf *CustomField) QueryValues() *CustomFieldValueQuery {
+	return NewCustomFieldClient(cf.config).QueryValues(cf)
+}
+
+// Update returns a builder for updating this CustomField.
+// Note that you need to call CustomField.Unwrap() before calling this method if this CustomField
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cf *CustomField) Update() *CustomFieldUpdateOne {
+	return NewCustomFieldClient(cf.config).UpdateOne(cf)
+}
+
+// Unwrap unwraps the CustomField entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cf *CustomField) Unwrap() *CustomField {
+	_tx, ok := cf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CustomField is not a transactional entity")
+	}
+	cf.config.driver = _tx.drv
+	return cf
+}
+
+// String implements the fmt.Stringer.
+func (cf *CustomField) String() string {
+	var builder strings.Builder
+	builder.WriteString("CustomField(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cf.ID))
+	builder.WriteString("board_id=")
+	builder.WriteString(cf.BoardID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(cf.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", cf.Type))
+	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", cf.Options))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cf.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CustomFields is a parsable slice of CustomField.
+type CustomFields []*CustomField
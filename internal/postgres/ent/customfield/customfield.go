@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customfield
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the customfield type in the database.
+	Label = "custom_field"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBoardID holds the string denoting the board_id field in the database.
+	FieldBoardID = "board_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeBoard holds the string denoting the board edge name in mutations.
+	EdgeBoard = "board"
+	// EdgeValues holds the string denoting the values edge name in mutations.
+	EdgeValues = "values"
+	// Table holds the table name of the customfield in the database.
+	Table = "custom_fields"
+	// BoardTable is the table that holds the board relation/edge.
+	BoardTable = "custom_fields"
+	// BoardInverseTable is the table name for the Board entity.
+	// It exists in this package in order to avoid circular dependency with the "board" package.
+	BoardInverseTable = "boards"
+	// BoardColumn is the table column denoting the board relation/edge.
+	BoardColumn = "board_id"
+	// ValuesTable is the table that holds the values relation/edge.
+	ValuesTable = "custom_field_values"
+	// ValuesInverseTable is the table name for the CustomFieldValue entity.
+	// It exists in this package in order to avoid circular dependency with the "customfieldvalue" package.
+	ValuesInverseTable = "custom_field_values"
+	// ValuesColumn is the table column denoting the values relation/edge.
+	ValuesColumn = "field_id"
+)
+
+// Columns holds all SQL columns for customfield fields.
+var Columns = []string{
+	FieldID,
+	FieldBoardID,
+	FieldName,
+	FieldType,
+	FieldOptions,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeText   Type = "text"
+	TypeNumber Type = "number"
+	TypeDate   Type = "date"
+	TypeSelect Type = "select"
+	TypeUser   Type = "user"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeText, TypeNumber, TypeDate, TypeSelect, TypeUser:
+		return nil
+	default:
+		return fmt.Errorf("customfield: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the CustomField queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBoardID orders the results by the board_id field.
+func ByBoardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBoardID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBoardField orders the results by board field.
+func ByBoardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBoardStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByValuesCount orders the results by values count.
+func ByValuesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newValuesStep(), opts...)
+	}
+}
+
+// ByValues orders the results by values terms.
+func ByValues(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newValuesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBoardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BoardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+	)
+}
+func newValuesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ValuesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ValuesTable, ValuesColumn),
+	)
+}
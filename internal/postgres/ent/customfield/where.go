@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customfield
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldContainsFold(FieldID, id))
+}
+
+// BoardID applies equality check predicate on the "board_id" field. It's identical to BoardIDEQ.
+func BoardID(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEQ(FieldBoardID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEQ(FieldName, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BoardIDEQ applies the EQ predicate on the "board_id" field.
+func BoardIDEQ(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEQ(FieldBoardID, v))
+}
+
+// BoardIDNEQ applies the NEQ predicate on the "board_id" field.
+func BoardIDNEQ(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNEQ(FieldBoardID, v))
+}
+
+// BoardIDIn applies the In predicate on the "board_id" field.
+func BoardIDIn(vs ...string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldIn(FieldBoardID, vs...))
+}
+
+// BoardIDNotIn applies the NotIn predicate on the "board_id" field.
+func BoardIDNotIn(vs ...string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNotIn(FieldBoardID, vs...))
+}
+
+// BoardIDGT applies the GT predicate on the "board_id" field.
+func BoardIDGT(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldGT(FieldBoardID, v))
+}
+
+// BoardIDGTE applies the GTE predicate on the "board_id" field.
+func BoardIDGTE(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldGTE(FieldBoardID, v))
+}
+
+// BoardIDLT applies the LT predicate on the "board_id" field.
+func BoardIDLT(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldLT(FieldBoardID, v))
+}
+
+// BoardIDLTE applies the LTE predicate on the "board_id" field.
+func BoardIDLTE(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldLTE(FieldBoardID, v))
+}
+
+// BoardIDContains applies the Contains predicate on the "board_id" field.
+func BoardIDContains(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldContains(FieldBoardID, v))
+}
+
+// BoardIDHasPrefix applies the HasPrefix predicate on the "board_id" field.
+func BoardIDHasPrefix(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldHasPrefix(FieldBoardID, v))
+}
+
+// BoardIDHasSuffix applies the HasSuffix predicate on the "board_id" field.
+func BoardIDHasSuffix(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldHasSuffix(FieldBoardID, v))
+}
+
+// BoardIDEqualFold applies the EqualFold predicate on the "board_id" field.
+func BoardIDEqualFold(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEqualFold(FieldBoardID, v))
+}
+
+// BoardIDContainsFold applies the ContainsFold predicate on the "board_id" field.
+func BoardIDContainsFold(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldContainsFold(FieldBoardID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CustomField {
+	return predicate.CustomField(sql.FieldContainsFold(FieldName, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.CustomField {
+	return predicate.CustomField(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNotIn(FieldType, vs...))
+}
+
+// OptionsIsNil applies the IsNil predicate on the "options" field.
+func OptionsIsNil() predicate.CustomField {
+	return predicate.CustomField(sql.FieldIsNull(FieldOptions))
+}
+
+// OptionsNotNil applies the NotNil predicate on the "options" field.
+func OptionsNotNil() predicate.CustomField {
+	return predicate.CustomField(sql.FieldNotNull(FieldOptions))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CustomField {
+	return predicate.CustomField(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CustomField {
+	return predicate.CustomField(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CustomField {
+	return predicate.CustomField(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CustomField {
+	return predicate.CustomField(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CustomField {
+	return predicate.CustomField(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CustomField {
+	return predicate.CustomField(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CustomField {
+	return predicate.CustomField(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBoard applies the HasEdge predicate on the "board" edge.
+func HasBoard() predicate.CustomField {
+	return predicate.CustomField(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoardWith applies the HasEdge predicate on the "board" edge with a given conditions (other predicates).
+func HasBoardWith(preds ...predicate.Board) predicate.CustomField {
+	return predicate.CustomField(func(s *sql.Selector) {
+		step := newBoardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasValues applies the HasEdge predicate on the "values" edge.
+func HasValues() predicate.CustomField {
+	return predicate.CustomField(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ValuesTable, ValuesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasValuesWith applies the HasEdge predicate on the "values" edge with a given conditions (other predicates).
+func HasValuesWith(preds ...predicate.CustomFieldValue) predicate.CustomField {
+	return predicate.CustomField(func(s *sql.Selector) {
+		step := newValuesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CustomField) predicate.CustomField {
+	return predicate.CustomField(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CustomField) predicate.CustomField {
+	return predicate.CustomField(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CustomField) predicate.CustomField {
+	return predicate.CustomField(sql.NotPredicates(p))
+}
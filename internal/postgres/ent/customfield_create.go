@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+)
+
+// CustomFieldCreate is the builder for creating a CustomField entity.
+type CustomFieldCreate struct {
+	config
+	mutation *CustomFieldMutation
+	hooks    []Hook
+}
+
+// SetBoardID sets the "board_id" field.
+func (cfc *CustomFieldCreate) SetBoardID(s string) *CustomFieldCreate {
+	cfc.mutation.SetBoardID(s)
+	return cfc
+}
+
+// SetName sets the "name" field.
+func (cfc *CustomFieldCreate) SetName(s string) *CustomFieldCreate {
+	cfc.mutation.SetName(s)
+	return cfc
+}
+
+// SetType sets the "type" field.
+func (cfc *CustomFieldCreate) SetType(c customfield.Type) *CustomFieldCreate {
+	cfc.mutation.SetType(c)
+	return cfc
+}
+
+// SetOptions sets the "options" field.
+func (cfc *CustomFieldCreate) SetOptions(s []string) *CustomFieldCreate {
+	cfc.mutation.SetOptions(s)
+	return cfc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfc *CustomFieldCreate) SetCreatedAt(t time.Time) *CustomFieldCreate {
+	cfc.mutation.SetCreatedAt(t)
+	return cfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfc *CustomFieldCreate) SetNillableCreatedAt(t *time.Time) *CustomFieldCreate {
+	if t != nil {
+		cfc.SetCreatedAt(*t)
+	}
+	return cfc
+}
+
+// SetID sets the "id" field.
+func (cfc *CustomFieldCreate) SetID(s string) *CustomFieldCreate {
+	cfc.mutation.SetID(s)
+	return cfc
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (cfc *CustomFieldCreate) SetBoard(b *Board) *CustomFieldCreate {
+	return cfc.SetBoardID(b.ID)
+}
+
+// AddValueIDs adds the "values" edge to the CustomFieldValue entity by IDs.
+func (cfc *CustomFieldCreate) AddValueIDs(ids ...int) *CustomFieldCreate {
+	cfc.mutation.AddValueIDs(ids...)
+	return cfc
+}
+
+// AddValues adds the "values" edges to the CustomFieldValue entity.
+func (cfc *CustomFieldCreate) AddValues(c ...*CustomFieldValue) *CustomFieldCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cfc.AddValueIDs(ids...)
+}
+
+// Mutation returns the CustomFieldMutation object of the builder.
+func (cfc *CustomFieldCreate) Mutation() *CustomFieldMutation {
+	return cfc.mutation
+}
+
+// Save creates the CustomField in the database.
+func (cfc *CustomFieldCreate) Save(ctx context.Context) (*CustomField, error) {
+	cfc.defaults()
+	return withHooks(ctx, cfc.sqlSave, cfc.mutation, cfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cfc *CustomFieldCreate) SaveX(ctx context.Context) *CustomField {
+	v, err := cfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfc *CustomFieldCreate) Exec(ctx context.Context) error {
+	_, err := cfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfc *CustomFieldCreate) ExecX(ctx context.Context) {
+	if err := cfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfc *CustomFieldCreate) defaults() {
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		v := customfield.DefaultCreatedAt()
+		cfc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfc *CustomFieldCreate) check() error {
+	if _, ok := cfc.mutation.BoardID(); !ok {
+		return &ValidationError{Name: "board_id", err: errors.New(`ent: missing required field "CustomField.board_id"`)}
+	}
+	if _, ok := cfc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CustomField.name"`)}
+	}
+	if _, ok := cfc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "CustomField.type"`)}
+	}
+	if v, ok := cfc.mutation.GetType(); ok {
+		if err := customfield.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CustomField.type": %w`, err)}
+		}
+	}
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CustomField.created_at"`)}
+	}
+	if len(cfc.mutation.BoardIDs()) == 0 {
+		return &ValidationError{Name: "board", err: errors.New(`ent: missing required edge "CustomField.board"`)}
+	}
+	return nil
+}
+
+func (cfc *CustomFieldCreate) sqlSave(ctx context.Context) (*CustomField, error) {
+	if err := cfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CustomField.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cfc.mutation.id = &_node.ID
+	cfc.mutation.done = true
+	return _node, nil
+}
+
+func (cfc *CustomFieldCreate) createSpec() (*CustomField, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CustomField{config: cfc.config}
+		_spec = sqlgraph.NewCreateSpec(customfield.Table, sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString))
+	)
+	if id, ok := cfc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cfc.mutation.Name(); ok {
+		_spec.SetField(customfield.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cfc.mutation.GetType(); ok {
+		_spec.SetField(customfield.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := cfc.mutation.Options(); ok {
+		_spec.SetField(customfield.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := cfc.mutation.CreatedAt(); ok {
+		_spec.SetField(customfield.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cfc.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfield.BoardTable,
+			Columns: []string{customfield.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cfc.mutation.ValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customfield.ValuesTable,
+			Columns: []string{customfield.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CustomFieldCreateBulk is the builder for creating many CustomField entities in bulk.
+type CustomFieldCreateBulk struct {
+	config
+	err      error
+	builders []*CustomFieldCreate
+}
+
+// Save creates the CustomField entities in the database.
+func (cfcb *CustomFieldCreateBulk) Save(ctx context.Context) ([]*CustomField, error) {
+	if cfcb.err != nil {
+		return nil, cfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cfcb.builders))
+	nodes := make([]*CustomField, len(cfcb.builders))
+	mutators := make([]Mutator, len(cfcb.builders))
+	for i := range cfcb.builders {
+		func(i int, root context.Context) {
+			builder := cfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomFieldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfcb *CustomFieldCreateBulk) SaveX(ctx context.Context) []*CustomField {
+	v, err := cfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfcb *CustomFieldCreateBulk) Exec(ctx context.Context) error {
+	_, err := cfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfcb *CustomFieldCreateBulk) ExecX(ctx context.Context) {
+	if err := cfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// CustomFieldDelete is the builder for deleting a CustomField entity.
+type CustomFieldDelete struct {
+	config
+	hooks    []Hook
+	mutation *CustomFieldMutation
+}
+
+// Where appends a list predicates to the CustomFieldDelete builder.
+func (cfd *CustomFieldDelete) Where(ps ...predicate.CustomField) *CustomFieldDelete {
+	cfd.mutation.Where(ps...)
+	return cfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cfd *CustomFieldDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cfd.sqlExec, cfd.mutation, cfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfd *CustomFieldDelete) ExecX(ctx context.Context) int {
+	n, err := cfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cfd *CustomFieldDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(customfield.Table, sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString))
+	if ps := cfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cfd.mutation.done = true
+	return affected, err
+}
+
+// CustomFieldDeleteOne is the builder for deleting a single CustomField entity.
+type CustomFieldDeleteOne struct {
+	cfd *CustomFieldDelete
+}
+
+// Where appends a list predicates to the CustomFieldDelete builder.
+func (cfdo *CustomFieldDeleteOne) Where(ps ...predicate.CustomField) *CustomFieldDeleteOne {
+	cfdo.cfd.mutation.Where(ps...)
+	return cfdo
+}
+
+// Exec executes the deletion query.
+func (cfdo *CustomFieldDeleteOne) Exec(ctx context.Context) error {
+	n, err := cfdo.cfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{customfield.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfdo *CustomFieldDeleteOne) ExecX(ctx context.Context) {
+	if err := cfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// CustomFieldQuery is the builder for querying CustomField entities.
+type CustomFieldQuery struct {
+	config
+	ctx        *QueryContext
+	order      []customfield.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CustomField
+	withBoard  *BoardQuery
+	withValues *CustomFieldValueQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CustomFieldQuery builder.
+func (cfq *CustomFieldQuery) Where(ps ...predicate.CustomField) *CustomFieldQuery {
+	cfq.predicates = append(cfq.predicates, ps...)
+	return cfq
+}
+
+// Limit the number of records to be returned by this query.
+func (cfq *CustomFieldQuery) Limit(limit int) *CustomFieldQuery {
+	cfq.ctx.Limit = &limit
+	return cfq
+}
+
+// Offset to start from.
+func (cfq *CustomFieldQuery) Offset(offset int) *CustomFieldQuery {
+	cfq.ctx.Offset = &offset
+	return cfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cfq *CustomFieldQuery) Unique(unique bool) *CustomFieldQuery {
+	cfq.ctx.Unique = &unique
+	return cfq
+}
+
+// Order specifies how the records should be ordered.
+func (cfq *CustomFieldQuery) Order(o ...customfield.OrderOption) *CustomFieldQuery {
+	cfq.order = append(cfq.order, o...)
+	return cfq
+}
+
+// QueryBoard chains the current query on the "board" edge.
+func (cfq *CustomFieldQuery) QueryBoard() *BoardQuery {
+	query := (&BoardClient{config: cfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customfield.Table, customfield.FieldID, selector),
+			sqlgraph.To(board.Table, board.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, customfield.BoardTable, customfield.BoardColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryValues chains the current query on the "values" edge.
+func (cfq *CustomFieldQuery) QueryValues() *CustomFieldValueQuery {
+	query := (&CustomFieldValueClient{config: cfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customfield.Table, customfield.FieldID, selector),
+			sqlgraph.To(customfieldvalue.Table, customfieldvalue.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, customfield.ValuesTable, customfield.ValuesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CustomField entity from the query.
+// Returns a *NotFoundError when no CustomField was found.
+func (cfq *CustomFieldQuery) First(ctx context.Context) (*CustomField, error) {
+	nodes, err := cfq.Limit(1).All(setContextOp(ctx, cfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{customfield.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cfq *CustomFieldQuery) FirstX(ctx context.Context) *CustomField {
+	node, err := cfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CustomField ID from the query.
+// Returns a *NotFoundError when no CustomField ID was found.
+func (cfq *CustomFieldQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cfq.Limit(1).IDs(setContextOp(ctx, cfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{customfield.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cfq *CustomFieldQuery) FirstIDX(ctx context.Context) string {
+	id, err := cfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CustomField entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CustomField entity is found.
+// Returns a *NotFoundError when no CustomField entities are found.
+func (cfq *CustomFieldQuery) Only(ctx context.Context) (*CustomField, error) {
+	nodes, err := cfq.Limit(2).All(setContextOp(ctx, cfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{customfield.Label}
+	default:
+		return nil, &NotSingularError{customfield.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cfq *CustomFieldQuery) OnlyX(ctx context.Context) *CustomField {
+	node, err := cfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CustomField ID in the query.
+// Returns a *NotSingularError when more than one CustomField ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cfq *CustomFieldQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = cfq.Limit(2).IDs(setContextOp(ctx, cfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = &NotSingularError{customfield.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cfq *CustomFieldQuery) OnlyIDX(ctx context.Context) string {
+	id, err := cfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CustomFields.
+func (cfq *CustomFieldQuery) All(ctx context.Context) ([]*CustomField, error) {
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryAll)
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CustomField, *CustomFieldQuery]()
+	return withInterceptors[[]*CustomField](ctx, cfq, qr, cfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cfq *CustomFieldQuery) AllX(ctx context.Context) []*CustomField {
+	nodes, err := cfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CustomField IDs.
+func (cfq *CustomFieldQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if cfq.ctx.Unique == nil && cfq.path != nil {
+		cfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryIDs)
+	if err = cfq.Select(customfield.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cfq *CustomFieldQuery) IDsX(ctx context.Context) []string {
+	ids, err := cfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cfq *CustomFieldQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryCount)
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cfq, querierCount[*CustomFieldQuery](), cfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cfq *CustomFieldQuery) CountX(ctx context.Context) int {
+	count, err := cfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cfq *CustomFieldQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cfq.ctx, ent.OpQueryExist)
+	switch _, err := cfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cfq *CustomFieldQuery) ExistX(ctx context.Context) bool {
+	exist, err := cfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CustomFieldQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cfq *CustomFieldQuery) Clone() *CustomFieldQuery {
+	if cfq == nil {
+		return nil
+	}
+	return &CustomFieldQuery{
+		config:     cfq.config,
+		ctx:        cfq.ctx.Clone(),
+		order:      append([]customfield.OrderOption{}, cfq.order...),
+		inters:     append([]Interceptor{}, cfq.inters...),
+		predicates: append([]predicate.CustomField{}, cfq.predicates...),
+		withBoard:  cfq.withBoard.Clone(),
+		withValues: cfq.withValues.Clone(),
+		// clone intermediate query.
+		sql:  cfq.sql.Clone(),
+		path: cfq.path,
+	}
+}
+
+// WithBoard tells the query-builder to eager-load the nodes that are connected to
+// the "board" edge. The optional arguments are used to configure the query builder of the edge.
+func (cfq *CustomFieldQuery) WithBoard(opts ...func(*BoardQuery)) *CustomFieldQuery {
+	query := (&BoardClient{config: cfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cfq.withBoard = query
+	return cfq
+}
+
+// WithValues tells the query-builder to eager-load the nodes that are connected to
+// the "values" edge. The optional arguments are used to configure the query builder of the edge.
+func (cfq *CustomFieldQuery) WithValues(opts ...func(*CustomFieldValueQuery)) *CustomFieldQuery {
+	query := (&CustomFieldValueClient{config: cfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cfq.withValues = query
+	return cfq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CustomField.Query().
+//		GroupBy(customfield.FieldBoardID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cfq *CustomFieldQuery) GroupBy(field string, fields ...string) *CustomFieldGroupBy {
+	cfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CustomFieldGroupBy{build: cfq}
+	grbuild.flds = &cfq.ctx.Fields
+	grbuild.label = customfield.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//	}
+//
+//	client.CustomField.Query().
+//		Select(customfield.FieldBoardID).
+//		Scan(ctx, &v)
+func (cfq *CustomFieldQuery) Select(fields ...string) *CustomFieldSelect {
+	cfq.ctx.Fields = append(cfq.ctx.Fields, fields...)
+	sbuild := &CustomFieldSelect{CustomFieldQuery: cfq}
+	sbuild.label = customfield.Label
+	sbuild.flds, sbuild.scan = &cfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CustomFieldSelect configured with the given aggregations.
+func (cfq *CustomFieldQuery) Aggregate(fns ...AggregateFunc) *CustomFieldSelect {
+	return cfq.Select().Aggregate(fns...)
+}
+
+func (cfq *CustomFieldQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cfq.ctx.Fields {
+		if !customfield.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cfq.path != nil {
+		prev, err := cfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cfq.sql = prev
+	}
+	return nil
+}
+
+func (cfq *CustomFieldQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CustomField, error) {
+	var (
+		nodes       = []*CustomField{}
+		_spec       = cfq.querySpec()
+		loadedTypes = [2]bool{
+			cfq.withBoard != nil,
+			cfq.withValues != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CustomField).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CustomField{config: cfq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cfq.withBoard; query != nil {
+		if err := cfq.loadBoard(ctx, query, nodes, nil,
+			func(n *CustomField, e *Board) { n.Edges.Board = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cfq.withValues; query != nil {
+		if err := cfq.loadValues(ctx, query, nodes,
+			func(n *CustomField) { n.Edges.Values = []*CustomFieldValue{} },
+			func(n *CustomField, e *CustomFieldValue) { n.Edges.Values = append(n.Edges.Values, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cfq *CustomFieldQuery) loadBoard(ctx context.Context, query *BoardQuery, nodes []*CustomField, init func(*CustomField), assign func(*CustomField, *Board)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CustomField)
+	for i := range nodes {
+		fk := nodes[i].BoardID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(board.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "board_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cfq *CustomFieldQuery) loadValues(ctx context.Context, query *CustomFieldValueQuery, nodes []*CustomField, init func(*CustomField), assign func(*CustomField, *CustomFieldValue)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*CustomField)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(customfieldvalue.FieldFieldID)
+	}
+	query.Where(predicate.CustomFieldValue(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(customfield.ValuesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FieldID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "field_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (cfq *CustomFieldQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cfq.querySpec()
+	_spec.Node.Columns = cfq.ctx.Fields
+	if len(cfq.ctx.Fields) > 0 {
+		_spec.Unique = cfq.ctx.Unique != nil && *cfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cfq.driver, _spec)
+}
+
+func (cfq *CustomFieldQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(customfield.Table, customfield.Columns, sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString))
+	_spec.From = cfq.sql
+	if unique := cfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customfield.FieldID)
+		for i := range fields {
+			if fields[i] != customfield.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cfq.withBoard != nil {
+			_spec.Node.AddColumnOnce(customfield.FieldBoardID)
+		}
+	}
+	if ps := cfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cfq *CustomFieldQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cfq.driver.Dialect())
+	t1 := builder.Table(customfield.Table)
+	columns := cfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = customfield.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cfq.sql != nil {
+		selector = cfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cfq.ctx.Unique != nil && *cfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cfq.predicates {
+		p(selector)
+	}
+	for _, p := range cfq.order {
+		p(selector)
+	}
+	if offset := cfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CustomFieldGroupBy is the group-by builder for CustomField entities.
+type CustomFieldGroupBy struct {
+	selector
+	build *CustomFieldQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cfgb *CustomFieldGroupBy) Aggregate(fns ...AggregateFunc) *CustomFieldGroupBy {
+	cfgb.fns = append(cfgb.fns, fns...)
+	return cfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfgb *CustomFieldGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CustomFieldQuery, *CustomFieldGroupBy](ctx, cfgb.build, cfgb, cfgb.build.inters, v)
+}
+
+func (cfgb *CustomFieldGroupBy) sqlScan(ctx context.Context, root *CustomFieldQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cfgb.fns))
+	for _, fn := range cfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cfgb.flds)+len(cfgb.fns))
+		for _, f := range *cfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CustomFieldSelect is the builder for selecting fields of CustomField entities.
+type CustomFieldSelect struct {
+	*CustomFieldQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cfs *CustomFieldSelect) Aggregate(fns ...AggregateFunc) *CustomFieldSelect {
+	cfs.fns = append(cfs.fns, fns...)
+	return cfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfs *CustomFieldSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfs.ctx, ent.OpQuerySelect)
+	if err := cfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CustomFieldQuery, *CustomFieldSelect](ctx, cfs.CustomFieldQuery, cfs, cfs.inters, v)
+}
+
+func (cfs *CustomFieldSelect) sqlScan(ctx context.Context, root *CustomFieldQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cfs.fns))
+	for _, fn := range cfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
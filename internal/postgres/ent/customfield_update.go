@@ -0,0 +1,641 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// CustomFieldUpdate is the builder for updating CustomField entities.
+type CustomFieldUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomFieldMutation
+}
+
+// Where appends a list predicates to the CustomFieldUpdate builder.
+func (cfu *CustomFieldUpdate) Where(ps ...predicate.CustomField) *CustomFieldUpdate {
+	cfu.mutation.Where(ps...)
+	return cfu
+}
+
+// SetBoardID sets the "board_id" field.
+func (cfu *CustomFieldUpdate) SetBoardID(s string) *CustomFieldUpdate {
+	cfu.mutation.SetBoardID(s)
+	return cfu
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillableBoardID(s *string) *CustomFieldUpdate {
+	if s != nil {
+		cfu.SetBoardID(*s)
+	}
+	return cfu
+}
+
+// SetName sets the "name" field.
+func (cfu *CustomFieldUpdate) SetName(s string) *CustomFieldUpdate {
+	cfu.mutation.SetName(s)
+	return cfu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillableName(s *string) *CustomFieldUpdate {
+	if s != nil {
+		cfu.SetName(*s)
+	}
+	return cfu
+}
+
+// SetType sets the "type" field.
+func (cfu *CustomFieldUpdate) SetType(c customfield.Type) *CustomFieldUpdate {
+	cfu.mutation.SetType(c)
+	return cfu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillableType(c *customfield.Type) *CustomFieldUpdate {
+	if c != nil {
+		cfu.SetType(*c)
+	}
+	return cfu
+}
+
+// SetOptions sets the "options" field.
+func (cfu *CustomFieldUpdate) SetOptions(s []string) *CustomFieldUpdate {
+	cfu.mutation.SetOptions(s)
+	return cfu
+}
+
+// AppendOptions appends s to the "options" field.
+func (cfu *CustomFieldUpdate) AppendOptions(s []string) *CustomFieldUpdate {
+	cfu.mutation.AppendOptions(s)
+	return cfu
+}
+
+// ClearOptions clears the value of the "options" field.
+func (cfu *CustomFieldUpdate) ClearOptions() *CustomFieldUpdate {
+	cfu.mutation.ClearOptions()
+	return cfu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfu *CustomFieldUpdate) SetCreatedAt(t time.Time) *CustomFieldUpdate {
+	cfu.mutation.SetCreatedAt(t)
+	return cfu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillableCreatedAt(t *time.Time) *CustomFieldUpdate {
+	if t != nil {
+		cfu.SetCreatedAt(*t)
+	}
+	return cfu
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (cfu *CustomFieldUpdate) SetBoard(b *Board) *CustomFieldUpdate {
+	return cfu.SetBoardID(b.ID)
+}
+
+// AddValueIDs adds the "values" edge to the CustomFieldValue entity by IDs.
+func (cfu *CustomFieldUpdate) AddValueIDs(ids ...int) *CustomFieldUpdate {
+	cfu.mutation.AddValueIDs(ids...)
+	return cfu
+}
+
+// AddValues adds the "values" edges to the CustomFieldValue entity.
+func (cfu *CustomFieldUpdate) AddValues(c ...*CustomFieldValue) *CustomFieldUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cfu.AddValueIDs(ids...)
+}
+
+// Mutation returns the CustomFieldMutation object of the builder.
+func (cfu *CustomFieldUpdate) Mutation() *CustomFieldMutation {
+	return cfu.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (cfu *CustomFieldUpdate) ClearBoard() *CustomFieldUpdate {
+	cfu.mutation.ClearBoard()
+	return cfu
+}
+
+// ClearValues clears all "values" edges to the CustomFieldValue entity.
+func (cfu *CustomFieldUpdate) ClearValues() *CustomFieldUpdate {
+	cfu.mutation.ClearValues()
+	return cfu
+}
+
+// RemoveValueIDs removes the "values" edge to CustomFieldValue entities by IDs.
+func (cfu *CustomFieldUpdate) RemoveValueIDs(ids ...int) *CustomFieldUpdate {
+	cfu.mutation.RemoveValueIDs(ids...)
+	return cfu
+}
+
+// RemoveValues removes "values" edges to CustomFieldValue entities.
+func (cfu *CustomFieldUpdate) RemoveValues(c ...*CustomFieldValue) *CustomFieldUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cfu.RemoveValueIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cfu *CustomFieldUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cfu.sqlSave, cfu.mutation, cfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfu *CustomFieldUpdate) SaveX(ctx context.Context) int {
+	affected, err := cfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cfu *CustomFieldUpdate) Exec(ctx context.Context) error {
+	_, err := cfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfu *CustomFieldUpdate) ExecX(ctx context.Context) {
+	if err := cfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfu *CustomFieldUpdate) check() error {
+	if v, ok := cfu.mutation.GetType(); ok {
+		if err := customfield.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CustomField.type": %w`, err)}
+		}
+	}
+	if cfu.mutation.BoardCleared() && len(cfu.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomField.board"`)
+	}
+	return nil
+}
+
+func (cfu *CustomFieldUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customfield.Table, customfield.Columns, sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString))
+	if ps := cfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfu.mutation.Name(); ok {
+		_spec.SetField(customfield.FieldName, field.TypeString, value)
+	}
+	if value, ok := cfu.mutation.GetType(); ok {
+		_spec.SetField(customfield.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := cfu.mutation.Options(); ok {
+		_spec.SetField(customfield.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := cfu.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, customfield.FieldOptions, value)
+		})
+	}
+	if cfu.mutation.OptionsCleared() {
+		_spec.ClearField(customfield.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := cfu.mutation.CreatedAt(); ok {
+		_spec.SetField(customfield.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cfu.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfield.BoardTable,
+			Columns: []string{customfield.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfu.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfield.BoardTable,
+			Columns: []string{customfield.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cfu.mutation.ValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customfield.ValuesTable,
+			Columns: []string{customfield.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfu.mutation.RemovedValuesIDs(); len(nodes) > 0 && !cfu.mutation.ValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customfield.ValuesTable,
+			Columns: []string{customfield.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfu.mutation.ValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customfield.ValuesTable,
+			Columns: []string{customfield.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customfield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cfu.mutation.done = true
+	return n, nil
+}
+
+// CustomFieldUpdateOne is the builder for updating a single CustomField entity.
+type CustomFieldUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomFieldMutation
+}
+
+// SetBoardID sets the "board_id" field.
+func (cfuo *CustomFieldUpdateOne) SetBoardID(s string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetBoardID(s)
+	return cfuo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillableBoardID(s *string) *CustomFieldUpdateOne {
+	if s != nil {
+		cfuo.SetBoardID(*s)
+	}
+	return cfuo
+}
+
+// SetName sets the "name" field.
+func (cfuo *CustomFieldUpdateOne) SetName(s string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetName(s)
+	return cfuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillableName(s *string) *CustomFieldUpdateOne {
+	if s != nil {
+		cfuo.SetName(*s)
+	}
+	return cfuo
+}
+
+// SetType sets the "type" field.
+func (cfuo *CustomFieldUpdateOne) SetType(c customfield.Type) *CustomFieldUpdateOne {
+	cfuo.mutation.SetType(c)
+	return cfuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillableType(c *customfield.Type) *CustomFieldUpdateOne {
+	if c != nil {
+		cfuo.SetType(*c)
+	}
+	return cfuo
+}
+
+// SetOptions sets the "options" field.
+func (cfuo *CustomFieldUpdateOne) SetOptions(s []string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetOptions(s)
+	return cfuo
+}
+
+// AppendOptions appends s to the "options" field.
+func (cfuo *CustomFieldUpdateOne) AppendOptions(s []string) *CustomFieldUpdateOne {
+	cfuo.mutation.AppendOptions(s)
+	return cfuo
+}
+
+// ClearOptions clears the value of the "options" field.
+func (cfuo *CustomFieldUpdateOne) ClearOptions() *CustomFieldUpdateOne {
+	cfuo.mutation.ClearOptions()
+	return cfuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfuo *CustomFieldUpdateOne) SetCreatedAt(t time.Time) *CustomFieldUpdateOne {
+	cfuo.mutation.SetCreatedAt(t)
+	return cfuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillableCreatedAt(t *time.Time) *CustomFieldUpdateOne {
+	if t != nil {
+		cfuo.SetCreatedAt(*t)
+	}
+	return cfuo
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (cfuo *CustomFieldUpdateOne) SetBoard(b *Board) *CustomFieldUpdateOne {
+	return cfuo.SetBoardID(b.ID)
+}
+
+// AddValueIDs adds the "values" edge to the CustomFieldValue entity by IDs.
+func (cfuo *CustomFieldUpdateOne) AddValueIDs(ids ...int) *CustomFieldUpdateOne {
+	cfuo.mutation.AddValueIDs(ids...)
+	return cfuo
+}
+
+// AddValues adds the "values" edges to the CustomFieldValue entity.
+func (cfuo *CustomFieldUpdateOne) AddValues(c ...*CustomFieldValue) *CustomFieldUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cfuo.AddValueIDs(ids...)
+}
+
+// Mutation returns the CustomFieldMutation object of the builder.
+func (cfuo *CustomFieldUpdateOne) Mutation() *CustomFieldMutation {
+	return cfuo.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (cfuo *CustomFieldUpdateOne) ClearBoard() *CustomFieldUpdateOne {
+	cfuo.mutation.ClearBoard()
+	return cfuo
+}
+
+// ClearValues clears all "values" edges to the CustomFieldValue entity.
+func (cfuo *CustomFieldUpdateOne) ClearValues() *CustomFieldUpdateOne {
+	cfuo.mutation.ClearValues()
+	return cfuo
+}
+
+// RemoveValueIDs removes the "values" edge to CustomFieldValue entities by IDs.
+func (cfuo *CustomFieldUpdateOne) RemoveValueIDs(ids ...int) *CustomFieldUpdateOne {
+	cfuo.mutation.RemoveValueIDs(ids...)
+	return cfuo
+}
+
+// RemoveValues removes "values" edges to CustomFieldValue entities.
+func (cfuo *CustomFieldUpdateOne) RemoveValues(c ...*CustomFieldValue) *CustomFieldUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cfuo.RemoveValueIDs(ids...)
+}
+
+// Where appends a list predicates to the CustomFieldUpdate builder.
+func (cfuo *CustomFieldUpdateOne) Where(ps ...predicate.CustomField) *CustomFieldUpdateOne {
+	cfuo.mutation.Where(ps...)
+	return cfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cfuo *CustomFieldUpdateOne) Select(field string, fields ...string) *CustomFieldUpdateOne {
+	cfuo.fields = append([]string{field}, fields...)
+	return cfuo
+}
+
+// Save executes the query and returns the updated CustomField entity.
+func (cfuo *CustomFieldUpdateOne) Save(ctx context.Context) (*CustomField, error) {
+	return withHooks(ctx, cfuo.sqlSave, cfuo.mutation, cfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfuo *CustomFieldUpdateOne) SaveX(ctx context.Context) *CustomField {
+	node, err := cfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cfuo *CustomFieldUpdateOne) Exec(ctx context.Context) error {
+	_, err := cfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfuo *CustomFieldUpdateOne) ExecX(ctx context.Context) {
+	if err := cfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfuo *CustomFieldUpdateOne) check() error {
+	if v, ok := cfuo.mutation.GetType(); ok {
+		if err := customfield.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CustomField.type": %w`, err)}
+		}
+	}
+	if cfuo.mutation.BoardCleared() && len(cfuo.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomField.board"`)
+	}
+	return nil
+}
+
+func (cfuo *CustomFieldUpdateOne) sqlSave(ctx context.Context) (_node *CustomField, err error) {
+	if err := cfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customfield.Table, customfield.Columns, sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString))
+	id, ok := cfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CustomField.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customfield.FieldID)
+		for _, f := range fields {
+			if !customfield.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customfield.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfuo.mutation.Name(); ok {
+		_spec.SetField(customfield.FieldName, field.TypeString, value)
+	}
+	if value, ok := cfuo.mutation.GetType(); ok {
+		_spec.SetField(customfield.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := cfuo.mutation.Options(); ok {
+		_spec.SetField(customfield.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := cfuo.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, customfield.FieldOptions, value)
+		})
+	}
+	if cfuo.mutation.OptionsCleared() {
+		_spec.ClearField(customfield.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := cfuo.mutation.CreatedAt(); ok {
+		_spec.SetField(customfield.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cfuo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfield.BoardTable,
+			Columns: []string{customfield.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfuo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfield.BoardTable,
+			Columns: []string{customfield.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cfuo.mutation.ValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customfield.ValuesTable,
+			Columns: []string{customfield.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfuo.mutation.RemovedValuesIDs(); len(nodes) > 0 && !cfuo.mutation.ValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customfield.ValuesTable,
+			Columns: []string{customfield.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfuo.mutation.ValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   customfield.ValuesTable,
+			Columns: []string{customfield.ValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CustomField{config: cfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customfield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cfuo.mutation.done = true
+	return _node, nil
+}
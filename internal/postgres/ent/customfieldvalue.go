@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// CustomFieldValue is the model entity for the CustomFieldValue schema.
+type CustomFieldValue struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// FieldID holds the value of the "field_id" field.
+	FieldID string `json:"field_id,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CustomFieldValueQuery when eager-loading is set.
+	Edges        CustomFieldValueEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CustomFieldValueEdges holds the relations/edges for other nodes in the graph.
+type CustomFieldValueEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// CustomField holds the value of the custom_field edge.
+	CustomField *CustomField `json:"custom_field,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomFieldValueEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// CustomFieldOrErr returns the CustomField value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CustomFieldValueEdges) CustomFieldOrErr() (*CustomField, error) {
+	if e.CustomField != nil {
+		return e.CustomField, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: customfield.Label}
+	}
+	return nil, &NotLoadedError{edge: "custom_field"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CustomFieldValue) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case customfieldvalue.FieldID:
+			values[i] = new(sql.NullInt64)
+		case customfieldvalue.FieldTaskID, customfieldvalue.FieldFieldID, customfieldvalue.FieldValue:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CustomFieldValue fields.
+func (cfv *CustomFieldValue) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case customfieldvalue.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cfv.ID = int(value.Int64)
+		case customfieldvalue.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				cfv.TaskID = value.String
+			}
+		case customfieldvalue.FieldFieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field_id", values[i])
+			} else if value.Valid {
+				cfv.FieldID = value.String
+			}
+		case customfieldvalue.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				cfv.Value = value.String
+			}
+		default:
+			cfv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the CustomFieldValue.
+// This includes values selected through modifiers, order, etc.
+func (cfv *CustomFieldValue) GetValue(name string) (ent.Value, error) {
+	return cfv.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the CustomFieldValue entity.
+func (cfv *CustomFieldValue) QueryTask() *TaskQuery {
+	return NewCustomFieldValueClient(cfv.config).QueryTask(cfv)
+}
+
+// QueryCustomField queries the "custom_field" edge of the CustomFieldValue entity.
+func (cfv *CustomFieldValue) QueryCustomField() *CustomFieldQuery {
+	return NewCustomFieldValueClient(cfv.config).QueryCustomField(cfv)
+}
+
+// Update returns a builder for updating this CustomFieldValue.
+// Note that you need to call CustomFieldValue.Unwrap() before calling this method if this CustomFieldValue
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cfv *CustomFieldValue) Update() *CustomFieldValueUpdateOne {
+	return NewCustomFieldValueClient(cfv.config).UpdateOne(cfv)
+}
+
+// Unwrap unwraps the CustomFieldValue entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cfv *CustomFieldValue) Unwrap() *CustomFieldValue {
+	_tx, ok := cfv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CustomFieldValue is not a transactional entity")
+	}
+	cfv.config.driver = _tx.drv
+	return cfv
+}
+
+// String implements the fmt.Stringer.
+func (cfv *CustomFieldValue) String() string {
+	var builder strings.Builder
+	builder.WriteString("CustomFieldValue(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cfv.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(cfv.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("field_id=")
+	builder.WriteString(cfv.FieldID)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(cfv.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CustomFieldValues is a parsable slice of CustomFieldValue.
+type CustomFieldValues []*CustomFieldValue
@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customfieldvalue
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the customfieldvalue type in the database.
+	Label = "custom_field_value"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldFieldID holds the string denoting the field_id field in the database.
+	FieldFieldID = "field_id"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// EdgeTask holds the string denoting the task edge name in mutations.
+	EdgeTask = "task"
+	// EdgeCustomField holds the string denoting the custom_field edge name in mutations.
+	EdgeCustomField = "custom_field"
+	// Table holds the table name of the customfieldvalue in the database.
+	Table = "custom_field_values"
+	// TaskTable is the table that holds the task relation/edge.
+	TaskTable = "custom_field_values"
+	// TaskInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TaskInverseTable = "tasks"
+	// TaskColumn is the table column denoting the task relation/edge.
+	TaskColumn = "task_id"
+	// CustomFieldTable is the table that holds the custom_field relation/edge.
+	CustomFieldTable = "custom_field_values"
+	// CustomFieldInverseTable is the table name for the CustomField entity.
+	// It exists in this package in order to avoid circular dependency with the "customfield" package.
+	CustomFieldInverseTable = "custom_fields"
+	// CustomFieldColumn is the table column denoting the custom_field relation/edge.
+	CustomFieldColumn = "field_id"
+)
+
+// Columns holds all SQL columns for customfieldvalue fields.
+var Columns = []string{
+	FieldID,
+	FieldTaskID,
+	FieldFieldID,
+	FieldValue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the CustomFieldValue queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByFieldID orders the results by the field_id field.
+func ByFieldID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFieldID, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByTaskField orders the results by task field.
+func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCustomFieldField orders the results by custom_field field.
+func ByCustomFieldField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomFieldStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTaskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+	)
+}
+func newCustomFieldStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomFieldInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CustomFieldTable, CustomFieldColumn),
+	)
+}
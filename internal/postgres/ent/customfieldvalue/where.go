@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package customfieldvalue
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldLTE(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEQ(FieldTaskID, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEQ(FieldValue, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDContains applies the Contains predicate on the "task_id" field.
+func TaskIDContains(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldContains(FieldTaskID, v))
+}
+
+// TaskIDHasPrefix applies the HasPrefix predicate on the "task_id" field.
+func TaskIDHasPrefix(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldHasPrefix(FieldTaskID, v))
+}
+
+// TaskIDHasSuffix applies the HasSuffix predicate on the "task_id" field.
+func TaskIDHasSuffix(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldHasSuffix(FieldTaskID, v))
+}
+
+// TaskIDEqualFold applies the EqualFold predicate on the "task_id" field.
+func TaskIDEqualFold(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEqualFold(FieldTaskID, v))
+}
+
+// TaskIDContainsFold applies the ContainsFold predicate on the "task_id" field.
+func TaskIDContainsFold(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldContainsFold(FieldTaskID, v))
+}
+
+// FieldIDEQ applies the EQ predicate on the "field_id" field.
+func FieldIDEQ(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEQ(FieldFieldID, v))
+}
+
+// FieldIDNEQ applies the NEQ predicate on the "field_id" field.
+func FieldIDNEQ(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldNEQ(FieldFieldID, v))
+}
+
+// FieldIDIn applies the In predicate on the "field_id" field.
+func FieldIDIn(vs ...string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldIn(FieldFieldID, vs...))
+}
+
+// FieldIDNotIn applies the NotIn predicate on the "field_id" field.
+func FieldIDNotIn(vs ...string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldNotIn(FieldFieldID, vs...))
+}
+
+// FieldIDGT applies the GT predicate on the "field_id" field.
+func FieldIDGT(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldGT(FieldFieldID, v))
+}
+
+// FieldIDGTE applies the GTE predicate on the "field_id" field.
+func FieldIDGTE(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldGTE(FieldFieldID, v))
+}
+
+// FieldIDLT applies the LT predicate on the "field_id" field.
+func FieldIDLT(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldLT(FieldFieldID, v))
+}
+
+// FieldIDLTE applies the LTE predicate on the "field_id" field.
+func FieldIDLTE(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldLTE(FieldFieldID, v))
+}
+
+// FieldIDContains applies the Contains predicate on the "field_id" field.
+func FieldIDContains(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldContains(FieldFieldID, v))
+}
+
+// FieldIDHasPrefix applies the HasPrefix predicate on the "field_id" field.
+func FieldIDHasPrefix(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldHasPrefix(FieldFieldID, v))
+}
+
+// FieldIDHasSuffix applies the HasSuffix predicate on the "field_id" field.
+func FieldIDHasSuffix(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldHasSuffix(FieldFieldID, v))
+}
+
+// FieldIDEqualFold applies the EqualFold predicate on the "field_id" field.
+func FieldIDEqualFold(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEqualFold(FieldFieldID, v))
+}
+
+// FieldIDContainsFold applies the ContainsFold predicate on the "field_id" field.
+func FieldIDContainsFold(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldContainsFold(FieldFieldID, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.FieldContainsFold(FieldValue, v))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCustomField applies the HasEdge predicate on the "custom_field" edge.
+func HasCustomField() predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CustomFieldTable, CustomFieldColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomFieldWith applies the HasEdge predicate on the "custom_field" edge with a given conditions (other predicates).
+func HasCustomFieldWith(preds ...predicate.CustomField) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(func(s *sql.Selector) {
+		step := newCustomFieldStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CustomFieldValue) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CustomFieldValue) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CustomFieldValue) predicate.CustomFieldValue {
+	return predicate.CustomFieldValue(sql.NotPredicates(p))
+}
@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// CustomFieldValueCreate is the builder for creating a CustomFieldValue entity.
+type CustomFieldValueCreate struct {
+	config
+	mutation *CustomFieldValueMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (cfvc *CustomFieldValueCreate) SetTaskID(s string) *CustomFieldValueCreate {
+	cfvc.mutation.SetTaskID(s)
+	return cfvc
+}
+
+// SetFieldID sets the "field_id" field.
+func (cfvc *CustomFieldValueCreate) SetFieldID(s string) *CustomFieldValueCreate {
+	cfvc.mutation.SetFieldID(s)
+	return cfvc
+}
+
+// SetValue sets the "value" field.
+func (cfvc *CustomFieldValueCreate) SetValue(s string) *CustomFieldValueCreate {
+	cfvc.mutation.SetValue(s)
+	return cfvc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (cfvc *CustomFieldValueCreate) SetTask(t *Task) *CustomFieldValueCreate {
+	return cfvc.SetTaskID(t.ID)
+}
+
+// SetCustomFieldID sets the "custom_field" edge to the CustomField entity by ID.
+func (cfvc *CustomFieldValueCreate) SetCustomFieldID(id string) *CustomFieldValueCreate {
+	cfvc.mutation.SetCustomFieldID(id)
+	return cfvc
+}
+
+// SetCustomField sets the "custom_field" edge to the CustomField entity.
+func (cfvc *CustomFieldValueCreate) SetCustomField(c *CustomField) *CustomFieldValueCreate {
+	return cfvc.SetCustomFieldID(c.ID)
+}
+
+// Mutation returns the CustomFieldValueMutation object of the builder.
+func (cfvc *CustomFieldValueCreate) Mutation() *CustomFieldValueMutation {
+	return cfvc.mutation
+}
+
+// Save creates the CustomFieldValue in the database.
+func (cfvc *CustomFieldValueCreate) Save(ctx context.Context) (*CustomFieldValue, error) {
+	return withHooks(ctx, cfvc.sqlSave, cfvc.mutation, cfvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cfvc *CustomFieldValueCreate) SaveX(ctx context.Context) *CustomFieldValue {
+	v, err := cfvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfvc *CustomFieldValueCreate) Exec(ctx context.Context) error {
+	_, err := cfvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvc *CustomFieldValueCreate) ExecX(ctx context.Context) {
+	if err := cfvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfvc *CustomFieldValueCreate) check() error {
+	if _, ok := cfvc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "CustomFieldValue.task_id"`)}
+	}
+	if _, ok := cfvc.mutation.FieldID(); !ok {
+		return &ValidationError{Name: "field_id", err: errors.New(`ent: missing required field "CustomFieldValue.field_id"`)}
+	}
+	if _, ok := cfvc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "CustomFieldValue.value"`)}
+	}
+	if len(cfvc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "CustomFieldValue.task"`)}
+	}
+	if len(cfvc.mutation.CustomFieldIDs()) == 0 {
+		return &ValidationError{Name: "custom_field", err: errors.New(`ent: missing required edge "CustomFieldValue.custom_field"`)}
+	}
+	return nil
+}
+
+func (cfvc *CustomFieldValueCreate) sqlSave(ctx context.Context) (*CustomFieldValue, error) {
+	if err := cfvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cfvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cfvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cfvc.mutation.id = &_node.ID
+	cfvc.mutation.done = true
+	return _node, nil
+}
+
+func (cfvc *CustomFieldValueCreate) createSpec() (*CustomFieldValue, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CustomFieldValue{config: cfvc.config}
+		_spec = sqlgraph.NewCreateSpec(customfieldvalue.Table, sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt))
+	)
+	if value, ok := cfvc.mutation.Value(); ok {
+		_spec.SetField(customfieldvalue.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if nodes := cfvc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.TaskTable,
+			Columns: []string{customfieldvalue.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cfvc.mutation.CustomFieldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.CustomFieldTable,
+			Columns: []string{customfieldvalue.CustomFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FieldID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CustomFieldValueCreateBulk is the builder for creating many CustomFieldValue entities in bulk.
+type CustomFieldValueCreateBulk struct {
+	config
+	err      error
+	builders []*CustomFieldValueCreate
+}
+
+// Save creates the CustomFieldValue entities in the database.
+func (cfvcb *CustomFieldValueCreateBulk) Save(ctx context.Context) ([]*CustomFieldValue, error) {
+	if cfvcb.err != nil {
+		return nil, cfvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cfvcb.builders))
+	nodes := make([]*CustomFieldValue, len(cfvcb.builders))
+	mutators := make([]Mutator, len(cfvcb.builders))
+	for i := range cfvcb.builders {
+		func(i int, root context.Context) {
+			builder := cfvcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomFieldValueMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cfvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cfvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cfvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfvcb *CustomFieldValueCreateBulk) SaveX(ctx context.Context) []*CustomFieldValue {
+	v, err := cfvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfvcb *CustomFieldValueCreateBulk) Exec(ctx context.Context) error {
+	_, err := cfvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvcb *CustomFieldValueCreateBulk) ExecX(ctx context.Context) {
+	if err := cfvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// CustomFieldValueDelete is the builder for deleting a CustomFieldValue entity.
+type CustomFieldValueDelete struct {
+	config
+	hooks    []Hook
+	mutation *CustomFieldValueMutation
+}
+
+// Where appends a list predicates to the CustomFieldValueDelete builder.
+func (cfvd *CustomFieldValueDelete) Where(ps ...predicate.CustomFieldValue) *CustomFieldValueDelete {
+	cfvd.mutation.Where(ps...)
+	return cfvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cfvd *CustomFieldValueDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cfvd.sqlExec, cfvd.mutation, cfvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvd *CustomFieldValueDelete) ExecX(ctx context.Context) int {
+	n, err := cfvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cfvd *CustomFieldValueDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(customfieldvalue.Table, sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt))
+	if ps := cfvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cfvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cfvd.mutation.done = true
+	return affected, err
+}
+
+// CustomFieldValueDeleteOne is the builder for deleting a single CustomFieldValue entity.
+type CustomFieldValueDeleteOne struct {
+	cfvd *CustomFieldValueDelete
+}
+
+// Where appends a list predicates to the CustomFieldValueDelete builder.
+func (cfvdo *CustomFieldValueDeleteOne) Where(ps ...predicate.CustomFieldValue) *CustomFieldValueDeleteOne {
+	cfvdo.cfvd.mutation.Where(ps...)
+	return cfvdo
+}
+
+// Exec executes the deletion query.
+func (cfvdo *CustomFieldValueDeleteOne) Exec(ctx context.Context) error {
+	n, err := cfvdo.cfvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{customfieldvalue.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvdo *CustomFieldValueDeleteOne) ExecX(ctx context.Context) {
+	if err := cfvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
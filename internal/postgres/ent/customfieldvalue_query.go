@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// CustomFieldValueQuery is the builder for querying CustomFieldValue entities.
+type CustomFieldValueQuery struct {
+	config
+	ctx             *QueryContext
+	order           []customfieldvalue.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.CustomFieldValue
+	withTask        *TaskQuery
+	withCustomField *CustomFieldQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CustomFieldValueQuery builder.
+func (cfvq *CustomFieldValueQuery) Where(ps ...predicate.CustomFieldValue) *CustomFieldValueQuery {
+	cfvq.predicates = append(cfvq.predicates, ps...)
+	return cfvq
+}
+
+// Limit the number of records to be returned by this query.
+func (cfvq *CustomFieldValueQuery) Limit(limit int) *CustomFieldValueQuery {
+	cfvq.ctx.Limit = &limit
+	return cfvq
+}
+
+// Offset to start from.
+func (cfvq *CustomFieldValueQuery) Offset(offset int) *CustomFieldValueQuery {
+	cfvq.ctx.Offset = &offset
+	return cfvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cfvq *CustomFieldValueQuery) Unique(unique bool) *CustomFieldValueQuery {
+	cfvq.ctx.Unique = &unique
+	return cfvq
+}
+
+// Order specifies how the records should be ordered.
+func (cfvq *CustomFieldValueQuery) Order(o ...customfieldvalue.OrderOption) *CustomFieldValueQuery {
+	cfvq.order = append(cfvq.order, o...)
+	return cfvq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (cfvq *CustomFieldValueQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: cfvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cfvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cfvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customfieldvalue.Table, customfieldvalue.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, customfieldvalue.TaskTable, customfieldvalue.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cfvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCustomField chains the current query on the "custom_field" edge.
+func (cfvq *CustomFieldValueQuery) QueryCustomField() *CustomFieldQuery {
+	query := (&CustomFieldClient{config: cfvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cfvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cfvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customfieldvalue.Table, customfieldvalue.FieldID, selector),
+			sqlgraph.To(customfield.Table, customfield.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, customfieldvalue.CustomFieldTable, customfieldvalue.CustomFieldColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cfvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CustomFieldValue entity from the query.
+// Returns a *NotFoundError when no CustomFieldValue was found.
+func (cfvq *CustomFieldValueQuery) First(ctx context.Context) (*CustomFieldValue, error) {
+	nodes, err := cfvq.Limit(1).All(setContextOp(ctx, cfvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{customfieldvalue.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) FirstX(ctx context.Context) *CustomFieldValue {
+	node, err := cfvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CustomFieldValue ID from the query.
+// Returns a *NotFoundError when no CustomFieldValue ID was found.
+func (cfvq *CustomFieldValueQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cfvq.Limit(1).IDs(setContextOp(ctx, cfvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{customfieldvalue.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) FirstIDX(ctx context.Context) int {
+	id, err := cfvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CustomFieldValue entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CustomFieldValue entity is found.
+// Returns a *NotFoundError when no CustomFieldValue entities are found.
+func (cfvq *CustomFieldValueQuery) Only(ctx context.Context) (*CustomFieldValue, error) {
+	nodes, err := cfvq.Limit(2).All(setContextOp(ctx, cfvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{customfieldvalue.Label}
+	default:
+		return nil, &NotSingularError{customfieldvalue.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) OnlyX(ctx context.Context) *CustomFieldValue {
+	node, err := cfvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CustomFieldValue ID in the query.
+// Returns a *NotSingularError when more than one CustomFieldValue ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cfvq *CustomFieldValueQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cfvq.Limit(2).IDs(setContextOp(ctx, cfvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = &NotSingularError{customfieldvalue.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cfvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CustomFieldValues.
+func (cfvq *CustomFieldValueQuery) All(ctx context.Context) ([]*CustomFieldValue, error) {
+	ctx = setContextOp(ctx, cfvq.ctx, ent.OpQueryAll)
+	if err := cfvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CustomFieldValue, *CustomFieldValueQuery]()
+	return withInterceptors[[]*CustomFieldValue](ctx, cfvq, qr, cfvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) AllX(ctx context.Context) []*CustomFieldValue {
+	nodes, err := cfvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CustomFieldValue IDs.
+func (cfvq *CustomFieldValueQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cfvq.ctx.Unique == nil && cfvq.path != nil {
+		cfvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cfvq.ctx, ent.OpQueryIDs)
+	if err = cfvq.Select(customfieldvalue.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) IDsX(ctx context.Context) []int {
+	ids, err := cfvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cfvq *CustomFieldValueQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cfvq.ctx, ent.OpQueryCount)
+	if err := cfvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cfvq, querierCount[*CustomFieldValueQuery](), cfvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) CountX(ctx context.Context) int {
+	count, err := cfvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cfvq *CustomFieldValueQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cfvq.ctx, ent.OpQueryExist)
+	switch _, err := cfvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) ExistX(ctx context.Context) bool {
+	exist, err := cfvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CustomFieldValueQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cfvq *CustomFieldValueQuery) Clone() *CustomFieldValueQuery {
+	if cfvq == nil {
+		return nil
+	}
+	return &CustomFieldValueQuery{
+		config:          cfvq.config,
+		ctx:             cfvq.ctx.Clone(),
+		order:           append([]customfieldvalue.OrderOption{}, cfvq.order...),
+		inters:          append([]Interceptor{}, cfvq.inters...),
+		predicates:      append([]predicate.CustomFieldValue{}, cfvq.predicates...),
+		withTask:        cfvq.withTask.Clone(),
+		withCustomField: cfvq.withCustomField.Clone(),
+		// clone intermediate query.
+		sql:  cfvq.sql.Clone(),
+		path: cfvq.path,
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (cfvq *CustomFieldValueQuery) WithTask(opts ...func(*TaskQuery)) *CustomFieldValueQuery {
+	query := (&TaskClient{config: cfvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cfvq.withTask = query
+	return cfvq
+}
+
+// WithCustomField tells the query-builder to eager-load the nodes that are connected to
+// the "custom_field" edge. The optional arguments are used to configure the query builder of the edge.
+func (cfvq *CustomFieldValueQuery) WithCustomField(opts ...func(*CustomFieldQuery)) *CustomFieldValueQuery {
+	query := (&CustomFieldClient{config: cfvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cfvq.withCustomField = query
+	return cfvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CustomFieldValue.Query().
+//		GroupBy(customfieldvalue.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cfvq *CustomFieldValueQuery) GroupBy(field string, fields ...string) *CustomFieldValueGroupBy {
+	cfvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CustomFieldValueGroupBy{build: cfvq}
+	grbuild.flds = &cfvq.ctx.Fields
+	grbuild.label = customfieldvalue.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//	}
+//
+//	client.CustomFieldValue.Query().
+//		Select(customfieldvalue.FieldTaskID).
+//		Scan(ctx, &v)
+func (cfvq *CustomFieldValueQuery) Select(fields ...string) *CustomFieldValueSelect {
+	cfvq.ctx.Fields = append(cfvq.ctx.Fields, fields...)
+	sbuild := &CustomFieldValueSelect{CustomFieldValueQuery: cfvq}
+	sbuild.label = customfieldvalue.Label
+	sbuild.flds, sbuild.scan = &cfvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CustomFieldValueSelect configured with the given aggregations.
+func (cfvq *CustomFieldValueQuery) Aggregate(fns ...AggregateFunc) *CustomFieldValueSelect {
+	return cfvq.Select().Aggregate(fns...)
+}
+
+func (cfvq *CustomFieldValueQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cfvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cfvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cfvq.ctx.Fields {
+		if !customfieldvalue.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cfvq.path != nil {
+		prev, err := cfvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cfvq.sql = prev
+	}
+	return nil
+}
+
+func (cfvq *CustomFieldValueQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CustomFieldValue, error) {
+	var (
+		nodes       = []*CustomFieldValue{}
+		_spec       = cfvq.querySpec()
+		loadedTypes = [2]bool{
+			cfvq.withTask != nil,
+			cfvq.withCustomField != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CustomFieldValue).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CustomFieldValue{config: cfvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cfvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cfvq.withTask; query != nil {
+		if err := cfvq.loadTask(ctx, query, nodes, nil,
+			func(n *CustomFieldValue, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cfvq.withCustomField; query != nil {
+		if err := cfvq.loadCustomField(ctx, query, nodes, nil,
+			func(n *CustomFieldValue, e *CustomField) { n.Edges.CustomField = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cfvq *CustomFieldValueQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*CustomFieldValue, init func(*CustomFieldValue), assign func(*CustomFieldValue, *Task)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CustomFieldValue)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cfvq *CustomFieldValueQuery) loadCustomField(ctx context.Context, query *CustomFieldQuery, nodes []*CustomFieldValue, init func(*CustomFieldValue), assign func(*CustomFieldValue, *CustomField)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CustomFieldValue)
+	for i := range nodes {
+		fk := nodes[i].FieldID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(customfield.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "field_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cfvq *CustomFieldValueQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cfvq.querySpec()
+	_spec.Node.Columns = cfvq.ctx.Fields
+	if len(cfvq.ctx.Fields) > 0 {
+		_spec.Unique = cfvq.ctx.Unique != nil && *cfvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cfvq.driver, _spec)
+}
+
+func (cfvq *CustomFieldValueQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(customfieldvalue.Table, customfieldvalue.Columns, sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt))
+	_spec.From = cfvq.sql
+	if unique := cfvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cfvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cfvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customfieldvalue.FieldID)
+		for i := range fields {
+			if fields[i] != customfieldvalue.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cfvq.withTask != nil {
+			_spec.Node.AddColumnOnce(customfieldvalue.FieldTaskID)
+		}
+		if cfvq.withCustomField != nil {
+			_spec.Node.AddColumnOnce(customfieldvalue.FieldFieldID)
+		}
+	}
+	if ps := cfvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cfvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cfvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cfvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cfvq *CustomFieldValueQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cfvq.driver.Dialect())
+	t1 := builder.Table(customfieldvalue.Table)
+	columns := cfvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = customfieldvalue.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cfvq.sql != nil {
+		selector = cfvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cfvq.ctx.Unique != nil && *cfvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cfvq.predicates {
+		p(selector)
+	}
+	for _, p := range cfvq.order {
+		p(selector)
+	}
+	if offset := cfvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cfvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CustomFieldValueGroupBy is the group-by builder for CustomFieldValue entities.
+type CustomFieldValueGroupBy struct {
+	selector
+	build *CustomFieldValueQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cfvgb *CustomFieldValueGroupBy) Aggregate(fns ...AggregateFunc) *CustomFieldValueGroupBy {
+	cfvgb.fns = append(cfvgb.fns, fns...)
+	return cfvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfvgb *CustomFieldValueGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cfvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CustomFieldValueQuery, *CustomFieldValueGroupBy](ctx, cfvgb.build, cfvgb, cfvgb.build.inters, v)
+}
+
+func (cfvgb *CustomFieldValueGroupBy) sqlScan(ctx context.Context, root *CustomFieldValueQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cfvgb.fns))
+	for _, fn := range cfvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cfvgb.flds)+len(cfvgb.fns))
+		for _, f := range *cfvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cfvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CustomFieldValueSelect is the builder for selecting fields of CustomFieldValue entities.
+type CustomFieldValueSelect struct {
+	*CustomFieldValueQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cfvs *CustomFieldValueSelect) Aggregate(fns ...AggregateFunc) *CustomFieldValueSelect {
+	cfvs.fns = append(cfvs.fns, fns...)
+	return cfvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfvs *CustomFieldValueSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cfvs.ctx, ent.OpQuerySelect)
+	if err := cfvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CustomFieldValueQuery, *CustomFieldValueSelect](ctx, cfvs.CustomFieldValueQuery, cfvs, cfvs.inters, v)
+}
+
+func (cfvs *CustomFieldValueSelect) sqlScan(ctx context.Context, root *CustomFieldValueQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cfvs.fns))
+	for _, fn := range cfvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cfvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// CustomFieldValueUpdate is the builder for updating CustomFieldValue entities.
+type CustomFieldValueUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomFieldValueMutation
+}
+
+// Where appends a list predicates to the CustomFieldValueUpdate builder.
+func (cfvu *CustomFieldValueUpdate) Where(ps ...predicate.CustomFieldValue) *CustomFieldValueUpdate {
+	cfvu.mutation.Where(ps...)
+	return cfvu
+}
+
+// SetTaskID sets the "task_id" field.
+func (cfvu *CustomFieldValueUpdate) SetTaskID(s string) *CustomFieldValueUpdate {
+	cfvu.mutation.SetTaskID(s)
+	return cfvu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (cfvu *CustomFieldValueUpdate) SetNillableTaskID(s *string) *CustomFieldValueUpdate {
+	if s != nil {
+		cfvu.SetTaskID(*s)
+	}
+	return cfvu
+}
+
+// SetFieldID sets the "field_id" field.
+func (cfvu *CustomFieldValueUpdate) SetFieldID(s string) *CustomFieldValueUpdate {
+	cfvu.mutation.SetFieldID(s)
+	return cfvu
+}
+
+// SetNillableFieldID sets the "field_id" field if the given value is not nil.
+func (cfvu *CustomFieldValueUpdate) SetNillableFieldID(s *string) *CustomFieldValueUpdate {
+	if s != nil {
+		cfvu.SetFieldID(*s)
+	}
+	return cfvu
+}
+
+// SetValue sets the "value" field.
+func (cfvu *CustomFieldValueUpdate) SetValue(s string) *CustomFieldValueUpdate {
+	cfvu.mutation.SetValue(s)
+	return cfvu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (cfvu *CustomFieldValueUpdate) SetNillableValue(s *string) *CustomFieldValueUpdate {
+	if s != nil {
+		cfvu.SetValue(*s)
+	}
+	return cfvu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (cfvu *CustomFieldValueUpdate) SetTask(t *Task) *CustomFieldValueUpdate {
+	return cfvu.SetTaskID(t.ID)
+}
+
+// SetCustomFieldID sets the "custom_field" edge to the CustomField entity by ID.
+func (cfvu *CustomFieldValueUpdate) SetCustomFieldID(id string) *CustomFieldValueUpdate {
+	cfvu.mutation.SetCustomFieldID(id)
+	return cfvu
+}
+
+// SetCustomField sets the "custom_field" edge to the CustomField entity.
+func (cfvu *CustomFieldValueUpdate) SetCustomField(c *CustomField) *CustomFieldValueUpdate {
+	return cfvu.SetCustomFieldID(c.ID)
+}
+
+// Mutation returns the CustomFieldValueMutation object of the builder.
+func (cfvu *CustomFieldValueUpdate) Mutation() *CustomFieldValueMutation {
+	return cfvu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (cfvu *CustomFieldValueUpdate) ClearTask() *CustomFieldValueUpdate {
+	cfvu.mutation.ClearTask()
+	return cfvu
+}
+
+// ClearCustomField clears the "custom_field" edge to the CustomField entity.
+func (cfvu *CustomFieldValueUpdate) ClearCustomField() *CustomFieldValueUpdate {
+	cfvu.mutation.ClearCustomField()
+	return cfvu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cfvu *CustomFieldValueUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cfvu.sqlSave, cfvu.mutation, cfvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfvu *CustomFieldValueUpdate) SaveX(ctx context.Context) int {
+	affected, err := cfvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cfvu *CustomFieldValueUpdate) Exec(ctx context.Context) error {
+	_, err := cfvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvu *CustomFieldValueUpdate) ExecX(ctx context.Context) {
+	if err := cfvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfvu *CustomFieldValueUpdate) check() error {
+	if cfvu.mutation.TaskCleared() && len(cfvu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomFieldValue.task"`)
+	}
+	if cfvu.mutation.CustomFieldCleared() && len(cfvu.mutation.CustomFieldIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomFieldValue.custom_field"`)
+	}
+	return nil
+}
+
+func (cfvu *CustomFieldValueUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cfvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customfieldvalue.Table, customfieldvalue.Columns, sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt))
+	if ps := cfvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfvu.mutation.Value(); ok {
+		_spec.SetField(customfieldvalue.FieldValue, field.TypeString, value)
+	}
+	if cfvu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.TaskTable,
+			Columns: []string{customfieldvalue.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfvu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.TaskTable,
+			Columns: []string{customfieldvalue.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cfvu.mutation.CustomFieldCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.CustomFieldTable,
+			Columns: []string{customfieldvalue.CustomFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfvu.mutation.CustomFieldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.CustomFieldTable,
+			Columns: []string{customfieldvalue.CustomFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cfvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customfieldvalue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cfvu.mutation.done = true
+	return n, nil
+}
+
+// CustomFieldValueUpdateOne is the builder for updating a single CustomFieldValue entity.
+type CustomFieldValueUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomFieldValueMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (cfvuo *CustomFieldValueUpdateOne) SetTaskID(s string) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.SetTaskID(s)
+	return cfvuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (cfvuo *CustomFieldValueUpdateOne) SetNillableTaskID(s *string) *CustomFieldValueUpdateOne {
+	if s != nil {
+		cfvuo.SetTaskID(*s)
+	}
+	return cfvuo
+}
+
+// SetFieldID sets the "field_id" field.
+func (cfvuo *CustomFieldValueUpdateOne) SetFieldID(s string) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.SetFieldID(s)
+	return cfvuo
+}
+
+// SetNillableFieldID sets the "field_id" field if the given value is not nil.
+func (cfvuo *CustomFieldValueUpdateOne) SetNillableFieldID(s *string) *CustomFieldValueUpdateOne {
+	if s != nil {
+		cfvuo.SetFieldID(*s)
+	}
+	return cfvuo
+}
+
+// SetValue sets the "value" field.
+func (cfvuo *CustomFieldValueUpdateOne) SetValue(s string) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.SetValue(s)
+	return cfvuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (cfvuo *CustomFieldValueUpdateOne) SetNillableValue(s *string) *CustomFieldValueUpdateOne {
+	if s != nil {
+		cfvuo.SetValue(*s)
+	}
+	return cfvuo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (cfvuo *CustomFieldValueUpdateOne) SetTask(t *Task) *CustomFieldValueUpdateOne {
+	return cfvuo.SetTaskID(t.ID)
+}
+
+// SetCustomFieldID sets the "custom_field" edge to the CustomField entity by ID.
+func (cfvuo *CustomFieldValueUpdateOne) SetCustomFieldID(id string) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.SetCustomFieldID(id)
+	return cfvuo
+}
+
+// SetCustomField sets the "custom_field" edge to the CustomField entity.
+func (cfvuo *CustomFieldValueUpdateOne) SetCustomField(c *CustomField) *CustomFieldValueUpdateOne {
+	return cfvuo.SetCustomFieldID(c.ID)
+}
+
+// Mutation returns the CustomFieldValueMutation object of the builder.
+func (cfvuo *CustomFieldValueUpdateOne) Mutation() *CustomFieldValueMutation {
+	return cfvuo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (cfvuo *CustomFieldValueUpdateOne) ClearTask() *CustomFieldValueUpdateOne {
+	cfvuo.mutation.ClearTask()
+	return cfvuo
+}
+
+// ClearCustomField clears the "custom_field" edge to the CustomField entity.
+func (cfvuo *CustomFieldValueUpdateOne) ClearCustomField() *CustomFieldValueUpdateOne {
+	cfvuo.mutation.ClearCustomField()
+	return cfvuo
+}
+
+// Where appends a list predicates to the CustomFieldValueUpdate builder.
+func (cfvuo *CustomFieldValueUpdateOne) Where(ps ...predicate.CustomFieldValue) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.Where(ps...)
+	return cfvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cfvuo *CustomFieldValueUpdateOne) Select(field string, fields ...string) *CustomFieldValueUpdateOne {
+	cfvuo.fields = append([]string{field}, fields...)
+	return cfvuo
+}
+
+// Save executes the query and returns the updated CustomFieldValue entity.
+func (cfvuo *CustomFieldValueUpdateOne) Save(ctx context.Context) (*CustomFieldValue, error) {
+	return withHooks(ctx, cfvuo.sqlSave, cfvuo.mutation, cfvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfvuo *CustomFieldValueUpdateOne) SaveX(ctx context.Context) *CustomFieldValue {
+	node, err := cfvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cfvuo *CustomFieldValueUpdateOne) Exec(ctx context.Context) error {
+	_, err := cfvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvuo *CustomFieldValueUpdateOne) ExecX(ctx context.Context) {
+	if err := cfvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfvuo *CustomFieldValueUpdateOne) check() error {
+	if cfvuo.mutation.TaskCleared() && len(cfvuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomFieldValue.task"`)
+	}
+	if cfvuo.mutation.CustomFieldCleared() && len(cfvuo.mutation.CustomFieldIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CustomFieldValue.custom_field"`)
+	}
+	return nil
+}
+
+func (cfvuo *CustomFieldValueUpdateOne) sqlSave(ctx context.Context) (_node *CustomFieldValue, err error) {
+	if err := cfvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(customfieldvalue.Table, customfieldvalue.Columns, sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt))
+	id, ok := cfvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CustomFieldValue.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cfvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customfieldvalue.FieldID)
+		for _, f := range fields {
+			if !customfieldvalue.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customfieldvalue.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cfvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfvuo.mutation.Value(); ok {
+		_spec.SetField(customfieldvalue.FieldValue, field.TypeString, value)
+	}
+	if cfvuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.TaskTable,
+			Columns: []string{customfieldvalue.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfvuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.TaskTable,
+			Columns: []string{customfieldvalue.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cfvuo.mutation.CustomFieldCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.CustomFieldTable,
+			Columns: []string{customfieldvalue.CustomFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cfvuo.mutation.CustomFieldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   customfieldvalue.CustomFieldTable,
+			Columns: []string{customfieldvalue.CustomFieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfield.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CustomFieldValue{config: cfvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cfvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customfieldvalue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cfvuo.mutation.done = true
+	return _node, nil
+}
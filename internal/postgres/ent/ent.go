@@ -12,8 +12,23 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/comment"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +89,23 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			file.Table: file.ValidColumn,
-			task.Table: task.ValidColumn,
+			activity.Table:         activity.ValidColumn,
+			board.Table:            board.ValidColumn,
+			checklistitem.Table:    checklistitem.ValidColumn,
+			comment.Table:          comment.ValidColumn,
+			customfield.Table:      customfield.ValidColumn,
+			customfieldvalue.Table: customfieldvalue.ValidColumn,
+			epic.Table:             epic.ValidColumn,
+			file.Table:             file.ValidColumn,
+			label.Table:            label.ValidColumn,
+			member.Table:           member.ValidColumn,
+			recurringtask.Table:    recurringtask.ValidColumn,
+			sprint.Table:           sprint.ValidColumn,
+			statuschange.Table:     statuschange.ValidColumn,
+			task.Table:             task.ValidColumn,
+			tasktemplate.Table:     tasktemplate.ValidColumn,
+			timer.Table:            timer.ValidColumn,
+			worklog.Table:          worklog.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
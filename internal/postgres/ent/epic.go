@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
+)
+
+// Epic is the model entity for the Epic schema.
+type Epic struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID string `json:"board_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// TargetDate holds the value of the "target_date" field.
+	TargetDate time.Time `json:"target_date,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EpicQuery when eager-loading is set.
+	Edges        EpicEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EpicEdges holds the relations/edges for other nodes in the graph.
+type EpicEdges struct {
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// Tasks holds the value of the tasks edge.
+	Tasks []*Task `json:"tasks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EpicEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e EpicEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[1] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Epic) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case epic.FieldID, epic.FieldBoardID, epic.FieldName, epic.FieldDescription:
+			values[i] = new(sql.NullString)
+		case epic.FieldTargetDate, epic.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Epic fields.
+func (e *Epic) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case epic.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				e.ID = value.String
+			}
+		case epic.FieldBoardID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				e.BoardID = value.String
+			}
+		case epic.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				e.Name = value.String
+			}
+		case epic.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				e.Description = new(string)
+				*e.Description = value.String
+			}
+		case epic.FieldTargetDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field target_date", values[i])
+			} else if value.Valid {
+				e.TargetDate = value.Time
+			}
+		case epic.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				e.CreatedAt = value.Time
+			}
+		default:
+			e.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Epic.
+// This includes values selected through modifiers, order, etc.
+func (e *Epic) Value(name string) (ent.Value, error) {
+	return e.selectValues.Get(name)
+}
+
+// QueryBoard queries the "board" edge of the Epic entity.
+func (e *Epic) QueryBoard() *BoardQuery {
+	return NewEpicClient(e.config).QueryBoard(e)
+}
+
+// QueryTasks queries the "tasks" edge of the Epic entity.
+func (e *Epic) QueryTasks() *TaskQuery {
+	return NewEpicClient(e.config).QueryTasks(e)
+}
+
+// Update returns a builder for updating this Epic.
+// Note that you need to call Epic.Unwrap() before calling this method if this Epic
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (e *Epic) Update() *EpicUpdateOne {
+	return NewEpicClient(e.config).UpdateOne(e)
+}
+
+// Unwrap unwraps the Epic entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (e *Epic) Unwrap() *Epic {
+	_tx, ok := e.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Epic is not a transactional entity")
+	}
+	e.config.driver = _tx.drv
+	return e
+}
+
+// String implements the fmt.Stringer.
+func (e *Epic) String() string {
+	var builder strings.Builder
+	builder.WriteString("Epic(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", e.ID))
+	builder.WriteString("board_id=")
+	builder.WriteString(e.BoardID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(e.Name)
+	builder.WriteString(", ")
+	if v := e.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("target_date=")
+	builder.WriteString(e.TargetDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(e.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Epics is a parsable slice of Epic.
+type Epics []*Epic
@@ -0,0 +1,437 @@
+// Code generated by ent, DO NOT EDIT.
+
+package epic
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Epic {
+	return predicate.Epic(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Epic {
+	return predicate.Epic(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Epic {
+	return predicate.Epic(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Epic {
+	return predicate.Epic(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Epic {
+	return predicate.Epic(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Epic {
+	return predicate.Epic(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Epic {
+	return predicate.Epic(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Epic {
+	return predicate.Epic(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Epic {
+	return predicate.Epic(sql.FieldContainsFold(FieldID, id))
+}
+
+// BoardID applies equality check predicate on the "board_id" field. It's identical to BoardIDEQ.
+func BoardID(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldBoardID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldDescription, v))
+}
+
+// TargetDate applies equality check predicate on the "target_date" field. It's identical to TargetDateEQ.
+func TargetDate(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldTargetDate, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BoardIDEQ applies the EQ predicate on the "board_id" field.
+func BoardIDEQ(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldBoardID, v))
+}
+
+// BoardIDNEQ applies the NEQ predicate on the "board_id" field.
+func BoardIDNEQ(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldNEQ(FieldBoardID, v))
+}
+
+// BoardIDIn applies the In predicate on the "board_id" field.
+func BoardIDIn(vs ...string) predicate.Epic {
+	return predicate.Epic(sql.FieldIn(FieldBoardID, vs...))
+}
+
+// BoardIDNotIn applies the NotIn predicate on the "board_id" field.
+func BoardIDNotIn(vs ...string) predicate.Epic {
+	return predicate.Epic(sql.FieldNotIn(FieldBoardID, vs...))
+}
+
+// BoardIDGT applies the GT predicate on the "board_id" field.
+func BoardIDGT(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldGT(FieldBoardID, v))
+}
+
+// BoardIDGTE applies the GTE predicate on the "board_id" field.
+func BoardIDGTE(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldGTE(FieldBoardID, v))
+}
+
+// BoardIDLT applies the LT predicate on the "board_id" field.
+func BoardIDLT(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldLT(FieldBoardID, v))
+}
+
+// BoardIDLTE applies the LTE predicate on the "board_id" field.
+func BoardIDLTE(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldLTE(FieldBoardID, v))
+}
+
+// BoardIDContains applies the Contains predicate on the "board_id" field.
+func BoardIDContains(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldContains(FieldBoardID, v))
+}
+
+// BoardIDHasPrefix applies the HasPrefix predicate on the "board_id" field.
+func BoardIDHasPrefix(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldHasPrefix(FieldBoardID, v))
+}
+
+// BoardIDHasSuffix applies the HasSuffix predicate on the "board_id" field.
+func BoardIDHasSuffix(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldHasSuffix(FieldBoardID, v))
+}
+
+// BoardIDEqualFold applies the EqualFold predicate on the "board_id" field.
+func BoardIDEqualFold(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldEqualFold(FieldBoardID, v))
+}
+
+// BoardIDContainsFold applies the ContainsFold predicate on the "board_id" field.
+func BoardIDContainsFold(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldContainsFold(FieldBoardID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Epic {
+	return predicate.Epic(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Epic {
+	return predicate.Epic(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Epic {
+	return predicate.Epic(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Epic {
+	return predicate.Epic(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Epic {
+	return predicate.Epic(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Epic {
+	return predicate.Epic(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Epic {
+	return predicate.Epic(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TargetDateEQ applies the EQ predicate on the "target_date" field.
+func TargetDateEQ(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldTargetDate, v))
+}
+
+// TargetDateNEQ applies the NEQ predicate on the "target_date" field.
+func TargetDateNEQ(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldNEQ(FieldTargetDate, v))
+}
+
+// TargetDateIn applies the In predicate on the "target_date" field.
+func TargetDateIn(vs ...time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldIn(FieldTargetDate, vs...))
+}
+
+// TargetDateNotIn applies the NotIn predicate on the "target_date" field.
+func TargetDateNotIn(vs ...time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldNotIn(FieldTargetDate, vs...))
+}
+
+// TargetDateGT applies the GT predicate on the "target_date" field.
+func TargetDateGT(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldGT(FieldTargetDate, v))
+}
+
+// TargetDateGTE applies the GTE predicate on the "target_date" field.
+func TargetDateGTE(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldGTE(FieldTargetDate, v))
+}
+
+// TargetDateLT applies the LT predicate on the "target_date" field.
+func TargetDateLT(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldLT(FieldTargetDate, v))
+}
+
+// TargetDateLTE applies the LTE predicate on the "target_date" field.
+func TargetDateLTE(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldLTE(FieldTargetDate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Epic {
+	return predicate.Epic(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBoard applies the HasEdge predicate on the "board" edge.
+func HasBoard() predicate.Epic {
+	return predicate.Epic(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoardWith applies the HasEdge predicate on the "board" edge with a given conditions (other predicates).
+func HasBoardWith(preds ...predicate.Board) predicate.Epic {
+	return predicate.Epic(func(s *sql.Selector) {
+		step := newBoardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTasks applies the HasEdge predicate on the "tasks" edge.
+func HasTasks() predicate.Epic {
+	return predicate.Epic(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TasksTable, TasksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTasksWith applies the HasEdge predicate on the "tasks" edge with a given conditions (other predicates).
+func HasTasksWith(preds ...predicate.Task) predicate.Epic {
+	return predicate.Epic(func(s *sql.Selector) {
+		step := newTasksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Epic) predicate.Epic {
+	return predicate.Epic(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Epic) predicate.Epic {
+	return predicate.Epic(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Epic) predicate.Epic {
+	return predicate.Epic(sql.NotPredicates(p))
+}
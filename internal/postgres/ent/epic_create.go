@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// EpicCreate is the builder for creating a Epic entity.
+type EpicCreate struct {
+	config
+	mutation *EpicMutation
+	hooks    []Hook
+}
+
+// SetBoardID sets the "board_id" field.
+func (ec *EpicCreate) SetBoardID(s string) *EpicCreate {
+	ec.mutation.SetBoardID(s)
+	return ec
+}
+
+// SetName sets the "name" field.
+func (ec *EpicCreate) SetName(s string) *EpicCreate {
+	ec.mutation.SetName(s)
+	return ec
+}
+
+// SetDescription sets the "description" field.
+func (ec *EpicCreate) SetDescription(s string) *EpicCreate {
+	ec.mutation.SetDescription(s)
+	return ec
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ec *EpicCreate) SetNillableDescription(s *string) *EpicCreate {
+	if s != nil {
+		ec.SetDescription(*s)
+	}
+	return ec
+}
+
+// SetTargetDate sets the "target_date" field.
+func (ec *EpicCreate) SetTargetDate(t time.Time) *EpicCreate {
+	ec.mutation.SetTargetDate(t)
+	return ec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ec *EpicCreate) SetCreatedAt(t time.Time) *EpicCreate {
+	ec.mutation.SetCreatedAt(t)
+	return ec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ec *EpicCreate) SetNillableCreatedAt(t *time.Time) *EpicCreate {
+	if t != nil {
+		ec.SetCreatedAt(*t)
+	}
+	return ec
+}
+
+// SetID sets the "id" field.
+func (ec *EpicCreate) SetID(s string) *EpicCreate {
+	ec.mutation.SetID(s)
+	return ec
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (ec *EpicCreate) SetBoard(b *Board) *EpicCreate {
+	return ec.SetBoardID(b.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (ec *EpicCreate) AddTaskIDs(ids ...string) *EpicCreate {
+	ec.mutation.AddTaskIDs(ids...)
+	return ec
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (ec *EpicCreate) AddTasks(t ...*Task) *EpicCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ec.AddTaskIDs(ids...)
+}
+
+// Mutation returns the EpicMutation object of the builder.
+func (ec *EpicCreate) Mutation() *EpicMutation {
+	return ec.mutation
+}
+
+// Save creates the Epic in the database.
+func (ec *EpicCreate) Save(ctx context.Context) (*Epic, error) {
+	ec.defaults()
+	return withHooks(ctx, ec.sqlSave, ec.mutation, ec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *EpicCreate) SaveX(ctx context.Context) *Epic {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ec *EpicCreate) Exec(ctx context.Context) error {
+	_, err := ec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ec *EpicCreate) ExecX(ctx context.Context) {
+	if err := ec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ec *EpicCreate) defaults() {
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		v := epic.DefaultCreatedAt()
+		ec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ec *EpicCreate) check() error {
+	if _, ok := ec.mutation.BoardID(); !ok {
+		return &ValidationError{Name: "board_id", err: errors.New(`ent: missing required field "Epic.board_id"`)}
+	}
+	if _, ok := ec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Epic.name"`)}
+	}
+	if _, ok := ec.mutation.TargetDate(); !ok {
+		return &ValidationError{Name: "target_date", err: errors.New(`ent: missing required field "Epic.target_date"`)}
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Epic.created_at"`)}
+	}
+	if len(ec.mutation.BoardIDs()) == 0 {
+		return &ValidationError{Name: "board", err: errors.New(`ent: missing required edge "Epic.board"`)}
+	}
+	return nil
+}
+
+func (ec *EpicCreate) sqlSave(ctx context.Context) (*Epic, error) {
+	if err := ec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Epic.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ec.mutation.id = &_node.ID
+	ec.mutation.done = true
+	return _node, nil
+}
+
+func (ec *EpicCreate) createSpec() (*Epic, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Epic{config: ec.config}
+		_spec = sqlgraph.NewCreateSpec(epic.Table, sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString))
+	)
+	if id, ok := ec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ec.mutation.Name(); ok {
+		_spec.SetField(epic.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ec.mutation.Description(); ok {
+		_spec.SetField(epic.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := ec.mutation.TargetDate(); ok {
+		_spec.SetField(epic.FieldTargetDate, field.TypeTime, value)
+		_node.TargetDate = value
+	}
+	if value, ok := ec.mutation.CreatedAt(); ok {
+		_spec.SetField(epic.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ec.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   epic.BoardTable,
+			Columns: []string{epic.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   epic.TasksTable,
+			Columns: []string{epic.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EpicCreateBulk is the builder for creating many Epic entities in bulk.
+type EpicCreateBulk struct {
+	config
+	err      error
+	builders []*EpicCreate
+}
+
+// Save creates the Epic entities in the database.
+func (ecb *EpicCreateBulk) Save(ctx context.Context) ([]*Epic, error) {
+	if ecb.err != nil {
+		return nil, ecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ecb.builders))
+	nodes := make([]*Epic, len(ecb.builders))
+	mutators := make([]Mutator, len(ecb.builders))
+	for i := range ecb.builders {
+		func(i int, root context.Context) {
+			builder := ecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EpicMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecb *EpicCreateBulk) SaveX(ctx context.Context) []*Epic {
+	v, err := ecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecb *EpicCreateBulk) Exec(ctx context.Context) error {
+	_, err := ecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecb *EpicCreateBulk) ExecX(ctx context.Context) {
+	if err := ecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// EpicQuery is the builder for querying Epic entities.
+type EpicQuery struct {
+	config
+	ctx        *QueryContext
+	order      []epic.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Epic
+	withBoard  *BoardQuery
+	withTasks  *TaskQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EpicQuery builder.
+func (eq *EpicQuery) Where(ps ...predicate.Epic) *EpicQuery {
+	eq.predicates = append(eq.predicates, ps...)
+	return eq
+}
+
+// Limit the number of records to be returned by this query.
+func (eq *EpicQuery) Limit(limit int) *EpicQuery {
+	eq.ctx.Limit = &limit
+	return eq
+}
+
+// Offset to start from.
+func (eq *EpicQuery) Offset(offset int) *EpicQuery {
+	eq.ctx.Offset = &offset
+	return eq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eq *EpicQuery) Unique(unique bool) *EpicQuery {
+	eq.ctx.Unique = &unique
+	return eq
+}
+
+// Order specifies how the records should be ordered.
+func (eq *EpicQuery) Order(o ...epic.OrderOption) *EpicQuery {
+	eq.order = append(eq.order, o...)
+	return eq
+}
+
+// QueryBoard chains the current query on the "board" edge.
+func (eq *EpicQuery) QueryBoard() *BoardQuery {
+	query := (&BoardClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(epic.Table, epic.FieldID, selector),
+			sqlgraph.To(board.Table, board.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, epic.BoardTable, epic.BoardColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTasks chains the current query on the "tasks" edge.
+func (eq *EpicQuery) QueryTasks() *TaskQuery {
+	query := (&TaskClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(epic.Table, epic.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, epic.TasksTable, epic.TasksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Epic entity from the query.
+// Returns a *NotFoundError when no Epic was found.
+func (eq *EpicQuery) First(ctx context.Context) (*Epic, error) {
+	nodes, err := eq.Limit(1).All(setContextOp(ctx, eq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{epic.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eq *EpicQuery) FirstX(ctx context.Context) *Epic {
+	node, err := eq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Epic ID from the query.
+// Returns a *NotFoundError when no Epic ID was found.
+func (eq *EpicQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eq.Limit(1).IDs(setContextOp(ctx, eq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{epic.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eq *EpicQuery) FirstIDX(ctx context.Context) string {
+	id, err := eq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Epic entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Epic entity is found.
+// Returns a *NotFoundError when no Epic entities are found.
+func (eq *EpicQuery) Only(ctx context.Context) (*Epic, error) {
+	nodes, err := eq.Limit(2).All(setContextOp(ctx, eq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{epic.Label}
+	default:
+		return nil, &NotSingularError{epic.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eq *EpicQuery) OnlyX(ctx context.Context) *Epic {
+	node, err := eq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Epic ID in the query.
+// Returns a *NotSingularError when more than one Epic ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eq *EpicQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eq.Limit(2).IDs(setContextOp(ctx, eq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{epic.Label}
+	default:
+		err = &NotSingularError{epic.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eq *EpicQuery) OnlyIDX(ctx context.Context) string {
+	id, err := eq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Epics.
+func (eq *EpicQuery) All(ctx context.Context) ([]*Epic, error) {
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryAll)
+	if err := eq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Epic, *EpicQuery]()
+	return withInterceptors[[]*Epic](ctx, eq, qr, eq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eq *EpicQuery) AllX(ctx context.Context) []*Epic {
+	nodes, err := eq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Epic IDs.
+func (eq *EpicQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if eq.ctx.Unique == nil && eq.path != nil {
+		eq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryIDs)
+	if err = eq.Select(epic.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eq *EpicQuery) IDsX(ctx context.Context) []string {
+	ids, err := eq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eq *EpicQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryCount)
+	if err := eq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eq, querierCount[*EpicQuery](), eq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eq *EpicQuery) CountX(ctx context.Context) int {
+	count, err := eq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eq *EpicQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eq.ctx, ent.OpQueryExist)
+	switch _, err := eq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eq *EpicQuery) ExistX(ctx context.Context) bool {
+	exist, err := eq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EpicQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eq *EpicQuery) Clone() *EpicQuery {
+	if eq == nil {
+		return nil
+	}
+	return &EpicQuery{
+		config:     eq.config,
+		ctx:        eq.ctx.Clone(),
+		order:      append([]epic.OrderOption{}, eq.order...),
+		inters:     append([]Interceptor{}, eq.inters...),
+		predicates: append([]predicate.Epic{}, eq.predicates...),
+		withBoard:  eq.withBoard.Clone(),
+		withTasks:  eq.withTasks.Clone(),
+		// clone intermediate query.
+		sql:  eq.sql.Clone(),
+		path: eq.path,
+	}
+}
+
+// WithBoard tells the query-builder to eager-load the nodes that are connected to
+// the "board" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpicQuery) WithBoard(opts ...func(*BoardQuery)) *EpicQuery {
+	query := (&BoardClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withBoard = query
+	return eq
+}
+
+// WithTasks tells the query-builder to eager-load the nodes that are connected to
+// the "tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EpicQuery) WithTasks(opts ...func(*TaskQuery)) *EpicQuery {
+	query := (&TaskClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withTasks = query
+	return eq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Epic.Query().
+//		GroupBy(epic.FieldBoardID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eq *EpicQuery) GroupBy(field string, fields ...string) *EpicGroupBy {
+	eq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EpicGroupBy{build: eq}
+	grbuild.flds = &eq.ctx.Fields
+	grbuild.label = epic.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//	}
+//
+//	client.Epic.Query().
+//		Select(epic.FieldBoardID).
+//		Scan(ctx, &v)
+func (eq *EpicQuery) Select(fields ...string) *EpicSelect {
+	eq.ctx.Fields = append(eq.ctx.Fields, fields...)
+	sbuild := &EpicSelect{EpicQuery: eq}
+	sbuild.label = epic.Label
+	sbuild.flds, sbuild.scan = &eq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EpicSelect configured with the given aggregations.
+func (eq *EpicQuery) Aggregate(fns ...AggregateFunc) *EpicSelect {
+	return eq.Select().Aggregate(fns...)
+}
+
+func (eq *EpicQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eq.ctx.Fields {
+		if !epic.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eq.path != nil {
+		prev, err := eq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eq.sql = prev
+	}
+	return nil
+}
+
+func (eq *EpicQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Epic, error) {
+	var (
+		nodes       = []*Epic{}
+		_spec       = eq.querySpec()
+		loadedTypes = [2]bool{
+			eq.withBoard != nil,
+			eq.withTasks != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Epic).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Epic{config: eq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := eq.withBoard; query != nil {
+		if err := eq.loadBoard(ctx, query, nodes, nil,
+			func(n *Epic, e *Board) { n.Edges.Board = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withTasks; query != nil {
+		if err := eq.loadTasks(ctx, query, nodes,
+			func(n *Epic) { n.Edges.Tasks = []*Task{} },
+			func(n *Epic, e *Task) { n.Edges.Tasks = append(n.Edges.Tasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (eq *EpicQuery) loadBoard(ctx context.Context, query *BoardQuery, nodes []*Epic, init func(*Epic), assign func(*Epic, *Board)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Epic)
+	for i := range nodes {
+		fk := nodes[i].BoardID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(board.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "board_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (eq *EpicQuery) loadTasks(ctx context.Context, query *TaskQuery, nodes []*Epic, init func(*Epic), assign func(*Epic, *Task)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Epic)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(task.FieldEpicID)
+	}
+	query.Where(predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(epic.TasksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EpicID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "epic_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "epic_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (eq *EpicQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eq.querySpec()
+	_spec.Node.Columns = eq.ctx.Fields
+	if len(eq.ctx.Fields) > 0 {
+		_spec.Unique = eq.ctx.Unique != nil && *eq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eq.driver, _spec)
+}
+
+func (eq *EpicQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(epic.Table, epic.Columns, sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString))
+	_spec.From = eq.sql
+	if unique := eq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, epic.FieldID)
+		for i := range fields {
+			if fields[i] != epic.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if eq.withBoard != nil {
+			_spec.Node.AddColumnOnce(epic.FieldBoardID)
+		}
+	}
+	if ps := eq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eq *EpicQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eq.driver.Dialect())
+	t1 := builder.Table(epic.Table)
+	columns := eq.ctx.Fields
+	if len(columns) == 0 {
+		columns = epic.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eq.sql != nil {
+		selector = eq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eq.ctx.Unique != nil && *eq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range eq.predicates {
+		p(selector)
+	}
+	for _, p := range eq.order {
+		p(selector)
+	}
+	if offset := eq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EpicGroupBy is the group-by builder for Epic entities.
+type EpicGroupBy struct {
+	selector
+	build *EpicQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (egb *EpicGroupBy) Aggregate(fns ...AggregateFunc) *EpicGroupBy {
+	egb.fns = append(egb.fns, fns...)
+	return egb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (egb *EpicGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, egb.build.ctx, ent.OpQueryGroupBy)
+	if err := egb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EpicQuery, *EpicGroupBy](ctx, egb.build, egb, egb.build.inters, v)
+}
+
+func (egb *EpicGroupBy) sqlScan(ctx context.Context, root *EpicQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(egb.fns))
+	for _, fn := range egb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*egb.flds)+len(egb.fns))
+		for _, f := range *egb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*egb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := egb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EpicSelect is the builder for selecting fields of Epic entities.
+type EpicSelect struct {
+	*EpicQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (es *EpicSelect) Aggregate(fns ...AggregateFunc) *EpicSelect {
+	es.fns = append(es.fns, fns...)
+	return es
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (es *EpicSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, es.ctx, ent.OpQuerySelect)
+	if err := es.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EpicQuery, *EpicSelect](ctx, es.EpicQuery, es, es.inters, v)
+}
+
+func (es *EpicSelect) sqlScan(ctx context.Context, root *EpicQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(es.fns))
+	for _, fn := range es.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*es.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := es.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
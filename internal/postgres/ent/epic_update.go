@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// EpicUpdate is the builder for updating Epic entities.
+type EpicUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EpicMutation
+}
+
+// Where appends a list predicates to the EpicUpdate builder.
+func (eu *EpicUpdate) Where(ps ...predicate.Epic) *EpicUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetBoardID sets the "board_id" field.
+func (eu *EpicUpdate) SetBoardID(s string) *EpicUpdate {
+	eu.mutation.SetBoardID(s)
+	return eu
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (eu *EpicUpdate) SetNillableBoardID(s *string) *EpicUpdate {
+	if s != nil {
+		eu.SetBoardID(*s)
+	}
+	return eu
+}
+
+// SetName sets the "name" field.
+func (eu *EpicUpdate) SetName(s string) *EpicUpdate {
+	eu.mutation.SetName(s)
+	return eu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (eu *EpicUpdate) SetNillableName(s *string) *EpicUpdate {
+	if s != nil {
+		eu.SetName(*s)
+	}
+	return eu
+}
+
+// SetDescription sets the "description" field.
+func (eu *EpicUpdate) SetDescription(s string) *EpicUpdate {
+	eu.mutation.SetDescription(s)
+	return eu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (eu *EpicUpdate) SetNillableDescription(s *string) *EpicUpdate {
+	if s != nil {
+		eu.SetDescription(*s)
+	}
+	return eu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (eu *EpicUpdate) ClearDescription() *EpicUpdate {
+	eu.mutation.ClearDescription()
+	return eu
+}
+
+// SetTargetDate sets the "target_date" field.
+func (eu *EpicUpdate) SetTargetDate(t time.Time) *EpicUpdate {
+	eu.mutation.SetTargetDate(t)
+	return eu
+}
+
+// SetNillableTargetDate sets the "target_date" field if the given value is not nil.
+func (eu *EpicUpdate) SetNillableTargetDate(t *time.Time) *EpicUpdate {
+	if t != nil {
+		eu.SetTargetDate(*t)
+	}
+	return eu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (eu *EpicUpdate) SetCreatedAt(t time.Time) *EpicUpdate {
+	eu.mutation.SetCreatedAt(t)
+	return eu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (eu *EpicUpdate) SetNillableCreatedAt(t *time.Time) *EpicUpdate {
+	if t != nil {
+		eu.SetCreatedAt(*t)
+	}
+	return eu
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (eu *EpicUpdate) SetBoard(b *Board) *EpicUpdate {
+	return eu.SetBoardID(b.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (eu *EpicUpdate) AddTaskIDs(ids ...string) *EpicUpdate {
+	eu.mutation.AddTaskIDs(ids...)
+	return eu
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (eu *EpicUpdate) AddTasks(t ...*Task) *EpicUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eu.AddTaskIDs(ids...)
+}
+
+// Mutation returns the EpicMutation object of the builder.
+func (eu *EpicUpdate) Mutation() *EpicMutation {
+	return eu.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (eu *EpicUpdate) ClearBoard() *EpicUpdate {
+	eu.mutation.ClearBoard()
+	return eu
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (eu *EpicUpdate) ClearTasks() *EpicUpdate {
+	eu.mutation.ClearTasks()
+	return eu
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (eu *EpicUpdate) RemoveTaskIDs(ids ...string) *EpicUpdate {
+	eu.mutation.RemoveTaskIDs(ids...)
+	return eu
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (eu *EpicUpdate) RemoveTasks(t ...*Task) *EpicUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return eu.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *EpicUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *EpicUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *EpicUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *EpicUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eu *EpicUpdate) check() error {
+	if eu.mutation.BoardCleared() && len(eu.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Epic.board"`)
+	}
+	return nil
+}
+
+func (eu *EpicUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(epic.Table, epic.Columns, sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString))
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.Name(); ok {
+		_spec.SetField(epic.FieldName, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.Description(); ok {
+		_spec.SetField(epic.FieldDescription, field.TypeString, value)
+	}
+	if eu.mutation.DescriptionCleared() {
+		_spec.ClearField(epic.FieldDescription, field.TypeString)
+	}
+	if value, ok := eu.mutation.TargetDate(); ok {
+		_spec.SetField(epic.FieldTargetDate, field.TypeTime, value)
+	}
+	if value, ok := eu.mutation.CreatedAt(); ok {
+		_spec.SetField(epic.FieldCreatedAt, field.TypeTime, value)
+	}
+	if eu.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   epic.BoardTable,
+			Columns: []string{epic.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   epic.BoardTable,
+			Columns: []string{epic.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   epic.TasksTable,
+			Columns: []string{epic.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedTasksIDs(); len(nodes) > 0 && !eu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   epic.TasksTable,
+			Columns: []string{epic.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   epic.TasksTable,
+			Columns: []string{epic.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{epic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eu.mutation.done = true
+	return n, nil
+}
+
+// EpicUpdateOne is the builder for updating a single Epic entity.
+type EpicUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EpicMutation
+}
+
+// SetBoardID sets the "board_id" field.
+func (euo *EpicUpdateOne) SetBoardID(s string) *EpicUpdateOne {
+	euo.mutation.SetBoardID(s)
+	return euo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (euo *EpicUpdateOne) SetNillableBoardID(s *string) *EpicUpdateOne {
+	if s != nil {
+		euo.SetBoardID(*s)
+	}
+	return euo
+}
+
+// SetName sets the "name" field.
+func (euo *EpicUpdateOne) SetName(s string) *EpicUpdateOne {
+	euo.mutation.SetName(s)
+	return euo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (euo *EpicUpdateOne) SetNillableName(s *string) *EpicUpdateOne {
+	if s != nil {
+		euo.SetName(*s)
+	}
+	return euo
+}
+
+// SetDescription sets the "description" field.
+func (euo *EpicUpdateOne) SetDescription(s string) *EpicUpdateOne {
+	euo.mutation.SetDescription(s)
+	return euo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (euo *EpicUpdateOne) SetNillableDescription(s *string) *EpicUpdateOne {
+	if s != nil {
+		euo.SetDescription(*s)
+	}
+	return euo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (euo *EpicUpdateOne) ClearDescription() *EpicUpdateOne {
+	euo.mutation.ClearDescription()
+	return euo
+}
+
+// SetTargetDate sets the "target_date" field.
+func (euo *EpicUpdateOne) SetTargetDate(t time.Time) *EpicUpdateOne {
+	euo.mutation.SetTargetDate(t)
+	return euo
+}
+
+// SetNillableTargetDate sets the "target_date" field if the given value is not nil.
+func (euo *EpicUpdateOne) SetNillableTargetDate(t *time.Time) *EpicUpdateOne {
+	if t != nil {
+		euo.SetTargetDate(*t)
+	}
+	return euo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (euo *EpicUpdateOne) SetCreatedAt(t time.Time) *EpicUpdateOne {
+	euo.mutation.SetCreatedAt(t)
+	return euo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (euo *EpicUpdateOne) SetNillableCreatedAt(t *time.Time) *EpicUpdateOne {
+	if t != nil {
+		euo.SetCreatedAt(*t)
+	}
+	return euo
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (euo *EpicUpdateOne) SetBoard(b *Board) *EpicUpdateOne {
+	return euo.SetBoardID(b.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (euo *EpicUpdateOne) AddTaskIDs(ids ...string) *EpicUpdateOne {
+	euo.mutation.AddTaskIDs(ids...)
+	return euo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (euo *EpicUpdateOne) AddTasks(t ...*Task) *EpicUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.AddTaskIDs(ids...)
+}
+
+// Mutation returns the EpicMutation object of the builder.
+func (euo *EpicUpdateOne) Mutation() *EpicMutation {
+	return euo.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (euo *EpicUpdateOne) ClearBoard() *EpicUpdateOne {
+	euo.mutation.ClearBoard()
+	return euo
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (euo *EpicUpdateOne) ClearTasks() *EpicUpdateOne {
+	euo.mutation.ClearTasks()
+	return euo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (euo *EpicUpdateOne) RemoveTaskIDs(ids ...string) *EpicUpdateOne {
+	euo.mutation.RemoveTaskIDs(ids...)
+	return euo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (euo *EpicUpdateOne) RemoveTasks(t ...*Task) *EpicUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return euo.RemoveTaskIDs(ids...)
+}
+
+// Where appends a list predicates to the EpicUpdate builder.
+func (euo *EpicUpdateOne) Where(ps ...predicate.Epic) *EpicUpdateOne {
+	euo.mutation.Where(ps...)
+	return euo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *EpicUpdateOne) Select(field string, fields ...string) *EpicUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Epic entity.
+func (euo *EpicUpdateOne) Save(ctx context.Context) (*Epic, error) {
+	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EpicUpdateOne) SaveX(ctx context.Context) *Epic {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *EpicUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EpicUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *EpicUpdateOne) check() error {
+	if euo.mutation.BoardCleared() && len(euo.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Epic.board"`)
+	}
+	return nil
+}
+
+func (euo *EpicUpdateOne) sqlSave(ctx context.Context) (_node *Epic, err error) {
+	if err := euo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(epic.Table, epic.Columns, sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString))
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Epic.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, epic.FieldID)
+		for _, f := range fields {
+			if !epic.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != epic.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.Name(); ok {
+		_spec.SetField(epic.FieldName, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.Description(); ok {
+		_spec.SetField(epic.FieldDescription, field.TypeString, value)
+	}
+	if euo.mutation.DescriptionCleared() {
+		_spec.ClearField(epic.FieldDescription, field.TypeString)
+	}
+	if value, ok := euo.mutation.TargetDate(); ok {
+		_spec.SetField(epic.FieldTargetDate, field.TypeTime, value)
+	}
+	if value, ok := euo.mutation.CreatedAt(); ok {
+		_spec.SetField(epic.FieldCreatedAt, field.TypeTime, value)
+	}
+	if euo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   epic.BoardTable,
+			Columns: []string{epic.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   epic.BoardTable,
+			Columns: []string{epic.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   epic.TasksTable,
+			Columns: []string{epic.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !euo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   epic.TasksTable,
+			Columns: []string{epic.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   epic.TasksTable,
+			Columns: []string{epic.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Epic{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{epic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	euo.mutation.done = true
+	return _node, nil
+}
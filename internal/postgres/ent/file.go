@@ -35,9 +35,11 @@ type File struct {
 type FileEdges struct {
 	// Task holds the value of the task edge.
 	Task []*Task `json:"task,omitempty"`
+	// Template holds the value of the template edge.
+	Template []*TaskTemplate `json:"template,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // TaskOrErr returns the Task value or an error if the edge
@@ -49,6 +51,15 @@ func (e FileEdges) TaskOrErr() ([]*Task, error) {
 	return nil, &NotLoadedError{edge: "task"}
 }
 
+// TemplateOrErr returns the Template value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) TemplateOrErr() ([]*TaskTemplate, error) {
+	if e.loadedTypes[1] {
+		return e.Template, nil
+	}
+	return nil, &NotLoadedError{edge: "template"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*File) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -123,6 +134,11 @@ func (f *File) QueryTask() *TaskQuery {
 	return NewFileClient(f.config).QueryTask(f)
 }
 
+// QueryTemplate queries the "template" edge of the File entity.
+func (f *File) QueryTemplate() *TaskTemplateQuery {
+	return NewFileClient(f.config).QueryTemplate(f)
+}
+
 // Update returns a builder for updating this File.
 // Note that you need to call File.Unwrap() before calling this method if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
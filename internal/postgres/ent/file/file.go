@@ -24,6 +24,8 @@ const (
 	FieldUploadedAt = "uploaded_at"
 	// EdgeTask holds the string denoting the task edge name in mutations.
 	EdgeTask = "task"
+	// EdgeTemplate holds the string denoting the template edge name in mutations.
+	EdgeTemplate = "template"
 	// Table holds the table name of the file in the database.
 	Table = "files"
 	// TaskTable is the table that holds the task relation/edge. The primary key declared below.
@@ -31,6 +33,11 @@ const (
 	// TaskInverseTable is the table name for the Task entity.
 	// It exists in this package in order to avoid circular dependency with the "task" package.
 	TaskInverseTable = "tasks"
+	// TemplateTable is the table that holds the template relation/edge. The primary key declared below.
+	TemplateTable = "task_template_files"
+	// TemplateInverseTable is the table name for the TaskTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "tasktemplate" package.
+	TemplateInverseTable = "task_templates"
 )
 
 // Columns holds all SQL columns for file fields.
@@ -46,6 +53,9 @@ var (
 	// TaskPrimaryKey and TaskColumn2 are the table columns denoting the
 	// primary key for the task relation (M2M).
 	TaskPrimaryKey = []string{"task_id", "file_id"}
+	// TemplatePrimaryKey and TemplateColumn2 are the table columns denoting the
+	// primary key for the template relation (M2M).
+	TemplatePrimaryKey = []string{"task_template_id", "file_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -104,6 +114,20 @@ func ByTask(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTaskStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTemplateCount orders the results by template count.
+func ByTemplateCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTemplateStep(), opts...)
+	}
+}
+
+// ByTemplate orders the results by template terms.
+func ByTemplate(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTemplateStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTaskStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -111,3 +135,10 @@ func newTaskStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, TaskTable, TaskPrimaryKey...),
 	)
 }
+func newTemplateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TemplateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TemplateTable, TemplatePrimaryKey...),
+	)
+}
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
 )
 
 // FileCreate is the builder for creating a File entity.
@@ -74,6 +75,21 @@ func (fc *FileCreate) AddTask(t ...*Task) *FileCreate {
 	return fc.AddTaskIDs(ids...)
 }
 
+// AddTemplateIDs adds the "template" edge to the TaskTemplate entity by IDs.
+func (fc *FileCreate) AddTemplateIDs(ids ...string) *FileCreate {
+	fc.mutation.AddTemplateIDs(ids...)
+	return fc
+}
+
+// AddTemplate adds the "template" edges to the TaskTemplate entity.
+func (fc *FileCreate) AddTemplate(t ...*TaskTemplate) *FileCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fc.AddTemplateIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fc *FileCreate) Mutation() *FileMutation {
 	return fc.mutation
@@ -196,6 +212,22 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := fc.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.TemplateTable,
+			Columns: file.TemplatePrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,16 +15,18 @@ import (
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
 )
 
 // FileQuery is the builder for querying File entities.
 type FileQuery struct {
 	config
-	ctx        *QueryContext
-	order      []file.OrderOption
-	inters     []Interceptor
-	predicates []predicate.File
-	withTask   *TaskQuery
+	ctx          *QueryContext
+	order        []file.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.File
+	withTask     *TaskQuery
+	withTemplate *TaskTemplateQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (fq *FileQuery) QueryTask() *TaskQuery {
 	return query
 }
 
+// QueryTemplate chains the current query on the "template" edge.
+func (fq *FileQuery) QueryTemplate() *TaskTemplateQuery {
+	query := (&TaskTemplateClient{config: fq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, selector),
+			sqlgraph.To(tasktemplate.Table, tasktemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, file.TemplateTable, file.TemplatePrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first File entity from the query.
 // Returns a *NotFoundError when no File was found.
 func (fq *FileQuery) First(ctx context.Context) (*File, error) {
@@ -270,12 +294,13 @@ func (fq *FileQuery) Clone() *FileQuery {
 		return nil
 	}
 	return &FileQuery{
-		config:     fq.config,
-		ctx:        fq.ctx.Clone(),
-		order:      append([]file.OrderOption{}, fq.order...),
-		inters:     append([]Interceptor{}, fq.inters...),
-		predicates: append([]predicate.File{}, fq.predicates...),
-		withTask:   fq.withTask.Clone(),
+		config:       fq.config,
+		ctx:          fq.ctx.Clone(),
+		order:        append([]file.OrderOption{}, fq.order...),
+		inters:       append([]Interceptor{}, fq.inters...),
+		predicates:   append([]predicate.File{}, fq.predicates...),
+		withTask:     fq.withTask.Clone(),
+		withTemplate: fq.withTemplate.Clone(),
 		// clone intermediate query.
 		sql:  fq.sql.Clone(),
 		path: fq.path,
@@ -293,6 +318,17 @@ func (fq *FileQuery) WithTask(opts ...func(*TaskQuery)) *FileQuery {
 	return fq
 }
 
+// WithTemplate tells the query-builder to eager-load the nodes that are connected to
+// the "template" edge. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithTemplate(opts ...func(*TaskTemplateQuery)) *FileQuery {
+	query := (&TaskTemplateClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withTemplate = query
+	return fq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 	var (
 		nodes       = []*File{}
 		_spec       = fq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			fq.withTask != nil,
+			fq.withTemplate != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +437,13 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 			return nil, err
 		}
 	}
+	if query := fq.withTemplate; query != nil {
+		if err := fq.loadTemplate(ctx, query, nodes,
+			func(n *File) { n.Edges.Template = []*TaskTemplate{} },
+			func(n *File, e *TaskTemplate) { n.Edges.Template = append(n.Edges.Template, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -464,6 +508,67 @@ func (fq *FileQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*Fi
 	}
 	return nil
 }
+func (fq *FileQuery) loadTemplate(ctx context.Context, query *TaskTemplateQuery, nodes []*File, init func(*File), assign func(*File, *TaskTemplate)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*File)
+	nids := make(map[string]map[*File]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(file.TemplateTable)
+		s.Join(joinT).On(s.C(tasktemplate.FieldID), joinT.C(file.TemplatePrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(file.TemplatePrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(file.TemplatePrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*File]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*TaskTemplate](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "template" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := fq.querySpec()
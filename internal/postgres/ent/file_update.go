@@ -14,6 +14,7 @@ import (
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
 )
 
 // FileUpdate is the builder for updating File entities.
@@ -107,6 +108,21 @@ func (fu *FileUpdate) AddTask(t ...*Task) *FileUpdate {
 	return fu.AddTaskIDs(ids...)
 }
 
+// AddTemplateIDs adds the "template" edge to the TaskTemplate entity by IDs.
+func (fu *FileUpdate) AddTemplateIDs(ids ...string) *FileUpdate {
+	fu.mutation.AddTemplateIDs(ids...)
+	return fu
+}
+
+// AddTemplate adds the "template" edges to the TaskTemplate entity.
+func (fu *FileUpdate) AddTemplate(t ...*TaskTemplate) *FileUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fu.AddTemplateIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fu *FileUpdate) Mutation() *FileMutation {
 	return fu.mutation
@@ -133,6 +149,27 @@ func (fu *FileUpdate) RemoveTask(t ...*Task) *FileUpdate {
 	return fu.RemoveTaskIDs(ids...)
 }
 
+// ClearTemplate clears all "template" edges to the TaskTemplate entity.
+func (fu *FileUpdate) ClearTemplate() *FileUpdate {
+	fu.mutation.ClearTemplate()
+	return fu
+}
+
+// RemoveTemplateIDs removes the "template" edge to TaskTemplate entities by IDs.
+func (fu *FileUpdate) RemoveTemplateIDs(ids ...string) *FileUpdate {
+	fu.mutation.RemoveTemplateIDs(ids...)
+	return fu
+}
+
+// RemoveTemplate removes "template" edges to TaskTemplate entities.
+func (fu *FileUpdate) RemoveTemplate(t ...*TaskTemplate) *FileUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fu.RemoveTemplateIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
@@ -229,6 +266,51 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fu.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.TemplateTable,
+			Columns: file.TemplatePrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedTemplateIDs(); len(nodes) > 0 && !fu.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.TemplateTable,
+			Columns: file.TemplatePrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.TemplateTable,
+			Columns: file.TemplatePrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
@@ -327,6 +409,21 @@ func (fuo *FileUpdateOne) AddTask(t ...*Task) *FileUpdateOne {
 	return fuo.AddTaskIDs(ids...)
 }
 
+// AddTemplateIDs adds the "template" edge to the TaskTemplate entity by IDs.
+func (fuo *FileUpdateOne) AddTemplateIDs(ids ...string) *FileUpdateOne {
+	fuo.mutation.AddTemplateIDs(ids...)
+	return fuo
+}
+
+// AddTemplate adds the "template" edges to the TaskTemplate entity.
+func (fuo *FileUpdateOne) AddTemplate(t ...*TaskTemplate) *FileUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fuo.AddTemplateIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (fuo *FileUpdateOne) Mutation() *FileMutation {
 	return fuo.mutation
@@ -353,6 +450,27 @@ func (fuo *FileUpdateOne) RemoveTask(t ...*Task) *FileUpdateOne {
 	return fuo.RemoveTaskIDs(ids...)
 }
 
+// ClearTemplate clears all "template" edges to the TaskTemplate entity.
+func (fuo *FileUpdateOne) ClearTemplate() *FileUpdateOne {
+	fuo.mutation.ClearTemplate()
+	return fuo
+}
+
+// RemoveTemplateIDs removes the "template" edge to TaskTemplate entities by IDs.
+func (fuo *FileUpdateOne) RemoveTemplateIDs(ids ...string) *FileUpdateOne {
+	fuo.mutation.RemoveTemplateIDs(ids...)
+	return fuo
+}
+
+// RemoveTemplate removes "template" edges to TaskTemplate entities.
+func (fuo *FileUpdateOne) RemoveTemplate(t ...*TaskTemplate) *FileUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fuo.RemoveTemplateIDs(ids...)
+}
+
 // Where appends a list predicates to the FileUpdate builder.
 func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
 	fuo.mutation.Where(ps...)
@@ -479,6 +597,51 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fuo.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.TemplateTable,
+			Columns: file.TemplatePrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedTemplateIDs(); len(nodes) > 0 && !fuo.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.TemplateTable,
+			Columns: file.TemplatePrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   file.TemplateTable,
+			Columns: file.TemplatePrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &File{config: fuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
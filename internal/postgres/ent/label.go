@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+)
+
+// Label is the model entity for the Label schema.
+type Label struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID string `json:"board_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Color holds the value of the "color" field.
+	Color string `json:"color,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LabelQuery when eager-loading is set.
+	Edges        LabelEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LabelEdges holds the relations/edges for other nodes in the graph.
+type LabelEdges struct {
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// Tasks holds the value of the tasks edge.
+	Tasks []*Task `json:"tasks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LabelEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e LabelEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[1] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Label) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case label.FieldID, label.FieldBoardID, label.FieldName, label.FieldColor:
+			values[i] = new(sql.NullString)
+		case label.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Label fields.
+func (l *Label) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case label.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				l.ID = value.String
+			}
+		case label.FieldBoardID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				l.BoardID = value.String
+			}
+		case label.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				l.Name = value.String
+			}
+		case label.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				l.Color = value.String
+			}
+		case label.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				l.CreatedAt = value.Time
+			}
+		default:
+			l.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Label.
+// This includes values selected through modifiers, order, etc.
+func (l *Label) Value(name string) (ent.Value, error) {
+	return l.selectValues.Get(name)
+}
+
+// QueryBoard queries the "board" edge of the Label entity.
+func (l *Label) QueryBoard() *BoardQuery {
+	return NewLabelClient(l.config).QueryBoard(l)
+}
+
+// QueryTasks queries the "tasks" edge of the Label entity.
+func (l *Label) QueryTasks() *TaskQuery {
+	return NewLabelClient(l.config).QueryTasks(l)
+}
+
+// Update returns a builder for updating this Label.
+// Note that you need to call Label.Unwrap() before calling this method if this Label
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Label) Update() *LabelUpdateOne {
+	return NewLabelClient(l.config).UpdateOne(l)
+}
+
+// Unwrap unwraps the Label entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Label) Unwrap() *Label {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Label is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Label) String() string {
+	var builder strings.Builder
+	builder.WriteString("Label(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("board_id=")
+	builder.WriteString(l.BoardID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(l.Name)
+	builder.WriteString(", ")
+	builder.WriteString("color=")
+	builder.WriteString(l.Color)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(l.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Labels is a parsable slice of Label.
+type Labels []*Label
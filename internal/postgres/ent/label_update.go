@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// LabelUpdate is the builder for updating Label entities.
+type LabelUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LabelMutation
+}
+
+// Where appends a list predicates to the LabelUpdate builder.
+func (lu *LabelUpdate) Where(ps ...predicate.Label) *LabelUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetBoardID sets the "board_id" field.
+func (lu *LabelUpdate) SetBoardID(s string) *LabelUpdate {
+	lu.mutation.SetBoardID(s)
+	return lu
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (lu *LabelUpdate) SetNillableBoardID(s *string) *LabelUpdate {
+	if s != nil {
+		lu.SetBoardID(*s)
+	}
+	return lu
+}
+
+// SetName sets the "name" field.
+func (lu *LabelUpdate) SetName(s string) *LabelUpdate {
+	lu.mutation.SetName(s)
+	return lu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (lu *LabelUpdate) SetNillableName(s *string) *LabelUpdate {
+	if s != nil {
+		lu.SetName(*s)
+	}
+	return lu
+}
+
+// SetColor sets the "color" field.
+func (lu *LabelUpdate) SetColor(s string) *LabelUpdate {
+	lu.mutation.SetColor(s)
+	return lu
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (lu *LabelUpdate) SetNillableColor(s *string) *LabelUpdate {
+	if s != nil {
+		lu.SetColor(*s)
+	}
+	return lu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lu *LabelUpdate) SetCreatedAt(t time.Time) *LabelUpdate {
+	lu.mutation.SetCreatedAt(t)
+	return lu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lu *LabelUpdate) SetNillableCreatedAt(t *time.Time) *LabelUpdate {
+	if t != nil {
+		lu.SetCreatedAt(*t)
+	}
+	return lu
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (lu *LabelUpdate) SetBoard(b *Board) *LabelUpdate {
+	return lu.SetBoardID(b.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (lu *LabelUpdate) AddTaskIDs(ids ...string) *LabelUpdate {
+	lu.mutation.AddTaskIDs(ids...)
+	return lu
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (lu *LabelUpdate) AddTasks(t ...*Task) *LabelUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return lu.AddTaskIDs(ids...)
+}
+
+// Mutation returns the LabelMutation object of the builder.
+func (lu *LabelUpdate) Mutation() *LabelMutation {
+	return lu.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (lu *LabelUpdate) ClearBoard() *LabelUpdate {
+	lu.mutation.ClearBoard()
+	return lu
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (lu *LabelUpdate) ClearTasks() *LabelUpdate {
+	lu.mutation.ClearTasks()
+	return lu
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (lu *LabelUpdate) RemoveTaskIDs(ids ...string) *LabelUpdate {
+	lu.mutation.RemoveTaskIDs(ids...)
+	return lu
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (lu *LabelUpdate) RemoveTasks(t ...*Task) *LabelUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return lu.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LabelUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LabelUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LabelUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LabelUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lu *LabelUpdate) check() error {
+	if lu.mutation.BoardCleared() && len(lu.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Label.board"`)
+	}
+	return nil
+}
+
+func (lu *LabelUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(label.Table, label.Columns, sqlgraph.NewFieldSpec(label.FieldID, field.TypeString))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Name(); ok {
+		_spec.SetField(label.FieldName, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Color(); ok {
+		_spec.SetField(label.FieldColor, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.CreatedAt(); ok {
+		_spec.SetField(label.FieldCreatedAt, field.TypeTime, value)
+	}
+	if lu.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   label.BoardTable,
+			Columns: []string{label.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   label.BoardTable,
+			Columns: []string{label.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   label.TasksTable,
+			Columns: label.TasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedTasksIDs(); len(nodes) > 0 && !lu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   label.TasksTable,
+			Columns: label.TasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   label.TasksTable,
+			Columns: label.TasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LabelUpdateOne is the builder for updating a single Label entity.
+type LabelUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LabelMutation
+}
+
+// SetBoardID sets the "board_id" field.
+func (luo *LabelUpdateOne) SetBoardID(s string) *LabelUpdateOne {
+	luo.mutation.SetBoardID(s)
+	return luo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (luo *LabelUpdateOne) SetNillableBoardID(s *string) *LabelUpdateOne {
+	if s != nil {
+		luo.SetBoardID(*s)
+	}
+	return luo
+}
+
+// SetName sets the "name" field.
+func (luo *LabelUpdateOne) SetName(s string) *LabelUpdateOne {
+	luo.mutation.SetName(s)
+	return luo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (luo *LabelUpdateOne) SetNillableName(s *string) *LabelUpdateOne {
+	if s != nil {
+		luo.SetName(*s)
+	}
+	return luo
+}
+
+// SetColor sets the "color" field.
+func (luo *LabelUpdateOne) SetColor(s string) *LabelUpdateOne {
+	luo.mutation.SetColor(s)
+	return luo
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (luo *LabelUpdateOne) SetNillableColor(s *string) *LabelUpdateOne {
+	if s != nil {
+		luo.SetColor(*s)
+	}
+	return luo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (luo *LabelUpdateOne) SetCreatedAt(t time.Time) *LabelUpdateOne {
+	luo.mutation.SetCreatedAt(t)
+	return luo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (luo *LabelUpdateOne) SetNillableCreatedAt(t *time.Time) *LabelUpdateOne {
+	if t != nil {
+		luo.SetCreatedAt(*t)
+	}
+	return luo
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (luo *LabelUpdateOne) SetBoard(b *Board) *LabelUpdateOne {
+	return luo.SetBoardID(b.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (luo *LabelUpdateOne) AddTaskIDs(ids ...string) *LabelUpdateOne {
+	luo.mutation.AddTaskIDs(ids...)
+	return luo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (luo *LabelUpdateOne) AddTasks(t ...*Task) *LabelUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return luo.AddTaskIDs(ids...)
+}
+
+// Mutation returns the LabelMutation object of the builder.
+func (luo *LabelUpdateOne) Mutation() *LabelMutation {
+	return luo.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (luo *LabelUpdateOne) ClearBoard() *LabelUpdateOne {
+	luo.mutation.ClearBoard()
+	return luo
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (luo *LabelUpdateOne) ClearTasks() *LabelUpdateOne {
+	luo.mutation.ClearTasks()
+	return luo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (luo *LabelUpdateOne) RemoveTaskIDs(ids ...string) *LabelUpdateOne {
+	luo.mutation.RemoveTaskIDs(ids...)
+	return luo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (luo *LabelUpdateOne) RemoveTasks(t ...*Task) *LabelUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return luo.RemoveTaskIDs(ids...)
+}
+
+// Where appends a list predicates to the LabelUpdate builder.
+func (luo *LabelUpdateOne) Where(ps ...predicate.Label) *LabelUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *LabelUpdateOne) Select(field string, fields ...string) *LabelUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Label entity.
+func (luo *LabelUpdateOne) Save(ctx context.Context) (*Label, error) {
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LabelUpdateOne) SaveX(ctx context.Context) *Label {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LabelUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LabelUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (luo *LabelUpdateOne) check() error {
+	if luo.mutation.BoardCleared() && len(luo.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Label.board"`)
+	}
+	return nil
+}
+
+func (luo *LabelUpdateOne) sqlSave(ctx context.Context) (_node *Label, err error) {
+	if err := luo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(label.Table, label.Columns, sqlgraph.NewFieldSpec(label.FieldID, field.TypeString))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Label.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, label.FieldID)
+		for _, f := range fields {
+			if !label.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != label.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Name(); ok {
+		_spec.SetField(label.FieldName, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Color(); ok {
+		_spec.SetField(label.FieldColor, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.CreatedAt(); ok {
+		_spec.SetField(label.FieldCreatedAt, field.TypeTime, value)
+	}
+	if luo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   label.BoardTable,
+			Columns: []string{label.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   label.BoardTable,
+			Columns: []string{label.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   label.TasksTable,
+			Columns: label.TasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !luo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   label.TasksTable,
+			Columns: label.TasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   label.TasksTable,
+			Columns: label.TasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Label{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{label.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
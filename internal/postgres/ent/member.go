@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+)
+
+// Member is the model entity for the Member schema.
+type Member struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID string `json:"board_id,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// Email holds the value of the "email" field.
+	Email *string `json:"email,omitempty"`
+	// Role holds the value of the "role" field.
+	Role member.Role `json:"role,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MemberQuery when eager-loading is set.
+	Edges        MemberEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MemberEdges holds the relations/edges for other nodes in the graph.
+type MemberEdges struct {
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// AssignedTasks holds the value of the assigned_tasks edge.
+	AssignedTasks []*Task `json:"assigned_tasks,omitempty"`
+	// WatchedTasks holds the value of the watched_tasks edge.
+	WatchedTasks []*Task `json:"watched_tasks,omitempty"`
+	// Worklogs holds the value of the worklogs edge.
+	Worklogs []*Worklog `json:"worklogs,omitempty"`
+	// Timers holds the value of the timers edge.
+	Timers []*Timer `json:"timers,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MemberEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// AssignedTasksOrErr returns the AssignedTasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) AssignedTasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[1] {
+		return e.AssignedTasks, nil
+	}
+	return nil, &NotLoadedError{edge: "assigned_tasks"}
+}
+
+// WatchedTasksOrErr returns the WatchedTasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) WatchedTasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[2] {
+		return e.WatchedTasks, nil
+	}
+	return nil, &NotLoadedError{edge: "watched_tasks"}
+}
+
+// WorklogsOrErr returns the Worklogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) WorklogsOrErr() ([]*Worklog, error) {
+	if e.loadedTypes[3] {
+		return e.Worklogs, nil
+	}
+	return nil, &NotLoadedError{edge: "worklogs"}
+}
+
+// TimersOrErr returns the Timers value or an error if the edge
+// was not loaded in eager-loading.
+func (e MemberEdges) TimersOrErr() ([]*Timer, error) {
+	if e.loadedTypes[4] {
+		return e.Timers, nil
+	}
+	return nil, &NotLoadedError{edge: "timers"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Member) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case member.FieldID, member.FieldBoardID, member.FieldDisplayName, member.FieldEmail, member.FieldRole:
+			values[i] = new(sql.NullString)
+		case member.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Member fields.
+func (m *Member) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case member.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				m.ID = value.String
+			}
+		case member.FieldBoardID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				m.BoardID = value.String
+			}
+		case member.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				m.DisplayName = value.String
+			}
+		case member.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				m.Email = new(string)
+				*m.Email = value.String
+			}
+		case member.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				m.Role = member.Role(value.String)
+			}
+		case member.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Member.
+// This includes values selected through modifiers, order, etc.
+func (m *Member) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// QueryBoard queries the "board" edge of the Member entity.
+func (m *Member) QueryBoard() *BoardQuery {
+	return NewMemberClient(m.config).QueryBoard(m)
+}
+
+// QueryAssignedTasks queries the "assigned_tasks" edge of the Member entity.
+func (m *Member) QueryAssignedTasks() *TaskQuery {
+	return NewMemberClient(m.config).QueryAssignedTasks(m)
+}
+
+// QueryWatchedTasks queries the "watched_tasks" edge of the Member entity.
+func (m *Member) QueryWatchedTasks() *TaskQuery {
+	return NewMemberClient(m.config).QueryWatchedTasks(m)
+}
+
+// QueryWorklogs queries the "worklogs" edge of the Member entity.
+func (m *Member) QueryWorklogs() *WorklogQuery {
+	return NewMemberClient(m.config).QueryWorklogs(m)
+}
+
+// QueryTimers queries the "timers" edge of the Member entity.
+func (m *Member) QueryTimers() *TimerQuery {
+	return NewMemberClient(m.config).QueryTimers(m)
+}
+
+// Update returns a builder for updating this Member.
+// Note that you need to call Member.Unwrap() before calling this method if this Member
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Member) Update() *MemberUpdateOne {
+	return NewMemberClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Member entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Member) Unwrap() *Member {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Member is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Member) String() string {
+	var builder strings.Builder
+	builder.WriteString("Member(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("board_id=")
+	builder.WriteString(m.BoardID)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(m.DisplayName)
+	builder.WriteString(", ")
+	if v := m.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Members is a parsable slice of Member.
+type Members []*Member
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package member
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the member type in the database.
+	Label = "member"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBoardID holds the string denoting the board_id field in the database.
+	FieldBoardID = "board_id"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeBoard holds the string denoting the board edge name in mutations.
+	EdgeBoard = "board"
+	// EdgeAssignedTasks holds the string denoting the assigned_tasks edge name in mutations.
+	EdgeAssignedTasks = "assigned_tasks"
+	// EdgeWatchedTasks holds the string denoting the watched_tasks edge name in mutations.
+	EdgeWatchedTasks = "watched_tasks"
+	// EdgeWorklogs holds the string denoting the worklogs edge name in mutations.
+	EdgeWorklogs = "worklogs"
+	// EdgeTimers holds the string denoting the timers edge name in mutations.
+	EdgeTimers = "timers"
+	// Table holds the table name of the member in the database.
+	Table = "members"
+	// BoardTable is the table that holds the board relation/edge.
+	BoardTable = "members"
+	// BoardInverseTable is the table name for the Board entity.
+	// It exists in this package in order to avoid circular dependency with the "board" package.
+	BoardInverseTable = "boards"
+	// BoardColumn is the table column denoting the board relation/edge.
+	BoardColumn = "board_id"
+	// AssignedTasksTable is the table that holds the assigned_tasks relation/edge. The primary key declared below.
+	AssignedTasksTable = "task_assignees"
+	// AssignedTasksInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	AssignedTasksInverseTable = "tasks"
+	// WatchedTasksTable is the table that holds the watched_tasks relation/edge. The primary key declared below.
+	WatchedTasksTable = "task_watchers"
+	// WatchedTasksInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	WatchedTasksInverseTable = "tasks"
+	// WorklogsTable is the table that holds the worklogs relation/edge.
+	WorklogsTable = "worklogs"
+	// WorklogsInverseTable is the table name for the Worklog entity.
+	// It exists in this package in order to avoid circular dependency with the "worklog" package.
+	WorklogsInverseTable = "worklogs"
+	// WorklogsColumn is the table column denoting the worklogs relation/edge.
+	WorklogsColumn = "member_id"
+	// TimersTable is the table that holds the timers relation/edge.
+	TimersTable = "timers"
+	// TimersInverseTable is the table name for the Timer entity.
+	// It exists in this package in order to avoid circular dependency with the "timer" package.
+	TimersInverseTable = "timers"
+	// TimersColumn is the table column denoting the timers relation/edge.
+	TimersColumn = "member_id"
+)
+
+// Columns holds all SQL columns for member fields.
+var Columns = []string{
+	FieldID,
+	FieldBoardID,
+	FieldDisplayName,
+	FieldEmail,
+	FieldRole,
+	FieldCreatedAt,
+}
+
+var (
+	// AssignedTasksPrimaryKey and AssignedTasksColumn2 are the table columns denoting the
+	// primary key for the assigned_tasks relation (M2M).
+	AssignedTasksPrimaryKey = []string{"task_id", "member_id"}
+	// WatchedTasksPrimaryKey and WatchedTasksColumn2 are the table columns denoting the
+	// primary key for the watched_tasks relation (M2M).
+	WatchedTasksPrimaryKey = []string{"task_id", "member_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleMember is the default value of the Role enum.
+const DefaultRole = RoleMember
+
+// Role values.
+const (
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleAdmin, RoleMember:
+		return nil
+	default:
+		return fmt.Errorf("member: invalid enum value for role field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the Member queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBoardID orders the results by the board_id field.
+func ByBoardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBoardID, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByBoardField orders the results by board field.
+func ByBoardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBoardStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAssignedTasksCount orders the results by assigned_tasks count.
+func ByAssignedTasksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssignedTasksStep(), opts...)
+	}
+}
+
+// ByAssignedTasks orders the results by assigned_tasks terms.
+func ByAssignedTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignedTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWatchedTasksCount orders the results by watched_tasks count.
+func ByWatchedTasksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWatchedTasksStep(), opts...)
+	}
+}
+
+// ByWatchedTasks orders the results by watched_tasks terms.
+func ByWatchedTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchedTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWorklogsCount orders the results by worklogs count.
+func ByWorklogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorklogsStep(), opts...)
+	}
+}
+
+// ByWorklogs orders the results by worklogs terms.
+func ByWorklogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorklogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTimersCount orders the results by timers count.
+func ByTimersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTimersStep(), opts...)
+	}
+}
+
+// ByTimers orders the results by timers terms.
+func ByTimers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTimersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBoardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BoardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+	)
+}
+func newAssignedTasksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignedTasksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, AssignedTasksTable, AssignedTasksPrimaryKey...),
+	)
+}
+func newWatchedTasksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WatchedTasksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, WatchedTasksTable, WatchedTasksPrimaryKey...),
+	)
+}
+func newWorklogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorklogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WorklogsTable, WorklogsColumn),
+	)
+}
+func newTimersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TimersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TimersTable, TimersColumn),
+	)
+}
@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
+)
+
+// MemberCreate is the builder for creating a Member entity.
+type MemberCreate struct {
+	config
+	mutation *MemberMutation
+	hooks    []Hook
+}
+
+// SetBoardID sets the "board_id" field.
+func (mc *MemberCreate) SetBoardID(s string) *MemberCreate {
+	mc.mutation.SetBoardID(s)
+	return mc
+}
+
+// SetDisplayName sets the "display_name" field.
+func (mc *MemberCreate) SetDisplayName(s string) *MemberCreate {
+	mc.mutation.SetDisplayName(s)
+	return mc
+}
+
+// SetEmail sets the "email" field.
+func (mc *MemberCreate) SetEmail(s string) *MemberCreate {
+	mc.mutation.SetEmail(s)
+	return mc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableEmail(s *string) *MemberCreate {
+	if s != nil {
+		mc.SetEmail(*s)
+	}
+	return mc
+}
+
+// SetRole sets the "role" field.
+func (mc *MemberCreate) SetRole(m member.Role) *MemberCreate {
+	mc.mutation.SetRole(m)
+	return mc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableRole(m *member.Role) *MemberCreate {
+	if m != nil {
+		mc.SetRole(*m)
+	}
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MemberCreate) SetCreatedAt(t time.Time) *MemberCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MemberCreate) SetNillableCreatedAt(t *time.Time) *MemberCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetID sets the "id" field.
+func (mc *MemberCreate) SetID(s string) *MemberCreate {
+	mc.mutation.SetID(s)
+	return mc
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (mc *MemberCreate) SetBoard(b *Board) *MemberCreate {
+	return mc.SetBoardID(b.ID)
+}
+
+// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
+func (mc *MemberCreate) AddAssignedTaskIDs(ids ...string) *MemberCreate {
+	mc.mutation.AddAssignedTaskIDs(ids...)
+	return mc
+}
+
+// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
+func (mc *MemberCreate) AddAssignedTasks(t ...*Task) *MemberCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mc.AddAssignedTaskIDs(ids...)
+}
+
+// AddWatchedTaskIDs adds the "watched_tasks" edge to the Task entity by IDs.
+func (mc *MemberCreate) AddWatchedTaskIDs(ids ...string) *MemberCreate {
+	mc.mutation.AddWatchedTaskIDs(ids...)
+	return mc
+}
+
+// AddWatchedTasks adds the "watched_tasks" edges to the Task entity.
+func (mc *MemberCreate) AddWatchedTasks(t ...*Task) *MemberCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mc.AddWatchedTaskIDs(ids...)
+}
+
+// AddWorklogIDs adds the "worklogs" edge to the Worklog entity by IDs.
+func (mc *MemberCreate) AddWorklogIDs(ids ...string) *MemberCreate {
+	mc.mutation.AddWorklogIDs(ids...)
+	return mc
+}
+
+// AddWorklogs adds the "worklogs" edges to the Worklog entity.
+func (mc *MemberCreate) AddWorklogs(w ...*Worklog) *MemberCreate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return mc.AddWorklogIDs(ids...)
+}
+
+// AddTimerIDs adds the "timers" edge to the Timer entity by IDs.
+func (mc *MemberCreate) AddTimerIDs(ids ...int) *MemberCreate {
+	mc.mutation.AddTimerIDs(ids...)
+	return mc
+}
+
+// AddTimers adds the "timers" edges to the Timer entity.
+func (mc *MemberCreate) AddTimers(t ...*Timer) *MemberCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mc.AddTimerIDs(ids...)
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (mc *MemberCreate) Mutation() *MemberMutation {
+	return mc.mutation
+}
+
+// Save creates the Member in the database.
+func (mc *MemberCreate) Save(ctx context.Context) (*Member, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MemberCreate) SaveX(ctx context.Context) *Member {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MemberCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MemberCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MemberCreate) defaults() {
+	if _, ok := mc.mutation.Role(); !ok {
+		v := member.DefaultRole
+		mc.mutation.SetRole(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := member.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MemberCreate) check() error {
+	if _, ok := mc.mutation.BoardID(); !ok {
+		return &ValidationError{Name: "board_id", err: errors.New(`ent: missing required field "Member.board_id"`)}
+	}
+	if _, ok := mc.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`ent: missing required field "Member.display_name"`)}
+	}
+	if _, ok := mc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Member.role"`)}
+	}
+	if v, ok := mc.mutation.Role(); ok {
+		if err := member.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Member.role": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Member.created_at"`)}
+	}
+	if len(mc.mutation.BoardIDs()) == 0 {
+		return &ValidationError{Name: "board", err: errors.New(`ent: missing required edge "Member.board"`)}
+	}
+	return nil
+}
+
+func (mc *MemberCreate) sqlSave(ctx context.Context) (*Member, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Member.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Member{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(member.Table, sqlgraph.NewFieldSpec(member.FieldID, field.TypeString))
+	)
+	if id, ok := mc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mc.mutation.DisplayName(); ok {
+		_spec.SetField(member.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := mc.mutation.Email(); ok {
+		_spec.SetField(member.FieldEmail, field.TypeString, value)
+		_node.Email = &value
+	}
+	if value, ok := mc.mutation.Role(); ok {
+		_spec.SetField(member.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mc.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.BoardTable,
+			Columns: []string{member.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.AssignedTasksTable,
+			Columns: member.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.WatchedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.WatchedTasksTable,
+			Columns: member.WatchedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.WorklogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WorklogsTable,
+			Columns: []string{member.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.TimersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.TimersTable,
+			Columns: []string{member.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MemberCreateBulk is the builder for creating many Member entities in bulk.
+type MemberCreateBulk struct {
+	config
+	err      error
+	builders []*MemberCreate
+}
+
+// Save creates the Member entities in the database.
+func (mcb *MemberCreateBulk) Save(ctx context.Context) ([]*Member, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Member, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MemberCreateBulk) SaveX(ctx context.Context) []*Member {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MemberCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
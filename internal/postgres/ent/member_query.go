@@ -0,0 +1,964 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
+)
+
+// MemberQuery is the builder for querying Member entities.
+type MemberQuery struct {
+	config
+	ctx               *QueryContext
+	order             []member.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Member
+	withBoard         *BoardQuery
+	withAssignedTasks *TaskQuery
+	withWatchedTasks  *TaskQuery
+	withWorklogs      *WorklogQuery
+	withTimers        *TimerQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MemberQuery builder.
+func (mq *MemberQuery) Where(ps ...predicate.Member) *MemberQuery {
+	mq.predicates = append(mq.predicates, ps...)
+	return mq
+}
+
+// Limit the number of records to be returned by this query.
+func (mq *MemberQuery) Limit(limit int) *MemberQuery {
+	mq.ctx.Limit = &limit
+	return mq
+}
+
+// Offset to start from.
+func (mq *MemberQuery) Offset(offset int) *MemberQuery {
+	mq.ctx.Offset = &offset
+	return mq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mq *MemberQuery) Unique(unique bool) *MemberQuery {
+	mq.ctx.Unique = &unique
+	return mq
+}
+
+// Order specifies how the records should be ordered.
+func (mq *MemberQuery) Order(o ...member.OrderOption) *MemberQuery {
+	mq.order = append(mq.order, o...)
+	return mq
+}
+
+// QueryBoard chains the current query on the "board" edge.
+func (mq *MemberQuery) QueryBoard() *BoardQuery {
+	query := (&BoardClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(board.Table, board.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, member.BoardTable, member.BoardColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignedTasks chains the current query on the "assigned_tasks" edge.
+func (mq *MemberQuery) QueryAssignedTasks() *TaskQuery {
+	query := (&TaskClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, member.AssignedTasksTable, member.AssignedTasksPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWatchedTasks chains the current query on the "watched_tasks" edge.
+func (mq *MemberQuery) QueryWatchedTasks() *TaskQuery {
+	query := (&TaskClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, member.WatchedTasksTable, member.WatchedTasksPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWorklogs chains the current query on the "worklogs" edge.
+func (mq *MemberQuery) QueryWorklogs() *WorklogQuery {
+	query := (&WorklogClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(worklog.Table, worklog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.WorklogsTable, member.WorklogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTimers chains the current query on the "timers" edge.
+func (mq *MemberQuery) QueryTimers() *TimerQuery {
+	query := (&TimerClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(member.Table, member.FieldID, selector),
+			sqlgraph.To(timer.Table, timer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, member.TimersTable, member.TimersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Member entity from the query.
+// Returns a *NotFoundError when no Member was found.
+func (mq *MemberQuery) First(ctx context.Context) (*Member, error) {
+	nodes, err := mq.Limit(1).All(setContextOp(ctx, mq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{member.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mq *MemberQuery) FirstX(ctx context.Context) *Member {
+	node, err := mq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Member ID from the query.
+// Returns a *NotFoundError when no Member ID was found.
+func (mq *MemberQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mq.Limit(1).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{member.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mq *MemberQuery) FirstIDX(ctx context.Context) string {
+	id, err := mq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Member entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Member entity is found.
+// Returns a *NotFoundError when no Member entities are found.
+func (mq *MemberQuery) Only(ctx context.Context) (*Member, error) {
+	nodes, err := mq.Limit(2).All(setContextOp(ctx, mq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{member.Label}
+	default:
+		return nil, &NotSingularError{member.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mq *MemberQuery) OnlyX(ctx context.Context) *Member {
+	node, err := mq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Member ID in the query.
+// Returns a *NotSingularError when more than one Member ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mq *MemberQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mq.Limit(2).IDs(setContextOp(ctx, mq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{member.Label}
+	default:
+		err = &NotSingularError{member.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mq *MemberQuery) OnlyIDX(ctx context.Context) string {
+	id, err := mq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Members.
+func (mq *MemberQuery) All(ctx context.Context) ([]*Member, error) {
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryAll)
+	if err := mq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Member, *MemberQuery]()
+	return withInterceptors[[]*Member](ctx, mq, qr, mq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mq *MemberQuery) AllX(ctx context.Context) []*Member {
+	nodes, err := mq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Member IDs.
+func (mq *MemberQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if mq.ctx.Unique == nil && mq.path != nil {
+		mq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryIDs)
+	if err = mq.Select(member.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mq *MemberQuery) IDsX(ctx context.Context) []string {
+	ids, err := mq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mq *MemberQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryCount)
+	if err := mq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mq, querierCount[*MemberQuery](), mq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mq *MemberQuery) CountX(ctx context.Context) int {
+	count, err := mq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mq *MemberQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mq.ctx, ent.OpQueryExist)
+	switch _, err := mq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mq *MemberQuery) ExistX(ctx context.Context) bool {
+	exist, err := mq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MemberQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mq *MemberQuery) Clone() *MemberQuery {
+	if mq == nil {
+		return nil
+	}
+	return &MemberQuery{
+		config:            mq.config,
+		ctx:               mq.ctx.Clone(),
+		order:             append([]member.OrderOption{}, mq.order...),
+		inters:            append([]Interceptor{}, mq.inters...),
+		predicates:        append([]predicate.Member{}, mq.predicates...),
+		withBoard:         mq.withBoard.Clone(),
+		withAssignedTasks: mq.withAssignedTasks.Clone(),
+		withWatchedTasks:  mq.withWatchedTasks.Clone(),
+		withWorklogs:      mq.withWorklogs.Clone(),
+		withTimers:        mq.withTimers.Clone(),
+		// clone intermediate query.
+		sql:  mq.sql.Clone(),
+		path: mq.path,
+	}
+}
+
+// WithBoard tells the query-builder to eager-load the nodes that are connected to
+// the "board" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithBoard(opts ...func(*BoardQuery)) *MemberQuery {
+	query := (&BoardClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withBoard = query
+	return mq
+}
+
+// WithAssignedTasks tells the query-builder to eager-load the nodes that are connected to
+// the "assigned_tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithAssignedTasks(opts ...func(*TaskQuery)) *MemberQuery {
+	query := (&TaskClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withAssignedTasks = query
+	return mq
+}
+
+// WithWatchedTasks tells the query-builder to eager-load the nodes that are connected to
+// the "watched_tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithWatchedTasks(opts ...func(*TaskQuery)) *MemberQuery {
+	query := (&TaskClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withWatchedTasks = query
+	return mq
+}
+
+// WithWorklogs tells the query-builder to eager-load the nodes that are connected to
+// the "worklogs" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithWorklogs(opts ...func(*WorklogQuery)) *MemberQuery {
+	query := (&WorklogClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withWorklogs = query
+	return mq
+}
+
+// WithTimers tells the query-builder to eager-load the nodes that are connected to
+// the "timers" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MemberQuery) WithTimers(opts ...func(*TimerQuery)) *MemberQuery {
+	query := (&TimerClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withTimers = query
+	return mq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Member.Query().
+//		GroupBy(member.FieldBoardID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mq *MemberQuery) GroupBy(field string, fields ...string) *MemberGroupBy {
+	mq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MemberGroupBy{build: mq}
+	grbuild.flds = &mq.ctx.Fields
+	grbuild.label = member.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//	}
+//
+//	client.Member.Query().
+//		Select(member.FieldBoardID).
+//		Scan(ctx, &v)
+func (mq *MemberQuery) Select(fields ...string) *MemberSelect {
+	mq.ctx.Fields = append(mq.ctx.Fields, fields...)
+	sbuild := &MemberSelect{MemberQuery: mq}
+	sbuild.label = member.Label
+	sbuild.flds, sbuild.scan = &mq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MemberSelect configured with the given aggregations.
+func (mq *MemberQuery) Aggregate(fns ...AggregateFunc) *MemberSelect {
+	return mq.Select().Aggregate(fns...)
+}
+
+func (mq *MemberQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mq.ctx.Fields {
+		if !member.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mq.path != nil {
+		prev, err := mq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mq.sql = prev
+	}
+	return nil
+}
+
+func (mq *MemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Member, error) {
+	var (
+		nodes       = []*Member{}
+		_spec       = mq.querySpec()
+		loadedTypes = [5]bool{
+			mq.withBoard != nil,
+			mq.withAssignedTasks != nil,
+			mq.withWatchedTasks != nil,
+			mq.withWorklogs != nil,
+			mq.withTimers != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Member).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Member{config: mq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mq.withBoard; query != nil {
+		if err := mq.loadBoard(ctx, query, nodes, nil,
+			func(n *Member, e *Board) { n.Edges.Board = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withAssignedTasks; query != nil {
+		if err := mq.loadAssignedTasks(ctx, query, nodes,
+			func(n *Member) { n.Edges.AssignedTasks = []*Task{} },
+			func(n *Member, e *Task) { n.Edges.AssignedTasks = append(n.Edges.AssignedTasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withWatchedTasks; query != nil {
+		if err := mq.loadWatchedTasks(ctx, query, nodes,
+			func(n *Member) { n.Edges.WatchedTasks = []*Task{} },
+			func(n *Member, e *Task) { n.Edges.WatchedTasks = append(n.Edges.WatchedTasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withWorklogs; query != nil {
+		if err := mq.loadWorklogs(ctx, query, nodes,
+			func(n *Member) { n.Edges.Worklogs = []*Worklog{} },
+			func(n *Member, e *Worklog) { n.Edges.Worklogs = append(n.Edges.Worklogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mq.withTimers; query != nil {
+		if err := mq.loadTimers(ctx, query, nodes,
+			func(n *Member) { n.Edges.Timers = []*Timer{} },
+			func(n *Member, e *Timer) { n.Edges.Timers = append(n.Edges.Timers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mq *MemberQuery) loadBoard(ctx context.Context, query *BoardQuery, nodes []*Member, init func(*Member), assign func(*Member, *Board)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Member)
+	for i := range nodes {
+		fk := nodes[i].BoardID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(board.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "board_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mq *MemberQuery) loadAssignedTasks(ctx context.Context, query *TaskQuery, nodes []*Member, init func(*Member), assign func(*Member, *Task)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Member)
+	nids := make(map[string]map[*Member]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(member.AssignedTasksTable)
+		s.Join(joinT).On(s.C(task.FieldID), joinT.C(member.AssignedTasksPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(member.AssignedTasksPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(member.AssignedTasksPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Member]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Task](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "assigned_tasks" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (mq *MemberQuery) loadWatchedTasks(ctx context.Context, query *TaskQuery, nodes []*Member, init func(*Member), assign func(*Member, *Task)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Member)
+	nids := make(map[string]map[*Member]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(member.WatchedTasksTable)
+		s.Join(joinT).On(s.C(task.FieldID), joinT.C(member.WatchedTasksPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(member.WatchedTasksPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(member.WatchedTasksPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Member]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Task](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "watched_tasks" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (mq *MemberQuery) loadWorklogs(ctx context.Context, query *WorklogQuery, nodes []*Member, init func(*Member), assign func(*Member, *Worklog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(worklog.FieldMemberID)
+	}
+	query.Where(predicate.Worklog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.WorklogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MemberID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (mq *MemberQuery) loadTimers(ctx context.Context, query *TimerQuery, nodes []*Member, init func(*Member), assign func(*Member, *Timer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Member)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(timer.FieldMemberID)
+	}
+	query.Where(predicate.Timer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(member.TimersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MemberID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "member_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (mq *MemberQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mq.querySpec()
+	_spec.Node.Columns = mq.ctx.Fields
+	if len(mq.ctx.Fields) > 0 {
+		_spec.Unique = mq.ctx.Unique != nil && *mq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mq.driver, _spec)
+}
+
+func (mq *MemberQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(member.Table, member.Columns, sqlgraph.NewFieldSpec(member.FieldID, field.TypeString))
+	_spec.From = mq.sql
+	if unique := mq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, member.FieldID)
+		for i := range fields {
+			if fields[i] != member.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mq.withBoard != nil {
+			_spec.Node.AddColumnOnce(member.FieldBoardID)
+		}
+	}
+	if ps := mq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mq *MemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mq.driver.Dialect())
+	t1 := builder.Table(member.Table)
+	columns := mq.ctx.Fields
+	if len(columns) == 0 {
+		columns = member.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mq.sql != nil {
+		selector = mq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mq.ctx.Unique != nil && *mq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mq.predicates {
+		p(selector)
+	}
+	for _, p := range mq.order {
+		p(selector)
+	}
+	if offset := mq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MemberGroupBy is the group-by builder for Member entities.
+type MemberGroupBy struct {
+	selector
+	build *MemberQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mgb *MemberGroupBy) Aggregate(fns ...AggregateFunc) *MemberGroupBy {
+	mgb.fns = append(mgb.fns, fns...)
+	return mgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mgb *MemberGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberQuery, *MemberGroupBy](ctx, mgb.build, mgb, mgb.build.inters, v)
+}
+
+func (mgb *MemberGroupBy) sqlScan(ctx context.Context, root *MemberQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mgb.fns))
+	for _, fn := range mgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mgb.flds)+len(mgb.fns))
+		for _, f := range *mgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MemberSelect is the builder for selecting fields of Member entities.
+type MemberSelect struct {
+	*MemberQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ms *MemberSelect) Aggregate(fns ...AggregateFunc) *MemberSelect {
+	ms.fns = append(ms.fns, fns...)
+	return ms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ms *MemberSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ms.ctx, ent.OpQuerySelect)
+	if err := ms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MemberQuery, *MemberSelect](ctx, ms.MemberQuery, ms, ms.inters, v)
+}
+
+func (ms *MemberSelect) sqlScan(ctx context.Context, root *MemberQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ms.fns))
+	for _, fn := range ms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
+)
+
+// MemberUpdate is the builder for updating Member entities.
+type MemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MemberMutation
+}
+
+// Where appends a list predicates to the MemberUpdate builder.
+func (mu *MemberUpdate) Where(ps ...predicate.Member) *MemberUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetBoardID sets the "board_id" field.
+func (mu *MemberUpdate) SetBoardID(s string) *MemberUpdate {
+	mu.mutation.SetBoardID(s)
+	return mu
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableBoardID(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetBoardID(*s)
+	}
+	return mu
+}
+
+// SetDisplayName sets the "display_name" field.
+func (mu *MemberUpdate) SetDisplayName(s string) *MemberUpdate {
+	mu.mutation.SetDisplayName(s)
+	return mu
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableDisplayName(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetDisplayName(*s)
+	}
+	return mu
+}
+
+// SetEmail sets the "email" field.
+func (mu *MemberUpdate) SetEmail(s string) *MemberUpdate {
+	mu.mutation.SetEmail(s)
+	return mu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableEmail(s *string) *MemberUpdate {
+	if s != nil {
+		mu.SetEmail(*s)
+	}
+	return mu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (mu *MemberUpdate) ClearEmail() *MemberUpdate {
+	mu.mutation.ClearEmail()
+	return mu
+}
+
+// SetRole sets the "role" field.
+func (mu *MemberUpdate) SetRole(m member.Role) *MemberUpdate {
+	mu.mutation.SetRole(m)
+	return mu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableRole(m *member.Role) *MemberUpdate {
+	if m != nil {
+		mu.SetRole(*m)
+	}
+	return mu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mu *MemberUpdate) SetCreatedAt(t time.Time) *MemberUpdate {
+	mu.mutation.SetCreatedAt(t)
+	return mu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mu *MemberUpdate) SetNillableCreatedAt(t *time.Time) *MemberUpdate {
+	if t != nil {
+		mu.SetCreatedAt(*t)
+	}
+	return mu
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (mu *MemberUpdate) SetBoard(b *Board) *MemberUpdate {
+	return mu.SetBoardID(b.ID)
+}
+
+// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
+func (mu *MemberUpdate) AddAssignedTaskIDs(ids ...string) *MemberUpdate {
+	mu.mutation.AddAssignedTaskIDs(ids...)
+	return mu
+}
+
+// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
+func (mu *MemberUpdate) AddAssignedTasks(t ...*Task) *MemberUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.AddAssignedTaskIDs(ids...)
+}
+
+// AddWatchedTaskIDs adds the "watched_tasks" edge to the Task entity by IDs.
+func (mu *MemberUpdate) AddWatchedTaskIDs(ids ...string) *MemberUpdate {
+	mu.mutation.AddWatchedTaskIDs(ids...)
+	return mu
+}
+
+// AddWatchedTasks adds the "watched_tasks" edges to the Task entity.
+func (mu *MemberUpdate) AddWatchedTasks(t ...*Task) *MemberUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.AddWatchedTaskIDs(ids...)
+}
+
+// AddWorklogIDs adds the "worklogs" edge to the Worklog entity by IDs.
+func (mu *MemberUpdate) AddWorklogIDs(ids ...string) *MemberUpdate {
+	mu.mutation.AddWorklogIDs(ids...)
+	return mu
+}
+
+// AddWorklogs adds the "worklogs" edges to the Worklog entity.
+func (mu *MemberUpdate) AddWorklogs(w ...*Worklog) *MemberUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return mu.AddWorklogIDs(ids...)
+}
+
+// AddTimerIDs adds the "timers" edge to the Timer entity by IDs.
+func (mu *MemberUpdate) AddTimerIDs(ids ...int) *MemberUpdate {
+	mu.mutation.AddTimerIDs(ids...)
+	return mu
+}
+
+// AddTimers adds the "timers" edges to the Timer entity.
+func (mu *MemberUpdate) AddTimers(t ...*Timer) *MemberUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.AddTimerIDs(ids...)
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (mu *MemberUpdate) Mutation() *MemberMutation {
+	return mu.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (mu *MemberUpdate) ClearBoard() *MemberUpdate {
+	mu.mutation.ClearBoard()
+	return mu
+}
+
+// ClearAssignedTasks clears all "assigned_tasks" edges to the Task entity.
+func (mu *MemberUpdate) ClearAssignedTasks() *MemberUpdate {
+	mu.mutation.ClearAssignedTasks()
+	return mu
+}
+
+// RemoveAssignedTaskIDs removes the "assigned_tasks" edge to Task entities by IDs.
+func (mu *MemberUpdate) RemoveAssignedTaskIDs(ids ...string) *MemberUpdate {
+	mu.mutation.RemoveAssignedTaskIDs(ids...)
+	return mu
+}
+
+// RemoveAssignedTasks removes "assigned_tasks" edges to Task entities.
+func (mu *MemberUpdate) RemoveAssignedTasks(t ...*Task) *MemberUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.RemoveAssignedTaskIDs(ids...)
+}
+
+// ClearWatchedTasks clears all "watched_tasks" edges to the Task entity.
+func (mu *MemberUpdate) ClearWatchedTasks() *MemberUpdate {
+	mu.mutation.ClearWatchedTasks()
+	return mu
+}
+
+// RemoveWatchedTaskIDs removes the "watched_tasks" edge to Task entities by IDs.
+func (mu *MemberUpdate) RemoveWatchedTaskIDs(ids ...string) *MemberUpdate {
+	mu.mutation.RemoveWatchedTaskIDs(ids...)
+	return mu
+}
+
+// RemoveWatchedTasks removes "watched_tasks" edges to Task entities.
+func (mu *MemberUpdate) RemoveWatchedTasks(t ...*Task) *MemberUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.RemoveWatchedTaskIDs(ids...)
+}
+
+// ClearWorklogs clears all "worklogs" edges to the Worklog entity.
+func (mu *MemberUpdate) ClearWorklogs() *MemberUpdate {
+	mu.mutation.ClearWorklogs()
+	return mu
+}
+
+// RemoveWorklogIDs removes the "worklogs" edge to Worklog entities by IDs.
+func (mu *MemberUpdate) RemoveWorklogIDs(ids ...string) *MemberUpdate {
+	mu.mutation.RemoveWorklogIDs(ids...)
+	return mu
+}
+
+// RemoveWorklogs removes "worklogs" edges to Worklog entities.
+func (mu *MemberUpdate) RemoveWorklogs(w ...*Worklog) *MemberUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return mu.RemoveWorklogIDs(ids...)
+}
+
+// ClearTimers clears all "timers" edges to the Timer entity.
+func (mu *MemberUpdate) ClearTimers() *MemberUpdate {
+	mu.mutation.ClearTimers()
+	return mu
+}
+
+// RemoveTimerIDs removes the "timers" edge to Timer entities by IDs.
+func (mu *MemberUpdate) RemoveTimerIDs(ids ...int) *MemberUpdate {
+	mu.mutation.RemoveTimerIDs(ids...)
+	return mu
+}
+
+// RemoveTimers removes "timers" edges to Timer entities.
+func (mu *MemberUpdate) RemoveTimers(t ...*Timer) *MemberUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return mu.RemoveTimerIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MemberUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MemberUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MemberUpdate) check() error {
+	if v, ok := mu.mutation.Role(); ok {
+		if err := member.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Member.role": %w`, err)}
+		}
+	}
+	if mu.mutation.BoardCleared() && len(mu.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Member.board"`)
+	}
+	return nil
+}
+
+func (mu *MemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(member.Table, member.Columns, sqlgraph.NewFieldSpec(member.FieldID, field.TypeString))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.DisplayName(); ok {
+		_spec.SetField(member.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Email(); ok {
+		_spec.SetField(member.FieldEmail, field.TypeString, value)
+	}
+	if mu.mutation.EmailCleared() {
+		_spec.ClearField(member.FieldEmail, field.TypeString)
+	}
+	if value, ok := mu.mutation.Role(); ok {
+		_spec.SetField(member.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.CreatedAt(); ok {
+		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mu.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.BoardTable,
+			Columns: []string{member.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.BoardTable,
+			Columns: []string{member.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.AssignedTasksTable,
+			Columns: member.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedAssignedTasksIDs(); len(nodes) > 0 && !mu.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.AssignedTasksTable,
+			Columns: member.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.AssignedTasksTable,
+			Columns: member.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.WatchedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.WatchedTasksTable,
+			Columns: member.WatchedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedWatchedTasksIDs(); len(nodes) > 0 && !mu.mutation.WatchedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.WatchedTasksTable,
+			Columns: member.WatchedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.WatchedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.WatchedTasksTable,
+			Columns: member.WatchedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.WorklogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WorklogsTable,
+			Columns: []string{member.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedWorklogsIDs(); len(nodes) > 0 && !mu.mutation.WorklogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WorklogsTable,
+			Columns: []string{member.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.WorklogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WorklogsTable,
+			Columns: []string{member.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mu.mutation.TimersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.TimersTable,
+			Columns: []string{member.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedTimersIDs(); len(nodes) > 0 && !mu.mutation.TimersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.TimersTable,
+			Columns: []string{member.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.TimersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.TimersTable,
+			Columns: []string{member.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{member.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MemberUpdateOne is the builder for updating a single Member entity.
+type MemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MemberMutation
+}
+
+// SetBoardID sets the "board_id" field.
+func (muo *MemberUpdateOne) SetBoardID(s string) *MemberUpdateOne {
+	muo.mutation.SetBoardID(s)
+	return muo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableBoardID(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetBoardID(*s)
+	}
+	return muo
+}
+
+// SetDisplayName sets the "display_name" field.
+func (muo *MemberUpdateOne) SetDisplayName(s string) *MemberUpdateOne {
+	muo.mutation.SetDisplayName(s)
+	return muo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableDisplayName(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetDisplayName(*s)
+	}
+	return muo
+}
+
+// SetEmail sets the "email" field.
+func (muo *MemberUpdateOne) SetEmail(s string) *MemberUpdateOne {
+	muo.mutation.SetEmail(s)
+	return muo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableEmail(s *string) *MemberUpdateOne {
+	if s != nil {
+		muo.SetEmail(*s)
+	}
+	return muo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (muo *MemberUpdateOne) ClearEmail() *MemberUpdateOne {
+	muo.mutation.ClearEmail()
+	return muo
+}
+
+// SetRole sets the "role" field.
+func (muo *MemberUpdateOne) SetRole(m member.Role) *MemberUpdateOne {
+	muo.mutation.SetRole(m)
+	return muo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableRole(m *member.Role) *MemberUpdateOne {
+	if m != nil {
+		muo.SetRole(*m)
+	}
+	return muo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (muo *MemberUpdateOne) SetCreatedAt(t time.Time) *MemberUpdateOne {
+	muo.mutation.SetCreatedAt(t)
+	return muo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (muo *MemberUpdateOne) SetNillableCreatedAt(t *time.Time) *MemberUpdateOne {
+	if t != nil {
+		muo.SetCreatedAt(*t)
+	}
+	return muo
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (muo *MemberUpdateOne) SetBoard(b *Board) *MemberUpdateOne {
+	return muo.SetBoardID(b.ID)
+}
+
+// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
+func (muo *MemberUpdateOne) AddAssignedTaskIDs(ids ...string) *MemberUpdateOne {
+	muo.mutation.AddAssignedTaskIDs(ids...)
+	return muo
+}
+
+// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
+func (muo *MemberUpdateOne) AddAssignedTasks(t ...*Task) *MemberUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.AddAssignedTaskIDs(ids...)
+}
+
+// AddWatchedTaskIDs adds the "watched_tasks" edge to the Task entity by IDs.
+func (muo *MemberUpdateOne) AddWatchedTaskIDs(ids ...string) *MemberUpdateOne {
+	muo.mutation.AddWatchedTaskIDs(ids...)
+	return muo
+}
+
+// AddWatchedTasks adds the "watched_tasks" edges to the Task entity.
+func (muo *MemberUpdateOne) AddWatchedTasks(t ...*Task) *MemberUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.AddWatchedTaskIDs(ids...)
+}
+
+// AddWorklogIDs adds the "worklogs" edge to the Worklog entity by IDs.
+func (muo *MemberUpdateOne) AddWorklogIDs(ids ...string) *MemberUpdateOne {
+	muo.mutation.AddWorklogIDs(ids...)
+	return muo
+}
+
+// AddWorklogs adds the "worklogs" edges to the Worklog entity.
+func (muo *MemberUpdateOne) AddWorklogs(w ...*Worklog) *MemberUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return muo.AddWorklogIDs(ids...)
+}
+
+// AddTimerIDs adds the "timers" edge to the Timer entity by IDs.
+func (muo *MemberUpdateOne) AddTimerIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.AddTimerIDs(ids...)
+	return muo
+}
+
+// AddTimers adds the "timers" edges to the Timer entity.
+func (muo *MemberUpdateOne) AddTimers(t ...*Timer) *MemberUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.AddTimerIDs(ids...)
+}
+
+// Mutation returns the MemberMutation object of the builder.
+func (muo *MemberUpdateOne) Mutation() *MemberMutation {
+	return muo.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (muo *MemberUpdateOne) ClearBoard() *MemberUpdateOne {
+	muo.mutation.ClearBoard()
+	return muo
+}
+
+// ClearAssignedTasks clears all "assigned_tasks" edges to the Task entity.
+func (muo *MemberUpdateOne) ClearAssignedTasks() *MemberUpdateOne {
+	muo.mutation.ClearAssignedTasks()
+	return muo
+}
+
+// RemoveAssignedTaskIDs removes the "assigned_tasks" edge to Task entities by IDs.
+func (muo *MemberUpdateOne) RemoveAssignedTaskIDs(ids ...string) *MemberUpdateOne {
+	muo.mutation.RemoveAssignedTaskIDs(ids...)
+	return muo
+}
+
+// RemoveAssignedTasks removes "assigned_tasks" edges to Task entities.
+func (muo *MemberUpdateOne) RemoveAssignedTasks(t ...*Task) *MemberUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.RemoveAssignedTaskIDs(ids...)
+}
+
+// ClearWatchedTasks clears all "watched_tasks" edges to the Task entity.
+func (muo *MemberUpdateOne) ClearWatchedTasks() *MemberUpdateOne {
+	muo.mutation.ClearWatchedTasks()
+	return muo
+}
+
+// RemoveWatchedTaskIDs removes the "watched_tasks" edge to Task entities by IDs.
+func (muo *MemberUpdateOne) RemoveWatchedTaskIDs(ids ...string) *MemberUpdateOne {
+	muo.mutation.RemoveWatchedTaskIDs(ids...)
+	return muo
+}
+
+// RemoveWatchedTasks removes "watched_tasks" edges to Task entities.
+func (muo *MemberUpdateOne) RemoveWatchedTasks(t ...*Task) *MemberUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.RemoveWatchedTaskIDs(ids...)
+}
+
+// ClearWorklogs clears all "worklogs" edges to the Worklog entity.
+func (muo *MemberUpdateOne) ClearWorklogs() *MemberUpdateOne {
+	muo.mutation.ClearWorklogs()
+	return muo
+}
+
+// RemoveWorklogIDs removes the "worklogs" edge to Worklog entities by IDs.
+func (muo *MemberUpdateOne) RemoveWorklogIDs(ids ...string) *MemberUpdateOne {
+	muo.mutation.RemoveWorklogIDs(ids...)
+	return muo
+}
+
+// RemoveWorklogs removes "worklogs" edges to Worklog entities.
+func (muo *MemberUpdateOne) RemoveWorklogs(w ...*Worklog) *MemberUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return muo.RemoveWorklogIDs(ids...)
+}
+
+// ClearTimers clears all "timers" edges to the Timer entity.
+func (muo *MemberUpdateOne) ClearTimers() *MemberUpdateOne {
+	muo.mutation.ClearTimers()
+	return muo
+}
+
+// RemoveTimerIDs removes the "timers" edge to Timer entities by IDs.
+func (muo *MemberUpdateOne) RemoveTimerIDs(ids ...int) *MemberUpdateOne {
+	muo.mutation.RemoveTimerIDs(ids...)
+	return muo
+}
+
+// RemoveTimers removes "timers" edges to Timer entities.
+func (muo *MemberUpdateOne) RemoveTimers(t ...*Timer) *MemberUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return muo.RemoveTimerIDs(ids...)
+}
+
+// Where appends a list predicates to the MemberUpdate builder.
+func (muo *MemberUpdateOne) Where(ps ...predicate.Member) *MemberUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MemberUpdateOne) Select(field string, fields ...string) *MemberUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Member entity.
+func (muo *MemberUpdateOne) Save(ctx context.Context) (*Member, error) {
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MemberUpdateOne) SaveX(ctx context.Context) *Member {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MemberUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MemberUpdateOne) check() error {
+	if v, ok := muo.mutation.Role(); ok {
+		if err := member.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Member.role": %w`, err)}
+		}
+	}
+	if muo.mutation.BoardCleared() && len(muo.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Member.board"`)
+	}
+	return nil
+}
+
+func (muo *MemberUpdateOne) sqlSave(ctx context.Context) (_node *Member, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(member.Table, member.Columns, sqlgraph.NewFieldSpec(member.FieldID, field.TypeString))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Member.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, member.FieldID)
+		for _, f := range fields {
+			if !member.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != member.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.DisplayName(); ok {
+		_spec.SetField(member.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Email(); ok {
+		_spec.SetField(member.FieldEmail, field.TypeString, value)
+	}
+	if muo.mutation.EmailCleared() {
+		_spec.ClearField(member.FieldEmail, field.TypeString)
+	}
+	if value, ok := muo.mutation.Role(); ok {
+		_spec.SetField(member.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.CreatedAt(); ok {
+		_spec.SetField(member.FieldCreatedAt, field.TypeTime, value)
+	}
+	if muo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.BoardTable,
+			Columns: []string{member.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   member.BoardTable,
+			Columns: []string{member.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.AssignedTasksTable,
+			Columns: member.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedAssignedTasksIDs(); len(nodes) > 0 && !muo.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.AssignedTasksTable,
+			Columns: member.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.AssignedTasksTable,
+			Columns: member.AssignedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.WatchedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.WatchedTasksTable,
+			Columns: member.WatchedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedWatchedTasksIDs(); len(nodes) > 0 && !muo.mutation.WatchedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.WatchedTasksTable,
+			Columns: member.WatchedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.WatchedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   member.WatchedTasksTable,
+			Columns: member.WatchedTasksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.WorklogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WorklogsTable,
+			Columns: []string{member.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedWorklogsIDs(); len(nodes) > 0 && !muo.mutation.WorklogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WorklogsTable,
+			Columns: []string{member.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.WorklogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.WorklogsTable,
+			Columns: []string{member.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if muo.mutation.TimersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.TimersTable,
+			Columns: []string{member.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedTimersIDs(); len(nodes) > 0 && !muo.mutation.TimersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.TimersTable,
+			Columns: []string{member.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.TimersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   member.TimersTable,
+			Columns: []string{member.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Member{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{member.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
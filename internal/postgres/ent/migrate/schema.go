@@ -8,6 +8,198 @@ import (
 )
 
 var (
+	// ActivitiesColumns holds the columns for the "activities" table.
+	ActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"api", "assistant", "scheduler"}},
+		{Name: "field", Type: field.TypeString},
+		{Name: "from_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "to_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeString},
+	}
+	// ActivitiesTable holds the schema information for the "activities" table.
+	ActivitiesTable = &schema.Table{
+		Name:       "activities",
+		Columns:    ActivitiesColumns,
+		PrimaryKey: []*schema.Column{ActivitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "activities_tasks_activities",
+				Columns:    []*schema.Column{ActivitiesColumns[7]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activity_task_id_changed_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivitiesColumns[7], ActivitiesColumns[6]},
+			},
+		},
+	}
+	// BoardsColumns holds the columns for the "boards" table.
+	BoardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "statuses", Type: field.TypeJSON, Nullable: true},
+		{Name: "transitions", Type: field.TypeJSON, Nullable: true},
+		{Name: "wip_limits", Type: field.TypeJSON, Nullable: true},
+		{Name: "assignee_wip_limits", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+	}
+	// BoardsTable holds the schema information for the "boards" table.
+	BoardsTable = &schema.Table{
+		Name:       "boards",
+		Columns:    BoardsColumns,
+		PrimaryKey: []*schema.Column{BoardsColumns[0]},
+	}
+	// ChecklistItemsColumns holds the columns for the "checklist_items" table.
+	ChecklistItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "text", Type: field.TypeString},
+		{Name: "done", Type: field.TypeBool, Default: false},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "task_id", Type: field.TypeString},
+	}
+	// ChecklistItemsTable holds the schema information for the "checklist_items" table.
+	ChecklistItemsTable = &schema.Table{
+		Name:       "checklist_items",
+		Columns:    ChecklistItemsColumns,
+		PrimaryKey: []*schema.Column{ChecklistItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "checklist_items_tasks_checklist_items",
+				Columns:    []*schema.Column{ChecklistItemsColumns[4]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "mentions", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
+		{Name: "task_id", Type: field.TypeString},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_comments_replies",
+				Columns:    []*schema.Column{CommentsColumns[8]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "comments_tasks_comments",
+				Columns:    []*schema.Column{CommentsColumns[9]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// CustomFieldsColumns holds the columns for the "custom_fields" table.
+	CustomFieldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "number", "date", "select", "user"}},
+		{Name: "options", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "board_id", Type: field.TypeString},
+	}
+	// CustomFieldsTable holds the schema information for the "custom_fields" table.
+	CustomFieldsTable = &schema.Table{
+		Name:       "custom_fields",
+		Columns:    CustomFieldsColumns,
+		PrimaryKey: []*schema.Column{CustomFieldsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "custom_fields_boards_custom_fields",
+				Columns:    []*schema.Column{CustomFieldsColumns[5]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customfield_board_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{CustomFieldsColumns[5], CustomFieldsColumns[1]},
+			},
+		},
+	}
+	// CustomFieldValuesColumns holds the columns for the "custom_field_values" table.
+	CustomFieldValuesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "value", Type: field.TypeString},
+		{Name: "field_id", Type: field.TypeString},
+		{Name: "task_id", Type: field.TypeString},
+	}
+	// CustomFieldValuesTable holds the schema information for the "custom_field_values" table.
+	CustomFieldValuesTable = &schema.Table{
+		Name:       "custom_field_values",
+		Columns:    CustomFieldValuesColumns,
+		PrimaryKey: []*schema.Column{CustomFieldValuesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "custom_field_values_custom_fields_values",
+				Columns:    []*schema.Column{CustomFieldValuesColumns[2]},
+				RefColumns: []*schema.Column{CustomFieldsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "custom_field_values_tasks_custom_field_values",
+				Columns:    []*schema.Column{CustomFieldValuesColumns[3]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "customfieldvalue_task_id_field_id",
+				Unique:  true,
+				Columns: []*schema.Column{CustomFieldValuesColumns[3], CustomFieldValuesColumns[2]},
+			},
+		},
+	}
+	// EpicsColumns holds the columns for the "epics" table.
+	EpicsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "target_date", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "board_id", Type: field.TypeString},
+	}
+	// EpicsTable holds the schema information for the "epics" table.
+	EpicsTable = &schema.Table{
+		Name:       "epics",
+		Columns:    EpicsColumns,
+		PrimaryKey: []*schema.Column{EpicsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "epics_boards_epics",
+				Columns:    []*schema.Column{EpicsColumns[5]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// FilesColumns holds the columns for the "files" table.
 	FilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -22,23 +214,310 @@ var (
 		Columns:    FilesColumns,
 		PrimaryKey: []*schema.Column{FilesColumns[0]},
 	}
+	// LabelsColumns holds the columns for the "labels" table.
+	LabelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "color", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "board_id", Type: field.TypeString},
+	}
+	// LabelsTable holds the schema information for the "labels" table.
+	LabelsTable = &schema.Table{
+		Name:       "labels",
+		Columns:    LabelsColumns,
+		PrimaryKey: []*schema.Column{LabelsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "labels_boards_labels",
+				Columns:    []*schema.Column{LabelsColumns[4]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "label_board_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{LabelsColumns[4], LabelsColumns[1]},
+			},
+		},
+	}
+	// MembersColumns holds the columns for the "members" table.
+	MembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "display_name", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "member"}, Default: "member"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "board_id", Type: field.TypeString},
+	}
+	// MembersTable holds the schema information for the "members" table.
+	MembersTable = &schema.Table{
+		Name:       "members",
+		Columns:    MembersColumns,
+		PrimaryKey: []*schema.Column{MembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "members_boards_members",
+				Columns:    []*schema.Column{MembersColumns[5]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "member_board_id_display_name",
+				Unique:  true,
+				Columns: []*schema.Column{MembersColumns[5], MembersColumns[1]},
+			},
+			{
+				Name:    "member_board_id_email",
+				Unique:  true,
+				Columns: []*schema.Column{MembersColumns[5], MembersColumns[2]},
+			},
+		},
+	}
+	// RecurringTasksColumns holds the columns for the "recurring_tasks" table.
+	RecurringTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "assignee_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "label_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "priority", Type: field.TypeEnum, Nullable: true, Enums: []string{"p0", "p1", "p2", "p3"}},
+		{Name: "story_points", Type: field.TypeInt, Nullable: true},
+		{Name: "rule", Type: field.TypeString},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "next_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "board_id", Type: field.TypeString},
+	}
+	// RecurringTasksTable holds the schema information for the "recurring_tasks" table.
+	RecurringTasksTable = &schema.Table{
+		Name:       "recurring_tasks",
+		Columns:    RecurringTasksColumns,
+		PrimaryKey: []*schema.Column{RecurringTasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recurring_tasks_boards_recurring_tasks",
+				Columns:    []*schema.Column{RecurringTasksColumns[13]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recurringtask_next_at",
+				Unique:  false,
+				Columns: []*schema.Column{RecurringTasksColumns[9]},
+			},
+		},
+	}
+	// SprintsColumns holds the columns for the "sprints" table.
+	SprintsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "goal", Type: field.TypeString, Nullable: true},
+		{Name: "start_date", Type: field.TypeTime},
+		{Name: "end_date", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"planned", "active", "closed"}, Default: "planned"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "board_id", Type: field.TypeString},
+	}
+	// SprintsTable holds the schema information for the "sprints" table.
+	SprintsTable = &schema.Table{
+		Name:       "sprints",
+		Columns:    SprintsColumns,
+		PrimaryKey: []*schema.Column{SprintsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sprints_boards_sprints",
+				Columns:    []*schema.Column{SprintsColumns[9]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// StatusChangesColumns holds the columns for the "status_changes" table.
+	StatusChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_status", Type: field.TypeString},
+		{Name: "to_status", Type: field.TypeString},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeString},
+	}
+	// StatusChangesTable holds the schema information for the "status_changes" table.
+	StatusChangesTable = &schema.Table{
+		Name:       "status_changes",
+		Columns:    StatusChangesColumns,
+		PrimaryKey: []*schema.Column{StatusChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "status_changes_tasks_status_changes",
+				Columns:    []*schema.Column{StatusChangesColumns[4]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "statuschange_task_id_changed_at",
+				Unique:  false,
+				Columns: []*schema.Column{StatusChangesColumns[4], StatusChangesColumns[3]},
+			},
+		},
+	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "due_date", Type: field.TypeTime, Nullable: true},
+		{Name: "assignee_id", Type: field.TypeString, Nullable: true},
 		{Name: "assignee_name", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "in_progress", "in_review", "completed"}, Default: "pending"},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "rank", Type: field.TypeString, Default: ""},
+		{Name: "priority", Type: field.TypeEnum, Nullable: true, Enums: []string{"p0", "p1", "p2", "p3"}},
+		{Name: "story_points", Type: field.TypeInt, Nullable: true},
+		{Name: "wip_override_by", Type: field.TypeString, Nullable: true},
+		{Name: "wip_override_status", Type: field.TypeString, Nullable: true},
+		{Name: "wip_override_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "board_id", Type: field.TypeString, Nullable: true},
+		{Name: "epic_id", Type: field.TypeString, Nullable: true},
+		{Name: "sprint_id", Type: field.TypeString, Nullable: true},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
 	TasksTable = &schema.Table{
 		Name:       "tasks",
 		Columns:    TasksColumns,
 		PrimaryKey: []*schema.Column{TasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tasks_boards_tasks",
+				Columns:    []*schema.Column{TasksColumns[18]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_epics_tasks",
+				Columns:    []*schema.Column{TasksColumns[19]},
+				RefColumns: []*schema.Column{EpicsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_sprints_tasks",
+				Columns:    []*schema.Column{TasksColumns[20]},
+				RefColumns: []*schema.Column{SprintsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_tasks_children",
+				Columns:    []*schema.Column{TasksColumns[21]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// TaskTemplatesColumns holds the columns for the "task_templates" table.
+	TaskTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "label_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "default_assignee_id", Type: field.TypeString, Nullable: true},
+		{Name: "subtasks", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "board_id", Type: field.TypeString},
+	}
+	// TaskTemplatesTable holds the schema information for the "task_templates" table.
+	TaskTemplatesTable = &schema.Table{
+		Name:       "task_templates",
+		Columns:    TaskTemplatesColumns,
+		PrimaryKey: []*schema.Column{TaskTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_templates_boards_task_templates",
+				Columns:    []*schema.Column{TaskTemplatesColumns[8]},
+				RefColumns: []*schema.Column{BoardsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// TimersColumns holds the columns for the "timers" table.
+	TimersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "member_id", Type: field.TypeString},
+		{Name: "task_id", Type: field.TypeString},
+	}
+	// TimersTable holds the schema information for the "timers" table.
+	TimersTable = &schema.Table{
+		Name:       "timers",
+		Columns:    TimersColumns,
+		PrimaryKey: []*schema.Column{TimersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "timers_members_timers",
+				Columns:    []*schema.Column{TimersColumns[2]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "timers_tasks_timers",
+				Columns:    []*schema.Column{TimersColumns[3]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// WorklogsColumns holds the columns for the "worklogs" table.
+	WorklogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "duration_minutes", Type: field.TypeInt},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "member_id", Type: field.TypeString},
+		{Name: "task_id", Type: field.TypeString},
+	}
+	// WorklogsTable holds the schema information for the "worklogs" table.
+	WorklogsTable = &schema.Table{
+		Name:       "worklogs",
+		Columns:    WorklogsColumns,
+		PrimaryKey: []*schema.Column{WorklogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "worklogs_members_worklogs",
+				Columns:    []*schema.Column{WorklogsColumns[5]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "worklogs_tasks_worklogs",
+				Columns:    []*schema.Column{WorklogsColumns[6]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "worklog_member_id_date",
+				Unique:  false,
+				Columns: []*schema.Column{WorklogsColumns[5], WorklogsColumns[2]},
+			},
+		},
 	}
 	// TaskFilesColumns holds the columns for the "task_files" table.
 	TaskFilesColumns = []*schema.Column{
@@ -65,15 +544,192 @@ var (
 			},
 		},
 	}
+	// TaskBlocksColumns holds the columns for the "task_blocks" table.
+	TaskBlocksColumns = []*schema.Column{
+		{Name: "task_id", Type: field.TypeString},
+		{Name: "blocked_by_id", Type: field.TypeString},
+	}
+	// TaskBlocksTable holds the schema information for the "task_blocks" table.
+	TaskBlocksTable = &schema.Table{
+		Name:       "task_blocks",
+		Columns:    TaskBlocksColumns,
+		PrimaryKey: []*schema.Column{TaskBlocksColumns[0], TaskBlocksColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_blocks_task_id",
+				Columns:    []*schema.Column{TaskBlocksColumns[0]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_blocks_blocked_by_id",
+				Columns:    []*schema.Column{TaskBlocksColumns[1]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TaskLabelsColumns holds the columns for the "task_labels" table.
+	TaskLabelsColumns = []*schema.Column{
+		{Name: "task_id", Type: field.TypeString},
+		{Name: "label_id", Type: field.TypeString},
+	}
+	// TaskLabelsTable holds the schema information for the "task_labels" table.
+	TaskLabelsTable = &schema.Table{
+		Name:       "task_labels",
+		Columns:    TaskLabelsColumns,
+		PrimaryKey: []*schema.Column{TaskLabelsColumns[0], TaskLabelsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_labels_task_id",
+				Columns:    []*schema.Column{TaskLabelsColumns[0]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_labels_label_id",
+				Columns:    []*schema.Column{TaskLabelsColumns[1]},
+				RefColumns: []*schema.Column{LabelsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TaskAssigneesColumns holds the columns for the "task_assignees" table.
+	TaskAssigneesColumns = []*schema.Column{
+		{Name: "task_id", Type: field.TypeString},
+		{Name: "member_id", Type: field.TypeString},
+	}
+	// TaskAssigneesTable holds the schema information for the "task_assignees" table.
+	TaskAssigneesTable = &schema.Table{
+		Name:       "task_assignees",
+		Columns:    TaskAssigneesColumns,
+		PrimaryKey: []*schema.Column{TaskAssigneesColumns[0], TaskAssigneesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_assignees_task_id",
+				Columns:    []*schema.Column{TaskAssigneesColumns[0]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_assignees_member_id",
+				Columns:    []*schema.Column{TaskAssigneesColumns[1]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TaskWatchersColumns holds the columns for the "task_watchers" table.
+	TaskWatchersColumns = []*schema.Column{
+		{Name: "task_id", Type: field.TypeString},
+		{Name: "member_id", Type: field.TypeString},
+	}
+	// TaskWatchersTable holds the schema information for the "task_watchers" table.
+	TaskWatchersTable = &schema.Table{
+		Name:       "task_watchers",
+		Columns:    TaskWatchersColumns,
+		PrimaryKey: []*schema.Column{TaskWatchersColumns[0], TaskWatchersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_watchers_task_id",
+				Columns:    []*schema.Column{TaskWatchersColumns[0]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_watchers_member_id",
+				Columns:    []*schema.Column{TaskWatchersColumns[1]},
+				RefColumns: []*schema.Column{MembersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TaskTemplateFilesColumns holds the columns for the "task_template_files" table.
+	TaskTemplateFilesColumns = []*schema.Column{
+		{Name: "task_template_id", Type: field.TypeString},
+		{Name: "file_id", Type: field.TypeString},
+	}
+	// TaskTemplateFilesTable holds the schema information for the "task_template_files" table.
+	TaskTemplateFilesTable = &schema.Table{
+		Name:       "task_template_files",
+		Columns:    TaskTemplateFilesColumns,
+		PrimaryKey: []*schema.Column{TaskTemplateFilesColumns[0], TaskTemplateFilesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_template_files_task_template_id",
+				Columns:    []*schema.Column{TaskTemplateFilesColumns[0]},
+				RefColumns: []*schema.Column{TaskTemplatesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "task_template_files_file_id",
+				Columns:    []*schema.Column{TaskTemplateFilesColumns[1]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ActivitiesTable,
+		BoardsTable,
+		ChecklistItemsTable,
+		CommentsTable,
+		CustomFieldsTable,
+		CustomFieldValuesTable,
+		EpicsTable,
 		FilesTable,
+		LabelsTable,
+		MembersTable,
+		RecurringTasksTable,
+		SprintsTable,
+		StatusChangesTable,
 		TasksTable,
+		TaskTemplatesTable,
+		TimersTable,
+		WorklogsTable,
 		TaskFilesTable,
+		TaskBlocksTable,
+		TaskLabelsTable,
+		TaskAssigneesTable,
+		TaskWatchersTable,
+		TaskTemplateFilesTable,
 	}
 )
 
 func init() {
+	ActivitiesTable.ForeignKeys[0].RefTable = TasksTable
+	ChecklistItemsTable.ForeignKeys[0].RefTable = TasksTable
+	CommentsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentsTable.ForeignKeys[1].RefTable = TasksTable
+	CustomFieldsTable.ForeignKeys[0].RefTable = BoardsTable
+	CustomFieldValuesTable.ForeignKeys[0].RefTable = CustomFieldsTable
+	CustomFieldValuesTable.ForeignKeys[1].RefTable = TasksTable
+	EpicsTable.ForeignKeys[0].RefTable = BoardsTable
+	LabelsTable.ForeignKeys[0].RefTable = BoardsTable
+	MembersTable.ForeignKeys[0].RefTable = BoardsTable
+	RecurringTasksTable.ForeignKeys[0].RefTable = BoardsTable
+	SprintsTable.ForeignKeys[0].RefTable = BoardsTable
+	StatusChangesTable.ForeignKeys[0].RefTable = TasksTable
+	TasksTable.ForeignKeys[0].RefTable = BoardsTable
+	TasksTable.ForeignKeys[1].RefTable = EpicsTable
+	TasksTable.ForeignKeys[2].RefTable = SprintsTable
+	TasksTable.ForeignKeys[3].RefTable = TasksTable
+	TaskTemplatesTable.ForeignKeys[0].RefTable = BoardsTable
+	TimersTable.ForeignKeys[0].RefTable = MembersTable
+	TimersTable.ForeignKeys[1].RefTable = TasksTable
+	WorklogsTable.ForeignKeys[0].RefTable = MembersTable
+	WorklogsTable.ForeignKeys[1].RefTable = TasksTable
 	TaskFilesTable.ForeignKeys[0].RefTable = TasksTable
 	TaskFilesTable.ForeignKeys[1].RefTable = FilesTable
+	TaskBlocksTable.ForeignKeys[0].RefTable = TasksTable
+	TaskBlocksTable.ForeignKeys[1].RefTable = TasksTable
+	TaskLabelsTable.ForeignKeys[0].RefTable = TasksTable
+	TaskLabelsTable.ForeignKeys[1].RefTable = LabelsTable
+	TaskAssigneesTable.ForeignKeys[0].RefTable = TasksTable
+	TaskAssigneesTable.ForeignKeys[1].RefTable = MembersTable
+	TaskWatchersTable.ForeignKeys[0].RefTable = TasksTable
+	TaskWatchersTable.ForeignKeys[1].RefTable = MembersTable
+	TaskTemplateFilesTable.ForeignKeys[0].RefTable = TaskTemplatesTable
+	TaskTemplateFilesTable.ForeignKeys[1].RefTable = FilesTable
 }
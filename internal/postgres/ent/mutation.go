@@ -11,9 +11,24 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/comment"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
 )
 
 const (
@@ -25,41 +40,56 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeFile = "File"
-	TypeTask = "Task"
+	TypeActivity         = "Activity"
+	TypeBoard            = "Board"
+	TypeChecklistItem    = "ChecklistItem"
+	TypeComment          = "Comment"
+	TypeCustomField      = "CustomField"
+	TypeCustomFieldValue = "CustomFieldValue"
+	TypeEpic             = "Epic"
+	TypeFile             = "File"
+	TypeLabel            = "Label"
+	TypeMember           = "Member"
+	TypeRecurringTask    = "RecurringTask"
+	TypeSprint           = "Sprint"
+	TypeStatusChange     = "StatusChange"
+	TypeTask             = "Task"
+	TypeTaskTemplate     = "TaskTemplate"
+	TypeTimer            = "Timer"
+	TypeWorklog          = "Worklog"
 )
 
-// FileMutation represents an operation that mutates the File nodes in the graph.
-type FileMutation struct {
+// ActivityMutation represents an operation that mutates the Activity nodes in the graph.
+type ActivityMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	name          *string
-	size          *int64
-	addsize       *int64
-	mime_type     *string
-	uploaded_at   *time.Time
+	actor         *string
+	source        *activity.Source
+	field         *string
+	from_value    *string
+	to_value      *string
+	changed_at    *time.Time
 	clearedFields map[string]struct{}
-	task          map[string]struct{}
-	removedtask   map[string]struct{}
+	task          *string
 	clearedtask   bool
 	done          bool
-	oldValue      func(context.Context) (*File, error)
-	predicates    []predicate.File
+	oldValue      func(context.Context) (*Activity, error)
+	predicates    []predicate.Activity
 }
 
-var _ ent.Mutation = (*FileMutation)(nil)
+var _ ent.Mutation = (*ActivityMutation)(nil)
 
-// fileOption allows management of the mutation configuration using functional options.
-type fileOption func(*FileMutation)
+// activityOption allows management of the mutation configuration using functional options.
+type activityOption func(*ActivityMutation)
 
-// newFileMutation creates new mutation for the File entity.
-func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
-	m := &FileMutation{
+// newActivityMutation creates new mutation for the Activity entity.
+func newActivityMutation(c config, op Op, opts ...activityOption) *ActivityMutation {
+	m := &ActivityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFile,
+		typ:           TypeActivity,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -68,20 +98,20 @@ func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
 	return m
 }
 
-// withFileID sets the ID field of the mutation.
-func withFileID(id string) fileOption {
-	return func(m *FileMutation) {
+// withActivityID sets the ID field of the mutation.
+func withActivityID(id string) activityOption {
+	return func(m *ActivityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *File
+			value *Activity
 		)
-		m.oldValue = func(ctx context.Context) (*File, error) {
+		m.oldValue = func(ctx context.Context) (*Activity, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().File.Get(ctx, id)
+					value, err = m.Client().Activity.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -90,10 +120,10 @@ func withFileID(id string) fileOption {
 	}
 }
 
-// withFile sets the old File of the mutation.
-func withFile(node *File) fileOption {
-	return func(m *FileMutation) {
-		m.oldValue = func(context.Context) (*File, error) {
+// withActivity sets the old Activity of the mutation.
+func withActivity(node *Activity) activityOption {
+	return func(m *ActivityMutation) {
+		m.oldValue = func(context.Context) (*Activity, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -102,7 +132,7 @@ func withFile(node *File) fileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileMutation) Client() *Client {
+func (m ActivityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -110,7 +140,7 @@ func (m FileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FileMutation) Tx() (*Tx, error) {
+func (m ActivityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -120,14 +150,14 @@ func (m FileMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of File entities.
-func (m *FileMutation) SetID(id string) {
+// operation is only accepted on creation of Activity entities.
+func (m *ActivityMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FileMutation) ID() (id string, exists bool) {
+func (m *ActivityMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -138,7 +168,7 @@ func (m *FileMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FileMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ActivityMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -147,239 +177,326 @@ func (m *FileMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Activity.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *FileMutation) SetName(s string) {
-	m.name = &s
+// SetTaskID sets the "task_id" field.
+func (m *ActivityMutation) SetTaskID(s string) {
+	m.task = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FileMutation) Name() (r string, exists bool) {
-	v := m.name
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ActivityMutation) TaskID() (r string, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ActivityMutation) OldTaskID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TaskID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FileMutation) ResetName() {
-	m.name = nil
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ActivityMutation) ResetTaskID() {
+	m.task = nil
 }
 
-// SetSize sets the "size" field.
-func (m *FileMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// SetActor sets the "actor" field.
+func (m *ActivityMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *FileMutation) Size() (r int64, exists bool) {
-	v := m.size
+// Actor returns the value of the "actor" field in the mutation.
+func (m *ActivityMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldSize(ctx context.Context) (v int64, err error) {
+func (m *ActivityMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.Size, nil
+	return oldValue.Actor, nil
 }
 
-// AddSize adds i to the "size" field.
-func (m *FileMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
+// ResetActor resets all changes to the "actor" field.
+func (m *ActivityMutation) ResetActor() {
+	m.actor = nil
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *FileMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
+// SetSource sets the "source" field.
+func (m *ActivityMutation) SetSource(a activity.Source) {
+	m.source = &a
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ActivityMutation) Source() (r activity.Source, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *FileMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// OldSource returns the old "source" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldSource(ctx context.Context) (v activity.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
 }
 
-// SetMimeType sets the "mime_type" field.
-func (m *FileMutation) SetMimeType(s string) {
-	m.mime_type = &s
+// ResetSource resets all changes to the "source" field.
+func (m *ActivityMutation) ResetSource() {
+	m.source = nil
 }
 
-// MimeType returns the value of the "mime_type" field in the mutation.
-func (m *FileMutation) MimeType() (r string, exists bool) {
-	v := m.mime_type
+// SetFieldField sets the "field" field.
+func (m *ActivityMutation) SetFieldField(s string) {
+	m.field = &s
+}
+
+// GetField returns the value of the "field" field in the mutation.
+func (m *ActivityMutation) GetField() (r string, exists bool) {
+	v := m.field
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMimeType returns the old "mime_type" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// GetOldField returns the old "field" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldMimeType(ctx context.Context) (v string, err error) {
+func (m *ActivityMutation) GetOldField(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+		return v, errors.New("GetOldField is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMimeType requires an ID field in the mutation")
+		return v, errors.New("GetOldField requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+		return v, fmt.Errorf("querying old value for GetOldField: %w", err)
 	}
-	return oldValue.MimeType, nil
+	return oldValue.Field, nil
 }
 
-// ResetMimeType resets all changes to the "mime_type" field.
-func (m *FileMutation) ResetMimeType() {
-	m.mime_type = nil
+// ResetFieldField resets all changes to the "field" field.
+func (m *ActivityMutation) ResetFieldField() {
+	m.field = nil
 }
 
-// SetUploadedAt sets the "uploaded_at" field.
-func (m *FileMutation) SetUploadedAt(t time.Time) {
-	m.uploaded_at = &t
+// SetFromValue sets the "from_value" field.
+func (m *ActivityMutation) SetFromValue(s string) {
+	m.from_value = &s
 }
 
-// UploadedAt returns the value of the "uploaded_at" field in the mutation.
-func (m *FileMutation) UploadedAt() (r time.Time, exists bool) {
-	v := m.uploaded_at
+// FromValue returns the value of the "from_value" field in the mutation.
+func (m *ActivityMutation) FromValue() (r string, exists bool) {
+	v := m.from_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUploadedAt returns the old "uploaded_at" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldFromValue returns the old "from_value" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldUploadedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ActivityMutation) OldFromValue(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFromValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadedAt requires an ID field in the mutation")
+		return v, errors.New("OldFromValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFromValue: %w", err)
 	}
-	return oldValue.UploadedAt, nil
+	return oldValue.FromValue, nil
 }
 
-// ResetUploadedAt resets all changes to the "uploaded_at" field.
-func (m *FileMutation) ResetUploadedAt() {
-	m.uploaded_at = nil
+// ClearFromValue clears the value of the "from_value" field.
+func (m *ActivityMutation) ClearFromValue() {
+	m.from_value = nil
+	m.clearedFields[activity.FieldFromValue] = struct{}{}
 }
 
-// AddTaskIDs adds the "task" edge to the Task entity by ids.
-func (m *FileMutation) AddTaskIDs(ids ...string) {
-	if m.task == nil {
-		m.task = make(map[string]struct{})
+// FromValueCleared returns if the "from_value" field was cleared in this mutation.
+func (m *ActivityMutation) FromValueCleared() bool {
+	_, ok := m.clearedFields[activity.FieldFromValue]
+	return ok
+}
+
+// ResetFromValue resets all changes to the "from_value" field.
+func (m *ActivityMutation) ResetFromValue() {
+	m.from_value = nil
+	delete(m.clearedFields, activity.FieldFromValue)
+}
+
+// SetToValue sets the "to_value" field.
+func (m *ActivityMutation) SetToValue(s string) {
+	m.to_value = &s
+}
+
+// ToValue returns the value of the "to_value" field in the mutation.
+func (m *ActivityMutation) ToValue() (r string, exists bool) {
+	v := m.to_value
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.task[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldToValue returns the old "to_value" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldToValue(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToValue is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToValue: %w", err)
+	}
+	return oldValue.ToValue, nil
 }
 
-// ClearTask clears the "task" edge to the Task entity.
-func (m *FileMutation) ClearTask() {
-	m.clearedtask = true
+// ClearToValue clears the value of the "to_value" field.
+func (m *ActivityMutation) ClearToValue() {
+	m.to_value = nil
+	m.clearedFields[activity.FieldToValue] = struct{}{}
 }
 
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *FileMutation) TaskCleared() bool {
-	return m.clearedtask
+// ToValueCleared returns if the "to_value" field was cleared in this mutation.
+func (m *ActivityMutation) ToValueCleared() bool {
+	_, ok := m.clearedFields[activity.FieldToValue]
+	return ok
 }
 
-// RemoveTaskIDs removes the "task" edge to the Task entity by IDs.
-func (m *FileMutation) RemoveTaskIDs(ids ...string) {
-	if m.removedtask == nil {
-		m.removedtask = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.task, ids[i])
-		m.removedtask[ids[i]] = struct{}{}
+// ResetToValue resets all changes to the "to_value" field.
+func (m *ActivityMutation) ResetToValue() {
+	m.to_value = nil
+	delete(m.clearedFields, activity.FieldToValue)
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (m *ActivityMutation) SetChangedAt(t time.Time) {
+	m.changed_at = &t
+}
+
+// ChangedAt returns the value of the "changed_at" field in the mutation.
+func (m *ActivityMutation) ChangedAt() (r time.Time, exists bool) {
+	v := m.changed_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTask returns the removed IDs of the "task" edge to the Task entity.
-func (m *FileMutation) RemovedTaskIDs() (ids []string) {
-	for id := range m.removedtask {
-		ids = append(ids, id)
+// OldChangedAt returns the old "changed_at" field's value of the Activity entity.
+// If the Activity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ActivityMutation) OldChangedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedAt: %w", err)
+	}
+	return oldValue.ChangedAt, nil
+}
+
+// ResetChangedAt resets all changes to the "changed_at" field.
+func (m *ActivityMutation) ResetChangedAt() {
+	m.changed_at = nil
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *ActivityMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[activity.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *ActivityMutation) TaskCleared() bool {
+	return m.clearedtask
 }
 
 // TaskIDs returns the "task" edge IDs in the mutation.
-func (m *FileMutation) TaskIDs() (ids []string) {
-	for id := range m.task {
-		ids = append(ids, id)
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *ActivityMutation) TaskIDs() (ids []string) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
 // ResetTask resets all changes to the "task" edge.
-func (m *FileMutation) ResetTask() {
+func (m *ActivityMutation) ResetTask() {
 	m.task = nil
 	m.clearedtask = false
-	m.removedtask = nil
 }
 
-// Where appends a list predicates to the FileMutation builder.
-func (m *FileMutation) Where(ps ...predicate.File) {
+// Where appends a list predicates to the ActivityMutation builder.
+func (m *ActivityMutation) Where(ps ...predicate.Activity) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ActivityMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.File, len(ps))
+func (m *ActivityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Activity, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -387,36 +504,45 @@ func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileMutation) Op() Op {
+func (m *ActivityMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileMutation) SetOp(op Op) {
+func (m *ActivityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (File).
-func (m *FileMutation) Type() string {
+// Type returns the node type of this mutation (Activity).
+func (m *ActivityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, file.FieldName)
+func (m *ActivityMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.task != nil {
+		fields = append(fields, activity.FieldTaskID)
 	}
-	if m.size != nil {
-		fields = append(fields, file.FieldSize)
+	if m.actor != nil {
+		fields = append(fields, activity.FieldActor)
 	}
-	if m.mime_type != nil {
-		fields = append(fields, file.FieldMimeType)
+	if m.source != nil {
+		fields = append(fields, activity.FieldSource)
 	}
-	if m.uploaded_at != nil {
-		fields = append(fields, file.FieldUploadedAt)
+	if m.field != nil {
+		fields = append(fields, activity.FieldField)
+	}
+	if m.from_value != nil {
+		fields = append(fields, activity.FieldFromValue)
+	}
+	if m.to_value != nil {
+		fields = append(fields, activity.FieldToValue)
+	}
+	if m.changed_at != nil {
+		fields = append(fields, activity.FieldChangedAt)
 	}
 	return fields
 }
@@ -424,16 +550,22 @@ func (m *FileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileMutation) Field(name string) (ent.Value, bool) {
+func (m *ActivityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldName:
-		return m.Name()
-	case file.FieldSize:
-		return m.Size()
-	case file.FieldMimeType:
-		return m.MimeType()
-	case file.FieldUploadedAt:
-		return m.UploadedAt()
+	case activity.FieldTaskID:
+		return m.TaskID()
+	case activity.FieldActor:
+		return m.Actor()
+	case activity.FieldSource:
+		return m.Source()
+	case activity.FieldField:
+		return m.GetField()
+	case activity.FieldFromValue:
+		return m.FromValue()
+	case activity.FieldToValue:
+		return m.ToValue()
+	case activity.FieldChangedAt:
+		return m.ChangedAt()
 	}
 	return nil, false
 }
@@ -441,193 +573,216 @@ func (m *FileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ActivityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case file.FieldName:
-		return m.OldName(ctx)
-	case file.FieldSize:
-		return m.OldSize(ctx)
-	case file.FieldMimeType:
-		return m.OldMimeType(ctx)
-	case file.FieldUploadedAt:
-		return m.OldUploadedAt(ctx)
+	case activity.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case activity.FieldActor:
+		return m.OldActor(ctx)
+	case activity.FieldSource:
+		return m.OldSource(ctx)
+	case activity.FieldField:
+		return m.GetOldField(ctx)
+	case activity.FieldFromValue:
+		return m.OldFromValue(ctx)
+	case activity.FieldToValue:
+		return m.OldToValue(ctx)
+	case activity.FieldChangedAt:
+		return m.OldChangedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown File field %s", name)
+	return nil, fmt.Errorf("unknown Activity field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) SetField(name string, value ent.Value) error {
+func (m *ActivityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldName:
+	case activity.FieldTaskID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTaskID(v)
 		return nil
-	case file.FieldSize:
-		v, ok := value.(int64)
+	case activity.FieldActor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetActor(v)
 		return nil
-	case file.FieldMimeType:
+	case activity.FieldSource:
+		v, ok := value.(activity.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case activity.FieldField:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMimeType(v)
+		m.SetFieldField(v)
 		return nil
-	case file.FieldUploadedAt:
-		v, ok := value.(time.Time)
+	case activity.FieldFromValue:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUploadedAt(v)
+		m.SetFromValue(v)
+		return nil
+	case activity.FieldToValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToValue(v)
+		return nil
+	case activity.FieldChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *FileMutation) AddedFields() []string {
-	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, file.FieldSize)
-	}
-	return fields
+	return fmt.Errorf("unknown Activity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ActivityMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case file.FieldSize:
-		return m.AddedSize()
-	}
+func (m *ActivityMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) AddField(name string, value ent.Value) error {
+func (m *ActivityMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
 	}
-	return fmt.Errorf("unknown File numeric field %s", name)
+	return fmt.Errorf("unknown Activity numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileMutation) ClearedFields() []string {
-	return nil
+func (m *ActivityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(activity.FieldFromValue) {
+		fields = append(fields, activity.FieldFromValue)
+	}
+	if m.FieldCleared(activity.FieldToValue) {
+		fields = append(fields, activity.FieldToValue)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileMutation) FieldCleared(name string) bool {
+func (m *ActivityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown File nullable field %s", name)
+func (m *ActivityMutation) ClearField(name string) error {
+	switch name {
+	case activity.FieldFromValue:
+		m.ClearFromValue()
+		return nil
+	case activity.FieldToValue:
+		m.ClearToValue()
+		return nil
+	}
+	return fmt.Errorf("unknown Activity nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileMutation) ResetField(name string) error {
+func (m *ActivityMutation) ResetField(name string) error {
 	switch name {
-	case file.FieldName:
-		m.ResetName()
+	case activity.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case file.FieldSize:
-		m.ResetSize()
+	case activity.FieldActor:
+		m.ResetActor()
 		return nil
-	case file.FieldMimeType:
-		m.ResetMimeType()
+	case activity.FieldSource:
+		m.ResetSource()
 		return nil
-	case file.FieldUploadedAt:
-		m.ResetUploadedAt()
+	case activity.FieldField:
+		m.ResetFieldField()
+		return nil
+	case activity.FieldFromValue:
+		m.ResetFromValue()
+		return nil
+	case activity.FieldToValue:
+		m.ResetToValue()
+		return nil
+	case activity.FieldChangedAt:
+		m.ResetChangedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown Activity field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileMutation) AddedEdges() []string {
+func (m *ActivityMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.task != nil {
-		edges = append(edges, file.EdgeTask)
+		edges = append(edges, activity.EdgeTask)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileMutation) AddedIDs(name string) []ent.Value {
+func (m *ActivityMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case file.EdgeTask:
-		ids := make([]ent.Value, 0, len(m.task))
-		for id := range m.task {
-			ids = append(ids, id)
+	case activity.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileMutation) RemovedEdges() []string {
+func (m *ActivityMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedtask != nil {
-		edges = append(edges, file.EdgeTask)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case file.EdgeTask:
-		ids := make([]ent.Value, 0, len(m.removedtask))
-		for id := range m.removedtask {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ActivityMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileMutation) ClearedEdges() []string {
+func (m *ActivityMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedtask {
-		edges = append(edges, file.EdgeTask)
+		edges = append(edges, activity.EdgeTask)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileMutation) EdgeCleared(name string) bool {
+func (m *ActivityMutation) EdgeCleared(name string) bool {
 	switch name {
-	case file.EdgeTask:
+	case activity.EdgeTask:
 		return m.clearedtask
 	}
 	return false
@@ -635,57 +790,82 @@ func (m *FileMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileMutation) ClearEdge(name string) error {
+func (m *ActivityMutation) ClearEdge(name string) error {
 	switch name {
+	case activity.EdgeTask:
+		m.ClearTask()
+		return nil
 	}
-	return fmt.Errorf("unknown File unique edge %s", name)
+	return fmt.Errorf("unknown Activity unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileMutation) ResetEdge(name string) error {
+func (m *ActivityMutation) ResetEdge(name string) error {
 	switch name {
-	case file.EdgeTask:
+	case activity.EdgeTask:
 		m.ResetTask()
 		return nil
 	}
-	return fmt.Errorf("unknown File edge %s", name)
+	return fmt.Errorf("unknown Activity edge %s", name)
 }
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// BoardMutation represents an operation that mutates the Board nodes in the graph.
+type BoardMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	title         *string
-	description   *string
-	due_date      *time.Time
-	assignee_name *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	completed_at  *time.Time
-	status        *task.Status
-	clearedFields map[string]struct{}
-	files         map[string]struct{}
-	removedfiles  map[string]struct{}
-	clearedfiles  bool
-	done          bool
-	oldValue      func(context.Context) (*Task, error)
-	predicates    []predicate.Task
-}
-
-var _ ent.Mutation = (*TaskMutation)(nil)
-
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
-
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+	op                     Op
+	typ                    string
+	id                     *string
+	name                   *string
+	statuses               *[]string
+	appendstatuses         []string
+	transitions            *map[string][]string
+	wip_limits             *map[string]int
+	assignee_wip_limits    *map[string]int
+	created_at             *time.Time
+	updated_at             *time.Time
+	archived_at            *time.Time
+	clearedFields          map[string]struct{}
+	tasks                  map[string]struct{}
+	removedtasks           map[string]struct{}
+	clearedtasks           bool
+	labels                 map[string]struct{}
+	removedlabels          map[string]struct{}
+	clearedlabels          bool
+	sprints                map[string]struct{}
+	removedsprints         map[string]struct{}
+	clearedsprints         bool
+	epics                  map[string]struct{}
+	removedepics           map[string]struct{}
+	clearedepics           bool
+	members                map[string]struct{}
+	removedmembers         map[string]struct{}
+	clearedmembers         bool
+	recurring_tasks        map[string]struct{}
+	removedrecurring_tasks map[string]struct{}
+	clearedrecurring_tasks bool
+	task_templates         map[string]struct{}
+	removedtask_templates  map[string]struct{}
+	clearedtask_templates  bool
+	custom_fields          map[string]struct{}
+	removedcustom_fields   map[string]struct{}
+	clearedcustom_fields   bool
+	done                   bool
+	oldValue               func(context.Context) (*Board, error)
+	predicates             []predicate.Board
+}
+
+var _ ent.Mutation = (*BoardMutation)(nil)
+
+// boardOption allows management of the mutation configuration using functional options.
+type boardOption func(*BoardMutation)
+
+// newBoardMutation creates new mutation for the Board entity.
+func newBoardMutation(c config, op Op, opts ...boardOption) *BoardMutation {
+	m := &BoardMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeBoard,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -694,20 +874,20 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id string) taskOption {
-	return func(m *TaskMutation) {
+// withBoardID sets the ID field of the mutation.
+func withBoardID(id string) boardOption {
+	return func(m *BoardMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Task
+			value *Board
 		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
+		m.oldValue = func(ctx context.Context) (*Board, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Task.Get(ctx, id)
+					value, err = m.Client().Board.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -716,10 +896,10 @@ func withTaskID(id string) taskOption {
 	}
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
+// withBoard sets the old Board of the mutation.
+func withBoard(node *Board) boardOption {
+	return func(m *BoardMutation) {
+		m.oldValue = func(context.Context) (*Board, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -728,7 +908,7 @@ func withTask(node *Task) taskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
+func (m BoardMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -736,7 +916,7 @@ func (m TaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
+func (m BoardMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -746,14 +926,14 @@ func (m TaskMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Task entities.
-func (m *TaskMutation) SetID(id string) {
+// operation is only accepted on creation of Board entities.
+func (m *BoardMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id string, exists bool) {
+func (m *BoardMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -764,7 +944,7 @@ func (m *TaskMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BoardMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -773,415 +953,15075 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Board.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *TaskMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the "name" field.
+func (m *BoardMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *TaskMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the value of the "name" field in the mutation.
+func (m *BoardMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *BoardMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *TaskMutation) ResetTitle() {
-	m.title = nil
+// ResetName resets all changes to the "name" field.
+func (m *BoardMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *TaskMutation) SetDescription(s string) {
-	m.description = &s
+// SetStatuses sets the "statuses" field.
+func (m *BoardMutation) SetStatuses(s []string) {
+	m.statuses = &s
+	m.appendstatuses = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TaskMutation) Description() (r string, exists bool) {
-	v := m.description
+// Statuses returns the value of the "statuses" field in the mutation.
+func (m *BoardMutation) Statuses() (r []string, exists bool) {
+	v := m.statuses
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldStatuses returns the old "statuses" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *BoardMutation) OldStatuses(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatuses is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldStatuses requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatuses: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Statuses, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *TaskMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[task.FieldDescription] = struct{}{}
+// AppendStatuses adds s to the "statuses" field.
+func (m *BoardMutation) AppendStatuses(s []string) {
+	m.appendstatuses = append(m.appendstatuses, s...)
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TaskMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[task.FieldDescription]
+// AppendedStatuses returns the list of values that were appended to the "statuses" field in this mutation.
+func (m *BoardMutation) AppendedStatuses() ([]string, bool) {
+	if len(m.appendstatuses) == 0 {
+		return nil, false
+	}
+	return m.appendstatuses, true
+}
+
+// ClearStatuses clears the value of the "statuses" field.
+func (m *BoardMutation) ClearStatuses() {
+	m.statuses = nil
+	m.appendstatuses = nil
+	m.clearedFields[board.FieldStatuses] = struct{}{}
+}
+
+// StatusesCleared returns if the "statuses" field was cleared in this mutation.
+func (m *BoardMutation) StatusesCleared() bool {
+	_, ok := m.clearedFields[board.FieldStatuses]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *TaskMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, task.FieldDescription)
+// ResetStatuses resets all changes to the "statuses" field.
+func (m *BoardMutation) ResetStatuses() {
+	m.statuses = nil
+	m.appendstatuses = nil
+	delete(m.clearedFields, board.FieldStatuses)
 }
 
-// SetDueDate sets the "due_date" field.
-func (m *TaskMutation) SetDueDate(t time.Time) {
-	m.due_date = &t
+// SetTransitions sets the "transitions" field.
+func (m *BoardMutation) SetTransitions(value map[string][]string) {
+	m.transitions = &value
 }
 
-// DueDate returns the value of the "due_date" field in the mutation.
-func (m *TaskMutation) DueDate() (r time.Time, exists bool) {
-	v := m.due_date
+// Transitions returns the value of the "transitions" field in the mutation.
+func (m *BoardMutation) Transitions() (r map[string][]string, exists bool) {
+	v := m.transitions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDueDate returns the old "due_date" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTransitions returns the old "transitions" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDueDate(ctx context.Context) (v *time.Time, err error) {
+func (m *BoardMutation) OldTransitions(ctx context.Context) (v map[string][]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldTransitions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDueDate requires an ID field in the mutation")
+		return v, errors.New("OldTransitions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldTransitions: %w", err)
 	}
-	return oldValue.DueDate, nil
+	return oldValue.Transitions, nil
 }
 
-// ClearDueDate clears the value of the "due_date" field.
-func (m *TaskMutation) ClearDueDate() {
-	m.due_date = nil
-	m.clearedFields[task.FieldDueDate] = struct{}{}
+// ClearTransitions clears the value of the "transitions" field.
+func (m *BoardMutation) ClearTransitions() {
+	m.transitions = nil
+	m.clearedFields[board.FieldTransitions] = struct{}{}
 }
 
-// DueDateCleared returns if the "due_date" field was cleared in this mutation.
-func (m *TaskMutation) DueDateCleared() bool {
-	_, ok := m.clearedFields[task.FieldDueDate]
+// TransitionsCleared returns if the "transitions" field was cleared in this mutation.
+func (m *BoardMutation) TransitionsCleared() bool {
+	_, ok := m.clearedFields[board.FieldTransitions]
 	return ok
 }
 
-// ResetDueDate resets all changes to the "due_date" field.
-func (m *TaskMutation) ResetDueDate() {
-	m.due_date = nil
-	delete(m.clearedFields, task.FieldDueDate)
+// ResetTransitions resets all changes to the "transitions" field.
+func (m *BoardMutation) ResetTransitions() {
+	m.transitions = nil
+	delete(m.clearedFields, board.FieldTransitions)
 }
 
-// SetAssigneeName sets the "assignee_name" field.
-func (m *TaskMutation) SetAssigneeName(s string) {
-	m.assignee_name = &s
+// SetWipLimits sets the "wip_limits" field.
+func (m *BoardMutation) SetWipLimits(value map[string]int) {
+	m.wip_limits = &value
 }
 
-// AssigneeName returns the value of the "assignee_name" field in the mutation.
-func (m *TaskMutation) AssigneeName() (r string, exists bool) {
-	v := m.assignee_name
+// WipLimits returns the value of the "wip_limits" field in the mutation.
+func (m *BoardMutation) WipLimits() (r map[string]int, exists bool) {
+	v := m.wip_limits
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAssigneeName returns the old "assignee_name" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldWipLimits returns the old "wip_limits" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldAssigneeName(ctx context.Context) (v *string, err error) {
+func (m *BoardMutation) OldWipLimits(ctx context.Context) (v map[string]int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssigneeName is only allowed on UpdateOne operations")
+		return v, errors.New("OldWipLimits is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssigneeName requires an ID field in the mutation")
+		return v, errors.New("OldWipLimits requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssigneeName: %w", err)
+		return v, fmt.Errorf("querying old value for OldWipLimits: %w", err)
 	}
-	return oldValue.AssigneeName, nil
+	return oldValue.WipLimits, nil
 }
 
-// ClearAssigneeName clears the value of the "assignee_name" field.
-func (m *TaskMutation) ClearAssigneeName() {
-	m.assignee_name = nil
-	m.clearedFields[task.FieldAssigneeName] = struct{}{}
+// ClearWipLimits clears the value of the "wip_limits" field.
+func (m *BoardMutation) ClearWipLimits() {
+	m.wip_limits = nil
+	m.clearedFields[board.FieldWipLimits] = struct{}{}
 }
 
-// AssigneeNameCleared returns if the "assignee_name" field was cleared in this mutation.
-func (m *TaskMutation) AssigneeNameCleared() bool {
-	_, ok := m.clearedFields[task.FieldAssigneeName]
+// WipLimitsCleared returns if the "wip_limits" field was cleared in this mutation.
+func (m *BoardMutation) WipLimitsCleared() bool {
+	_, ok := m.clearedFields[board.FieldWipLimits]
 	return ok
 }
 
-// ResetAssigneeName resets all changes to the "assignee_name" field.
-func (m *TaskMutation) ResetAssigneeName() {
-	m.assignee_name = nil
-	delete(m.clearedFields, task.FieldAssigneeName)
+// ResetWipLimits resets all changes to the "wip_limits" field.
+func (m *BoardMutation) ResetWipLimits() {
+	m.wip_limits = nil
+	delete(m.clearedFields, board.FieldWipLimits)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TaskMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAssigneeWipLimits sets the "assignee_wip_limits" field.
+func (m *BoardMutation) SetAssigneeWipLimits(value map[string]int) {
+	m.assignee_wip_limits = &value
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AssigneeWipLimits returns the value of the "assignee_wip_limits" field in the mutation.
+func (m *BoardMutation) AssigneeWipLimits() (r map[string]int, exists bool) {
+	v := m.assignee_wip_limits
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldAssigneeWipLimits returns the old "assignee_wip_limits" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BoardMutation) OldAssigneeWipLimits(ctx context.Context) (v map[string]int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAssigneeWipLimits is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAssigneeWipLimits requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAssigneeWipLimits: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.AssigneeWipLimits, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TaskMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearAssigneeWipLimits clears the value of the "assignee_wip_limits" field.
+func (m *BoardMutation) ClearAssigneeWipLimits() {
+	m.assignee_wip_limits = nil
+	m.clearedFields[board.FieldAssigneeWipLimits] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TaskMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AssigneeWipLimitsCleared returns if the "assignee_wip_limits" field was cleared in this mutation.
+func (m *BoardMutation) AssigneeWipLimitsCleared() bool {
+	_, ok := m.clearedFields[board.FieldAssigneeWipLimits]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
+// ResetAssigneeWipLimits resets all changes to the "assignee_wip_limits" field.
+func (m *BoardMutation) ResetAssigneeWipLimits() {
+	m.assignee_wip_limits = nil
+	delete(m.clearedFields, board.FieldAssigneeWipLimits)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BoardMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BoardMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BoardMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BoardMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BoardMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BoardMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BoardMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BoardMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *BoardMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *BoardMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Board entity.
+// If the Board object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BoardMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *BoardMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[board.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *BoardMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[board.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *BoardMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, board.FieldArchivedAt)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *BoardMutation) AddTaskIDs(ids ...string) {
+	if m.tasks == nil {
+		m.tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *BoardMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *BoardMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *BoardMutation) RemoveTaskIDs(ids ...string) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *BoardMutation) RemovedTasksIDs() (ids []string) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *BoardMutation) TasksIDs() (ids []string) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *BoardMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by ids.
+func (m *BoardMutation) AddLabelIDs(ids ...string) {
+	if m.labels == nil {
+		m.labels = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.labels[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLabels clears the "labels" edge to the Label entity.
+func (m *BoardMutation) ClearLabels() {
+	m.clearedlabels = true
+}
+
+// LabelsCleared reports if the "labels" edge to the Label entity was cleared.
+func (m *BoardMutation) LabelsCleared() bool {
+	return m.clearedlabels
+}
+
+// RemoveLabelIDs removes the "labels" edge to the Label entity by IDs.
+func (m *BoardMutation) RemoveLabelIDs(ids ...string) {
+	if m.removedlabels == nil {
+		m.removedlabels = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.labels, ids[i])
+		m.removedlabels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLabels returns the removed IDs of the "labels" edge to the Label entity.
+func (m *BoardMutation) RemovedLabelsIDs() (ids []string) {
+	for id := range m.removedlabels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LabelsIDs returns the "labels" edge IDs in the mutation.
+func (m *BoardMutation) LabelsIDs() (ids []string) {
+	for id := range m.labels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLabels resets all changes to the "labels" edge.
+func (m *BoardMutation) ResetLabels() {
+	m.labels = nil
+	m.clearedlabels = false
+	m.removedlabels = nil
+}
+
+// AddSprintIDs adds the "sprints" edge to the Sprint entity by ids.
+func (m *BoardMutation) AddSprintIDs(ids ...string) {
+	if m.sprints == nil {
+		m.sprints = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.sprints[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSprints clears the "sprints" edge to the Sprint entity.
+func (m *BoardMutation) ClearSprints() {
+	m.clearedsprints = true
+}
+
+// SprintsCleared reports if the "sprints" edge to the Sprint entity was cleared.
+func (m *BoardMutation) SprintsCleared() bool {
+	return m.clearedsprints
+}
+
+// RemoveSprintIDs removes the "sprints" edge to the Sprint entity by IDs.
+func (m *BoardMutation) RemoveSprintIDs(ids ...string) {
+	if m.removedsprints == nil {
+		m.removedsprints = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.sprints, ids[i])
+		m.removedsprints[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSprints returns the removed IDs of the "sprints" edge to the Sprint entity.
+func (m *BoardMutation) RemovedSprintsIDs() (ids []string) {
+	for id := range m.removedsprints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SprintsIDs returns the "sprints" edge IDs in the mutation.
+func (m *BoardMutation) SprintsIDs() (ids []string) {
+	for id := range m.sprints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSprints resets all changes to the "sprints" edge.
+func (m *BoardMutation) ResetSprints() {
+	m.sprints = nil
+	m.clearedsprints = false
+	m.removedsprints = nil
+}
+
+// AddEpicIDs adds the "epics" edge to the Epic entity by ids.
+func (m *BoardMutation) AddEpicIDs(ids ...string) {
+	if m.epics == nil {
+		m.epics = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.epics[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEpics clears the "epics" edge to the Epic entity.
+func (m *BoardMutation) ClearEpics() {
+	m.clearedepics = true
+}
+
+// EpicsCleared reports if the "epics" edge to the Epic entity was cleared.
+func (m *BoardMutation) EpicsCleared() bool {
+	return m.clearedepics
+}
+
+// RemoveEpicIDs removes the "epics" edge to the Epic entity by IDs.
+func (m *BoardMutation) RemoveEpicIDs(ids ...string) {
+	if m.removedepics == nil {
+		m.removedepics = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.epics, ids[i])
+		m.removedepics[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEpics returns the removed IDs of the "epics" edge to the Epic entity.
+func (m *BoardMutation) RemovedEpicsIDs() (ids []string) {
+	for id := range m.removedepics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EpicsIDs returns the "epics" edge IDs in the mutation.
+func (m *BoardMutation) EpicsIDs() (ids []string) {
+	for id := range m.epics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEpics resets all changes to the "epics" edge.
+func (m *BoardMutation) ResetEpics() {
+	m.epics = nil
+	m.clearedepics = false
+	m.removedepics = nil
+}
+
+// AddMemberIDs adds the "members" edge to the Member entity by ids.
+func (m *BoardMutation) AddMemberIDs(ids ...string) {
+	if m.members == nil {
+		m.members = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the Member entity.
+func (m *BoardMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the Member entity was cleared.
+func (m *BoardMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the Member entity by IDs.
+func (m *BoardMutation) RemoveMemberIDs(ids ...string) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the Member entity.
+func (m *BoardMutation) RemovedMembersIDs() (ids []string) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *BoardMutation) MembersIDs() (ids []string) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *BoardMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddRecurringTaskIDs adds the "recurring_tasks" edge to the RecurringTask entity by ids.
+func (m *BoardMutation) AddRecurringTaskIDs(ids ...string) {
+	if m.recurring_tasks == nil {
+		m.recurring_tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.recurring_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecurringTasks clears the "recurring_tasks" edge to the RecurringTask entity.
+func (m *BoardMutation) ClearRecurringTasks() {
+	m.clearedrecurring_tasks = true
+}
+
+// RecurringTasksCleared reports if the "recurring_tasks" edge to the RecurringTask entity was cleared.
+func (m *BoardMutation) RecurringTasksCleared() bool {
+	return m.clearedrecurring_tasks
+}
+
+// RemoveRecurringTaskIDs removes the "recurring_tasks" edge to the RecurringTask entity by IDs.
+func (m *BoardMutation) RemoveRecurringTaskIDs(ids ...string) {
+	if m.removedrecurring_tasks == nil {
+		m.removedrecurring_tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.recurring_tasks, ids[i])
+		m.removedrecurring_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecurringTasks returns the removed IDs of the "recurring_tasks" edge to the RecurringTask entity.
+func (m *BoardMutation) RemovedRecurringTasksIDs() (ids []string) {
+	for id := range m.removedrecurring_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecurringTasksIDs returns the "recurring_tasks" edge IDs in the mutation.
+func (m *BoardMutation) RecurringTasksIDs() (ids []string) {
+	for id := range m.recurring_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecurringTasks resets all changes to the "recurring_tasks" edge.
+func (m *BoardMutation) ResetRecurringTasks() {
+	m.recurring_tasks = nil
+	m.clearedrecurring_tasks = false
+	m.removedrecurring_tasks = nil
+}
+
+// AddTaskTemplateIDs adds the "task_templates" edge to the TaskTemplate entity by ids.
+func (m *BoardMutation) AddTaskTemplateIDs(ids ...string) {
+	if m.task_templates == nil {
+		m.task_templates = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.task_templates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTaskTemplates clears the "task_templates" edge to the TaskTemplate entity.
+func (m *BoardMutation) ClearTaskTemplates() {
+	m.clearedtask_templates = true
+}
+
+// TaskTemplatesCleared reports if the "task_templates" edge to the TaskTemplate entity was cleared.
+func (m *BoardMutation) TaskTemplatesCleared() bool {
+	return m.clearedtask_templates
+}
+
+// RemoveTaskTemplateIDs removes the "task_templates" edge to the TaskTemplate entity by IDs.
+func (m *BoardMutation) RemoveTaskTemplateIDs(ids ...string) {
+	if m.removedtask_templates == nil {
+		m.removedtask_templates = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.task_templates, ids[i])
+		m.removedtask_templates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTaskTemplates returns the removed IDs of the "task_templates" edge to the TaskTemplate entity.
+func (m *BoardMutation) RemovedTaskTemplatesIDs() (ids []string) {
+	for id := range m.removedtask_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TaskTemplatesIDs returns the "task_templates" edge IDs in the mutation.
+func (m *BoardMutation) TaskTemplatesIDs() (ids []string) {
+	for id := range m.task_templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTaskTemplates resets all changes to the "task_templates" edge.
+func (m *BoardMutation) ResetTaskTemplates() {
+	m.task_templates = nil
+	m.clearedtask_templates = false
+	m.removedtask_templates = nil
+}
+
+// AddCustomFieldIDs adds the "custom_fields" edge to the CustomField entity by ids.
+func (m *BoardMutation) AddCustomFieldIDs(ids ...string) {
+	if m.custom_fields == nil {
+		m.custom_fields = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.custom_fields[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCustomFields clears the "custom_fields" edge to the CustomField entity.
+func (m *BoardMutation) ClearCustomFields() {
+	m.clearedcustom_fields = true
+}
+
+// CustomFieldsCleared reports if the "custom_fields" edge to the CustomField entity was cleared.
+func (m *BoardMutation) CustomFieldsCleared() bool {
+	return m.clearedcustom_fields
+}
+
+// RemoveCustomFieldIDs removes the "custom_fields" edge to the CustomField entity by IDs.
+func (m *BoardMutation) RemoveCustomFieldIDs(ids ...string) {
+	if m.removedcustom_fields == nil {
+		m.removedcustom_fields = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.custom_fields, ids[i])
+		m.removedcustom_fields[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCustomFields returns the removed IDs of the "custom_fields" edge to the CustomField entity.
+func (m *BoardMutation) RemovedCustomFieldsIDs() (ids []string) {
+	for id := range m.removedcustom_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CustomFieldsIDs returns the "custom_fields" edge IDs in the mutation.
+func (m *BoardMutation) CustomFieldsIDs() (ids []string) {
+	for id := range m.custom_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCustomFields resets all changes to the "custom_fields" edge.
+func (m *BoardMutation) ResetCustomFields() {
+	m.custom_fields = nil
+	m.clearedcustom_fields = false
+	m.removedcustom_fields = nil
+}
+
+// Where appends a list predicates to the BoardMutation builder.
+func (m *BoardMutation) Where(ps ...predicate.Board) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BoardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BoardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Board, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BoardMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BoardMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Board).
+func (m *BoardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BoardMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, board.FieldName)
+	}
+	if m.statuses != nil {
+		fields = append(fields, board.FieldStatuses)
+	}
+	if m.transitions != nil {
+		fields = append(fields, board.FieldTransitions)
+	}
+	if m.wip_limits != nil {
+		fields = append(fields, board.FieldWipLimits)
+	}
+	if m.assignee_wip_limits != nil {
+		fields = append(fields, board.FieldAssigneeWipLimits)
+	}
+	if m.created_at != nil {
+		fields = append(fields, board.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, board.FieldUpdatedAt)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, board.FieldArchivedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BoardMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case board.FieldName:
+		return m.Name()
+	case board.FieldStatuses:
+		return m.Statuses()
+	case board.FieldTransitions:
+		return m.Transitions()
+	case board.FieldWipLimits:
+		return m.WipLimits()
+	case board.FieldAssigneeWipLimits:
+		return m.AssigneeWipLimits()
+	case board.FieldCreatedAt:
+		return m.CreatedAt()
+	case board.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case board.FieldArchivedAt:
+		return m.ArchivedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BoardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case board.FieldName:
+		return m.OldName(ctx)
+	case board.FieldStatuses:
+		return m.OldStatuses(ctx)
+	case board.FieldTransitions:
+		return m.OldTransitions(ctx)
+	case board.FieldWipLimits:
+		return m.OldWipLimits(ctx)
+	case board.FieldAssigneeWipLimits:
+		return m.OldAssigneeWipLimits(ctx)
+	case board.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case board.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case board.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Board field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BoardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case board.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case board.FieldStatuses:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatuses(v)
+		return nil
+	case board.FieldTransitions:
+		v, ok := value.(map[string][]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransitions(v)
+		return nil
+	case board.FieldWipLimits:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWipLimits(v)
+		return nil
+	case board.FieldAssigneeWipLimits:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssigneeWipLimits(v)
+		return nil
+	case board.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case board.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case board.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Board field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BoardMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BoardMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BoardMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Board numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BoardMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(board.FieldStatuses) {
+		fields = append(fields, board.FieldStatuses)
+	}
+	if m.FieldCleared(board.FieldTransitions) {
+		fields = append(fields, board.FieldTransitions)
+	}
+	if m.FieldCleared(board.FieldWipLimits) {
+		fields = append(fields, board.FieldWipLimits)
+	}
+	if m.FieldCleared(board.FieldAssigneeWipLimits) {
+		fields = append(fields, board.FieldAssigneeWipLimits)
+	}
+	if m.FieldCleared(board.FieldArchivedAt) {
+		fields = append(fields, board.FieldArchivedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BoardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BoardMutation) ClearField(name string) error {
+	switch name {
+	case board.FieldStatuses:
+		m.ClearStatuses()
+		return nil
+	case board.FieldTransitions:
+		m.ClearTransitions()
+		return nil
+	case board.FieldWipLimits:
+		m.ClearWipLimits()
+		return nil
+	case board.FieldAssigneeWipLimits:
+		m.ClearAssigneeWipLimits()
+		return nil
+	case board.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Board nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BoardMutation) ResetField(name string) error {
+	switch name {
+	case board.FieldName:
+		m.ResetName()
+		return nil
+	case board.FieldStatuses:
+		m.ResetStatuses()
+		return nil
+	case board.FieldTransitions:
+		m.ResetTransitions()
+		return nil
+	case board.FieldWipLimits:
+		m.ResetWipLimits()
+		return nil
+	case board.FieldAssigneeWipLimits:
+		m.ResetAssigneeWipLimits()
+		return nil
+	case board.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case board.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case board.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Board field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BoardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.tasks != nil {
+		edges = append(edges, board.EdgeTasks)
+	}
+	if m.labels != nil {
+		edges = append(edges, board.EdgeLabels)
+	}
+	if m.sprints != nil {
+		edges = append(edges, board.EdgeSprints)
+	}
+	if m.epics != nil {
+		edges = append(edges, board.EdgeEpics)
+	}
+	if m.members != nil {
+		edges = append(edges, board.EdgeMembers)
+	}
+	if m.recurring_tasks != nil {
+		edges = append(edges, board.EdgeRecurringTasks)
+	}
+	if m.task_templates != nil {
+		edges = append(edges, board.EdgeTaskTemplates)
+	}
+	if m.custom_fields != nil {
+		edges = append(edges, board.EdgeCustomFields)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BoardMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case board.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeLabels:
+		ids := make([]ent.Value, 0, len(m.labels))
+		for id := range m.labels {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeSprints:
+		ids := make([]ent.Value, 0, len(m.sprints))
+		for id := range m.sprints {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeEpics:
+		ids := make([]ent.Value, 0, len(m.epics))
+		for id := range m.epics {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeRecurringTasks:
+		ids := make([]ent.Value, 0, len(m.recurring_tasks))
+		for id := range m.recurring_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeTaskTemplates:
+		ids := make([]ent.Value, 0, len(m.task_templates))
+		for id := range m.task_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeCustomFields:
+		ids := make([]ent.Value, 0, len(m.custom_fields))
+		for id := range m.custom_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BoardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedtasks != nil {
+		edges = append(edges, board.EdgeTasks)
+	}
+	if m.removedlabels != nil {
+		edges = append(edges, board.EdgeLabels)
+	}
+	if m.removedsprints != nil {
+		edges = append(edges, board.EdgeSprints)
+	}
+	if m.removedepics != nil {
+		edges = append(edges, board.EdgeEpics)
+	}
+	if m.removedmembers != nil {
+		edges = append(edges, board.EdgeMembers)
+	}
+	if m.removedrecurring_tasks != nil {
+		edges = append(edges, board.EdgeRecurringTasks)
+	}
+	if m.removedtask_templates != nil {
+		edges = append(edges, board.EdgeTaskTemplates)
+	}
+	if m.removedcustom_fields != nil {
+		edges = append(edges, board.EdgeCustomFields)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BoardMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case board.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeLabels:
+		ids := make([]ent.Value, 0, len(m.removedlabels))
+		for id := range m.removedlabels {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeSprints:
+		ids := make([]ent.Value, 0, len(m.removedsprints))
+		for id := range m.removedsprints {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeEpics:
+		ids := make([]ent.Value, 0, len(m.removedepics))
+		for id := range m.removedepics {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeRecurringTasks:
+		ids := make([]ent.Value, 0, len(m.removedrecurring_tasks))
+		for id := range m.removedrecurring_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeTaskTemplates:
+		ids := make([]ent.Value, 0, len(m.removedtask_templates))
+		for id := range m.removedtask_templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case board.EdgeCustomFields:
+		ids := make([]ent.Value, 0, len(m.removedcustom_fields))
+		for id := range m.removedcustom_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BoardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedtasks {
+		edges = append(edges, board.EdgeTasks)
+	}
+	if m.clearedlabels {
+		edges = append(edges, board.EdgeLabels)
+	}
+	if m.clearedsprints {
+		edges = append(edges, board.EdgeSprints)
+	}
+	if m.clearedepics {
+		edges = append(edges, board.EdgeEpics)
+	}
+	if m.clearedmembers {
+		edges = append(edges, board.EdgeMembers)
+	}
+	if m.clearedrecurring_tasks {
+		edges = append(edges, board.EdgeRecurringTasks)
+	}
+	if m.clearedtask_templates {
+		edges = append(edges, board.EdgeTaskTemplates)
+	}
+	if m.clearedcustom_fields {
+		edges = append(edges, board.EdgeCustomFields)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BoardMutation) EdgeCleared(name string) bool {
+	switch name {
+	case board.EdgeTasks:
+		return m.clearedtasks
+	case board.EdgeLabels:
+		return m.clearedlabels
+	case board.EdgeSprints:
+		return m.clearedsprints
+	case board.EdgeEpics:
+		return m.clearedepics
+	case board.EdgeMembers:
+		return m.clearedmembers
+	case board.EdgeRecurringTasks:
+		return m.clearedrecurring_tasks
+	case board.EdgeTaskTemplates:
+		return m.clearedtask_templates
+	case board.EdgeCustomFields:
+		return m.clearedcustom_fields
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BoardMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Board unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BoardMutation) ResetEdge(name string) error {
+	switch name {
+	case board.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	case board.EdgeLabels:
+		m.ResetLabels()
+		return nil
+	case board.EdgeSprints:
+		m.ResetSprints()
+		return nil
+	case board.EdgeEpics:
+		m.ResetEpics()
+		return nil
+	case board.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case board.EdgeRecurringTasks:
+		m.ResetRecurringTasks()
+		return nil
+	case board.EdgeTaskTemplates:
+		m.ResetTaskTemplates()
+		return nil
+	case board.EdgeCustomFields:
+		m.ResetCustomFields()
+		return nil
+	}
+	return fmt.Errorf("unknown Board edge %s", name)
+}
+
+// ChecklistItemMutation represents an operation that mutates the ChecklistItem nodes in the graph.
+type ChecklistItemMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	text          *string
+	_done         *bool
+	position      *int
+	addposition   *int
+	clearedFields map[string]struct{}
+	task          *string
+	clearedtask   bool
+	done          bool
+	oldValue      func(context.Context) (*ChecklistItem, error)
+	predicates    []predicate.ChecklistItem
+}
+
+var _ ent.Mutation = (*ChecklistItemMutation)(nil)
+
+// checklistitemOption allows management of the mutation configuration using functional options.
+type checklistitemOption func(*ChecklistItemMutation)
+
+// newChecklistItemMutation creates new mutation for the ChecklistItem entity.
+func newChecklistItemMutation(c config, op Op, opts ...checklistitemOption) *ChecklistItemMutation {
+	m := &ChecklistItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChecklistItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withChecklistItemID sets the ID field of the mutation.
+func withChecklistItemID(id string) checklistitemOption {
+	return func(m *ChecklistItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ChecklistItem
+		)
+		m.oldValue = func(ctx context.Context) (*ChecklistItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ChecklistItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withChecklistItem sets the old ChecklistItem of the mutation.
+func withChecklistItem(node *ChecklistItem) checklistitemOption {
+	return func(m *ChecklistItemMutation) {
+		m.oldValue = func(context.Context) (*ChecklistItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ChecklistItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ChecklistItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ChecklistItem entities.
+func (m *ChecklistItemMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ChecklistItemMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ChecklistItemMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ChecklistItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *ChecklistItemMutation) SetTaskID(s string) {
+	m.task = &s
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ChecklistItemMutation) TaskID() (r string, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the ChecklistItem entity.
+// If the ChecklistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistItemMutation) OldTaskID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ChecklistItemMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// SetText sets the "text" field.
+func (m *ChecklistItemMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *ChecklistItemMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the ChecklistItem entity.
+// If the ChecklistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistItemMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *ChecklistItemMutation) ResetText() {
+	m.text = nil
+}
+
+// SetDone sets the "done" field.
+func (m *ChecklistItemMutation) SetDone(b bool) {
+	m._done = &b
+}
+
+// Done returns the value of the "done" field in the mutation.
+func (m *ChecklistItemMutation) Done() (r bool, exists bool) {
+	v := m._done
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDone returns the old "done" field's value of the ChecklistItem entity.
+// If the ChecklistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistItemMutation) OldDone(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDone: %w", err)
+	}
+	return oldValue.Done, nil
+}
+
+// ResetDone resets all changes to the "done" field.
+func (m *ChecklistItemMutation) ResetDone() {
+	m._done = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *ChecklistItemMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *ChecklistItemMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the ChecklistItem entity.
+// If the ChecklistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChecklistItemMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *ChecklistItemMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *ChecklistItemMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *ChecklistItemMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *ChecklistItemMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[checklistitem.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *ChecklistItemMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *ChecklistItemMutation) TaskIDs() (ids []string) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *ChecklistItemMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// Where appends a list predicates to the ChecklistItemMutation builder.
+func (m *ChecklistItemMutation) Where(ps ...predicate.ChecklistItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChecklistItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChecklistItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChecklistItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChecklistItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChecklistItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ChecklistItem).
+func (m *ChecklistItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChecklistItemMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.task != nil {
+		fields = append(fields, checklistitem.FieldTaskID)
+	}
+	if m.text != nil {
+		fields = append(fields, checklistitem.FieldText)
+	}
+	if m._done != nil {
+		fields = append(fields, checklistitem.FieldDone)
+	}
+	if m.position != nil {
+		fields = append(fields, checklistitem.FieldPosition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChecklistItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case checklistitem.FieldTaskID:
+		return m.TaskID()
+	case checklistitem.FieldText:
+		return m.Text()
+	case checklistitem.FieldDone:
+		return m.Done()
+	case checklistitem.FieldPosition:
+		return m.Position()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChecklistItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case checklistitem.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case checklistitem.FieldText:
+		return m.OldText(ctx)
+	case checklistitem.FieldDone:
+		return m.OldDone(ctx)
+	case checklistitem.FieldPosition:
+		return m.OldPosition(ctx)
+	}
+	return nil, fmt.Errorf("unknown ChecklistItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChecklistItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case checklistitem.FieldTaskID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case checklistitem.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case checklistitem.FieldDone:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDone(v)
+		return nil
+	case checklistitem.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChecklistItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, checklistitem.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChecklistItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case checklistitem.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChecklistItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case checklistitem.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChecklistItemMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChecklistItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChecklistItemMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChecklistItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChecklistItemMutation) ResetField(name string) error {
+	switch name {
+	case checklistitem.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case checklistitem.FieldText:
+		m.ResetText()
+		return nil
+	case checklistitem.FieldDone:
+		m.ResetDone()
+		return nil
+	case checklistitem.FieldPosition:
+		m.ResetPosition()
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChecklistItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, checklistitem.EdgeTask)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChecklistItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case checklistitem.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChecklistItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChecklistItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChecklistItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, checklistitem.EdgeTask)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChecklistItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case checklistitem.EdgeTask:
+		return m.clearedtask
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChecklistItemMutation) ClearEdge(name string) error {
+	switch name {
+	case checklistitem.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChecklistItemMutation) ResetEdge(name string) error {
+	switch name {
+	case checklistitem.EdgeTask:
+		m.ResetTask()
+		return nil
+	}
+	return fmt.Errorf("unknown ChecklistItem edge %s", name)
+}
+
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	author         *string
+	body           *string
+	mentions       *[]string
+	appendmentions []string
+	created_at     *time.Time
+	updated_at     *time.Time
+	edited_at      *time.Time
+	deleted_at     *time.Time
+	clearedFields  map[string]struct{}
+	task           *string
+	clearedtask    bool
+	parent         *string
+	clearedparent  bool
+	replies        map[string]struct{}
+	removedreplies map[string]struct{}
+	clearedreplies bool
+	done           bool
+	oldValue       func(context.Context) (*Comment, error)
+	predicates     []predicate.Comment
+}
+
+var _ ent.Mutation = (*CommentMutation)(nil)
+
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
+
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeComment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id string) commentOption {
+	return func(m *CommentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Comment
+		)
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Comment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Comment entities.
+func (m *CommentMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *CommentMutation) SetTaskID(s string) {
+	m.task = &s
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *CommentMutation) TaskID() (r string, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldTaskID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *CommentMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *CommentMutation) SetParentID(s string) {
+	m.parent = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CommentMutation) ParentID() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CommentMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[comment.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CommentMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CommentMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, comment.FieldParentID)
+}
+
+// SetAuthor sets the "author" field.
+func (m *CommentMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *CommentMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *CommentMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetBody sets the "body" field.
+func (m *CommentMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *CommentMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *CommentMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetMentions sets the "mentions" field.
+func (m *CommentMutation) SetMentions(s []string) {
+	m.mentions = &s
+	m.appendmentions = nil
+}
+
+// Mentions returns the value of the "mentions" field in the mutation.
+func (m *CommentMutation) Mentions() (r []string, exists bool) {
+	v := m.mentions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMentions returns the old "mentions" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldMentions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMentions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMentions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMentions: %w", err)
+	}
+	return oldValue.Mentions, nil
+}
+
+// AppendMentions adds s to the "mentions" field.
+func (m *CommentMutation) AppendMentions(s []string) {
+	m.appendmentions = append(m.appendmentions, s...)
+}
+
+// AppendedMentions returns the list of values that were appended to the "mentions" field in this mutation.
+func (m *CommentMutation) AppendedMentions() ([]string, bool) {
+	if len(m.appendmentions) == 0 {
+		return nil, false
+	}
+	return m.appendmentions, true
+}
+
+// ClearMentions clears the value of the "mentions" field.
+func (m *CommentMutation) ClearMentions() {
+	m.mentions = nil
+	m.appendmentions = nil
+	m.clearedFields[comment.FieldMentions] = struct{}{}
+}
+
+// MentionsCleared returns if the "mentions" field was cleared in this mutation.
+func (m *CommentMutation) MentionsCleared() bool {
+	_, ok := m.clearedFields[comment.FieldMentions]
+	return ok
+}
+
+// ResetMentions resets all changes to the "mentions" field.
+func (m *CommentMutation) ResetMentions() {
+	m.mentions = nil
+	m.appendmentions = nil
+	delete(m.clearedFields, comment.FieldMentions)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CommentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CommentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *CommentMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *CommentMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *CommentMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[comment.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *CommentMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *CommentMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, comment.FieldEditedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CommentMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CommentMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CommentMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[comment.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CommentMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CommentMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, comment.FieldDeletedAt)
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *CommentMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[comment.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *CommentMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) TaskIDs() (ids []string) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *CommentMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (m *CommentMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[comment.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
+func (m *CommentMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CommentMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by ids.
+func (m *CommentMutation) AddReplyIDs(ids ...string) {
+	if m.replies == nil {
+		m.replies = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the Comment entity.
+func (m *CommentMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the Comment entity was cleared.
+func (m *CommentMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the Comment entity by IDs.
+func (m *CommentMutation) RemoveReplyIDs(ids ...string) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Comment entity.
+func (m *CommentMutation) RemovedRepliesIDs() (ids []string) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *CommentMutation) RepliesIDs() (ids []string) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *CommentMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.task != nil {
+		fields = append(fields, comment.FieldTaskID)
+	}
+	if m.parent != nil {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.author != nil {
+		fields = append(fields, comment.FieldAuthor)
+	}
+	if m.body != nil {
+		fields = append(fields, comment.FieldBody)
+	}
+	if m.mentions != nil {
+		fields = append(fields, comment.FieldMentions)
+	}
+	if m.created_at != nil {
+		fields = append(fields, comment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, comment.FieldUpdatedAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, comment.FieldEditedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldTaskID:
+		return m.TaskID()
+	case comment.FieldParentID:
+		return m.ParentID()
+	case comment.FieldAuthor:
+		return m.Author()
+	case comment.FieldBody:
+		return m.Body()
+	case comment.FieldMentions:
+		return m.Mentions()
+	case comment.FieldCreatedAt:
+		return m.CreatedAt()
+	case comment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case comment.FieldEditedAt:
+		return m.EditedAt()
+	case comment.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case comment.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case comment.FieldParentID:
+		return m.OldParentID(ctx)
+	case comment.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case comment.FieldBody:
+		return m.OldBody(ctx)
+	case comment.FieldMentions:
+		return m.OldMentions(ctx)
+	case comment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case comment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case comment.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case comment.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Comment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldTaskID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case comment.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case comment.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case comment.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case comment.FieldMentions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMentions(v)
+		return nil
+	case comment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case comment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case comment.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case comment.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Comment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(comment.FieldParentID) {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.FieldCleared(comment.FieldMentions) {
+		fields = append(fields, comment.FieldMentions)
+	}
+	if m.FieldCleared(comment.FieldEditedAt) {
+		fields = append(fields, comment.FieldEditedAt)
+	}
+	if m.FieldCleared(comment.FieldDeletedAt) {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentMutation) ClearField(name string) error {
+	switch name {
+	case comment.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case comment.FieldMentions:
+		m.ClearMentions()
+		return nil
+	case comment.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case comment.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentMutation) ResetField(name string) error {
+	switch name {
+	case comment.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case comment.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case comment.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case comment.FieldBody:
+		m.ResetBody()
+		return nil
+	case comment.FieldMentions:
+		m.ResetMentions()
+		return nil
+	case comment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case comment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case comment.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case comment.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.task != nil {
+		edges = append(edges, comment.EdgeTask)
+	}
+	if m.parent != nil {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.replies != nil {
+		edges = append(edges, comment.EdgeReplies)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedreplies != nil {
+		edges = append(edges, comment.EdgeReplies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtask {
+		edges = append(edges, comment.EdgeTask)
+	}
+	if m.clearedparent {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.clearedreplies {
+		edges = append(edges, comment.EdgeReplies)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case comment.EdgeTask:
+		return m.clearedtask
+	case comment.EdgeParent:
+		return m.clearedparent
+	case comment.EdgeReplies:
+		return m.clearedreplies
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentMutation) ClearEdge(name string) error {
+	switch name {
+	case comment.EdgeTask:
+		m.ClearTask()
+		return nil
+	case comment.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentMutation) ResetEdge(name string) error {
+	switch name {
+	case comment.EdgeTask:
+		m.ResetTask()
+		return nil
+	case comment.EdgeParent:
+		m.ResetParent()
+		return nil
+	case comment.EdgeReplies:
+		m.ResetReplies()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment edge %s", name)
+}
+
+// CustomFieldMutation represents an operation that mutates the CustomField nodes in the graph.
+type CustomFieldMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	_type         *customfield.Type
+	options       *[]string
+	appendoptions []string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	board         *string
+	clearedboard  bool
+	values        map[int]struct{}
+	removedvalues map[int]struct{}
+	clearedvalues bool
+	done          bool
+	oldValue      func(context.Context) (*CustomField, error)
+	predicates    []predicate.CustomField
+}
+
+var _ ent.Mutation = (*CustomFieldMutation)(nil)
+
+// customfieldOption allows management of the mutation configuration using functional options.
+type customfieldOption func(*CustomFieldMutation)
+
+// newCustomFieldMutation creates new mutation for the CustomField entity.
+func newCustomFieldMutation(c config, op Op, opts ...customfieldOption) *CustomFieldMutation {
+	m := &CustomFieldMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCustomField,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCustomFieldID sets the ID field of the mutation.
+func withCustomFieldID(id string) customfieldOption {
+	return func(m *CustomFieldMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CustomField
+		)
+		m.oldValue = func(ctx context.Context) (*CustomField, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CustomField.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCustomField sets the old CustomField of the mutation.
+func withCustomField(node *CustomField) customfieldOption {
+	return func(m *CustomFieldMutation) {
+		m.oldValue = func(context.Context) (*CustomField, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CustomFieldMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CustomFieldMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CustomField entities.
+func (m *CustomFieldMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CustomFieldMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CustomFieldMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CustomField.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *CustomFieldMutation) SetBoardID(s string) {
+	m.board = &s
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *CustomFieldMutation) BoardID() (r string, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the CustomField entity.
+// If the CustomField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomFieldMutation) OldBoardID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *CustomFieldMutation) ResetBoardID() {
+	m.board = nil
+}
+
+// SetName sets the "name" field.
+func (m *CustomFieldMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CustomFieldMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CustomField entity.
+// If the CustomField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomFieldMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CustomFieldMutation) ResetName() {
+	m.name = nil
+}
+
+// SetType sets the "type" field.
+func (m *CustomFieldMutation) SetType(c customfield.Type) {
+	m._type = &c
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *CustomFieldMutation) GetType() (r customfield.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the CustomField entity.
+// If the CustomField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomFieldMutation) OldType(ctx context.Context) (v customfield.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *CustomFieldMutation) ResetType() {
+	m._type = nil
+}
+
+// SetOptions sets the "options" field.
+func (m *CustomFieldMutation) SetOptions(s []string) {
+	m.options = &s
+	m.appendoptions = nil
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *CustomFieldMutation) Options() (r []string, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the CustomField entity.
+// If the CustomField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomFieldMutation) OldOptions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// AppendOptions adds s to the "options" field.
+func (m *CustomFieldMutation) AppendOptions(s []string) {
+	m.appendoptions = append(m.appendoptions, s...)
+}
+
+// AppendedOptions returns the list of values that were appended to the "options" field in this mutation.
+func (m *CustomFieldMutation) AppendedOptions() ([]string, bool) {
+	if len(m.appendoptions) == 0 {
+		return nil, false
+	}
+	return m.appendoptions, true
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *CustomFieldMutation) ClearOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	m.clearedFields[customfield.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *CustomFieldMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[customfield.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *CustomFieldMutation) ResetOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	delete(m.clearedFields, customfield.FieldOptions)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CustomFieldMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CustomFieldMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CustomField entity.
+// If the CustomField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomFieldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CustomFieldMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *CustomFieldMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[customfield.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *CustomFieldMutation) BoardCleared() bool {
+	return m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *CustomFieldMutation) BoardIDs() (ids []string) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *CustomFieldMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// AddValueIDs adds the "values" edge to the CustomFieldValue entity by ids.
+func (m *CustomFieldMutation) AddValueIDs(ids ...int) {
+	if m.values == nil {
+		m.values = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.values[ids[i]] = struct{}{}
+	}
+}
+
+// ClearValues clears the "values" edge to the CustomFieldValue entity.
+func (m *CustomFieldMutation) ClearValues() {
+	m.clearedvalues = true
+}
+
+// ValuesCleared reports if the "values" edge to the CustomFieldValue entity was cleared.
+func (m *CustomFieldMutation) ValuesCleared() bool {
+	return m.clearedvalues
+}
+
+// RemoveValueIDs removes the "values" edge to the CustomFieldValue entity by IDs.
+func (m *CustomFieldMutation) RemoveValueIDs(ids ...int) {
+	if m.removedvalues == nil {
+		m.removedvalues = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.values, ids[i])
+		m.removedvalues[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedValues returns the removed IDs of the "values" edge to the CustomFieldValue entity.
+func (m *CustomFieldMutation) RemovedValuesIDs() (ids []int) {
+	for id := range m.removedvalues {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ValuesIDs returns the "values" edge IDs in the mutation.
+func (m *CustomFieldMutation) ValuesIDs() (ids []int) {
+	for id := range m.values {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetValues resets all changes to the "values" edge.
+func (m *CustomFieldMutation) ResetValues() {
+	m.values = nil
+	m.clearedvalues = false
+	m.removedvalues = nil
+}
+
+// Where appends a list predicates to the CustomFieldMutation builder.
+func (m *CustomFieldMutation) Where(ps ...predicate.CustomField) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CustomFieldMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CustomFieldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CustomField, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CustomFieldMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CustomFieldMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CustomField).
+func (m *CustomFieldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CustomFieldMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.board != nil {
+		fields = append(fields, customfield.FieldBoardID)
+	}
+	if m.name != nil {
+		fields = append(fields, customfield.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, customfield.FieldType)
+	}
+	if m.options != nil {
+		fields = append(fields, customfield.FieldOptions)
+	}
+	if m.created_at != nil {
+		fields = append(fields, customfield.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CustomFieldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case customfield.FieldBoardID:
+		return m.BoardID()
+	case customfield.FieldName:
+		return m.Name()
+	case customfield.FieldType:
+		return m.GetType()
+	case customfield.FieldOptions:
+		return m.Options()
+	case customfield.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CustomFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case customfield.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case customfield.FieldName:
+		return m.OldName(ctx)
+	case customfield.FieldType:
+		return m.OldType(ctx)
+	case customfield.FieldOptions:
+		return m.OldOptions(ctx)
+	case customfield.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CustomField field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CustomFieldMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case customfield.FieldBoardID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case customfield.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case customfield.FieldType:
+		v, ok := value.(customfield.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case customfield.FieldOptions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case customfield.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CustomField field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CustomFieldMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CustomFieldMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CustomFieldMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CustomField numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CustomFieldMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(customfield.FieldOptions) {
+		fields = append(fields, customfield.FieldOptions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CustomFieldMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CustomFieldMutation) ClearField(name string) error {
+	switch name {
+	case customfield.FieldOptions:
+		m.ClearOptions()
+		return nil
+	}
+	return fmt.Errorf("unknown CustomField nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CustomFieldMutation) ResetField(name string) error {
+	switch name {
+	case customfield.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case customfield.FieldName:
+		m.ResetName()
+		return nil
+	case customfield.FieldType:
+		m.ResetType()
+		return nil
+	case customfield.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case customfield.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CustomField field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CustomFieldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.board != nil {
+		edges = append(edges, customfield.EdgeBoard)
+	}
+	if m.values != nil {
+		edges = append(edges, customfield.EdgeValues)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CustomFieldMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case customfield.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case customfield.EdgeValues:
+		ids := make([]ent.Value, 0, len(m.values))
+		for id := range m.values {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CustomFieldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedvalues != nil {
+		edges = append(edges, customfield.EdgeValues)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CustomFieldMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case customfield.EdgeValues:
+		ids := make([]ent.Value, 0, len(m.removedvalues))
+		for id := range m.removedvalues {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CustomFieldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedboard {
+		edges = append(edges, customfield.EdgeBoard)
+	}
+	if m.clearedvalues {
+		edges = append(edges, customfield.EdgeValues)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CustomFieldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case customfield.EdgeBoard:
+		return m.clearedboard
+	case customfield.EdgeValues:
+		return m.clearedvalues
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CustomFieldMutation) ClearEdge(name string) error {
+	switch name {
+	case customfield.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	}
+	return fmt.Errorf("unknown CustomField unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CustomFieldMutation) ResetEdge(name string) error {
+	switch name {
+	case customfield.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case customfield.EdgeValues:
+		m.ResetValues()
+		return nil
+	}
+	return fmt.Errorf("unknown CustomField edge %s", name)
+}
+
+// CustomFieldValueMutation represents an operation that mutates the CustomFieldValue nodes in the graph.
+type CustomFieldValueMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	value               *string
+	clearedFields       map[string]struct{}
+	task                *string
+	clearedtask         bool
+	custom_field        *string
+	clearedcustom_field bool
+	done                bool
+	oldValue            func(context.Context) (*CustomFieldValue, error)
+	predicates          []predicate.CustomFieldValue
+}
+
+var _ ent.Mutation = (*CustomFieldValueMutation)(nil)
+
+// customfieldvalueOption allows management of the mutation configuration using functional options.
+type customfieldvalueOption func(*CustomFieldValueMutation)
+
+// newCustomFieldValueMutation creates new mutation for the CustomFieldValue entity.
+func newCustomFieldValueMutation(c config, op Op, opts ...customfieldvalueOption) *CustomFieldValueMutation {
+	m := &CustomFieldValueMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCustomFieldValue,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCustomFieldValueID sets the ID field of the mutation.
+func withCustomFieldValueID(id int) customfieldvalueOption {
+	return func(m *CustomFieldValueMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CustomFieldValue
+		)
+		m.oldValue = func(ctx context.Context) (*CustomFieldValue, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CustomFieldValue.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCustomFieldValue sets the old CustomFieldValue of the mutation.
+func withCustomFieldValue(node *CustomFieldValue) customfieldvalueOption {
+	return func(m *CustomFieldValueMutation) {
+		m.oldValue = func(context.Context) (*CustomFieldValue, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CustomFieldValueMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CustomFieldValueMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CustomFieldValueMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CustomFieldValueMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CustomFieldValue.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *CustomFieldValueMutation) SetTaskID(s string) {
+	m.task = &s
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *CustomFieldValueMutation) TaskID() (r string, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the CustomFieldValue entity.
+// If the CustomFieldValue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomFieldValueMutation) OldTaskID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *CustomFieldValueMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// SetFieldID sets the "field_id" field.
+func (m *CustomFieldValueMutation) SetFieldID(s string) {
+	m.custom_field = &s
+}
+
+// FieldID returns the value of the "field_id" field in the mutation.
+func (m *CustomFieldValueMutation) FieldID() (r string, exists bool) {
+	v := m.custom_field
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldID returns the old "field_id" field's value of the CustomFieldValue entity.
+// If the CustomFieldValue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomFieldValueMutation) OldFieldID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldID: %w", err)
+	}
+	return oldValue.FieldID, nil
+}
+
+// ResetFieldID resets all changes to the "field_id" field.
+func (m *CustomFieldValueMutation) ResetFieldID() {
+	m.custom_field = nil
+}
+
+// SetValue sets the "value" field.
+func (m *CustomFieldValueMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *CustomFieldValueMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the CustomFieldValue entity.
+// If the CustomFieldValue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CustomFieldValueMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *CustomFieldValueMutation) ResetValue() {
+	m.value = nil
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *CustomFieldValueMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[customfieldvalue.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *CustomFieldValueMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *CustomFieldValueMutation) TaskIDs() (ids []string) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *CustomFieldValueMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// SetCustomFieldID sets the "custom_field" edge to the CustomField entity by id.
+func (m *CustomFieldValueMutation) SetCustomFieldID(id string) {
+	m.custom_field = &id
+}
+
+// ClearCustomField clears the "custom_field" edge to the CustomField entity.
+func (m *CustomFieldValueMutation) ClearCustomField() {
+	m.clearedcustom_field = true
+	m.clearedFields[customfieldvalue.FieldFieldID] = struct{}{}
+}
+
+// CustomFieldCleared reports if the "custom_field" edge to the CustomField entity was cleared.
+func (m *CustomFieldValueMutation) CustomFieldCleared() bool {
+	return m.clearedcustom_field
+}
+
+// CustomFieldID returns the "custom_field" edge ID in the mutation.
+func (m *CustomFieldValueMutation) CustomFieldID() (id string, exists bool) {
+	if m.custom_field != nil {
+		return *m.custom_field, true
+	}
+	return
+}
+
+// CustomFieldIDs returns the "custom_field" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CustomFieldID instead. It exists only for internal usage by the builders.
+func (m *CustomFieldValueMutation) CustomFieldIDs() (ids []string) {
+	if id := m.custom_field; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCustomField resets all changes to the "custom_field" edge.
+func (m *CustomFieldValueMutation) ResetCustomField() {
+	m.custom_field = nil
+	m.clearedcustom_field = false
+}
+
+// Where appends a list predicates to the CustomFieldValueMutation builder.
+func (m *CustomFieldValueMutation) Where(ps ...predicate.CustomFieldValue) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CustomFieldValueMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CustomFieldValueMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CustomFieldValue, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CustomFieldValueMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CustomFieldValueMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CustomFieldValue).
+func (m *CustomFieldValueMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CustomFieldValueMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.task != nil {
+		fields = append(fields, customfieldvalue.FieldTaskID)
+	}
+	if m.custom_field != nil {
+		fields = append(fields, customfieldvalue.FieldFieldID)
+	}
+	if m.value != nil {
+		fields = append(fields, customfieldvalue.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CustomFieldValueMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case customfieldvalue.FieldTaskID:
+		return m.TaskID()
+	case customfieldvalue.FieldFieldID:
+		return m.FieldID()
+	case customfieldvalue.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CustomFieldValueMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case customfieldvalue.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case customfieldvalue.FieldFieldID:
+		return m.OldFieldID(ctx)
+	case customfieldvalue.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown CustomFieldValue field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CustomFieldValueMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case customfieldvalue.FieldTaskID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case customfieldvalue.FieldFieldID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldID(v)
+		return nil
+	case customfieldvalue.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CustomFieldValue field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CustomFieldValueMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CustomFieldValueMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CustomFieldValueMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CustomFieldValue numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CustomFieldValueMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CustomFieldValueMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CustomFieldValueMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CustomFieldValue nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CustomFieldValueMutation) ResetField(name string) error {
+	switch name {
+	case customfieldvalue.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case customfieldvalue.FieldFieldID:
+		m.ResetFieldID()
+		return nil
+	case customfieldvalue.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown CustomFieldValue field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CustomFieldValueMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, customfieldvalue.EdgeTask)
+	}
+	if m.custom_field != nil {
+		edges = append(edges, customfieldvalue.EdgeCustomField)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CustomFieldValueMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case customfieldvalue.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case customfieldvalue.EdgeCustomField:
+		if id := m.custom_field; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CustomFieldValueMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CustomFieldValueMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CustomFieldValueMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, customfieldvalue.EdgeTask)
+	}
+	if m.clearedcustom_field {
+		edges = append(edges, customfieldvalue.EdgeCustomField)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CustomFieldValueMutation) EdgeCleared(name string) bool {
+	switch name {
+	case customfieldvalue.EdgeTask:
+		return m.clearedtask
+	case customfieldvalue.EdgeCustomField:
+		return m.clearedcustom_field
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CustomFieldValueMutation) ClearEdge(name string) error {
+	switch name {
+	case customfieldvalue.EdgeTask:
+		m.ClearTask()
+		return nil
+	case customfieldvalue.EdgeCustomField:
+		m.ClearCustomField()
+		return nil
+	}
+	return fmt.Errorf("unknown CustomFieldValue unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CustomFieldValueMutation) ResetEdge(name string) error {
+	switch name {
+	case customfieldvalue.EdgeTask:
+		m.ResetTask()
+		return nil
+	case customfieldvalue.EdgeCustomField:
+		m.ResetCustomField()
+		return nil
+	}
+	return fmt.Errorf("unknown CustomFieldValue edge %s", name)
+}
+
+// EpicMutation represents an operation that mutates the Epic nodes in the graph.
+type EpicMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	description   *string
+	target_date   *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	board         *string
+	clearedboard  bool
+	tasks         map[string]struct{}
+	removedtasks  map[string]struct{}
+	clearedtasks  bool
+	done          bool
+	oldValue      func(context.Context) (*Epic, error)
+	predicates    []predicate.Epic
+}
+
+var _ ent.Mutation = (*EpicMutation)(nil)
+
+// epicOption allows management of the mutation configuration using functional options.
+type epicOption func(*EpicMutation)
+
+// newEpicMutation creates new mutation for the Epic entity.
+func newEpicMutation(c config, op Op, opts ...epicOption) *EpicMutation {
+	m := &EpicMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEpic,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEpicID sets the ID field of the mutation.
+func withEpicID(id string) epicOption {
+	return func(m *EpicMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Epic
+		)
+		m.oldValue = func(ctx context.Context) (*Epic, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Epic.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEpic sets the old Epic of the mutation.
+func withEpic(node *Epic) epicOption {
+	return func(m *EpicMutation) {
+		m.oldValue = func(context.Context) (*Epic, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EpicMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EpicMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Epic entities.
+func (m *EpicMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EpicMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EpicMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Epic.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *EpicMutation) SetBoardID(s string) {
+	m.board = &s
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *EpicMutation) BoardID() (r string, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the Epic entity.
+// If the Epic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpicMutation) OldBoardID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *EpicMutation) ResetBoardID() {
+	m.board = nil
+}
+
+// SetName sets the "name" field.
+func (m *EpicMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EpicMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Epic entity.
+// If the Epic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpicMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EpicMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EpicMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EpicMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Epic entity.
+// If the Epic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpicMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EpicMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[epic.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EpicMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[epic.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EpicMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, epic.FieldDescription)
+}
+
+// SetTargetDate sets the "target_date" field.
+func (m *EpicMutation) SetTargetDate(t time.Time) {
+	m.target_date = &t
+}
+
+// TargetDate returns the value of the "target_date" field in the mutation.
+func (m *EpicMutation) TargetDate() (r time.Time, exists bool) {
+	v := m.target_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetDate returns the old "target_date" field's value of the Epic entity.
+// If the Epic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpicMutation) OldTargetDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetDate: %w", err)
+	}
+	return oldValue.TargetDate, nil
+}
+
+// ResetTargetDate resets all changes to the "target_date" field.
+func (m *EpicMutation) ResetTargetDate() {
+	m.target_date = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EpicMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EpicMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Epic entity.
+// If the Epic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EpicMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EpicMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *EpicMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[epic.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *EpicMutation) BoardCleared() bool {
+	return m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *EpicMutation) BoardIDs() (ids []string) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *EpicMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *EpicMutation) AddTaskIDs(ids ...string) {
+	if m.tasks == nil {
+		m.tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *EpicMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *EpicMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *EpicMutation) RemoveTaskIDs(ids ...string) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *EpicMutation) RemovedTasksIDs() (ids []string) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *EpicMutation) TasksIDs() (ids []string) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *EpicMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// Where appends a list predicates to the EpicMutation builder.
+func (m *EpicMutation) Where(ps ...predicate.Epic) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EpicMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EpicMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Epic, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EpicMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EpicMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Epic).
+func (m *EpicMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EpicMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.board != nil {
+		fields = append(fields, epic.FieldBoardID)
+	}
+	if m.name != nil {
+		fields = append(fields, epic.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, epic.FieldDescription)
+	}
+	if m.target_date != nil {
+		fields = append(fields, epic.FieldTargetDate)
+	}
+	if m.created_at != nil {
+		fields = append(fields, epic.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EpicMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case epic.FieldBoardID:
+		return m.BoardID()
+	case epic.FieldName:
+		return m.Name()
+	case epic.FieldDescription:
+		return m.Description()
+	case epic.FieldTargetDate:
+		return m.TargetDate()
+	case epic.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EpicMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case epic.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case epic.FieldName:
+		return m.OldName(ctx)
+	case epic.FieldDescription:
+		return m.OldDescription(ctx)
+	case epic.FieldTargetDate:
+		return m.OldTargetDate(ctx)
+	case epic.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Epic field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EpicMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case epic.FieldBoardID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case epic.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case epic.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case epic.FieldTargetDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetDate(v)
+		return nil
+	case epic.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Epic field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EpicMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EpicMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EpicMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Epic numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EpicMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(epic.FieldDescription) {
+		fields = append(fields, epic.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EpicMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EpicMutation) ClearField(name string) error {
+	switch name {
+	case epic.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Epic nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EpicMutation) ResetField(name string) error {
+	switch name {
+	case epic.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case epic.FieldName:
+		m.ResetName()
+		return nil
+	case epic.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case epic.FieldTargetDate:
+		m.ResetTargetDate()
+		return nil
+	case epic.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Epic field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EpicMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.board != nil {
+		edges = append(edges, epic.EdgeBoard)
+	}
+	if m.tasks != nil {
+		edges = append(edges, epic.EdgeTasks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EpicMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case epic.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case epic.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EpicMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtasks != nil {
+		edges = append(edges, epic.EdgeTasks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EpicMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case epic.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EpicMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedboard {
+		edges = append(edges, epic.EdgeBoard)
+	}
+	if m.clearedtasks {
+		edges = append(edges, epic.EdgeTasks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EpicMutation) EdgeCleared(name string) bool {
+	switch name {
+	case epic.EdgeBoard:
+		return m.clearedboard
+	case epic.EdgeTasks:
+		return m.clearedtasks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EpicMutation) ClearEdge(name string) error {
+	switch name {
+	case epic.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	}
+	return fmt.Errorf("unknown Epic unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EpicMutation) ResetEdge(name string) error {
+	switch name {
+	case epic.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case epic.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	}
+	return fmt.Errorf("unknown Epic edge %s", name)
+}
+
+// FileMutation represents an operation that mutates the File nodes in the graph.
+type FileMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	name            *string
+	size            *int64
+	addsize         *int64
+	mime_type       *string
+	uploaded_at     *time.Time
+	clearedFields   map[string]struct{}
+	task            map[string]struct{}
+	removedtask     map[string]struct{}
+	clearedtask     bool
+	template        map[string]struct{}
+	removedtemplate map[string]struct{}
+	clearedtemplate bool
+	done            bool
+	oldValue        func(context.Context) (*File, error)
+	predicates      []predicate.File
+}
+
+var _ ent.Mutation = (*FileMutation)(nil)
+
+// fileOption allows management of the mutation configuration using functional options.
+type fileOption func(*FileMutation)
+
+// newFileMutation creates new mutation for the File entity.
+func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
+	m := &FileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileID sets the ID field of the mutation.
+func withFileID(id string) fileOption {
+	return func(m *FileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *File
+		)
+		m.oldValue = func(ctx context.Context) (*File, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().File.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFile sets the old File of the mutation.
+func withFile(node *File) fileOption {
+	return func(m *FileMutation) {
+		m.oldValue = func(context.Context) (*File, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of File entities.
+func (m *FileMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *FileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSize sets the "size" field.
+func (m *FileMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *FileMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *FileMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *FileMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *FileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *FileMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *FileMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *FileMutation) ResetMimeType() {
+	m.mime_type = nil
+}
+
+// SetUploadedAt sets the "uploaded_at" field.
+func (m *FileMutation) SetUploadedAt(t time.Time) {
+	m.uploaded_at = &t
+}
+
+// UploadedAt returns the value of the "uploaded_at" field in the mutation.
+func (m *FileMutation) UploadedAt() (r time.Time, exists bool) {
+	v := m.uploaded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadedAt returns the old "uploaded_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUploadedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadedAt: %w", err)
+	}
+	return oldValue.UploadedAt, nil
+}
+
+// ResetUploadedAt resets all changes to the "uploaded_at" field.
+func (m *FileMutation) ResetUploadedAt() {
+	m.uploaded_at = nil
+}
+
+// AddTaskIDs adds the "task" edge to the Task entity by ids.
+func (m *FileMutation) AddTaskIDs(ids ...string) {
+	if m.task == nil {
+		m.task = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.task[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *FileMutation) ClearTask() {
+	m.clearedtask = true
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *FileMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// RemoveTaskIDs removes the "task" edge to the Task entity by IDs.
+func (m *FileMutation) RemoveTaskIDs(ids ...string) {
+	if m.removedtask == nil {
+		m.removedtask = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.task, ids[i])
+		m.removedtask[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTask returns the removed IDs of the "task" edge to the Task entity.
+func (m *FileMutation) RemovedTaskIDs() (ids []string) {
+	for id := range m.removedtask {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+func (m *FileMutation) TaskIDs() (ids []string) {
+	for id := range m.task {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *FileMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+	m.removedtask = nil
+}
+
+// AddTemplateIDs adds the "template" edge to the TaskTemplate entity by ids.
+func (m *FileMutation) AddTemplateIDs(ids ...string) {
+	if m.template == nil {
+		m.template = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.template[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTemplate clears the "template" edge to the TaskTemplate entity.
+func (m *FileMutation) ClearTemplate() {
+	m.clearedtemplate = true
+}
+
+// TemplateCleared reports if the "template" edge to the TaskTemplate entity was cleared.
+func (m *FileMutation) TemplateCleared() bool {
+	return m.clearedtemplate
+}
+
+// RemoveTemplateIDs removes the "template" edge to the TaskTemplate entity by IDs.
+func (m *FileMutation) RemoveTemplateIDs(ids ...string) {
+	if m.removedtemplate == nil {
+		m.removedtemplate = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.template, ids[i])
+		m.removedtemplate[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTemplate returns the removed IDs of the "template" edge to the TaskTemplate entity.
+func (m *FileMutation) RemovedTemplateIDs() (ids []string) {
+	for id := range m.removedtemplate {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TemplateIDs returns the "template" edge IDs in the mutation.
+func (m *FileMutation) TemplateIDs() (ids []string) {
+	for id := range m.template {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTemplate resets all changes to the "template" edge.
+func (m *FileMutation) ResetTemplate() {
+	m.template = nil
+	m.clearedtemplate = false
+	m.removedtemplate = nil
+}
+
+// Where appends a list predicates to the FileMutation builder.
+func (m *FileMutation) Where(ps ...predicate.File) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (File).
+func (m *FileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, file.FieldName)
+	}
+	if m.size != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, file.FieldMimeType)
+	}
+	if m.uploaded_at != nil {
+		fields = append(fields, file.FieldUploadedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldName:
+		return m.Name()
+	case file.FieldSize:
+		return m.Size()
+	case file.FieldMimeType:
+		return m.MimeType()
+	case file.FieldUploadedAt:
+		return m.UploadedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case file.FieldName:
+		return m.OldName(ctx)
+	case file.FieldSize:
+		return m.OldSize(ctx)
+	case file.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case file.FieldUploadedAt:
+		return m.OldUploadedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown File field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case file.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case file.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case file.FieldUploadedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown File nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileMutation) ResetField(name string) error {
+	switch name {
+	case file.FieldName:
+		m.ResetName()
+		return nil
+	case file.FieldSize:
+		m.ResetSize()
+		return nil
+	case file.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case file.FieldUploadedAt:
+		m.ResetUploadedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, file.EdgeTask)
+	}
+	if m.template != nil {
+		edges = append(edges, file.EdgeTemplate)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeTask:
+		ids := make([]ent.Value, 0, len(m.task))
+		for id := range m.task {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeTemplate:
+		ids := make([]ent.Value, 0, len(m.template))
+		for id := range m.template {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtask != nil {
+		edges = append(edges, file.EdgeTask)
+	}
+	if m.removedtemplate != nil {
+		edges = append(edges, file.EdgeTemplate)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeTask:
+		ids := make([]ent.Value, 0, len(m.removedtask))
+		for id := range m.removedtask {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeTemplate:
+		ids := make([]ent.Value, 0, len(m.removedtemplate))
+		for id := range m.removedtemplate {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, file.EdgeTask)
+	}
+	if m.clearedtemplate {
+		edges = append(edges, file.EdgeTemplate)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case file.EdgeTask:
+		return m.clearedtask
+	case file.EdgeTemplate:
+		return m.clearedtemplate
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown File unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileMutation) ResetEdge(name string) error {
+	switch name {
+	case file.EdgeTask:
+		m.ResetTask()
+		return nil
+	case file.EdgeTemplate:
+		m.ResetTemplate()
+		return nil
+	}
+	return fmt.Errorf("unknown File edge %s", name)
+}
+
+// LabelMutation represents an operation that mutates the Label nodes in the graph.
+type LabelMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	color         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	board         *string
+	clearedboard  bool
+	tasks         map[string]struct{}
+	removedtasks  map[string]struct{}
+	clearedtasks  bool
+	done          bool
+	oldValue      func(context.Context) (*Label, error)
+	predicates    []predicate.Label
+}
+
+var _ ent.Mutation = (*LabelMutation)(nil)
+
+// labelOption allows management of the mutation configuration using functional options.
+type labelOption func(*LabelMutation)
+
+// newLabelMutation creates new mutation for the Label entity.
+func newLabelMutation(c config, op Op, opts ...labelOption) *LabelMutation {
+	m := &LabelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLabel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLabelID sets the ID field of the mutation.
+func withLabelID(id string) labelOption {
+	return func(m *LabelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Label
+		)
+		m.oldValue = func(ctx context.Context) (*Label, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Label.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLabel sets the old Label of the mutation.
+func withLabel(node *Label) labelOption {
+	return func(m *LabelMutation) {
+		m.oldValue = func(context.Context) (*Label, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LabelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LabelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Label entities.
+func (m *LabelMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LabelMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LabelMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Label.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *LabelMutation) SetBoardID(s string) {
+	m.board = &s
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *LabelMutation) BoardID() (r string, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LabelMutation) OldBoardID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *LabelMutation) ResetBoardID() {
+	m.board = nil
+}
+
+// SetName sets the "name" field.
+func (m *LabelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LabelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LabelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LabelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetColor sets the "color" field.
+func (m *LabelMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *LabelMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LabelMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *LabelMutation) ResetColor() {
+	m.color = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LabelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LabelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Label entity.
+// If the Label object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LabelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LabelMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *LabelMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[label.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *LabelMutation) BoardCleared() bool {
+	return m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *LabelMutation) BoardIDs() (ids []string) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *LabelMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *LabelMutation) AddTaskIDs(ids ...string) {
+	if m.tasks == nil {
+		m.tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *LabelMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *LabelMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *LabelMutation) RemoveTaskIDs(ids ...string) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *LabelMutation) RemovedTasksIDs() (ids []string) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *LabelMutation) TasksIDs() (ids []string) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *LabelMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// Where appends a list predicates to the LabelMutation builder.
+func (m *LabelMutation) Where(ps ...predicate.Label) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LabelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LabelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Label, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LabelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LabelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Label).
+func (m *LabelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LabelMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.board != nil {
+		fields = append(fields, label.FieldBoardID)
+	}
+	if m.name != nil {
+		fields = append(fields, label.FieldName)
+	}
+	if m.color != nil {
+		fields = append(fields, label.FieldColor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, label.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LabelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case label.FieldBoardID:
+		return m.BoardID()
+	case label.FieldName:
+		return m.Name()
+	case label.FieldColor:
+		return m.Color()
+	case label.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LabelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case label.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case label.FieldName:
+		return m.OldName(ctx)
+	case label.FieldColor:
+		return m.OldColor(ctx)
+	case label.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Label field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LabelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case label.FieldBoardID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case label.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case label.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case label.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Label field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LabelMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LabelMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LabelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Label numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LabelMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LabelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LabelMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Label nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LabelMutation) ResetField(name string) error {
+	switch name {
+	case label.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case label.FieldName:
+		m.ResetName()
+		return nil
+	case label.FieldColor:
+		m.ResetColor()
+		return nil
+	case label.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Label field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LabelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.board != nil {
+		edges = append(edges, label.EdgeBoard)
+	}
+	if m.tasks != nil {
+		edges = append(edges, label.EdgeTasks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LabelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case label.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case label.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LabelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtasks != nil {
+		edges = append(edges, label.EdgeTasks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LabelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case label.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LabelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedboard {
+		edges = append(edges, label.EdgeBoard)
+	}
+	if m.clearedtasks {
+		edges = append(edges, label.EdgeTasks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LabelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case label.EdgeBoard:
+		return m.clearedboard
+	case label.EdgeTasks:
+		return m.clearedtasks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LabelMutation) ClearEdge(name string) error {
+	switch name {
+	case label.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	}
+	return fmt.Errorf("unknown Label unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LabelMutation) ResetEdge(name string) error {
+	switch name {
+	case label.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case label.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	}
+	return fmt.Errorf("unknown Label edge %s", name)
+}
+
+// MemberMutation represents an operation that mutates the Member nodes in the graph.
+type MemberMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	display_name          *string
+	email                 *string
+	role                  *member.Role
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	board                 *string
+	clearedboard          bool
+	assigned_tasks        map[string]struct{}
+	removedassigned_tasks map[string]struct{}
+	clearedassigned_tasks bool
+	watched_tasks         map[string]struct{}
+	removedwatched_tasks  map[string]struct{}
+	clearedwatched_tasks  bool
+	worklogs              map[string]struct{}
+	removedworklogs       map[string]struct{}
+	clearedworklogs       bool
+	timers                map[int]struct{}
+	removedtimers         map[int]struct{}
+	clearedtimers         bool
+	done                  bool
+	oldValue              func(context.Context) (*Member, error)
+	predicates            []predicate.Member
+}
+
+var _ ent.Mutation = (*MemberMutation)(nil)
+
+// memberOption allows management of the mutation configuration using functional options.
+type memberOption func(*MemberMutation)
+
+// newMemberMutation creates new mutation for the Member entity.
+func newMemberMutation(c config, op Op, opts ...memberOption) *MemberMutation {
+	m := &MemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberID sets the ID field of the mutation.
+func withMemberID(id string) memberOption {
+	return func(m *MemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Member
+		)
+		m.oldValue = func(ctx context.Context) (*Member, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Member.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMember sets the old Member of the mutation.
+func withMember(node *Member) memberOption {
+	return func(m *MemberMutation) {
+		m.oldValue = func(context.Context) (*Member, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Member entities.
+func (m *MemberMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Member.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *MemberMutation) SetBoardID(s string) {
+	m.board = &s
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *MemberMutation) BoardID() (r string, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldBoardID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *MemberMutation) ResetBoardID() {
+	m.board = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *MemberMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *MemberMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *MemberMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *MemberMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *MemberMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *MemberMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[member.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *MemberMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[member.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *MemberMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, member.FieldEmail)
+}
+
+// SetRole sets the "role" field.
+func (m *MemberMutation) SetRole(value member.Role) {
+	m.role = &value
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *MemberMutation) Role() (r member.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldRole(ctx context.Context) (v member.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *MemberMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MemberMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MemberMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MemberMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *MemberMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[member.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *MemberMutation) BoardCleared() bool {
+	return m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *MemberMutation) BoardIDs() (ids []string) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *MemberMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by ids.
+func (m *MemberMutation) AddAssignedTaskIDs(ids ...string) {
+	if m.assigned_tasks == nil {
+		m.assigned_tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.assigned_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignedTasks clears the "assigned_tasks" edge to the Task entity.
+func (m *MemberMutation) ClearAssignedTasks() {
+	m.clearedassigned_tasks = true
+}
+
+// AssignedTasksCleared reports if the "assigned_tasks" edge to the Task entity was cleared.
+func (m *MemberMutation) AssignedTasksCleared() bool {
+	return m.clearedassigned_tasks
+}
+
+// RemoveAssignedTaskIDs removes the "assigned_tasks" edge to the Task entity by IDs.
+func (m *MemberMutation) RemoveAssignedTaskIDs(ids ...string) {
+	if m.removedassigned_tasks == nil {
+		m.removedassigned_tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.assigned_tasks, ids[i])
+		m.removedassigned_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignedTasks returns the removed IDs of the "assigned_tasks" edge to the Task entity.
+func (m *MemberMutation) RemovedAssignedTasksIDs() (ids []string) {
+	for id := range m.removedassigned_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssignedTasksIDs returns the "assigned_tasks" edge IDs in the mutation.
+func (m *MemberMutation) AssignedTasksIDs() (ids []string) {
+	for id := range m.assigned_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignedTasks resets all changes to the "assigned_tasks" edge.
+func (m *MemberMutation) ResetAssignedTasks() {
+	m.assigned_tasks = nil
+	m.clearedassigned_tasks = false
+	m.removedassigned_tasks = nil
+}
+
+// AddWatchedTaskIDs adds the "watched_tasks" edge to the Task entity by ids.
+func (m *MemberMutation) AddWatchedTaskIDs(ids ...string) {
+	if m.watched_tasks == nil {
+		m.watched_tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.watched_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWatchedTasks clears the "watched_tasks" edge to the Task entity.
+func (m *MemberMutation) ClearWatchedTasks() {
+	m.clearedwatched_tasks = true
+}
+
+// WatchedTasksCleared reports if the "watched_tasks" edge to the Task entity was cleared.
+func (m *MemberMutation) WatchedTasksCleared() bool {
+	return m.clearedwatched_tasks
+}
+
+// RemoveWatchedTaskIDs removes the "watched_tasks" edge to the Task entity by IDs.
+func (m *MemberMutation) RemoveWatchedTaskIDs(ids ...string) {
+	if m.removedwatched_tasks == nil {
+		m.removedwatched_tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.watched_tasks, ids[i])
+		m.removedwatched_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWatchedTasks returns the removed IDs of the "watched_tasks" edge to the Task entity.
+func (m *MemberMutation) RemovedWatchedTasksIDs() (ids []string) {
+	for id := range m.removedwatched_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WatchedTasksIDs returns the "watched_tasks" edge IDs in the mutation.
+func (m *MemberMutation) WatchedTasksIDs() (ids []string) {
+	for id := range m.watched_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWatchedTasks resets all changes to the "watched_tasks" edge.
+func (m *MemberMutation) ResetWatchedTasks() {
+	m.watched_tasks = nil
+	m.clearedwatched_tasks = false
+	m.removedwatched_tasks = nil
+}
+
+// AddWorklogIDs adds the "worklogs" edge to the Worklog entity by ids.
+func (m *MemberMutation) AddWorklogIDs(ids ...string) {
+	if m.worklogs == nil {
+		m.worklogs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.worklogs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWorklogs clears the "worklogs" edge to the Worklog entity.
+func (m *MemberMutation) ClearWorklogs() {
+	m.clearedworklogs = true
+}
+
+// WorklogsCleared reports if the "worklogs" edge to the Worklog entity was cleared.
+func (m *MemberMutation) WorklogsCleared() bool {
+	return m.clearedworklogs
+}
+
+// RemoveWorklogIDs removes the "worklogs" edge to the Worklog entity by IDs.
+func (m *MemberMutation) RemoveWorklogIDs(ids ...string) {
+	if m.removedworklogs == nil {
+		m.removedworklogs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.worklogs, ids[i])
+		m.removedworklogs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWorklogs returns the removed IDs of the "worklogs" edge to the Worklog entity.
+func (m *MemberMutation) RemovedWorklogsIDs() (ids []string) {
+	for id := range m.removedworklogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WorklogsIDs returns the "worklogs" edge IDs in the mutation.
+func (m *MemberMutation) WorklogsIDs() (ids []string) {
+	for id := range m.worklogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWorklogs resets all changes to the "worklogs" edge.
+func (m *MemberMutation) ResetWorklogs() {
+	m.worklogs = nil
+	m.clearedworklogs = false
+	m.removedworklogs = nil
+}
+
+// AddTimerIDs adds the "timers" edge to the Timer entity by ids.
+func (m *MemberMutation) AddTimerIDs(ids ...int) {
+	if m.timers == nil {
+		m.timers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.timers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTimers clears the "timers" edge to the Timer entity.
+func (m *MemberMutation) ClearTimers() {
+	m.clearedtimers = true
+}
+
+// TimersCleared reports if the "timers" edge to the Timer entity was cleared.
+func (m *MemberMutation) TimersCleared() bool {
+	return m.clearedtimers
+}
+
+// RemoveTimerIDs removes the "timers" edge to the Timer entity by IDs.
+func (m *MemberMutation) RemoveTimerIDs(ids ...int) {
+	if m.removedtimers == nil {
+		m.removedtimers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.timers, ids[i])
+		m.removedtimers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTimers returns the removed IDs of the "timers" edge to the Timer entity.
+func (m *MemberMutation) RemovedTimersIDs() (ids []int) {
+	for id := range m.removedtimers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TimersIDs returns the "timers" edge IDs in the mutation.
+func (m *MemberMutation) TimersIDs() (ids []int) {
+	for id := range m.timers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTimers resets all changes to the "timers" edge.
+func (m *MemberMutation) ResetTimers() {
+	m.timers = nil
+	m.clearedtimers = false
+	m.removedtimers = nil
+}
+
+// Where appends a list predicates to the MemberMutation builder.
+func (m *MemberMutation) Where(ps ...predicate.Member) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Member, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Member).
+func (m *MemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.board != nil {
+		fields = append(fields, member.FieldBoardID)
+	}
+	if m.display_name != nil {
+		fields = append(fields, member.FieldDisplayName)
+	}
+	if m.email != nil {
+		fields = append(fields, member.FieldEmail)
+	}
+	if m.role != nil {
+		fields = append(fields, member.FieldRole)
+	}
+	if m.created_at != nil {
+		fields = append(fields, member.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case member.FieldBoardID:
+		return m.BoardID()
+	case member.FieldDisplayName:
+		return m.DisplayName()
+	case member.FieldEmail:
+		return m.Email()
+	case member.FieldRole:
+		return m.Role()
+	case member.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case member.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case member.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case member.FieldEmail:
+		return m.OldEmail(ctx)
+	case member.FieldRole:
+		return m.OldRole(ctx)
+	case member.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Member field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case member.FieldBoardID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case member.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case member.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case member.FieldRole:
+		v, ok := value.(member.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case member.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Member field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Member numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(member.FieldEmail) {
+		fields = append(fields, member.FieldEmail)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberMutation) ClearField(name string) error {
+	switch name {
+	case member.FieldEmail:
+		m.ClearEmail()
+		return nil
+	}
+	return fmt.Errorf("unknown Member nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberMutation) ResetField(name string) error {
+	switch name {
+	case member.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case member.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case member.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case member.FieldRole:
+		m.ResetRole()
+		return nil
+	case member.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Member field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.board != nil {
+		edges = append(edges, member.EdgeBoard)
+	}
+	if m.assigned_tasks != nil {
+		edges = append(edges, member.EdgeAssignedTasks)
+	}
+	if m.watched_tasks != nil {
+		edges = append(edges, member.EdgeWatchedTasks)
+	}
+	if m.worklogs != nil {
+		edges = append(edges, member.EdgeWorklogs)
+	}
+	if m.timers != nil {
+		edges = append(edges, member.EdgeTimers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case member.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case member.EdgeAssignedTasks:
+		ids := make([]ent.Value, 0, len(m.assigned_tasks))
+		for id := range m.assigned_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case member.EdgeWatchedTasks:
+		ids := make([]ent.Value, 0, len(m.watched_tasks))
+		for id := range m.watched_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case member.EdgeWorklogs:
+		ids := make([]ent.Value, 0, len(m.worklogs))
+		for id := range m.worklogs {
+			ids = append(ids, id)
+		}
+		return ids
+	case member.EdgeTimers:
+		ids := make([]ent.Value, 0, len(m.timers))
+		for id := range m.timers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedassigned_tasks != nil {
+		edges = append(edges, member.EdgeAssignedTasks)
+	}
+	if m.removedwatched_tasks != nil {
+		edges = append(edges, member.EdgeWatchedTasks)
+	}
+	if m.removedworklogs != nil {
+		edges = append(edges, member.EdgeWorklogs)
+	}
+	if m.removedtimers != nil {
+		edges = append(edges, member.EdgeTimers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case member.EdgeAssignedTasks:
+		ids := make([]ent.Value, 0, len(m.removedassigned_tasks))
+		for id := range m.removedassigned_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case member.EdgeWatchedTasks:
+		ids := make([]ent.Value, 0, len(m.removedwatched_tasks))
+		for id := range m.removedwatched_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case member.EdgeWorklogs:
+		ids := make([]ent.Value, 0, len(m.removedworklogs))
+		for id := range m.removedworklogs {
+			ids = append(ids, id)
+		}
+		return ids
+	case member.EdgeTimers:
+		ids := make([]ent.Value, 0, len(m.removedtimers))
+		for id := range m.removedtimers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedboard {
+		edges = append(edges, member.EdgeBoard)
+	}
+	if m.clearedassigned_tasks {
+		edges = append(edges, member.EdgeAssignedTasks)
+	}
+	if m.clearedwatched_tasks {
+		edges = append(edges, member.EdgeWatchedTasks)
+	}
+	if m.clearedworklogs {
+		edges = append(edges, member.EdgeWorklogs)
+	}
+	if m.clearedtimers {
+		edges = append(edges, member.EdgeTimers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case member.EdgeBoard:
+		return m.clearedboard
+	case member.EdgeAssignedTasks:
+		return m.clearedassigned_tasks
+	case member.EdgeWatchedTasks:
+		return m.clearedwatched_tasks
+	case member.EdgeWorklogs:
+		return m.clearedworklogs
+	case member.EdgeTimers:
+		return m.clearedtimers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberMutation) ClearEdge(name string) error {
+	switch name {
+	case member.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	}
+	return fmt.Errorf("unknown Member unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberMutation) ResetEdge(name string) error {
+	switch name {
+	case member.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case member.EdgeAssignedTasks:
+		m.ResetAssignedTasks()
+		return nil
+	case member.EdgeWatchedTasks:
+		m.ResetWatchedTasks()
+		return nil
+	case member.EdgeWorklogs:
+		m.ResetWorklogs()
+		return nil
+	case member.EdgeTimers:
+		m.ResetTimers()
+		return nil
+	}
+	return fmt.Errorf("unknown Member edge %s", name)
+}
+
+// RecurringTaskMutation represents an operation that mutates the RecurringTask nodes in the graph.
+type RecurringTaskMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	title              *string
+	description        *string
+	assignee_ids       *[]string
+	appendassignee_ids []string
+	label_ids          *[]string
+	appendlabel_ids    []string
+	priority           *recurringtask.Priority
+	story_points       *int
+	addstory_points    *int
+	rule               *string
+	starts_at          *time.Time
+	next_at            *time.Time
+	last_at            *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	board              *string
+	clearedboard       bool
+	done               bool
+	oldValue           func(context.Context) (*RecurringTask, error)
+	predicates         []predicate.RecurringTask
+}
+
+var _ ent.Mutation = (*RecurringTaskMutation)(nil)
+
+// recurringtaskOption allows management of the mutation configuration using functional options.
+type recurringtaskOption func(*RecurringTaskMutation)
+
+// newRecurringTaskMutation creates new mutation for the RecurringTask entity.
+func newRecurringTaskMutation(c config, op Op, opts ...recurringtaskOption) *RecurringTaskMutation {
+	m := &RecurringTaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecurringTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecurringTaskID sets the ID field of the mutation.
+func withRecurringTaskID(id string) recurringtaskOption {
+	return func(m *RecurringTaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecurringTask
+		)
+		m.oldValue = func(ctx context.Context) (*RecurringTask, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecurringTask.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecurringTask sets the old RecurringTask of the mutation.
+func withRecurringTask(node *RecurringTask) recurringtaskOption {
+	return func(m *RecurringTaskMutation) {
+		m.oldValue = func(context.Context) (*RecurringTask, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecurringTaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecurringTaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RecurringTask entities.
+func (m *RecurringTaskMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecurringTaskMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecurringTaskMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecurringTask.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *RecurringTaskMutation) SetBoardID(s string) {
+	m.board = &s
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *RecurringTaskMutation) BoardID() (r string, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldBoardID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *RecurringTaskMutation) ResetBoardID() {
+	m.board = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *RecurringTaskMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *RecurringTaskMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *RecurringTaskMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RecurringTaskMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RecurringTaskMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RecurringTaskMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[recurringtask.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RecurringTaskMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RecurringTaskMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, recurringtask.FieldDescription)
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (m *RecurringTaskMutation) SetAssigneeIds(s []string) {
+	m.assignee_ids = &s
+	m.appendassignee_ids = nil
+}
+
+// AssigneeIds returns the value of the "assignee_ids" field in the mutation.
+func (m *RecurringTaskMutation) AssigneeIds() (r []string, exists bool) {
+	v := m.assignee_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssigneeIds returns the old "assignee_ids" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldAssigneeIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssigneeIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssigneeIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssigneeIds: %w", err)
+	}
+	return oldValue.AssigneeIds, nil
+}
+
+// AppendAssigneeIds adds s to the "assignee_ids" field.
+func (m *RecurringTaskMutation) AppendAssigneeIds(s []string) {
+	m.appendassignee_ids = append(m.appendassignee_ids, s...)
+}
+
+// AppendedAssigneeIds returns the list of values that were appended to the "assignee_ids" field in this mutation.
+func (m *RecurringTaskMutation) AppendedAssigneeIds() ([]string, bool) {
+	if len(m.appendassignee_ids) == 0 {
+		return nil, false
+	}
+	return m.appendassignee_ids, true
+}
+
+// ClearAssigneeIds clears the value of the "assignee_ids" field.
+func (m *RecurringTaskMutation) ClearAssigneeIds() {
+	m.assignee_ids = nil
+	m.appendassignee_ids = nil
+	m.clearedFields[recurringtask.FieldAssigneeIds] = struct{}{}
+}
+
+// AssigneeIdsCleared returns if the "assignee_ids" field was cleared in this mutation.
+func (m *RecurringTaskMutation) AssigneeIdsCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldAssigneeIds]
+	return ok
+}
+
+// ResetAssigneeIds resets all changes to the "assignee_ids" field.
+func (m *RecurringTaskMutation) ResetAssigneeIds() {
+	m.assignee_ids = nil
+	m.appendassignee_ids = nil
+	delete(m.clearedFields, recurringtask.FieldAssigneeIds)
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (m *RecurringTaskMutation) SetLabelIds(s []string) {
+	m.label_ids = &s
+	m.appendlabel_ids = nil
+}
+
+// LabelIds returns the value of the "label_ids" field in the mutation.
+func (m *RecurringTaskMutation) LabelIds() (r []string, exists bool) {
+	v := m.label_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelIds returns the old "label_ids" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldLabelIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelIds: %w", err)
+	}
+	return oldValue.LabelIds, nil
+}
+
+// AppendLabelIds adds s to the "label_ids" field.
+func (m *RecurringTaskMutation) AppendLabelIds(s []string) {
+	m.appendlabel_ids = append(m.appendlabel_ids, s...)
+}
+
+// AppendedLabelIds returns the list of values that were appended to the "label_ids" field in this mutation.
+func (m *RecurringTaskMutation) AppendedLabelIds() ([]string, bool) {
+	if len(m.appendlabel_ids) == 0 {
+		return nil, false
+	}
+	return m.appendlabel_ids, true
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (m *RecurringTaskMutation) ClearLabelIds() {
+	m.label_ids = nil
+	m.appendlabel_ids = nil
+	m.clearedFields[recurringtask.FieldLabelIds] = struct{}{}
+}
+
+// LabelIdsCleared returns if the "label_ids" field was cleared in this mutation.
+func (m *RecurringTaskMutation) LabelIdsCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldLabelIds]
+	return ok
+}
+
+// ResetLabelIds resets all changes to the "label_ids" field.
+func (m *RecurringTaskMutation) ResetLabelIds() {
+	m.label_ids = nil
+	m.appendlabel_ids = nil
+	delete(m.clearedFields, recurringtask.FieldLabelIds)
+}
+
+// SetPriority sets the "priority" field.
+func (m *RecurringTaskMutation) SetPriority(r recurringtask.Priority) {
+	m.priority = &r
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *RecurringTaskMutation) Priority() (r recurringtask.Priority, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldPriority(ctx context.Context) (v *recurringtask.Priority, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (m *RecurringTaskMutation) ClearPriority() {
+	m.priority = nil
+	m.clearedFields[recurringtask.FieldPriority] = struct{}{}
+}
+
+// PriorityCleared returns if the "priority" field was cleared in this mutation.
+func (m *RecurringTaskMutation) PriorityCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldPriority]
+	return ok
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *RecurringTaskMutation) ResetPriority() {
+	m.priority = nil
+	delete(m.clearedFields, recurringtask.FieldPriority)
+}
+
+// SetStoryPoints sets the "story_points" field.
+func (m *RecurringTaskMutation) SetStoryPoints(i int) {
+	m.story_points = &i
+	m.addstory_points = nil
+}
+
+// StoryPoints returns the value of the "story_points" field in the mutation.
+func (m *RecurringTaskMutation) StoryPoints() (r int, exists bool) {
+	v := m.story_points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoryPoints returns the old "story_points" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldStoryPoints(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoryPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoryPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoryPoints: %w", err)
+	}
+	return oldValue.StoryPoints, nil
+}
+
+// AddStoryPoints adds i to the "story_points" field.
+func (m *RecurringTaskMutation) AddStoryPoints(i int) {
+	if m.addstory_points != nil {
+		*m.addstory_points += i
+	} else {
+		m.addstory_points = &i
+	}
+}
+
+// AddedStoryPoints returns the value that was added to the "story_points" field in this mutation.
+func (m *RecurringTaskMutation) AddedStoryPoints() (r int, exists bool) {
+	v := m.addstory_points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStoryPoints clears the value of the "story_points" field.
+func (m *RecurringTaskMutation) ClearStoryPoints() {
+	m.story_points = nil
+	m.addstory_points = nil
+	m.clearedFields[recurringtask.FieldStoryPoints] = struct{}{}
+}
+
+// StoryPointsCleared returns if the "story_points" field was cleared in this mutation.
+func (m *RecurringTaskMutation) StoryPointsCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldStoryPoints]
+	return ok
+}
+
+// ResetStoryPoints resets all changes to the "story_points" field.
+func (m *RecurringTaskMutation) ResetStoryPoints() {
+	m.story_points = nil
+	m.addstory_points = nil
+	delete(m.clearedFields, recurringtask.FieldStoryPoints)
+}
+
+// SetRule sets the "rule" field.
+func (m *RecurringTaskMutation) SetRule(s string) {
+	m.rule = &s
+}
+
+// Rule returns the value of the "rule" field in the mutation.
+func (m *RecurringTaskMutation) Rule() (r string, exists bool) {
+	v := m.rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRule returns the old "rule" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldRule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRule: %w", err)
+	}
+	return oldValue.Rule, nil
+}
+
+// ResetRule resets all changes to the "rule" field.
+func (m *RecurringTaskMutation) ResetRule() {
+	m.rule = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *RecurringTaskMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *RecurringTaskMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *RecurringTaskMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetNextAt sets the "next_at" field.
+func (m *RecurringTaskMutation) SetNextAt(t time.Time) {
+	m.next_at = &t
+}
+
+// NextAt returns the value of the "next_at" field in the mutation.
+func (m *RecurringTaskMutation) NextAt() (r time.Time, exists bool) {
+	v := m.next_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAt returns the old "next_at" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldNextAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAt: %w", err)
+	}
+	return oldValue.NextAt, nil
+}
+
+// ClearNextAt clears the value of the "next_at" field.
+func (m *RecurringTaskMutation) ClearNextAt() {
+	m.next_at = nil
+	m.clearedFields[recurringtask.FieldNextAt] = struct{}{}
+}
+
+// NextAtCleared returns if the "next_at" field was cleared in this mutation.
+func (m *RecurringTaskMutation) NextAtCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldNextAt]
+	return ok
+}
+
+// ResetNextAt resets all changes to the "next_at" field.
+func (m *RecurringTaskMutation) ResetNextAt() {
+	m.next_at = nil
+	delete(m.clearedFields, recurringtask.FieldNextAt)
+}
+
+// SetLastAt sets the "last_at" field.
+func (m *RecurringTaskMutation) SetLastAt(t time.Time) {
+	m.last_at = &t
+}
+
+// LastAt returns the value of the "last_at" field in the mutation.
+func (m *RecurringTaskMutation) LastAt() (r time.Time, exists bool) {
+	v := m.last_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastAt returns the old "last_at" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldLastAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAt: %w", err)
+	}
+	return oldValue.LastAt, nil
+}
+
+// ClearLastAt clears the value of the "last_at" field.
+func (m *RecurringTaskMutation) ClearLastAt() {
+	m.last_at = nil
+	m.clearedFields[recurringtask.FieldLastAt] = struct{}{}
+}
+
+// LastAtCleared returns if the "last_at" field was cleared in this mutation.
+func (m *RecurringTaskMutation) LastAtCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldLastAt]
+	return ok
+}
+
+// ResetLastAt resets all changes to the "last_at" field.
+func (m *RecurringTaskMutation) ResetLastAt() {
+	m.last_at = nil
+	delete(m.clearedFields, recurringtask.FieldLastAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecurringTaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecurringTaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecurringTaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RecurringTaskMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RecurringTaskMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RecurringTaskMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *RecurringTaskMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[recurringtask.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *RecurringTaskMutation) BoardCleared() bool {
+	return m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *RecurringTaskMutation) BoardIDs() (ids []string) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *RecurringTaskMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// Where appends a list predicates to the RecurringTaskMutation builder.
+func (m *RecurringTaskMutation) Where(ps ...predicate.RecurringTask) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecurringTaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecurringTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecurringTask, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecurringTaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecurringTaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecurringTask).
+func (m *RecurringTaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecurringTaskMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.board != nil {
+		fields = append(fields, recurringtask.FieldBoardID)
+	}
+	if m.title != nil {
+		fields = append(fields, recurringtask.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, recurringtask.FieldDescription)
+	}
+	if m.assignee_ids != nil {
+		fields = append(fields, recurringtask.FieldAssigneeIds)
+	}
+	if m.label_ids != nil {
+		fields = append(fields, recurringtask.FieldLabelIds)
+	}
+	if m.priority != nil {
+		fields = append(fields, recurringtask.FieldPriority)
+	}
+	if m.story_points != nil {
+		fields = append(fields, recurringtask.FieldStoryPoints)
+	}
+	if m.rule != nil {
+		fields = append(fields, recurringtask.FieldRule)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, recurringtask.FieldStartsAt)
+	}
+	if m.next_at != nil {
+		fields = append(fields, recurringtask.FieldNextAt)
+	}
+	if m.last_at != nil {
+		fields = append(fields, recurringtask.FieldLastAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recurringtask.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, recurringtask.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecurringTaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recurringtask.FieldBoardID:
+		return m.BoardID()
+	case recurringtask.FieldTitle:
+		return m.Title()
+	case recurringtask.FieldDescription:
+		return m.Description()
+	case recurringtask.FieldAssigneeIds:
+		return m.AssigneeIds()
+	case recurringtask.FieldLabelIds:
+		return m.LabelIds()
+	case recurringtask.FieldPriority:
+		return m.Priority()
+	case recurringtask.FieldStoryPoints:
+		return m.StoryPoints()
+	case recurringtask.FieldRule:
+		return m.Rule()
+	case recurringtask.FieldStartsAt:
+		return m.StartsAt()
+	case recurringtask.FieldNextAt:
+		return m.NextAt()
+	case recurringtask.FieldLastAt:
+		return m.LastAt()
+	case recurringtask.FieldCreatedAt:
+		return m.CreatedAt()
+	case recurringtask.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecurringTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recurringtask.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case recurringtask.FieldTitle:
+		return m.OldTitle(ctx)
+	case recurringtask.FieldDescription:
+		return m.OldDescription(ctx)
+	case recurringtask.FieldAssigneeIds:
+		return m.OldAssigneeIds(ctx)
+	case recurringtask.FieldLabelIds:
+		return m.OldLabelIds(ctx)
+	case recurringtask.FieldPriority:
+		return m.OldPriority(ctx)
+	case recurringtask.FieldStoryPoints:
+		return m.OldStoryPoints(ctx)
+	case recurringtask.FieldRule:
+		return m.OldRule(ctx)
+	case recurringtask.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case recurringtask.FieldNextAt:
+		return m.OldNextAt(ctx)
+	case recurringtask.FieldLastAt:
+		return m.OldLastAt(ctx)
+	case recurringtask.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case recurringtask.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecurringTask field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringTaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recurringtask.FieldBoardID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case recurringtask.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case recurringtask.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case recurringtask.FieldAssigneeIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssigneeIds(v)
+		return nil
+	case recurringtask.FieldLabelIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelIds(v)
+		return nil
+	case recurringtask.FieldPriority:
+		v, ok := value.(recurringtask.Priority)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case recurringtask.FieldStoryPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoryPoints(v)
+		return nil
+	case recurringtask.FieldRule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRule(v)
+		return nil
+	case recurringtask.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case recurringtask.FieldNextAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAt(v)
+		return nil
+	case recurringtask.FieldLastAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAt(v)
+		return nil
+	case recurringtask.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case recurringtask.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecurringTaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addstory_points != nil {
+		fields = append(fields, recurringtask.FieldStoryPoints)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecurringTaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recurringtask.FieldStoryPoints:
+		return m.AddedStoryPoints()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringTaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recurringtask.FieldStoryPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStoryPoints(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecurringTaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recurringtask.FieldDescription) {
+		fields = append(fields, recurringtask.FieldDescription)
+	}
+	if m.FieldCleared(recurringtask.FieldAssigneeIds) {
+		fields = append(fields, recurringtask.FieldAssigneeIds)
+	}
+	if m.FieldCleared(recurringtask.FieldLabelIds) {
+		fields = append(fields, recurringtask.FieldLabelIds)
+	}
+	if m.FieldCleared(recurringtask.FieldPriority) {
+		fields = append(fields, recurringtask.FieldPriority)
+	}
+	if m.FieldCleared(recurringtask.FieldStoryPoints) {
+		fields = append(fields, recurringtask.FieldStoryPoints)
+	}
+	if m.FieldCleared(recurringtask.FieldNextAt) {
+		fields = append(fields, recurringtask.FieldNextAt)
+	}
+	if m.FieldCleared(recurringtask.FieldLastAt) {
+		fields = append(fields, recurringtask.FieldLastAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecurringTaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecurringTaskMutation) ClearField(name string) error {
+	switch name {
+	case recurringtask.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case recurringtask.FieldAssigneeIds:
+		m.ClearAssigneeIds()
+		return nil
+	case recurringtask.FieldLabelIds:
+		m.ClearLabelIds()
+		return nil
+	case recurringtask.FieldPriority:
+		m.ClearPriority()
+		return nil
+	case recurringtask.FieldStoryPoints:
+		m.ClearStoryPoints()
+		return nil
+	case recurringtask.FieldNextAt:
+		m.ClearNextAt()
+		return nil
+	case recurringtask.FieldLastAt:
+		m.ClearLastAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecurringTaskMutation) ResetField(name string) error {
+	switch name {
+	case recurringtask.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case recurringtask.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case recurringtask.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case recurringtask.FieldAssigneeIds:
+		m.ResetAssigneeIds()
+		return nil
+	case recurringtask.FieldLabelIds:
+		m.ResetLabelIds()
+		return nil
+	case recurringtask.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case recurringtask.FieldStoryPoints:
+		m.ResetStoryPoints()
+		return nil
+	case recurringtask.FieldRule:
+		m.ResetRule()
+		return nil
+	case recurringtask.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case recurringtask.FieldNextAt:
+		m.ResetNextAt()
+		return nil
+	case recurringtask.FieldLastAt:
+		m.ResetLastAt()
+		return nil
+	case recurringtask.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case recurringtask.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecurringTaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.board != nil {
+		edges = append(edges, recurringtask.EdgeBoard)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecurringTaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recurringtask.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecurringTaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecurringTaskMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecurringTaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedboard {
+		edges = append(edges, recurringtask.EdgeBoard)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecurringTaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recurringtask.EdgeBoard:
+		return m.clearedboard
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecurringTaskMutation) ClearEdge(name string) error {
+	switch name {
+	case recurringtask.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecurringTaskMutation) ResetEdge(name string) error {
+	switch name {
+	case recurringtask.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask edge %s", name)
+}
+
+// SprintMutation represents an operation that mutates the Sprint nodes in the graph.
+type SprintMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	goal          *string
+	start_date    *time.Time
+	end_date      *time.Time
+	status        *sprint.Status
+	created_at    *time.Time
+	started_at    *time.Time
+	closed_at     *time.Time
+	clearedFields map[string]struct{}
+	board         *string
+	clearedboard  bool
+	tasks         map[string]struct{}
+	removedtasks  map[string]struct{}
+	clearedtasks  bool
+	done          bool
+	oldValue      func(context.Context) (*Sprint, error)
+	predicates    []predicate.Sprint
+}
+
+var _ ent.Mutation = (*SprintMutation)(nil)
+
+// sprintOption allows management of the mutation configuration using functional options.
+type sprintOption func(*SprintMutation)
+
+// newSprintMutation creates new mutation for the Sprint entity.
+func newSprintMutation(c config, op Op, opts ...sprintOption) *SprintMutation {
+	m := &SprintMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSprint,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSprintID sets the ID field of the mutation.
+func withSprintID(id string) sprintOption {
+	return func(m *SprintMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Sprint
+		)
+		m.oldValue = func(ctx context.Context) (*Sprint, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Sprint.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSprint sets the old Sprint of the mutation.
+func withSprint(node *Sprint) sprintOption {
+	return func(m *SprintMutation) {
+		m.oldValue = func(context.Context) (*Sprint, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SprintMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SprintMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Sprint entities.
+func (m *SprintMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SprintMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SprintMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Sprint.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *SprintMutation) SetBoardID(s string) {
+	m.board = &s
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *SprintMutation) BoardID() (r string, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the Sprint entity.
+// If the Sprint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SprintMutation) OldBoardID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *SprintMutation) ResetBoardID() {
+	m.board = nil
+}
+
+// SetName sets the "name" field.
+func (m *SprintMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SprintMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Sprint entity.
+// If the Sprint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SprintMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SprintMutation) ResetName() {
+	m.name = nil
+}
+
+// SetGoal sets the "goal" field.
+func (m *SprintMutation) SetGoal(s string) {
+	m.goal = &s
+}
+
+// Goal returns the value of the "goal" field in the mutation.
+func (m *SprintMutation) Goal() (r string, exists bool) {
+	v := m.goal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGoal returns the old "goal" field's value of the Sprint entity.
+// If the Sprint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SprintMutation) OldGoal(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGoal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGoal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGoal: %w", err)
+	}
+	return oldValue.Goal, nil
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (m *SprintMutation) ClearGoal() {
+	m.goal = nil
+	m.clearedFields[sprint.FieldGoal] = struct{}{}
+}
+
+// GoalCleared returns if the "goal" field was cleared in this mutation.
+func (m *SprintMutation) GoalCleared() bool {
+	_, ok := m.clearedFields[sprint.FieldGoal]
+	return ok
+}
+
+// ResetGoal resets all changes to the "goal" field.
+func (m *SprintMutation) ResetGoal() {
+	m.goal = nil
+	delete(m.clearedFields, sprint.FieldGoal)
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *SprintMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *SprintMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the Sprint entity.
+// If the Sprint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SprintMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *SprintMutation) ResetStartDate() {
+	m.start_date = nil
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *SprintMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *SprintMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the Sprint entity.
+// If the Sprint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SprintMutation) OldEndDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *SprintMutation) ResetEndDate() {
+	m.end_date = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SprintMutation) SetStatus(s sprint.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SprintMutation) Status() (r sprint.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Sprint entity.
+// If the Sprint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SprintMutation) OldStatus(ctx context.Context) (v sprint.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SprintMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SprintMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SprintMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Sprint entity.
+// If the Sprint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SprintMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SprintMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *SprintMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *SprintMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Sprint entity.
+// If the Sprint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SprintMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *SprintMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[sprint.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *SprintMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[sprint.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *SprintMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, sprint.FieldStartedAt)
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (m *SprintMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
+}
+
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *SprintMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedAt returns the old "closed_at" field's value of the Sprint entity.
+// If the Sprint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SprintMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *SprintMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[sprint.FieldClosedAt] = struct{}{}
+}
+
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *SprintMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[sprint.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *SprintMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, sprint.FieldClosedAt)
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *SprintMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[sprint.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *SprintMutation) BoardCleared() bool {
+	return m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *SprintMutation) BoardIDs() (ids []string) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *SprintMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *SprintMutation) AddTaskIDs(ids ...string) {
+	if m.tasks == nil {
+		m.tasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *SprintMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *SprintMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *SprintMutation) RemoveTaskIDs(ids ...string) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *SprintMutation) RemovedTasksIDs() (ids []string) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *SprintMutation) TasksIDs() (ids []string) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *SprintMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// Where appends a list predicates to the SprintMutation builder.
+func (m *SprintMutation) Where(ps ...predicate.Sprint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SprintMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SprintMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Sprint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SprintMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SprintMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Sprint).
+func (m *SprintMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SprintMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.board != nil {
+		fields = append(fields, sprint.FieldBoardID)
+	}
+	if m.name != nil {
+		fields = append(fields, sprint.FieldName)
+	}
+	if m.goal != nil {
+		fields = append(fields, sprint.FieldGoal)
+	}
+	if m.start_date != nil {
+		fields = append(fields, sprint.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, sprint.FieldEndDate)
+	}
+	if m.status != nil {
+		fields = append(fields, sprint.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, sprint.FieldCreatedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, sprint.FieldStartedAt)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, sprint.FieldClosedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SprintMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sprint.FieldBoardID:
+		return m.BoardID()
+	case sprint.FieldName:
+		return m.Name()
+	case sprint.FieldGoal:
+		return m.Goal()
+	case sprint.FieldStartDate:
+		return m.StartDate()
+	case sprint.FieldEndDate:
+		return m.EndDate()
+	case sprint.FieldStatus:
+		return m.Status()
+	case sprint.FieldCreatedAt:
+		return m.CreatedAt()
+	case sprint.FieldStartedAt:
+		return m.StartedAt()
+	case sprint.FieldClosedAt:
+		return m.ClosedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SprintMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sprint.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case sprint.FieldName:
+		return m.OldName(ctx)
+	case sprint.FieldGoal:
+		return m.OldGoal(ctx)
+	case sprint.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case sprint.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case sprint.FieldStatus:
+		return m.OldStatus(ctx)
+	case sprint.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sprint.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case sprint.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Sprint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SprintMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sprint.FieldBoardID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case sprint.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sprint.FieldGoal:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGoal(v)
+		return nil
+	case sprint.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case sprint.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case sprint.FieldStatus:
+		v, ok := value.(sprint.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case sprint.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sprint.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case sprint.FieldClosedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Sprint field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SprintMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SprintMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SprintMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Sprint numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SprintMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sprint.FieldGoal) {
+		fields = append(fields, sprint.FieldGoal)
+	}
+	if m.FieldCleared(sprint.FieldStartedAt) {
+		fields = append(fields, sprint.FieldStartedAt)
+	}
+	if m.FieldCleared(sprint.FieldClosedAt) {
+		fields = append(fields, sprint.FieldClosedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SprintMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SprintMutation) ClearField(name string) error {
+	switch name {
+	case sprint.FieldGoal:
+		m.ClearGoal()
+		return nil
+	case sprint.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case sprint.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Sprint nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SprintMutation) ResetField(name string) error {
+	switch name {
+	case sprint.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case sprint.FieldName:
+		m.ResetName()
+		return nil
+	case sprint.FieldGoal:
+		m.ResetGoal()
+		return nil
+	case sprint.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case sprint.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case sprint.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case sprint.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sprint.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case sprint.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Sprint field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SprintMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.board != nil {
+		edges = append(edges, sprint.EdgeBoard)
+	}
+	if m.tasks != nil {
+		edges = append(edges, sprint.EdgeTasks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SprintMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sprint.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case sprint.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SprintMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtasks != nil {
+		edges = append(edges, sprint.EdgeTasks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SprintMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case sprint.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SprintMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedboard {
+		edges = append(edges, sprint.EdgeBoard)
+	}
+	if m.clearedtasks {
+		edges = append(edges, sprint.EdgeTasks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SprintMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sprint.EdgeBoard:
+		return m.clearedboard
+	case sprint.EdgeTasks:
+		return m.clearedtasks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SprintMutation) ClearEdge(name string) error {
+	switch name {
+	case sprint.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	}
+	return fmt.Errorf("unknown Sprint unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SprintMutation) ResetEdge(name string) error {
+	switch name {
+	case sprint.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case sprint.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	}
+	return fmt.Errorf("unknown Sprint edge %s", name)
+}
+
+// StatusChangeMutation represents an operation that mutates the StatusChange nodes in the graph.
+type StatusChangeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	from_status   *string
+	to_status     *string
+	changed_at    *time.Time
+	clearedFields map[string]struct{}
+	task          *string
+	clearedtask   bool
+	done          bool
+	oldValue      func(context.Context) (*StatusChange, error)
+	predicates    []predicate.StatusChange
+}
+
+var _ ent.Mutation = (*StatusChangeMutation)(nil)
+
+// statuschangeOption allows management of the mutation configuration using functional options.
+type statuschangeOption func(*StatusChangeMutation)
+
+// newStatusChangeMutation creates new mutation for the StatusChange entity.
+func newStatusChangeMutation(c config, op Op, opts ...statuschangeOption) *StatusChangeMutation {
+	m := &StatusChangeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStatusChange,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStatusChangeID sets the ID field of the mutation.
+func withStatusChangeID(id int) statuschangeOption {
+	return func(m *StatusChangeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StatusChange
+		)
+		m.oldValue = func(ctx context.Context) (*StatusChange, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StatusChange.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStatusChange sets the old StatusChange of the mutation.
+func withStatusChange(node *StatusChange) statuschangeOption {
+	return func(m *StatusChangeMutation) {
+		m.oldValue = func(context.Context) (*StatusChange, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StatusChangeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StatusChangeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StatusChangeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StatusChangeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StatusChange.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *StatusChangeMutation) SetTaskID(s string) {
+	m.task = &s
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *StatusChangeMutation) TaskID() (r string, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the StatusChange entity.
+// If the StatusChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusChangeMutation) OldTaskID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *StatusChangeMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// SetFromStatus sets the "from_status" field.
+func (m *StatusChangeMutation) SetFromStatus(s string) {
+	m.from_status = &s
+}
+
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *StatusChangeMutation) FromStatus() (r string, exists bool) {
+	v := m.from_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromStatus returns the old "from_status" field's value of the StatusChange entity.
+// If the StatusChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusChangeMutation) OldFromStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
+	}
+	return oldValue.FromStatus, nil
+}
+
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *StatusChangeMutation) ResetFromStatus() {
+	m.from_status = nil
+}
+
+// SetToStatus sets the "to_status" field.
+func (m *StatusChangeMutation) SetToStatus(s string) {
+	m.to_status = &s
+}
+
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *StatusChangeMutation) ToStatus() (r string, exists bool) {
+	v := m.to_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToStatus returns the old "to_status" field's value of the StatusChange entity.
+// If the StatusChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusChangeMutation) OldToStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
+}
+
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *StatusChangeMutation) ResetToStatus() {
+	m.to_status = nil
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (m *StatusChangeMutation) SetChangedAt(t time.Time) {
+	m.changed_at = &t
+}
+
+// ChangedAt returns the value of the "changed_at" field in the mutation.
+func (m *StatusChangeMutation) ChangedAt() (r time.Time, exists bool) {
+	v := m.changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedAt returns the old "changed_at" field's value of the StatusChange entity.
+// If the StatusChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusChangeMutation) OldChangedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedAt: %w", err)
+	}
+	return oldValue.ChangedAt, nil
+}
+
+// ResetChangedAt resets all changes to the "changed_at" field.
+func (m *StatusChangeMutation) ResetChangedAt() {
+	m.changed_at = nil
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *StatusChangeMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[statuschange.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *StatusChangeMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *StatusChangeMutation) TaskIDs() (ids []string) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *StatusChangeMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// Where appends a list predicates to the StatusChangeMutation builder.
+func (m *StatusChangeMutation) Where(ps ...predicate.StatusChange) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StatusChangeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StatusChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StatusChange, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StatusChangeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StatusChangeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StatusChange).
+func (m *StatusChangeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StatusChangeMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.task != nil {
+		fields = append(fields, statuschange.FieldTaskID)
+	}
+	if m.from_status != nil {
+		fields = append(fields, statuschange.FieldFromStatus)
+	}
+	if m.to_status != nil {
+		fields = append(fields, statuschange.FieldToStatus)
+	}
+	if m.changed_at != nil {
+		fields = append(fields, statuschange.FieldChangedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StatusChangeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case statuschange.FieldTaskID:
+		return m.TaskID()
+	case statuschange.FieldFromStatus:
+		return m.FromStatus()
+	case statuschange.FieldToStatus:
+		return m.ToStatus()
+	case statuschange.FieldChangedAt:
+		return m.ChangedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StatusChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case statuschange.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case statuschange.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case statuschange.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case statuschange.FieldChangedAt:
+		return m.OldChangedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StatusChange field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StatusChangeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case statuschange.FieldTaskID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case statuschange.FieldFromStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromStatus(v)
+		return nil
+	case statuschange.FieldToStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToStatus(v)
+		return nil
+	case statuschange.FieldChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StatusChange field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StatusChangeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StatusChangeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StatusChangeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown StatusChange numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StatusChangeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StatusChangeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StatusChangeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown StatusChange nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StatusChangeMutation) ResetField(name string) error {
+	switch name {
+	case statuschange.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case statuschange.FieldFromStatus:
+		m.ResetFromStatus()
+		return nil
+	case statuschange.FieldToStatus:
+		m.ResetToStatus()
+		return nil
+	case statuschange.FieldChangedAt:
+		m.ResetChangedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StatusChange field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StatusChangeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, statuschange.EdgeTask)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StatusChangeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case statuschange.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StatusChangeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StatusChangeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StatusChangeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, statuschange.EdgeTask)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StatusChangeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case statuschange.EdgeTask:
+		return m.clearedtask
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StatusChangeMutation) ClearEdge(name string) error {
+	switch name {
+	case statuschange.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown StatusChange unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StatusChangeMutation) ResetEdge(name string) error {
+	switch name {
+	case statuschange.EdgeTask:
+		m.ResetTask()
+		return nil
+	}
+	return fmt.Errorf("unknown StatusChange edge %s", name)
+}
+
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	title                      *string
+	description                *string
+	due_date                   *time.Time
+	assignee_id                *string
+	assignee_name              *string
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	completed_at               *time.Time
+	archived_at                *time.Time
+	status                     *string
+	rank                       *string
+	priority                   *task.Priority
+	story_points               *int
+	addstory_points            *int
+	wip_override_by            *string
+	wip_override_status        *string
+	wip_override_at            *time.Time
+	version                    *int
+	addversion                 *int
+	clearedFields              map[string]struct{}
+	files                      map[string]struct{}
+	removedfiles               map[string]struct{}
+	clearedfiles               bool
+	board                      *string
+	clearedboard               bool
+	parent                     *string
+	clearedparent              bool
+	children                   map[string]struct{}
+	removedchildren            map[string]struct{}
+	clearedchildren            bool
+	blocked_by                 map[string]struct{}
+	removedblocked_by          map[string]struct{}
+	clearedblocked_by          bool
+	blocks                     map[string]struct{}
+	removedblocks              map[string]struct{}
+	clearedblocks              bool
+	labels                     map[string]struct{}
+	removedlabels              map[string]struct{}
+	clearedlabels              bool
+	sprint                     *string
+	clearedsprint              bool
+	epic                       *string
+	clearedepic                bool
+	comments                   map[string]struct{}
+	removedcomments            map[string]struct{}
+	clearedcomments            bool
+	activities                 map[string]struct{}
+	removedactivities          map[string]struct{}
+	clearedactivities          bool
+	assignees                  map[string]struct{}
+	removedassignees           map[string]struct{}
+	clearedassignees           bool
+	watchers                   map[string]struct{}
+	removedwatchers            map[string]struct{}
+	clearedwatchers            bool
+	checklist_items            map[string]struct{}
+	removedchecklist_items     map[string]struct{}
+	clearedchecklist_items     bool
+	custom_field_values        map[int]struct{}
+	removedcustom_field_values map[int]struct{}
+	clearedcustom_field_values bool
+	worklogs                   map[string]struct{}
+	removedworklogs            map[string]struct{}
+	clearedworklogs            bool
+	timers                     map[int]struct{}
+	removedtimers              map[int]struct{}
+	clearedtimers              bool
+	status_changes             map[int]struct{}
+	removedstatus_changes      map[int]struct{}
+	clearedstatus_changes      bool
+	done                       bool
+	oldValue                   func(context.Context) (*Task, error)
+	predicates                 []predicate.Task
+}
+
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id string) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Task entities.
+func (m *TaskMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *TaskMutation) SetBoardID(s string) {
+	m.board = &s
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *TaskMutation) BoardID() (r string, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldBoardID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ClearBoardID clears the value of the "board_id" field.
+func (m *TaskMutation) ClearBoardID() {
+	m.board = nil
+	m.clearedFields[task.FieldBoardID] = struct{}{}
+}
+
+// BoardIDCleared returns if the "board_id" field was cleared in this mutation.
+func (m *TaskMutation) BoardIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldBoardID]
+	return ok
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *TaskMutation) ResetBoardID() {
+	m.board = nil
+	delete(m.clearedFields, task.FieldBoardID)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *TaskMutation) SetParentID(s string) {
+	m.parent = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *TaskMutation) ParentID() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *TaskMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[task.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *TaskMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *TaskMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, task.FieldParentID)
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (m *TaskMutation) SetSprintID(s string) {
+	m.sprint = &s
+}
+
+// SprintID returns the value of the "sprint_id" field in the mutation.
+func (m *TaskMutation) SprintID() (r string, exists bool) {
+	v := m.sprint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSprintID returns the old "sprint_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldSprintID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSprintID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSprintID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSprintID: %w", err)
+	}
+	return oldValue.SprintID, nil
+}
+
+// ClearSprintID clears the value of the "sprint_id" field.
+func (m *TaskMutation) ClearSprintID() {
+	m.sprint = nil
+	m.clearedFields[task.FieldSprintID] = struct{}{}
+}
+
+// SprintIDCleared returns if the "sprint_id" field was cleared in this mutation.
+func (m *TaskMutation) SprintIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldSprintID]
+	return ok
+}
+
+// ResetSprintID resets all changes to the "sprint_id" field.
+func (m *TaskMutation) ResetSprintID() {
+	m.sprint = nil
+	delete(m.clearedFields, task.FieldSprintID)
+}
+
+// SetEpicID sets the "epic_id" field.
+func (m *TaskMutation) SetEpicID(s string) {
+	m.epic = &s
+}
+
+// EpicID returns the value of the "epic_id" field in the mutation.
+func (m *TaskMutation) EpicID() (r string, exists bool) {
+	v := m.epic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEpicID returns the old "epic_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldEpicID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEpicID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEpicID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEpicID: %w", err)
+	}
+	return oldValue.EpicID, nil
+}
+
+// ClearEpicID clears the value of the "epic_id" field.
+func (m *TaskMutation) ClearEpicID() {
+	m.epic = nil
+	m.clearedFields[task.FieldEpicID] = struct{}{}
+}
+
+// EpicIDCleared returns if the "epic_id" field was cleared in this mutation.
+func (m *TaskMutation) EpicIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldEpicID]
+	return ok
+}
+
+// ResetEpicID resets all changes to the "epic_id" field.
+func (m *TaskMutation) ResetEpicID() {
+	m.epic = nil
+	delete(m.clearedFields, task.FieldEpicID)
+}
+
+// SetTitle sets the "title" field.
+func (m *TaskMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TaskMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TaskMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TaskMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TaskMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[task.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[task.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, task.FieldDescription)
+}
+
+// SetDueDate sets the "due_date" field.
+func (m *TaskMutation) SetDueDate(t time.Time) {
+	m.due_date = &t
+}
+
+// DueDate returns the value of the "due_date" field in the mutation.
+func (m *TaskMutation) DueDate() (r time.Time, exists bool) {
+	v := m.due_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueDate returns the old "due_date" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDueDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+	}
+	return oldValue.DueDate, nil
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (m *TaskMutation) ClearDueDate() {
+	m.due_date = nil
+	m.clearedFields[task.FieldDueDate] = struct{}{}
+}
+
+// DueDateCleared returns if the "due_date" field was cleared in this mutation.
+func (m *TaskMutation) DueDateCleared() bool {
+	_, ok := m.clearedFields[task.FieldDueDate]
+	return ok
+}
+
+// ResetDueDate resets all changes to the "due_date" field.
+func (m *TaskMutation) ResetDueDate() {
+	m.due_date = nil
+	delete(m.clearedFields, task.FieldDueDate)
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (m *TaskMutation) SetAssigneeID(s string) {
+	m.assignee_id = &s
+}
+
+// AssigneeID returns the value of the "assignee_id" field in the mutation.
+func (m *TaskMutation) AssigneeID() (r string, exists bool) {
+	v := m.assignee_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssigneeID returns the old "assignee_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldAssigneeID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssigneeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssigneeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssigneeID: %w", err)
+	}
+	return oldValue.AssigneeID, nil
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (m *TaskMutation) ClearAssigneeID() {
+	m.assignee_id = nil
+	m.clearedFields[task.FieldAssigneeID] = struct{}{}
+}
+
+// AssigneeIDCleared returns if the "assignee_id" field was cleared in this mutation.
+func (m *TaskMutation) AssigneeIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldAssigneeID]
+	return ok
+}
+
+// ResetAssigneeID resets all changes to the "assignee_id" field.
+func (m *TaskMutation) ResetAssigneeID() {
+	m.assignee_id = nil
+	delete(m.clearedFields, task.FieldAssigneeID)
+}
+
+// SetAssigneeName sets the "assignee_name" field.
+func (m *TaskMutation) SetAssigneeName(s string) {
+	m.assignee_name = &s
+}
+
+// AssigneeName returns the value of the "assignee_name" field in the mutation.
+func (m *TaskMutation) AssigneeName() (r string, exists bool) {
+	v := m.assignee_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssigneeName returns the old "assignee_name" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldAssigneeName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssigneeName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssigneeName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssigneeName: %w", err)
+	}
+	return oldValue.AssigneeName, nil
+}
+
+// ClearAssigneeName clears the value of the "assignee_name" field.
+func (m *TaskMutation) ClearAssigneeName() {
+	m.assignee_name = nil
+	m.clearedFields[task.FieldAssigneeName] = struct{}{}
+}
+
+// AssigneeNameCleared returns if the "assignee_name" field was cleared in this mutation.
+func (m *TaskMutation) AssigneeNameCleared() bool {
+	_, ok := m.clearedFields[task.FieldAssigneeName]
+	return ok
+}
+
+// ResetAssigneeName resets all changes to the "assignee_name" field.
+func (m *TaskMutation) ResetAssigneeName() {
+	m.assignee_name = nil
+	delete(m.clearedFields, task.FieldAssigneeName)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TaskMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TaskMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *TaskMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *TaskMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *TaskMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[task.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *TaskMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *TaskMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, task.FieldCompletedAt)
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *TaskMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *TaskMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *TaskMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[task.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *TaskMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *TaskMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, task.FieldArchivedAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *TaskMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TaskMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TaskMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRank sets the "rank" field.
+func (m *TaskMutation) SetRank(s string) {
+	m.rank = &s
+}
+
+// Rank returns the value of the "rank" field in the mutation.
+func (m *TaskMutation) Rank() (r string, exists bool) {
+	v := m.rank
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRank returns the old "rank" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldRank(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRank: %w", err)
+	}
+	return oldValue.Rank, nil
+}
+
+// ResetRank resets all changes to the "rank" field.
+func (m *TaskMutation) ResetRank() {
+	m.rank = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *TaskMutation) SetPriority(t task.Priority) {
+	m.priority = &t
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *TaskMutation) Priority() (r task.Priority, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPriority(ctx context.Context) (v *task.Priority, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (m *TaskMutation) ClearPriority() {
+	m.priority = nil
+	m.clearedFields[task.FieldPriority] = struct{}{}
+}
+
+// PriorityCleared returns if the "priority" field was cleared in this mutation.
+func (m *TaskMutation) PriorityCleared() bool {
+	_, ok := m.clearedFields[task.FieldPriority]
+	return ok
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *TaskMutation) ResetPriority() {
+	m.priority = nil
+	delete(m.clearedFields, task.FieldPriority)
+}
+
+// SetStoryPoints sets the "story_points" field.
+func (m *TaskMutation) SetStoryPoints(i int) {
+	m.story_points = &i
+	m.addstory_points = nil
+}
+
+// StoryPoints returns the value of the "story_points" field in the mutation.
+func (m *TaskMutation) StoryPoints() (r int, exists bool) {
+	v := m.story_points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoryPoints returns the old "story_points" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldStoryPoints(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoryPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoryPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoryPoints: %w", err)
+	}
+	return oldValue.StoryPoints, nil
+}
+
+// AddStoryPoints adds i to the "story_points" field.
+func (m *TaskMutation) AddStoryPoints(i int) {
+	if m.addstory_points != nil {
+		*m.addstory_points += i
+	} else {
+		m.addstory_points = &i
+	}
+}
+
+// AddedStoryPoints returns the value that was added to the "story_points" field in this mutation.
+func (m *TaskMutation) AddedStoryPoints() (r int, exists bool) {
+	v := m.addstory_points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStoryPoints clears the value of the "story_points" field.
+func (m *TaskMutation) ClearStoryPoints() {
+	m.story_points = nil
+	m.addstory_points = nil
+	m.clearedFields[task.FieldStoryPoints] = struct{}{}
+}
+
+// StoryPointsCleared returns if the "story_points" field was cleared in this mutation.
+func (m *TaskMutation) StoryPointsCleared() bool {
+	_, ok := m.clearedFields[task.FieldStoryPoints]
+	return ok
+}
+
+// ResetStoryPoints resets all changes to the "story_points" field.
+func (m *TaskMutation) ResetStoryPoints() {
+	m.story_points = nil
+	m.addstory_points = nil
+	delete(m.clearedFields, task.FieldStoryPoints)
+}
+
+// SetWipOverrideBy sets the "wip_override_by" field.
+func (m *TaskMutation) SetWipOverrideBy(s string) {
+	m.wip_override_by = &s
+}
+
+// WipOverrideBy returns the value of the "wip_override_by" field in the mutation.
+func (m *TaskMutation) WipOverrideBy() (r string, exists bool) {
+	v := m.wip_override_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWipOverrideBy returns the old "wip_override_by" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldWipOverrideBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWipOverrideBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWipOverrideBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWipOverrideBy: %w", err)
+	}
+	return oldValue.WipOverrideBy, nil
+}
+
+// ClearWipOverrideBy clears the value of the "wip_override_by" field.
+func (m *TaskMutation) ClearWipOverrideBy() {
+	m.wip_override_by = nil
+	m.clearedFields[task.FieldWipOverrideBy] = struct{}{}
+}
+
+// WipOverrideByCleared returns if the "wip_override_by" field was cleared in this mutation.
+func (m *TaskMutation) WipOverrideByCleared() bool {
+	_, ok := m.clearedFields[task.FieldWipOverrideBy]
+	return ok
+}
+
+// ResetWipOverrideBy resets all changes to the "wip_override_by" field.
+func (m *TaskMutation) ResetWipOverrideBy() {
+	m.wip_override_by = nil
+	delete(m.clearedFields, task.FieldWipOverrideBy)
+}
+
+// SetWipOverrideStatus sets the "wip_override_status" field.
+func (m *TaskMutation) SetWipOverrideStatus(s string) {
+	m.wip_override_status = &s
+}
+
+// WipOverrideStatus returns the value of the "wip_override_status" field in the mutation.
+func (m *TaskMutation) WipOverrideStatus() (r string, exists bool) {
+	v := m.wip_override_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWipOverrideStatus returns the old "wip_override_status" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldWipOverrideStatus(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWipOverrideStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWipOverrideStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWipOverrideStatus: %w", err)
+	}
+	return oldValue.WipOverrideStatus, nil
+}
+
+// ClearWipOverrideStatus clears the value of the "wip_override_status" field.
+func (m *TaskMutation) ClearWipOverrideStatus() {
+	m.wip_override_status = nil
+	m.clearedFields[task.FieldWipOverrideStatus] = struct{}{}
+}
+
+// WipOverrideStatusCleared returns if the "wip_override_status" field was cleared in this mutation.
+func (m *TaskMutation) WipOverrideStatusCleared() bool {
+	_, ok := m.clearedFields[task.FieldWipOverrideStatus]
+	return ok
+}
+
+// ResetWipOverrideStatus resets all changes to the "wip_override_status" field.
+func (m *TaskMutation) ResetWipOverrideStatus() {
+	m.wip_override_status = nil
+	delete(m.clearedFields, task.FieldWipOverrideStatus)
+}
+
+// SetWipOverrideAt sets the "wip_override_at" field.
+func (m *TaskMutation) SetWipOverrideAt(t time.Time) {
+	m.wip_override_at = &t
+}
+
+// WipOverrideAt returns the value of the "wip_override_at" field in the mutation.
+func (m *TaskMutation) WipOverrideAt() (r time.Time, exists bool) {
+	v := m.wip_override_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWipOverrideAt returns the old "wip_override_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldWipOverrideAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWipOverrideAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWipOverrideAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWipOverrideAt: %w", err)
+	}
+	return oldValue.WipOverrideAt, nil
+}
+
+// ClearWipOverrideAt clears the value of the "wip_override_at" field.
+func (m *TaskMutation) ClearWipOverrideAt() {
+	m.wip_override_at = nil
+	m.clearedFields[task.FieldWipOverrideAt] = struct{}{}
+}
+
+// WipOverrideAtCleared returns if the "wip_override_at" field was cleared in this mutation.
+func (m *TaskMutation) WipOverrideAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldWipOverrideAt]
+	return ok
+}
+
+// ResetWipOverrideAt resets all changes to the "wip_override_at" field.
+func (m *TaskMutation) ResetWipOverrideAt() {
+	m.wip_override_at = nil
+	delete(m.clearedFields, task.FieldWipOverrideAt)
+}
+
+// SetVersion sets the "version" field.
+func (m *TaskMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *TaskMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *TaskMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *TaskMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *TaskMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *TaskMutation) AddFileIDs(ids ...string) {
+	if m.files == nil {
+		m.files = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *TaskMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *TaskMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *TaskMutation) RemoveFileIDs(ids ...string) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *TaskMutation) RemovedFilesIDs() (ids []string) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *TaskMutation) FilesIDs() (ids []string) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *TaskMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *TaskMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[task.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *TaskMutation) BoardCleared() bool {
+	return m.BoardIDCleared() || m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) BoardIDs() (ids []string) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *TaskMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// ClearParent clears the "parent" edge to the Task entity.
+func (m *TaskMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[task.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Task entity was cleared.
+func (m *TaskMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *TaskMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by ids.
+func (m *TaskMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Task entity.
+func (m *TaskMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Task entity was cleared.
+func (m *TaskMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Task entity by IDs.
+func (m *TaskMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Task entity.
+func (m *TaskMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *TaskMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *TaskMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Task entity by ids.
+func (m *TaskMutation) AddBlockedByIDs(ids ...string) {
+	if m.blocked_by == nil {
+		m.blocked_by = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.blocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockedBy clears the "blocked_by" edge to the Task entity.
+func (m *TaskMutation) ClearBlockedBy() {
+	m.clearedblocked_by = true
+}
+
+// BlockedByCleared reports if the "blocked_by" edge to the Task entity was cleared.
+func (m *TaskMutation) BlockedByCleared() bool {
+	return m.clearedblocked_by
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to the Task entity by IDs.
+func (m *TaskMutation) RemoveBlockedByIDs(ids ...string) {
+	if m.removedblocked_by == nil {
+		m.removedblocked_by = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_by, ids[i])
+		m.removedblocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockedBy returns the removed IDs of the "blocked_by" edge to the Task entity.
+func (m *TaskMutation) RemovedBlockedByIDs() (ids []string) {
+	for id := range m.removedblocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockedByIDs returns the "blocked_by" edge IDs in the mutation.
+func (m *TaskMutation) BlockedByIDs() (ids []string) {
+	for id := range m.blocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockedBy resets all changes to the "blocked_by" edge.
+func (m *TaskMutation) ResetBlockedBy() {
+	m.blocked_by = nil
+	m.clearedblocked_by = false
+	m.removedblocked_by = nil
+}
+
+// AddBlockIDs adds the "blocks" edge to the Task entity by ids.
+func (m *TaskMutation) AddBlockIDs(ids ...string) {
+	if m.blocks == nil {
+		m.blocks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.blocks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlocks clears the "blocks" edge to the Task entity.
+func (m *TaskMutation) ClearBlocks() {
+	m.clearedblocks = true
+}
+
+// BlocksCleared reports if the "blocks" edge to the Task entity was cleared.
+func (m *TaskMutation) BlocksCleared() bool {
+	return m.clearedblocks
+}
+
+// RemoveBlockIDs removes the "blocks" edge to the Task entity by IDs.
+func (m *TaskMutation) RemoveBlockIDs(ids ...string) {
+	if m.removedblocks == nil {
+		m.removedblocks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.blocks, ids[i])
+		m.removedblocks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlocks returns the removed IDs of the "blocks" edge to the Task entity.
+func (m *TaskMutation) RemovedBlocksIDs() (ids []string) {
+	for id := range m.removedblocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlocksIDs returns the "blocks" edge IDs in the mutation.
+func (m *TaskMutation) BlocksIDs() (ids []string) {
+	for id := range m.blocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlocks resets all changes to the "blocks" edge.
+func (m *TaskMutation) ResetBlocks() {
+	m.blocks = nil
+	m.clearedblocks = false
+	m.removedblocks = nil
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by ids.
+func (m *TaskMutation) AddLabelIDs(ids ...string) {
+	if m.labels == nil {
+		m.labels = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.labels[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLabels clears the "labels" edge to the Label entity.
+func (m *TaskMutation) ClearLabels() {
+	m.clearedlabels = true
+}
+
+// LabelsCleared reports if the "labels" edge to the Label entity was cleared.
+func (m *TaskMutation) LabelsCleared() bool {
+	return m.clearedlabels
+}
+
+// RemoveLabelIDs removes the "labels" edge to the Label entity by IDs.
+func (m *TaskMutation) RemoveLabelIDs(ids ...string) {
+	if m.removedlabels == nil {
+		m.removedlabels = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.labels, ids[i])
+		m.removedlabels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLabels returns the removed IDs of the "labels" edge to the Label entity.
+func (m *TaskMutation) RemovedLabelsIDs() (ids []string) {
+	for id := range m.removedlabels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LabelsIDs returns the "labels" edge IDs in the mutation.
+func (m *TaskMutation) LabelsIDs() (ids []string) {
+	for id := range m.labels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLabels resets all changes to the "labels" edge.
+func (m *TaskMutation) ResetLabels() {
+	m.labels = nil
+	m.clearedlabels = false
+	m.removedlabels = nil
+}
+
+// ClearSprint clears the "sprint" edge to the Sprint entity.
+func (m *TaskMutation) ClearSprint() {
+	m.clearedsprint = true
+	m.clearedFields[task.FieldSprintID] = struct{}{}
+}
+
+// SprintCleared reports if the "sprint" edge to the Sprint entity was cleared.
+func (m *TaskMutation) SprintCleared() bool {
+	return m.SprintIDCleared() || m.clearedsprint
+}
+
+// SprintIDs returns the "sprint" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SprintID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) SprintIDs() (ids []string) {
+	if id := m.sprint; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSprint resets all changes to the "sprint" edge.
+func (m *TaskMutation) ResetSprint() {
+	m.sprint = nil
+	m.clearedsprint = false
+}
+
+// ClearEpic clears the "epic" edge to the Epic entity.
+func (m *TaskMutation) ClearEpic() {
+	m.clearedepic = true
+	m.clearedFields[task.FieldEpicID] = struct{}{}
+}
+
+// EpicCleared reports if the "epic" edge to the Epic entity was cleared.
+func (m *TaskMutation) EpicCleared() bool {
+	return m.EpicIDCleared() || m.clearedepic
+}
+
+// EpicIDs returns the "epic" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EpicID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) EpicIDs() (ids []string) {
+	if id := m.epic; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEpic resets all changes to the "epic" edge.
+func (m *TaskMutation) ResetEpic() {
+	m.epic = nil
+	m.clearedepic = false
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *TaskMutation) AddCommentIDs(ids ...string) {
+	if m.comments == nil {
+		m.comments = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *TaskMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *TaskMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *TaskMutation) RemoveCommentIDs(ids ...string) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *TaskMutation) RemovedCommentsIDs() (ids []string) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *TaskMutation) CommentsIDs() (ids []string) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *TaskMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddActivityIDs adds the "activities" edge to the Activity entity by ids.
+func (m *TaskMutation) AddActivityIDs(ids ...string) {
+	if m.activities == nil {
+		m.activities = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.activities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActivities clears the "activities" edge to the Activity entity.
+func (m *TaskMutation) ClearActivities() {
+	m.clearedactivities = true
+}
+
+// ActivitiesCleared reports if the "activities" edge to the Activity entity was cleared.
+func (m *TaskMutation) ActivitiesCleared() bool {
+	return m.clearedactivities
+}
+
+// RemoveActivityIDs removes the "activities" edge to the Activity entity by IDs.
+func (m *TaskMutation) RemoveActivityIDs(ids ...string) {
+	if m.removedactivities == nil {
+		m.removedactivities = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.activities, ids[i])
+		m.removedactivities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActivities returns the removed IDs of the "activities" edge to the Activity entity.
+func (m *TaskMutation) RemovedActivitiesIDs() (ids []string) {
+	for id := range m.removedactivities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActivitiesIDs returns the "activities" edge IDs in the mutation.
+func (m *TaskMutation) ActivitiesIDs() (ids []string) {
+	for id := range m.activities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActivities resets all changes to the "activities" edge.
+func (m *TaskMutation) ResetActivities() {
+	m.activities = nil
+	m.clearedactivities = false
+	m.removedactivities = nil
+}
+
+// AddAssigneeIDs adds the "assignees" edge to the Member entity by ids.
+func (m *TaskMutation) AddAssigneeIDs(ids ...string) {
+	if m.assignees == nil {
+		m.assignees = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.assignees[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignees clears the "assignees" edge to the Member entity.
+func (m *TaskMutation) ClearAssignees() {
+	m.clearedassignees = true
+}
+
+// AssigneesCleared reports if the "assignees" edge to the Member entity was cleared.
+func (m *TaskMutation) AssigneesCleared() bool {
+	return m.clearedassignees
+}
+
+// RemoveAssigneeIDs removes the "assignees" edge to the Member entity by IDs.
+func (m *TaskMutation) RemoveAssigneeIDs(ids ...string) {
+	if m.removedassignees == nil {
+		m.removedassignees = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.assignees, ids[i])
+		m.removedassignees[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignees returns the removed IDs of the "assignees" edge to the Member entity.
+func (m *TaskMutation) RemovedAssigneesIDs() (ids []string) {
+	for id := range m.removedassignees {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssigneesIDs returns the "assignees" edge IDs in the mutation.
+func (m *TaskMutation) AssigneesIDs() (ids []string) {
+	for id := range m.assignees {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignees resets all changes to the "assignees" edge.
+func (m *TaskMutation) ResetAssignees() {
+	m.assignees = nil
+	m.clearedassignees = false
+	m.removedassignees = nil
+}
+
+// AddWatcherIDs adds the "watchers" edge to the Member entity by ids.
+func (m *TaskMutation) AddWatcherIDs(ids ...string) {
+	if m.watchers == nil {
+		m.watchers = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.watchers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWatchers clears the "watchers" edge to the Member entity.
+func (m *TaskMutation) ClearWatchers() {
+	m.clearedwatchers = true
+}
+
+// WatchersCleared reports if the "watchers" edge to the Member entity was cleared.
+func (m *TaskMutation) WatchersCleared() bool {
+	return m.clearedwatchers
+}
+
+// RemoveWatcherIDs removes the "watchers" edge to the Member entity by IDs.
+func (m *TaskMutation) RemoveWatcherIDs(ids ...string) {
+	if m.removedwatchers == nil {
+		m.removedwatchers = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.watchers, ids[i])
+		m.removedwatchers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWatchers returns the removed IDs of the "watchers" edge to the Member entity.
+func (m *TaskMutation) RemovedWatchersIDs() (ids []string) {
+	for id := range m.removedwatchers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WatchersIDs returns the "watchers" edge IDs in the mutation.
+func (m *TaskMutation) WatchersIDs() (ids []string) {
+	for id := range m.watchers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWatchers resets all changes to the "watchers" edge.
+func (m *TaskMutation) ResetWatchers() {
+	m.watchers = nil
+	m.clearedwatchers = false
+	m.removedwatchers = nil
+}
+
+// AddChecklistItemIDs adds the "checklist_items" edge to the ChecklistItem entity by ids.
+func (m *TaskMutation) AddChecklistItemIDs(ids ...string) {
+	if m.checklist_items == nil {
+		m.checklist_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.checklist_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChecklistItems clears the "checklist_items" edge to the ChecklistItem entity.
+func (m *TaskMutation) ClearChecklistItems() {
+	m.clearedchecklist_items = true
+}
+
+// ChecklistItemsCleared reports if the "checklist_items" edge to the ChecklistItem entity was cleared.
+func (m *TaskMutation) ChecklistItemsCleared() bool {
+	return m.clearedchecklist_items
+}
+
+// RemoveChecklistItemIDs removes the "checklist_items" edge to the ChecklistItem entity by IDs.
+func (m *TaskMutation) RemoveChecklistItemIDs(ids ...string) {
+	if m.removedchecklist_items == nil {
+		m.removedchecklist_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.checklist_items, ids[i])
+		m.removedchecklist_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChecklistItems returns the removed IDs of the "checklist_items" edge to the ChecklistItem entity.
+func (m *TaskMutation) RemovedChecklistItemsIDs() (ids []string) {
+	for id := range m.removedchecklist_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChecklistItemsIDs returns the "checklist_items" edge IDs in the mutation.
+func (m *TaskMutation) ChecklistItemsIDs() (ids []string) {
+	for id := range m.checklist_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChecklistItems resets all changes to the "checklist_items" edge.
+func (m *TaskMutation) ResetChecklistItems() {
+	m.checklist_items = nil
+	m.clearedchecklist_items = false
+	m.removedchecklist_items = nil
+}
+
+// AddCustomFieldValueIDs adds the "custom_field_values" edge to the CustomFieldValue entity by ids.
+func (m *TaskMutation) AddCustomFieldValueIDs(ids ...int) {
+	if m.custom_field_values == nil {
+		m.custom_field_values = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.custom_field_values[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCustomFieldValues clears the "custom_field_values" edge to the CustomFieldValue entity.
+func (m *TaskMutation) ClearCustomFieldValues() {
+	m.clearedcustom_field_values = true
+}
+
+// CustomFieldValuesCleared reports if the "custom_field_values" edge to the CustomFieldValue entity was cleared.
+func (m *TaskMutation) CustomFieldValuesCleared() bool {
+	return m.clearedcustom_field_values
+}
+
+// RemoveCustomFieldValueIDs removes the "custom_field_values" edge to the CustomFieldValue entity by IDs.
+func (m *TaskMutation) RemoveCustomFieldValueIDs(ids ...int) {
+	if m.removedcustom_field_values == nil {
+		m.removedcustom_field_values = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.custom_field_values, ids[i])
+		m.removedcustom_field_values[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCustomFieldValues returns the removed IDs of the "custom_field_values" edge to the CustomFieldValue entity.
+func (m *TaskMutation) RemovedCustomFieldValuesIDs() (ids []int) {
+	for id := range m.removedcustom_field_values {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CustomFieldValuesIDs returns the "custom_field_values" edge IDs in the mutation.
+func (m *TaskMutation) CustomFieldValuesIDs() (ids []int) {
+	for id := range m.custom_field_values {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCustomFieldValues resets all changes to the "custom_field_values" edge.
+func (m *TaskMutation) ResetCustomFieldValues() {
+	m.custom_field_values = nil
+	m.clearedcustom_field_values = false
+	m.removedcustom_field_values = nil
+}
+
+// AddWorklogIDs adds the "worklogs" edge to the Worklog entity by ids.
+func (m *TaskMutation) AddWorklogIDs(ids ...string) {
+	if m.worklogs == nil {
+		m.worklogs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.worklogs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWorklogs clears the "worklogs" edge to the Worklog entity.
+func (m *TaskMutation) ClearWorklogs() {
+	m.clearedworklogs = true
+}
+
+// WorklogsCleared reports if the "worklogs" edge to the Worklog entity was cleared.
+func (m *TaskMutation) WorklogsCleared() bool {
+	return m.clearedworklogs
+}
+
+// RemoveWorklogIDs removes the "worklogs" edge to the Worklog entity by IDs.
+func (m *TaskMutation) RemoveWorklogIDs(ids ...string) {
+	if m.removedworklogs == nil {
+		m.removedworklogs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.worklogs, ids[i])
+		m.removedworklogs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWorklogs returns the removed IDs of the "worklogs" edge to the Worklog entity.
+func (m *TaskMutation) RemovedWorklogsIDs() (ids []string) {
+	for id := range m.removedworklogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WorklogsIDs returns the "worklogs" edge IDs in the mutation.
+func (m *TaskMutation) WorklogsIDs() (ids []string) {
+	for id := range m.worklogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWorklogs resets all changes to the "worklogs" edge.
+func (m *TaskMutation) ResetWorklogs() {
+	m.worklogs = nil
+	m.clearedworklogs = false
+	m.removedworklogs = nil
+}
+
+// AddTimerIDs adds the "timers" edge to the Timer entity by ids.
+func (m *TaskMutation) AddTimerIDs(ids ...int) {
+	if m.timers == nil {
+		m.timers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.timers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTimers clears the "timers" edge to the Timer entity.
+func (m *TaskMutation) ClearTimers() {
+	m.clearedtimers = true
+}
+
+// TimersCleared reports if the "timers" edge to the Timer entity was cleared.
+func (m *TaskMutation) TimersCleared() bool {
+	return m.clearedtimers
+}
+
+// RemoveTimerIDs removes the "timers" edge to the Timer entity by IDs.
+func (m *TaskMutation) RemoveTimerIDs(ids ...int) {
+	if m.removedtimers == nil {
+		m.removedtimers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.timers, ids[i])
+		m.removedtimers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTimers returns the removed IDs of the "timers" edge to the Timer entity.
+func (m *TaskMutation) RemovedTimersIDs() (ids []int) {
+	for id := range m.removedtimers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TimersIDs returns the "timers" edge IDs in the mutation.
+func (m *TaskMutation) TimersIDs() (ids []int) {
+	for id := range m.timers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTimers resets all changes to the "timers" edge.
+func (m *TaskMutation) ResetTimers() {
+	m.timers = nil
+	m.clearedtimers = false
+	m.removedtimers = nil
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the StatusChange entity by ids.
+func (m *TaskMutation) AddStatusChangeIDs(ids ...int) {
+	if m.status_changes == nil {
+		m.status_changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.status_changes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatusChanges clears the "status_changes" edge to the StatusChange entity.
+func (m *TaskMutation) ClearStatusChanges() {
+	m.clearedstatus_changes = true
+}
+
+// StatusChangesCleared reports if the "status_changes" edge to the StatusChange entity was cleared.
+func (m *TaskMutation) StatusChangesCleared() bool {
+	return m.clearedstatus_changes
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to the StatusChange entity by IDs.
+func (m *TaskMutation) RemoveStatusChangeIDs(ids ...int) {
+	if m.removedstatus_changes == nil {
+		m.removedstatus_changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.status_changes, ids[i])
+		m.removedstatus_changes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatusChanges returns the removed IDs of the "status_changes" edge to the StatusChange entity.
+func (m *TaskMutation) RemovedStatusChangesIDs() (ids []int) {
+	for id := range m.removedstatus_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatusChangesIDs returns the "status_changes" edge IDs in the mutation.
+func (m *TaskMutation) StatusChangesIDs() (ids []int) {
+	for id := range m.status_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatusChanges resets all changes to the "status_changes" edge.
+func (m *TaskMutation) ResetStatusChanges() {
+	m.status_changes = nil
+	m.clearedstatus_changes = false
+	m.removedstatus_changes = nil
+}
+
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.board != nil {
+		fields = append(fields, task.FieldBoardID)
+	}
+	if m.parent != nil {
+		fields = append(fields, task.FieldParentID)
+	}
+	if m.sprint != nil {
+		fields = append(fields, task.FieldSprintID)
+	}
+	if m.epic != nil {
+		fields = append(fields, task.FieldEpicID)
+	}
+	if m.title != nil {
+		fields = append(fields, task.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.due_date != nil {
+		fields = append(fields, task.FieldDueDate)
+	}
+	if m.assignee_id != nil {
+		fields = append(fields, task.FieldAssigneeID)
+	}
+	if m.assignee_name != nil {
+		fields = append(fields, task.FieldAssigneeName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, task.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, task.FieldUpdatedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, task.FieldCompletedAt)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, task.FieldArchivedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, task.FieldStatus)
+	}
+	if m.rank != nil {
+		fields = append(fields, task.FieldRank)
+	}
+	if m.priority != nil {
+		fields = append(fields, task.FieldPriority)
+	}
+	if m.story_points != nil {
+		fields = append(fields, task.FieldStoryPoints)
+	}
+	if m.wip_override_by != nil {
+		fields = append(fields, task.FieldWipOverrideBy)
+	}
+	if m.wip_override_status != nil {
+		fields = append(fields, task.FieldWipOverrideStatus)
+	}
+	if m.wip_override_at != nil {
+		fields = append(fields, task.FieldWipOverrideAt)
+	}
+	if m.version != nil {
+		fields = append(fields, task.FieldVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldBoardID:
+		return m.BoardID()
+	case task.FieldParentID:
+		return m.ParentID()
+	case task.FieldSprintID:
+		return m.SprintID()
+	case task.FieldEpicID:
+		return m.EpicID()
+	case task.FieldTitle:
+		return m.Title()
+	case task.FieldDescription:
+		return m.Description()
+	case task.FieldDueDate:
+		return m.DueDate()
+	case task.FieldAssigneeID:
+		return m.AssigneeID()
+	case task.FieldAssigneeName:
+		return m.AssigneeName()
+	case task.FieldCreatedAt:
+		return m.CreatedAt()
+	case task.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case task.FieldCompletedAt:
+		return m.CompletedAt()
+	case task.FieldArchivedAt:
+		return m.ArchivedAt()
+	case task.FieldStatus:
+		return m.Status()
+	case task.FieldRank:
+		return m.Rank()
+	case task.FieldPriority:
+		return m.Priority()
+	case task.FieldStoryPoints:
+		return m.StoryPoints()
+	case task.FieldWipOverrideBy:
+		return m.WipOverrideBy()
+	case task.FieldWipOverrideStatus:
+		return m.WipOverrideStatus()
+	case task.FieldWipOverrideAt:
+		return m.WipOverrideAt()
+	case task.FieldVersion:
+		return m.Version()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case task.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case task.FieldParentID:
+		return m.OldParentID(ctx)
+	case task.FieldSprintID:
+		return m.OldSprintID(ctx)
+	case task.FieldEpicID:
+		return m.OldEpicID(ctx)
+	case task.FieldTitle:
+		return m.OldTitle(ctx)
+	case task.FieldDescription:
+		return m.OldDescription(ctx)
+	case task.FieldDueDate:
+		return m.OldDueDate(ctx)
+	case task.FieldAssigneeID:
+		return m.OldAssigneeID(ctx)
+	case task.FieldAssigneeName:
+		return m.OldAssigneeName(ctx)
+	case task.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case task.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case task.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case task.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case task.FieldStatus:
+		return m.OldStatus(ctx)
+	case task.FieldRank:
+		return m.OldRank(ctx)
+	case task.FieldPriority:
+		return m.OldPriority(ctx)
+	case task.FieldStoryPoints:
+		return m.OldStoryPoints(ctx)
+	case task.FieldWipOverrideBy:
+		return m.OldWipOverrideBy(ctx)
+	case task.FieldWipOverrideStatus:
+		return m.OldWipOverrideStatus(ctx)
+	case task.FieldWipOverrideAt:
+		return m.OldWipOverrideAt(ctx)
+	case task.FieldVersion:
+		return m.OldVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown Task field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldBoardID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case task.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case task.FieldSprintID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSprintID(v)
+		return nil
+	case task.FieldEpicID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEpicID(v)
+		return nil
+	case task.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case task.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case task.FieldDueDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueDate(v)
+		return nil
+	case task.FieldAssigneeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssigneeID(v)
+		return nil
+	case task.FieldAssigneeName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssigneeName(v)
+		return nil
+	case task.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case task.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case task.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case task.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	case task.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case task.FieldRank:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRank(v)
+		return nil
+	case task.FieldPriority:
+		v, ok := value.(task.Priority)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case task.FieldStoryPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoryPoints(v)
+		return nil
+	case task.FieldWipOverrideBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWipOverrideBy(v)
+		return nil
+	case task.FieldWipOverrideStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWipOverrideStatus(v)
+		return nil
+	case task.FieldWipOverrideAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWipOverrideAt(v)
+		return nil
+	case task.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addstory_points != nil {
+		fields = append(fields, task.FieldStoryPoints)
+	}
+	if m.addversion != nil {
+		fields = append(fields, task.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldStoryPoints:
+		return m.AddedStoryPoints()
+	case task.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldStoryPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStoryPoints(v)
+		return nil
+	case task.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(task.FieldBoardID) {
+		fields = append(fields, task.FieldBoardID)
+	}
+	if m.FieldCleared(task.FieldParentID) {
+		fields = append(fields, task.FieldParentID)
+	}
+	if m.FieldCleared(task.FieldSprintID) {
+		fields = append(fields, task.FieldSprintID)
+	}
+	if m.FieldCleared(task.FieldEpicID) {
+		fields = append(fields, task.FieldEpicID)
+	}
+	if m.FieldCleared(task.FieldDescription) {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.FieldCleared(task.FieldDueDate) {
+		fields = append(fields, task.FieldDueDate)
+	}
+	if m.FieldCleared(task.FieldAssigneeID) {
+		fields = append(fields, task.FieldAssigneeID)
+	}
+	if m.FieldCleared(task.FieldAssigneeName) {
+		fields = append(fields, task.FieldAssigneeName)
+	}
+	if m.FieldCleared(task.FieldCompletedAt) {
+		fields = append(fields, task.FieldCompletedAt)
+	}
+	if m.FieldCleared(task.FieldArchivedAt) {
+		fields = append(fields, task.FieldArchivedAt)
+	}
+	if m.FieldCleared(task.FieldPriority) {
+		fields = append(fields, task.FieldPriority)
+	}
+	if m.FieldCleared(task.FieldStoryPoints) {
+		fields = append(fields, task.FieldStoryPoints)
+	}
+	if m.FieldCleared(task.FieldWipOverrideBy) {
+		fields = append(fields, task.FieldWipOverrideBy)
+	}
+	if m.FieldCleared(task.FieldWipOverrideStatus) {
+		fields = append(fields, task.FieldWipOverrideStatus)
+	}
+	if m.FieldCleared(task.FieldWipOverrideAt) {
+		fields = append(fields, task.FieldWipOverrideAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskMutation) ClearField(name string) error {
+	switch name {
+	case task.FieldBoardID:
+		m.ClearBoardID()
+		return nil
+	case task.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case task.FieldSprintID:
+		m.ClearSprintID()
+		return nil
+	case task.FieldEpicID:
+		m.ClearEpicID()
+		return nil
+	case task.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case task.FieldDueDate:
+		m.ClearDueDate()
+		return nil
+	case task.FieldAssigneeID:
+		m.ClearAssigneeID()
+		return nil
+	case task.FieldAssigneeName:
+		m.ClearAssigneeName()
+		return nil
+	case task.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case task.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	case task.FieldPriority:
+		m.ClearPriority()
+		return nil
+	case task.FieldStoryPoints:
+		m.ClearStoryPoints()
+		return nil
+	case task.FieldWipOverrideBy:
+		m.ClearWipOverrideBy()
+		return nil
+	case task.FieldWipOverrideStatus:
+		m.ClearWipOverrideStatus()
+		return nil
+	case task.FieldWipOverrideAt:
+		m.ClearWipOverrideAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Task nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskMutation) ResetField(name string) error {
+	switch name {
+	case task.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case task.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case task.FieldSprintID:
+		m.ResetSprintID()
+		return nil
+	case task.FieldEpicID:
+		m.ResetEpicID()
+		return nil
+	case task.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case task.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case task.FieldDueDate:
+		m.ResetDueDate()
+		return nil
+	case task.FieldAssigneeID:
+		m.ResetAssigneeID()
+		return nil
+	case task.FieldAssigneeName:
+		m.ResetAssigneeName()
+		return nil
+	case task.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case task.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case task.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case task.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	case task.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case task.FieldRank:
+		m.ResetRank()
+		return nil
+	case task.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case task.FieldStoryPoints:
+		m.ResetStoryPoints()
+		return nil
+	case task.FieldWipOverrideBy:
+		m.ResetWipOverrideBy()
+		return nil
+	case task.FieldWipOverrideStatus:
+		m.ResetWipOverrideStatus()
+		return nil
+	case task.FieldWipOverrideAt:
+		m.ResetWipOverrideAt()
+		return nil
+	case task.FieldVersion:
+		m.ResetVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 18)
+	if m.files != nil {
+		edges = append(edges, task.EdgeFiles)
+	}
+	if m.board != nil {
+		edges = append(edges, task.EdgeBoard)
+	}
+	if m.parent != nil {
+		edges = append(edges, task.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, task.EdgeChildren)
+	}
+	if m.blocked_by != nil {
+		edges = append(edges, task.EdgeBlockedBy)
+	}
+	if m.blocks != nil {
+		edges = append(edges, task.EdgeBlocks)
+	}
+	if m.labels != nil {
+		edges = append(edges, task.EdgeLabels)
+	}
+	if m.sprint != nil {
+		edges = append(edges, task.EdgeSprint)
+	}
+	if m.epic != nil {
+		edges = append(edges, task.EdgeEpic)
+	}
+	if m.comments != nil {
+		edges = append(edges, task.EdgeComments)
+	}
+	if m.activities != nil {
+		edges = append(edges, task.EdgeActivities)
+	}
+	if m.assignees != nil {
+		edges = append(edges, task.EdgeAssignees)
+	}
+	if m.watchers != nil {
+		edges = append(edges, task.EdgeWatchers)
+	}
+	if m.checklist_items != nil {
+		edges = append(edges, task.EdgeChecklistItems)
+	}
+	if m.custom_field_values != nil {
+		edges = append(edges, task.EdgeCustomFieldValues)
+	}
+	if m.worklogs != nil {
+		edges = append(edges, task.EdgeWorklogs)
+	}
+	if m.timers != nil {
+		edges = append(edges, task.EdgeTimers)
+	}
+	if m.status_changes != nil {
+		edges = append(edges, task.EdgeStatusChanges)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.blocked_by))
+		for id := range m.blocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeBlocks:
+		ids := make([]ent.Value, 0, len(m.blocks))
+		for id := range m.blocks {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeLabels:
+		ids := make([]ent.Value, 0, len(m.labels))
+		for id := range m.labels {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeSprint:
+		if id := m.sprint; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeEpic:
+		if id := m.epic; id != nil {
+			return []ent.Value{*id}
+		}
+	case task.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeActivities:
+		ids := make([]ent.Value, 0, len(m.activities))
+		for id := range m.activities {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeAssignees:
+		ids := make([]ent.Value, 0, len(m.assignees))
+		for id := range m.assignees {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeWatchers:
+		ids := make([]ent.Value, 0, len(m.watchers))
+		for id := range m.watchers {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeChecklistItems:
+		ids := make([]ent.Value, 0, len(m.checklist_items))
+		for id := range m.checklist_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeCustomFieldValues:
+		ids := make([]ent.Value, 0, len(m.custom_field_values))
+		for id := range m.custom_field_values {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeWorklogs:
+		ids := make([]ent.Value, 0, len(m.worklogs))
+		for id := range m.worklogs {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeTimers:
+		ids := make([]ent.Value, 0, len(m.timers))
+		for id := range m.timers {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeStatusChanges:
+		ids := make([]ent.Value, 0, len(m.status_changes))
+		for id := range m.status_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 18)
+	if m.removedfiles != nil {
+		edges = append(edges, task.EdgeFiles)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, task.EdgeChildren)
+	}
+	if m.removedblocked_by != nil {
+		edges = append(edges, task.EdgeBlockedBy)
+	}
+	if m.removedblocks != nil {
+		edges = append(edges, task.EdgeBlocks)
+	}
+	if m.removedlabels != nil {
+		edges = append(edges, task.EdgeLabels)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, task.EdgeComments)
+	}
+	if m.removedactivities != nil {
+		edges = append(edges, task.EdgeActivities)
+	}
+	if m.removedassignees != nil {
+		edges = append(edges, task.EdgeAssignees)
+	}
+	if m.removedwatchers != nil {
+		edges = append(edges, task.EdgeWatchers)
+	}
+	if m.removedchecklist_items != nil {
+		edges = append(edges, task.EdgeChecklistItems)
+	}
+	if m.removedcustom_field_values != nil {
+		edges = append(edges, task.EdgeCustomFieldValues)
+	}
+	if m.removedworklogs != nil {
+		edges = append(edges, task.EdgeWorklogs)
+	}
+	if m.removedtimers != nil {
+		edges = append(edges, task.EdgeTimers)
+	}
+	if m.removedstatus_changes != nil {
+		edges = append(edges, task.EdgeStatusChanges)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.removedblocked_by))
+		for id := range m.removedblocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeBlocks:
+		ids := make([]ent.Value, 0, len(m.removedblocks))
+		for id := range m.removedblocks {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeLabels:
+		ids := make([]ent.Value, 0, len(m.removedlabels))
+		for id := range m.removedlabels {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeActivities:
+		ids := make([]ent.Value, 0, len(m.removedactivities))
+		for id := range m.removedactivities {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeAssignees:
+		ids := make([]ent.Value, 0, len(m.removedassignees))
+		for id := range m.removedassignees {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeWatchers:
+		ids := make([]ent.Value, 0, len(m.removedwatchers))
+		for id := range m.removedwatchers {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeChecklistItems:
+		ids := make([]ent.Value, 0, len(m.removedchecklist_items))
+		for id := range m.removedchecklist_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeCustomFieldValues:
+		ids := make([]ent.Value, 0, len(m.removedcustom_field_values))
+		for id := range m.removedcustom_field_values {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeWorklogs:
+		ids := make([]ent.Value, 0, len(m.removedworklogs))
+		for id := range m.removedworklogs {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeTimers:
+		ids := make([]ent.Value, 0, len(m.removedtimers))
+		for id := range m.removedtimers {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeStatusChanges:
+		ids := make([]ent.Value, 0, len(m.removedstatus_changes))
+		for id := range m.removedstatus_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 18)
+	if m.clearedfiles {
+		edges = append(edges, task.EdgeFiles)
+	}
+	if m.clearedboard {
+		edges = append(edges, task.EdgeBoard)
+	}
+	if m.clearedparent {
+		edges = append(edges, task.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, task.EdgeChildren)
+	}
+	if m.clearedblocked_by {
+		edges = append(edges, task.EdgeBlockedBy)
+	}
+	if m.clearedblocks {
+		edges = append(edges, task.EdgeBlocks)
+	}
+	if m.clearedlabels {
+		edges = append(edges, task.EdgeLabels)
+	}
+	if m.clearedsprint {
+		edges = append(edges, task.EdgeSprint)
+	}
+	if m.clearedepic {
+		edges = append(edges, task.EdgeEpic)
+	}
+	if m.clearedcomments {
+		edges = append(edges, task.EdgeComments)
+	}
+	if m.clearedactivities {
+		edges = append(edges, task.EdgeActivities)
+	}
+	if m.clearedassignees {
+		edges = append(edges, task.EdgeAssignees)
+	}
+	if m.clearedwatchers {
+		edges = append(edges, task.EdgeWatchers)
+	}
+	if m.clearedchecklist_items {
+		edges = append(edges, task.EdgeChecklistItems)
+	}
+	if m.clearedcustom_field_values {
+		edges = append(edges, task.EdgeCustomFieldValues)
+	}
+	if m.clearedworklogs {
+		edges = append(edges, task.EdgeWorklogs)
+	}
+	if m.clearedtimers {
+		edges = append(edges, task.EdgeTimers)
+	}
+	if m.clearedstatus_changes {
+		edges = append(edges, task.EdgeStatusChanges)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case task.EdgeFiles:
+		return m.clearedfiles
+	case task.EdgeBoard:
+		return m.clearedboard
+	case task.EdgeParent:
+		return m.clearedparent
+	case task.EdgeChildren:
+		return m.clearedchildren
+	case task.EdgeBlockedBy:
+		return m.clearedblocked_by
+	case task.EdgeBlocks:
+		return m.clearedblocks
+	case task.EdgeLabels:
+		return m.clearedlabels
+	case task.EdgeSprint:
+		return m.clearedsprint
+	case task.EdgeEpic:
+		return m.clearedepic
+	case task.EdgeComments:
+		return m.clearedcomments
+	case task.EdgeActivities:
+		return m.clearedactivities
+	case task.EdgeAssignees:
+		return m.clearedassignees
+	case task.EdgeWatchers:
+		return m.clearedwatchers
+	case task.EdgeChecklistItems:
+		return m.clearedchecklist_items
+	case task.EdgeCustomFieldValues:
+		return m.clearedcustom_field_values
+	case task.EdgeWorklogs:
+		return m.clearedworklogs
+	case task.EdgeTimers:
+		return m.clearedtimers
+	case task.EdgeStatusChanges:
+		return m.clearedstatus_changes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskMutation) ClearEdge(name string) error {
+	switch name {
+	case task.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	case task.EdgeParent:
+		m.ClearParent()
+		return nil
+	case task.EdgeSprint:
+		m.ClearSprint()
+		return nil
+	case task.EdgeEpic:
+		m.ClearEpic()
+		return nil
+	}
+	return fmt.Errorf("unknown Task unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskMutation) ResetEdge(name string) error {
+	switch name {
+	case task.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case task.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case task.EdgeParent:
+		m.ResetParent()
+		return nil
+	case task.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case task.EdgeBlockedBy:
+		m.ResetBlockedBy()
+		return nil
+	case task.EdgeBlocks:
+		m.ResetBlocks()
+		return nil
+	case task.EdgeLabels:
+		m.ResetLabels()
+		return nil
+	case task.EdgeSprint:
+		m.ResetSprint()
+		return nil
+	case task.EdgeEpic:
+		m.ResetEpic()
+		return nil
+	case task.EdgeComments:
+		m.ResetComments()
+		return nil
+	case task.EdgeActivities:
+		m.ResetActivities()
+		return nil
+	case task.EdgeAssignees:
+		m.ResetAssignees()
+		return nil
+	case task.EdgeWatchers:
+		m.ResetWatchers()
+		return nil
+	case task.EdgeChecklistItems:
+		m.ResetChecklistItems()
+		return nil
+	case task.EdgeCustomFieldValues:
+		m.ResetCustomFieldValues()
+		return nil
+	case task.EdgeWorklogs:
+		m.ResetWorklogs()
+		return nil
+	case task.EdgeTimers:
+		m.ResetTimers()
+		return nil
+	case task.EdgeStatusChanges:
+		m.ResetStatusChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown Task edge %s", name)
+}
+
+// TaskTemplateMutation represents an operation that mutates the TaskTemplate nodes in the graph.
+type TaskTemplateMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	title               *string
+	description         *string
+	label_ids           *[]string
+	appendlabel_ids     []string
+	default_assignee_id *string
+	subtasks            *[]string
+	appendsubtasks      []string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	board               *string
+	clearedboard        bool
+	files               map[string]struct{}
+	removedfiles        map[string]struct{}
+	clearedfiles        bool
+	done                bool
+	oldValue            func(context.Context) (*TaskTemplate, error)
+	predicates          []predicate.TaskTemplate
+}
+
+var _ ent.Mutation = (*TaskTemplateMutation)(nil)
+
+// tasktemplateOption allows management of the mutation configuration using functional options.
+type tasktemplateOption func(*TaskTemplateMutation)
+
+// newTaskTemplateMutation creates new mutation for the TaskTemplate entity.
+func newTaskTemplateMutation(c config, op Op, opts ...tasktemplateOption) *TaskTemplateMutation {
+	m := &TaskTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskTemplateID sets the ID field of the mutation.
+func withTaskTemplateID(id string) tasktemplateOption {
+	return func(m *TaskTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*TaskTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaskTemplate sets the old TaskTemplate of the mutation.
+func withTaskTemplate(node *TaskTemplate) tasktemplateOption {
+	return func(m *TaskTemplateMutation) {
+		m.oldValue = func(context.Context) (*TaskTemplate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TaskTemplate entities.
+func (m *TaskTemplateMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskTemplateMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskTemplateMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBoardID sets the "board_id" field.
+func (m *TaskTemplateMutation) SetBoardID(s string) {
+	m.board = &s
+}
+
+// BoardID returns the value of the "board_id" field in the mutation.
+func (m *TaskTemplateMutation) BoardID() (r string, exists bool) {
+	v := m.board
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoardID returns the old "board_id" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldBoardID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoardID: %w", err)
+	}
+	return oldValue.BoardID, nil
+}
+
+// ResetBoardID resets all changes to the "board_id" field.
+func (m *TaskTemplateMutation) ResetBoardID() {
+	m.board = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *TaskTemplateMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TaskTemplateMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TaskTemplateMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TaskTemplateMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskTemplateMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TaskTemplateMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[tasktemplate.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskTemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[tasktemplate.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskTemplateMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, tasktemplate.FieldDescription)
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (m *TaskTemplateMutation) SetLabelIds(s []string) {
+	m.label_ids = &s
+	m.appendlabel_ids = nil
+}
+
+// LabelIds returns the value of the "label_ids" field in the mutation.
+func (m *TaskTemplateMutation) LabelIds() (r []string, exists bool) {
+	v := m.label_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelIds returns the old "label_ids" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldLabelIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelIds: %w", err)
+	}
+	return oldValue.LabelIds, nil
+}
+
+// AppendLabelIds adds s to the "label_ids" field.
+func (m *TaskTemplateMutation) AppendLabelIds(s []string) {
+	m.appendlabel_ids = append(m.appendlabel_ids, s...)
+}
+
+// AppendedLabelIds returns the list of values that were appended to the "label_ids" field in this mutation.
+func (m *TaskTemplateMutation) AppendedLabelIds() ([]string, bool) {
+	if len(m.appendlabel_ids) == 0 {
+		return nil, false
+	}
+	return m.appendlabel_ids, true
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (m *TaskTemplateMutation) ClearLabelIds() {
+	m.label_ids = nil
+	m.appendlabel_ids = nil
+	m.clearedFields[tasktemplate.FieldLabelIds] = struct{}{}
+}
+
+// LabelIdsCleared returns if the "label_ids" field was cleared in this mutation.
+func (m *TaskTemplateMutation) LabelIdsCleared() bool {
+	_, ok := m.clearedFields[tasktemplate.FieldLabelIds]
+	return ok
+}
+
+// ResetLabelIds resets all changes to the "label_ids" field.
+func (m *TaskTemplateMutation) ResetLabelIds() {
+	m.label_ids = nil
+	m.appendlabel_ids = nil
+	delete(m.clearedFields, tasktemplate.FieldLabelIds)
+}
+
+// SetDefaultAssigneeID sets the "default_assignee_id" field.
+func (m *TaskTemplateMutation) SetDefaultAssigneeID(s string) {
+	m.default_assignee_id = &s
+}
+
+// DefaultAssigneeID returns the value of the "default_assignee_id" field in the mutation.
+func (m *TaskTemplateMutation) DefaultAssigneeID() (r string, exists bool) {
+	v := m.default_assignee_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultAssigneeID returns the old "default_assignee_id" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldDefaultAssigneeID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultAssigneeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultAssigneeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultAssigneeID: %w", err)
+	}
+	return oldValue.DefaultAssigneeID, nil
+}
+
+// ClearDefaultAssigneeID clears the value of the "default_assignee_id" field.
+func (m *TaskTemplateMutation) ClearDefaultAssigneeID() {
+	m.default_assignee_id = nil
+	m.clearedFields[tasktemplate.FieldDefaultAssigneeID] = struct{}{}
+}
+
+// DefaultAssigneeIDCleared returns if the "default_assignee_id" field was cleared in this mutation.
+func (m *TaskTemplateMutation) DefaultAssigneeIDCleared() bool {
+	_, ok := m.clearedFields[tasktemplate.FieldDefaultAssigneeID]
+	return ok
+}
+
+// ResetDefaultAssigneeID resets all changes to the "default_assignee_id" field.
+func (m *TaskTemplateMutation) ResetDefaultAssigneeID() {
+	m.default_assignee_id = nil
+	delete(m.clearedFields, tasktemplate.FieldDefaultAssigneeID)
+}
+
+// SetSubtasks sets the "subtasks" field.
+func (m *TaskTemplateMutation) SetSubtasks(s []string) {
+	m.subtasks = &s
+	m.appendsubtasks = nil
+}
+
+// Subtasks returns the value of the "subtasks" field in the mutation.
+func (m *TaskTemplateMutation) Subtasks() (r []string, exists bool) {
+	v := m.subtasks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtasks returns the old "subtasks" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldSubtasks(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtasks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtasks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtasks: %w", err)
+	}
+	return oldValue.Subtasks, nil
+}
+
+// AppendSubtasks adds s to the "subtasks" field.
+func (m *TaskTemplateMutation) AppendSubtasks(s []string) {
+	m.appendsubtasks = append(m.appendsubtasks, s...)
+}
+
+// AppendedSubtasks returns the list of values that were appended to the "subtasks" field in this mutation.
+func (m *TaskTemplateMutation) AppendedSubtasks() ([]string, bool) {
+	if len(m.appendsubtasks) == 0 {
+		return nil, false
+	}
+	return m.appendsubtasks, true
+}
+
+// ClearSubtasks clears the value of the "subtasks" field.
+func (m *TaskTemplateMutation) ClearSubtasks() {
+	m.subtasks = nil
+	m.appendsubtasks = nil
+	m.clearedFields[tasktemplate.FieldSubtasks] = struct{}{}
+}
+
+// SubtasksCleared returns if the "subtasks" field was cleared in this mutation.
+func (m *TaskTemplateMutation) SubtasksCleared() bool {
+	_, ok := m.clearedFields[tasktemplate.FieldSubtasks]
+	return ok
+}
+
+// ResetSubtasks resets all changes to the "subtasks" field.
+func (m *TaskTemplateMutation) ResetSubtasks() {
+	m.subtasks = nil
+	m.appendsubtasks = nil
+	delete(m.clearedFields, tasktemplate.FieldSubtasks)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TaskTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TaskTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TaskTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (m *TaskTemplateMutation) ClearBoard() {
+	m.clearedboard = true
+	m.clearedFields[tasktemplate.FieldBoardID] = struct{}{}
+}
+
+// BoardCleared reports if the "board" edge to the Board entity was cleared.
+func (m *TaskTemplateMutation) BoardCleared() bool {
+	return m.clearedboard
+}
+
+// BoardIDs returns the "board" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoardID instead. It exists only for internal usage by the builders.
+func (m *TaskTemplateMutation) BoardIDs() (ids []string) {
+	if id := m.board; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBoard resets all changes to the "board" edge.
+func (m *TaskTemplateMutation) ResetBoard() {
+	m.board = nil
+	m.clearedboard = false
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *TaskTemplateMutation) AddFileIDs(ids ...string) {
+	if m.files == nil {
+		m.files = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *TaskTemplateMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *TaskTemplateMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *TaskTemplateMutation) RemoveFileIDs(ids ...string) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *TaskTemplateMutation) RemovedFilesIDs() (ids []string) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *TaskTemplateMutation) FilesIDs() (ids []string) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *TaskTemplateMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// Where appends a list predicates to the TaskTemplateMutation builder.
+func (m *TaskTemplateMutation) Where(ps ...predicate.TaskTemplate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskTemplateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskTemplate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskTemplateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskTemplateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskTemplate).
+func (m *TaskTemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.board != nil {
+		fields = append(fields, tasktemplate.FieldBoardID)
+	}
+	if m.title != nil {
+		fields = append(fields, tasktemplate.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, tasktemplate.FieldDescription)
+	}
+	if m.label_ids != nil {
+		fields = append(fields, tasktemplate.FieldLabelIds)
+	}
+	if m.default_assignee_id != nil {
+		fields = append(fields, tasktemplate.FieldDefaultAssigneeID)
+	}
+	if m.subtasks != nil {
+		fields = append(fields, tasktemplate.FieldSubtasks)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tasktemplate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tasktemplate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskTemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tasktemplate.FieldBoardID:
+		return m.BoardID()
+	case tasktemplate.FieldTitle:
+		return m.Title()
+	case tasktemplate.FieldDescription:
+		return m.Description()
+	case tasktemplate.FieldLabelIds:
+		return m.LabelIds()
+	case tasktemplate.FieldDefaultAssigneeID:
+		return m.DefaultAssigneeID()
+	case tasktemplate.FieldSubtasks:
+		return m.Subtasks()
+	case tasktemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	case tasktemplate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tasktemplate.FieldBoardID:
+		return m.OldBoardID(ctx)
+	case tasktemplate.FieldTitle:
+		return m.OldTitle(ctx)
+	case tasktemplate.FieldDescription:
+		return m.OldDescription(ctx)
+	case tasktemplate.FieldLabelIds:
+		return m.OldLabelIds(ctx)
+	case tasktemplate.FieldDefaultAssigneeID:
+		return m.OldDefaultAssigneeID(ctx)
+	case tasktemplate.FieldSubtasks:
+		return m.OldSubtasks(ctx)
+	case tasktemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tasktemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaskTemplate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskTemplateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tasktemplate.FieldBoardID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoardID(v)
+		return nil
+	case tasktemplate.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case tasktemplate.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case tasktemplate.FieldLabelIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelIds(v)
+		return nil
+	case tasktemplate.FieldDefaultAssigneeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultAssigneeID(v)
+		return nil
+	case tasktemplate.FieldSubtasks:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtasks(v)
+		return nil
+	case tasktemplate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tasktemplate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTemplate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskTemplateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TaskTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskTemplateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tasktemplate.FieldDescription) {
+		fields = append(fields, tasktemplate.FieldDescription)
+	}
+	if m.FieldCleared(tasktemplate.FieldLabelIds) {
+		fields = append(fields, tasktemplate.FieldLabelIds)
+	}
+	if m.FieldCleared(tasktemplate.FieldDefaultAssigneeID) {
+		fields = append(fields, tasktemplate.FieldDefaultAssigneeID)
+	}
+	if m.FieldCleared(tasktemplate.FieldSubtasks) {
+		fields = append(fields, tasktemplate.FieldSubtasks)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskTemplateMutation) ClearField(name string) error {
+	switch name {
+	case tasktemplate.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case tasktemplate.FieldLabelIds:
+		m.ClearLabelIds()
+		return nil
+	case tasktemplate.FieldDefaultAssigneeID:
+		m.ClearDefaultAssigneeID()
+		return nil
+	case tasktemplate.FieldSubtasks:
+		m.ClearSubtasks()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTemplate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskTemplateMutation) ResetField(name string) error {
+	switch name {
+	case tasktemplate.FieldBoardID:
+		m.ResetBoardID()
+		return nil
+	case tasktemplate.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case tasktemplate.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case tasktemplate.FieldLabelIds:
+		m.ResetLabelIds()
+		return nil
+	case tasktemplate.FieldDefaultAssigneeID:
+		m.ResetDefaultAssigneeID()
+		return nil
+	case tasktemplate.FieldSubtasks:
+		m.ResetSubtasks()
+		return nil
+	case tasktemplate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tasktemplate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTemplate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.board != nil {
+		edges = append(edges, tasktemplate.EdgeBoard)
+	}
+	if m.files != nil {
+		edges = append(edges, tasktemplate.EdgeFiles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskTemplateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tasktemplate.EdgeBoard:
+		if id := m.board; id != nil {
+			return []ent.Value{*id}
+		}
+	case tasktemplate.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedfiles != nil {
+		edges = append(edges, tasktemplate.EdgeFiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskTemplateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tasktemplate.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedboard {
+		edges = append(edges, tasktemplate.EdgeBoard)
+	}
+	if m.clearedfiles {
+		edges = append(edges, tasktemplate.EdgeFiles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskTemplateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tasktemplate.EdgeBoard:
+		return m.clearedboard
+	case tasktemplate.EdgeFiles:
+		return m.clearedfiles
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskTemplateMutation) ClearEdge(name string) error {
+	switch name {
+	case tasktemplate.EdgeBoard:
+		m.ClearBoard()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTemplate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskTemplateMutation) ResetEdge(name string) error {
+	switch name {
+	case tasktemplate.EdgeBoard:
+		m.ResetBoard()
+		return nil
+	case tasktemplate.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTemplate edge %s", name)
+}
+
+// TimerMutation represents an operation that mutates the Timer nodes in the graph.
+type TimerMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	started_at    *time.Time
+	clearedFields map[string]struct{}
+	task          *string
+	clearedtask   bool
+	member        *string
+	clearedmember bool
+	done          bool
+	oldValue      func(context.Context) (*Timer, error)
+	predicates    []predicate.Timer
+}
+
+var _ ent.Mutation = (*TimerMutation)(nil)
+
+// timerOption allows management of the mutation configuration using functional options.
+type timerOption func(*TimerMutation)
+
+// newTimerMutation creates new mutation for the Timer entity.
+func newTimerMutation(c config, op Op, opts ...timerOption) *TimerMutation {
+	m := &TimerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTimer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTimerID sets the ID field of the mutation.
+func withTimerID(id int) timerOption {
+	return func(m *TimerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Timer
+		)
+		m.oldValue = func(ctx context.Context) (*Timer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Timer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTimer sets the old Timer of the mutation.
+func withTimer(node *Timer) timerOption {
+	return func(m *TimerMutation) {
+		m.oldValue = func(context.Context) (*Timer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TimerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TimerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TimerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TimerMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Timer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *TimerMutation) SetTaskID(s string) {
+	m.task = &s
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *TimerMutation) TaskID() (r string, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the Timer entity.
+// If the Timer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimerMutation) OldTaskID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *TimerMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// SetMemberID sets the "member_id" field.
+func (m *TimerMutation) SetMemberID(s string) {
+	m.member = &s
+}
+
+// MemberID returns the value of the "member_id" field in the mutation.
+func (m *TimerMutation) MemberID() (r string, exists bool) {
+	v := m.member
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemberID returns the old "member_id" field's value of the Timer entity.
+// If the Timer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimerMutation) OldMemberID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemberID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemberID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemberID: %w", err)
+	}
+	return oldValue.MemberID, nil
+}
+
+// ResetMemberID resets all changes to the "member_id" field.
+func (m *TimerMutation) ResetMemberID() {
+	m.member = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *TimerMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *TimerMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Timer entity.
+// If the Timer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimerMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *TimerMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *TimerMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[timer.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *TimerMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *TimerMutation) TaskIDs() (ids []string) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *TimerMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (m *TimerMutation) ClearMember() {
+	m.clearedmember = true
+	m.clearedFields[timer.FieldMemberID] = struct{}{}
+}
+
+// MemberCleared reports if the "member" edge to the Member entity was cleared.
+func (m *TimerMutation) MemberCleared() bool {
+	return m.clearedmember
+}
+
+// MemberIDs returns the "member" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MemberID instead. It exists only for internal usage by the builders.
+func (m *TimerMutation) MemberIDs() (ids []string) {
+	if id := m.member; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMember resets all changes to the "member" edge.
+func (m *TimerMutation) ResetMember() {
+	m.member = nil
+	m.clearedmember = false
+}
+
+// Where appends a list predicates to the TimerMutation builder.
+func (m *TimerMutation) Where(ps ...predicate.Timer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TimerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TimerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Timer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TimerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TimerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Timer).
+func (m *TimerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TimerMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.task != nil {
+		fields = append(fields, timer.FieldTaskID)
+	}
+	if m.member != nil {
+		fields = append(fields, timer.FieldMemberID)
+	}
+	if m.started_at != nil {
+		fields = append(fields, timer.FieldStartedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TimerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case timer.FieldTaskID:
+		return m.TaskID()
+	case timer.FieldMemberID:
+		return m.MemberID()
+	case timer.FieldStartedAt:
+		return m.StartedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TimerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case timer.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case timer.FieldMemberID:
+		return m.OldMemberID(ctx)
+	case timer.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Timer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TimerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case timer.FieldTaskID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case timer.FieldMemberID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemberID(v)
+		return nil
+	case timer.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Timer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TimerMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TimerMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TimerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Timer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TimerMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TimerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TimerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Timer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TimerMutation) ResetField(name string) error {
+	switch name {
+	case timer.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case timer.FieldMemberID:
+		m.ResetMemberID()
+		return nil
+	case timer.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Timer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TimerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, timer.EdgeTask)
+	}
+	if m.member != nil {
+		edges = append(edges, timer.EdgeMember)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TimerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case timer.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case timer.EdgeMember:
+		if id := m.member; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TimerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TimerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TimerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, timer.EdgeTask)
+	}
+	if m.clearedmember {
+		edges = append(edges, timer.EdgeMember)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TimerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case timer.EdgeTask:
+		return m.clearedtask
+	case timer.EdgeMember:
+		return m.clearedmember
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TimerMutation) ClearEdge(name string) error {
+	switch name {
+	case timer.EdgeTask:
+		m.ClearTask()
+		return nil
+	case timer.EdgeMember:
+		m.ClearMember()
+		return nil
+	}
+	return fmt.Errorf("unknown Timer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TimerMutation) ResetEdge(name string) error {
+	switch name {
+	case timer.EdgeTask:
+		m.ResetTask()
+		return nil
+	case timer.EdgeMember:
+		m.ResetMember()
+		return nil
+	}
+	return fmt.Errorf("unknown Timer edge %s", name)
+}
+
+// WorklogMutation represents an operation that mutates the Worklog nodes in the graph.
+type WorklogMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	duration_minutes    *int
+	addduration_minutes *int
+	date                *time.Time
+	note                *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	task                *string
+	clearedtask         bool
+	member              *string
+	clearedmember       bool
+	done                bool
+	oldValue            func(context.Context) (*Worklog, error)
+	predicates          []predicate.Worklog
+}
+
+var _ ent.Mutation = (*WorklogMutation)(nil)
+
+// worklogOption allows management of the mutation configuration using functional options.
+type worklogOption func(*WorklogMutation)
+
+// newWorklogMutation creates new mutation for the Worklog entity.
+func newWorklogMutation(c config, op Op, opts ...worklogOption) *WorklogMutation {
+	m := &WorklogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorklog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorklogID sets the ID field of the mutation.
+func withWorklogID(id string) worklogOption {
+	return func(m *WorklogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Worklog
+		)
+		m.oldValue = func(ctx context.Context) (*Worklog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Worklog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorklog sets the old Worklog of the mutation.
+func withWorklog(node *Worklog) worklogOption {
+	return func(m *WorklogMutation) {
+		m.oldValue = func(context.Context) (*Worklog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorklogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorklogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Worklog entities.
+func (m *WorklogMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WorklogMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WorklogMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Worklog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *WorklogMutation) SetTaskID(s string) {
+	m.task = &s
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *WorklogMutation) TaskID() (r string, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the Worklog entity.
+// If the Worklog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WorklogMutation) OldTaskID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TaskID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TaskMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *WorklogMutation) ResetTaskID() {
+	m.task = nil
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *TaskMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// SetMemberID sets the "member_id" field.
+func (m *WorklogMutation) SetMemberID(s string) {
+	m.member = &s
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *TaskMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
+// MemberID returns the value of the "member_id" field in the mutation.
+func (m *WorklogMutation) MemberID() (r string, exists bool) {
+	v := m.member
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldMemberID returns the old "member_id" field's value of the Worklog entity.
+// If the Worklog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *WorklogMutation) OldMemberID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMemberID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+		return v, errors.New("OldMemberID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMemberID: %w", err)
 	}
-	return oldValue.CompletedAt, nil
+	return oldValue.MemberID, nil
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *TaskMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[task.FieldCompletedAt] = struct{}{}
+// ResetMemberID resets all changes to the "member_id" field.
+func (m *WorklogMutation) ResetMemberID() {
+	m.member = nil
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *TaskMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[task.FieldCompletedAt]
-	return ok
+// SetDurationMinutes sets the "duration_minutes" field.
+func (m *WorklogMutation) SetDurationMinutes(i int) {
+	m.duration_minutes = &i
+	m.addduration_minutes = nil
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *TaskMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, task.FieldCompletedAt)
+// DurationMinutes returns the value of the "duration_minutes" field in the mutation.
+func (m *WorklogMutation) DurationMinutes() (r int, exists bool) {
+	v := m.duration_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetStatus sets the "status" field.
-func (m *TaskMutation) SetStatus(t task.Status) {
-	m.status = &t
+// OldDurationMinutes returns the old "duration_minutes" field's value of the Worklog entity.
+// If the Worklog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorklogMutation) OldDurationMinutes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMinutes: %w", err)
+	}
+	return oldValue.DurationMinutes, nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TaskMutation) Status() (r task.Status, exists bool) {
-	v := m.status
+// AddDurationMinutes adds i to the "duration_minutes" field.
+func (m *WorklogMutation) AddDurationMinutes(i int) {
+	if m.addduration_minutes != nil {
+		*m.addduration_minutes += i
+	} else {
+		m.addduration_minutes = &i
+	}
+}
+
+// AddedDurationMinutes returns the value that was added to the "duration_minutes" field in this mutation.
+func (m *WorklogMutation) AddedDurationMinutes() (r int, exists bool) {
+	v := m.addduration_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// ResetDurationMinutes resets all changes to the "duration_minutes" field.
+func (m *WorklogMutation) ResetDurationMinutes() {
+	m.duration_minutes = nil
+	m.addduration_minutes = nil
+}
+
+// SetDate sets the "date" field.
+func (m *WorklogMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *WorklogMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the Worklog entity.
+// If the Worklog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldStatus(ctx context.Context) (v task.Status, err error) {
+func (m *WorklogMutation) OldDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Date, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TaskMutation) ResetStatus() {
-	m.status = nil
+// ResetDate resets all changes to the "date" field.
+func (m *WorklogMutation) ResetDate() {
+	m.date = nil
 }
 
-// AddFileIDs adds the "files" edge to the File entity by ids.
-func (m *TaskMutation) AddFileIDs(ids ...string) {
-	if m.files == nil {
-		m.files = make(map[string]struct{})
+// SetNote sets the "note" field.
+func (m *WorklogMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *WorklogMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the Worklog entity.
+// If the Worklog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorklogMutation) OldNote(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *WorklogMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[worklog.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *WorklogMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[worklog.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *WorklogMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, worklog.FieldNote)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WorklogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WorklogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Worklog entity.
+// If the Worklog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorklogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearFiles clears the "files" edge to the File entity.
-func (m *TaskMutation) ClearFiles() {
-	m.clearedfiles = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WorklogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// FilesCleared reports if the "files" edge to the File entity was cleared.
-func (m *TaskMutation) FilesCleared() bool {
-	return m.clearedfiles
+// ClearTask clears the "task" edge to the Task entity.
+func (m *WorklogMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[worklog.FieldTaskID] = struct{}{}
 }
 
-// RemoveFileIDs removes the "files" edge to the File entity by IDs.
-func (m *TaskMutation) RemoveFileIDs(ids ...string) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.files, ids[i])
-		m.removedfiles[ids[i]] = struct{}{}
-	}
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *WorklogMutation) TaskCleared() bool {
+	return m.clearedtask
 }
 
-// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
-func (m *TaskMutation) RemovedFilesIDs() (ids []string) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *WorklogMutation) TaskIDs() (ids []string) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// FilesIDs returns the "files" edge IDs in the mutation.
-func (m *TaskMutation) FilesIDs() (ids []string) {
-	for id := range m.files {
-		ids = append(ids, id)
+// ResetTask resets all changes to the "task" edge.
+func (m *WorklogMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (m *WorklogMutation) ClearMember() {
+	m.clearedmember = true
+	m.clearedFields[worklog.FieldMemberID] = struct{}{}
+}
+
+// MemberCleared reports if the "member" edge to the Member entity was cleared.
+func (m *WorklogMutation) MemberCleared() bool {
+	return m.clearedmember
+}
+
+// MemberIDs returns the "member" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MemberID instead. It exists only for internal usage by the builders.
+func (m *WorklogMutation) MemberIDs() (ids []string) {
+	if id := m.member; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFiles resets all changes to the "files" edge.
-func (m *TaskMutation) ResetFiles() {
-	m.files = nil
-	m.clearedfiles = false
-	m.removedfiles = nil
+// ResetMember resets all changes to the "member" edge.
+func (m *WorklogMutation) ResetMember() {
+	m.member = nil
+	m.clearedmember = false
 }
 
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
+// Where appends a list predicates to the WorklogMutation builder.
+func (m *WorklogMutation) Where(ps ...predicate.Worklog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WorklogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
+func (m *WorklogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Worklog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1189,48 +16029,42 @@ func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskMutation) Op() Op {
+func (m *WorklogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
+func (m *WorklogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
+// Type returns the node type of this mutation (Worklog).
+func (m *WorklogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.title != nil {
-		fields = append(fields, task.FieldTitle)
-	}
-	if m.description != nil {
-		fields = append(fields, task.FieldDescription)
-	}
-	if m.due_date != nil {
-		fields = append(fields, task.FieldDueDate)
+func (m *WorklogMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.task != nil {
+		fields = append(fields, worklog.FieldTaskID)
 	}
-	if m.assignee_name != nil {
-		fields = append(fields, task.FieldAssigneeName)
+	if m.member != nil {
+		fields = append(fields, worklog.FieldMemberID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, task.FieldCreatedAt)
+	if m.duration_minutes != nil {
+		fields = append(fields, worklog.FieldDurationMinutes)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, task.FieldUpdatedAt)
+	if m.date != nil {
+		fields = append(fields, worklog.FieldDate)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, task.FieldCompletedAt)
+	if m.note != nil {
+		fields = append(fields, worklog.FieldNote)
 	}
-	if m.status != nil {
-		fields = append(fields, task.FieldStatus)
+	if m.created_at != nil {
+		fields = append(fields, worklog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1238,24 +16072,20 @@ func (m *TaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+func (m *WorklogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldTitle:
-		return m.Title()
-	case task.FieldDescription:
-		return m.Description()
-	case task.FieldDueDate:
-		return m.DueDate()
-	case task.FieldAssigneeName:
-		return m.AssigneeName()
-	case task.FieldCreatedAt:
+	case worklog.FieldTaskID:
+		return m.TaskID()
+	case worklog.FieldMemberID:
+		return m.MemberID()
+	case worklog.FieldDurationMinutes:
+		return m.DurationMinutes()
+	case worklog.FieldDate:
+		return m.Date()
+	case worklog.FieldNote:
+		return m.Note()
+	case worklog.FieldCreatedAt:
 		return m.CreatedAt()
-	case task.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case task.FieldCompletedAt:
-		return m.CompletedAt()
-	case task.FieldStatus:
-		return m.Status()
 	}
 	return nil, false
 }
@@ -1263,273 +16093,254 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WorklogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case task.FieldTitle:
-		return m.OldTitle(ctx)
-	case task.FieldDescription:
-		return m.OldDescription(ctx)
-	case task.FieldDueDate:
-		return m.OldDueDate(ctx)
-	case task.FieldAssigneeName:
-		return m.OldAssigneeName(ctx)
-	case task.FieldCreatedAt:
+	case worklog.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case worklog.FieldMemberID:
+		return m.OldMemberID(ctx)
+	case worklog.FieldDurationMinutes:
+		return m.OldDurationMinutes(ctx)
+	case worklog.FieldDate:
+		return m.OldDate(ctx)
+	case worklog.FieldNote:
+		return m.OldNote(ctx)
+	case worklog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case task.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case task.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case task.FieldStatus:
-		return m.OldStatus(ctx)
 	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
+	return nil, fmt.Errorf("unknown Worklog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
+func (m *WorklogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldTitle:
+	case worklog.FieldTaskID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetTaskID(v)
 		return nil
-	case task.FieldDescription:
+	case worklog.FieldMemberID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case task.FieldDueDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDueDate(v)
+		m.SetMemberID(v)
 		return nil
-	case task.FieldAssigneeName:
-		v, ok := value.(string)
+	case worklog.FieldDurationMinutes:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAssigneeName(v)
+		m.SetDurationMinutes(v)
 		return nil
-	case task.FieldCreatedAt:
+	case worklog.FieldDate:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDate(v)
 		return nil
-	case task.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case worklog.FieldNote:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetNote(v)
 		return nil
-	case task.FieldCompletedAt:
+	case worklog.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
-		return nil
-	case task.FieldStatus:
-		v, ok := value.(task.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown Worklog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskMutation) AddedFields() []string {
-	return nil
+func (m *WorklogMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_minutes != nil {
+		fields = append(fields, worklog.FieldDurationMinutes)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WorklogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case worklog.FieldDurationMinutes:
+		return m.AddedDurationMinutes()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
+func (m *WorklogMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case worklog.FieldDurationMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMinutes(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return fmt.Errorf("unknown Worklog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskMutation) ClearedFields() []string {
+func (m *WorklogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(task.FieldDescription) {
-		fields = append(fields, task.FieldDescription)
-	}
-	if m.FieldCleared(task.FieldDueDate) {
-		fields = append(fields, task.FieldDueDate)
-	}
-	if m.FieldCleared(task.FieldAssigneeName) {
-		fields = append(fields, task.FieldAssigneeName)
-	}
-	if m.FieldCleared(task.FieldCompletedAt) {
-		fields = append(fields, task.FieldCompletedAt)
+	if m.FieldCleared(worklog.FieldNote) {
+		fields = append(fields, worklog.FieldNote)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
+func (m *WorklogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
+func (m *WorklogMutation) ClearField(name string) error {
 	switch name {
-	case task.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case task.FieldDueDate:
-		m.ClearDueDate()
-		return nil
-	case task.FieldAssigneeName:
-		m.ClearAssigneeName()
-		return nil
-	case task.FieldCompletedAt:
-		m.ClearCompletedAt()
+	case worklog.FieldNote:
+		m.ClearNote()
 		return nil
 	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+	return fmt.Errorf("unknown Worklog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
+func (m *WorklogMutation) ResetField(name string) error {
 	switch name {
-	case task.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case task.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case task.FieldDueDate:
-		m.ResetDueDate()
+	case worklog.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case task.FieldAssigneeName:
-		m.ResetAssigneeName()
+	case worklog.FieldMemberID:
+		m.ResetMemberID()
 		return nil
-	case task.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case worklog.FieldDurationMinutes:
+		m.ResetDurationMinutes()
 		return nil
-	case task.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case worklog.FieldDate:
+		m.ResetDate()
 		return nil
-	case task.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case worklog.FieldNote:
+		m.ResetNote()
 		return nil
-	case task.FieldStatus:
-		m.ResetStatus()
+	case worklog.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown Worklog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.files != nil {
-		edges = append(edges, task.EdgeFiles)
+func (m *WorklogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, worklog.EdgeTask)
+	}
+	if m.member != nil {
+		edges = append(edges, worklog.EdgeMember)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+func (m *WorklogMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
+	case worklog.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case worklog.EdgeMember:
+		if id := m.member; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedfiles != nil {
-		edges = append(edges, task.EdgeFiles)
-	}
+func (m *WorklogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case task.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WorklogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedfiles {
-		edges = append(edges, task.EdgeFiles)
+func (m *WorklogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, worklog.EdgeTask)
+	}
+	if m.clearedmember {
+		edges = append(edges, worklog.EdgeMember)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
+func (m *WorklogMutation) EdgeCleared(name string) bool {
 	switch name {
-	case task.EdgeFiles:
-		return m.clearedfiles
+	case worklog.EdgeTask:
+		return m.clearedtask
+	case worklog.EdgeMember:
+		return m.clearedmember
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
+func (m *WorklogMutation) ClearEdge(name string) error {
 	switch name {
+	case worklog.EdgeTask:
+		m.ClearTask()
+		return nil
+	case worklog.EdgeMember:
+		m.ClearMember()
+		return nil
 	}
-	return fmt.Errorf("unknown Task unique edge %s", name)
+	return fmt.Errorf("unknown Worklog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
+func (m *WorklogMutation) ResetEdge(name string) error {
 	switch name {
-	case task.EdgeFiles:
-		m.ResetFiles()
+	case worklog.EdgeTask:
+		m.ResetTask()
+		return nil
+	case worklog.EdgeMember:
+		m.ResetMember()
 		return nil
 	}
-	return fmt.Errorf("unknown Task edge %s", name)
+	return fmt.Errorf("unknown Worklog edge %s", name)
 }
@@ -6,8 +6,53 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Activity is the predicate function for activity builders.
+type Activity func(*sql.Selector)
+
+// Board is the predicate function for board builders.
+type Board func(*sql.Selector)
+
+// ChecklistItem is the predicate function for checklistitem builders.
+type ChecklistItem func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
+// CustomField is the predicate function for customfield builders.
+type CustomField func(*sql.Selector)
+
+// CustomFieldValue is the predicate function for customfieldvalue builders.
+type CustomFieldValue func(*sql.Selector)
+
+// Epic is the predicate function for epic builders.
+type Epic func(*sql.Selector)
+
 // File is the predicate function for file builders.
 type File func(*sql.Selector)
 
+// Label is the predicate function for label builders.
+type Label func(*sql.Selector)
+
+// Member is the predicate function for member builders.
+type Member func(*sql.Selector)
+
+// RecurringTask is the predicate function for recurringtask builders.
+type RecurringTask func(*sql.Selector)
+
+// Sprint is the predicate function for sprint builders.
+type Sprint func(*sql.Selector)
+
+// StatusChange is the predicate function for statuschange builders.
+type StatusChange func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
+
+// TaskTemplate is the predicate function for tasktemplate builders.
+type TaskTemplate func(*sql.Selector)
+
+// Timer is the predicate function for timer builders.
+type Timer func(*sql.Selector)
+
+// Worklog is the predicate function for worklog builders.
+type Worklog func(*sql.Selector)
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+)
+
+// RecurringTask is the model entity for the RecurringTask schema.
+type RecurringTask struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID string `json:"board_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// AssigneeIds holds the value of the "assignee_ids" field.
+	AssigneeIds []string `json:"assignee_ids,omitempty"`
+	// LabelIds holds the value of the "label_ids" field.
+	LabelIds []string `json:"label_ids,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority *recurringtask.Priority `json:"priority,omitempty"`
+	// StoryPoints holds the value of the "story_points" field.
+	StoryPoints *int `json:"story_points,omitempty"`
+	// Rule holds the value of the "rule" field.
+	Rule string `json:"rule,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// NextAt holds the value of the "next_at" field.
+	NextAt *time.Time `json:"next_at,omitempty"`
+	// LastAt holds the value of the "last_at" field.
+	LastAt *time.Time `json:"last_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RecurringTaskQuery when eager-loading is set.
+	Edges        RecurringTaskEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RecurringTaskEdges holds the relations/edges for other nodes in the graph.
+type RecurringTaskEdges struct {
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecurringTaskEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RecurringTask) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recurringtask.FieldAssigneeIds, recurringtask.FieldLabelIds:
+			values[i] = new([]byte)
+		case recurringtask.FieldStoryPoints:
+			values[i] = new(sql.NullInt64)
+		case recurringtask.FieldID, recurringtask.FieldBoardID, recurringtask.FieldTitle, recurringtask.FieldDescription, recurringtask.FieldPriority, recurringtask.FieldRule:
+			values[i] = new(sql.NullString)
+		case recurringtask.FieldStartsAt, recurringtask.FieldNextAt, recurringtask.FieldLastAt, recurringtask.FieldCreatedAt, recurringtask.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RecurringTask fields.
+func (rt *RecurringTask) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recurringtask.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rt.ID = value.String
+			}
+		case recurringtask.FieldBoardID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				rt.BoardID = value.String
+			}
+		case recurringtask.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				rt.Title = value.String
+			}
+		case recurringtask.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				rt.Description = new(string)
+				*rt.Description = value.String
+			}
+		case recurringtask.FieldAssigneeIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rt.AssigneeIds); err != nil {
+					return fmt.Errorf("unmarshal field assignee_ids: %w", err)
+				}
+			}
+		case recurringtask.FieldLabelIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field label_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rt.LabelIds); err != nil {
+					return fmt.Errorf("unmarshal field label_ids: %w", err)
+				}
+			}
+		case recurringtask.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				rt.Priority = new(recurringtask.Priority)
+				*rt.Priority = recurringtask.Priority(value.String)
+			}
+		case recurringtask.FieldStoryPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field story_points", values[i])
+			} else if value.Valid {
+				rt.StoryPoints = new(int)
+				*rt.StoryPoints = int(value.Int64)
+			}
+		case recurringtask.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				rt.Rule = value.String
+			}
+		case recurringtask.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				rt.StartsAt = value.Time
+			}
+		case recurringtask.FieldNextAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_at", values[i])
+			} else if value.Valid {
+				rt.NextAt = new(time.Time)
+				*rt.NextAt = value.Time
+			}
+		case recurringtask.FieldLastAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_at", values[i])
+			} else if value.Valid {
+				rt.LastAt = new(time.Time)
+				*rt.LastAt = value.Time
+			}
+		case recurringtask.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rt.CreatedAt = value.Time
+			}
+		case recurringtask.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rt.UpdatedAt = value.Time
+			}
+		default:
+			rt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RecurringTask.
+// This includes values selected through modifiers, order, etc.
+func (rt *RecurringTask) Value(name string) (ent.Value, error) {
+	return rt.selectValues.Get(name)
+}
+
+// QueryBoard queries the "board" edge of the RecurringTask entity.
+func (rt *RecurringTask) QueryBoard() *BoardQuery {
+	return NewRecurringTaskClient(rt.config).QueryBoard(rt)
+}
+
+// Update returns a builder for updating this RecurringTask.
+// Note that you need to call RecurringTask.Unwrap() before calling this method if this RecurringTask
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *RecurringTask) Update() *RecurringTaskUpdateOne {
+	return NewRecurringTaskClient(rt.config).UpdateOne(rt)
+}
+
+// Unwrap unwraps the RecurringTask entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *RecurringTask) Unwrap() *RecurringTask {
+	_tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RecurringTask is not a transactional entity")
+	}
+	rt.config.driver = _tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *RecurringTask) String() string {
+	var builder strings.Builder
+	builder.WriteString("RecurringTask(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rt.ID))
+	builder.WriteString("board_id=")
+	builder.WriteString(rt.BoardID)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(rt.Title)
+	builder.WriteString(", ")
+	if v := rt.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("assignee_ids=")
+	builder.WriteString(fmt.Sprintf("%v", rt.AssigneeIds))
+	builder.WriteString(", ")
+	builder.WriteString("label_ids=")
+	builder.WriteString(fmt.Sprintf("%v", rt.LabelIds))
+	builder.WriteString(", ")
+	if v := rt.Priority; v != nil {
+		builder.WriteString("priority=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := rt.StoryPoints; v != nil {
+		builder.WriteString("story_points=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(rt.Rule)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(rt.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := rt.NextAt; v != nil {
+		builder.WriteString("next_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := rt.LastAt; v != nil {
+		builder.WriteString("last_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RecurringTasks is a parsable slice of RecurringTask.
+type RecurringTasks []*RecurringTask
@@ -0,0 +1,744 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recurringtask
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldID, id))
+}
+
+// BoardID applies equality check predicate on the "board_id" field. It's identical to BoardIDEQ.
+func BoardID(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldBoardID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldDescription, v))
+}
+
+// StoryPoints applies equality check predicate on the "story_points" field. It's identical to StoryPointsEQ.
+func StoryPoints(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldStoryPoints, v))
+}
+
+// Rule applies equality check predicate on the "rule" field. It's identical to RuleEQ.
+func Rule(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldRule, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// NextAt applies equality check predicate on the "next_at" field. It's identical to NextAtEQ.
+func NextAt(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldNextAt, v))
+}
+
+// LastAt applies equality check predicate on the "last_at" field. It's identical to LastAtEQ.
+func LastAt(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldLastAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// BoardIDEQ applies the EQ predicate on the "board_id" field.
+func BoardIDEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldBoardID, v))
+}
+
+// BoardIDNEQ applies the NEQ predicate on the "board_id" field.
+func BoardIDNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldBoardID, v))
+}
+
+// BoardIDIn applies the In predicate on the "board_id" field.
+func BoardIDIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldBoardID, vs...))
+}
+
+// BoardIDNotIn applies the NotIn predicate on the "board_id" field.
+func BoardIDNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldBoardID, vs...))
+}
+
+// BoardIDGT applies the GT predicate on the "board_id" field.
+func BoardIDGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldBoardID, v))
+}
+
+// BoardIDGTE applies the GTE predicate on the "board_id" field.
+func BoardIDGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldBoardID, v))
+}
+
+// BoardIDLT applies the LT predicate on the "board_id" field.
+func BoardIDLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldBoardID, v))
+}
+
+// BoardIDLTE applies the LTE predicate on the "board_id" field.
+func BoardIDLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldBoardID, v))
+}
+
+// BoardIDContains applies the Contains predicate on the "board_id" field.
+func BoardIDContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldBoardID, v))
+}
+
+// BoardIDHasPrefix applies the HasPrefix predicate on the "board_id" field.
+func BoardIDHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldBoardID, v))
+}
+
+// BoardIDHasSuffix applies the HasSuffix predicate on the "board_id" field.
+func BoardIDHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldBoardID, v))
+}
+
+// BoardIDEqualFold applies the EqualFold predicate on the "board_id" field.
+func BoardIDEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldBoardID, v))
+}
+
+// BoardIDContainsFold applies the ContainsFold predicate on the "board_id" field.
+func BoardIDContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldBoardID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// AssigneeIdsIsNil applies the IsNil predicate on the "assignee_ids" field.
+func AssigneeIdsIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldAssigneeIds))
+}
+
+// AssigneeIdsNotNil applies the NotNil predicate on the "assignee_ids" field.
+func AssigneeIdsNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldAssigneeIds))
+}
+
+// LabelIdsIsNil applies the IsNil predicate on the "label_ids" field.
+func LabelIdsIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldLabelIds))
+}
+
+// LabelIdsNotNil applies the NotNil predicate on the "label_ids" field.
+func LabelIdsNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldLabelIds))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityIsNil applies the IsNil predicate on the "priority" field.
+func PriorityIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldPriority))
+}
+
+// PriorityNotNil applies the NotNil predicate on the "priority" field.
+func PriorityNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldPriority))
+}
+
+// StoryPointsEQ applies the EQ predicate on the "story_points" field.
+func StoryPointsEQ(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldStoryPoints, v))
+}
+
+// StoryPointsNEQ applies the NEQ predicate on the "story_points" field.
+func StoryPointsNEQ(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldStoryPoints, v))
+}
+
+// StoryPointsIn applies the In predicate on the "story_points" field.
+func StoryPointsIn(vs ...int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldStoryPoints, vs...))
+}
+
+// StoryPointsNotIn applies the NotIn predicate on the "story_points" field.
+func StoryPointsNotIn(vs ...int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldStoryPoints, vs...))
+}
+
+// StoryPointsGT applies the GT predicate on the "story_points" field.
+func StoryPointsGT(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldStoryPoints, v))
+}
+
+// StoryPointsGTE applies the GTE predicate on the "story_points" field.
+func StoryPointsGTE(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldStoryPoints, v))
+}
+
+// StoryPointsLT applies the LT predicate on the "story_points" field.
+func StoryPointsLT(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldStoryPoints, v))
+}
+
+// StoryPointsLTE applies the LTE predicate on the "story_points" field.
+func StoryPointsLTE(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldStoryPoints, v))
+}
+
+// StoryPointsIsNil applies the IsNil predicate on the "story_points" field.
+func StoryPointsIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldStoryPoints))
+}
+
+// StoryPointsNotNil applies the NotNil predicate on the "story_points" field.
+func StoryPointsNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldStoryPoints))
+}
+
+// RuleEQ applies the EQ predicate on the "rule" field.
+func RuleEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleNEQ applies the NEQ predicate on the "rule" field.
+func RuleNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldRule, v))
+}
+
+// RuleIn applies the In predicate on the "rule" field.
+func RuleIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldRule, vs...))
+}
+
+// RuleNotIn applies the NotIn predicate on the "rule" field.
+func RuleNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldRule, vs...))
+}
+
+// RuleGT applies the GT predicate on the "rule" field.
+func RuleGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldRule, v))
+}
+
+// RuleGTE applies the GTE predicate on the "rule" field.
+func RuleGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldRule, v))
+}
+
+// RuleLT applies the LT predicate on the "rule" field.
+func RuleLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldRule, v))
+}
+
+// RuleLTE applies the LTE predicate on the "rule" field.
+func RuleLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldRule, v))
+}
+
+// RuleContains applies the Contains predicate on the "rule" field.
+func RuleContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldRule, v))
+}
+
+// RuleHasPrefix applies the HasPrefix predicate on the "rule" field.
+func RuleHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldRule, v))
+}
+
+// RuleHasSuffix applies the HasSuffix predicate on the "rule" field.
+func RuleHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldRule, v))
+}
+
+// RuleEqualFold applies the EqualFold predicate on the "rule" field.
+func RuleEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldRule, v))
+}
+
+// RuleContainsFold applies the ContainsFold predicate on the "rule" field.
+func RuleContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldRule, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// NextAtEQ applies the EQ predicate on the "next_at" field.
+func NextAtEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldNextAt, v))
+}
+
+// NextAtNEQ applies the NEQ predicate on the "next_at" field.
+func NextAtNEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldNextAt, v))
+}
+
+// NextAtIn applies the In predicate on the "next_at" field.
+func NextAtIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldNextAt, vs...))
+}
+
+// NextAtNotIn applies the NotIn predicate on the "next_at" field.
+func NextAtNotIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldNextAt, vs...))
+}
+
+// NextAtGT applies the GT predicate on the "next_at" field.
+func NextAtGT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldNextAt, v))
+}
+
+// NextAtGTE applies the GTE predicate on the "next_at" field.
+func NextAtGTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldNextAt, v))
+}
+
+// NextAtLT applies the LT predicate on the "next_at" field.
+func NextAtLT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldNextAt, v))
+}
+
+// NextAtLTE applies the LTE predicate on the "next_at" field.
+func NextAtLTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldNextAt, v))
+}
+
+// NextAtIsNil applies the IsNil predicate on the "next_at" field.
+func NextAtIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldNextAt))
+}
+
+// NextAtNotNil applies the NotNil predicate on the "next_at" field.
+func NextAtNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldNextAt))
+}
+
+// LastAtEQ applies the EQ predicate on the "last_at" field.
+func LastAtEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldLastAt, v))
+}
+
+// LastAtNEQ applies the NEQ predicate on the "last_at" field.
+func LastAtNEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldLastAt, v))
+}
+
+// LastAtIn applies the In predicate on the "last_at" field.
+func LastAtIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldLastAt, vs...))
+}
+
+// LastAtNotIn applies the NotIn predicate on the "last_at" field.
+func LastAtNotIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldLastAt, vs...))
+}
+
+// LastAtGT applies the GT predicate on the "last_at" field.
+func LastAtGT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldLastAt, v))
+}
+
+// LastAtGTE applies the GTE predicate on the "last_at" field.
+func LastAtGTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldLastAt, v))
+}
+
+// LastAtLT applies the LT predicate on the "last_at" field.
+func LastAtLT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldLastAt, v))
+}
+
+// LastAtLTE applies the LTE predicate on the "last_at" field.
+func LastAtLTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldLastAt, v))
+}
+
+// LastAtIsNil applies the IsNil predicate on the "last_at" field.
+func LastAtIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldLastAt))
+}
+
+// LastAtNotNil applies the NotNil predicate on the "last_at" field.
+func LastAtNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldLastAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasBoard applies the HasEdge predicate on the "board" edge.
+func HasBoard() predicate.RecurringTask {
+	return predicate.RecurringTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoardWith applies the HasEdge predicate on the "board" edge with a given conditions (other predicates).
+func HasBoardWith(preds ...predicate.Board) predicate.RecurringTask {
+	return predicate.RecurringTask(func(s *sql.Selector) {
+		step := newBoardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RecurringTask) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RecurringTask) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RecurringTask) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.NotPredicates(p))
+}
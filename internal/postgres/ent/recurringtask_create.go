@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+)
+
+// RecurringTaskCreate is the builder for creating a RecurringTask entity.
+type RecurringTaskCreate struct {
+	config
+	mutation *RecurringTaskMutation
+	hooks    []Hook
+}
+
+// SetBoardID sets the "board_id" field.
+func (rtc *RecurringTaskCreate) SetBoardID(s string) *RecurringTaskCreate {
+	rtc.mutation.SetBoardID(s)
+	return rtc
+}
+
+// SetTitle sets the "title" field.
+func (rtc *RecurringTaskCreate) SetTitle(s string) *RecurringTaskCreate {
+	rtc.mutation.SetTitle(s)
+	return rtc
+}
+
+// SetDescription sets the "description" field.
+func (rtc *RecurringTaskCreate) SetDescription(s string) *RecurringTaskCreate {
+	rtc.mutation.SetDescription(s)
+	return rtc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rtc *RecurringTaskCreate) SetNillableDescription(s *string) *RecurringTaskCreate {
+	if s != nil {
+		rtc.SetDescription(*s)
+	}
+	return rtc
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (rtc *RecurringTaskCreate) SetAssigneeIds(s []string) *RecurringTaskCreate {
+	rtc.mutation.SetAssigneeIds(s)
+	return rtc
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (rtc *RecurringTaskCreate) SetLabelIds(s []string) *RecurringTaskCreate {
+	rtc.mutation.SetLabelIds(s)
+	return rtc
+}
+
+// SetPriority sets the "priority" field.
+func (rtc *RecurringTaskCreate) SetPriority(r recurringtask.Priority) *RecurringTaskCreate {
+	rtc.mutation.SetPriority(r)
+	return rtc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (rtc *RecurringTaskCreate) SetNillablePriority(r *recurringtask.Priority) *RecurringTaskCreate {
+	if r != nil {
+		rtc.SetPriority(*r)
+	}
+	return rtc
+}
+
+// SetStoryPoints sets the "story_points" field.
+func (rtc *RecurringTaskCreate) SetStoryPoints(i int) *RecurringTaskCreate {
+	rtc.mutation.SetStoryPoints(i)
+	return rtc
+}
+
+// SetNillableStoryPoints sets the "story_points" field if the given value is not nil.
+func (rtc *RecurringTaskCreate) SetNillableStoryPoints(i *int) *RecurringTaskCreate {
+	if i != nil {
+		rtc.SetStoryPoints(*i)
+	}
+	return rtc
+}
+
+// SetRule sets the "rule" field.
+func (rtc *RecurringTaskCreate) SetRule(s string) *RecurringTaskCreate {
+	rtc.mutation.SetRule(s)
+	return rtc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (rtc *RecurringTaskCreate) SetStartsAt(t time.Time) *RecurringTaskCreate {
+	rtc.mutation.SetStartsAt(t)
+	return rtc
+}
+
+// SetNextAt sets the "next_at" field.
+func (rtc *RecurringTaskCreate) SetNextAt(t time.Time) *RecurringTaskCreate {
+	rtc.mutation.SetNextAt(t)
+	return rtc
+}
+
+// SetNillableNextAt sets the "next_at" field if the given value is not nil.
+func (rtc *RecurringTaskCreate) SetNillableNextAt(t *time.Time) *RecurringTaskCreate {
+	if t != nil {
+		rtc.SetNextAt(*t)
+	}
+	return rtc
+}
+
+// SetLastAt sets the "last_at" field.
+func (rtc *RecurringTaskCreate) SetLastAt(t time.Time) *RecurringTaskCreate {
+	rtc.mutation.SetLastAt(t)
+	return rtc
+}
+
+// SetNillableLastAt sets the "last_at" field if the given value is not nil.
+func (rtc *RecurringTaskCreate) SetNillableLastAt(t *time.Time) *RecurringTaskCreate {
+	if t != nil {
+		rtc.SetLastAt(*t)
+	}
+	return rtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtc *RecurringTaskCreate) SetCreatedAt(t time.Time) *RecurringTaskCreate {
+	rtc.mutation.SetCreatedAt(t)
+	return rtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtc *RecurringTaskCreate) SetNillableCreatedAt(t *time.Time) *RecurringTaskCreate {
+	if t != nil {
+		rtc.SetCreatedAt(*t)
+	}
+	return rtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtc *RecurringTaskCreate) SetUpdatedAt(t time.Time) *RecurringTaskCreate {
+	rtc.mutation.SetUpdatedAt(t)
+	return rtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rtc *RecurringTaskCreate) SetNillableUpdatedAt(t *time.Time) *RecurringTaskCreate {
+	if t != nil {
+		rtc.SetUpdatedAt(*t)
+	}
+	return rtc
+}
+
+// SetID sets the "id" field.
+func (rtc *RecurringTaskCreate) SetID(s string) *RecurringTaskCreate {
+	rtc.mutation.SetID(s)
+	return rtc
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (rtc *RecurringTaskCreate) SetBoard(b *Board) *RecurringTaskCreate {
+	return rtc.SetBoardID(b.ID)
+}
+
+// Mutation returns the RecurringTaskMutation object of the builder.
+func (rtc *RecurringTaskCreate) Mutation() *RecurringTaskMutation {
+	return rtc.mutation
+}
+
+// Save creates the RecurringTask in the database.
+func (rtc *RecurringTaskCreate) Save(ctx context.Context) (*RecurringTask, error) {
+	rtc.defaults()
+	return withHooks(ctx, rtc.sqlSave, rtc.mutation, rtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *RecurringTaskCreate) SaveX(ctx context.Context) *RecurringTask {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtc *RecurringTaskCreate) Exec(ctx context.Context) error {
+	_, err := rtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtc *RecurringTaskCreate) ExecX(ctx context.Context) {
+	if err := rtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *RecurringTaskCreate) defaults() {
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		v := recurringtask.DefaultCreatedAt()
+		rtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rtc.mutation.UpdatedAt(); !ok {
+		v := recurringtask.DefaultUpdatedAt()
+		rtc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *RecurringTaskCreate) check() error {
+	if _, ok := rtc.mutation.BoardID(); !ok {
+		return &ValidationError{Name: "board_id", err: errors.New(`ent: missing required field "RecurringTask.board_id"`)}
+	}
+	if _, ok := rtc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "RecurringTask.title"`)}
+	}
+	if v, ok := rtc.mutation.Priority(); ok {
+		if err := recurringtask.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.priority": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required field "RecurringTask.rule"`)}
+	}
+	if _, ok := rtc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "RecurringTask.starts_at"`)}
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RecurringTask.created_at"`)}
+	}
+	if _, ok := rtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RecurringTask.updated_at"`)}
+	}
+	if len(rtc.mutation.BoardIDs()) == 0 {
+		return &ValidationError{Name: "board", err: errors.New(`ent: missing required edge "RecurringTask.board"`)}
+	}
+	return nil
+}
+
+func (rtc *RecurringTaskCreate) sqlSave(ctx context.Context) (*RecurringTask, error) {
+	if err := rtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RecurringTask.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rtc.mutation.id = &_node.ID
+	rtc.mutation.done = true
+	return _node, nil
+}
+
+func (rtc *RecurringTaskCreate) createSpec() (*RecurringTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecurringTask{config: rtc.config}
+		_spec = sqlgraph.NewCreateSpec(recurringtask.Table, sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString))
+	)
+	if id, ok := rtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rtc.mutation.Title(); ok {
+		_spec.SetField(recurringtask.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := rtc.mutation.Description(); ok {
+		_spec.SetField(recurringtask.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := rtc.mutation.AssigneeIds(); ok {
+		_spec.SetField(recurringtask.FieldAssigneeIds, field.TypeJSON, value)
+		_node.AssigneeIds = value
+	}
+	if value, ok := rtc.mutation.LabelIds(); ok {
+		_spec.SetField(recurringtask.FieldLabelIds, field.TypeJSON, value)
+		_node.LabelIds = value
+	}
+	if value, ok := rtc.mutation.Priority(); ok {
+		_spec.SetField(recurringtask.FieldPriority, field.TypeEnum, value)
+		_node.Priority = &value
+	}
+	if value, ok := rtc.mutation.StoryPoints(); ok {
+		_spec.SetField(recurringtask.FieldStoryPoints, field.TypeInt, value)
+		_node.StoryPoints = &value
+	}
+	if value, ok := rtc.mutation.Rule(); ok {
+		_spec.SetField(recurringtask.FieldRule, field.TypeString, value)
+		_node.Rule = value
+	}
+	if value, ok := rtc.mutation.StartsAt(); ok {
+		_spec.SetField(recurringtask.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := rtc.mutation.NextAt(); ok {
+		_spec.SetField(recurringtask.FieldNextAt, field.TypeTime, value)
+		_node.NextAt = &value
+	}
+	if value, ok := rtc.mutation.LastAt(); ok {
+		_spec.SetField(recurringtask.FieldLastAt, field.TypeTime, value)
+		_node.LastAt = &value
+	}
+	if value, ok := rtc.mutation.CreatedAt(); ok {
+		_spec.SetField(recurringtask.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurringtask.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := rtc.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringtask.BoardTable,
+			Columns: []string{recurringtask.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RecurringTaskCreateBulk is the builder for creating many RecurringTask entities in bulk.
+type RecurringTaskCreateBulk struct {
+	config
+	err      error
+	builders []*RecurringTaskCreate
+}
+
+// Save creates the RecurringTask entities in the database.
+func (rtcb *RecurringTaskCreateBulk) Save(ctx context.Context) ([]*RecurringTask, error) {
+	if rtcb.err != nil {
+		return nil, rtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*RecurringTask, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecurringTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *RecurringTaskCreateBulk) SaveX(ctx context.Context) []*RecurringTask {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtcb *RecurringTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := rtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtcb *RecurringTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := rtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+)
+
+// RecurringTaskDelete is the builder for deleting a RecurringTask entity.
+type RecurringTaskDelete struct {
+	config
+	hooks    []Hook
+	mutation *RecurringTaskMutation
+}
+
+// Where appends a list predicates to the RecurringTaskDelete builder.
+func (rtd *RecurringTaskDelete) Where(ps ...predicate.RecurringTask) *RecurringTaskDelete {
+	rtd.mutation.Where(ps...)
+	return rtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rtd *RecurringTaskDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtd.sqlExec, rtd.mutation, rtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtd *RecurringTaskDelete) ExecX(ctx context.Context) int {
+	n, err := rtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rtd *RecurringTaskDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(recurringtask.Table, sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString))
+	if ps := rtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rtd.mutation.done = true
+	return affected, err
+}
+
+// RecurringTaskDeleteOne is the builder for deleting a single RecurringTask entity.
+type RecurringTaskDeleteOne struct {
+	rtd *RecurringTaskDelete
+}
+
+// Where appends a list predicates to the RecurringTaskDelete builder.
+func (rtdo *RecurringTaskDeleteOne) Where(ps ...predicate.RecurringTask) *RecurringTaskDeleteOne {
+	rtdo.rtd.mutation.Where(ps...)
+	return rtdo
+}
+
+// Exec executes the deletion query.
+func (rtdo *RecurringTaskDeleteOne) Exec(ctx context.Context) error {
+	n, err := rtdo.rtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{recurringtask.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtdo *RecurringTaskDeleteOne) ExecX(ctx context.Context) {
+	if err := rtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+)
+
+// RecurringTaskQuery is the builder for querying RecurringTask entities.
+type RecurringTaskQuery struct {
+	config
+	ctx        *QueryContext
+	order      []recurringtask.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RecurringTask
+	withBoard  *BoardQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RecurringTaskQuery builder.
+func (rtq *RecurringTaskQuery) Where(ps ...predicate.RecurringTask) *RecurringTaskQuery {
+	rtq.predicates = append(rtq.predicates, ps...)
+	return rtq
+}
+
+// Limit the number of records to be returned by this query.
+func (rtq *RecurringTaskQuery) Limit(limit int) *RecurringTaskQuery {
+	rtq.ctx.Limit = &limit
+	return rtq
+}
+
+// Offset to start from.
+func (rtq *RecurringTaskQuery) Offset(offset int) *RecurringTaskQuery {
+	rtq.ctx.Offset = &offset
+	return rtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rtq *RecurringTaskQuery) Unique(unique bool) *RecurringTaskQuery {
+	rtq.ctx.Unique = &unique
+	return rtq
+}
+
+// Order specifies how the records should be ordered.
+func (rtq *RecurringTaskQuery) Order(o ...recurringtask.OrderOption) *RecurringTaskQuery {
+	rtq.order = append(rtq.order, o...)
+	return rtq
+}
+
+// QueryBoard chains the current query on the "board" edge.
+func (rtq *RecurringTaskQuery) QueryBoard() *BoardQuery {
+	query := (&BoardClient{config: rtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recurringtask.Table, recurringtask.FieldID, selector),
+			sqlgraph.To(board.Table, board.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurringtask.BoardTable, recurringtask.BoardColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RecurringTask entity from the query.
+// Returns a *NotFoundError when no RecurringTask was found.
+func (rtq *RecurringTaskQuery) First(ctx context.Context) (*RecurringTask, error) {
+	nodes, err := rtq.Limit(1).All(setContextOp(ctx, rtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{recurringtask.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rtq *RecurringTaskQuery) FirstX(ctx context.Context) *RecurringTask {
+	node, err := rtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RecurringTask ID from the query.
+// Returns a *NotFoundError when no RecurringTask ID was found.
+func (rtq *RecurringTaskQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rtq.Limit(1).IDs(setContextOp(ctx, rtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{recurringtask.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rtq *RecurringTaskQuery) FirstIDX(ctx context.Context) string {
+	id, err := rtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RecurringTask entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RecurringTask entity is found.
+// Returns a *NotFoundError when no RecurringTask entities are found.
+func (rtq *RecurringTaskQuery) Only(ctx context.Context) (*RecurringTask, error) {
+	nodes, err := rtq.Limit(2).All(setContextOp(ctx, rtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{recurringtask.Label}
+	default:
+		return nil, &NotSingularError{recurringtask.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rtq *RecurringTaskQuery) OnlyX(ctx context.Context) *RecurringTask {
+	node, err := rtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RecurringTask ID in the query.
+// Returns a *NotSingularError when more than one RecurringTask ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rtq *RecurringTaskQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rtq.Limit(2).IDs(setContextOp(ctx, rtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{recurringtask.Label}
+	default:
+		err = &NotSingularError{recurringtask.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rtq *RecurringTaskQuery) OnlyIDX(ctx context.Context) string {
+	id, err := rtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RecurringTasks.
+func (rtq *RecurringTaskQuery) All(ctx context.Context) ([]*RecurringTask, error) {
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryAll)
+	if err := rtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RecurringTask, *RecurringTaskQuery]()
+	return withInterceptors[[]*RecurringTask](ctx, rtq, qr, rtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rtq *RecurringTaskQuery) AllX(ctx context.Context) []*RecurringTask {
+	nodes, err := rtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RecurringTask IDs.
+func (rtq *RecurringTaskQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if rtq.ctx.Unique == nil && rtq.path != nil {
+		rtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryIDs)
+	if err = rtq.Select(recurringtask.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rtq *RecurringTaskQuery) IDsX(ctx context.Context) []string {
+	ids, err := rtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rtq *RecurringTaskQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryCount)
+	if err := rtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rtq, querierCount[*RecurringTaskQuery](), rtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rtq *RecurringTaskQuery) CountX(ctx context.Context) int {
+	count, err := rtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rtq *RecurringTaskQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rtq.ctx, ent.OpQueryExist)
+	switch _, err := rtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rtq *RecurringTaskQuery) ExistX(ctx context.Context) bool {
+	exist, err := rtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RecurringTaskQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rtq *RecurringTaskQuery) Clone() *RecurringTaskQuery {
+	if rtq == nil {
+		return nil
+	}
+	return &RecurringTaskQuery{
+		config:     rtq.config,
+		ctx:        rtq.ctx.Clone(),
+		order:      append([]recurringtask.OrderOption{}, rtq.order...),
+		inters:     append([]Interceptor{}, rtq.inters...),
+		predicates: append([]predicate.RecurringTask{}, rtq.predicates...),
+		withBoard:  rtq.withBoard.Clone(),
+		// clone intermediate query.
+		sql:  rtq.sql.Clone(),
+		path: rtq.path,
+	}
+}
+
+// WithBoard tells the query-builder to eager-load the nodes that are connected to
+// the "board" edge. The optional arguments are used to configure the query builder of the edge.
+func (rtq *RecurringTaskQuery) WithBoard(opts ...func(*BoardQuery)) *RecurringTaskQuery {
+	query := (&BoardClient{config: rtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rtq.withBoard = query
+	return rtq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RecurringTask.Query().
+//		GroupBy(recurringtask.FieldBoardID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rtq *RecurringTaskQuery) GroupBy(field string, fields ...string) *RecurringTaskGroupBy {
+	rtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RecurringTaskGroupBy{build: rtq}
+	grbuild.flds = &rtq.ctx.Fields
+	grbuild.label = recurringtask.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//	}
+//
+//	client.RecurringTask.Query().
+//		Select(recurringtask.FieldBoardID).
+//		Scan(ctx, &v)
+func (rtq *RecurringTaskQuery) Select(fields ...string) *RecurringTaskSelect {
+	rtq.ctx.Fields = append(rtq.ctx.Fields, fields...)
+	sbuild := &RecurringTaskSelect{RecurringTaskQuery: rtq}
+	sbuild.label = recurringtask.Label
+	sbuild.flds, sbuild.scan = &rtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RecurringTaskSelect configured with the given aggregations.
+func (rtq *RecurringTaskQuery) Aggregate(fns ...AggregateFunc) *RecurringTaskSelect {
+	return rtq.Select().Aggregate(fns...)
+}
+
+func (rtq *RecurringTaskQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rtq.ctx.Fields {
+		if !recurringtask.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rtq.path != nil {
+		prev, err := rtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rtq.sql = prev
+	}
+	return nil
+}
+
+func (rtq *RecurringTaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RecurringTask, error) {
+	var (
+		nodes       = []*RecurringTask{}
+		_spec       = rtq.querySpec()
+		loadedTypes = [1]bool{
+			rtq.withBoard != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RecurringTask).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RecurringTask{config: rtq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rtq.withBoard; query != nil {
+		if err := rtq.loadBoard(ctx, query, nodes, nil,
+			func(n *RecurringTask, e *Board) { n.Edges.Board = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rtq *RecurringTaskQuery) loadBoard(ctx context.Context, query *BoardQuery, nodes []*RecurringTask, init func(*RecurringTask), assign func(*RecurringTask, *Board)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*RecurringTask)
+	for i := range nodes {
+		fk := nodes[i].BoardID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(board.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "board_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rtq *RecurringTaskQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rtq.querySpec()
+	_spec.Node.Columns = rtq.ctx.Fields
+	if len(rtq.ctx.Fields) > 0 {
+		_spec.Unique = rtq.ctx.Unique != nil && *rtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rtq.driver, _spec)
+}
+
+func (rtq *RecurringTaskQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(recurringtask.Table, recurringtask.Columns, sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString))
+	_spec.From = rtq.sql
+	if unique := rtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recurringtask.FieldID)
+		for i := range fields {
+			if fields[i] != recurringtask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rtq.withBoard != nil {
+			_spec.Node.AddColumnOnce(recurringtask.FieldBoardID)
+		}
+	}
+	if ps := rtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rtq *RecurringTaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rtq.driver.Dialect())
+	t1 := builder.Table(recurringtask.Table)
+	columns := rtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = recurringtask.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rtq.sql != nil {
+		selector = rtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rtq.ctx.Unique != nil && *rtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rtq.predicates {
+		p(selector)
+	}
+	for _, p := range rtq.order {
+		p(selector)
+	}
+	if offset := rtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RecurringTaskGroupBy is the group-by builder for RecurringTask entities.
+type RecurringTaskGroupBy struct {
+	selector
+	build *RecurringTaskQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rtgb *RecurringTaskGroupBy) Aggregate(fns ...AggregateFunc) *RecurringTaskGroupBy {
+	rtgb.fns = append(rtgb.fns, fns...)
+	return rtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rtgb *RecurringTaskGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RecurringTaskQuery, *RecurringTaskGroupBy](ctx, rtgb.build, rtgb, rtgb.build.inters, v)
+}
+
+func (rtgb *RecurringTaskGroupBy) sqlScan(ctx context.Context, root *RecurringTaskQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rtgb.fns))
+	for _, fn := range rtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rtgb.flds)+len(rtgb.fns))
+		for _, f := range *rtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RecurringTaskSelect is the builder for selecting fields of RecurringTask entities.
+type RecurringTaskSelect struct {
+	*RecurringTaskQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rts *RecurringTaskSelect) Aggregate(fns ...AggregateFunc) *RecurringTaskSelect {
+	rts.fns = append(rts.fns, fns...)
+	return rts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rts *RecurringTaskSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rts.ctx, ent.OpQuerySelect)
+	if err := rts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RecurringTaskQuery, *RecurringTaskSelect](ctx, rts.RecurringTaskQuery, rts, rts.inters, v)
+}
+
+func (rts *RecurringTaskSelect) sqlScan(ctx context.Context, root *RecurringTaskQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rts.fns))
+	for _, fn := range rts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
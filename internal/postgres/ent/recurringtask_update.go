@@ -0,0 +1,886 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+)
+
+// RecurringTaskUpdate is the builder for updating RecurringTask entities.
+type RecurringTaskUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecurringTaskMutation
+}
+
+// Where appends a list predicates to the RecurringTaskUpdate builder.
+func (rtu *RecurringTaskUpdate) Where(ps ...predicate.RecurringTask) *RecurringTaskUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetBoardID sets the "board_id" field.
+func (rtu *RecurringTaskUpdate) SetBoardID(s string) *RecurringTaskUpdate {
+	rtu.mutation.SetBoardID(s)
+	return rtu
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillableBoardID(s *string) *RecurringTaskUpdate {
+	if s != nil {
+		rtu.SetBoardID(*s)
+	}
+	return rtu
+}
+
+// SetTitle sets the "title" field.
+func (rtu *RecurringTaskUpdate) SetTitle(s string) *RecurringTaskUpdate {
+	rtu.mutation.SetTitle(s)
+	return rtu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillableTitle(s *string) *RecurringTaskUpdate {
+	if s != nil {
+		rtu.SetTitle(*s)
+	}
+	return rtu
+}
+
+// SetDescription sets the "description" field.
+func (rtu *RecurringTaskUpdate) SetDescription(s string) *RecurringTaskUpdate {
+	rtu.mutation.SetDescription(s)
+	return rtu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillableDescription(s *string) *RecurringTaskUpdate {
+	if s != nil {
+		rtu.SetDescription(*s)
+	}
+	return rtu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rtu *RecurringTaskUpdate) ClearDescription() *RecurringTaskUpdate {
+	rtu.mutation.ClearDescription()
+	return rtu
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (rtu *RecurringTaskUpdate) SetAssigneeIds(s []string) *RecurringTaskUpdate {
+	rtu.mutation.SetAssigneeIds(s)
+	return rtu
+}
+
+// AppendAssigneeIds appends s to the "assignee_ids" field.
+func (rtu *RecurringTaskUpdate) AppendAssigneeIds(s []string) *RecurringTaskUpdate {
+	rtu.mutation.AppendAssigneeIds(s)
+	return rtu
+}
+
+// ClearAssigneeIds clears the value of the "assignee_ids" field.
+func (rtu *RecurringTaskUpdate) ClearAssigneeIds() *RecurringTaskUpdate {
+	rtu.mutation.ClearAssigneeIds()
+	return rtu
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (rtu *RecurringTaskUpdate) SetLabelIds(s []string) *RecurringTaskUpdate {
+	rtu.mutation.SetLabelIds(s)
+	return rtu
+}
+
+// AppendLabelIds appends s to the "label_ids" field.
+func (rtu *RecurringTaskUpdate) AppendLabelIds(s []string) *RecurringTaskUpdate {
+	rtu.mutation.AppendLabelIds(s)
+	return rtu
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (rtu *RecurringTaskUpdate) ClearLabelIds() *RecurringTaskUpdate {
+	rtu.mutation.ClearLabelIds()
+	return rtu
+}
+
+// SetPriority sets the "priority" field.
+func (rtu *RecurringTaskUpdate) SetPriority(r recurringtask.Priority) *RecurringTaskUpdate {
+	rtu.mutation.SetPriority(r)
+	return rtu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillablePriority(r *recurringtask.Priority) *RecurringTaskUpdate {
+	if r != nil {
+		rtu.SetPriority(*r)
+	}
+	return rtu
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (rtu *RecurringTaskUpdate) ClearPriority() *RecurringTaskUpdate {
+	rtu.mutation.ClearPriority()
+	return rtu
+}
+
+// SetStoryPoints sets the "story_points" field.
+func (rtu *RecurringTaskUpdate) SetStoryPoints(i int) *RecurringTaskUpdate {
+	rtu.mutation.ResetStoryPoints()
+	rtu.mutation.SetStoryPoints(i)
+	return rtu
+}
+
+// SetNillableStoryPoints sets the "story_points" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillableStoryPoints(i *int) *RecurringTaskUpdate {
+	if i != nil {
+		rtu.SetStoryPoints(*i)
+	}
+	return rtu
+}
+
+// AddStoryPoints adds i to the "story_points" field.
+func (rtu *RecurringTaskUpdate) AddStoryPoints(i int) *RecurringTaskUpdate {
+	rtu.mutation.AddStoryPoints(i)
+	return rtu
+}
+
+// ClearStoryPoints clears the value of the "story_points" field.
+func (rtu *RecurringTaskUpdate) ClearStoryPoints() *RecurringTaskUpdate {
+	rtu.mutation.ClearStoryPoints()
+	return rtu
+}
+
+// SetRule sets the "rule" field.
+func (rtu *RecurringTaskUpdate) SetRule(s string) *RecurringTaskUpdate {
+	rtu.mutation.SetRule(s)
+	return rtu
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillableRule(s *string) *RecurringTaskUpdate {
+	if s != nil {
+		rtu.SetRule(*s)
+	}
+	return rtu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (rtu *RecurringTaskUpdate) SetStartsAt(t time.Time) *RecurringTaskUpdate {
+	rtu.mutation.SetStartsAt(t)
+	return rtu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillableStartsAt(t *time.Time) *RecurringTaskUpdate {
+	if t != nil {
+		rtu.SetStartsAt(*t)
+	}
+	return rtu
+}
+
+// SetNextAt sets the "next_at" field.
+func (rtu *RecurringTaskUpdate) SetNextAt(t time.Time) *RecurringTaskUpdate {
+	rtu.mutation.SetNextAt(t)
+	return rtu
+}
+
+// SetNillableNextAt sets the "next_at" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillableNextAt(t *time.Time) *RecurringTaskUpdate {
+	if t != nil {
+		rtu.SetNextAt(*t)
+	}
+	return rtu
+}
+
+// ClearNextAt clears the value of the "next_at" field.
+func (rtu *RecurringTaskUpdate) ClearNextAt() *RecurringTaskUpdate {
+	rtu.mutation.ClearNextAt()
+	return rtu
+}
+
+// SetLastAt sets the "last_at" field.
+func (rtu *RecurringTaskUpdate) SetLastAt(t time.Time) *RecurringTaskUpdate {
+	rtu.mutation.SetLastAt(t)
+	return rtu
+}
+
+// SetNillableLastAt sets the "last_at" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillableLastAt(t *time.Time) *RecurringTaskUpdate {
+	if t != nil {
+		rtu.SetLastAt(*t)
+	}
+	return rtu
+}
+
+// ClearLastAt clears the value of the "last_at" field.
+func (rtu *RecurringTaskUpdate) ClearLastAt() *RecurringTaskUpdate {
+	rtu.mutation.ClearLastAt()
+	return rtu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtu *RecurringTaskUpdate) SetCreatedAt(t time.Time) *RecurringTaskUpdate {
+	rtu.mutation.SetCreatedAt(t)
+	return rtu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtu *RecurringTaskUpdate) SetNillableCreatedAt(t *time.Time) *RecurringTaskUpdate {
+	if t != nil {
+		rtu.SetCreatedAt(*t)
+	}
+	return rtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtu *RecurringTaskUpdate) SetUpdatedAt(t time.Time) *RecurringTaskUpdate {
+	rtu.mutation.SetUpdatedAt(t)
+	return rtu
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (rtu *RecurringTaskUpdate) SetBoard(b *Board) *RecurringTaskUpdate {
+	return rtu.SetBoardID(b.ID)
+}
+
+// Mutation returns the RecurringTaskMutation object of the builder.
+func (rtu *RecurringTaskUpdate) Mutation() *RecurringTaskMutation {
+	return rtu.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (rtu *RecurringTaskUpdate) ClearBoard() *RecurringTaskUpdate {
+	rtu.mutation.ClearBoard()
+	return rtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RecurringTaskUpdate) Save(ctx context.Context) (int, error) {
+	rtu.defaults()
+	return withHooks(ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RecurringTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RecurringTaskUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RecurringTaskUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtu *RecurringTaskUpdate) defaults() {
+	if _, ok := rtu.mutation.UpdatedAt(); !ok {
+		v := recurringtask.UpdateDefaultUpdatedAt()
+		rtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *RecurringTaskUpdate) check() error {
+	if v, ok := rtu.mutation.Priority(); ok {
+		if err := recurringtask.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.priority": %w`, err)}
+		}
+	}
+	if rtu.mutation.BoardCleared() && len(rtu.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurringTask.board"`)
+	}
+	return nil
+}
+
+func (rtu *RecurringTaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurringtask.Table, recurringtask.Columns, sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.Title(); ok {
+		_spec.SetField(recurringtask.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.Description(); ok {
+		_spec.SetField(recurringtask.FieldDescription, field.TypeString, value)
+	}
+	if rtu.mutation.DescriptionCleared() {
+		_spec.ClearField(recurringtask.FieldDescription, field.TypeString)
+	}
+	if value, ok := rtu.mutation.AssigneeIds(); ok {
+		_spec.SetField(recurringtask.FieldAssigneeIds, field.TypeJSON, value)
+	}
+	if value, ok := rtu.mutation.AppendedAssigneeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurringtask.FieldAssigneeIds, value)
+		})
+	}
+	if rtu.mutation.AssigneeIdsCleared() {
+		_spec.ClearField(recurringtask.FieldAssigneeIds, field.TypeJSON)
+	}
+	if value, ok := rtu.mutation.LabelIds(); ok {
+		_spec.SetField(recurringtask.FieldLabelIds, field.TypeJSON, value)
+	}
+	if value, ok := rtu.mutation.AppendedLabelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurringtask.FieldLabelIds, value)
+		})
+	}
+	if rtu.mutation.LabelIdsCleared() {
+		_spec.ClearField(recurringtask.FieldLabelIds, field.TypeJSON)
+	}
+	if value, ok := rtu.mutation.Priority(); ok {
+		_spec.SetField(recurringtask.FieldPriority, field.TypeEnum, value)
+	}
+	if rtu.mutation.PriorityCleared() {
+		_spec.ClearField(recurringtask.FieldPriority, field.TypeEnum)
+	}
+	if value, ok := rtu.mutation.StoryPoints(); ok {
+		_spec.SetField(recurringtask.FieldStoryPoints, field.TypeInt, value)
+	}
+	if value, ok := rtu.mutation.AddedStoryPoints(); ok {
+		_spec.AddField(recurringtask.FieldStoryPoints, field.TypeInt, value)
+	}
+	if rtu.mutation.StoryPointsCleared() {
+		_spec.ClearField(recurringtask.FieldStoryPoints, field.TypeInt)
+	}
+	if value, ok := rtu.mutation.Rule(); ok {
+		_spec.SetField(recurringtask.FieldRule, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.StartsAt(); ok {
+		_spec.SetField(recurringtask.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := rtu.mutation.NextAt(); ok {
+		_spec.SetField(recurringtask.FieldNextAt, field.TypeTime, value)
+	}
+	if rtu.mutation.NextAtCleared() {
+		_spec.ClearField(recurringtask.FieldNextAt, field.TypeTime)
+	}
+	if value, ok := rtu.mutation.LastAt(); ok {
+		_spec.SetField(recurringtask.FieldLastAt, field.TypeTime, value)
+	}
+	if rtu.mutation.LastAtCleared() {
+		_spec.ClearField(recurringtask.FieldLastAt, field.TypeTime)
+	}
+	if value, ok := rtu.mutation.CreatedAt(); ok {
+		_spec.SetField(recurringtask.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := rtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurringtask.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rtu.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringtask.BoardTable,
+			Columns: []string{recurringtask.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtu.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringtask.BoardTable,
+			Columns: []string{recurringtask.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurringtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RecurringTaskUpdateOne is the builder for updating a single RecurringTask entity.
+type RecurringTaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecurringTaskMutation
+}
+
+// SetBoardID sets the "board_id" field.
+func (rtuo *RecurringTaskUpdateOne) SetBoardID(s string) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetBoardID(s)
+	return rtuo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillableBoardID(s *string) *RecurringTaskUpdateOne {
+	if s != nil {
+		rtuo.SetBoardID(*s)
+	}
+	return rtuo
+}
+
+// SetTitle sets the "title" field.
+func (rtuo *RecurringTaskUpdateOne) SetTitle(s string) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetTitle(s)
+	return rtuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillableTitle(s *string) *RecurringTaskUpdateOne {
+	if s != nil {
+		rtuo.SetTitle(*s)
+	}
+	return rtuo
+}
+
+// SetDescription sets the "description" field.
+func (rtuo *RecurringTaskUpdateOne) SetDescription(s string) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetDescription(s)
+	return rtuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillableDescription(s *string) *RecurringTaskUpdateOne {
+	if s != nil {
+		rtuo.SetDescription(*s)
+	}
+	return rtuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rtuo *RecurringTaskUpdateOne) ClearDescription() *RecurringTaskUpdateOne {
+	rtuo.mutation.ClearDescription()
+	return rtuo
+}
+
+// SetAssigneeIds sets the "assignee_ids" field.
+func (rtuo *RecurringTaskUpdateOne) SetAssigneeIds(s []string) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetAssigneeIds(s)
+	return rtuo
+}
+
+// AppendAssigneeIds appends s to the "assignee_ids" field.
+func (rtuo *RecurringTaskUpdateOne) AppendAssigneeIds(s []string) *RecurringTaskUpdateOne {
+	rtuo.mutation.AppendAssigneeIds(s)
+	return rtuo
+}
+
+// ClearAssigneeIds clears the value of the "assignee_ids" field.
+func (rtuo *RecurringTaskUpdateOne) ClearAssigneeIds() *RecurringTaskUpdateOne {
+	rtuo.mutation.ClearAssigneeIds()
+	return rtuo
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (rtuo *RecurringTaskUpdateOne) SetLabelIds(s []string) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetLabelIds(s)
+	return rtuo
+}
+
+// AppendLabelIds appends s to the "label_ids" field.
+func (rtuo *RecurringTaskUpdateOne) AppendLabelIds(s []string) *RecurringTaskUpdateOne {
+	rtuo.mutation.AppendLabelIds(s)
+	return rtuo
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (rtuo *RecurringTaskUpdateOne) ClearLabelIds() *RecurringTaskUpdateOne {
+	rtuo.mutation.ClearLabelIds()
+	return rtuo
+}
+
+// SetPriority sets the "priority" field.
+func (rtuo *RecurringTaskUpdateOne) SetPriority(r recurringtask.Priority) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetPriority(r)
+	return rtuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillablePriority(r *recurringtask.Priority) *RecurringTaskUpdateOne {
+	if r != nil {
+		rtuo.SetPriority(*r)
+	}
+	return rtuo
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (rtuo *RecurringTaskUpdateOne) ClearPriority() *RecurringTaskUpdateOne {
+	rtuo.mutation.ClearPriority()
+	return rtuo
+}
+
+// SetStoryPoints sets the "story_points" field.
+func (rtuo *RecurringTaskUpdateOne) SetStoryPoints(i int) *RecurringTaskUpdateOne {
+	rtuo.mutation.ResetStoryPoints()
+	rtuo.mutation.SetStoryPoints(i)
+	return rtuo
+}
+
+// SetNillableStoryPoints sets the "story_points" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillableStoryPoints(i *int) *RecurringTaskUpdateOne {
+	if i != nil {
+		rtuo.SetStoryPoints(*i)
+	}
+	return rtuo
+}
+
+// AddStoryPoints adds i to the "story_points" field.
+func (rtuo *RecurringTaskUpdateOne) AddStoryPoints(i int) *RecurringTaskUpdateOne {
+	rtuo.mutation.AddStoryPoints(i)
+	return rtuo
+}
+
+// ClearStoryPoints clears the value of the "story_points" field.
+func (rtuo *RecurringTaskUpdateOne) ClearStoryPoints() *RecurringTaskUpdateOne {
+	rtuo.mutation.ClearStoryPoints()
+	return rtuo
+}
+
+// SetRule sets the "rule" field.
+func (rtuo *RecurringTaskUpdateOne) SetRule(s string) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetRule(s)
+	return rtuo
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillableRule(s *string) *RecurringTaskUpdateOne {
+	if s != nil {
+		rtuo.SetRule(*s)
+	}
+	return rtuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (rtuo *RecurringTaskUpdateOne) SetStartsAt(t time.Time) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetStartsAt(t)
+	return rtuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillableStartsAt(t *time.Time) *RecurringTaskUpdateOne {
+	if t != nil {
+		rtuo.SetStartsAt(*t)
+	}
+	return rtuo
+}
+
+// SetNextAt sets the "next_at" field.
+func (rtuo *RecurringTaskUpdateOne) SetNextAt(t time.Time) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetNextAt(t)
+	return rtuo
+}
+
+// SetNillableNextAt sets the "next_at" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillableNextAt(t *time.Time) *RecurringTaskUpdateOne {
+	if t != nil {
+		rtuo.SetNextAt(*t)
+	}
+	return rtuo
+}
+
+// ClearNextAt clears the value of the "next_at" field.
+func (rtuo *RecurringTaskUpdateOne) ClearNextAt() *RecurringTaskUpdateOne {
+	rtuo.mutation.ClearNextAt()
+	return rtuo
+}
+
+// SetLastAt sets the "last_at" field.
+func (rtuo *RecurringTaskUpdateOne) SetLastAt(t time.Time) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetLastAt(t)
+	return rtuo
+}
+
+// SetNillableLastAt sets the "last_at" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillableLastAt(t *time.Time) *RecurringTaskUpdateOne {
+	if t != nil {
+		rtuo.SetLastAt(*t)
+	}
+	return rtuo
+}
+
+// ClearLastAt clears the value of the "last_at" field.
+func (rtuo *RecurringTaskUpdateOne) ClearLastAt() *RecurringTaskUpdateOne {
+	rtuo.mutation.ClearLastAt()
+	return rtuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtuo *RecurringTaskUpdateOne) SetCreatedAt(t time.Time) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetCreatedAt(t)
+	return rtuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtuo *RecurringTaskUpdateOne) SetNillableCreatedAt(t *time.Time) *RecurringTaskUpdateOne {
+	if t != nil {
+		rtuo.SetCreatedAt(*t)
+	}
+	return rtuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtuo *RecurringTaskUpdateOne) SetUpdatedAt(t time.Time) *RecurringTaskUpdateOne {
+	rtuo.mutation.SetUpdatedAt(t)
+	return rtuo
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (rtuo *RecurringTaskUpdateOne) SetBoard(b *Board) *RecurringTaskUpdateOne {
+	return rtuo.SetBoardID(b.ID)
+}
+
+// Mutation returns the RecurringTaskMutation object of the builder.
+func (rtuo *RecurringTaskUpdateOne) Mutation() *RecurringTaskMutation {
+	return rtuo.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (rtuo *RecurringTaskUpdateOne) ClearBoard() *RecurringTaskUpdateOne {
+	rtuo.mutation.ClearBoard()
+	return rtuo
+}
+
+// Where appends a list predicates to the RecurringTaskUpdate builder.
+func (rtuo *RecurringTaskUpdateOne) Where(ps ...predicate.RecurringTask) *RecurringTaskUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RecurringTaskUpdateOne) Select(field string, fields ...string) *RecurringTaskUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RecurringTask entity.
+func (rtuo *RecurringTaskUpdateOne) Save(ctx context.Context) (*RecurringTask, error) {
+	rtuo.defaults()
+	return withHooks(ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RecurringTaskUpdateOne) SaveX(ctx context.Context) *RecurringTask {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RecurringTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RecurringTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtuo *RecurringTaskUpdateOne) defaults() {
+	if _, ok := rtuo.mutation.UpdatedAt(); !ok {
+		v := recurringtask.UpdateDefaultUpdatedAt()
+		rtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *RecurringTaskUpdateOne) check() error {
+	if v, ok := rtuo.mutation.Priority(); ok {
+		if err := recurringtask.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.priority": %w`, err)}
+		}
+	}
+	if rtuo.mutation.BoardCleared() && len(rtuo.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RecurringTask.board"`)
+	}
+	return nil
+}
+
+func (rtuo *RecurringTaskUpdateOne) sqlSave(ctx context.Context) (_node *RecurringTask, err error) {
+	if err := rtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurringtask.Table, recurringtask.Columns, sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeString))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecurringTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recurringtask.FieldID)
+		for _, f := range fields {
+			if !recurringtask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recurringtask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.Title(); ok {
+		_spec.SetField(recurringtask.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.Description(); ok {
+		_spec.SetField(recurringtask.FieldDescription, field.TypeString, value)
+	}
+	if rtuo.mutation.DescriptionCleared() {
+		_spec.ClearField(recurringtask.FieldDescription, field.TypeString)
+	}
+	if value, ok := rtuo.mutation.AssigneeIds(); ok {
+		_spec.SetField(recurringtask.FieldAssigneeIds, field.TypeJSON, value)
+	}
+	if value, ok := rtuo.mutation.AppendedAssigneeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurringtask.FieldAssigneeIds, value)
+		})
+	}
+	if rtuo.mutation.AssigneeIdsCleared() {
+		_spec.ClearField(recurringtask.FieldAssigneeIds, field.TypeJSON)
+	}
+	if value, ok := rtuo.mutation.LabelIds(); ok {
+		_spec.SetField(recurringtask.FieldLabelIds, field.TypeJSON, value)
+	}
+	if value, ok := rtuo.mutation.AppendedLabelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurringtask.FieldLabelIds, value)
+		})
+	}
+	if rtuo.mutation.LabelIdsCleared() {
+		_spec.ClearField(recurringtask.FieldLabelIds, field.TypeJSON)
+	}
+	if value, ok := rtuo.mutation.Priority(); ok {
+		_spec.SetField(recurringtask.FieldPriority, field.TypeEnum, value)
+	}
+	if rtuo.mutation.PriorityCleared() {
+		_spec.ClearField(recurringtask.FieldPriority, field.TypeEnum)
+	}
+	if value, ok := rtuo.mutation.StoryPoints(); ok {
+		_spec.SetField(recurringtask.FieldStoryPoints, field.TypeInt, value)
+	}
+	if value, ok := rtuo.mutation.AddedStoryPoints(); ok {
+		_spec.AddField(recurringtask.FieldStoryPoints, field.TypeInt, value)
+	}
+	if rtuo.mutation.StoryPointsCleared() {
+		_spec.ClearField(recurringtask.FieldStoryPoints, field.TypeInt)
+	}
+	if value, ok := rtuo.mutation.Rule(); ok {
+		_spec.SetField(recurringtask.FieldRule, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.StartsAt(); ok {
+		_spec.SetField(recurringtask.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := rtuo.mutation.NextAt(); ok {
+		_spec.SetField(recurringtask.FieldNextAt, field.TypeTime, value)
+	}
+	if rtuo.mutation.NextAtCleared() {
+		_spec.ClearField(recurringtask.FieldNextAt, field.TypeTime)
+	}
+	if value, ok := rtuo.mutation.LastAt(); ok {
+		_spec.SetField(recurringtask.FieldLastAt, field.TypeTime, value)
+	}
+	if rtuo.mutation.LastAtCleared() {
+		_spec.ClearField(recurringtask.FieldLastAt, field.TypeTime)
+	}
+	if value, ok := rtuo.mutation.CreatedAt(); ok {
+		_spec.SetField(recurringtask.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := rtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(recurringtask.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rtuo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringtask.BoardTable,
+			Columns: []string{recurringtask.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtuo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recurringtask.BoardTable,
+			Columns: []string{recurringtask.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RecurringTask{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurringtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
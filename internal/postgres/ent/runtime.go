@@ -5,31 +5,158 @@ package ent
 import (
 	"time"
 
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/comment"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/schema"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	activityFields := schema.Activity{}.Fields()
+	_ = activityFields
+	// activityDescChangedAt is the schema descriptor for changed_at field.
+	activityDescChangedAt := activityFields[7].Descriptor()
+	// activity.DefaultChangedAt holds the default value on creation for the changed_at field.
+	activity.DefaultChangedAt = activityDescChangedAt.Default.(func() time.Time)
+	boardFields := schema.Board{}.Fields()
+	_ = boardFields
+	// boardDescCreatedAt is the schema descriptor for created_at field.
+	boardDescCreatedAt := boardFields[6].Descriptor()
+	// board.DefaultCreatedAt holds the default value on creation for the created_at field.
+	board.DefaultCreatedAt = boardDescCreatedAt.Default.(func() time.Time)
+	// boardDescUpdatedAt is the schema descriptor for updated_at field.
+	boardDescUpdatedAt := boardFields[7].Descriptor()
+	// board.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	board.DefaultUpdatedAt = boardDescUpdatedAt.Default.(func() time.Time)
+	// board.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	board.UpdateDefaultUpdatedAt = boardDescUpdatedAt.UpdateDefault.(func() time.Time)
+	checklistitemFields := schema.ChecklistItem{}.Fields()
+	_ = checklistitemFields
+	// checklistitemDescDone is the schema descriptor for done field.
+	checklistitemDescDone := checklistitemFields[3].Descriptor()
+	// checklistitem.DefaultDone holds the default value on creation for the done field.
+	checklistitem.DefaultDone = checklistitemDescDone.Default.(bool)
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[6].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentFields[7].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	comment.UpdateDefaultUpdatedAt = commentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	customfieldFields := schema.CustomField{}.Fields()
+	_ = customfieldFields
+	// customfieldDescCreatedAt is the schema descriptor for created_at field.
+	customfieldDescCreatedAt := customfieldFields[5].Descriptor()
+	// customfield.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customfield.DefaultCreatedAt = customfieldDescCreatedAt.Default.(func() time.Time)
+	epicFields := schema.Epic{}.Fields()
+	_ = epicFields
+	// epicDescCreatedAt is the schema descriptor for created_at field.
+	epicDescCreatedAt := epicFields[5].Descriptor()
+	// epic.DefaultCreatedAt holds the default value on creation for the created_at field.
+	epic.DefaultCreatedAt = epicDescCreatedAt.Default.(func() time.Time)
 	fileFields := schema.File{}.Fields()
 	_ = fileFields
 	// fileDescUploadedAt is the schema descriptor for uploaded_at field.
 	fileDescUploadedAt := fileFields[4].Descriptor()
 	// file.DefaultUploadedAt holds the default value on creation for the uploaded_at field.
 	file.DefaultUploadedAt = fileDescUploadedAt.Default.(func() time.Time)
+	labelFields := schema.Label{}.Fields()
+	_ = labelFields
+	// labelDescCreatedAt is the schema descriptor for created_at field.
+	labelDescCreatedAt := labelFields[4].Descriptor()
+	// label.DefaultCreatedAt holds the default value on creation for the created_at field.
+	label.DefaultCreatedAt = labelDescCreatedAt.Default.(func() time.Time)
+	memberFields := schema.Member{}.Fields()
+	_ = memberFields
+	// memberDescCreatedAt is the schema descriptor for created_at field.
+	memberDescCreatedAt := memberFields[5].Descriptor()
+	// member.DefaultCreatedAt holds the default value on creation for the created_at field.
+	member.DefaultCreatedAt = memberDescCreatedAt.Default.(func() time.Time)
+	recurringtaskFields := schema.RecurringTask{}.Fields()
+	_ = recurringtaskFields
+	// recurringtaskDescCreatedAt is the schema descriptor for created_at field.
+	recurringtaskDescCreatedAt := recurringtaskFields[12].Descriptor()
+	// recurringtask.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recurringtask.DefaultCreatedAt = recurringtaskDescCreatedAt.Default.(func() time.Time)
+	// recurringtaskDescUpdatedAt is the schema descriptor for updated_at field.
+	recurringtaskDescUpdatedAt := recurringtaskFields[13].Descriptor()
+	// recurringtask.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recurringtask.DefaultUpdatedAt = recurringtaskDescUpdatedAt.Default.(func() time.Time)
+	// recurringtask.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	recurringtask.UpdateDefaultUpdatedAt = recurringtaskDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sprintFields := schema.Sprint{}.Fields()
+	_ = sprintFields
+	// sprintDescCreatedAt is the schema descriptor for created_at field.
+	sprintDescCreatedAt := sprintFields[7].Descriptor()
+	// sprint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sprint.DefaultCreatedAt = sprintDescCreatedAt.Default.(func() time.Time)
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
 	// taskDescCreatedAt is the schema descriptor for created_at field.
-	taskDescCreatedAt := taskFields[5].Descriptor()
+	taskDescCreatedAt := taskFields[10].Descriptor()
 	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
 	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
 	// taskDescUpdatedAt is the schema descriptor for updated_at field.
-	taskDescUpdatedAt := taskFields[6].Descriptor()
+	taskDescUpdatedAt := taskFields[11].Descriptor()
 	// task.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
 	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	task.UpdateDefaultUpdatedAt = taskDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taskDescStatus is the schema descriptor for status field.
+	taskDescStatus := taskFields[14].Descriptor()
+	// task.DefaultStatus holds the default value on creation for the status field.
+	task.DefaultStatus = taskDescStatus.Default.(string)
+	// taskDescRank is the schema descriptor for rank field.
+	taskDescRank := taskFields[15].Descriptor()
+	// task.DefaultRank holds the default value on creation for the rank field.
+	task.DefaultRank = taskDescRank.Default.(string)
+	// taskDescVersion is the schema descriptor for version field.
+	taskDescVersion := taskFields[21].Descriptor()
+	// task.DefaultVersion holds the default value on creation for the version field.
+	task.DefaultVersion = taskDescVersion.Default.(int)
+	tasktemplateFields := schema.TaskTemplate{}.Fields()
+	_ = tasktemplateFields
+	// tasktemplateDescCreatedAt is the schema descriptor for created_at field.
+	tasktemplateDescCreatedAt := tasktemplateFields[7].Descriptor()
+	// tasktemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tasktemplate.DefaultCreatedAt = tasktemplateDescCreatedAt.Default.(func() time.Time)
+	// tasktemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	tasktemplateDescUpdatedAt := tasktemplateFields[8].Descriptor()
+	// tasktemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tasktemplate.DefaultUpdatedAt = tasktemplateDescUpdatedAt.Default.(func() time.Time)
+	// tasktemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tasktemplate.UpdateDefaultUpdatedAt = tasktemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	timerFields := schema.Timer{}.Fields()
+	_ = timerFields
+	// timerDescStartedAt is the schema descriptor for started_at field.
+	timerDescStartedAt := timerFields[2].Descriptor()
+	// timer.DefaultStartedAt holds the default value on creation for the started_at field.
+	timer.DefaultStartedAt = timerDescStartedAt.Default.(func() time.Time)
+	worklogFields := schema.Worklog{}.Fields()
+	_ = worklogFields
+	// worklogDescCreatedAt is the schema descriptor for created_at field.
+	worklogDescCreatedAt := worklogFields[6].Descriptor()
+	// worklog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	worklog.DefaultCreatedAt = worklogDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Activity holds the schema definition for the Activity entity.
+// Activities are only ever appended, they record the history of a task.
+type Activity struct {
+	ent.Schema
+}
+
+// Fields of the Activity.
+func (Activity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Immutable(),
+		field.String("task_id").
+			Immutable(),
+		field.String("actor").
+			Immutable(),
+		field.Enum("source").
+			Values("api", "assistant", "scheduler").
+			Immutable(),
+		field.String("field").
+			Immutable(),
+		field.Text("from_value").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Text("to_value").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("changed_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Activity.
+func (Activity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("activities").
+			Field("task_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Activity.
+func (Activity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "changed_at"),
+	}
+}
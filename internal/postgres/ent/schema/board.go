@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Board holds the schema definition for the Board entity.
+type Board struct {
+	ent.Schema
+}
+
+// Fields of the Board.
+func (Board) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("name"),
+		// The workflow's columns in order, empty for boards created before workflows existed
+		field.Strings("statuses").
+			Optional(),
+		// Allowed moves from a status, a status without an entry can move anywhere
+		field.JSON("transitions", map[string][]string{}).
+			Optional(),
+		// WIP limits per status for the whole board and for each assignee
+		field.JSON("wip_limits", map[string]int{}).
+			Optional(),
+		field.JSON("assignee_wip_limits", map[string]int{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("archived_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Board.
+func (Board) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tasks", Task.Type),
+		edge.To("labels", Label.Type),
+		edge.To("sprints", Sprint.Type),
+		edge.To("epics", Epic.Type),
+		edge.To("members", Member.Type),
+		edge.To("recurring_tasks", RecurringTask.Type),
+		edge.To("task_templates", TaskTemplate.Type),
+		edge.To("custom_fields", CustomField.Type),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ChecklistItem holds the schema definition for the ChecklistItem entity.
+type ChecklistItem struct {
+	ent.Schema
+}
+
+// Fields of the ChecklistItem.
+func (ChecklistItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("task_id"),
+		field.String("text"),
+		field.Bool("done").
+			Default(false),
+		// Order of the item in the task's checklist, starting at 0
+		field.Int("position"),
+	}
+}
+
+// Edges of the ChecklistItem.
+func (ChecklistItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("checklist_items").
+			Field("task_id").
+			Unique().
+			Required(),
+	}
+}
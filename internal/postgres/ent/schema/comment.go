@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Comment holds the schema definition for the Comment entity.
+type Comment struct {
+	ent.Schema
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("task_id"),
+		// The comment this one replies to
+		field.String("parent_id").
+			Optional().
+			Nillable(),
+		field.String("author"),
+		// Markdown
+		field.Text("body"),
+		field.Strings("mentions").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("edited_at").
+			Optional().
+			Nillable(),
+		// Deleted comments are kept so their replies stay in the thread
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("comments").
+			Field("task_id").
+			Unique().
+			Required(),
+		edge.To("replies", Comment.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CustomField holds the schema definition for the CustomField entity.
+type CustomField struct {
+	ent.Schema
+}
+
+// Fields of the CustomField.
+func (CustomField) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("board_id"),
+		field.String("name"),
+		field.Enum("type").
+			Values("text", "number", "date", "select", "user"),
+		// Values a select field can take
+		field.Strings("options").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the CustomField.
+func (CustomField) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("board", Board.Type).
+			Ref("custom_fields").
+			Field("board_id").
+			Unique().
+			Required(),
+		edge.To("values", CustomFieldValue.Type),
+	}
+}
+
+// Indexes of the CustomField.
+func (CustomField) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("board_id", "name").
+			Unique(),
+	}
+}
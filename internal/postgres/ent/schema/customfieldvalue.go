@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CustomFieldValue holds the schema definition for the CustomFieldValue entity.
+type CustomFieldValue struct {
+	ent.Schema
+}
+
+// Fields of the CustomFieldValue.
+func (CustomFieldValue) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("task_id"),
+		field.String("field_id"),
+		// Numbers are stored like 3.5, dates like 2026-01-31 and users as member IDs
+		field.String("value"),
+	}
+}
+
+// Edges of the CustomFieldValue.
+func (CustomFieldValue) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("custom_field_values").
+			Field("task_id").
+			Unique().
+			Required(),
+		edge.From("custom_field", CustomField.Type).
+			Ref("values").
+			Field("field_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the CustomFieldValue.
+func (CustomFieldValue) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "field_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Epic holds the schema definition for the Epic entity.
+type Epic struct {
+	ent.Schema
+}
+
+// Fields of the Epic.
+func (Epic) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("board_id"),
+		field.String("name"),
+		field.String("description").
+			Optional().
+			Nillable(),
+		field.Time("target_date"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Epic.
+func (Epic) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("board", Board.Type).
+			Ref("epics").
+			Field("board_id").
+			Unique().
+			Required(),
+		edge.To("tasks", Task.Type),
+	}
+}
@@ -30,5 +30,7 @@ func (File) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("task", Task.Type).
 			Ref("files"),
+		edge.From("template", TaskTemplate.Type).
+			Ref("files"),
 	}
 }
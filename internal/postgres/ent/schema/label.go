@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Label holds the schema definition for the Label entity.
+type Label struct {
+	ent.Schema
+}
+
+// Fields of the Label.
+func (Label) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("board_id"),
+		field.String("name"),
+		field.String("color"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Label.
+func (Label) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("board", Board.Type).
+			Ref("labels").
+			Field("board_id").
+			Unique().
+			Required(),
+		edge.From("tasks", Task.Type).
+			Ref("labels"),
+	}
+}
+
+// Indexes of the Label.
+func (Label) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("board_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Member holds the schema definition for the Member entity.
+type Member struct {
+	ent.Schema
+}
+
+// Fields of the Member.
+func (Member) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("board_id"),
+		field.String("display_name"),
+		// Members migrated from the free-text assignee names don't have one
+		field.String("email").
+			Optional().
+			Nillable(),
+		field.Enum("role").
+			Values("admin", "member").
+			Default("member"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Member.
+func (Member) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("board", Board.Type).
+			Ref("members").
+			Field("board_id").
+			Unique().
+			Required(),
+		edge.From("assigned_tasks", Task.Type).
+			Ref("assignees"),
+		edge.From("watched_tasks", Task.Type).
+			Ref("watchers"),
+		edge.To("worklogs", Worklog.Type),
+		edge.To("timers", Timer.Type),
+	}
+}
+
+// Indexes of the Member.
+func (Member) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("board_id", "display_name").
+			Unique(),
+		index.Fields("board_id", "email").
+			Unique(),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RecurringTask holds the schema definition for the RecurringTask entity.
+type RecurringTask struct {
+	ent.Schema
+}
+
+// Fields of the RecurringTask.
+func (RecurringTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("board_id"),
+		field.String("title"),
+		field.String("description").
+			Optional().
+			Nillable(),
+		// Copied to every task created from the recurring task
+		field.Strings("assignee_ids").
+			Optional(),
+		field.Strings("label_ids").
+			Optional(),
+		field.Enum("priority").
+			Values("p0", "p1", "p2", "p3").
+			Optional().
+			Nillable(),
+		field.Int("story_points").
+			Optional().
+			Nillable(),
+		// RRULE, e.g. FREQ=WEEKLY;BYDAY=MO
+		field.String("rule"),
+		field.Time("starts_at"),
+		// Null once the rule has no occurrences left
+		field.Time("next_at").
+			Optional().
+			Nillable(),
+		field.Time("last_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RecurringTask.
+func (RecurringTask) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("board", Board.Type).
+			Ref("recurring_tasks").
+			Field("board_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the RecurringTask.
+func (RecurringTask) Indexes() []ent.Index {
+	return []ent.Index{
+		// The scheduler looks for the due ones
+		index.Fields("next_at"),
+	}
+}
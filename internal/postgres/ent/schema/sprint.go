@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Sprint holds the schema definition for the Sprint entity.
+type Sprint struct {
+	ent.Schema
+}
+
+// Fields of the Sprint.
+func (Sprint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("board_id"),
+		field.String("name"),
+		field.String("goal").
+			Optional().
+			Nillable(),
+		field.Time("start_date"),
+		field.Time("end_date"),
+		field.Enum("status").
+			Values("planned", "active", "closed").
+			Default("planned"),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Sprint.
+func (Sprint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("board", Board.Type).
+			Ref("sprints").
+			Field("board_id").
+			Unique().
+			Required(),
+		edge.To("tasks", Task.Type),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StatusChange holds the schema definition for the StatusChange entity.
+type StatusChange struct {
+	ent.Schema
+}
+
+// Fields of the StatusChange.
+func (StatusChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("task_id"),
+		field.String("from_status"),
+		field.String("to_status"),
+		field.Time("changed_at"),
+	}
+}
+
+// Edges of the StatusChange.
+func (StatusChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("status_changes").
+			Field("task_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the StatusChange.
+func (StatusChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "changed_at"),
+	}
+}
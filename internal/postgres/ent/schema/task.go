@@ -16,6 +16,18 @@ type Task struct {
 func (Task) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id"),
+		field.String("board_id").
+			Optional().
+			Nillable(),
+		field.String("parent_id").
+			Optional().
+			Nillable(),
+		field.String("sprint_id").
+			Optional().
+			Nillable(),
+		field.String("epic_id").
+			Optional().
+			Nillable(),
 		field.String("title"),
 		field.String("description").
 			Optional().
@@ -23,6 +35,11 @@ func (Task) Fields() []ent.Field {
 		field.Time("due_date").
 			Optional().
 			Nillable(),
+		// Deprecated: replaced by the assignees edge, only kept until the tasks are migrated
+		field.String("assignee_id").
+			Optional().
+			Nillable(),
+		// Deprecated: replaced by the assignees edge, only kept until the names are migrated to members
 		field.String("assignee_name").
 			Optional().
 			Nillable(),
@@ -34,14 +51,36 @@ func (Task) Fields() []ent.Field {
 		field.Time("completed_at").
 			Optional().
 			Nillable(),
-		field.Enum("status").
-			Values(
-				"pending",
-				"in_progress",
-				"in_review",
-				"completed",
-			).
+		// Archived tasks are hidden from the board until they're restored
+		field.Time("archived_at").
+			Optional().
+			Nillable(),
+		// Statuses are defined by the board's workflow
+		field.String("status").
 			Default("pending"),
+		// Position of the task in its status column, compared as a string
+		field.String("rank").
+			Default(""),
+		field.Enum("priority").
+			Values("p0", "p1", "p2", "p3").
+			Optional().
+			Nillable(),
+		field.Int("story_points").
+			Optional().
+			Nillable(),
+		// Who forced the task into its status past the column's WIP limit
+		field.String("wip_override_by").
+			Optional().
+			Nillable(),
+		field.String("wip_override_status").
+			Optional().
+			Nillable(),
+		field.Time("wip_override_at").
+			Optional().
+			Nillable(),
+		// Bumped on every update, an update based on an older version is rejected
+		field.Int("version").
+			Default(1),
 	}
 }
 
@@ -49,5 +88,35 @@ func (Task) Fields() []ent.Field {
 func (Task) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("files", File.Type),
+		edge.From("board", Board.Type).
+			Ref("tasks").
+			Field("board_id").
+			Unique(),
+		edge.To("children", Task.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		// A task can't start while the tasks blocking it aren't completed
+		edge.To("blocks", Task.Type).
+			From("blocked_by"),
+		edge.To("labels", Label.Type),
+		edge.From("sprint", Sprint.Type).
+			Ref("tasks").
+			Field("sprint_id").
+			Unique(),
+		edge.From("epic", Epic.Type).
+			Ref("tasks").
+			Field("epic_id").
+			Unique(),
+		edge.To("comments", Comment.Type),
+		edge.To("activities", Activity.Type),
+		edge.To("assignees", Member.Type),
+		// Watchers get notified about the task without being assigned to it
+		edge.To("watchers", Member.Type),
+		edge.To("checklist_items", ChecklistItem.Type),
+		edge.To("custom_field_values", CustomFieldValue.Type),
+		edge.To("worklogs", Worklog.Type),
+		edge.To("timers", Timer.Type),
+		edge.To("status_changes", StatusChange.Type),
 	}
 }
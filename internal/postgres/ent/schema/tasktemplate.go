@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskTemplate holds the schema definition for the TaskTemplate entity.
+type TaskTemplate struct {
+	ent.Schema
+}
+
+// Fields of the TaskTemplate.
+func (TaskTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("board_id"),
+		field.String("title"),
+		field.String("description").
+			Optional().
+			Nillable(),
+		field.Strings("label_ids").
+			Optional(),
+		field.String("default_assignee_id").
+			Optional().
+			Nillable(),
+		// Titles of the subtasks created with each task
+		field.Strings("subtasks").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TaskTemplate.
+func (TaskTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("board", Board.Type).
+			Ref("task_templates").
+			Field("board_id").
+			Unique().
+			Required(),
+		edge.To("files", File.Type),
+	}
+}
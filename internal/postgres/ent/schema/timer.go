@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Timer holds the schema definition for the Timer entity.
+type Timer struct {
+	ent.Schema
+}
+
+// Fields of the Timer.
+func (Timer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("task_id"),
+		// A member runs at most one timer at a time
+		field.String("member_id").
+			Unique(),
+		field.Time("started_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Timer.
+func (Timer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("timers").
+			Field("task_id").
+			Unique().
+			Required(),
+		edge.From("member", Member.Type).
+			Ref("timers").
+			Field("member_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Worklog holds the schema definition for the Worklog entity.
+type Worklog struct {
+	ent.Schema
+}
+
+// Fields of the Worklog.
+func (Worklog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("task_id"),
+		field.String("member_id"),
+		field.Int("duration_minutes"),
+		// The day the work was done, at midnight UTC
+		field.Time("date"),
+		field.String("note").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Worklog.
+func (Worklog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("worklogs").
+			Field("task_id").
+			Unique().
+			Required(),
+		edge.From("member", Member.Type).
+			Ref("worklogs").
+			Field("member_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Worklog.
+func (Worklog) Indexes() []ent.Index {
+	return []ent.Index{
+		// For the timesheets
+		index.Fields("member_id", "date"),
+	}
+}
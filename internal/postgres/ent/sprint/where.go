@@ -0,0 +1,612 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sprint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldContainsFold(FieldID, id))
+}
+
+// BoardID applies equality check predicate on the "board_id" field. It's identical to BoardIDEQ.
+func BoardID(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldBoardID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldName, v))
+}
+
+// Goal applies equality check predicate on the "goal" field. It's identical to GoalEQ.
+func Goal(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldGoal, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldEndDate, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// BoardIDEQ applies the EQ predicate on the "board_id" field.
+func BoardIDEQ(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldBoardID, v))
+}
+
+// BoardIDNEQ applies the NEQ predicate on the "board_id" field.
+func BoardIDNEQ(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldBoardID, v))
+}
+
+// BoardIDIn applies the In predicate on the "board_id" field.
+func BoardIDIn(vs ...string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldBoardID, vs...))
+}
+
+// BoardIDNotIn applies the NotIn predicate on the "board_id" field.
+func BoardIDNotIn(vs ...string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldBoardID, vs...))
+}
+
+// BoardIDGT applies the GT predicate on the "board_id" field.
+func BoardIDGT(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGT(FieldBoardID, v))
+}
+
+// BoardIDGTE applies the GTE predicate on the "board_id" field.
+func BoardIDGTE(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGTE(FieldBoardID, v))
+}
+
+// BoardIDLT applies the LT predicate on the "board_id" field.
+func BoardIDLT(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLT(FieldBoardID, v))
+}
+
+// BoardIDLTE applies the LTE predicate on the "board_id" field.
+func BoardIDLTE(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLTE(FieldBoardID, v))
+}
+
+// BoardIDContains applies the Contains predicate on the "board_id" field.
+func BoardIDContains(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldContains(FieldBoardID, v))
+}
+
+// BoardIDHasPrefix applies the HasPrefix predicate on the "board_id" field.
+func BoardIDHasPrefix(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldHasPrefix(FieldBoardID, v))
+}
+
+// BoardIDHasSuffix applies the HasSuffix predicate on the "board_id" field.
+func BoardIDHasSuffix(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldHasSuffix(FieldBoardID, v))
+}
+
+// BoardIDEqualFold applies the EqualFold predicate on the "board_id" field.
+func BoardIDEqualFold(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEqualFold(FieldBoardID, v))
+}
+
+// BoardIDContainsFold applies the ContainsFold predicate on the "board_id" field.
+func BoardIDContainsFold(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldContainsFold(FieldBoardID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldContainsFold(FieldName, v))
+}
+
+// GoalEQ applies the EQ predicate on the "goal" field.
+func GoalEQ(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldGoal, v))
+}
+
+// GoalNEQ applies the NEQ predicate on the "goal" field.
+func GoalNEQ(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldGoal, v))
+}
+
+// GoalIn applies the In predicate on the "goal" field.
+func GoalIn(vs ...string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldGoal, vs...))
+}
+
+// GoalNotIn applies the NotIn predicate on the "goal" field.
+func GoalNotIn(vs ...string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldGoal, vs...))
+}
+
+// GoalGT applies the GT predicate on the "goal" field.
+func GoalGT(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGT(FieldGoal, v))
+}
+
+// GoalGTE applies the GTE predicate on the "goal" field.
+func GoalGTE(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGTE(FieldGoal, v))
+}
+
+// GoalLT applies the LT predicate on the "goal" field.
+func GoalLT(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLT(FieldGoal, v))
+}
+
+// GoalLTE applies the LTE predicate on the "goal" field.
+func GoalLTE(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLTE(FieldGoal, v))
+}
+
+// GoalContains applies the Contains predicate on the "goal" field.
+func GoalContains(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldContains(FieldGoal, v))
+}
+
+// GoalHasPrefix applies the HasPrefix predicate on the "goal" field.
+func GoalHasPrefix(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldHasPrefix(FieldGoal, v))
+}
+
+// GoalHasSuffix applies the HasSuffix predicate on the "goal" field.
+func GoalHasSuffix(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldHasSuffix(FieldGoal, v))
+}
+
+// GoalIsNil applies the IsNil predicate on the "goal" field.
+func GoalIsNil() predicate.Sprint {
+	return predicate.Sprint(sql.FieldIsNull(FieldGoal))
+}
+
+// GoalNotNil applies the NotNil predicate on the "goal" field.
+func GoalNotNil() predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotNull(FieldGoal))
+}
+
+// GoalEqualFold applies the EqualFold predicate on the "goal" field.
+func GoalEqualFold(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEqualFold(FieldGoal, v))
+}
+
+// GoalContainsFold applies the ContainsFold predicate on the "goal" field.
+func GoalContainsFold(v string) predicate.Sprint {
+	return predicate.Sprint(sql.FieldContainsFold(FieldGoal, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLTE(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLTE(FieldEndDate, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.Sprint {
+	return predicate.Sprint(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotNull(FieldStartedAt))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.Sprint {
+	return predicate.Sprint(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.Sprint {
+	return predicate.Sprint(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.Sprint {
+	return predicate.Sprint(sql.FieldNotNull(FieldClosedAt))
+}
+
+// HasBoard applies the HasEdge predicate on the "board" edge.
+func HasBoard() predicate.Sprint {
+	return predicate.Sprint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoardWith applies the HasEdge predicate on the "board" edge with a given conditions (other predicates).
+func HasBoardWith(preds ...predicate.Board) predicate.Sprint {
+	return predicate.Sprint(func(s *sql.Selector) {
+		step := newBoardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTasks applies the HasEdge predicate on the "tasks" edge.
+func HasTasks() predicate.Sprint {
+	return predicate.Sprint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TasksTable, TasksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTasksWith applies the HasEdge predicate on the "tasks" edge with a given conditions (other predicates).
+func HasTasksWith(preds ...predicate.Task) predicate.Sprint {
+	return predicate.Sprint(func(s *sql.Selector) {
+		step := newTasksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Sprint) predicate.Sprint {
+	return predicate.Sprint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Sprint) predicate.Sprint {
+	return predicate.Sprint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Sprint) predicate.Sprint {
+	return predicate.Sprint(sql.NotPredicates(p))
+}
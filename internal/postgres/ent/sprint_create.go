@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// SprintCreate is the builder for creating a Sprint entity.
+type SprintCreate struct {
+	config
+	mutation *SprintMutation
+	hooks    []Hook
+}
+
+// SetBoardID sets the "board_id" field.
+func (sc *SprintCreate) SetBoardID(s string) *SprintCreate {
+	sc.mutation.SetBoardID(s)
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *SprintCreate) SetName(s string) *SprintCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetGoal sets the "goal" field.
+func (sc *SprintCreate) SetGoal(s string) *SprintCreate {
+	sc.mutation.SetGoal(s)
+	return sc
+}
+
+// SetNillableGoal sets the "goal" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableGoal(s *string) *SprintCreate {
+	if s != nil {
+		sc.SetGoal(*s)
+	}
+	return sc
+}
+
+// SetStartDate sets the "start_date" field.
+func (sc *SprintCreate) SetStartDate(t time.Time) *SprintCreate {
+	sc.mutation.SetStartDate(t)
+	return sc
+}
+
+// SetEndDate sets the "end_date" field.
+func (sc *SprintCreate) SetEndDate(t time.Time) *SprintCreate {
+	sc.mutation.SetEndDate(t)
+	return sc
+}
+
+// SetStatus sets the "status" field.
+func (sc *SprintCreate) SetStatus(s sprint.Status) *SprintCreate {
+	sc.mutation.SetStatus(s)
+	return sc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableStatus(s *sprint.Status) *SprintCreate {
+	if s != nil {
+		sc.SetStatus(*s)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SprintCreate) SetCreatedAt(t time.Time) *SprintCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableCreatedAt(t *time.Time) *SprintCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sc *SprintCreate) SetStartedAt(t time.Time) *SprintCreate {
+	sc.mutation.SetStartedAt(t)
+	return sc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableStartedAt(t *time.Time) *SprintCreate {
+	if t != nil {
+		sc.SetStartedAt(*t)
+	}
+	return sc
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (sc *SprintCreate) SetClosedAt(t time.Time) *SprintCreate {
+	sc.mutation.SetClosedAt(t)
+	return sc
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (sc *SprintCreate) SetNillableClosedAt(t *time.Time) *SprintCreate {
+	if t != nil {
+		sc.SetClosedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SprintCreate) SetID(s string) *SprintCreate {
+	sc.mutation.SetID(s)
+	return sc
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (sc *SprintCreate) SetBoard(b *Board) *SprintCreate {
+	return sc.SetBoardID(b.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (sc *SprintCreate) AddTaskIDs(ids ...string) *SprintCreate {
+	sc.mutation.AddTaskIDs(ids...)
+	return sc
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (sc *SprintCreate) AddTasks(t ...*Task) *SprintCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return sc.AddTaskIDs(ids...)
+}
+
+// Mutation returns the SprintMutation object of the builder.
+func (sc *SprintCreate) Mutation() *SprintMutation {
+	return sc.mutation
+}
+
+// Save creates the Sprint in the database.
+func (sc *SprintCreate) Save(ctx context.Context) (*Sprint, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SprintCreate) SaveX(ctx context.Context) *Sprint {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SprintCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SprintCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SprintCreate) defaults() {
+	if _, ok := sc.mutation.Status(); !ok {
+		v := sprint.DefaultStatus
+		sc.mutation.SetStatus(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := sprint.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SprintCreate) check() error {
+	if _, ok := sc.mutation.BoardID(); !ok {
+		return &ValidationError{Name: "board_id", err: errors.New(`ent: missing required field "Sprint.board_id"`)}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Sprint.name"`)}
+	}
+	if _, ok := sc.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "Sprint.start_date"`)}
+	}
+	if _, ok := sc.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "Sprint.end_date"`)}
+	}
+	if _, ok := sc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Sprint.status"`)}
+	}
+	if v, ok := sc.mutation.Status(); ok {
+		if err := sprint.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Sprint.status": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Sprint.created_at"`)}
+	}
+	if len(sc.mutation.BoardIDs()) == 0 {
+		return &ValidationError{Name: "board", err: errors.New(`ent: missing required edge "Sprint.board"`)}
+	}
+	return nil
+}
+
+func (sc *SprintCreate) sqlSave(ctx context.Context) (*Sprint, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Sprint.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SprintCreate) createSpec() (*Sprint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Sprint{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(sprint.Table, sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString))
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(sprint.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.Goal(); ok {
+		_spec.SetField(sprint.FieldGoal, field.TypeString, value)
+		_node.Goal = &value
+	}
+	if value, ok := sc.mutation.StartDate(); ok {
+		_spec.SetField(sprint.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := sc.mutation.EndDate(); ok {
+		_spec.SetField(sprint.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := sc.mutation.Status(); ok {
+		_spec.SetField(sprint.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(sprint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.StartedAt(); ok {
+		_spec.SetField(sprint.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := sc.mutation.ClosedAt(); ok {
+		_spec.SetField(sprint.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if nodes := sc.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.BoardTable,
+			Columns: []string{sprint.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SprintCreateBulk is the builder for creating many Sprint entities in bulk.
+type SprintCreateBulk struct {
+	config
+	err      error
+	builders []*SprintCreate
+}
+
+// Save creates the Sprint entities in the database.
+func (scb *SprintCreateBulk) Save(ctx context.Context) ([]*Sprint, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Sprint, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SprintMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SprintCreateBulk) SaveX(ctx context.Context) []*Sprint {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SprintCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SprintCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,806 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// SprintUpdate is the builder for updating Sprint entities.
+type SprintUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SprintMutation
+}
+
+// Where appends a list predicates to the SprintUpdate builder.
+func (su *SprintUpdate) Where(ps ...predicate.Sprint) *SprintUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetBoardID sets the "board_id" field.
+func (su *SprintUpdate) SetBoardID(s string) *SprintUpdate {
+	su.mutation.SetBoardID(s)
+	return su
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableBoardID(s *string) *SprintUpdate {
+	if s != nil {
+		su.SetBoardID(*s)
+	}
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *SprintUpdate) SetName(s string) *SprintUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableName(s *string) *SprintUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetGoal sets the "goal" field.
+func (su *SprintUpdate) SetGoal(s string) *SprintUpdate {
+	su.mutation.SetGoal(s)
+	return su
+}
+
+// SetNillableGoal sets the "goal" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableGoal(s *string) *SprintUpdate {
+	if s != nil {
+		su.SetGoal(*s)
+	}
+	return su
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (su *SprintUpdate) ClearGoal() *SprintUpdate {
+	su.mutation.ClearGoal()
+	return su
+}
+
+// SetStartDate sets the "start_date" field.
+func (su *SprintUpdate) SetStartDate(t time.Time) *SprintUpdate {
+	su.mutation.SetStartDate(t)
+	return su
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableStartDate(t *time.Time) *SprintUpdate {
+	if t != nil {
+		su.SetStartDate(*t)
+	}
+	return su
+}
+
+// SetEndDate sets the "end_date" field.
+func (su *SprintUpdate) SetEndDate(t time.Time) *SprintUpdate {
+	su.mutation.SetEndDate(t)
+	return su
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableEndDate(t *time.Time) *SprintUpdate {
+	if t != nil {
+		su.SetEndDate(*t)
+	}
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *SprintUpdate) SetStatus(s sprint.Status) *SprintUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableStatus(s *sprint.Status) *SprintUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (su *SprintUpdate) SetCreatedAt(t time.Time) *SprintUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableCreatedAt(t *time.Time) *SprintUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetStartedAt sets the "started_at" field.
+func (su *SprintUpdate) SetStartedAt(t time.Time) *SprintUpdate {
+	su.mutation.SetStartedAt(t)
+	return su
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableStartedAt(t *time.Time) *SprintUpdate {
+	if t != nil {
+		su.SetStartedAt(*t)
+	}
+	return su
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (su *SprintUpdate) ClearStartedAt() *SprintUpdate {
+	su.mutation.ClearStartedAt()
+	return su
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (su *SprintUpdate) SetClosedAt(t time.Time) *SprintUpdate {
+	su.mutation.SetClosedAt(t)
+	return su
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (su *SprintUpdate) SetNillableClosedAt(t *time.Time) *SprintUpdate {
+	if t != nil {
+		su.SetClosedAt(*t)
+	}
+	return su
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (su *SprintUpdate) ClearClosedAt() *SprintUpdate {
+	su.mutation.ClearClosedAt()
+	return su
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (su *SprintUpdate) SetBoard(b *Board) *SprintUpdate {
+	return su.SetBoardID(b.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (su *SprintUpdate) AddTaskIDs(ids ...string) *SprintUpdate {
+	su.mutation.AddTaskIDs(ids...)
+	return su
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (su *SprintUpdate) AddTasks(t ...*Task) *SprintUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.AddTaskIDs(ids...)
+}
+
+// Mutation returns the SprintMutation object of the builder.
+func (su *SprintUpdate) Mutation() *SprintMutation {
+	return su.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (su *SprintUpdate) ClearBoard() *SprintUpdate {
+	su.mutation.ClearBoard()
+	return su
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (su *SprintUpdate) ClearTasks() *SprintUpdate {
+	su.mutation.ClearTasks()
+	return su
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (su *SprintUpdate) RemoveTaskIDs(ids ...string) *SprintUpdate {
+	su.mutation.RemoveTaskIDs(ids...)
+	return su
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (su *SprintUpdate) RemoveTasks(t ...*Task) *SprintUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SprintUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SprintUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SprintUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SprintUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SprintUpdate) check() error {
+	if v, ok := su.mutation.Status(); ok {
+		if err := sprint.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Sprint.status": %w`, err)}
+		}
+	}
+	if su.mutation.BoardCleared() && len(su.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sprint.board"`)
+	}
+	return nil
+}
+
+func (su *SprintUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sprint.Table, sprint.Columns, sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(sprint.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Goal(); ok {
+		_spec.SetField(sprint.FieldGoal, field.TypeString, value)
+	}
+	if su.mutation.GoalCleared() {
+		_spec.ClearField(sprint.FieldGoal, field.TypeString)
+	}
+	if value, ok := su.mutation.StartDate(); ok {
+		_spec.SetField(sprint.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.EndDate(); ok {
+		_spec.SetField(sprint.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(sprint.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(sprint.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.StartedAt(); ok {
+		_spec.SetField(sprint.FieldStartedAt, field.TypeTime, value)
+	}
+	if su.mutation.StartedAtCleared() {
+		_spec.ClearField(sprint.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.ClosedAt(); ok {
+		_spec.SetField(sprint.FieldClosedAt, field.TypeTime, value)
+	}
+	if su.mutation.ClosedAtCleared() {
+		_spec.ClearField(sprint.FieldClosedAt, field.TypeTime)
+	}
+	if su.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.BoardTable,
+			Columns: []string{sprint.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.BoardTable,
+			Columns: []string{sprint.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedTasksIDs(); len(nodes) > 0 && !su.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sprint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SprintUpdateOne is the builder for updating a single Sprint entity.
+type SprintUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SprintMutation
+}
+
+// SetBoardID sets the "board_id" field.
+func (suo *SprintUpdateOne) SetBoardID(s string) *SprintUpdateOne {
+	suo.mutation.SetBoardID(s)
+	return suo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableBoardID(s *string) *SprintUpdateOne {
+	if s != nil {
+		suo.SetBoardID(*s)
+	}
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *SprintUpdateOne) SetName(s string) *SprintUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableName(s *string) *SprintUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetGoal sets the "goal" field.
+func (suo *SprintUpdateOne) SetGoal(s string) *SprintUpdateOne {
+	suo.mutation.SetGoal(s)
+	return suo
+}
+
+// SetNillableGoal sets the "goal" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableGoal(s *string) *SprintUpdateOne {
+	if s != nil {
+		suo.SetGoal(*s)
+	}
+	return suo
+}
+
+// ClearGoal clears the value of the "goal" field.
+func (suo *SprintUpdateOne) ClearGoal() *SprintUpdateOne {
+	suo.mutation.ClearGoal()
+	return suo
+}
+
+// SetStartDate sets the "start_date" field.
+func (suo *SprintUpdateOne) SetStartDate(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetStartDate(t)
+	return suo
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableStartDate(t *time.Time) *SprintUpdateOne {
+	if t != nil {
+		suo.SetStartDate(*t)
+	}
+	return suo
+}
+
+// SetEndDate sets the "end_date" field.
+func (suo *SprintUpdateOne) SetEndDate(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetEndDate(t)
+	return suo
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableEndDate(t *time.Time) *SprintUpdateOne {
+	if t != nil {
+		suo.SetEndDate(*t)
+	}
+	return suo
+}
+
+// SetStatus sets the "status" field.
+func (suo *SprintUpdateOne) SetStatus(s sprint.Status) *SprintUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableStatus(s *sprint.Status) *SprintUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (suo *SprintUpdateOne) SetCreatedAt(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableCreatedAt(t *time.Time) *SprintUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (suo *SprintUpdateOne) SetStartedAt(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetStartedAt(t)
+	return suo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableStartedAt(t *time.Time) *SprintUpdateOne {
+	if t != nil {
+		suo.SetStartedAt(*t)
+	}
+	return suo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (suo *SprintUpdateOne) ClearStartedAt() *SprintUpdateOne {
+	suo.mutation.ClearStartedAt()
+	return suo
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (suo *SprintUpdateOne) SetClosedAt(t time.Time) *SprintUpdateOne {
+	suo.mutation.SetClosedAt(t)
+	return suo
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (suo *SprintUpdateOne) SetNillableClosedAt(t *time.Time) *SprintUpdateOne {
+	if t != nil {
+		suo.SetClosedAt(*t)
+	}
+	return suo
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (suo *SprintUpdateOne) ClearClosedAt() *SprintUpdateOne {
+	suo.mutation.ClearClosedAt()
+	return suo
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (suo *SprintUpdateOne) SetBoard(b *Board) *SprintUpdateOne {
+	return suo.SetBoardID(b.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (suo *SprintUpdateOne) AddTaskIDs(ids ...string) *SprintUpdateOne {
+	suo.mutation.AddTaskIDs(ids...)
+	return suo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (suo *SprintUpdateOne) AddTasks(t ...*Task) *SprintUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.AddTaskIDs(ids...)
+}
+
+// Mutation returns the SprintMutation object of the builder.
+func (suo *SprintUpdateOne) Mutation() *SprintMutation {
+	return suo.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (suo *SprintUpdateOne) ClearBoard() *SprintUpdateOne {
+	suo.mutation.ClearBoard()
+	return suo
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (suo *SprintUpdateOne) ClearTasks() *SprintUpdateOne {
+	suo.mutation.ClearTasks()
+	return suo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (suo *SprintUpdateOne) RemoveTaskIDs(ids ...string) *SprintUpdateOne {
+	suo.mutation.RemoveTaskIDs(ids...)
+	return suo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (suo *SprintUpdateOne) RemoveTasks(t ...*Task) *SprintUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.RemoveTaskIDs(ids...)
+}
+
+// Where appends a list predicates to the SprintUpdate builder.
+func (suo *SprintUpdateOne) Where(ps ...predicate.Sprint) *SprintUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SprintUpdateOne) Select(field string, fields ...string) *SprintUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Sprint entity.
+func (suo *SprintUpdateOne) Save(ctx context.Context) (*Sprint, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SprintUpdateOne) SaveX(ctx context.Context) *Sprint {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SprintUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SprintUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SprintUpdateOne) check() error {
+	if v, ok := suo.mutation.Status(); ok {
+		if err := sprint.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Sprint.status": %w`, err)}
+		}
+	}
+	if suo.mutation.BoardCleared() && len(suo.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sprint.board"`)
+	}
+	return nil
+}
+
+func (suo *SprintUpdateOne) sqlSave(ctx context.Context) (_node *Sprint, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sprint.Table, sprint.Columns, sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Sprint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sprint.FieldID)
+		for _, f := range fields {
+			if !sprint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sprint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(sprint.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Goal(); ok {
+		_spec.SetField(sprint.FieldGoal, field.TypeString, value)
+	}
+	if suo.mutation.GoalCleared() {
+		_spec.ClearField(sprint.FieldGoal, field.TypeString)
+	}
+	if value, ok := suo.mutation.StartDate(); ok {
+		_spec.SetField(sprint.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.EndDate(); ok {
+		_spec.SetField(sprint.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.SetField(sprint.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(sprint.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.StartedAt(); ok {
+		_spec.SetField(sprint.FieldStartedAt, field.TypeTime, value)
+	}
+	if suo.mutation.StartedAtCleared() {
+		_spec.ClearField(sprint.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.ClosedAt(); ok {
+		_spec.SetField(sprint.FieldClosedAt, field.TypeTime, value)
+	}
+	if suo.mutation.ClosedAtCleared() {
+		_spec.ClearField(sprint.FieldClosedAt, field.TypeTime)
+	}
+	if suo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.BoardTable,
+			Columns: []string{sprint.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sprint.BoardTable,
+			Columns: []string{sprint.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !suo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sprint.TasksTable,
+			Columns: []string{sprint.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Sprint{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sprint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// StatusChange is the model entity for the StatusChange schema.
+type StatusChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus string `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status,omitempty"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StatusChangeQuery when eager-loading is set.
+	Edges        StatusChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StatusChangeEdges holds the relations/edges for other nodes in the graph.
+type StatusChangeEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StatusChangeEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StatusChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case statuschange.FieldID:
+			values[i] = new(sql.NullInt64)
+		case statuschange.FieldTaskID, statuschange.FieldFromStatus, statuschange.FieldToStatus:
+			values[i] = new(sql.NullString)
+		case statuschange.FieldChangedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StatusChange fields.
+func (sc *StatusChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case statuschange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sc.ID = int(value.Int64)
+		case statuschange.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				sc.TaskID = value.String
+			}
+		case statuschange.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				sc.FromStatus = value.String
+			}
+		case statuschange.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				sc.ToStatus = value.String
+			}
+		case statuschange.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				sc.ChangedAt = value.Time
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StatusChange.
+// This includes values selected through modifiers, order, etc.
+func (sc *StatusChange) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the StatusChange entity.
+func (sc *StatusChange) QueryTask() *TaskQuery {
+	return NewStatusChangeClient(sc.config).QueryTask(sc)
+}
+
+// Update returns a builder for updating this StatusChange.
+// Note that you need to call StatusChange.Unwrap() before calling this method if this StatusChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *StatusChange) Update() *StatusChangeUpdateOne {
+	return NewStatusChangeClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the StatusChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *StatusChange) Unwrap() *StatusChange {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StatusChange is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *StatusChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("StatusChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(sc.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(sc.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(sc.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(sc.ChangedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StatusChanges is a parsable slice of StatusChange.
+type StatusChanges []*StatusChange
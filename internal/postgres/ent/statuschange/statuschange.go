@@ -0,0 +1,95 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statuschange
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the statuschange type in the database.
+	Label = "status_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldChangedAt holds the string denoting the changed_at field in the database.
+	FieldChangedAt = "changed_at"
+	// EdgeTask holds the string denoting the task edge name in mutations.
+	EdgeTask = "task"
+	// Table holds the table name of the statuschange in the database.
+	Table = "status_changes"
+	// TaskTable is the table that holds the task relation/edge.
+	TaskTable = "status_changes"
+	// TaskInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TaskInverseTable = "tasks"
+	// TaskColumn is the table column denoting the task relation/edge.
+	TaskColumn = "task_id"
+)
+
+// Columns holds all SQL columns for statuschange fields.
+var Columns = []string{
+	FieldID,
+	FieldTaskID,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldChangedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the StatusChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByChangedAt orders the results by the changed_at field.
+func ByChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedAt, opts...).ToFunc()
+}
+
+// ByTaskField orders the results by task field.
+func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTaskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+	)
+}
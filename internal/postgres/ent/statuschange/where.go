@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statuschange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLTE(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldTaskID, v))
+}
+
+// FromStatus applies equality check predicate on the "from_status" field. It's identical to FromStatusEQ.
+func FromStatus(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// ToStatus applies equality check predicate on the "to_status" field. It's identical to ToStatusEQ.
+func ToStatus(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDContains applies the Contains predicate on the "task_id" field.
+func TaskIDContains(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldContains(FieldTaskID, v))
+}
+
+// TaskIDHasPrefix applies the HasPrefix predicate on the "task_id" field.
+func TaskIDHasPrefix(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldHasPrefix(FieldTaskID, v))
+}
+
+// TaskIDHasSuffix applies the HasSuffix predicate on the "task_id" field.
+func TaskIDHasSuffix(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldHasSuffix(FieldTaskID, v))
+}
+
+// TaskIDEqualFold applies the EqualFold predicate on the "task_id" field.
+func TaskIDEqualFold(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEqualFold(FieldTaskID, v))
+}
+
+// TaskIDContainsFold applies the ContainsFold predicate on the "task_id" field.
+func TaskIDContainsFold(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldContainsFold(FieldTaskID, v))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusGT applies the GT predicate on the "from_status" field.
+func FromStatusGT(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGT(FieldFromStatus, v))
+}
+
+// FromStatusGTE applies the GTE predicate on the "from_status" field.
+func FromStatusGTE(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGTE(FieldFromStatus, v))
+}
+
+// FromStatusLT applies the LT predicate on the "from_status" field.
+func FromStatusLT(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLT(FieldFromStatus, v))
+}
+
+// FromStatusLTE applies the LTE predicate on the "from_status" field.
+func FromStatusLTE(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLTE(FieldFromStatus, v))
+}
+
+// FromStatusContains applies the Contains predicate on the "from_status" field.
+func FromStatusContains(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldContains(FieldFromStatus, v))
+}
+
+// FromStatusHasPrefix applies the HasPrefix predicate on the "from_status" field.
+func FromStatusHasPrefix(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldHasPrefix(FieldFromStatus, v))
+}
+
+// FromStatusHasSuffix applies the HasSuffix predicate on the "from_status" field.
+func FromStatusHasSuffix(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldHasSuffix(FieldFromStatus, v))
+}
+
+// FromStatusEqualFold applies the EqualFold predicate on the "from_status" field.
+func FromStatusEqualFold(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEqualFold(FieldFromStatus, v))
+}
+
+// FromStatusContainsFold applies the ContainsFold predicate on the "from_status" field.
+func FromStatusContainsFold(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldContainsFold(FieldFromStatus, v))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// ToStatusGT applies the GT predicate on the "to_status" field.
+func ToStatusGT(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGT(FieldToStatus, v))
+}
+
+// ToStatusGTE applies the GTE predicate on the "to_status" field.
+func ToStatusGTE(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGTE(FieldToStatus, v))
+}
+
+// ToStatusLT applies the LT predicate on the "to_status" field.
+func ToStatusLT(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLT(FieldToStatus, v))
+}
+
+// ToStatusLTE applies the LTE predicate on the "to_status" field.
+func ToStatusLTE(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLTE(FieldToStatus, v))
+}
+
+// ToStatusContains applies the Contains predicate on the "to_status" field.
+func ToStatusContains(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldContains(FieldToStatus, v))
+}
+
+// ToStatusHasPrefix applies the HasPrefix predicate on the "to_status" field.
+func ToStatusHasPrefix(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldHasPrefix(FieldToStatus, v))
+}
+
+// ToStatusHasSuffix applies the HasSuffix predicate on the "to_status" field.
+func ToStatusHasSuffix(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldHasSuffix(FieldToStatus, v))
+}
+
+// ToStatusEqualFold applies the EqualFold predicate on the "to_status" field.
+func ToStatusEqualFold(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEqualFold(FieldToStatus, v))
+}
+
+// ToStatusContainsFold applies the ContainsFold predicate on the "to_status" field.
+func ToStatusContainsFold(v string) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldContainsFold(FieldToStatus, v))
+}
+
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNEQ(FieldChangedAt, v))
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldNotIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGT(FieldChangedAt, v))
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldGTE(FieldChangedAt, v))
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLT(FieldChangedAt, v))
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.StatusChange {
+	return predicate.StatusChange(sql.FieldLTE(FieldChangedAt, v))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.StatusChange {
+	return predicate.StatusChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.StatusChange {
+	return predicate.StatusChange(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StatusChange) predicate.StatusChange {
+	return predicate.StatusChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StatusChange) predicate.StatusChange {
+	return predicate.StatusChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StatusChange) predicate.StatusChange {
+	return predicate.StatusChange(sql.NotPredicates(p))
+}
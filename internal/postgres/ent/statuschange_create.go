@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// StatusChangeCreate is the builder for creating a StatusChange entity.
+type StatusChangeCreate struct {
+	config
+	mutation *StatusChangeMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (scc *StatusChangeCreate) SetTaskID(s string) *StatusChangeCreate {
+	scc.mutation.SetTaskID(s)
+	return scc
+}
+
+// SetFromStatus sets the "from_status" field.
+func (scc *StatusChangeCreate) SetFromStatus(s string) *StatusChangeCreate {
+	scc.mutation.SetFromStatus(s)
+	return scc
+}
+
+// SetToStatus sets the "to_status" field.
+func (scc *StatusChangeCreate) SetToStatus(s string) *StatusChangeCreate {
+	scc.mutation.SetToStatus(s)
+	return scc
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (scc *StatusChangeCreate) SetChangedAt(t time.Time) *StatusChangeCreate {
+	scc.mutation.SetChangedAt(t)
+	return scc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (scc *StatusChangeCreate) SetTask(t *Task) *StatusChangeCreate {
+	return scc.SetTaskID(t.ID)
+}
+
+// Mutation returns the StatusChangeMutation object of the builder.
+func (scc *StatusChangeCreate) Mutation() *StatusChangeMutation {
+	return scc.mutation
+}
+
+// Save creates the StatusChange in the database.
+func (scc *StatusChangeCreate) Save(ctx context.Context) (*StatusChange, error) {
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *StatusChangeCreate) SaveX(ctx context.Context) *StatusChange {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *StatusChangeCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *StatusChangeCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *StatusChangeCreate) check() error {
+	if _, ok := scc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "StatusChange.task_id"`)}
+	}
+	if _, ok := scc.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "StatusChange.from_status"`)}
+	}
+	if _, ok := scc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "StatusChange.to_status"`)}
+	}
+	if _, ok := scc.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "StatusChange.changed_at"`)}
+	}
+	if len(scc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "StatusChange.task"`)}
+	}
+	return nil
+}
+
+func (scc *StatusChangeCreate) sqlSave(ctx context.Context) (*StatusChange, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *StatusChangeCreate) createSpec() (*StatusChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StatusChange{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(statuschange.Table, sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt))
+	)
+	if value, ok := scc.mutation.FromStatus(); ok {
+		_spec.SetField(statuschange.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := scc.mutation.ToStatus(); ok {
+		_spec.SetField(statuschange.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := scc.mutation.ChangedAt(); ok {
+		_spec.SetField(statuschange.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if nodes := scc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statuschange.TaskTable,
+			Columns: []string{statuschange.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StatusChangeCreateBulk is the builder for creating many StatusChange entities in bulk.
+type StatusChangeCreateBulk struct {
+	config
+	err      error
+	builders []*StatusChangeCreate
+}
+
+// Save creates the StatusChange entities in the database.
+func (sccb *StatusChangeCreateBulk) Save(ctx context.Context) ([]*StatusChange, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*StatusChange, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StatusChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *StatusChangeCreateBulk) SaveX(ctx context.Context) []*StatusChange {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *StatusChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *StatusChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
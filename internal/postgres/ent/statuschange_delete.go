@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
+)
+
+// StatusChangeDelete is the builder for deleting a StatusChange entity.
+type StatusChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *StatusChangeMutation
+}
+
+// Where appends a list predicates to the StatusChangeDelete builder.
+func (scd *StatusChangeDelete) Where(ps ...predicate.StatusChange) *StatusChangeDelete {
+	scd.mutation.Where(ps...)
+	return scd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (scd *StatusChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, scd.sqlExec, scd.mutation, scd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scd *StatusChangeDelete) ExecX(ctx context.Context) int {
+	n, err := scd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (scd *StatusChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(statuschange.Table, sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt))
+	if ps := scd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, scd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	scd.mutation.done = true
+	return affected, err
+}
+
+// StatusChangeDeleteOne is the builder for deleting a single StatusChange entity.
+type StatusChangeDeleteOne struct {
+	scd *StatusChangeDelete
+}
+
+// Where appends a list predicates to the StatusChangeDelete builder.
+func (scdo *StatusChangeDeleteOne) Where(ps ...predicate.StatusChange) *StatusChangeDeleteOne {
+	scdo.scd.mutation.Where(ps...)
+	return scdo
+}
+
+// Exec executes the deletion query.
+func (scdo *StatusChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := scdo.scd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{statuschange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scdo *StatusChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := scdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// StatusChangeQuery is the builder for querying StatusChange entities.
+type StatusChangeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []statuschange.OrderOption
+	inters     []Interceptor
+	predicates []predicate.StatusChange
+	withTask   *TaskQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StatusChangeQuery builder.
+func (scq *StatusChangeQuery) Where(ps ...predicate.StatusChange) *StatusChangeQuery {
+	scq.predicates = append(scq.predicates, ps...)
+	return scq
+}
+
+// Limit the number of records to be returned by this query.
+func (scq *StatusChangeQuery) Limit(limit int) *StatusChangeQuery {
+	scq.ctx.Limit = &limit
+	return scq
+}
+
+// Offset to start from.
+func (scq *StatusChangeQuery) Offset(offset int) *StatusChangeQuery {
+	scq.ctx.Offset = &offset
+	return scq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (scq *StatusChangeQuery) Unique(unique bool) *StatusChangeQuery {
+	scq.ctx.Unique = &unique
+	return scq
+}
+
+// Order specifies how the records should be ordered.
+func (scq *StatusChangeQuery) Order(o ...statuschange.OrderOption) *StatusChangeQuery {
+	scq.order = append(scq.order, o...)
+	return scq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (scq *StatusChangeQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(statuschange.Table, statuschange.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, statuschange.TaskTable, statuschange.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StatusChange entity from the query.
+// Returns a *NotFoundError when no StatusChange was found.
+func (scq *StatusChangeQuery) First(ctx context.Context) (*StatusChange, error) {
+	nodes, err := scq.Limit(1).All(setContextOp(ctx, scq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{statuschange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (scq *StatusChangeQuery) FirstX(ctx context.Context) *StatusChange {
+	node, err := scq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StatusChange ID from the query.
+// Returns a *NotFoundError when no StatusChange ID was found.
+func (scq *StatusChangeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = scq.Limit(1).IDs(setContextOp(ctx, scq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{statuschange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (scq *StatusChangeQuery) FirstIDX(ctx context.Context) int {
+	id, err := scq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StatusChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StatusChange entity is found.
+// Returns a *NotFoundError when no StatusChange entities are found.
+func (scq *StatusChangeQuery) Only(ctx context.Context) (*StatusChange, error) {
+	nodes, err := scq.Limit(2).All(setContextOp(ctx, scq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{statuschange.Label}
+	default:
+		return nil, &NotSingularError{statuschange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (scq *StatusChangeQuery) OnlyX(ctx context.Context) *StatusChange {
+	node, err := scq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StatusChange ID in the query.
+// Returns a *NotSingularError when more than one StatusChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (scq *StatusChangeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = scq.Limit(2).IDs(setContextOp(ctx, scq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{statuschange.Label}
+	default:
+		err = &NotSingularError{statuschange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (scq *StatusChangeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := scq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StatusChanges.
+func (scq *StatusChangeQuery) All(ctx context.Context) ([]*StatusChange, error) {
+	ctx = setContextOp(ctx, scq.ctx, ent.OpQueryAll)
+	if err := scq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StatusChange, *StatusChangeQuery]()
+	return withInterceptors[[]*StatusChange](ctx, scq, qr, scq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (scq *StatusChangeQuery) AllX(ctx context.Context) []*StatusChange {
+	nodes, err := scq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StatusChange IDs.
+func (scq *StatusChangeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if scq.ctx.Unique == nil && scq.path != nil {
+		scq.Unique(true)
+	}
+	ctx = setContextOp(ctx, scq.ctx, ent.OpQueryIDs)
+	if err = scq.Select(statuschange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (scq *StatusChangeQuery) IDsX(ctx context.Context) []int {
+	ids, err := scq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (scq *StatusChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, scq.ctx, ent.OpQueryCount)
+	if err := scq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, scq, querierCount[*StatusChangeQuery](), scq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (scq *StatusChangeQuery) CountX(ctx context.Context) int {
+	count, err := scq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (scq *StatusChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, scq.ctx, ent.OpQueryExist)
+	switch _, err := scq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (scq *StatusChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := scq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StatusChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (scq *StatusChangeQuery) Clone() *StatusChangeQuery {
+	if scq == nil {
+		return nil
+	}
+	return &StatusChangeQuery{
+		config:     scq.config,
+		ctx:        scq.ctx.Clone(),
+		order:      append([]statuschange.OrderOption{}, scq.order...),
+		inters:     append([]Interceptor{}, scq.inters...),
+		predicates: append([]predicate.StatusChange{}, scq.predicates...),
+		withTask:   scq.withTask.Clone(),
+		// clone intermediate query.
+		sql:  scq.sql.Clone(),
+		path: scq.path,
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *StatusChangeQuery) WithTask(opts ...func(*TaskQuery)) *StatusChangeQuery {
+	query := (&TaskClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withTask = query
+	return scq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StatusChange.Query().
+//		GroupBy(statuschange.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (scq *StatusChangeQuery) GroupBy(field string, fields ...string) *StatusChangeGroupBy {
+	scq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StatusChangeGroupBy{build: scq}
+	grbuild.flds = &scq.ctx.Fields
+	grbuild.label = statuschange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//	}
+//
+//	client.StatusChange.Query().
+//		Select(statuschange.FieldTaskID).
+//		Scan(ctx, &v)
+func (scq *StatusChangeQuery) Select(fields ...string) *StatusChangeSelect {
+	scq.ctx.Fields = append(scq.ctx.Fields, fields...)
+	sbuild := &StatusChangeSelect{StatusChangeQuery: scq}
+	sbuild.label = statuschange.Label
+	sbuild.flds, sbuild.scan = &scq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StatusChangeSelect configured with the given aggregations.
+func (scq *StatusChangeQuery) Aggregate(fns ...AggregateFunc) *StatusChangeSelect {
+	return scq.Select().Aggregate(fns...)
+}
+
+func (scq *StatusChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range scq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, scq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range scq.ctx.Fields {
+		if !statuschange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if scq.path != nil {
+		prev, err := scq.path(ctx)
+		if err != nil {
+			return err
+		}
+		scq.sql = prev
+	}
+	return nil
+}
+
+func (scq *StatusChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StatusChange, error) {
+	var (
+		nodes       = []*StatusChange{}
+		_spec       = scq.querySpec()
+		loadedTypes = [1]bool{
+			scq.withTask != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StatusChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StatusChange{config: scq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, scq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := scq.withTask; query != nil {
+		if err := scq.loadTask(ctx, query, nodes, nil,
+			func(n *StatusChange, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (scq *StatusChangeQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*StatusChange, init func(*StatusChange), assign func(*StatusChange, *Task)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*StatusChange)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (scq *StatusChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := scq.querySpec()
+	_spec.Node.Columns = scq.ctx.Fields
+	if len(scq.ctx.Fields) > 0 {
+		_spec.Unique = scq.ctx.Unique != nil && *scq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, scq.driver, _spec)
+}
+
+func (scq *StatusChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(statuschange.Table, statuschange.Columns, sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt))
+	_spec.From = scq.sql
+	if unique := scq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if scq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := scq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statuschange.FieldID)
+		for i := range fields {
+			if fields[i] != statuschange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if scq.withTask != nil {
+			_spec.Node.AddColumnOnce(statuschange.FieldTaskID)
+		}
+	}
+	if ps := scq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := scq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (scq *StatusChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(scq.driver.Dialect())
+	t1 := builder.Table(statuschange.Table)
+	columns := scq.ctx.Fields
+	if len(columns) == 0 {
+		columns = statuschange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if scq.sql != nil {
+		selector = scq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if scq.ctx.Unique != nil && *scq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range scq.predicates {
+		p(selector)
+	}
+	for _, p := range scq.order {
+		p(selector)
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StatusChangeGroupBy is the group-by builder for StatusChange entities.
+type StatusChangeGroupBy struct {
+	selector
+	build *StatusChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (scgb *StatusChangeGroupBy) Aggregate(fns ...AggregateFunc) *StatusChangeGroupBy {
+	scgb.fns = append(scgb.fns, fns...)
+	return scgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scgb *StatusChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scgb.build.ctx, ent.OpQueryGroupBy)
+	if err := scgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StatusChangeQuery, *StatusChangeGroupBy](ctx, scgb.build, scgb, scgb.build.inters, v)
+}
+
+func (scgb *StatusChangeGroupBy) sqlScan(ctx context.Context, root *StatusChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(scgb.fns))
+	for _, fn := range scgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*scgb.flds)+len(scgb.fns))
+		for _, f := range *scgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*scgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StatusChangeSelect is the builder for selecting fields of StatusChange entities.
+type StatusChangeSelect struct {
+	*StatusChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (scs *StatusChangeSelect) Aggregate(fns ...AggregateFunc) *StatusChangeSelect {
+	scs.fns = append(scs.fns, fns...)
+	return scs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scs *StatusChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scs.ctx, ent.OpQuerySelect)
+	if err := scs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StatusChangeQuery, *StatusChangeSelect](ctx, scs.StatusChangeQuery, scs, scs.inters, v)
+}
+
+func (scs *StatusChangeSelect) sqlScan(ctx context.Context, root *StatusChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(scs.fns))
+	for _, fn := range scs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*scs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+)
+
+// StatusChangeUpdate is the builder for updating StatusChange entities.
+type StatusChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StatusChangeMutation
+}
+
+// Where appends a list predicates to the StatusChangeUpdate builder.
+func (scu *StatusChangeUpdate) Where(ps ...predicate.StatusChange) *StatusChangeUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetTaskID sets the "task_id" field.
+func (scu *StatusChangeUpdate) SetTaskID(s string) *StatusChangeUpdate {
+	scu.mutation.SetTaskID(s)
+	return scu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (scu *StatusChangeUpdate) SetNillableTaskID(s *string) *StatusChangeUpdate {
+	if s != nil {
+		scu.SetTaskID(*s)
+	}
+	return scu
+}
+
+// SetFromStatus sets the "from_status" field.
+func (scu *StatusChangeUpdate) SetFromStatus(s string) *StatusChangeUpdate {
+	scu.mutation.SetFromStatus(s)
+	return scu
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (scu *StatusChangeUpdate) SetNillableFromStatus(s *string) *StatusChangeUpdate {
+	if s != nil {
+		scu.SetFromStatus(*s)
+	}
+	return scu
+}
+
+// SetToStatus sets the "to_status" field.
+func (scu *StatusChangeUpdate) SetToStatus(s string) *StatusChangeUpdate {
+	scu.mutation.SetToStatus(s)
+	return scu
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (scu *StatusChangeUpdate) SetNillableToStatus(s *string) *StatusChangeUpdate {
+	if s != nil {
+		scu.SetToStatus(*s)
+	}
+	return scu
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (scu *StatusChangeUpdate) SetChangedAt(t time.Time) *StatusChangeUpdate {
+	scu.mutation.SetChangedAt(t)
+	return scu
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (scu *StatusChangeUpdate) SetNillableChangedAt(t *time.Time) *StatusChangeUpdate {
+	if t != nil {
+		scu.SetChangedAt(*t)
+	}
+	return scu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (scu *StatusChangeUpdate) SetTask(t *Task) *StatusChangeUpdate {
+	return scu.SetTaskID(t.ID)
+}
+
+// Mutation returns the StatusChangeMutation object of the builder.
+func (scu *StatusChangeUpdate) Mutation() *StatusChangeMutation {
+	return scu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (scu *StatusChangeUpdate) ClearTask() *StatusChangeUpdate {
+	scu.mutation.ClearTask()
+	return scu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *StatusChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *StatusChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *StatusChangeUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *StatusChangeUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *StatusChangeUpdate) check() error {
+	if scu.mutation.TaskCleared() && len(scu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatusChange.task"`)
+	}
+	return nil
+}
+
+func (scu *StatusChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statuschange.Table, statuschange.Columns, sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.FromStatus(); ok {
+		_spec.SetField(statuschange.FieldFromStatus, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.ToStatus(); ok {
+		_spec.SetField(statuschange.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.ChangedAt(); ok {
+		_spec.SetField(statuschange.FieldChangedAt, field.TypeTime, value)
+	}
+	if scu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statuschange.TaskTable,
+			Columns: []string{statuschange.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statuschange.TaskTable,
+			Columns: []string{statuschange.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// StatusChangeUpdateOne is the builder for updating a single StatusChange entity.
+type StatusChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StatusChangeMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (scuo *StatusChangeUpdateOne) SetTaskID(s string) *StatusChangeUpdateOne {
+	scuo.mutation.SetTaskID(s)
+	return scuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (scuo *StatusChangeUpdateOne) SetNillableTaskID(s *string) *StatusChangeUpdateOne {
+	if s != nil {
+		scuo.SetTaskID(*s)
+	}
+	return scuo
+}
+
+// SetFromStatus sets the "from_status" field.
+func (scuo *StatusChangeUpdateOne) SetFromStatus(s string) *StatusChangeUpdateOne {
+	scuo.mutation.SetFromStatus(s)
+	return scuo
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (scuo *StatusChangeUpdateOne) SetNillableFromStatus(s *string) *StatusChangeUpdateOne {
+	if s != nil {
+		scuo.SetFromStatus(*s)
+	}
+	return scuo
+}
+
+// SetToStatus sets the "to_status" field.
+func (scuo *StatusChangeUpdateOne) SetToStatus(s string) *StatusChangeUpdateOne {
+	scuo.mutation.SetToStatus(s)
+	return scuo
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (scuo *StatusChangeUpdateOne) SetNillableToStatus(s *string) *StatusChangeUpdateOne {
+	if s != nil {
+		scuo.SetToStatus(*s)
+	}
+	return scuo
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (scuo *StatusChangeUpdateOne) SetChangedAt(t time.Time) *StatusChangeUpdateOne {
+	scuo.mutation.SetChangedAt(t)
+	return scuo
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (scuo *StatusChangeUpdateOne) SetNillableChangedAt(t *time.Time) *StatusChangeUpdateOne {
+	if t != nil {
+		scuo.SetChangedAt(*t)
+	}
+	return scuo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (scuo *StatusChangeUpdateOne) SetTask(t *Task) *StatusChangeUpdateOne {
+	return scuo.SetTaskID(t.ID)
+}
+
+// Mutation returns the StatusChangeMutation object of the builder.
+func (scuo *StatusChangeUpdateOne) Mutation() *StatusChangeMutation {
+	return scuo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (scuo *StatusChangeUpdateOne) ClearTask() *StatusChangeUpdateOne {
+	scuo.mutation.ClearTask()
+	return scuo
+}
+
+// Where appends a list predicates to the StatusChangeUpdate builder.
+func (scuo *StatusChangeUpdateOne) Where(ps ...predicate.StatusChange) *StatusChangeUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *StatusChangeUpdateOne) Select(field string, fields ...string) *StatusChangeUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated StatusChange entity.
+func (scuo *StatusChangeUpdateOne) Save(ctx context.Context) (*StatusChange, error) {
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *StatusChangeUpdateOne) SaveX(ctx context.Context) *StatusChange {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *StatusChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *StatusChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *StatusChangeUpdateOne) check() error {
+	if scuo.mutation.TaskCleared() && len(scuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StatusChange.task"`)
+	}
+	return nil
+}
+
+func (scuo *StatusChangeUpdateOne) sqlSave(ctx context.Context) (_node *StatusChange, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statuschange.Table, statuschange.Columns, sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StatusChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statuschange.FieldID)
+		for _, f := range fields {
+			if !statuschange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != statuschange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.FromStatus(); ok {
+		_spec.SetField(statuschange.FieldFromStatus, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.ToStatus(); ok {
+		_spec.SetField(statuschange.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.ChangedAt(); ok {
+		_spec.SetField(statuschange.FieldChangedAt, field.TypeTime, value)
+	}
+	if scuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statuschange.TaskTable,
+			Columns: []string{statuschange.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   statuschange.TaskTable,
+			Columns: []string{statuschange.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StatusChange{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,9 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
 )
 
@@ -17,12 +20,22 @@ type Task struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID *string `json:"board_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *string `json:"parent_id,omitempty"`
+	// SprintID holds the value of the "sprint_id" field.
+	SprintID *string `json:"sprint_id,omitempty"`
+	// EpicID holds the value of the "epic_id" field.
+	EpicID *string `json:"epic_id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
 	Description *string `json:"description,omitempty"`
 	// DueDate holds the value of the "due_date" field.
 	DueDate *time.Time `json:"due_date,omitempty"`
+	// AssigneeID holds the value of the "assignee_id" field.
+	AssigneeID *string `json:"assignee_id,omitempty"`
 	// AssigneeName holds the value of the "assignee_name" field.
 	AssigneeName *string `json:"assignee_name,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -31,8 +44,24 @@ type Task struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 	// Status holds the value of the "status" field.
-	Status task.Status `json:"status,omitempty"`
+	Status string `json:"status,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank string `json:"rank,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority *task.Priority `json:"priority,omitempty"`
+	// StoryPoints holds the value of the "story_points" field.
+	StoryPoints *int `json:"story_points,omitempty"`
+	// WipOverrideBy holds the value of the "wip_override_by" field.
+	WipOverrideBy *string `json:"wip_override_by,omitempty"`
+	// WipOverrideStatus holds the value of the "wip_override_status" field.
+	WipOverrideStatus *string `json:"wip_override_status,omitempty"`
+	// WipOverrideAt holds the value of the "wip_override_at" field.
+	WipOverrideAt *time.Time `json:"wip_override_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges        TaskEdges `json:"edges"`
@@ -43,9 +72,43 @@ type Task struct {
 type TaskEdges struct {
 	// Files holds the value of the files edge.
 	Files []*File `json:"files,omitempty"`
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Task `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Task `json:"children,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*Task `json:"blocked_by,omitempty"`
+	// Blocks holds the value of the blocks edge.
+	Blocks []*Task `json:"blocks,omitempty"`
+	// Labels holds the value of the labels edge.
+	Labels []*Label `json:"labels,omitempty"`
+	// Sprint holds the value of the sprint edge.
+	Sprint *Sprint `json:"sprint,omitempty"`
+	// Epic holds the value of the epic edge.
+	Epic *Epic `json:"epic,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Activities holds the value of the activities edge.
+	Activities []*Activity `json:"activities,omitempty"`
+	// Assignees holds the value of the assignees edge.
+	Assignees []*Member `json:"assignees,omitempty"`
+	// Watchers holds the value of the watchers edge.
+	Watchers []*Member `json:"watchers,omitempty"`
+	// ChecklistItems holds the value of the checklist_items edge.
+	ChecklistItems []*ChecklistItem `json:"checklist_items,omitempty"`
+	// CustomFieldValues holds the value of the custom_field_values edge.
+	CustomFieldValues []*CustomFieldValue `json:"custom_field_values,omitempty"`
+	// Worklogs holds the value of the worklogs edge.
+	Worklogs []*Worklog `json:"worklogs,omitempty"`
+	// Timers holds the value of the timers edge.
+	Timers []*Timer `json:"timers,omitempty"`
+	// StatusChanges holds the value of the status_changes edge.
+	StatusChanges []*StatusChange `json:"status_changes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [18]bool
 }
 
 // FilesOrErr returns the Files value or an error if the edge
@@ -57,14 +120,177 @@ func (e TaskEdges) FilesOrErr() ([]*File, error) {
 	return nil, &NotLoadedError{edge: "files"}
 }
 
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) ParentOrErr() (*Task, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ChildrenOrErr() ([]*Task, error) {
+	if e.loadedTypes[3] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) BlockedByOrErr() ([]*Task, error) {
+	if e.loadedTypes[4] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// BlocksOrErr returns the Blocks value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) BlocksOrErr() ([]*Task, error) {
+	if e.loadedTypes[5] {
+		return e.Blocks, nil
+	}
+	return nil, &NotLoadedError{edge: "blocks"}
+}
+
+// LabelsOrErr returns the Labels value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) LabelsOrErr() ([]*Label, error) {
+	if e.loadedTypes[6] {
+		return e.Labels, nil
+	}
+	return nil, &NotLoadedError{edge: "labels"}
+}
+
+// SprintOrErr returns the Sprint value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) SprintOrErr() (*Sprint, error) {
+	if e.Sprint != nil {
+		return e.Sprint, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: sprint.Label}
+	}
+	return nil, &NotLoadedError{edge: "sprint"}
+}
+
+// EpicOrErr returns the Epic value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskEdges) EpicOrErr() (*Epic, error) {
+	if e.Epic != nil {
+		return e.Epic, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: epic.Label}
+	}
+	return nil, &NotLoadedError{edge: "epic"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[9] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// ActivitiesOrErr returns the Activities value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ActivitiesOrErr() ([]*Activity, error) {
+	if e.loadedTypes[10] {
+		return e.Activities, nil
+	}
+	return nil, &NotLoadedError{edge: "activities"}
+}
+
+// AssigneesOrErr returns the Assignees value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) AssigneesOrErr() ([]*Member, error) {
+	if e.loadedTypes[11] {
+		return e.Assignees, nil
+	}
+	return nil, &NotLoadedError{edge: "assignees"}
+}
+
+// WatchersOrErr returns the Watchers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) WatchersOrErr() ([]*Member, error) {
+	if e.loadedTypes[12] {
+		return e.Watchers, nil
+	}
+	return nil, &NotLoadedError{edge: "watchers"}
+}
+
+// ChecklistItemsOrErr returns the ChecklistItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ChecklistItemsOrErr() ([]*ChecklistItem, error) {
+	if e.loadedTypes[13] {
+		return e.ChecklistItems, nil
+	}
+	return nil, &NotLoadedError{edge: "checklist_items"}
+}
+
+// CustomFieldValuesOrErr returns the CustomFieldValues value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) CustomFieldValuesOrErr() ([]*CustomFieldValue, error) {
+	if e.loadedTypes[14] {
+		return e.CustomFieldValues, nil
+	}
+	return nil, &NotLoadedError{edge: "custom_field_values"}
+}
+
+// WorklogsOrErr returns the Worklogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) WorklogsOrErr() ([]*Worklog, error) {
+	if e.loadedTypes[15] {
+		return e.Worklogs, nil
+	}
+	return nil, &NotLoadedError{edge: "worklogs"}
+}
+
+// TimersOrErr returns the Timers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) TimersOrErr() ([]*Timer, error) {
+	if e.loadedTypes[16] {
+		return e.Timers, nil
+	}
+	return nil, &NotLoadedError{edge: "timers"}
+}
+
+// StatusChangesOrErr returns the StatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) StatusChangesOrErr() ([]*StatusChange, error) {
+	if e.loadedTypes[17] {
+		return e.StatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "status_changes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldID, task.FieldTitle, task.FieldDescription, task.FieldAssigneeName, task.FieldStatus:
+		case task.FieldStoryPoints, task.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case task.FieldID, task.FieldBoardID, task.FieldParentID, task.FieldSprintID, task.FieldEpicID, task.FieldTitle, task.FieldDescription, task.FieldAssigneeID, task.FieldAssigneeName, task.FieldStatus, task.FieldRank, task.FieldPriority, task.FieldWipOverrideBy, task.FieldWipOverrideStatus:
 			values[i] = new(sql.NullString)
-		case task.FieldDueDate, task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldCompletedAt:
+		case task.FieldDueDate, task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldCompletedAt, task.FieldArchivedAt, task.FieldWipOverrideAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -87,6 +313,34 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.ID = value.String
 			}
+		case task.FieldBoardID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				t.BoardID = new(string)
+				*t.BoardID = value.String
+			}
+		case task.FieldParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				t.ParentID = new(string)
+				*t.ParentID = value.String
+			}
+		case task.FieldSprintID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sprint_id", values[i])
+			} else if value.Valid {
+				t.SprintID = new(string)
+				*t.SprintID = value.String
+			}
+		case task.FieldEpicID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field epic_id", values[i])
+			} else if value.Valid {
+				t.EpicID = new(string)
+				*t.EpicID = value.String
+			}
 		case task.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -107,6 +361,13 @@ func (t *Task) assignValues(columns []string, values []any) error {
 				t.DueDate = new(time.Time)
 				*t.DueDate = value.Time
 			}
+		case task.FieldAssigneeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+			} else if value.Valid {
+				t.AssigneeID = new(string)
+				*t.AssigneeID = value.String
+			}
 		case task.FieldAssigneeName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field assignee_name", values[i])
@@ -133,11 +394,65 @@ func (t *Task) assignValues(columns []string, values []any) error {
 				t.CompletedAt = new(time.Time)
 				*t.CompletedAt = value.Time
 			}
+		case task.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				t.ArchivedAt = new(time.Time)
+				*t.ArchivedAt = value.Time
+			}
 		case task.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
-				t.Status = task.Status(value.String)
+				t.Status = value.String
+			}
+		case task.FieldRank:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				t.Rank = value.String
+			}
+		case task.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				t.Priority = new(task.Priority)
+				*t.Priority = task.Priority(value.String)
+			}
+		case task.FieldStoryPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field story_points", values[i])
+			} else if value.Valid {
+				t.StoryPoints = new(int)
+				*t.StoryPoints = int(value.Int64)
+			}
+		case task.FieldWipOverrideBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field wip_override_by", values[i])
+			} else if value.Valid {
+				t.WipOverrideBy = new(string)
+				*t.WipOverrideBy = value.String
+			}
+		case task.FieldWipOverrideStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field wip_override_status", values[i])
+			} else if value.Valid {
+				t.WipOverrideStatus = new(string)
+				*t.WipOverrideStatus = value.String
+			}
+		case task.FieldWipOverrideAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field wip_override_at", values[i])
+			} else if value.Valid {
+				t.WipOverrideAt = new(time.Time)
+				*t.WipOverrideAt = value.Time
+			}
+		case task.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				t.Version = int(value.Int64)
 			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
@@ -157,6 +472,91 @@ func (t *Task) QueryFiles() *FileQuery {
 	return NewTaskClient(t.config).QueryFiles(t)
 }
 
+// QueryBoard queries the "board" edge of the Task entity.
+func (t *Task) QueryBoard() *BoardQuery {
+	return NewTaskClient(t.config).QueryBoard(t)
+}
+
+// QueryParent queries the "parent" edge of the Task entity.
+func (t *Task) QueryParent() *TaskQuery {
+	return NewTaskClient(t.config).QueryParent(t)
+}
+
+// QueryChildren queries the "children" edge of the Task entity.
+func (t *Task) QueryChildren() *TaskQuery {
+	return NewTaskClient(t.config).QueryChildren(t)
+}
+
+// QueryBlockedBy queries the "blocked_by" edge of the Task entity.
+func (t *Task) QueryBlockedBy() *TaskQuery {
+	return NewTaskClient(t.config).QueryBlockedBy(t)
+}
+
+// QueryBlocks queries the "blocks" edge of the Task entity.
+func (t *Task) QueryBlocks() *TaskQuery {
+	return NewTaskClient(t.config).QueryBlocks(t)
+}
+
+// QueryLabels queries the "labels" edge of the Task entity.
+func (t *Task) QueryLabels() *LabelQuery {
+	return NewTaskClient(t.config).QueryLabels(t)
+}
+
+// QuerySprint queries the "sprint" edge of the Task entity.
+func (t *Task) QuerySprint() *SprintQuery {
+	return NewTaskClient(t.config).QuerySprint(t)
+}
+
+// QueryEpic queries the "epic" edge of the Task entity.
+func (t *Task) QueryEpic() *EpicQuery {
+	return NewTaskClient(t.config).QueryEpic(t)
+}
+
+// QueryComments queries the "comments" edge of the Task entity.
+func (t *Task) QueryComments() *CommentQuery {
+	return NewTaskClient(t.config).QueryComments(t)
+}
+
+// QueryActivities queries the "activities" edge of the Task entity.
+func (t *Task) QueryActivities() *ActivityQuery {
+	return NewTaskClient(t.config).QueryActivities(t)
+}
+
+// QueryAssignees queries the "assignees" edge of the Task entity.
+func (t *Task) QueryAssignees() *MemberQuery {
+	return NewTaskClient(t.config).QueryAssignees(t)
+}
+
+// QueryWatchers queries the "watchers" edge of the Task entity.
+func (t *Task) QueryWatchers() *MemberQuery {
+	return NewTaskClient(t.config).QueryWatchers(t)
+}
+
+// QueryChecklistItems queries the "checklist_items" edge of the Task entity.
+func (t *Task) QueryChecklistItems() *ChecklistItemQuery {
+	return NewTaskClient(t.config).QueryChecklistItems(t)
+}
+
+// QueryCustomFieldValues queries the "custom_field_values" edge of the Task entity.
+func (t *Task) QueryCustomFieldValues() *CustomFieldValueQuery {
+	return NewTaskClient(t.config).QueryCustomFieldValues(t)
+}
+
+// QueryWorklogs queries the "worklogs" edge of the Task entity.
+func (t *Task) QueryWorklogs() *WorklogQuery {
+	return NewTaskClient(t.config).QueryWorklogs(t)
+}
+
+// QueryTimers queries the "timers" edge of the Task entity.
+func (t *Task) QueryTimers() *TimerQuery {
+	return NewTaskClient(t.config).QueryTimers(t)
+}
+
+// QueryStatusChanges queries the "status_changes" edge of the Task entity.
+func (t *Task) QueryStatusChanges() *StatusChangeQuery {
+	return NewTaskClient(t.config).QueryStatusChanges(t)
+}
+
 // Update returns a builder for updating this Task.
 // Note that you need to call Task.Unwrap() before calling this method if this Task
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -180,6 +580,26 @@ func (t *Task) String() string {
 	var builder strings.Builder
 	builder.WriteString("Task(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	if v := t.BoardID; v != nil {
+		builder.WriteString("board_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.SprintID; v != nil {
+		builder.WriteString("sprint_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.EpicID; v != nil {
+		builder.WriteString("epic_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(t.Title)
 	builder.WriteString(", ")
@@ -193,6 +613,11 @@ func (t *Task) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := t.AssigneeID; v != nil {
+		builder.WriteString("assignee_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := t.AssigneeName; v != nil {
 		builder.WriteString("assignee_name=")
 		builder.WriteString(*v)
@@ -209,8 +634,44 @@ func (t *Task) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := t.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("status=")
-	builder.WriteString(fmt.Sprintf("%v", t.Status))
+	builder.WriteString(t.Status)
+	builder.WriteString(", ")
+	builder.WriteString("rank=")
+	builder.WriteString(t.Rank)
+	builder.WriteString(", ")
+	if v := t.Priority; v != nil {
+		builder.WriteString("priority=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.StoryPoints; v != nil {
+		builder.WriteString("story_points=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.WipOverrideBy; v != nil {
+		builder.WriteString("wip_override_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.WipOverrideStatus; v != nil {
+		builder.WriteString("wip_override_status=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.WipOverrideAt; v != nil {
+		builder.WriteString("wip_override_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", t.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
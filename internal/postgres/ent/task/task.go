@@ -15,12 +15,22 @@ const (
 	Label = "task"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldBoardID holds the string denoting the board_id field in the database.
+	FieldBoardID = "board_id"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldSprintID holds the string denoting the sprint_id field in the database.
+	FieldSprintID = "sprint_id"
+	// FieldEpicID holds the string denoting the epic_id field in the database.
+	FieldEpicID = "epic_id"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
 	// FieldDueDate holds the string denoting the due_date field in the database.
 	FieldDueDate = "due_date"
+	// FieldAssigneeID holds the string denoting the assignee_id field in the database.
+	FieldAssigneeID = "assignee_id"
 	// FieldAssigneeName holds the string denoting the assignee_name field in the database.
 	FieldAssigneeName = "assignee_name"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -29,10 +39,60 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
 	FieldCompletedAt = "completed_at"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldRank holds the string denoting the rank field in the database.
+	FieldRank = "rank"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldStoryPoints holds the string denoting the story_points field in the database.
+	FieldStoryPoints = "story_points"
+	// FieldWipOverrideBy holds the string denoting the wip_override_by field in the database.
+	FieldWipOverrideBy = "wip_override_by"
+	// FieldWipOverrideStatus holds the string denoting the wip_override_status field in the database.
+	FieldWipOverrideStatus = "wip_override_status"
+	// FieldWipOverrideAt holds the string denoting the wip_override_at field in the database.
+	FieldWipOverrideAt = "wip_override_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
 	EdgeFiles = "files"
+	// EdgeBoard holds the string denoting the board edge name in mutations.
+	EdgeBoard = "board"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeBlockedBy holds the string denoting the blocked_by edge name in mutations.
+	EdgeBlockedBy = "blocked_by"
+	// EdgeBlocks holds the string denoting the blocks edge name in mutations.
+	EdgeBlocks = "blocks"
+	// EdgeLabels holds the string denoting the labels edge name in mutations.
+	EdgeLabels = "labels"
+	// EdgeSprint holds the string denoting the sprint edge name in mutations.
+	EdgeSprint = "sprint"
+	// EdgeEpic holds the string denoting the epic edge name in mutations.
+	EdgeEpic = "epic"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeActivities holds the string denoting the activities edge name in mutations.
+	EdgeActivities = "activities"
+	// EdgeAssignees holds the string denoting the assignees edge name in mutations.
+	EdgeAssignees = "assignees"
+	// EdgeWatchers holds the string denoting the watchers edge name in mutations.
+	EdgeWatchers = "watchers"
+	// EdgeChecklistItems holds the string denoting the checklist_items edge name in mutations.
+	EdgeChecklistItems = "checklist_items"
+	// EdgeCustomFieldValues holds the string denoting the custom_field_values edge name in mutations.
+	EdgeCustomFieldValues = "custom_field_values"
+	// EdgeWorklogs holds the string denoting the worklogs edge name in mutations.
+	EdgeWorklogs = "worklogs"
+	// EdgeTimers holds the string denoting the timers edge name in mutations.
+	EdgeTimers = "timers"
+	// EdgeStatusChanges holds the string denoting the status_changes edge name in mutations.
+	EdgeStatusChanges = "status_changes"
 	// Table holds the table name of the task in the database.
 	Table = "tasks"
 	// FilesTable is the table that holds the files relation/edge. The primary key declared below.
@@ -40,25 +100,150 @@ const (
 	// FilesInverseTable is the table name for the File entity.
 	// It exists in this package in order to avoid circular dependency with the "file" package.
 	FilesInverseTable = "files"
+	// BoardTable is the table that holds the board relation/edge.
+	BoardTable = "tasks"
+	// BoardInverseTable is the table name for the Board entity.
+	// It exists in this package in order to avoid circular dependency with the "board" package.
+	BoardInverseTable = "boards"
+	// BoardColumn is the table column denoting the board relation/edge.
+	BoardColumn = "board_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "tasks"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "tasks"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
+	// BlockedByTable is the table that holds the blocked_by relation/edge. The primary key declared below.
+	BlockedByTable = "task_blocks"
+	// BlocksTable is the table that holds the blocks relation/edge. The primary key declared below.
+	BlocksTable = "task_blocks"
+	// LabelsTable is the table that holds the labels relation/edge. The primary key declared below.
+	LabelsTable = "task_labels"
+	// LabelsInverseTable is the table name for the Label entity.
+	// It exists in this package in order to avoid circular dependency with the "label" package.
+	LabelsInverseTable = "labels"
+	// SprintTable is the table that holds the sprint relation/edge.
+	SprintTable = "tasks"
+	// SprintInverseTable is the table name for the Sprint entity.
+	// It exists in this package in order to avoid circular dependency with the "sprint" package.
+	SprintInverseTable = "sprints"
+	// SprintColumn is the table column denoting the sprint relation/edge.
+	SprintColumn = "sprint_id"
+	// EpicTable is the table that holds the epic relation/edge.
+	EpicTable = "tasks"
+	// EpicInverseTable is the table name for the Epic entity.
+	// It exists in this package in order to avoid circular dependency with the "epic" package.
+	EpicInverseTable = "epics"
+	// EpicColumn is the table column denoting the epic relation/edge.
+	EpicColumn = "epic_id"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "task_id"
+	// ActivitiesTable is the table that holds the activities relation/edge.
+	ActivitiesTable = "activities"
+	// ActivitiesInverseTable is the table name for the Activity entity.
+	// It exists in this package in order to avoid circular dependency with the "activity" package.
+	ActivitiesInverseTable = "activities"
+	// ActivitiesColumn is the table column denoting the activities relation/edge.
+	ActivitiesColumn = "task_id"
+	// AssigneesTable is the table that holds the assignees relation/edge. The primary key declared below.
+	AssigneesTable = "task_assignees"
+	// AssigneesInverseTable is the table name for the Member entity.
+	// It exists in this package in order to avoid circular dependency with the "member" package.
+	AssigneesInverseTable = "members"
+	// WatchersTable is the table that holds the watchers relation/edge. The primary key declared below.
+	WatchersTable = "task_watchers"
+	// WatchersInverseTable is the table name for the Member entity.
+	// It exists in this package in order to avoid circular dependency with the "member" package.
+	WatchersInverseTable = "members"
+	// ChecklistItemsTable is the table that holds the checklist_items relation/edge.
+	ChecklistItemsTable = "checklist_items"
+	// ChecklistItemsInverseTable is the table name for the ChecklistItem entity.
+	// It exists in this package in order to avoid circular dependency with the "checklistitem" package.
+	ChecklistItemsInverseTable = "checklist_items"
+	// ChecklistItemsColumn is the table column denoting the checklist_items relation/edge.
+	ChecklistItemsColumn = "task_id"
+	// CustomFieldValuesTable is the table that holds the custom_field_values relation/edge.
+	CustomFieldValuesTable = "custom_field_values"
+	// CustomFieldValuesInverseTable is the table name for the CustomFieldValue entity.
+	// It exists in this package in order to avoid circular dependency with the "customfieldvalue" package.
+	CustomFieldValuesInverseTable = "custom_field_values"
+	// CustomFieldValuesColumn is the table column denoting the custom_field_values relation/edge.
+	CustomFieldValuesColumn = "task_id"
+	// WorklogsTable is the table that holds the worklogs relation/edge.
+	WorklogsTable = "worklogs"
+	// WorklogsInverseTable is the table name for the Worklog entity.
+	// It exists in this package in order to avoid circular dependency with the "worklog" package.
+	WorklogsInverseTable = "worklogs"
+	// WorklogsColumn is the table column denoting the worklogs relation/edge.
+	WorklogsColumn = "task_id"
+	// TimersTable is the table that holds the timers relation/edge.
+	TimersTable = "timers"
+	// TimersInverseTable is the table name for the Timer entity.
+	// It exists in this package in order to avoid circular dependency with the "timer" package.
+	TimersInverseTable = "timers"
+	// TimersColumn is the table column denoting the timers relation/edge.
+	TimersColumn = "task_id"
+	// StatusChangesTable is the table that holds the status_changes relation/edge.
+	StatusChangesTable = "status_changes"
+	// StatusChangesInverseTable is the table name for the StatusChange entity.
+	// It exists in this package in order to avoid circular dependency with the "statuschange" package.
+	StatusChangesInverseTable = "status_changes"
+	// StatusChangesColumn is the table column denoting the status_changes relation/edge.
+	StatusChangesColumn = "task_id"
 )
 
 // Columns holds all SQL columns for task fields.
 var Columns = []string{
 	FieldID,
+	FieldBoardID,
+	FieldParentID,
+	FieldSprintID,
+	FieldEpicID,
 	FieldTitle,
 	FieldDescription,
 	FieldDueDate,
+	FieldAssigneeID,
 	FieldAssigneeName,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldCompletedAt,
+	FieldArchivedAt,
 	FieldStatus,
+	FieldRank,
+	FieldPriority,
+	FieldStoryPoints,
+	FieldWipOverrideBy,
+	FieldWipOverrideStatus,
+	FieldWipOverrideAt,
+	FieldVersion,
 }
 
 var (
 	// FilesPrimaryKey and FilesColumn2 are the table columns denoting the
 	// primary key for the files relation (M2M).
 	FilesPrimaryKey = []string{"task_id", "file_id"}
+	// BlockedByPrimaryKey and BlockedByColumn2 are the table columns denoting the
+	// primary key for the blocked_by relation (M2M).
+	BlockedByPrimaryKey = []string{"task_id", "blocked_by_id"}
+	// BlocksPrimaryKey and BlocksColumn2 are the table columns denoting the
+	// primary key for the blocks relation (M2M).
+	BlocksPrimaryKey = []string{"task_id", "blocked_by_id"}
+	// LabelsPrimaryKey and LabelsColumn2 are the table columns denoting the
+	// primary key for the labels relation (M2M).
+	LabelsPrimaryKey = []string{"task_id", "label_id"}
+	// AssigneesPrimaryKey and AssigneesColumn2 are the table columns denoting the
+	// primary key for the assignees relation (M2M).
+	AssigneesPrimaryKey = []string{"task_id", "member_id"}
+	// WatchersPrimaryKey and WatchersColumn2 are the table columns denoting the
+	// primary key for the watchers relation (M2M).
+	WatchersPrimaryKey = []string{"task_id", "member_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -78,33 +263,36 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultRank holds the default value on creation for the "rank" field.
+	DefaultRank string
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 )
 
-// Status defines the type for the "status" enum field.
-type Status string
+// Priority defines the type for the "priority" enum field.
+type Priority string
 
-// StatusPending is the default value of the Status enum.
-const DefaultStatus = StatusPending
-
-// Status values.
+// Priority values.
 const (
-	StatusPending    Status = "pending"
-	StatusInProgress Status = "in_progress"
-	StatusInReview   Status = "in_review"
-	StatusCompleted  Status = "completed"
+	PriorityP0 Priority = "p0"
+	PriorityP1 Priority = "p1"
+	PriorityP2 Priority = "p2"
+	PriorityP3 Priority = "p3"
 )
 
-func (s Status) String() string {
-	return string(s)
+func (pr Priority) String() string {
+	return string(pr)
 }
 
-// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
-func StatusValidator(s Status) error {
-	switch s {
-	case StatusPending, StatusInProgress, StatusInReview, StatusCompleted:
+// PriorityValidator is a validator for the "priority" field enum values. It is called by the builders before save.
+func PriorityValidator(pr Priority) error {
+	switch pr {
+	case PriorityP0, PriorityP1, PriorityP2, PriorityP3:
 		return nil
 	default:
-		return fmt.Errorf("task: invalid enum value for status field: %q", s)
+		return fmt.Errorf("task: invalid enum value for priority field: %q", pr)
 	}
 }
 
@@ -116,6 +304,26 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByBoardID orders the results by the board_id field.
+func ByBoardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBoardID, opts...).ToFunc()
+}
+
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// BySprintID orders the results by the sprint_id field.
+func BySprintID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSprintID, opts...).ToFunc()
+}
+
+// ByEpicID orders the results by the epic_id field.
+func ByEpicID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEpicID, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
@@ -131,6 +339,11 @@ func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
 }
 
+// ByAssigneeID orders the results by the assignee_id field.
+func ByAssigneeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssigneeID, opts...).ToFunc()
+}
+
 // ByAssigneeName orders the results by the assignee_name field.
 func ByAssigneeName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAssigneeName, opts...).ToFunc()
@@ -151,11 +364,51 @@ func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
 }
 
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByRank orders the results by the rank field.
+func ByRank(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRank, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByStoryPoints orders the results by the story_points field.
+func ByStoryPoints(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoryPoints, opts...).ToFunc()
+}
+
+// ByWipOverrideBy orders the results by the wip_override_by field.
+func ByWipOverrideBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWipOverrideBy, opts...).ToFunc()
+}
+
+// ByWipOverrideStatus orders the results by the wip_override_status field.
+func ByWipOverrideStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWipOverrideStatus, opts...).ToFunc()
+}
+
+// ByWipOverrideAt orders the results by the wip_override_at field.
+func ByWipOverrideAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWipOverrideAt, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByFilesCount orders the results by files count.
 func ByFilesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -169,6 +422,216 @@ func ByFiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFilesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBoardField orders the results by board field.
+func ByBoardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBoardStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedByCount orders the results by blocked_by count.
+func ByBlockedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByStep(), opts...)
+	}
+}
+
+// ByBlockedBy orders the results by blocked_by terms.
+func ByBlockedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlocksCount orders the results by blocks count.
+func ByBlocksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlocksStep(), opts...)
+	}
+}
+
+// ByBlocks orders the results by blocks terms.
+func ByBlocks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlocksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLabelsCount orders the results by labels count.
+func ByLabelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLabelsStep(), opts...)
+	}
+}
+
+// ByLabels orders the results by labels terms.
+func ByLabels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLabelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySprintField orders the results by sprint field.
+func BySprintField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSprintStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEpicField orders the results by epic field.
+func ByEpicField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEpicStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByActivitiesCount orders the results by activities count.
+func ByActivitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActivitiesStep(), opts...)
+	}
+}
+
+// ByActivities orders the results by activities terms.
+func ByActivities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActivitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAssigneesCount orders the results by assignees count.
+func ByAssigneesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssigneesStep(), opts...)
+	}
+}
+
+// ByAssignees orders the results by assignees terms.
+func ByAssignees(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssigneesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWatchersCount orders the results by watchers count.
+func ByWatchersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWatchersStep(), opts...)
+	}
+}
+
+// ByWatchers orders the results by watchers terms.
+func ByWatchers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChecklistItemsCount orders the results by checklist_items count.
+func ByChecklistItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChecklistItemsStep(), opts...)
+	}
+}
+
+// ByChecklistItems orders the results by checklist_items terms.
+func ByChecklistItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChecklistItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCustomFieldValuesCount orders the results by custom_field_values count.
+func ByCustomFieldValuesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCustomFieldValuesStep(), opts...)
+	}
+}
+
+// ByCustomFieldValues orders the results by custom_field_values terms.
+func ByCustomFieldValues(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomFieldValuesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWorklogsCount orders the results by worklogs count.
+func ByWorklogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorklogsStep(), opts...)
+	}
+}
+
+// ByWorklogs orders the results by worklogs terms.
+func ByWorklogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorklogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTimersCount orders the results by timers count.
+func ByTimersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTimersStep(), opts...)
+	}
+}
+
+// ByTimers orders the results by timers terms.
+func ByTimers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTimersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatusChangesCount orders the results by status_changes count.
+func ByStatusChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusChangesStep(), opts...)
+	}
+}
+
+// ByStatusChanges orders the results by status_changes terms.
+func ByStatusChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFilesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -176,3 +639,122 @@ func newFilesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, FilesTable, FilesPrimaryKey...),
 	)
 }
+func newBoardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BoardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
+func newBlockedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, BlockedByTable, BlockedByPrimaryKey...),
+	)
+}
+func newBlocksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, BlocksTable, BlocksPrimaryKey...),
+	)
+}
+func newLabelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LabelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, LabelsTable, LabelsPrimaryKey...),
+	)
+}
+func newSprintStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SprintInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SprintTable, SprintColumn),
+	)
+}
+func newEpicStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EpicInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EpicTable, EpicColumn),
+	)
+}
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
+func newActivitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActivitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+	)
+}
+func newAssigneesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssigneesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, AssigneesTable, AssigneesPrimaryKey...),
+	)
+}
+func newWatchersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WatchersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, WatchersTable, WatchersPrimaryKey...),
+	)
+}
+func newChecklistItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChecklistItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChecklistItemsTable, ChecklistItemsColumn),
+	)
+}
+func newCustomFieldValuesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomFieldValuesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CustomFieldValuesTable, CustomFieldValuesColumn),
+	)
+}
+func newWorklogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorklogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WorklogsTable, WorklogsColumn),
+	)
+}
+func newTimersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TimersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TimersTable, TimersColumn),
+	)
+}
+func newStatusChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusChangesTable, StatusChangesColumn),
+	)
+}
@@ -65,6 +65,26 @@ func IDContainsFold(id string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldID, id))
 }
 
+// BoardID applies equality check predicate on the "board_id" field. It's identical to BoardIDEQ.
+func BoardID(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldBoardID, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParentID, v))
+}
+
+// SprintID applies equality check predicate on the "sprint_id" field. It's identical to SprintIDEQ.
+func SprintID(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSprintID, v))
+}
+
+// EpicID applies equality check predicate on the "epic_id" field. It's identical to EpicIDEQ.
+func EpicID(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldEpicID, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldTitle, v))
@@ -80,6 +100,11 @@ func DueDate(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldDueDate, v))
 }
 
+// AssigneeID applies equality check predicate on the "assignee_id" field. It's identical to AssigneeIDEQ.
+func AssigneeID(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldAssigneeID, v))
+}
+
 // AssigneeName applies equality check predicate on the "assignee_name" field. It's identical to AssigneeNameEQ.
 func AssigneeName(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldAssigneeName, v))
@@ -100,6 +125,346 @@ func CompletedAt(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCompletedAt, v))
 }
 
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldStatus, v))
+}
+
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRank, v))
+}
+
+// StoryPoints applies equality check predicate on the "story_points" field. It's identical to StoryPointsEQ.
+func StoryPoints(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldStoryPoints, v))
+}
+
+// WipOverrideBy applies equality check predicate on the "wip_override_by" field. It's identical to WipOverrideByEQ.
+func WipOverrideBy(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldWipOverrideBy, v))
+}
+
+// WipOverrideStatus applies equality check predicate on the "wip_override_status" field. It's identical to WipOverrideStatusEQ.
+func WipOverrideStatus(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideAt applies equality check predicate on the "wip_override_at" field. It's identical to WipOverrideAtEQ.
+func WipOverrideAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldWipOverrideAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldVersion, v))
+}
+
+// BoardIDEQ applies the EQ predicate on the "board_id" field.
+func BoardIDEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldBoardID, v))
+}
+
+// BoardIDNEQ applies the NEQ predicate on the "board_id" field.
+func BoardIDNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldBoardID, v))
+}
+
+// BoardIDIn applies the In predicate on the "board_id" field.
+func BoardIDIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldBoardID, vs...))
+}
+
+// BoardIDNotIn applies the NotIn predicate on the "board_id" field.
+func BoardIDNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldBoardID, vs...))
+}
+
+// BoardIDGT applies the GT predicate on the "board_id" field.
+func BoardIDGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldBoardID, v))
+}
+
+// BoardIDGTE applies the GTE predicate on the "board_id" field.
+func BoardIDGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldBoardID, v))
+}
+
+// BoardIDLT applies the LT predicate on the "board_id" field.
+func BoardIDLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldBoardID, v))
+}
+
+// BoardIDLTE applies the LTE predicate on the "board_id" field.
+func BoardIDLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldBoardID, v))
+}
+
+// BoardIDContains applies the Contains predicate on the "board_id" field.
+func BoardIDContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldBoardID, v))
+}
+
+// BoardIDHasPrefix applies the HasPrefix predicate on the "board_id" field.
+func BoardIDHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldBoardID, v))
+}
+
+// BoardIDHasSuffix applies the HasSuffix predicate on the "board_id" field.
+func BoardIDHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldBoardID, v))
+}
+
+// BoardIDIsNil applies the IsNil predicate on the "board_id" field.
+func BoardIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldBoardID))
+}
+
+// BoardIDNotNil applies the NotNil predicate on the "board_id" field.
+func BoardIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldBoardID))
+}
+
+// BoardIDEqualFold applies the EqualFold predicate on the "board_id" field.
+func BoardIDEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldBoardID, v))
+}
+
+// BoardIDContainsFold applies the ContainsFold predicate on the "board_id" field.
+func BoardIDContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldBoardID, v))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDGT applies the GT predicate on the "parent_id" field.
+func ParentIDGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldParentID, v))
+}
+
+// ParentIDGTE applies the GTE predicate on the "parent_id" field.
+func ParentIDGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldParentID, v))
+}
+
+// ParentIDLT applies the LT predicate on the "parent_id" field.
+func ParentIDLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldParentID, v))
+}
+
+// ParentIDLTE applies the LTE predicate on the "parent_id" field.
+func ParentIDLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldParentID, v))
+}
+
+// ParentIDContains applies the Contains predicate on the "parent_id" field.
+func ParentIDContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldParentID, v))
+}
+
+// ParentIDHasPrefix applies the HasPrefix predicate on the "parent_id" field.
+func ParentIDHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldParentID, v))
+}
+
+// ParentIDHasSuffix applies the HasSuffix predicate on the "parent_id" field.
+func ParentIDHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldParentID, v))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldParentID))
+}
+
+// ParentIDEqualFold applies the EqualFold predicate on the "parent_id" field.
+func ParentIDEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldParentID, v))
+}
+
+// ParentIDContainsFold applies the ContainsFold predicate on the "parent_id" field.
+func ParentIDContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldParentID, v))
+}
+
+// SprintIDEQ applies the EQ predicate on the "sprint_id" field.
+func SprintIDEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSprintID, v))
+}
+
+// SprintIDNEQ applies the NEQ predicate on the "sprint_id" field.
+func SprintIDNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldSprintID, v))
+}
+
+// SprintIDIn applies the In predicate on the "sprint_id" field.
+func SprintIDIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldSprintID, vs...))
+}
+
+// SprintIDNotIn applies the NotIn predicate on the "sprint_id" field.
+func SprintIDNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldSprintID, vs...))
+}
+
+// SprintIDGT applies the GT predicate on the "sprint_id" field.
+func SprintIDGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldSprintID, v))
+}
+
+// SprintIDGTE applies the GTE predicate on the "sprint_id" field.
+func SprintIDGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldSprintID, v))
+}
+
+// SprintIDLT applies the LT predicate on the "sprint_id" field.
+func SprintIDLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldSprintID, v))
+}
+
+// SprintIDLTE applies the LTE predicate on the "sprint_id" field.
+func SprintIDLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldSprintID, v))
+}
+
+// SprintIDContains applies the Contains predicate on the "sprint_id" field.
+func SprintIDContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldSprintID, v))
+}
+
+// SprintIDHasPrefix applies the HasPrefix predicate on the "sprint_id" field.
+func SprintIDHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldSprintID, v))
+}
+
+// SprintIDHasSuffix applies the HasSuffix predicate on the "sprint_id" field.
+func SprintIDHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldSprintID, v))
+}
+
+// SprintIDIsNil applies the IsNil predicate on the "sprint_id" field.
+func SprintIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldSprintID))
+}
+
+// SprintIDNotNil applies the NotNil predicate on the "sprint_id" field.
+func SprintIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldSprintID))
+}
+
+// SprintIDEqualFold applies the EqualFold predicate on the "sprint_id" field.
+func SprintIDEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldSprintID, v))
+}
+
+// SprintIDContainsFold applies the ContainsFold predicate on the "sprint_id" field.
+func SprintIDContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldSprintID, v))
+}
+
+// EpicIDEQ applies the EQ predicate on the "epic_id" field.
+func EpicIDEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldEpicID, v))
+}
+
+// EpicIDNEQ applies the NEQ predicate on the "epic_id" field.
+func EpicIDNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldEpicID, v))
+}
+
+// EpicIDIn applies the In predicate on the "epic_id" field.
+func EpicIDIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldEpicID, vs...))
+}
+
+// EpicIDNotIn applies the NotIn predicate on the "epic_id" field.
+func EpicIDNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldEpicID, vs...))
+}
+
+// EpicIDGT applies the GT predicate on the "epic_id" field.
+func EpicIDGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldEpicID, v))
+}
+
+// EpicIDGTE applies the GTE predicate on the "epic_id" field.
+func EpicIDGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldEpicID, v))
+}
+
+// EpicIDLT applies the LT predicate on the "epic_id" field.
+func EpicIDLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldEpicID, v))
+}
+
+// EpicIDLTE applies the LTE predicate on the "epic_id" field.
+func EpicIDLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldEpicID, v))
+}
+
+// EpicIDContains applies the Contains predicate on the "epic_id" field.
+func EpicIDContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldEpicID, v))
+}
+
+// EpicIDHasPrefix applies the HasPrefix predicate on the "epic_id" field.
+func EpicIDHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldEpicID, v))
+}
+
+// EpicIDHasSuffix applies the HasSuffix predicate on the "epic_id" field.
+func EpicIDHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldEpicID, v))
+}
+
+// EpicIDIsNil applies the IsNil predicate on the "epic_id" field.
+func EpicIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldEpicID))
+}
+
+// EpicIDNotNil applies the NotNil predicate on the "epic_id" field.
+func EpicIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldEpicID))
+}
+
+// EpicIDEqualFold applies the EqualFold predicate on the "epic_id" field.
+func EpicIDEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldEpicID, v))
+}
+
+// EpicIDContainsFold applies the ContainsFold predicate on the "epic_id" field.
+func EpicIDContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldEpicID, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldTitle, v))
@@ -290,6 +655,81 @@ func DueDateNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldDueDate))
 }
 
+// AssigneeIDEQ applies the EQ predicate on the "assignee_id" field.
+func AssigneeIDEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDNEQ applies the NEQ predicate on the "assignee_id" field.
+func AssigneeIDNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDIn applies the In predicate on the "assignee_id" field.
+func AssigneeIDIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDNotIn applies the NotIn predicate on the "assignee_id" field.
+func AssigneeIDNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDGT applies the GT predicate on the "assignee_id" field.
+func AssigneeIDGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldAssigneeID, v))
+}
+
+// AssigneeIDGTE applies the GTE predicate on the "assignee_id" field.
+func AssigneeIDGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDLT applies the LT predicate on the "assignee_id" field.
+func AssigneeIDLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldAssigneeID, v))
+}
+
+// AssigneeIDLTE applies the LTE predicate on the "assignee_id" field.
+func AssigneeIDLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDContains applies the Contains predicate on the "assignee_id" field.
+func AssigneeIDContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldAssigneeID, v))
+}
+
+// AssigneeIDHasPrefix applies the HasPrefix predicate on the "assignee_id" field.
+func AssigneeIDHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldAssigneeID, v))
+}
+
+// AssigneeIDHasSuffix applies the HasSuffix predicate on the "assignee_id" field.
+func AssigneeIDHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldAssigneeID, v))
+}
+
+// AssigneeIDIsNil applies the IsNil predicate on the "assignee_id" field.
+func AssigneeIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldAssigneeID))
+}
+
+// AssigneeIDNotNil applies the NotNil predicate on the "assignee_id" field.
+func AssigneeIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldAssigneeID))
+}
+
+// AssigneeIDEqualFold applies the EqualFold predicate on the "assignee_id" field.
+func AssigneeIDEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldAssigneeID, v))
+}
+
+// AssigneeIDContainsFold applies the ContainsFold predicate on the "assignee_id" field.
+func AssigneeIDContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldAssigneeID, v))
+}
+
 // AssigneeNameEQ applies the EQ predicate on the "assignee_name" field.
 func AssigneeNameEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldAssigneeName, v))
@@ -495,41 +935,912 @@ func CompletedAtNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldCompletedAt))
 }
 
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldArchivedAt))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
-func StatusEQ(v Status) predicate.Task {
+func StatusEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldStatus, v))
 }
 
 // StatusNEQ applies the NEQ predicate on the "status" field.
-func StatusNEQ(v Status) predicate.Task {
+func StatusNEQ(v string) predicate.Task {
 	return predicate.Task(sql.FieldNEQ(FieldStatus, v))
 }
 
 // StatusIn applies the In predicate on the "status" field.
-func StatusIn(vs ...Status) predicate.Task {
+func StatusIn(vs ...string) predicate.Task {
 	return predicate.Task(sql.FieldIn(FieldStatus, vs...))
 }
 
 // StatusNotIn applies the NotIn predicate on the "status" field.
-func StatusNotIn(vs ...Status) predicate.Task {
+func StatusNotIn(vs ...string) predicate.Task {
 	return predicate.Task(sql.FieldNotIn(FieldStatus, vs...))
 }
 
-// HasFiles applies the HasEdge predicate on the "files" edge.
-func HasFiles() predicate.Task {
-	return predicate.Task(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, FilesTable, FilesPrimaryKey...),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldStatus, v))
 }
 
-// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
-func HasFilesWith(preds ...predicate.File) predicate.Task {
-	return predicate.Task(func(s *sql.Selector) {
-		step := newFilesStep()
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRank, v))
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRank, v))
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRank, vs...))
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRank, vs...))
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRank, v))
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRank, v))
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRank, v))
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRank, v))
+}
+
+// RankContains applies the Contains predicate on the "rank" field.
+func RankContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldRank, v))
+}
+
+// RankHasPrefix applies the HasPrefix predicate on the "rank" field.
+func RankHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldRank, v))
+}
+
+// RankHasSuffix applies the HasSuffix predicate on the "rank" field.
+func RankHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldRank, v))
+}
+
+// RankEqualFold applies the EqualFold predicate on the "rank" field.
+func RankEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldRank, v))
+}
+
+// RankContainsFold applies the ContainsFold predicate on the "rank" field.
+func RankContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldRank, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityIsNil applies the IsNil predicate on the "priority" field.
+func PriorityIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldPriority))
+}
+
+// PriorityNotNil applies the NotNil predicate on the "priority" field.
+func PriorityNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldPriority))
+}
+
+// StoryPointsEQ applies the EQ predicate on the "story_points" field.
+func StoryPointsEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldStoryPoints, v))
+}
+
+// StoryPointsNEQ applies the NEQ predicate on the "story_points" field.
+func StoryPointsNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldStoryPoints, v))
+}
+
+// StoryPointsIn applies the In predicate on the "story_points" field.
+func StoryPointsIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldStoryPoints, vs...))
+}
+
+// StoryPointsNotIn applies the NotIn predicate on the "story_points" field.
+func StoryPointsNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldStoryPoints, vs...))
+}
+
+// StoryPointsGT applies the GT predicate on the "story_points" field.
+func StoryPointsGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldStoryPoints, v))
+}
+
+// StoryPointsGTE applies the GTE predicate on the "story_points" field.
+func StoryPointsGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldStoryPoints, v))
+}
+
+// StoryPointsLT applies the LT predicate on the "story_points" field.
+func StoryPointsLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldStoryPoints, v))
+}
+
+// StoryPointsLTE applies the LTE predicate on the "story_points" field.
+func StoryPointsLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldStoryPoints, v))
+}
+
+// StoryPointsIsNil applies the IsNil predicate on the "story_points" field.
+func StoryPointsIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldStoryPoints))
+}
+
+// StoryPointsNotNil applies the NotNil predicate on the "story_points" field.
+func StoryPointsNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldStoryPoints))
+}
+
+// WipOverrideByEQ applies the EQ predicate on the "wip_override_by" field.
+func WipOverrideByEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByNEQ applies the NEQ predicate on the "wip_override_by" field.
+func WipOverrideByNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByIn applies the In predicate on the "wip_override_by" field.
+func WipOverrideByIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldWipOverrideBy, vs...))
+}
+
+// WipOverrideByNotIn applies the NotIn predicate on the "wip_override_by" field.
+func WipOverrideByNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldWipOverrideBy, vs...))
+}
+
+// WipOverrideByGT applies the GT predicate on the "wip_override_by" field.
+func WipOverrideByGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByGTE applies the GTE predicate on the "wip_override_by" field.
+func WipOverrideByGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByLT applies the LT predicate on the "wip_override_by" field.
+func WipOverrideByLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByLTE applies the LTE predicate on the "wip_override_by" field.
+func WipOverrideByLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByContains applies the Contains predicate on the "wip_override_by" field.
+func WipOverrideByContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByHasPrefix applies the HasPrefix predicate on the "wip_override_by" field.
+func WipOverrideByHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByHasSuffix applies the HasSuffix predicate on the "wip_override_by" field.
+func WipOverrideByHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByIsNil applies the IsNil predicate on the "wip_override_by" field.
+func WipOverrideByIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldWipOverrideBy))
+}
+
+// WipOverrideByNotNil applies the NotNil predicate on the "wip_override_by" field.
+func WipOverrideByNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldWipOverrideBy))
+}
+
+// WipOverrideByEqualFold applies the EqualFold predicate on the "wip_override_by" field.
+func WipOverrideByEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldWipOverrideBy, v))
+}
+
+// WipOverrideByContainsFold applies the ContainsFold predicate on the "wip_override_by" field.
+func WipOverrideByContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldWipOverrideBy, v))
+}
+
+// WipOverrideStatusEQ applies the EQ predicate on the "wip_override_status" field.
+func WipOverrideStatusEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusNEQ applies the NEQ predicate on the "wip_override_status" field.
+func WipOverrideStatusNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusIn applies the In predicate on the "wip_override_status" field.
+func WipOverrideStatusIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldWipOverrideStatus, vs...))
+}
+
+// WipOverrideStatusNotIn applies the NotIn predicate on the "wip_override_status" field.
+func WipOverrideStatusNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldWipOverrideStatus, vs...))
+}
+
+// WipOverrideStatusGT applies the GT predicate on the "wip_override_status" field.
+func WipOverrideStatusGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusGTE applies the GTE predicate on the "wip_override_status" field.
+func WipOverrideStatusGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusLT applies the LT predicate on the "wip_override_status" field.
+func WipOverrideStatusLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusLTE applies the LTE predicate on the "wip_override_status" field.
+func WipOverrideStatusLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusContains applies the Contains predicate on the "wip_override_status" field.
+func WipOverrideStatusContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusHasPrefix applies the HasPrefix predicate on the "wip_override_status" field.
+func WipOverrideStatusHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusHasSuffix applies the HasSuffix predicate on the "wip_override_status" field.
+func WipOverrideStatusHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusIsNil applies the IsNil predicate on the "wip_override_status" field.
+func WipOverrideStatusIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldWipOverrideStatus))
+}
+
+// WipOverrideStatusNotNil applies the NotNil predicate on the "wip_override_status" field.
+func WipOverrideStatusNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldWipOverrideStatus))
+}
+
+// WipOverrideStatusEqualFold applies the EqualFold predicate on the "wip_override_status" field.
+func WipOverrideStatusEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideStatusContainsFold applies the ContainsFold predicate on the "wip_override_status" field.
+func WipOverrideStatusContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldWipOverrideStatus, v))
+}
+
+// WipOverrideAtEQ applies the EQ predicate on the "wip_override_at" field.
+func WipOverrideAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldWipOverrideAt, v))
+}
+
+// WipOverrideAtNEQ applies the NEQ predicate on the "wip_override_at" field.
+func WipOverrideAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldWipOverrideAt, v))
+}
+
+// WipOverrideAtIn applies the In predicate on the "wip_override_at" field.
+func WipOverrideAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldWipOverrideAt, vs...))
+}
+
+// WipOverrideAtNotIn applies the NotIn predicate on the "wip_override_at" field.
+func WipOverrideAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldWipOverrideAt, vs...))
+}
+
+// WipOverrideAtGT applies the GT predicate on the "wip_override_at" field.
+func WipOverrideAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldWipOverrideAt, v))
+}
+
+// WipOverrideAtGTE applies the GTE predicate on the "wip_override_at" field.
+func WipOverrideAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldWipOverrideAt, v))
+}
+
+// WipOverrideAtLT applies the LT predicate on the "wip_override_at" field.
+func WipOverrideAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldWipOverrideAt, v))
+}
+
+// WipOverrideAtLTE applies the LTE predicate on the "wip_override_at" field.
+func WipOverrideAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldWipOverrideAt, v))
+}
+
+// WipOverrideAtIsNil applies the IsNil predicate on the "wip_override_at" field.
+func WipOverrideAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldWipOverrideAt))
+}
+
+// WipOverrideAtNotNil applies the NotNil predicate on the "wip_override_at" field.
+func WipOverrideAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldWipOverrideAt))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldVersion, v))
+}
+
+// HasFiles applies the HasEdge predicate on the "files" edge.
+func HasFiles() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, FilesTable, FilesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
+func HasFilesWith(preds ...predicate.File) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newFilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBoard applies the HasEdge predicate on the "board" edge.
+func HasBoard() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoardWith applies the HasEdge predicate on the "board" edge with a given conditions (other predicates).
+func HasBoardWith(preds ...predicate.Board) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newBoardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlockedBy applies the HasEdge predicate on the "blocked_by" edge.
+func HasBlockedBy() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, BlockedByTable, BlockedByPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedByWith applies the HasEdge predicate on the "blocked_by" edge with a given conditions (other predicates).
+func HasBlockedByWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newBlockedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocks applies the HasEdge predicate on the "blocks" edge.
+func HasBlocks() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, BlocksTable, BlocksPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlocksWith applies the HasEdge predicate on the "blocks" edge with a given conditions (other predicates).
+func HasBlocksWith(preds ...predicate.Task) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newBlocksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLabels applies the HasEdge predicate on the "labels" edge.
+func HasLabels() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, LabelsTable, LabelsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLabelsWith applies the HasEdge predicate on the "labels" edge with a given conditions (other predicates).
+func HasLabelsWith(preds ...predicate.Label) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newLabelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSprint applies the HasEdge predicate on the "sprint" edge.
+func HasSprint() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SprintTable, SprintColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSprintWith applies the HasEdge predicate on the "sprint" edge with a given conditions (other predicates).
+func HasSprintWith(preds ...predicate.Sprint) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newSprintStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEpic applies the HasEdge predicate on the "epic" edge.
+func HasEpic() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EpicTable, EpicColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEpicWith applies the HasEdge predicate on the "epic" edge with a given conditions (other predicates).
+func HasEpicWith(preds ...predicate.Epic) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newEpicStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActivities applies the HasEdge predicate on the "activities" edge.
+func HasActivities() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActivitiesWith applies the HasEdge predicate on the "activities" edge with a given conditions (other predicates).
+func HasActivitiesWith(preds ...predicate.Activity) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newActivitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssignees applies the HasEdge predicate on the "assignees" edge.
+func HasAssignees() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, AssigneesTable, AssigneesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssigneesWith applies the HasEdge predicate on the "assignees" edge with a given conditions (other predicates).
+func HasAssigneesWith(preds ...predicate.Member) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newAssigneesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWatchers applies the HasEdge predicate on the "watchers" edge.
+func HasWatchers() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, WatchersTable, WatchersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWatchersWith applies the HasEdge predicate on the "watchers" edge with a given conditions (other predicates).
+func HasWatchersWith(preds ...predicate.Member) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newWatchersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChecklistItems applies the HasEdge predicate on the "checklist_items" edge.
+func HasChecklistItems() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChecklistItemsTable, ChecklistItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChecklistItemsWith applies the HasEdge predicate on the "checklist_items" edge with a given conditions (other predicates).
+func HasChecklistItemsWith(preds ...predicate.ChecklistItem) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newChecklistItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCustomFieldValues applies the HasEdge predicate on the "custom_field_values" edge.
+func HasCustomFieldValues() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CustomFieldValuesTable, CustomFieldValuesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomFieldValuesWith applies the HasEdge predicate on the "custom_field_values" edge with a given conditions (other predicates).
+func HasCustomFieldValuesWith(preds ...predicate.CustomFieldValue) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newCustomFieldValuesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorklogs applies the HasEdge predicate on the "worklogs" edge.
+func HasWorklogs() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WorklogsTable, WorklogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorklogsWith applies the HasEdge predicate on the "worklogs" edge with a given conditions (other predicates).
+func HasWorklogsWith(preds ...predicate.Worklog) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newWorklogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTimers applies the HasEdge predicate on the "timers" edge.
+func HasTimers() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TimersTable, TimersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTimersWith applies the HasEdge predicate on the "timers" edge with a given conditions (other predicates).
+func HasTimersWith(preds ...predicate.Timer) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newTimersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatusChanges applies the HasEdge predicate on the "status_changes" edge.
+func HasStatusChanges() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusChangesTable, StatusChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusChangesWith applies the HasEdge predicate on the "status_changes" edge with a given conditions (other predicates).
+func HasStatusChangesWith(preds ...predicate.StatusChange) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newStatusChangesStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
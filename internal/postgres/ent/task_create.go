@@ -10,8 +10,20 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/comment"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
 )
 
 // TaskCreate is the builder for creating a Task entity.
@@ -21,6 +33,62 @@ type TaskCreate struct {
 	hooks    []Hook
 }
 
+// SetBoardID sets the "board_id" field.
+func (tc *TaskCreate) SetBoardID(s string) *TaskCreate {
+	tc.mutation.SetBoardID(s)
+	return tc
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableBoardID(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetBoardID(*s)
+	}
+	return tc
+}
+
+// SetParentID sets the "parent_id" field.
+func (tc *TaskCreate) SetParentID(s string) *TaskCreate {
+	tc.mutation.SetParentID(s)
+	return tc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableParentID(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetParentID(*s)
+	}
+	return tc
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (tc *TaskCreate) SetSprintID(s string) *TaskCreate {
+	tc.mutation.SetSprintID(s)
+	return tc
+}
+
+// SetNillableSprintID sets the "sprint_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableSprintID(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetSprintID(*s)
+	}
+	return tc
+}
+
+// SetEpicID sets the "epic_id" field.
+func (tc *TaskCreate) SetEpicID(s string) *TaskCreate {
+	tc.mutation.SetEpicID(s)
+	return tc
+}
+
+// SetNillableEpicID sets the "epic_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableEpicID(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetEpicID(*s)
+	}
+	return tc
+}
+
 // SetTitle sets the "title" field.
 func (tc *TaskCreate) SetTitle(s string) *TaskCreate {
 	tc.mutation.SetTitle(s)
@@ -55,6 +123,20 @@ func (tc *TaskCreate) SetNillableDueDate(t *time.Time) *TaskCreate {
 	return tc
 }
 
+// SetAssigneeID sets the "assignee_id" field.
+func (tc *TaskCreate) SetAssigneeID(s string) *TaskCreate {
+	tc.mutation.SetAssigneeID(s)
+	return tc
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableAssigneeID(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetAssigneeID(*s)
+	}
+	return tc
+}
+
 // SetAssigneeName sets the "assignee_name" field.
 func (tc *TaskCreate) SetAssigneeName(s string) *TaskCreate {
 	tc.mutation.SetAssigneeName(s)
@@ -111,16 +193,128 @@ func (tc *TaskCreate) SetNillableCompletedAt(t *time.Time) *TaskCreate {
 	return tc
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (tc *TaskCreate) SetArchivedAt(t time.Time) *TaskCreate {
+	tc.mutation.SetArchivedAt(t)
+	return tc
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableArchivedAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetArchivedAt(*t)
+	}
+	return tc
+}
+
 // SetStatus sets the "status" field.
-func (tc *TaskCreate) SetStatus(t task.Status) *TaskCreate {
-	tc.mutation.SetStatus(t)
+func (tc *TaskCreate) SetStatus(s string) *TaskCreate {
+	tc.mutation.SetStatus(s)
 	return tc
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (tc *TaskCreate) SetNillableStatus(t *task.Status) *TaskCreate {
+func (tc *TaskCreate) SetNillableStatus(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetStatus(*s)
+	}
+	return tc
+}
+
+// SetRank sets the "rank" field.
+func (tc *TaskCreate) SetRank(s string) *TaskCreate {
+	tc.mutation.SetRank(s)
+	return tc
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRank(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetRank(*s)
+	}
+	return tc
+}
+
+// SetPriority sets the "priority" field.
+func (tc *TaskCreate) SetPriority(t task.Priority) *TaskCreate {
+	tc.mutation.SetPriority(t)
+	return tc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tc *TaskCreate) SetNillablePriority(t *task.Priority) *TaskCreate {
+	if t != nil {
+		tc.SetPriority(*t)
+	}
+	return tc
+}
+
+// SetStoryPoints sets the "story_points" field.
+func (tc *TaskCreate) SetStoryPoints(i int) *TaskCreate {
+	tc.mutation.SetStoryPoints(i)
+	return tc
+}
+
+// SetNillableStoryPoints sets the "story_points" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableStoryPoints(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetStoryPoints(*i)
+	}
+	return tc
+}
+
+// SetWipOverrideBy sets the "wip_override_by" field.
+func (tc *TaskCreate) SetWipOverrideBy(s string) *TaskCreate {
+	tc.mutation.SetWipOverrideBy(s)
+	return tc
+}
+
+// SetNillableWipOverrideBy sets the "wip_override_by" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableWipOverrideBy(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetWipOverrideBy(*s)
+	}
+	return tc
+}
+
+// SetWipOverrideStatus sets the "wip_override_status" field.
+func (tc *TaskCreate) SetWipOverrideStatus(s string) *TaskCreate {
+	tc.mutation.SetWipOverrideStatus(s)
+	return tc
+}
+
+// SetNillableWipOverrideStatus sets the "wip_override_status" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableWipOverrideStatus(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetWipOverrideStatus(*s)
+	}
+	return tc
+}
+
+// SetWipOverrideAt sets the "wip_override_at" field.
+func (tc *TaskCreate) SetWipOverrideAt(t time.Time) *TaskCreate {
+	tc.mutation.SetWipOverrideAt(t)
+	return tc
+}
+
+// SetNillableWipOverrideAt sets the "wip_override_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableWipOverrideAt(t *time.Time) *TaskCreate {
 	if t != nil {
-		tc.SetStatus(*t)
+		tc.SetWipOverrideAt(*t)
+	}
+	return tc
+}
+
+// SetVersion sets the "version" field.
+func (tc *TaskCreate) SetVersion(i int) *TaskCreate {
+	tc.mutation.SetVersion(i)
+	return tc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableVersion(i *int) *TaskCreate {
+	if i != nil {
+		tc.SetVersion(*i)
 	}
 	return tc
 }
@@ -146,6 +340,221 @@ func (tc *TaskCreate) AddFiles(f ...*File) *TaskCreate {
 	return tc.AddFileIDs(ids...)
 }
 
+// SetBoard sets the "board" edge to the Board entity.
+func (tc *TaskCreate) SetBoard(b *Board) *TaskCreate {
+	return tc.SetBoardID(b.ID)
+}
+
+// SetParent sets the "parent" edge to the Task entity.
+func (tc *TaskCreate) SetParent(t *Task) *TaskCreate {
+	return tc.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by IDs.
+func (tc *TaskCreate) AddChildIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddChildIDs(ids...)
+	return tc
+}
+
+// AddChildren adds the "children" edges to the Task entity.
+func (tc *TaskCreate) AddChildren(t ...*Task) *TaskCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddChildIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Task entity by IDs.
+func (tc *TaskCreate) AddBlockedByIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddBlockedByIDs(ids...)
+	return tc
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Task entity.
+func (tc *TaskCreate) AddBlockedBy(t ...*Task) *TaskCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddBlockedByIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Task entity by IDs.
+func (tc *TaskCreate) AddBlockIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddBlockIDs(ids...)
+	return tc
+}
+
+// AddBlocks adds the "blocks" edges to the Task entity.
+func (tc *TaskCreate) AddBlocks(t ...*Task) *TaskCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddBlockIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (tc *TaskCreate) AddLabelIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddLabelIDs(ids...)
+	return tc
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (tc *TaskCreate) AddLabels(l ...*Label) *TaskCreate {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tc.AddLabelIDs(ids...)
+}
+
+// SetSprint sets the "sprint" edge to the Sprint entity.
+func (tc *TaskCreate) SetSprint(s *Sprint) *TaskCreate {
+	return tc.SetSprintID(s.ID)
+}
+
+// SetEpic sets the "epic" edge to the Epic entity.
+func (tc *TaskCreate) SetEpic(e *Epic) *TaskCreate {
+	return tc.SetEpicID(e.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tc *TaskCreate) AddCommentIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddCommentIDs(ids...)
+	return tc
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tc *TaskCreate) AddComments(c ...*Comment) *TaskCreate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tc.AddCommentIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the Activity entity by IDs.
+func (tc *TaskCreate) AddActivityIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddActivityIDs(ids...)
+	return tc
+}
+
+// AddActivities adds the "activities" edges to the Activity entity.
+func (tc *TaskCreate) AddActivities(a ...*Activity) *TaskCreate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tc.AddActivityIDs(ids...)
+}
+
+// AddAssigneeIDs adds the "assignees" edge to the Member entity by IDs.
+func (tc *TaskCreate) AddAssigneeIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddAssigneeIDs(ids...)
+	return tc
+}
+
+// AddAssignees adds the "assignees" edges to the Member entity.
+func (tc *TaskCreate) AddAssignees(m ...*Member) *TaskCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tc.AddAssigneeIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the Member entity by IDs.
+func (tc *TaskCreate) AddWatcherIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddWatcherIDs(ids...)
+	return tc
+}
+
+// AddWatchers adds the "watchers" edges to the Member entity.
+func (tc *TaskCreate) AddWatchers(m ...*Member) *TaskCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tc.AddWatcherIDs(ids...)
+}
+
+// AddChecklistItemIDs adds the "checklist_items" edge to the ChecklistItem entity by IDs.
+func (tc *TaskCreate) AddChecklistItemIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddChecklistItemIDs(ids...)
+	return tc
+}
+
+// AddChecklistItems adds the "checklist_items" edges to the ChecklistItem entity.
+func (tc *TaskCreate) AddChecklistItems(c ...*ChecklistItem) *TaskCreate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tc.AddChecklistItemIDs(ids...)
+}
+
+// AddCustomFieldValueIDs adds the "custom_field_values" edge to the CustomFieldValue entity by IDs.
+func (tc *TaskCreate) AddCustomFieldValueIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddCustomFieldValueIDs(ids...)
+	return tc
+}
+
+// AddCustomFieldValues adds the "custom_field_values" edges to the CustomFieldValue entity.
+func (tc *TaskCreate) AddCustomFieldValues(c ...*CustomFieldValue) *TaskCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tc.AddCustomFieldValueIDs(ids...)
+}
+
+// AddWorklogIDs adds the "worklogs" edge to the Worklog entity by IDs.
+func (tc *TaskCreate) AddWorklogIDs(ids ...string) *TaskCreate {
+	tc.mutation.AddWorklogIDs(ids...)
+	return tc
+}
+
+// AddWorklogs adds the "worklogs" edges to the Worklog entity.
+func (tc *TaskCreate) AddWorklogs(w ...*Worklog) *TaskCreate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tc.AddWorklogIDs(ids...)
+}
+
+// AddTimerIDs adds the "timers" edge to the Timer entity by IDs.
+func (tc *TaskCreate) AddTimerIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddTimerIDs(ids...)
+	return tc
+}
+
+// AddTimers adds the "timers" edges to the Timer entity.
+func (tc *TaskCreate) AddTimers(t ...*Timer) *TaskCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddTimerIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the StatusChange entity by IDs.
+func (tc *TaskCreate) AddStatusChangeIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddStatusChangeIDs(ids...)
+	return tc
+}
+
+// AddStatusChanges adds the "status_changes" edges to the StatusChange entity.
+func (tc *TaskCreate) AddStatusChanges(s ...*StatusChange) *TaskCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tc.AddStatusChangeIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tc *TaskCreate) Mutation() *TaskMutation {
 	return tc.mutation
@@ -193,6 +602,14 @@ func (tc *TaskCreate) defaults() {
 		v := task.DefaultStatus
 		tc.mutation.SetStatus(v)
 	}
+	if _, ok := tc.mutation.Rank(); !ok {
+		v := task.DefaultRank
+		tc.mutation.SetRank(v)
+	}
+	if _, ok := tc.mutation.Version(); !ok {
+		v := task.DefaultVersion
+		tc.mutation.SetVersion(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -209,11 +626,17 @@ func (tc *TaskCreate) check() error {
 	if _, ok := tc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Task.status"`)}
 	}
-	if v, ok := tc.mutation.Status(); ok {
-		if err := task.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+	if _, ok := tc.mutation.Rank(); !ok {
+		return &ValidationError{Name: "rank", err: errors.New(`ent: missing required field "Task.rank"`)}
+	}
+	if v, ok := tc.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Task.version"`)}
+	}
 	return nil
 }
 
@@ -261,6 +684,10 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldDueDate, field.TypeTime, value)
 		_node.DueDate = &value
 	}
+	if value, ok := tc.mutation.AssigneeID(); ok {
+		_spec.SetField(task.FieldAssigneeID, field.TypeString, value)
+		_node.AssigneeID = &value
+	}
 	if value, ok := tc.mutation.AssigneeName(); ok {
 		_spec.SetField(task.FieldAssigneeName, field.TypeString, value)
 		_node.AssigneeName = &value
@@ -277,10 +704,42 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldCompletedAt, field.TypeTime, value)
 		_node.CompletedAt = &value
 	}
+	if value, ok := tc.mutation.ArchivedAt(); ok {
+		_spec.SetField(task.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
 	if value, ok := tc.mutation.Status(); ok {
-		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
+		_spec.SetField(task.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := tc.mutation.Rank(); ok {
+		_spec.SetField(task.FieldRank, field.TypeString, value)
+		_node.Rank = value
+	}
+	if value, ok := tc.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+		_node.Priority = &value
+	}
+	if value, ok := tc.mutation.StoryPoints(); ok {
+		_spec.SetField(task.FieldStoryPoints, field.TypeInt, value)
+		_node.StoryPoints = &value
+	}
+	if value, ok := tc.mutation.WipOverrideBy(); ok {
+		_spec.SetField(task.FieldWipOverrideBy, field.TypeString, value)
+		_node.WipOverrideBy = &value
+	}
+	if value, ok := tc.mutation.WipOverrideStatus(); ok {
+		_spec.SetField(task.FieldWipOverrideStatus, field.TypeString, value)
+		_node.WipOverrideStatus = &value
+	}
+	if value, ok := tc.mutation.WipOverrideAt(); ok {
+		_spec.SetField(task.FieldWipOverrideAt, field.TypeTime, value)
+		_node.WipOverrideAt = &value
+	}
+	if value, ok := tc.mutation.Version(); ok {
+		_spec.SetField(task.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if nodes := tc.mutation.FilesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -297,6 +756,282 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.BoardTable,
+			Columns: []string{task.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.SprintIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SprintID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.EpicIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.EpicTable,
+			Columns: []string{task.EpicColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EpicID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ChecklistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChecklistItemsTable,
+			Columns: []string{task.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.CustomFieldValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CustomFieldValuesTable,
+			Columns: []string{task.CustomFieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.WorklogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorklogsTable,
+			Columns: []string{task.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.TimersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.TimersTable,
+			Columns: []string{task.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,19 +12,48 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/comment"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
 )
 
 // TaskQuery is the builder for querying Task entities.
 type TaskQuery struct {
 	config
-	ctx        *QueryContext
-	order      []task.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Task
-	withFiles  *FileQuery
+	ctx                   *QueryContext
+	order                 []task.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Task
+	withFiles             *FileQuery
+	withBoard             *BoardQuery
+	withParent            *TaskQuery
+	withChildren          *TaskQuery
+	withBlockedBy         *TaskQuery
+	withBlocks            *TaskQuery
+	withLabels            *LabelQuery
+	withSprint            *SprintQuery
+	withEpic              *EpicQuery
+	withComments          *CommentQuery
+	withActivities        *ActivityQuery
+	withAssignees         *MemberQuery
+	withWatchers          *MemberQuery
+	withChecklistItems    *ChecklistItemQuery
+	withCustomFieldValues *CustomFieldValueQuery
+	withWorklogs          *WorklogQuery
+	withTimers            *TimerQuery
+	withStatusChanges     *StatusChangeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,141 +112,515 @@ func (tq *TaskQuery) QueryFiles() *FileQuery {
 	return query
 }
 
-// First returns the first Task entity from the query.
-// Returns a *NotFoundError when no Task was found.
-func (tq *TaskQuery) First(ctx context.Context) (*Task, error) {
-	nodes, err := tq.Limit(1).All(setContextOp(ctx, tq.ctx, ent.OpQueryFirst))
-	if err != nil {
-		return nil, err
-	}
-	if len(nodes) == 0 {
-		return nil, &NotFoundError{task.Label}
+// QueryBoard chains the current query on the "board" edge.
+func (tq *TaskQuery) QueryBoard() *BoardQuery {
+	query := (&BoardClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(board.Table, board.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.BoardTable, task.BoardColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes[0], nil
+	return query
 }
 
-// FirstX is like First, but panics if an error occurs.
-func (tq *TaskQuery) FirstX(ctx context.Context) *Task {
-	node, err := tq.First(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryParent chains the current query on the "parent" edge.
+func (tq *TaskQuery) QueryParent() *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.ParentTable, task.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// FirstID returns the first Task ID from the query.
-// Returns a *NotFoundError when no Task ID was found.
-func (tq *TaskQuery) FirstID(ctx context.Context) (id string, err error) {
-	var ids []string
-	if ids, err = tq.Limit(1).IDs(setContextOp(ctx, tq.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{task.Label}
-		return
+// QueryChildren chains the current query on the "children" edge.
+func (tq *TaskQuery) QueryChildren() *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ChildrenTable, task.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids[0], nil
+	return query
 }
 
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (tq *TaskQuery) FirstIDX(ctx context.Context) string {
-	id, err := tq.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryBlockedBy chains the current query on the "blocked_by" edge.
+func (tq *TaskQuery) QueryBlockedBy() *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, task.BlockedByTable, task.BlockedByPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// Only returns a single Task entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when more than one Task entity is found.
-// Returns a *NotFoundError when no Task entities are found.
-func (tq *TaskQuery) Only(ctx context.Context) (*Task, error) {
-	nodes, err := tq.Limit(2).All(setContextOp(ctx, tq.ctx, ent.OpQueryOnly))
-	if err != nil {
-		return nil, err
-	}
-	switch len(nodes) {
-	case 1:
-		return nodes[0], nil
-	case 0:
-		return nil, &NotFoundError{task.Label}
-	default:
-		return nil, &NotSingularError{task.Label}
+// QueryBlocks chains the current query on the "blocks" edge.
+func (tq *TaskQuery) QueryBlocks() *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, task.BlocksTable, task.BlocksPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
+	return query
 }
 
-// OnlyX is like Only, but panics if an error occurs.
-func (tq *TaskQuery) OnlyX(ctx context.Context) *Task {
-	node, err := tq.Only(ctx)
-	if err != nil {
-		panic(err)
+// QueryLabels chains the current query on the "labels" edge.
+func (tq *TaskQuery) QueryLabels() *LabelQuery {
+	query := (&LabelClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(label.Table, label.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, task.LabelsTable, task.LabelsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// OnlyID is like Only, but returns the only Task ID in the query.
-// Returns a *NotSingularError when more than one Task ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (tq *TaskQuery) OnlyID(ctx context.Context) (id string, err error) {
-	var ids []string
-	if ids, err = tq.Limit(2).IDs(setContextOp(ctx, tq.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{task.Label}
-	default:
-		err = &NotSingularError{task.Label}
+// QuerySprint chains the current query on the "sprint" edge.
+func (tq *TaskQuery) QuerySprint() *SprintQuery {
+	query := (&SprintClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(sprint.Table, sprint.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.SprintTable, task.SprintColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return
+	return query
 }
 
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (tq *TaskQuery) OnlyIDX(ctx context.Context) string {
-	id, err := tq.OnlyID(ctx)
-	if err != nil {
-		panic(err)
+// QueryEpic chains the current query on the "epic" edge.
+func (tq *TaskQuery) QueryEpic() *EpicQuery {
+	query := (&EpicClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(epic.Table, epic.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.EpicTable, task.EpicColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// All executes the query and returns a list of Tasks.
-func (tq *TaskQuery) All(ctx context.Context) ([]*Task, error) {
-	ctx = setContextOp(ctx, tq.ctx, ent.OpQueryAll)
-	if err := tq.prepareQuery(ctx); err != nil {
-		return nil, err
+// QueryComments chains the current query on the "comments" edge.
+func (tq *TaskQuery) QueryComments() *CommentQuery {
+	query := (&CommentClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.CommentsTable, task.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	qr := querierAll[[]*Task, *TaskQuery]()
-	return withInterceptors[[]*Task](ctx, tq, qr, tq.inters)
+	return query
 }
 
-// AllX is like All, but panics if an error occurs.
-func (tq *TaskQuery) AllX(ctx context.Context) []*Task {
-	nodes, err := tq.All(ctx)
-	if err != nil {
-		panic(err)
+// QueryActivities chains the current query on the "activities" edge.
+func (tq *TaskQuery) QueryActivities() *ActivityQuery {
+	query := (&ActivityClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(activity.Table, activity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ActivitiesTable, task.ActivitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes
+	return query
 }
 
-// IDs executes the query and returns a list of Task IDs.
-func (tq *TaskQuery) IDs(ctx context.Context) (ids []string, err error) {
-	if tq.ctx.Unique == nil && tq.path != nil {
-		tq.Unique(true)
-	}
-	ctx = setContextOp(ctx, tq.ctx, ent.OpQueryIDs)
-	if err = tq.Select(task.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
+// QueryAssignees chains the current query on the "assignees" edge.
+func (tq *TaskQuery) QueryAssignees() *MemberQuery {
+	query := (&MemberClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, task.AssigneesTable, task.AssigneesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids, nil
+	return query
 }
 
-// IDsX is like IDs, but panics if an error occurs.
-func (tq *TaskQuery) IDsX(ctx context.Context) []string {
-	ids, err := tq.IDs(ctx)
-	if err != nil {
+// QueryWatchers chains the current query on the "watchers" edge.
+func (tq *TaskQuery) QueryWatchers() *MemberQuery {
+	query := (&MemberClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, task.WatchersTable, task.WatchersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChecklistItems chains the current query on the "checklist_items" edge.
+func (tq *TaskQuery) QueryChecklistItems() *ChecklistItemQuery {
+	query := (&ChecklistItemClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(checklistitem.Table, checklistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ChecklistItemsTable, task.ChecklistItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCustomFieldValues chains the current query on the "custom_field_values" edge.
+func (tq *TaskQuery) QueryCustomFieldValues() *CustomFieldValueQuery {
+	query := (&CustomFieldValueClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(customfieldvalue.Table, customfieldvalue.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.CustomFieldValuesTable, task.CustomFieldValuesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWorklogs chains the current query on the "worklogs" edge.
+func (tq *TaskQuery) QueryWorklogs() *WorklogQuery {
+	query := (&WorklogClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(worklog.Table, worklog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.WorklogsTable, task.WorklogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTimers chains the current query on the "timers" edge.
+func (tq *TaskQuery) QueryTimers() *TimerQuery {
+	query := (&TimerClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(timer.Table, timer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.TimersTable, task.TimersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStatusChanges chains the current query on the "status_changes" edge.
+func (tq *TaskQuery) QueryStatusChanges() *StatusChangeQuery {
+	query := (&StatusChangeClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(statuschange.Table, statuschange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.StatusChangesTable, task.StatusChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Task entity from the query.
+// Returns a *NotFoundError when no Task was found.
+func (tq *TaskQuery) First(ctx context.Context) (*Task, error) {
+	nodes, err := tq.Limit(1).All(setContextOp(ctx, tq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{task.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tq *TaskQuery) FirstX(ctx context.Context) *Task {
+	node, err := tq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Task ID from the query.
+// Returns a *NotFoundError when no Task ID was found.
+func (tq *TaskQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tq.Limit(1).IDs(setContextOp(ctx, tq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{task.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tq *TaskQuery) FirstIDX(ctx context.Context) string {
+	id, err := tq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Task entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Task entity is found.
+// Returns a *NotFoundError when no Task entities are found.
+func (tq *TaskQuery) Only(ctx context.Context) (*Task, error) {
+	nodes, err := tq.Limit(2).All(setContextOp(ctx, tq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{task.Label}
+	default:
+		return nil, &NotSingularError{task.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tq *TaskQuery) OnlyX(ctx context.Context) *Task {
+	node, err := tq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Task ID in the query.
+// Returns a *NotSingularError when more than one Task ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tq *TaskQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tq.Limit(2).IDs(setContextOp(ctx, tq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{task.Label}
+	default:
+		err = &NotSingularError{task.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tq *TaskQuery) OnlyIDX(ctx context.Context) string {
+	id, err := tq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Tasks.
+func (tq *TaskQuery) All(ctx context.Context) ([]*Task, error) {
+	ctx = setContextOp(ctx, tq.ctx, ent.OpQueryAll)
+	if err := tq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Task, *TaskQuery]()
+	return withInterceptors[[]*Task](ctx, tq, qr, tq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tq *TaskQuery) AllX(ctx context.Context) []*Task {
+	nodes, err := tq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Task IDs.
+func (tq *TaskQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if tq.ctx.Unique == nil && tq.path != nil {
+		tq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tq.ctx, ent.OpQueryIDs)
+	if err = tq.Select(task.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tq *TaskQuery) IDsX(ctx context.Context) []string {
+	ids, err := tq.IDs(ctx)
+	if err != nil {
 		panic(err)
 	}
 	return ids
@@ -270,140 +673,1002 @@ func (tq *TaskQuery) Clone() *TaskQuery {
 		return nil
 	}
 	return &TaskQuery{
-		config:     tq.config,
-		ctx:        tq.ctx.Clone(),
-		order:      append([]task.OrderOption{}, tq.order...),
-		inters:     append([]Interceptor{}, tq.inters...),
-		predicates: append([]predicate.Task{}, tq.predicates...),
-		withFiles:  tq.withFiles.Clone(),
+		config:                tq.config,
+		ctx:                   tq.ctx.Clone(),
+		order:                 append([]task.OrderOption{}, tq.order...),
+		inters:                append([]Interceptor{}, tq.inters...),
+		predicates:            append([]predicate.Task{}, tq.predicates...),
+		withFiles:             tq.withFiles.Clone(),
+		withBoard:             tq.withBoard.Clone(),
+		withParent:            tq.withParent.Clone(),
+		withChildren:          tq.withChildren.Clone(),
+		withBlockedBy:         tq.withBlockedBy.Clone(),
+		withBlocks:            tq.withBlocks.Clone(),
+		withLabels:            tq.withLabels.Clone(),
+		withSprint:            tq.withSprint.Clone(),
+		withEpic:              tq.withEpic.Clone(),
+		withComments:          tq.withComments.Clone(),
+		withActivities:        tq.withActivities.Clone(),
+		withAssignees:         tq.withAssignees.Clone(),
+		withWatchers:          tq.withWatchers.Clone(),
+		withChecklistItems:    tq.withChecklistItems.Clone(),
+		withCustomFieldValues: tq.withCustomFieldValues.Clone(),
+		withWorklogs:          tq.withWorklogs.Clone(),
+		withTimers:            tq.withTimers.Clone(),
+		withStatusChanges:     tq.withStatusChanges.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
 		path: tq.path,
 	}
 }
-
-// WithFiles tells the query-builder to eager-load the nodes that are connected to
-// the "files" edge. The optional arguments are used to configure the query builder of the edge.
-func (tq *TaskQuery) WithFiles(opts ...func(*FileQuery)) *TaskQuery {
-	query := (&FileClient{config: tq.config}).Query()
-	for _, opt := range opts {
-		opt(query)
+
+// WithFiles tells the query-builder to eager-load the nodes that are connected to
+// the "files" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithFiles(opts ...func(*FileQuery)) *TaskQuery {
+	query := (&FileClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withFiles = query
+	return tq
+}
+
+// WithBoard tells the query-builder to eager-load the nodes that are connected to
+// the "board" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithBoard(opts ...func(*BoardQuery)) *TaskQuery {
+	query := (&BoardClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withBoard = query
+	return tq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithParent(opts ...func(*TaskQuery)) *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withParent = query
+	return tq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithChildren(opts ...func(*TaskQuery)) *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withChildren = query
+	return tq
+}
+
+// WithBlockedBy tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithBlockedBy(opts ...func(*TaskQuery)) *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withBlockedBy = query
+	return tq
+}
+
+// WithBlocks tells the query-builder to eager-load the nodes that are connected to
+// the "blocks" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithBlocks(opts ...func(*TaskQuery)) *TaskQuery {
+	query := (&TaskClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withBlocks = query
+	return tq
+}
+
+// WithLabels tells the query-builder to eager-load the nodes that are connected to
+// the "labels" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithLabels(opts ...func(*LabelQuery)) *TaskQuery {
+	query := (&LabelClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withLabels = query
+	return tq
+}
+
+// WithSprint tells the query-builder to eager-load the nodes that are connected to
+// the "sprint" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithSprint(opts ...func(*SprintQuery)) *TaskQuery {
+	query := (&SprintClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withSprint = query
+	return tq
+}
+
+// WithEpic tells the query-builder to eager-load the nodes that are connected to
+// the "epic" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithEpic(opts ...func(*EpicQuery)) *TaskQuery {
+	query := (&EpicClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withEpic = query
+	return tq
+}
+
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithComments(opts ...func(*CommentQuery)) *TaskQuery {
+	query := (&CommentClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withComments = query
+	return tq
+}
+
+// WithActivities tells the query-builder to eager-load the nodes that are connected to
+// the "activities" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithActivities(opts ...func(*ActivityQuery)) *TaskQuery {
+	query := (&ActivityClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withActivities = query
+	return tq
+}
+
+// WithAssignees tells the query-builder to eager-load the nodes that are connected to
+// the "assignees" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithAssignees(opts ...func(*MemberQuery)) *TaskQuery {
+	query := (&MemberClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withAssignees = query
+	return tq
+}
+
+// WithWatchers tells the query-builder to eager-load the nodes that are connected to
+// the "watchers" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithWatchers(opts ...func(*MemberQuery)) *TaskQuery {
+	query := (&MemberClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withWatchers = query
+	return tq
+}
+
+// WithChecklistItems tells the query-builder to eager-load the nodes that are connected to
+// the "checklist_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithChecklistItems(opts ...func(*ChecklistItemQuery)) *TaskQuery {
+	query := (&ChecklistItemClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withChecklistItems = query
+	return tq
+}
+
+// WithCustomFieldValues tells the query-builder to eager-load the nodes that are connected to
+// the "custom_field_values" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithCustomFieldValues(opts ...func(*CustomFieldValueQuery)) *TaskQuery {
+	query := (&CustomFieldValueClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withCustomFieldValues = query
+	return tq
+}
+
+// WithWorklogs tells the query-builder to eager-load the nodes that are connected to
+// the "worklogs" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithWorklogs(opts ...func(*WorklogQuery)) *TaskQuery {
+	query := (&WorklogClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withWorklogs = query
+	return tq
+}
+
+// WithTimers tells the query-builder to eager-load the nodes that are connected to
+// the "timers" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithTimers(opts ...func(*TimerQuery)) *TaskQuery {
+	query := (&TimerClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withTimers = query
+	return tq
+}
+
+// WithStatusChanges tells the query-builder to eager-load the nodes that are connected to
+// the "status_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithStatusChanges(opts ...func(*StatusChangeQuery)) *TaskQuery {
+	query := (&StatusChangeClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withStatusChanges = query
+	return tq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Task.Query().
+//		GroupBy(task.FieldBoardID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tq *TaskQuery) GroupBy(field string, fields ...string) *TaskGroupBy {
+	tq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskGroupBy{build: tq}
+	grbuild.flds = &tq.ctx.Fields
+	grbuild.label = task.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BoardID string `json:"board_id,omitempty"`
+//	}
+//
+//	client.Task.Query().
+//		Select(task.FieldBoardID).
+//		Scan(ctx, &v)
+func (tq *TaskQuery) Select(fields ...string) *TaskSelect {
+	tq.ctx.Fields = append(tq.ctx.Fields, fields...)
+	sbuild := &TaskSelect{TaskQuery: tq}
+	sbuild.label = task.Label
+	sbuild.flds, sbuild.scan = &tq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskSelect configured with the given aggregations.
+func (tq *TaskQuery) Aggregate(fns ...AggregateFunc) *TaskSelect {
+	return tq.Select().Aggregate(fns...)
+}
+
+func (tq *TaskQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tq.ctx.Fields {
+		if !task.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tq.path != nil {
+		prev, err := tq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tq.sql = prev
+	}
+	return nil
+}
+
+func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, error) {
+	var (
+		nodes       = []*Task{}
+		_spec       = tq.querySpec()
+		loadedTypes = [18]bool{
+			tq.withFiles != nil,
+			tq.withBoard != nil,
+			tq.withParent != nil,
+			tq.withChildren != nil,
+			tq.withBlockedBy != nil,
+			tq.withBlocks != nil,
+			tq.withLabels != nil,
+			tq.withSprint != nil,
+			tq.withEpic != nil,
+			tq.withComments != nil,
+			tq.withActivities != nil,
+			tq.withAssignees != nil,
+			tq.withWatchers != nil,
+			tq.withChecklistItems != nil,
+			tq.withCustomFieldValues != nil,
+			tq.withWorklogs != nil,
+			tq.withTimers != nil,
+			tq.withStatusChanges != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Task).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Task{config: tq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tq.withFiles; query != nil {
+		if err := tq.loadFiles(ctx, query, nodes,
+			func(n *Task) { n.Edges.Files = []*File{} },
+			func(n *Task, e *File) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withBoard; query != nil {
+		if err := tq.loadBoard(ctx, query, nodes, nil,
+			func(n *Task, e *Board) { n.Edges.Board = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withParent; query != nil {
+		if err := tq.loadParent(ctx, query, nodes, nil,
+			func(n *Task, e *Task) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withChildren; query != nil {
+		if err := tq.loadChildren(ctx, query, nodes,
+			func(n *Task) { n.Edges.Children = []*Task{} },
+			func(n *Task, e *Task) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withBlockedBy; query != nil {
+		if err := tq.loadBlockedBy(ctx, query, nodes,
+			func(n *Task) { n.Edges.BlockedBy = []*Task{} },
+			func(n *Task, e *Task) { n.Edges.BlockedBy = append(n.Edges.BlockedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withBlocks; query != nil {
+		if err := tq.loadBlocks(ctx, query, nodes,
+			func(n *Task) { n.Edges.Blocks = []*Task{} },
+			func(n *Task, e *Task) { n.Edges.Blocks = append(n.Edges.Blocks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withLabels; query != nil {
+		if err := tq.loadLabels(ctx, query, nodes,
+			func(n *Task) { n.Edges.Labels = []*Label{} },
+			func(n *Task, e *Label) { n.Edges.Labels = append(n.Edges.Labels, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withSprint; query != nil {
+		if err := tq.loadSprint(ctx, query, nodes, nil,
+			func(n *Task, e *Sprint) { n.Edges.Sprint = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withEpic; query != nil {
+		if err := tq.loadEpic(ctx, query, nodes, nil,
+			func(n *Task, e *Epic) { n.Edges.Epic = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withComments; query != nil {
+		if err := tq.loadComments(ctx, query, nodes,
+			func(n *Task) { n.Edges.Comments = []*Comment{} },
+			func(n *Task, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withActivities; query != nil {
+		if err := tq.loadActivities(ctx, query, nodes,
+			func(n *Task) { n.Edges.Activities = []*Activity{} },
+			func(n *Task, e *Activity) { n.Edges.Activities = append(n.Edges.Activities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withAssignees; query != nil {
+		if err := tq.loadAssignees(ctx, query, nodes,
+			func(n *Task) { n.Edges.Assignees = []*Member{} },
+			func(n *Task, e *Member) { n.Edges.Assignees = append(n.Edges.Assignees, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withWatchers; query != nil {
+		if err := tq.loadWatchers(ctx, query, nodes,
+			func(n *Task) { n.Edges.Watchers = []*Member{} },
+			func(n *Task, e *Member) { n.Edges.Watchers = append(n.Edges.Watchers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withChecklistItems; query != nil {
+		if err := tq.loadChecklistItems(ctx, query, nodes,
+			func(n *Task) { n.Edges.ChecklistItems = []*ChecklistItem{} },
+			func(n *Task, e *ChecklistItem) { n.Edges.ChecklistItems = append(n.Edges.ChecklistItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withCustomFieldValues; query != nil {
+		if err := tq.loadCustomFieldValues(ctx, query, nodes,
+			func(n *Task) { n.Edges.CustomFieldValues = []*CustomFieldValue{} },
+			func(n *Task, e *CustomFieldValue) { n.Edges.CustomFieldValues = append(n.Edges.CustomFieldValues, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withWorklogs; query != nil {
+		if err := tq.loadWorklogs(ctx, query, nodes,
+			func(n *Task) { n.Edges.Worklogs = []*Worklog{} },
+			func(n *Task, e *Worklog) { n.Edges.Worklogs = append(n.Edges.Worklogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withTimers; query != nil {
+		if err := tq.loadTimers(ctx, query, nodes,
+			func(n *Task) { n.Edges.Timers = []*Timer{} },
+			func(n *Task, e *Timer) { n.Edges.Timers = append(n.Edges.Timers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withStatusChanges; query != nil {
+		if err := tq.loadStatusChanges(ctx, query, nodes,
+			func(n *Task) { n.Edges.StatusChanges = []*StatusChange{} },
+			func(n *Task, e *StatusChange) { n.Edges.StatusChanges = append(n.Edges.StatusChanges, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tq *TaskQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*Task, init func(*Task), assign func(*Task, *File)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Task)
+	nids := make(map[string]map[*Task]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(task.FilesTable)
+		s.Join(joinT).On(s.C(file.FieldID), joinT.C(task.FilesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(task.FilesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(task.FilesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Task]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*File](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "files" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (tq *TaskQuery) loadBoard(ctx context.Context, query *BoardQuery, nodes []*Task, init func(*Task), assign func(*Task, *Board)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Task)
+	for i := range nodes {
+		if nodes[i].BoardID == nil {
+			continue
+		}
+		fk := *nodes[i].BoardID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(board.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "board_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TaskQuery) loadParent(ctx context.Context, query *TaskQuery, nodes []*Task, init func(*Task), assign func(*Task, *Task)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Task)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TaskQuery) loadChildren(ctx context.Context, query *TaskQuery, nodes []*Task, init func(*Task), assign func(*Task, *Task)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(task.FieldParentID)
+	}
+	query.Where(predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TaskQuery) loadBlockedBy(ctx context.Context, query *TaskQuery, nodes []*Task, init func(*Task), assign func(*Task, *Task)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Task)
+	nids := make(map[string]map[*Task]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(task.BlockedByTable)
+		s.Join(joinT).On(s.C(task.FieldID), joinT.C(task.BlockedByPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(task.BlockedByPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(task.BlockedByPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Task]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Task](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocked_by" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (tq *TaskQuery) loadBlocks(ctx context.Context, query *TaskQuery, nodes []*Task, init func(*Task), assign func(*Task, *Task)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Task)
+	nids := make(map[string]map[*Task]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(task.BlocksTable)
+		s.Join(joinT).On(s.C(task.FieldID), joinT.C(task.BlocksPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(task.BlocksPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(task.BlocksPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Task]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Task](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blocks" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (tq *TaskQuery) loadLabels(ctx context.Context, query *LabelQuery, nodes []*Task, init func(*Task), assign func(*Task, *Label)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Task)
+	nids := make(map[string]map[*Task]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(task.LabelsTable)
+		s.Join(joinT).On(s.C(label.FieldID), joinT.C(task.LabelsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(task.LabelsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(task.LabelsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Task]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Label](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "labels" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (tq *TaskQuery) loadSprint(ctx context.Context, query *SprintQuery, nodes []*Task, init func(*Task), assign func(*Task, *Sprint)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Task)
+	for i := range nodes {
+		if nodes[i].SprintID == nil {
+			continue
+		}
+		fk := *nodes[i].SprintID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sprint.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sprint_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TaskQuery) loadEpic(ctx context.Context, query *EpicQuery, nodes []*Task, init func(*Task), assign func(*Task, *Epic)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Task)
+	for i := range nodes {
+		if nodes[i].EpicID == nil {
+			continue
+		}
+		fk := *nodes[i].EpicID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	tq.withFiles = query
-	return tq
-}
-
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		Title string `json:"title,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.Task.Query().
-//		GroupBy(task.FieldTitle).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (tq *TaskQuery) GroupBy(field string, fields ...string) *TaskGroupBy {
-	tq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &TaskGroupBy{build: tq}
-	grbuild.flds = &tq.ctx.Fields
-	grbuild.label = task.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
-}
-
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
-//
-//	var v []struct {
-//		Title string `json:"title,omitempty"`
-//	}
-//
-//	client.Task.Query().
-//		Select(task.FieldTitle).
-//		Scan(ctx, &v)
-func (tq *TaskQuery) Select(fields ...string) *TaskSelect {
-	tq.ctx.Fields = append(tq.ctx.Fields, fields...)
-	sbuild := &TaskSelect{TaskQuery: tq}
-	sbuild.label = task.Label
-	sbuild.flds, sbuild.scan = &tq.ctx.Fields, sbuild.Scan
-	return sbuild
-}
-
-// Aggregate returns a TaskSelect configured with the given aggregations.
-func (tq *TaskQuery) Aggregate(fns ...AggregateFunc) *TaskSelect {
-	return tq.Select().Aggregate(fns...)
-}
-
-func (tq *TaskQuery) prepareQuery(ctx context.Context) error {
-	for _, inter := range tq.inters {
-		if inter == nil {
-			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(epic.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "epic_id" returned %v`, n.ID)
 		}
-		if trv, ok := inter.(Traverser); ok {
-			if err := trv.Traverse(ctx, tq); err != nil {
-				return err
-			}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
 	}
-	for _, f := range tq.ctx.Fields {
-		if !task.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+	return nil
+}
+func (tq *TaskQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Task, init func(*Task), assign func(*Task, *Comment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if tq.path != nil {
-		prev, err := tq.path(ctx)
-		if err != nil {
-			return err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(comment.FieldTaskID)
+	}
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
 		}
-		tq.sql = prev
+		assign(node, n)
 	}
 	return nil
 }
-
-func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, error) {
-	var (
-		nodes       = []*Task{}
-		_spec       = tq.querySpec()
-		loadedTypes = [1]bool{
-			tq.withFiles != nil,
+func (tq *TaskQuery) loadActivities(ctx context.Context, query *ActivityQuery, nodes []*Task, init func(*Task), assign func(*Task, *Activity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-	)
-	_spec.ScanValues = func(columns []string) ([]any, error) {
-		return (*Task).scanValues(nil, columns)
 	}
-	_spec.Assign = func(columns []string, values []any) error {
-		node := &Task{config: tq.config}
-		nodes = append(nodes, node)
-		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(columns, values)
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(activity.FieldTaskID)
 	}
-	for i := range hooks {
-		hooks[i](ctx, _spec)
+	query.Where(predicate.Activity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.ActivitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if err := sqlgraph.QueryNodes(ctx, tq.driver, _spec); err != nil {
-		return nil, err
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
 	}
-	if len(nodes) == 0 {
-		return nodes, nil
+	return nil
+}
+func (tq *TaskQuery) loadAssignees(ctx context.Context, query *MemberQuery, nodes []*Task, init func(*Task), assign func(*Task, *Member)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*Task)
+	nids := make(map[string]map[*Task]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
 	}
-	if query := tq.withFiles; query != nil {
-		if err := tq.loadFiles(ctx, query, nodes,
-			func(n *Task) { n.Edges.Files = []*File{} },
-			func(n *Task, e *File) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
-			return nil, err
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(task.AssigneesTable)
+		s.Join(joinT).On(s.C(member.FieldID), joinT.C(task.AssigneesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(task.AssigneesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(task.AssigneesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Task]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Member](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "assignees" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
 		}
 	}
-	return nodes, nil
+	return nil
 }
-
-func (tq *TaskQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*Task, init func(*Task), assign func(*Task, *File)) error {
+func (tq *TaskQuery) loadWatchers(ctx context.Context, query *MemberQuery, nodes []*Task, init func(*Task), assign func(*Task, *Member)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[string]*Task)
 	nids := make(map[string]map[*Task]struct{})
@@ -415,11 +1680,11 @@ func (tq *TaskQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*T
 		}
 	}
 	query.Where(func(s *sql.Selector) {
-		joinT := sql.Table(task.FilesTable)
-		s.Join(joinT).On(s.C(file.FieldID), joinT.C(task.FilesPrimaryKey[1]))
-		s.Where(sql.InValues(joinT.C(task.FilesPrimaryKey[0]), edgeIDs...))
+		joinT := sql.Table(task.WatchersTable)
+		s.Join(joinT).On(s.C(member.FieldID), joinT.C(task.WatchersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(task.WatchersPrimaryKey[0]), edgeIDs...))
 		columns := s.SelectedColumns()
-		s.Select(joinT.C(task.FilesPrimaryKey[0]))
+		s.Select(joinT.C(task.WatchersPrimaryKey[0]))
 		s.AppendSelect(columns...)
 		s.SetDistinct(false)
 	})
@@ -449,14 +1714,14 @@ func (tq *TaskQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*T
 			}
 		})
 	})
-	neighbors, err := withInterceptors[[]*File](ctx, query, qr, query.inters)
+	neighbors, err := withInterceptors[[]*Member](ctx, query, qr, query.inters)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
 		nodes, ok := nids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected "files" node returned %v`, n.ID)
+			return fmt.Errorf(`unexpected "watchers" node returned %v`, n.ID)
 		}
 		for kn := range nodes {
 			assign(kn, n)
@@ -464,6 +1729,156 @@ func (tq *TaskQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*T
 	}
 	return nil
 }
+func (tq *TaskQuery) loadChecklistItems(ctx context.Context, query *ChecklistItemQuery, nodes []*Task, init func(*Task), assign func(*Task, *ChecklistItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(checklistitem.FieldTaskID)
+	}
+	query.Where(predicate.ChecklistItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.ChecklistItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TaskQuery) loadCustomFieldValues(ctx context.Context, query *CustomFieldValueQuery, nodes []*Task, init func(*Task), assign func(*Task, *CustomFieldValue)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(customfieldvalue.FieldTaskID)
+	}
+	query.Where(predicate.CustomFieldValue(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.CustomFieldValuesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TaskQuery) loadWorklogs(ctx context.Context, query *WorklogQuery, nodes []*Task, init func(*Task), assign func(*Task, *Worklog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(worklog.FieldTaskID)
+	}
+	query.Where(predicate.Worklog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.WorklogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TaskQuery) loadTimers(ctx context.Context, query *TimerQuery, nodes []*Task, init func(*Task), assign func(*Task, *Timer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(timer.FieldTaskID)
+	}
+	query.Where(predicate.Timer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.TimersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TaskQuery) loadStatusChanges(ctx context.Context, query *StatusChangeQuery, nodes []*Task, init func(*Task), assign func(*Task, *StatusChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(statuschange.FieldTaskID)
+	}
+	query.Where(predicate.StatusChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.StatusChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (tq *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
@@ -490,6 +1905,18 @@ func (tq *TaskQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if tq.withBoard != nil {
+			_spec.Node.AddColumnOnce(task.FieldBoardID)
+		}
+		if tq.withParent != nil {
+			_spec.Node.AddColumnOnce(task.FieldParentID)
+		}
+		if tq.withSprint != nil {
+			_spec.Node.AddColumnOnce(task.FieldSprintID)
+		}
+		if tq.withEpic != nil {
+			_spec.Node.AddColumnOnce(task.FieldEpicID)
+		}
 	}
 	if ps := tq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
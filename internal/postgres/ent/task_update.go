@@ -11,9 +11,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/comment"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
 )
 
 // TaskUpdate is the builder for updating Task entities.
@@ -29,6 +41,86 @@ func (tu *TaskUpdate) Where(ps ...predicate.Task) *TaskUpdate {
 	return tu
 }
 
+// SetBoardID sets the "board_id" field.
+func (tu *TaskUpdate) SetBoardID(s string) *TaskUpdate {
+	tu.mutation.SetBoardID(s)
+	return tu
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableBoardID(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetBoardID(*s)
+	}
+	return tu
+}
+
+// ClearBoardID clears the value of the "board_id" field.
+func (tu *TaskUpdate) ClearBoardID() *TaskUpdate {
+	tu.mutation.ClearBoardID()
+	return tu
+}
+
+// SetParentID sets the "parent_id" field.
+func (tu *TaskUpdate) SetParentID(s string) *TaskUpdate {
+	tu.mutation.SetParentID(s)
+	return tu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableParentID(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetParentID(*s)
+	}
+	return tu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tu *TaskUpdate) ClearParentID() *TaskUpdate {
+	tu.mutation.ClearParentID()
+	return tu
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (tu *TaskUpdate) SetSprintID(s string) *TaskUpdate {
+	tu.mutation.SetSprintID(s)
+	return tu
+}
+
+// SetNillableSprintID sets the "sprint_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableSprintID(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetSprintID(*s)
+	}
+	return tu
+}
+
+// ClearSprintID clears the value of the "sprint_id" field.
+func (tu *TaskUpdate) ClearSprintID() *TaskUpdate {
+	tu.mutation.ClearSprintID()
+	return tu
+}
+
+// SetEpicID sets the "epic_id" field.
+func (tu *TaskUpdate) SetEpicID(s string) *TaskUpdate {
+	tu.mutation.SetEpicID(s)
+	return tu
+}
+
+// SetNillableEpicID sets the "epic_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableEpicID(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetEpicID(*s)
+	}
+	return tu
+}
+
+// ClearEpicID clears the value of the "epic_id" field.
+func (tu *TaskUpdate) ClearEpicID() *TaskUpdate {
+	tu.mutation.ClearEpicID()
+	return tu
+}
+
 // SetTitle sets the "title" field.
 func (tu *TaskUpdate) SetTitle(s string) *TaskUpdate {
 	tu.mutation.SetTitle(s)
@@ -83,6 +175,26 @@ func (tu *TaskUpdate) ClearDueDate() *TaskUpdate {
 	return tu
 }
 
+// SetAssigneeID sets the "assignee_id" field.
+func (tu *TaskUpdate) SetAssigneeID(s string) *TaskUpdate {
+	tu.mutation.SetAssigneeID(s)
+	return tu
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableAssigneeID(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetAssigneeID(*s)
+	}
+	return tu
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (tu *TaskUpdate) ClearAssigneeID() *TaskUpdate {
+	tu.mutation.ClearAssigneeID()
+	return tu
+}
+
 // SetAssigneeName sets the "assignee_name" field.
 func (tu *TaskUpdate) SetAssigneeName(s string) *TaskUpdate {
 	tu.mutation.SetAssigneeName(s)
@@ -143,20 +255,182 @@ func (tu *TaskUpdate) ClearCompletedAt() *TaskUpdate {
 	return tu
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (tu *TaskUpdate) SetArchivedAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetArchivedAt(t)
+	return tu
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableArchivedAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetArchivedAt(*t)
+	}
+	return tu
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (tu *TaskUpdate) ClearArchivedAt() *TaskUpdate {
+	tu.mutation.ClearArchivedAt()
+	return tu
+}
+
 // SetStatus sets the "status" field.
-func (tu *TaskUpdate) SetStatus(t task.Status) *TaskUpdate {
-	tu.mutation.SetStatus(t)
+func (tu *TaskUpdate) SetStatus(s string) *TaskUpdate {
+	tu.mutation.SetStatus(s)
 	return tu
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (tu *TaskUpdate) SetNillableStatus(t *task.Status) *TaskUpdate {
+func (tu *TaskUpdate) SetNillableStatus(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetStatus(*s)
+	}
+	return tu
+}
+
+// SetRank sets the "rank" field.
+func (tu *TaskUpdate) SetRank(s string) *TaskUpdate {
+	tu.mutation.SetRank(s)
+	return tu
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRank(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetRank(*s)
+	}
+	return tu
+}
+
+// SetPriority sets the "priority" field.
+func (tu *TaskUpdate) SetPriority(t task.Priority) *TaskUpdate {
+	tu.mutation.SetPriority(t)
+	return tu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePriority(t *task.Priority) *TaskUpdate {
+	if t != nil {
+		tu.SetPriority(*t)
+	}
+	return tu
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (tu *TaskUpdate) ClearPriority() *TaskUpdate {
+	tu.mutation.ClearPriority()
+	return tu
+}
+
+// SetStoryPoints sets the "story_points" field.
+func (tu *TaskUpdate) SetStoryPoints(i int) *TaskUpdate {
+	tu.mutation.ResetStoryPoints()
+	tu.mutation.SetStoryPoints(i)
+	return tu
+}
+
+// SetNillableStoryPoints sets the "story_points" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableStoryPoints(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetStoryPoints(*i)
+	}
+	return tu
+}
+
+// AddStoryPoints adds i to the "story_points" field.
+func (tu *TaskUpdate) AddStoryPoints(i int) *TaskUpdate {
+	tu.mutation.AddStoryPoints(i)
+	return tu
+}
+
+// ClearStoryPoints clears the value of the "story_points" field.
+func (tu *TaskUpdate) ClearStoryPoints() *TaskUpdate {
+	tu.mutation.ClearStoryPoints()
+	return tu
+}
+
+// SetWipOverrideBy sets the "wip_override_by" field.
+func (tu *TaskUpdate) SetWipOverrideBy(s string) *TaskUpdate {
+	tu.mutation.SetWipOverrideBy(s)
+	return tu
+}
+
+// SetNillableWipOverrideBy sets the "wip_override_by" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableWipOverrideBy(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetWipOverrideBy(*s)
+	}
+	return tu
+}
+
+// ClearWipOverrideBy clears the value of the "wip_override_by" field.
+func (tu *TaskUpdate) ClearWipOverrideBy() *TaskUpdate {
+	tu.mutation.ClearWipOverrideBy()
+	return tu
+}
+
+// SetWipOverrideStatus sets the "wip_override_status" field.
+func (tu *TaskUpdate) SetWipOverrideStatus(s string) *TaskUpdate {
+	tu.mutation.SetWipOverrideStatus(s)
+	return tu
+}
+
+// SetNillableWipOverrideStatus sets the "wip_override_status" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableWipOverrideStatus(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetWipOverrideStatus(*s)
+	}
+	return tu
+}
+
+// ClearWipOverrideStatus clears the value of the "wip_override_status" field.
+func (tu *TaskUpdate) ClearWipOverrideStatus() *TaskUpdate {
+	tu.mutation.ClearWipOverrideStatus()
+	return tu
+}
+
+// SetWipOverrideAt sets the "wip_override_at" field.
+func (tu *TaskUpdate) SetWipOverrideAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetWipOverrideAt(t)
+	return tu
+}
+
+// SetNillableWipOverrideAt sets the "wip_override_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableWipOverrideAt(t *time.Time) *TaskUpdate {
 	if t != nil {
-		tu.SetStatus(*t)
+		tu.SetWipOverrideAt(*t)
 	}
 	return tu
 }
 
+// ClearWipOverrideAt clears the value of the "wip_override_at" field.
+func (tu *TaskUpdate) ClearWipOverrideAt() *TaskUpdate {
+	tu.mutation.ClearWipOverrideAt()
+	return tu
+}
+
+// SetVersion sets the "version" field.
+func (tu *TaskUpdate) SetVersion(i int) *TaskUpdate {
+	tu.mutation.ResetVersion()
+	tu.mutation.SetVersion(i)
+	return tu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableVersion(i *int) *TaskUpdate {
+	if i != nil {
+		tu.SetVersion(*i)
+	}
+	return tu
+}
+
+// AddVersion adds i to the "version" field.
+func (tu *TaskUpdate) AddVersion(i int) *TaskUpdate {
+	tu.mutation.AddVersion(i)
+	return tu
+}
+
 // AddFileIDs adds the "files" edge to the File entity by IDs.
 func (tu *TaskUpdate) AddFileIDs(ids ...string) *TaskUpdate {
 	tu.mutation.AddFileIDs(ids...)
@@ -172,6 +446,221 @@ func (tu *TaskUpdate) AddFiles(f ...*File) *TaskUpdate {
 	return tu.AddFileIDs(ids...)
 }
 
+// SetBoard sets the "board" edge to the Board entity.
+func (tu *TaskUpdate) SetBoard(b *Board) *TaskUpdate {
+	return tu.SetBoardID(b.ID)
+}
+
+// SetParent sets the "parent" edge to the Task entity.
+func (tu *TaskUpdate) SetParent(t *Task) *TaskUpdate {
+	return tu.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by IDs.
+func (tu *TaskUpdate) AddChildIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddChildIDs(ids...)
+	return tu
+}
+
+// AddChildren adds the "children" edges to the Task entity.
+func (tu *TaskUpdate) AddChildren(t ...*Task) *TaskUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddChildIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Task entity by IDs.
+func (tu *TaskUpdate) AddBlockedByIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddBlockedByIDs(ids...)
+	return tu
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Task entity.
+func (tu *TaskUpdate) AddBlockedBy(t ...*Task) *TaskUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddBlockedByIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Task entity by IDs.
+func (tu *TaskUpdate) AddBlockIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddBlockIDs(ids...)
+	return tu
+}
+
+// AddBlocks adds the "blocks" edges to the Task entity.
+func (tu *TaskUpdate) AddBlocks(t ...*Task) *TaskUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddBlockIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (tu *TaskUpdate) AddLabelIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddLabelIDs(ids...)
+	return tu
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (tu *TaskUpdate) AddLabels(l ...*Label) *TaskUpdate {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tu.AddLabelIDs(ids...)
+}
+
+// SetSprint sets the "sprint" edge to the Sprint entity.
+func (tu *TaskUpdate) SetSprint(s *Sprint) *TaskUpdate {
+	return tu.SetSprintID(s.ID)
+}
+
+// SetEpic sets the "epic" edge to the Epic entity.
+func (tu *TaskUpdate) SetEpic(e *Epic) *TaskUpdate {
+	return tu.SetEpicID(e.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tu *TaskUpdate) AddCommentIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddCommentIDs(ids...)
+	return tu
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tu *TaskUpdate) AddComments(c ...*Comment) *TaskUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.AddCommentIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the Activity entity by IDs.
+func (tu *TaskUpdate) AddActivityIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddActivityIDs(ids...)
+	return tu
+}
+
+// AddActivities adds the "activities" edges to the Activity entity.
+func (tu *TaskUpdate) AddActivities(a ...*Activity) *TaskUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.AddActivityIDs(ids...)
+}
+
+// AddAssigneeIDs adds the "assignees" edge to the Member entity by IDs.
+func (tu *TaskUpdate) AddAssigneeIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddAssigneeIDs(ids...)
+	return tu
+}
+
+// AddAssignees adds the "assignees" edges to the Member entity.
+func (tu *TaskUpdate) AddAssignees(m ...*Member) *TaskUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tu.AddAssigneeIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the Member entity by IDs.
+func (tu *TaskUpdate) AddWatcherIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddWatcherIDs(ids...)
+	return tu
+}
+
+// AddWatchers adds the "watchers" edges to the Member entity.
+func (tu *TaskUpdate) AddWatchers(m ...*Member) *TaskUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tu.AddWatcherIDs(ids...)
+}
+
+// AddChecklistItemIDs adds the "checklist_items" edge to the ChecklistItem entity by IDs.
+func (tu *TaskUpdate) AddChecklistItemIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddChecklistItemIDs(ids...)
+	return tu
+}
+
+// AddChecklistItems adds the "checklist_items" edges to the ChecklistItem entity.
+func (tu *TaskUpdate) AddChecklistItems(c ...*ChecklistItem) *TaskUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.AddChecklistItemIDs(ids...)
+}
+
+// AddCustomFieldValueIDs adds the "custom_field_values" edge to the CustomFieldValue entity by IDs.
+func (tu *TaskUpdate) AddCustomFieldValueIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddCustomFieldValueIDs(ids...)
+	return tu
+}
+
+// AddCustomFieldValues adds the "custom_field_values" edges to the CustomFieldValue entity.
+func (tu *TaskUpdate) AddCustomFieldValues(c ...*CustomFieldValue) *TaskUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.AddCustomFieldValueIDs(ids...)
+}
+
+// AddWorklogIDs adds the "worklogs" edge to the Worklog entity by IDs.
+func (tu *TaskUpdate) AddWorklogIDs(ids ...string) *TaskUpdate {
+	tu.mutation.AddWorklogIDs(ids...)
+	return tu
+}
+
+// AddWorklogs adds the "worklogs" edges to the Worklog entity.
+func (tu *TaskUpdate) AddWorklogs(w ...*Worklog) *TaskUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.AddWorklogIDs(ids...)
+}
+
+// AddTimerIDs adds the "timers" edge to the Timer entity by IDs.
+func (tu *TaskUpdate) AddTimerIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddTimerIDs(ids...)
+	return tu
+}
+
+// AddTimers adds the "timers" edges to the Timer entity.
+func (tu *TaskUpdate) AddTimers(t ...*Timer) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTimerIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the StatusChange entity by IDs.
+func (tu *TaskUpdate) AddStatusChangeIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddStatusChangeIDs(ids...)
+	return tu
+}
+
+// AddStatusChanges adds the "status_changes" edges to the StatusChange entity.
+func (tu *TaskUpdate) AddStatusChanges(s ...*StatusChange) *TaskUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.AddStatusChangeIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tu *TaskUpdate) Mutation() *TaskMutation {
 	return tu.mutation
@@ -198,47 +687,344 @@ func (tu *TaskUpdate) RemoveFiles(f ...*File) *TaskUpdate {
 	return tu.RemoveFileIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
-	tu.defaults()
-	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+// ClearBoard clears the "board" edge to the Board entity.
+func (tu *TaskUpdate) ClearBoard() *TaskUpdate {
+	tu.mutation.ClearBoard()
+	return tu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (tu *TaskUpdate) SaveX(ctx context.Context) int {
-	affected, err := tu.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// ClearParent clears the "parent" edge to the Task entity.
+func (tu *TaskUpdate) ClearParent() *TaskUpdate {
+	tu.mutation.ClearParent()
+	return tu
 }
 
-// Exec executes the query.
-func (tu *TaskUpdate) Exec(ctx context.Context) error {
-	_, err := tu.Save(ctx)
-	return err
+// ClearChildren clears all "children" edges to the Task entity.
+func (tu *TaskUpdate) ClearChildren() *TaskUpdate {
+	tu.mutation.ClearChildren()
+	return tu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (tu *TaskUpdate) ExecX(ctx context.Context) {
-	if err := tu.Exec(ctx); err != nil {
-		panic(err)
-	}
+// RemoveChildIDs removes the "children" edge to Task entities by IDs.
+func (tu *TaskUpdate) RemoveChildIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveChildIDs(ids...)
+	return tu
 }
 
-// defaults sets the default values of the builder before save.
-func (tu *TaskUpdate) defaults() {
-	if _, ok := tu.mutation.UpdatedAt(); !ok {
-		v := task.UpdateDefaultUpdatedAt()
-		tu.mutation.SetUpdatedAt(v)
+// RemoveChildren removes "children" edges to Task entities.
+func (tu *TaskUpdate) RemoveChildren(t ...*Task) *TaskUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveChildIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Task entity.
+func (tu *TaskUpdate) ClearBlockedBy() *TaskUpdate {
+	tu.mutation.ClearBlockedBy()
+	return tu
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to Task entities by IDs.
+func (tu *TaskUpdate) RemoveBlockedByIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveBlockedByIDs(ids...)
+	return tu
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to Task entities.
+func (tu *TaskUpdate) RemoveBlockedBy(t ...*Task) *TaskUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveBlockedByIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the Task entity.
+func (tu *TaskUpdate) ClearBlocks() *TaskUpdate {
+	tu.mutation.ClearBlocks()
+	return tu
+}
+
+// RemoveBlockIDs removes the "blocks" edge to Task entities by IDs.
+func (tu *TaskUpdate) RemoveBlockIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveBlockIDs(ids...)
+	return tu
+}
+
+// RemoveBlocks removes "blocks" edges to Task entities.
+func (tu *TaskUpdate) RemoveBlocks(t ...*Task) *TaskUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveBlockIDs(ids...)
+}
+
+// ClearLabels clears all "labels" edges to the Label entity.
+func (tu *TaskUpdate) ClearLabels() *TaskUpdate {
+	tu.mutation.ClearLabels()
+	return tu
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (tu *TaskUpdate) RemoveLabelIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveLabelIDs(ids...)
+	return tu
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (tu *TaskUpdate) RemoveLabels(l ...*Label) *TaskUpdate {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tu.RemoveLabelIDs(ids...)
+}
+
+// ClearSprint clears the "sprint" edge to the Sprint entity.
+func (tu *TaskUpdate) ClearSprint() *TaskUpdate {
+	tu.mutation.ClearSprint()
+	return tu
+}
+
+// ClearEpic clears the "epic" edge to the Epic entity.
+func (tu *TaskUpdate) ClearEpic() *TaskUpdate {
+	tu.mutation.ClearEpic()
+	return tu
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (tu *TaskUpdate) ClearComments() *TaskUpdate {
+	tu.mutation.ClearComments()
+	return tu
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (tu *TaskUpdate) RemoveCommentIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveCommentIDs(ids...)
+	return tu
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (tu *TaskUpdate) RemoveComments(c ...*Comment) *TaskUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.RemoveCommentIDs(ids...)
+}
+
+// ClearActivities clears all "activities" edges to the Activity entity.
+func (tu *TaskUpdate) ClearActivities() *TaskUpdate {
+	tu.mutation.ClearActivities()
+	return tu
+}
+
+// RemoveActivityIDs removes the "activities" edge to Activity entities by IDs.
+func (tu *TaskUpdate) RemoveActivityIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveActivityIDs(ids...)
+	return tu
+}
+
+// RemoveActivities removes "activities" edges to Activity entities.
+func (tu *TaskUpdate) RemoveActivities(a ...*Activity) *TaskUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.RemoveActivityIDs(ids...)
+}
+
+// ClearAssignees clears all "assignees" edges to the Member entity.
+func (tu *TaskUpdate) ClearAssignees() *TaskUpdate {
+	tu.mutation.ClearAssignees()
+	return tu
+}
+
+// RemoveAssigneeIDs removes the "assignees" edge to Member entities by IDs.
+func (tu *TaskUpdate) RemoveAssigneeIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveAssigneeIDs(ids...)
+	return tu
+}
+
+// RemoveAssignees removes "assignees" edges to Member entities.
+func (tu *TaskUpdate) RemoveAssignees(m ...*Member) *TaskUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tu.RemoveAssigneeIDs(ids...)
+}
+
+// ClearWatchers clears all "watchers" edges to the Member entity.
+func (tu *TaskUpdate) ClearWatchers() *TaskUpdate {
+	tu.mutation.ClearWatchers()
+	return tu
+}
+
+// RemoveWatcherIDs removes the "watchers" edge to Member entities by IDs.
+func (tu *TaskUpdate) RemoveWatcherIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveWatcherIDs(ids...)
+	return tu
+}
+
+// RemoveWatchers removes "watchers" edges to Member entities.
+func (tu *TaskUpdate) RemoveWatchers(m ...*Member) *TaskUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tu.RemoveWatcherIDs(ids...)
+}
+
+// ClearChecklistItems clears all "checklist_items" edges to the ChecklistItem entity.
+func (tu *TaskUpdate) ClearChecklistItems() *TaskUpdate {
+	tu.mutation.ClearChecklistItems()
+	return tu
+}
+
+// RemoveChecklistItemIDs removes the "checklist_items" edge to ChecklistItem entities by IDs.
+func (tu *TaskUpdate) RemoveChecklistItemIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveChecklistItemIDs(ids...)
+	return tu
+}
+
+// RemoveChecklistItems removes "checklist_items" edges to ChecklistItem entities.
+func (tu *TaskUpdate) RemoveChecklistItems(c ...*ChecklistItem) *TaskUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.RemoveChecklistItemIDs(ids...)
+}
+
+// ClearCustomFieldValues clears all "custom_field_values" edges to the CustomFieldValue entity.
+func (tu *TaskUpdate) ClearCustomFieldValues() *TaskUpdate {
+	tu.mutation.ClearCustomFieldValues()
+	return tu
+}
+
+// RemoveCustomFieldValueIDs removes the "custom_field_values" edge to CustomFieldValue entities by IDs.
+func (tu *TaskUpdate) RemoveCustomFieldValueIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveCustomFieldValueIDs(ids...)
+	return tu
+}
+
+// RemoveCustomFieldValues removes "custom_field_values" edges to CustomFieldValue entities.
+func (tu *TaskUpdate) RemoveCustomFieldValues(c ...*CustomFieldValue) *TaskUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.RemoveCustomFieldValueIDs(ids...)
+}
+
+// ClearWorklogs clears all "worklogs" edges to the Worklog entity.
+func (tu *TaskUpdate) ClearWorklogs() *TaskUpdate {
+	tu.mutation.ClearWorklogs()
+	return tu
+}
+
+// RemoveWorklogIDs removes the "worklogs" edge to Worklog entities by IDs.
+func (tu *TaskUpdate) RemoveWorklogIDs(ids ...string) *TaskUpdate {
+	tu.mutation.RemoveWorklogIDs(ids...)
+	return tu
+}
+
+// RemoveWorklogs removes "worklogs" edges to Worklog entities.
+func (tu *TaskUpdate) RemoveWorklogs(w ...*Worklog) *TaskUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tu.RemoveWorklogIDs(ids...)
+}
+
+// ClearTimers clears all "timers" edges to the Timer entity.
+func (tu *TaskUpdate) ClearTimers() *TaskUpdate {
+	tu.mutation.ClearTimers()
+	return tu
+}
+
+// RemoveTimerIDs removes the "timers" edge to Timer entities by IDs.
+func (tu *TaskUpdate) RemoveTimerIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveTimerIDs(ids...)
+	return tu
+}
+
+// RemoveTimers removes "timers" edges to Timer entities.
+func (tu *TaskUpdate) RemoveTimers(t ...*Timer) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTimerIDs(ids...)
+}
+
+// ClearStatusChanges clears all "status_changes" edges to the StatusChange entity.
+func (tu *TaskUpdate) ClearStatusChanges() *TaskUpdate {
+	tu.mutation.ClearStatusChanges()
+	return tu
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to StatusChange entities by IDs.
+func (tu *TaskUpdate) RemoveStatusChangeIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveStatusChangeIDs(ids...)
+	return tu
+}
+
+// RemoveStatusChanges removes "status_changes" edges to StatusChange entities.
+func (tu *TaskUpdate) RemoveStatusChanges(s ...*StatusChange) *TaskUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.RemoveStatusChangeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
+	tu.defaults()
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TaskUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TaskUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tu *TaskUpdate) defaults() {
+	if _, ok := tu.mutation.UpdatedAt(); !ok {
+		v := task.UpdateDefaultUpdatedAt()
+		tu.mutation.SetUpdatedAt(v)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (tu *TaskUpdate) check() error {
-	if v, ok := tu.mutation.Status(); ok {
-		if err := task.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+	if v, ok := tu.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
 		}
 	}
 	return nil
@@ -271,6 +1057,12 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.DueDateCleared() {
 		_spec.ClearField(task.FieldDueDate, field.TypeTime)
 	}
+	if value, ok := tu.mutation.AssigneeID(); ok {
+		_spec.SetField(task.FieldAssigneeID, field.TypeString, value)
+	}
+	if tu.mutation.AssigneeIDCleared() {
+		_spec.ClearField(task.FieldAssigneeID, field.TypeString)
+	}
 	if value, ok := tu.mutation.AssigneeName(); ok {
 		_spec.SetField(task.FieldAssigneeName, field.TypeString, value)
 	}
@@ -289,8 +1081,56 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.CompletedAtCleared() {
 		_spec.ClearField(task.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := tu.mutation.ArchivedAt(); ok {
+		_spec.SetField(task.FieldArchivedAt, field.TypeTime, value)
+	}
+	if tu.mutation.ArchivedAtCleared() {
+		_spec.ClearField(task.FieldArchivedAt, field.TypeTime)
+	}
 	if value, ok := tu.mutation.Status(); ok {
-		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
+		_spec.SetField(task.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Rank(); ok {
+		_spec.SetField(task.FieldRank, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+	}
+	if tu.mutation.PriorityCleared() {
+		_spec.ClearField(task.FieldPriority, field.TypeEnum)
+	}
+	if value, ok := tu.mutation.StoryPoints(); ok {
+		_spec.SetField(task.FieldStoryPoints, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedStoryPoints(); ok {
+		_spec.AddField(task.FieldStoryPoints, field.TypeInt, value)
+	}
+	if tu.mutation.StoryPointsCleared() {
+		_spec.ClearField(task.FieldStoryPoints, field.TypeInt)
+	}
+	if value, ok := tu.mutation.WipOverrideBy(); ok {
+		_spec.SetField(task.FieldWipOverrideBy, field.TypeString, value)
+	}
+	if tu.mutation.WipOverrideByCleared() {
+		_spec.ClearField(task.FieldWipOverrideBy, field.TypeString)
+	}
+	if value, ok := tu.mutation.WipOverrideStatus(); ok {
+		_spec.SetField(task.FieldWipOverrideStatus, field.TypeString, value)
+	}
+	if tu.mutation.WipOverrideStatusCleared() {
+		_spec.ClearField(task.FieldWipOverrideStatus, field.TypeString)
+	}
+	if value, ok := tu.mutation.WipOverrideAt(); ok {
+		_spec.SetField(task.FieldWipOverrideAt, field.TypeTime, value)
+	}
+	if tu.mutation.WipOverrideAtCleared() {
+		_spec.ClearField(task.FieldWipOverrideAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.Version(); ok {
+		_spec.SetField(task.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedVersion(); ok {
+		_spec.AddField(task.FieldVersion, field.TypeInt, value)
 	}
 	if tu.mutation.FilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -337,341 +1177,2571 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{task.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if tu.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.BoardTable,
+			Columns: []string{task.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
-	}
-	tu.mutation.done = true
-	return n, nil
-}
-
-// TaskUpdateOne is the builder for updating a single Task entity.
-type TaskUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TaskMutation
-}
-
-// SetTitle sets the "title" field.
-func (tuo *TaskUpdateOne) SetTitle(s string) *TaskUpdateOne {
-	tuo.mutation.SetTitle(s)
-	return tuo
-}
-
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableTitle(s *string) *TaskUpdateOne {
-	if s != nil {
-		tuo.SetTitle(*s)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo
-}
-
-// SetDescription sets the "description" field.
-func (tuo *TaskUpdateOne) SetDescription(s string) *TaskUpdateOne {
-	tuo.mutation.SetDescription(s)
-	return tuo
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableDescription(s *string) *TaskUpdateOne {
-	if s != nil {
-		tuo.SetDescription(*s)
+	if nodes := tu.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.BoardTable,
+			Columns: []string{task.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return tuo
-}
-
-// ClearDescription clears the value of the "description" field.
-func (tuo *TaskUpdateOne) ClearDescription() *TaskUpdateOne {
-	tuo.mutation.ClearDescription()
-	return tuo
-}
-
-// SetDueDate sets the "due_date" field.
-func (tuo *TaskUpdateOne) SetDueDate(t time.Time) *TaskUpdateOne {
-	tuo.mutation.SetDueDate(t)
-	return tuo
-}
-
-// SetNillableDueDate sets the "due_date" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableDueDate(t *time.Time) *TaskUpdateOne {
-	if t != nil {
-		tuo.SetDueDate(*t)
+	if tu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !tu.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !tu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !tu.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.SprintCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.SprintIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.EpicCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.EpicTable,
+			Columns: []string{task.EpicColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.EpicIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.EpicTable,
+			Columns: []string{task.EpicColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !tu.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedAssigneesIDs(); len(nodes) > 0 && !tu.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !tu.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ChecklistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChecklistItemsTable,
+			Columns: []string{task.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedChecklistItemsIDs(); len(nodes) > 0 && !tu.mutation.ChecklistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChecklistItemsTable,
+			Columns: []string{task.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ChecklistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChecklistItemsTable,
+			Columns: []string{task.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.CustomFieldValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CustomFieldValuesTable,
+			Columns: []string{task.CustomFieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedCustomFieldValuesIDs(); len(nodes) > 0 && !tu.mutation.CustomFieldValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CustomFieldValuesTable,
+			Columns: []string{task.CustomFieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CustomFieldValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CustomFieldValuesTable,
+			Columns: []string{task.CustomFieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.WorklogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorklogsTable,
+			Columns: []string{task.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWorklogsIDs(); len(nodes) > 0 && !tu.mutation.WorklogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorklogsTable,
+			Columns: []string{task.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WorklogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorklogsTable,
+			Columns: []string{task.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.TimersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.TimersTable,
+			Columns: []string{task.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTimersIDs(); len(nodes) > 0 && !tu.mutation.TimersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.TimersTable,
+			Columns: []string{task.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TimersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.TimersTable,
+			Columns: []string{task.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !tu.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{task.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TaskUpdateOne is the builder for updating a single Task entity.
+type TaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskMutation
+}
+
+// SetBoardID sets the "board_id" field.
+func (tuo *TaskUpdateOne) SetBoardID(s string) *TaskUpdateOne {
+	tuo.mutation.SetBoardID(s)
+	return tuo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableBoardID(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetBoardID(*s)
+	}
+	return tuo
+}
+
+// ClearBoardID clears the value of the "board_id" field.
+func (tuo *TaskUpdateOne) ClearBoardID() *TaskUpdateOne {
+	tuo.mutation.ClearBoardID()
+	return tuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (tuo *TaskUpdateOne) SetParentID(s string) *TaskUpdateOne {
+	tuo.mutation.SetParentID(s)
+	return tuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableParentID(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetParentID(*s)
+	}
+	return tuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (tuo *TaskUpdateOne) ClearParentID() *TaskUpdateOne {
+	tuo.mutation.ClearParentID()
+	return tuo
+}
+
+// SetSprintID sets the "sprint_id" field.
+func (tuo *TaskUpdateOne) SetSprintID(s string) *TaskUpdateOne {
+	tuo.mutation.SetSprintID(s)
+	return tuo
+}
+
+// SetNillableSprintID sets the "sprint_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableSprintID(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetSprintID(*s)
+	}
+	return tuo
+}
+
+// ClearSprintID clears the value of the "sprint_id" field.
+func (tuo *TaskUpdateOne) ClearSprintID() *TaskUpdateOne {
+	tuo.mutation.ClearSprintID()
+	return tuo
+}
+
+// SetEpicID sets the "epic_id" field.
+func (tuo *TaskUpdateOne) SetEpicID(s string) *TaskUpdateOne {
+	tuo.mutation.SetEpicID(s)
+	return tuo
+}
+
+// SetNillableEpicID sets the "epic_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableEpicID(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetEpicID(*s)
+	}
+	return tuo
+}
+
+// ClearEpicID clears the value of the "epic_id" field.
+func (tuo *TaskUpdateOne) ClearEpicID() *TaskUpdateOne {
+	tuo.mutation.ClearEpicID()
+	return tuo
+}
+
+// SetTitle sets the "title" field.
+func (tuo *TaskUpdateOne) SetTitle(s string) *TaskUpdateOne {
+	tuo.mutation.SetTitle(s)
+	return tuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableTitle(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetTitle(*s)
+	}
+	return tuo
+}
+
+// SetDescription sets the "description" field.
+func (tuo *TaskUpdateOne) SetDescription(s string) *TaskUpdateOne {
+	tuo.mutation.SetDescription(s)
+	return tuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDescription(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetDescription(*s)
+	}
+	return tuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tuo *TaskUpdateOne) ClearDescription() *TaskUpdateOne {
+	tuo.mutation.ClearDescription()
+	return tuo
+}
+
+// SetDueDate sets the "due_date" field.
+func (tuo *TaskUpdateOne) SetDueDate(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetDueDate(t)
+	return tuo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDueDate(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetDueDate(*t)
+	}
+	return tuo
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (tuo *TaskUpdateOne) ClearDueDate() *TaskUpdateOne {
+	tuo.mutation.ClearDueDate()
+	return tuo
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (tuo *TaskUpdateOne) SetAssigneeID(s string) *TaskUpdateOne {
+	tuo.mutation.SetAssigneeID(s)
+	return tuo
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableAssigneeID(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetAssigneeID(*s)
+	}
+	return tuo
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (tuo *TaskUpdateOne) ClearAssigneeID() *TaskUpdateOne {
+	tuo.mutation.ClearAssigneeID()
+	return tuo
+}
+
+// SetAssigneeName sets the "assignee_name" field.
+func (tuo *TaskUpdateOne) SetAssigneeName(s string) *TaskUpdateOne {
+	tuo.mutation.SetAssigneeName(s)
+	return tuo
+}
+
+// SetNillableAssigneeName sets the "assignee_name" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableAssigneeName(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetAssigneeName(*s)
+	}
+	return tuo
+}
+
+// ClearAssigneeName clears the value of the "assignee_name" field.
+func (tuo *TaskUpdateOne) ClearAssigneeName() *TaskUpdateOne {
+	tuo.mutation.ClearAssigneeName()
+	return tuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tuo *TaskUpdateOne) SetCreatedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetCreatedAt(t)
+	return tuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCreatedAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetCreatedAt(*t)
+	}
+	return tuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuo *TaskUpdateOne) SetUpdatedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetUpdatedAt(t)
+	return tuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (tuo *TaskUpdateOne) SetCompletedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetCompletedAt(t)
+	return tuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCompletedAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetCompletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (tuo *TaskUpdateOne) ClearCompletedAt() *TaskUpdateOne {
+	tuo.mutation.ClearCompletedAt()
+	return tuo
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (tuo *TaskUpdateOne) SetArchivedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetArchivedAt(t)
+	return tuo
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableArchivedAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetArchivedAt(*t)
+	}
+	return tuo
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (tuo *TaskUpdateOne) ClearArchivedAt() *TaskUpdateOne {
+	tuo.mutation.ClearArchivedAt()
+	return tuo
+}
+
+// SetStatus sets the "status" field.
+func (tuo *TaskUpdateOne) SetStatus(s string) *TaskUpdateOne {
+	tuo.mutation.SetStatus(s)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableStatus(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetStatus(*s)
+	}
+	return tuo
+}
+
+// SetRank sets the "rank" field.
+func (tuo *TaskUpdateOne) SetRank(s string) *TaskUpdateOne {
+	tuo.mutation.SetRank(s)
+	return tuo
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRank(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetRank(*s)
+	}
+	return tuo
+}
+
+// SetPriority sets the "priority" field.
+func (tuo *TaskUpdateOne) SetPriority(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.SetPriority(t)
+	return tuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePriority(t *task.Priority) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetPriority(*t)
+	}
+	return tuo
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (tuo *TaskUpdateOne) ClearPriority() *TaskUpdateOne {
+	tuo.mutation.ClearPriority()
+	return tuo
+}
+
+// SetStoryPoints sets the "story_points" field.
+func (tuo *TaskUpdateOne) SetStoryPoints(i int) *TaskUpdateOne {
+	tuo.mutation.ResetStoryPoints()
+	tuo.mutation.SetStoryPoints(i)
+	return tuo
+}
+
+// SetNillableStoryPoints sets the "story_points" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableStoryPoints(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetStoryPoints(*i)
+	}
+	return tuo
+}
+
+// AddStoryPoints adds i to the "story_points" field.
+func (tuo *TaskUpdateOne) AddStoryPoints(i int) *TaskUpdateOne {
+	tuo.mutation.AddStoryPoints(i)
+	return tuo
+}
+
+// ClearStoryPoints clears the value of the "story_points" field.
+func (tuo *TaskUpdateOne) ClearStoryPoints() *TaskUpdateOne {
+	tuo.mutation.ClearStoryPoints()
+	return tuo
+}
+
+// SetWipOverrideBy sets the "wip_override_by" field.
+func (tuo *TaskUpdateOne) SetWipOverrideBy(s string) *TaskUpdateOne {
+	tuo.mutation.SetWipOverrideBy(s)
+	return tuo
+}
+
+// SetNillableWipOverrideBy sets the "wip_override_by" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableWipOverrideBy(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetWipOverrideBy(*s)
+	}
+	return tuo
+}
+
+// ClearWipOverrideBy clears the value of the "wip_override_by" field.
+func (tuo *TaskUpdateOne) ClearWipOverrideBy() *TaskUpdateOne {
+	tuo.mutation.ClearWipOverrideBy()
+	return tuo
+}
+
+// SetWipOverrideStatus sets the "wip_override_status" field.
+func (tuo *TaskUpdateOne) SetWipOverrideStatus(s string) *TaskUpdateOne {
+	tuo.mutation.SetWipOverrideStatus(s)
+	return tuo
+}
+
+// SetNillableWipOverrideStatus sets the "wip_override_status" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableWipOverrideStatus(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetWipOverrideStatus(*s)
+	}
+	return tuo
+}
+
+// ClearWipOverrideStatus clears the value of the "wip_override_status" field.
+func (tuo *TaskUpdateOne) ClearWipOverrideStatus() *TaskUpdateOne {
+	tuo.mutation.ClearWipOverrideStatus()
+	return tuo
+}
+
+// SetWipOverrideAt sets the "wip_override_at" field.
+func (tuo *TaskUpdateOne) SetWipOverrideAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetWipOverrideAt(t)
+	return tuo
+}
+
+// SetNillableWipOverrideAt sets the "wip_override_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableWipOverrideAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetWipOverrideAt(*t)
+	}
+	return tuo
+}
+
+// ClearWipOverrideAt clears the value of the "wip_override_at" field.
+func (tuo *TaskUpdateOne) ClearWipOverrideAt() *TaskUpdateOne {
+	tuo.mutation.ClearWipOverrideAt()
+	return tuo
+}
+
+// SetVersion sets the "version" field.
+func (tuo *TaskUpdateOne) SetVersion(i int) *TaskUpdateOne {
+	tuo.mutation.ResetVersion()
+	tuo.mutation.SetVersion(i)
+	return tuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableVersion(i *int) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetVersion(*i)
+	}
+	return tuo
+}
+
+// AddVersion adds i to the "version" field.
+func (tuo *TaskUpdateOne) AddVersion(i int) *TaskUpdateOne {
+	tuo.mutation.AddVersion(i)
+	return tuo
+}
+
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (tuo *TaskUpdateOne) AddFileIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddFileIDs(ids...)
+	return tuo
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (tuo *TaskUpdateOne) AddFiles(f ...*File) *TaskUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return tuo.AddFileIDs(ids...)
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (tuo *TaskUpdateOne) SetBoard(b *Board) *TaskUpdateOne {
+	return tuo.SetBoardID(b.ID)
+}
+
+// SetParent sets the "parent" edge to the Task entity.
+func (tuo *TaskUpdateOne) SetParent(t *Task) *TaskUpdateOne {
+	return tuo.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Task entity by IDs.
+func (tuo *TaskUpdateOne) AddChildIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddChildIDs(ids...)
+	return tuo
+}
+
+// AddChildren adds the "children" edges to the Task entity.
+func (tuo *TaskUpdateOne) AddChildren(t ...*Task) *TaskUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddChildIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the Task entity by IDs.
+func (tuo *TaskUpdateOne) AddBlockedByIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddBlockedByIDs(ids...)
+	return tuo
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the Task entity.
+func (tuo *TaskUpdateOne) AddBlockedBy(t ...*Task) *TaskUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddBlockedByIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Task entity by IDs.
+func (tuo *TaskUpdateOne) AddBlockIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddBlockIDs(ids...)
+	return tuo
+}
+
+// AddBlocks adds the "blocks" edges to the Task entity.
+func (tuo *TaskUpdateOne) AddBlocks(t ...*Task) *TaskUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddBlockIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (tuo *TaskUpdateOne) AddLabelIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddLabelIDs(ids...)
+	return tuo
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (tuo *TaskUpdateOne) AddLabels(l ...*Label) *TaskUpdateOne {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tuo.AddLabelIDs(ids...)
+}
+
+// SetSprint sets the "sprint" edge to the Sprint entity.
+func (tuo *TaskUpdateOne) SetSprint(s *Sprint) *TaskUpdateOne {
+	return tuo.SetSprintID(s.ID)
+}
+
+// SetEpic sets the "epic" edge to the Epic entity.
+func (tuo *TaskUpdateOne) SetEpic(e *Epic) *TaskUpdateOne {
+	return tuo.SetEpicID(e.ID)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tuo *TaskUpdateOne) AddCommentIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddCommentIDs(ids...)
+	return tuo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tuo *TaskUpdateOne) AddComments(c ...*Comment) *TaskUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.AddCommentIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the Activity entity by IDs.
+func (tuo *TaskUpdateOne) AddActivityIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddActivityIDs(ids...)
+	return tuo
+}
+
+// AddActivities adds the "activities" edges to the Activity entity.
+func (tuo *TaskUpdateOne) AddActivities(a ...*Activity) *TaskUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.AddActivityIDs(ids...)
+}
+
+// AddAssigneeIDs adds the "assignees" edge to the Member entity by IDs.
+func (tuo *TaskUpdateOne) AddAssigneeIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddAssigneeIDs(ids...)
+	return tuo
+}
+
+// AddAssignees adds the "assignees" edges to the Member entity.
+func (tuo *TaskUpdateOne) AddAssignees(m ...*Member) *TaskUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tuo.AddAssigneeIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the Member entity by IDs.
+func (tuo *TaskUpdateOne) AddWatcherIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddWatcherIDs(ids...)
+	return tuo
+}
+
+// AddWatchers adds the "watchers" edges to the Member entity.
+func (tuo *TaskUpdateOne) AddWatchers(m ...*Member) *TaskUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tuo.AddWatcherIDs(ids...)
+}
+
+// AddChecklistItemIDs adds the "checklist_items" edge to the ChecklistItem entity by IDs.
+func (tuo *TaskUpdateOne) AddChecklistItemIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddChecklistItemIDs(ids...)
+	return tuo
+}
+
+// AddChecklistItems adds the "checklist_items" edges to the ChecklistItem entity.
+func (tuo *TaskUpdateOne) AddChecklistItems(c ...*ChecklistItem) *TaskUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.AddChecklistItemIDs(ids...)
+}
+
+// AddCustomFieldValueIDs adds the "custom_field_values" edge to the CustomFieldValue entity by IDs.
+func (tuo *TaskUpdateOne) AddCustomFieldValueIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddCustomFieldValueIDs(ids...)
+	return tuo
+}
+
+// AddCustomFieldValues adds the "custom_field_values" edges to the CustomFieldValue entity.
+func (tuo *TaskUpdateOne) AddCustomFieldValues(c ...*CustomFieldValue) *TaskUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.AddCustomFieldValueIDs(ids...)
+}
+
+// AddWorklogIDs adds the "worklogs" edge to the Worklog entity by IDs.
+func (tuo *TaskUpdateOne) AddWorklogIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.AddWorklogIDs(ids...)
+	return tuo
+}
+
+// AddWorklogs adds the "worklogs" edges to the Worklog entity.
+func (tuo *TaskUpdateOne) AddWorklogs(w ...*Worklog) *TaskUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.AddWorklogIDs(ids...)
+}
+
+// AddTimerIDs adds the "timers" edge to the Timer entity by IDs.
+func (tuo *TaskUpdateOne) AddTimerIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddTimerIDs(ids...)
+	return tuo
+}
+
+// AddTimers adds the "timers" edges to the Timer entity.
+func (tuo *TaskUpdateOne) AddTimers(t ...*Timer) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddTimerIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the StatusChange entity by IDs.
+func (tuo *TaskUpdateOne) AddStatusChangeIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddStatusChangeIDs(ids...)
+	return tuo
+}
+
+// AddStatusChanges adds the "status_changes" edges to the StatusChange entity.
+func (tuo *TaskUpdateOne) AddStatusChanges(s ...*StatusChange) *TaskUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.AddStatusChangeIDs(ids...)
+}
+
+// Mutation returns the TaskMutation object of the builder.
+func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
+	return tuo.mutation
+}
+
+// ClearFiles clears all "files" edges to the File entity.
+func (tuo *TaskUpdateOne) ClearFiles() *TaskUpdateOne {
+	tuo.mutation.ClearFiles()
+	return tuo
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (tuo *TaskUpdateOne) RemoveFileIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveFileIDs(ids...)
+	return tuo
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (tuo *TaskUpdateOne) RemoveFiles(f ...*File) *TaskUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return tuo.RemoveFileIDs(ids...)
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (tuo *TaskUpdateOne) ClearBoard() *TaskUpdateOne {
+	tuo.mutation.ClearBoard()
+	return tuo
+}
+
+// ClearParent clears the "parent" edge to the Task entity.
+func (tuo *TaskUpdateOne) ClearParent() *TaskUpdateOne {
+	tuo.mutation.ClearParent()
+	return tuo
+}
+
+// ClearChildren clears all "children" edges to the Task entity.
+func (tuo *TaskUpdateOne) ClearChildren() *TaskUpdateOne {
+	tuo.mutation.ClearChildren()
+	return tuo
+}
+
+// RemoveChildIDs removes the "children" edge to Task entities by IDs.
+func (tuo *TaskUpdateOne) RemoveChildIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveChildIDs(ids...)
+	return tuo
+}
+
+// RemoveChildren removes "children" edges to Task entities.
+func (tuo *TaskUpdateOne) RemoveChildren(t ...*Task) *TaskUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveChildIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the Task entity.
+func (tuo *TaskUpdateOne) ClearBlockedBy() *TaskUpdateOne {
+	tuo.mutation.ClearBlockedBy()
+	return tuo
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to Task entities by IDs.
+func (tuo *TaskUpdateOne) RemoveBlockedByIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveBlockedByIDs(ids...)
+	return tuo
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to Task entities.
+func (tuo *TaskUpdateOne) RemoveBlockedBy(t ...*Task) *TaskUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveBlockedByIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the Task entity.
+func (tuo *TaskUpdateOne) ClearBlocks() *TaskUpdateOne {
+	tuo.mutation.ClearBlocks()
+	return tuo
+}
+
+// RemoveBlockIDs removes the "blocks" edge to Task entities by IDs.
+func (tuo *TaskUpdateOne) RemoveBlockIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveBlockIDs(ids...)
+	return tuo
+}
+
+// RemoveBlocks removes "blocks" edges to Task entities.
+func (tuo *TaskUpdateOne) RemoveBlocks(t ...*Task) *TaskUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveBlockIDs(ids...)
+}
+
+// ClearLabels clears all "labels" edges to the Label entity.
+func (tuo *TaskUpdateOne) ClearLabels() *TaskUpdateOne {
+	tuo.mutation.ClearLabels()
+	return tuo
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (tuo *TaskUpdateOne) RemoveLabelIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveLabelIDs(ids...)
+	return tuo
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (tuo *TaskUpdateOne) RemoveLabels(l ...*Label) *TaskUpdateOne {
+	ids := make([]string, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tuo.RemoveLabelIDs(ids...)
+}
+
+// ClearSprint clears the "sprint" edge to the Sprint entity.
+func (tuo *TaskUpdateOne) ClearSprint() *TaskUpdateOne {
+	tuo.mutation.ClearSprint()
+	return tuo
+}
+
+// ClearEpic clears the "epic" edge to the Epic entity.
+func (tuo *TaskUpdateOne) ClearEpic() *TaskUpdateOne {
+	tuo.mutation.ClearEpic()
+	return tuo
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (tuo *TaskUpdateOne) ClearComments() *TaskUpdateOne {
+	tuo.mutation.ClearComments()
+	return tuo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (tuo *TaskUpdateOne) RemoveCommentIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveCommentIDs(ids...)
+	return tuo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (tuo *TaskUpdateOne) RemoveComments(c ...*Comment) *TaskUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.RemoveCommentIDs(ids...)
+}
+
+// ClearActivities clears all "activities" edges to the Activity entity.
+func (tuo *TaskUpdateOne) ClearActivities() *TaskUpdateOne {
+	tuo.mutation.ClearActivities()
+	return tuo
+}
+
+// RemoveActivityIDs removes the "activities" edge to Activity entities by IDs.
+func (tuo *TaskUpdateOne) RemoveActivityIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveActivityIDs(ids...)
+	return tuo
+}
+
+// RemoveActivities removes "activities" edges to Activity entities.
+func (tuo *TaskUpdateOne) RemoveActivities(a ...*Activity) *TaskUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.RemoveActivityIDs(ids...)
+}
+
+// ClearAssignees clears all "assignees" edges to the Member entity.
+func (tuo *TaskUpdateOne) ClearAssignees() *TaskUpdateOne {
+	tuo.mutation.ClearAssignees()
+	return tuo
+}
+
+// RemoveAssigneeIDs removes the "assignees" edge to Member entities by IDs.
+func (tuo *TaskUpdateOne) RemoveAssigneeIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveAssigneeIDs(ids...)
+	return tuo
+}
+
+// RemoveAssignees removes "assignees" edges to Member entities.
+func (tuo *TaskUpdateOne) RemoveAssignees(m ...*Member) *TaskUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tuo.RemoveAssigneeIDs(ids...)
+}
+
+// ClearWatchers clears all "watchers" edges to the Member entity.
+func (tuo *TaskUpdateOne) ClearWatchers() *TaskUpdateOne {
+	tuo.mutation.ClearWatchers()
+	return tuo
+}
+
+// RemoveWatcherIDs removes the "watchers" edge to Member entities by IDs.
+func (tuo *TaskUpdateOne) RemoveWatcherIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveWatcherIDs(ids...)
+	return tuo
+}
+
+// RemoveWatchers removes "watchers" edges to Member entities.
+func (tuo *TaskUpdateOne) RemoveWatchers(m ...*Member) *TaskUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tuo.RemoveWatcherIDs(ids...)
+}
+
+// ClearChecklistItems clears all "checklist_items" edges to the ChecklistItem entity.
+func (tuo *TaskUpdateOne) ClearChecklistItems() *TaskUpdateOne {
+	tuo.mutation.ClearChecklistItems()
+	return tuo
+}
+
+// RemoveChecklistItemIDs removes the "checklist_items" edge to ChecklistItem entities by IDs.
+func (tuo *TaskUpdateOne) RemoveChecklistItemIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveChecklistItemIDs(ids...)
+	return tuo
+}
+
+// RemoveChecklistItems removes "checklist_items" edges to ChecklistItem entities.
+func (tuo *TaskUpdateOne) RemoveChecklistItems(c ...*ChecklistItem) *TaskUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.RemoveChecklistItemIDs(ids...)
+}
+
+// ClearCustomFieldValues clears all "custom_field_values" edges to the CustomFieldValue entity.
+func (tuo *TaskUpdateOne) ClearCustomFieldValues() *TaskUpdateOne {
+	tuo.mutation.ClearCustomFieldValues()
+	return tuo
+}
+
+// RemoveCustomFieldValueIDs removes the "custom_field_values" edge to CustomFieldValue entities by IDs.
+func (tuo *TaskUpdateOne) RemoveCustomFieldValueIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveCustomFieldValueIDs(ids...)
+	return tuo
+}
+
+// RemoveCustomFieldValues removes "custom_field_values" edges to CustomFieldValue entities.
+func (tuo *TaskUpdateOne) RemoveCustomFieldValues(c ...*CustomFieldValue) *TaskUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.RemoveCustomFieldValueIDs(ids...)
+}
+
+// ClearWorklogs clears all "worklogs" edges to the Worklog entity.
+func (tuo *TaskUpdateOne) ClearWorklogs() *TaskUpdateOne {
+	tuo.mutation.ClearWorklogs()
+	return tuo
+}
+
+// RemoveWorklogIDs removes the "worklogs" edge to Worklog entities by IDs.
+func (tuo *TaskUpdateOne) RemoveWorklogIDs(ids ...string) *TaskUpdateOne {
+	tuo.mutation.RemoveWorklogIDs(ids...)
+	return tuo
+}
+
+// RemoveWorklogs removes "worklogs" edges to Worklog entities.
+func (tuo *TaskUpdateOne) RemoveWorklogs(w ...*Worklog) *TaskUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return tuo.RemoveWorklogIDs(ids...)
+}
+
+// ClearTimers clears all "timers" edges to the Timer entity.
+func (tuo *TaskUpdateOne) ClearTimers() *TaskUpdateOne {
+	tuo.mutation.ClearTimers()
+	return tuo
+}
+
+// RemoveTimerIDs removes the "timers" edge to Timer entities by IDs.
+func (tuo *TaskUpdateOne) RemoveTimerIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveTimerIDs(ids...)
+	return tuo
+}
+
+// RemoveTimers removes "timers" edges to Timer entities.
+func (tuo *TaskUpdateOne) RemoveTimers(t ...*Timer) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveTimerIDs(ids...)
+}
+
+// ClearStatusChanges clears all "status_changes" edges to the StatusChange entity.
+func (tuo *TaskUpdateOne) ClearStatusChanges() *TaskUpdateOne {
+	tuo.mutation.ClearStatusChanges()
+	return tuo
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to StatusChange entities by IDs.
+func (tuo *TaskUpdateOne) RemoveStatusChangeIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveStatusChangeIDs(ids...)
+	return tuo
+}
+
+// RemoveStatusChanges removes "status_changes" edges to StatusChange entities.
+func (tuo *TaskUpdateOne) RemoveStatusChanges(s ...*StatusChange) *TaskUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.RemoveStatusChangeIDs(ids...)
+}
+
+// Where appends a list predicates to the TaskUpdate builder.
+func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TaskUpdateOne) Select(field string, fields ...string) *TaskUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Task entity.
+func (tuo *TaskUpdateOne) Save(ctx context.Context) (*Task, error) {
+	tuo.defaults()
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TaskUpdateOne) SaveX(ctx context.Context) *Task {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TaskUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
 	}
-	return tuo
-}
-
-// ClearDueDate clears the value of the "due_date" field.
-func (tuo *TaskUpdateOne) ClearDueDate() *TaskUpdateOne {
-	tuo.mutation.ClearDueDate()
-	return tuo
 }
 
-// SetAssigneeName sets the "assignee_name" field.
-func (tuo *TaskUpdateOne) SetAssigneeName(s string) *TaskUpdateOne {
-	tuo.mutation.SetAssigneeName(s)
-	return tuo
+// defaults sets the default values of the builder before save.
+func (tuo *TaskUpdateOne) defaults() {
+	if _, ok := tuo.mutation.UpdatedAt(); !ok {
+		v := task.UpdateDefaultUpdatedAt()
+		tuo.mutation.SetUpdatedAt(v)
+	}
 }
 
-// SetNillableAssigneeName sets the "assignee_name" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableAssigneeName(s *string) *TaskUpdateOne {
-	if s != nil {
-		tuo.SetAssigneeName(*s)
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TaskUpdateOne) check() error {
+	if v, ok := tuo.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeString))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Task.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, task.FieldID)
+		for _, f := range fields {
+			if !task.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != task.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.Title(); ok {
+		_spec.SetField(task.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Description(); ok {
+		_spec.SetField(task.FieldDescription, field.TypeString, value)
+	}
+	if tuo.mutation.DescriptionCleared() {
+		_spec.ClearField(task.FieldDescription, field.TypeString)
+	}
+	if value, ok := tuo.mutation.DueDate(); ok {
+		_spec.SetField(task.FieldDueDate, field.TypeTime, value)
+	}
+	if tuo.mutation.DueDateCleared() {
+		_spec.ClearField(task.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.AssigneeID(); ok {
+		_spec.SetField(task.FieldAssigneeID, field.TypeString, value)
+	}
+	if tuo.mutation.AssigneeIDCleared() {
+		_spec.ClearField(task.FieldAssigneeID, field.TypeString)
+	}
+	if value, ok := tuo.mutation.AssigneeName(); ok {
+		_spec.SetField(task.FieldAssigneeName, field.TypeString, value)
+	}
+	if tuo.mutation.AssigneeNameCleared() {
+		_spec.ClearField(task.FieldAssigneeName, field.TypeString)
+	}
+	if value, ok := tuo.mutation.CreatedAt(); ok {
+		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tuo.mutation.CompletedAt(); ok {
+		_spec.SetField(task.FieldCompletedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(task.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.ArchivedAt(); ok {
+		_spec.SetField(task.FieldArchivedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.ArchivedAtCleared() {
+		_spec.ClearField(task.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(task.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Rank(); ok {
+		_spec.SetField(task.FieldRank, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+	}
+	if tuo.mutation.PriorityCleared() {
+		_spec.ClearField(task.FieldPriority, field.TypeEnum)
+	}
+	if value, ok := tuo.mutation.StoryPoints(); ok {
+		_spec.SetField(task.FieldStoryPoints, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedStoryPoints(); ok {
+		_spec.AddField(task.FieldStoryPoints, field.TypeInt, value)
+	}
+	if tuo.mutation.StoryPointsCleared() {
+		_spec.ClearField(task.FieldStoryPoints, field.TypeInt)
+	}
+	if value, ok := tuo.mutation.WipOverrideBy(); ok {
+		_spec.SetField(task.FieldWipOverrideBy, field.TypeString, value)
+	}
+	if tuo.mutation.WipOverrideByCleared() {
+		_spec.ClearField(task.FieldWipOverrideBy, field.TypeString)
+	}
+	if value, ok := tuo.mutation.WipOverrideStatus(); ok {
+		_spec.SetField(task.FieldWipOverrideStatus, field.TypeString, value)
+	}
+	if tuo.mutation.WipOverrideStatusCleared() {
+		_spec.ClearField(task.FieldWipOverrideStatus, field.TypeString)
+	}
+	if value, ok := tuo.mutation.WipOverrideAt(); ok {
+		_spec.SetField(task.FieldWipOverrideAt, field.TypeTime, value)
+	}
+	if tuo.mutation.WipOverrideAtCleared() {
+		_spec.ClearField(task.FieldWipOverrideAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Version(); ok {
+		_spec.SetField(task.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedVersion(); ok {
+		_spec.AddField(task.FieldVersion, field.TypeInt, value)
+	}
+	if tuo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.FilesTable,
+			Columns: task.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedFilesIDs(); len(nodes) > 0 && !tuo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.FilesTable,
+			Columns: task.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.FilesTable,
+			Columns: task.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.BoardTable,
+			Columns: []string{task.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.BoardTable,
+			Columns: []string{task.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ParentTable,
+			Columns: []string{task.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !tuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChildrenTable,
+			Columns: []string{task.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !tuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.BlockedByTable,
+			Columns: task.BlockedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !tuo.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo
-}
-
-// ClearAssigneeName clears the value of the "assignee_name" field.
-func (tuo *TaskUpdateOne) ClearAssigneeName() *TaskUpdateOne {
-	tuo.mutation.ClearAssigneeName()
-	return tuo
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (tuo *TaskUpdateOne) SetCreatedAt(t time.Time) *TaskUpdateOne {
-	tuo.mutation.SetCreatedAt(t)
-	return tuo
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableCreatedAt(t *time.Time) *TaskUpdateOne {
-	if t != nil {
-		tuo.SetCreatedAt(*t)
+	if nodes := tuo.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.BlocksTable,
+			Columns: task.BlocksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return tuo
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (tuo *TaskUpdateOne) SetUpdatedAt(t time.Time) *TaskUpdateOne {
-	tuo.mutation.SetUpdatedAt(t)
-	return tuo
-}
-
-// SetCompletedAt sets the "completed_at" field.
-func (tuo *TaskUpdateOne) SetCompletedAt(t time.Time) *TaskUpdateOne {
-	tuo.mutation.SetCompletedAt(t)
-	return tuo
-}
-
-// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableCompletedAt(t *time.Time) *TaskUpdateOne {
-	if t != nil {
-		tuo.SetCompletedAt(*t)
+	if tuo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo
-}
-
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (tuo *TaskUpdateOne) ClearCompletedAt() *TaskUpdateOne {
-	tuo.mutation.ClearCompletedAt()
-	return tuo
-}
-
-// SetStatus sets the "status" field.
-func (tuo *TaskUpdateOne) SetStatus(t task.Status) *TaskUpdateOne {
-	tuo.mutation.SetStatus(t)
-	return tuo
-}
-
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (tuo *TaskUpdateOne) SetNillableStatus(t *task.Status) *TaskUpdateOne {
-	if t != nil {
-		tuo.SetStatus(*t)
+	if nodes := tuo.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !tuo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo
-}
-
-// AddFileIDs adds the "files" edge to the File entity by IDs.
-func (tuo *TaskUpdateOne) AddFileIDs(ids ...string) *TaskUpdateOne {
-	tuo.mutation.AddFileIDs(ids...)
-	return tuo
-}
-
-// AddFiles adds the "files" edges to the File entity.
-func (tuo *TaskUpdateOne) AddFiles(f ...*File) *TaskUpdateOne {
-	ids := make([]string, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := tuo.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return tuo.AddFileIDs(ids...)
-}
-
-// Mutation returns the TaskMutation object of the builder.
-func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
-	return tuo.mutation
-}
-
-// ClearFiles clears all "files" edges to the File entity.
-func (tuo *TaskUpdateOne) ClearFiles() *TaskUpdateOne {
-	tuo.mutation.ClearFiles()
-	return tuo
-}
-
-// RemoveFileIDs removes the "files" edge to File entities by IDs.
-func (tuo *TaskUpdateOne) RemoveFileIDs(ids ...string) *TaskUpdateOne {
-	tuo.mutation.RemoveFileIDs(ids...)
-	return tuo
-}
-
-// RemoveFiles removes "files" edges to File entities.
-func (tuo *TaskUpdateOne) RemoveFiles(f ...*File) *TaskUpdateOne {
-	ids := make([]string, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if tuo.mutation.SprintCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return tuo.RemoveFileIDs(ids...)
-}
-
-// Where appends a list predicates to the TaskUpdate builder.
-func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
-	tuo.mutation.Where(ps...)
-	return tuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (tuo *TaskUpdateOne) Select(field string, fields ...string) *TaskUpdateOne {
-	tuo.fields = append([]string{field}, fields...)
-	return tuo
-}
-
-// Save executes the query and returns the updated Task entity.
-func (tuo *TaskUpdateOne) Save(ctx context.Context) (*Task, error) {
-	tuo.defaults()
-	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (tuo *TaskUpdateOne) SaveX(ctx context.Context) *Task {
-	node, err := tuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := tuo.mutation.SprintIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.SprintTable,
+			Columns: []string{task.SprintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sprint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (tuo *TaskUpdateOne) Exec(ctx context.Context) error {
-	_, err := tuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (tuo *TaskUpdateOne) ExecX(ctx context.Context) {
-	if err := tuo.Exec(ctx); err != nil {
-		panic(err)
+	if tuo.mutation.EpicCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.EpicTable,
+			Columns: []string{task.EpicColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.EpicIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.EpicTable,
+			Columns: []string{task.EpicColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(epic.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !tuo.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (tuo *TaskUpdateOne) defaults() {
-	if _, ok := tuo.mutation.UpdatedAt(); !ok {
-		v := task.UpdateDefaultUpdatedAt()
-		tuo.mutation.SetUpdatedAt(v)
+	if nodes := tuo.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (tuo *TaskUpdateOne) check() error {
-	if v, ok := tuo.mutation.Status(); ok {
-		if err := task.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+	if tuo.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
-	if err := tuo.check(); err != nil {
-		return _node, err
+	if nodes := tuo.mutation.RemovedAssigneesIDs(); len(nodes) > 0 && !tuo.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeString))
-	id, ok := tuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Task.id" for update`)}
+	if nodes := tuo.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: task.AssigneesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := tuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, task.FieldID)
-		for _, f := range fields {
-			if !task.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != task.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if tuo.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := tuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := tuo.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !tuo.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.Title(); ok {
-		_spec.SetField(task.FieldTitle, field.TypeString, value)
+	if nodes := tuo.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := tuo.mutation.Description(); ok {
-		_spec.SetField(task.FieldDescription, field.TypeString, value)
+	if tuo.mutation.ChecklistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChecklistItemsTable,
+			Columns: []string{task.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if tuo.mutation.DescriptionCleared() {
-		_spec.ClearField(task.FieldDescription, field.TypeString)
+	if nodes := tuo.mutation.RemovedChecklistItemsIDs(); len(nodes) > 0 && !tuo.mutation.ChecklistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChecklistItemsTable,
+			Columns: []string{task.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.DueDate(); ok {
-		_spec.SetField(task.FieldDueDate, field.TypeTime, value)
+	if nodes := tuo.mutation.ChecklistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ChecklistItemsTable,
+			Columns: []string{task.ChecklistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checklistitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if tuo.mutation.DueDateCleared() {
-		_spec.ClearField(task.FieldDueDate, field.TypeTime)
+	if tuo.mutation.CustomFieldValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CustomFieldValuesTable,
+			Columns: []string{task.CustomFieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.AssigneeName(); ok {
-		_spec.SetField(task.FieldAssigneeName, field.TypeString, value)
+	if nodes := tuo.mutation.RemovedCustomFieldValuesIDs(); len(nodes) > 0 && !tuo.mutation.CustomFieldValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CustomFieldValuesTable,
+			Columns: []string{task.CustomFieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if tuo.mutation.AssigneeNameCleared() {
-		_spec.ClearField(task.FieldAssigneeName, field.TypeString)
+	if nodes := tuo.mutation.CustomFieldValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CustomFieldValuesTable,
+			Columns: []string{task.CustomFieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := tuo.mutation.CreatedAt(); ok {
-		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
+	if tuo.mutation.WorklogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorklogsTable,
+			Columns: []string{task.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := tuo.mutation.RemovedWorklogsIDs(); len(nodes) > 0 && !tuo.mutation.WorklogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorklogsTable,
+			Columns: []string{task.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.CompletedAt(); ok {
-		_spec.SetField(task.FieldCompletedAt, field.TypeTime, value)
+	if nodes := tuo.mutation.WorklogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WorklogsTable,
+			Columns: []string{task.WorklogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if tuo.mutation.CompletedAtCleared() {
-		_spec.ClearField(task.FieldCompletedAt, field.TypeTime)
+	if tuo.mutation.TimersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.TimersTable,
+			Columns: []string{task.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := tuo.mutation.Status(); ok {
-		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
+	if nodes := tuo.mutation.RemovedTimersIDs(); len(nodes) > 0 && !tuo.mutation.TimersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.TimersTable,
+			Columns: []string{task.TimersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if tuo.mutation.FilesCleared() {
+	if nodes := tuo.mutation.TimersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.FilesTable,
-			Columns: task.FilesPrimaryKey,
+			Table:   task.TimersTable,
+			Columns: []string{task.TimersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.RemovedFilesIDs(); len(nodes) > 0 && !tuo.mutation.FilesCleared() {
+	if nodes := tuo.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !tuo.mutation.StatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.FilesTable,
-			Columns: task.FilesPrimaryKey,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -679,15 +3749,15 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := tuo.mutation.FilesIDs(); len(nodes) > 0 {
+	if nodes := tuo.mutation.StatusChangesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   task.FilesTable,
-			Columns: task.FilesPrimaryKey,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(statuschange.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
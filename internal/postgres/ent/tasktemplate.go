@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
+)
+
+// TaskTemplate is the model entity for the TaskTemplate schema.
+type TaskTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// BoardID holds the value of the "board_id" field.
+	BoardID string `json:"board_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// LabelIds holds the value of the "label_ids" field.
+	LabelIds []string `json:"label_ids,omitempty"`
+	// DefaultAssigneeID holds the value of the "default_assignee_id" field.
+	DefaultAssigneeID *string `json:"default_assignee_id,omitempty"`
+	// Subtasks holds the value of the "subtasks" field.
+	Subtasks []string `json:"subtasks,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskTemplateQuery when eager-loading is set.
+	Edges        TaskTemplateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskTemplateEdges holds the relations/edges for other nodes in the graph.
+type TaskTemplateEdges struct {
+	// Board holds the value of the board edge.
+	Board *Board `json:"board,omitempty"`
+	// Files holds the value of the files edge.
+	Files []*File `json:"files,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BoardOrErr returns the Board value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskTemplateEdges) BoardOrErr() (*Board, error) {
+	if e.Board != nil {
+		return e.Board, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: board.Label}
+	}
+	return nil, &NotLoadedError{edge: "board"}
+}
+
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskTemplateEdges) FilesOrErr() ([]*File, error) {
+	if e.loadedTypes[1] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tasktemplate.FieldLabelIds, tasktemplate.FieldSubtasks:
+			values[i] = new([]byte)
+		case tasktemplate.FieldID, tasktemplate.FieldBoardID, tasktemplate.FieldTitle, tasktemplate.FieldDescription, tasktemplate.FieldDefaultAssigneeID:
+			values[i] = new(sql.NullString)
+		case tasktemplate.FieldCreatedAt, tasktemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskTemplate fields.
+func (tt *TaskTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tasktemplate.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				tt.ID = value.String
+			}
+		case tasktemplate.FieldBoardID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field board_id", values[i])
+			} else if value.Valid {
+				tt.BoardID = value.String
+			}
+		case tasktemplate.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				tt.Title = value.String
+			}
+		case tasktemplate.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				tt.Description = new(string)
+				*tt.Description = value.String
+			}
+		case tasktemplate.FieldLabelIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field label_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tt.LabelIds); err != nil {
+					return fmt.Errorf("unmarshal field label_ids: %w", err)
+				}
+			}
+		case tasktemplate.FieldDefaultAssigneeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_assignee_id", values[i])
+			} else if value.Valid {
+				tt.DefaultAssigneeID = new(string)
+				*tt.DefaultAssigneeID = value.String
+			}
+		case tasktemplate.FieldSubtasks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field subtasks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tt.Subtasks); err != nil {
+					return fmt.Errorf("unmarshal field subtasks: %w", err)
+				}
+			}
+		case tasktemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tt.CreatedAt = value.Time
+			}
+		case tasktemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tt.UpdatedAt = value.Time
+			}
+		default:
+			tt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskTemplate.
+// This includes values selected through modifiers, order, etc.
+func (tt *TaskTemplate) Value(name string) (ent.Value, error) {
+	return tt.selectValues.Get(name)
+}
+
+// QueryBoard queries the "board" edge of the TaskTemplate entity.
+func (tt *TaskTemplate) QueryBoard() *BoardQuery {
+	return NewTaskTemplateClient(tt.config).QueryBoard(tt)
+}
+
+// QueryFiles queries the "files" edge of the TaskTemplate entity.
+func (tt *TaskTemplate) QueryFiles() *FileQuery {
+	return NewTaskTemplateClient(tt.config).QueryFiles(tt)
+}
+
+// Update returns a builder for updating this TaskTemplate.
+// Note that you need to call TaskTemplate.Unwrap() before calling this method if this TaskTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tt *TaskTemplate) Update() *TaskTemplateUpdateOne {
+	return NewTaskTemplateClient(tt.config).UpdateOne(tt)
+}
+
+// Unwrap unwraps the TaskTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tt *TaskTemplate) Unwrap() *TaskTemplate {
+	_tx, ok := tt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskTemplate is not a transactional entity")
+	}
+	tt.config.driver = _tx.drv
+	return tt
+}
+
+// String implements the fmt.Stringer.
+func (tt *TaskTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tt.ID))
+	builder.WriteString("board_id=")
+	builder.WriteString(tt.BoardID)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(tt.Title)
+	builder.WriteString(", ")
+	if v := tt.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("label_ids=")
+	builder.WriteString(fmt.Sprintf("%v", tt.LabelIds))
+	builder.WriteString(", ")
+	if v := tt.DefaultAssigneeID; v != nil {
+		builder.WriteString("default_assignee_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("subtasks=")
+	builder.WriteString(fmt.Sprintf("%v", tt.Subtasks))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskTemplates is a parsable slice of TaskTemplate.
+type TaskTemplates []*TaskTemplate
@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tasktemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldID, id))
+}
+
+// BoardID applies equality check predicate on the "board_id" field. It's identical to BoardIDEQ.
+func BoardID(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldBoardID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DefaultAssigneeID applies equality check predicate on the "default_assignee_id" field. It's identical to DefaultAssigneeIDEQ.
+func DefaultAssigneeID(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDefaultAssigneeID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// BoardIDEQ applies the EQ predicate on the "board_id" field.
+func BoardIDEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldBoardID, v))
+}
+
+// BoardIDNEQ applies the NEQ predicate on the "board_id" field.
+func BoardIDNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldBoardID, v))
+}
+
+// BoardIDIn applies the In predicate on the "board_id" field.
+func BoardIDIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldBoardID, vs...))
+}
+
+// BoardIDNotIn applies the NotIn predicate on the "board_id" field.
+func BoardIDNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldBoardID, vs...))
+}
+
+// BoardIDGT applies the GT predicate on the "board_id" field.
+func BoardIDGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldBoardID, v))
+}
+
+// BoardIDGTE applies the GTE predicate on the "board_id" field.
+func BoardIDGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldBoardID, v))
+}
+
+// BoardIDLT applies the LT predicate on the "board_id" field.
+func BoardIDLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldBoardID, v))
+}
+
+// BoardIDLTE applies the LTE predicate on the "board_id" field.
+func BoardIDLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldBoardID, v))
+}
+
+// BoardIDContains applies the Contains predicate on the "board_id" field.
+func BoardIDContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldBoardID, v))
+}
+
+// BoardIDHasPrefix applies the HasPrefix predicate on the "board_id" field.
+func BoardIDHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldBoardID, v))
+}
+
+// BoardIDHasSuffix applies the HasSuffix predicate on the "board_id" field.
+func BoardIDHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldBoardID, v))
+}
+
+// BoardIDEqualFold applies the EqualFold predicate on the "board_id" field.
+func BoardIDEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldBoardID, v))
+}
+
+// BoardIDContainsFold applies the ContainsFold predicate on the "board_id" field.
+func BoardIDContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldBoardID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// LabelIdsIsNil applies the IsNil predicate on the "label_ids" field.
+func LabelIdsIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldLabelIds))
+}
+
+// LabelIdsNotNil applies the NotNil predicate on the "label_ids" field.
+func LabelIdsNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldLabelIds))
+}
+
+// DefaultAssigneeIDEQ applies the EQ predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDNEQ applies the NEQ predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDIn applies the In predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldDefaultAssigneeID, vs...))
+}
+
+// DefaultAssigneeIDNotIn applies the NotIn predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldDefaultAssigneeID, vs...))
+}
+
+// DefaultAssigneeIDGT applies the GT predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDGTE applies the GTE predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDLT applies the LT predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDLTE applies the LTE predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDContains applies the Contains predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDHasPrefix applies the HasPrefix predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDHasSuffix applies the HasSuffix predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDIsNil applies the IsNil predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldDefaultAssigneeID))
+}
+
+// DefaultAssigneeIDNotNil applies the NotNil predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldDefaultAssigneeID))
+}
+
+// DefaultAssigneeIDEqualFold applies the EqualFold predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldDefaultAssigneeID, v))
+}
+
+// DefaultAssigneeIDContainsFold applies the ContainsFold predicate on the "default_assignee_id" field.
+func DefaultAssigneeIDContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldDefaultAssigneeID, v))
+}
+
+// SubtasksIsNil applies the IsNil predicate on the "subtasks" field.
+func SubtasksIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldSubtasks))
+}
+
+// SubtasksNotNil applies the NotNil predicate on the "subtasks" field.
+func SubtasksNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldSubtasks))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasBoard applies the HasEdge predicate on the "board" edge.
+func HasBoard() predicate.TaskTemplate {
+	return predicate.TaskTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoardTable, BoardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoardWith applies the HasEdge predicate on the "board" edge with a given conditions (other predicates).
+func HasBoardWith(preds ...predicate.Board) predicate.TaskTemplate {
+	return predicate.TaskTemplate(func(s *sql.Selector) {
+		step := newBoardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFiles applies the HasEdge predicate on the "files" edge.
+func HasFiles() predicate.TaskTemplate {
+	return predicate.TaskTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, FilesTable, FilesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
+func HasFilesWith(preds ...predicate.File) predicate.TaskTemplate {
+	return predicate.TaskTemplate(func(s *sql.Selector) {
+		step := newFilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskTemplate) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskTemplate) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskTemplate) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.NotPredicates(p))
+}
@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
+)
+
+// TaskTemplateCreate is the builder for creating a TaskTemplate entity.
+type TaskTemplateCreate struct {
+	config
+	mutation *TaskTemplateMutation
+	hooks    []Hook
+}
+
+// SetBoardID sets the "board_id" field.
+func (ttc *TaskTemplateCreate) SetBoardID(s string) *TaskTemplateCreate {
+	ttc.mutation.SetBoardID(s)
+	return ttc
+}
+
+// SetTitle sets the "title" field.
+func (ttc *TaskTemplateCreate) SetTitle(s string) *TaskTemplateCreate {
+	ttc.mutation.SetTitle(s)
+	return ttc
+}
+
+// SetDescription sets the "description" field.
+func (ttc *TaskTemplateCreate) SetDescription(s string) *TaskTemplateCreate {
+	ttc.mutation.SetDescription(s)
+	return ttc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableDescription(s *string) *TaskTemplateCreate {
+	if s != nil {
+		ttc.SetDescription(*s)
+	}
+	return ttc
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (ttc *TaskTemplateCreate) SetLabelIds(s []string) *TaskTemplateCreate {
+	ttc.mutation.SetLabelIds(s)
+	return ttc
+}
+
+// SetDefaultAssigneeID sets the "default_assignee_id" field.
+func (ttc *TaskTemplateCreate) SetDefaultAssigneeID(s string) *TaskTemplateCreate {
+	ttc.mutation.SetDefaultAssigneeID(s)
+	return ttc
+}
+
+// SetNillableDefaultAssigneeID sets the "default_assignee_id" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableDefaultAssigneeID(s *string) *TaskTemplateCreate {
+	if s != nil {
+		ttc.SetDefaultAssigneeID(*s)
+	}
+	return ttc
+}
+
+// SetSubtasks sets the "subtasks" field.
+func (ttc *TaskTemplateCreate) SetSubtasks(s []string) *TaskTemplateCreate {
+	ttc.mutation.SetSubtasks(s)
+	return ttc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ttc *TaskTemplateCreate) SetCreatedAt(t time.Time) *TaskTemplateCreate {
+	ttc.mutation.SetCreatedAt(t)
+	return ttc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableCreatedAt(t *time.Time) *TaskTemplateCreate {
+	if t != nil {
+		ttc.SetCreatedAt(*t)
+	}
+	return ttc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttc *TaskTemplateCreate) SetUpdatedAt(t time.Time) *TaskTemplateCreate {
+	ttc.mutation.SetUpdatedAt(t)
+	return ttc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ttc *TaskTemplateCreate) SetNillableUpdatedAt(t *time.Time) *TaskTemplateCreate {
+	if t != nil {
+		ttc.SetUpdatedAt(*t)
+	}
+	return ttc
+}
+
+// SetID sets the "id" field.
+func (ttc *TaskTemplateCreate) SetID(s string) *TaskTemplateCreate {
+	ttc.mutation.SetID(s)
+	return ttc
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (ttc *TaskTemplateCreate) SetBoard(b *Board) *TaskTemplateCreate {
+	return ttc.SetBoardID(b.ID)
+}
+
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (ttc *TaskTemplateCreate) AddFileIDs(ids ...string) *TaskTemplateCreate {
+	ttc.mutation.AddFileIDs(ids...)
+	return ttc
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (ttc *TaskTemplateCreate) AddFiles(f ...*File) *TaskTemplateCreate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ttc.AddFileIDs(ids...)
+}
+
+// Mutation returns the TaskTemplateMutation object of the builder.
+func (ttc *TaskTemplateCreate) Mutation() *TaskTemplateMutation {
+	return ttc.mutation
+}
+
+// Save creates the TaskTemplate in the database.
+func (ttc *TaskTemplateCreate) Save(ctx context.Context) (*TaskTemplate, error) {
+	ttc.defaults()
+	return withHooks(ctx, ttc.sqlSave, ttc.mutation, ttc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ttc *TaskTemplateCreate) SaveX(ctx context.Context) *TaskTemplate {
+	v, err := ttc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttc *TaskTemplateCreate) Exec(ctx context.Context) error {
+	_, err := ttc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttc *TaskTemplateCreate) ExecX(ctx context.Context) {
+	if err := ttc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttc *TaskTemplateCreate) defaults() {
+	if _, ok := ttc.mutation.CreatedAt(); !ok {
+		v := tasktemplate.DefaultCreatedAt()
+		ttc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ttc.mutation.UpdatedAt(); !ok {
+		v := tasktemplate.DefaultUpdatedAt()
+		ttc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttc *TaskTemplateCreate) check() error {
+	if _, ok := ttc.mutation.BoardID(); !ok {
+		return &ValidationError{Name: "board_id", err: errors.New(`ent: missing required field "TaskTemplate.board_id"`)}
+	}
+	if _, ok := ttc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "TaskTemplate.title"`)}
+	}
+	if _, ok := ttc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskTemplate.created_at"`)}
+	}
+	if _, ok := ttc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaskTemplate.updated_at"`)}
+	}
+	if len(ttc.mutation.BoardIDs()) == 0 {
+		return &ValidationError{Name: "board", err: errors.New(`ent: missing required edge "TaskTemplate.board"`)}
+	}
+	return nil
+}
+
+func (ttc *TaskTemplateCreate) sqlSave(ctx context.Context) (*TaskTemplate, error) {
+	if err := ttc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ttc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ttc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TaskTemplate.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ttc.mutation.id = &_node.ID
+	ttc.mutation.done = true
+	return _node, nil
+}
+
+func (ttc *TaskTemplateCreate) createSpec() (*TaskTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskTemplate{config: ttc.config}
+		_spec = sqlgraph.NewCreateSpec(tasktemplate.Table, sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString))
+	)
+	if id, ok := ttc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ttc.mutation.Title(); ok {
+		_spec.SetField(tasktemplate.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := ttc.mutation.Description(); ok {
+		_spec.SetField(tasktemplate.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := ttc.mutation.LabelIds(); ok {
+		_spec.SetField(tasktemplate.FieldLabelIds, field.TypeJSON, value)
+		_node.LabelIds = value
+	}
+	if value, ok := ttc.mutation.DefaultAssigneeID(); ok {
+		_spec.SetField(tasktemplate.FieldDefaultAssigneeID, field.TypeString, value)
+		_node.DefaultAssigneeID = &value
+	}
+	if value, ok := ttc.mutation.Subtasks(); ok {
+		_spec.SetField(tasktemplate.FieldSubtasks, field.TypeJSON, value)
+		_node.Subtasks = value
+	}
+	if value, ok := ttc.mutation.CreatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ttc.mutation.UpdatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ttc.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.BoardTable,
+			Columns: []string{tasktemplate.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ttc.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tasktemplate.FilesTable,
+			Columns: tasktemplate.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskTemplateCreateBulk is the builder for creating many TaskTemplate entities in bulk.
+type TaskTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*TaskTemplateCreate
+}
+
+// Save creates the TaskTemplate entities in the database.
+func (ttcb *TaskTemplateCreateBulk) Save(ctx context.Context) ([]*TaskTemplate, error) {
+	if ttcb.err != nil {
+		return nil, ttcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ttcb.builders))
+	nodes := make([]*TaskTemplate, len(ttcb.builders))
+	mutators := make([]Mutator, len(ttcb.builders))
+	for i := range ttcb.builders {
+		func(i int, root context.Context) {
+			builder := ttcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ttcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ttcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ttcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttcb *TaskTemplateCreateBulk) SaveX(ctx context.Context) []*TaskTemplate {
+	v, err := ttcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ttcb *TaskTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ttcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttcb *TaskTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := ttcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,795 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
+)
+
+// TaskTemplateUpdate is the builder for updating TaskTemplate entities.
+type TaskTemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskTemplateMutation
+}
+
+// Where appends a list predicates to the TaskTemplateUpdate builder.
+func (ttu *TaskTemplateUpdate) Where(ps ...predicate.TaskTemplate) *TaskTemplateUpdate {
+	ttu.mutation.Where(ps...)
+	return ttu
+}
+
+// SetBoardID sets the "board_id" field.
+func (ttu *TaskTemplateUpdate) SetBoardID(s string) *TaskTemplateUpdate {
+	ttu.mutation.SetBoardID(s)
+	return ttu
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableBoardID(s *string) *TaskTemplateUpdate {
+	if s != nil {
+		ttu.SetBoardID(*s)
+	}
+	return ttu
+}
+
+// SetTitle sets the "title" field.
+func (ttu *TaskTemplateUpdate) SetTitle(s string) *TaskTemplateUpdate {
+	ttu.mutation.SetTitle(s)
+	return ttu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableTitle(s *string) *TaskTemplateUpdate {
+	if s != nil {
+		ttu.SetTitle(*s)
+	}
+	return ttu
+}
+
+// SetDescription sets the "description" field.
+func (ttu *TaskTemplateUpdate) SetDescription(s string) *TaskTemplateUpdate {
+	ttu.mutation.SetDescription(s)
+	return ttu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableDescription(s *string) *TaskTemplateUpdate {
+	if s != nil {
+		ttu.SetDescription(*s)
+	}
+	return ttu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ttu *TaskTemplateUpdate) ClearDescription() *TaskTemplateUpdate {
+	ttu.mutation.ClearDescription()
+	return ttu
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (ttu *TaskTemplateUpdate) SetLabelIds(s []string) *TaskTemplateUpdate {
+	ttu.mutation.SetLabelIds(s)
+	return ttu
+}
+
+// AppendLabelIds appends s to the "label_ids" field.
+func (ttu *TaskTemplateUpdate) AppendLabelIds(s []string) *TaskTemplateUpdate {
+	ttu.mutation.AppendLabelIds(s)
+	return ttu
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (ttu *TaskTemplateUpdate) ClearLabelIds() *TaskTemplateUpdate {
+	ttu.mutation.ClearLabelIds()
+	return ttu
+}
+
+// SetDefaultAssigneeID sets the "default_assignee_id" field.
+func (ttu *TaskTemplateUpdate) SetDefaultAssigneeID(s string) *TaskTemplateUpdate {
+	ttu.mutation.SetDefaultAssigneeID(s)
+	return ttu
+}
+
+// SetNillableDefaultAssigneeID sets the "default_assignee_id" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableDefaultAssigneeID(s *string) *TaskTemplateUpdate {
+	if s != nil {
+		ttu.SetDefaultAssigneeID(*s)
+	}
+	return ttu
+}
+
+// ClearDefaultAssigneeID clears the value of the "default_assignee_id" field.
+func (ttu *TaskTemplateUpdate) ClearDefaultAssigneeID() *TaskTemplateUpdate {
+	ttu.mutation.ClearDefaultAssigneeID()
+	return ttu
+}
+
+// SetSubtasks sets the "subtasks" field.
+func (ttu *TaskTemplateUpdate) SetSubtasks(s []string) *TaskTemplateUpdate {
+	ttu.mutation.SetSubtasks(s)
+	return ttu
+}
+
+// AppendSubtasks appends s to the "subtasks" field.
+func (ttu *TaskTemplateUpdate) AppendSubtasks(s []string) *TaskTemplateUpdate {
+	ttu.mutation.AppendSubtasks(s)
+	return ttu
+}
+
+// ClearSubtasks clears the value of the "subtasks" field.
+func (ttu *TaskTemplateUpdate) ClearSubtasks() *TaskTemplateUpdate {
+	ttu.mutation.ClearSubtasks()
+	return ttu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ttu *TaskTemplateUpdate) SetCreatedAt(t time.Time) *TaskTemplateUpdate {
+	ttu.mutation.SetCreatedAt(t)
+	return ttu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ttu *TaskTemplateUpdate) SetNillableCreatedAt(t *time.Time) *TaskTemplateUpdate {
+	if t != nil {
+		ttu.SetCreatedAt(*t)
+	}
+	return ttu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttu *TaskTemplateUpdate) SetUpdatedAt(t time.Time) *TaskTemplateUpdate {
+	ttu.mutation.SetUpdatedAt(t)
+	return ttu
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (ttu *TaskTemplateUpdate) SetBoard(b *Board) *TaskTemplateUpdate {
+	return ttu.SetBoardID(b.ID)
+}
+
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (ttu *TaskTemplateUpdate) AddFileIDs(ids ...string) *TaskTemplateUpdate {
+	ttu.mutation.AddFileIDs(ids...)
+	return ttu
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (ttu *TaskTemplateUpdate) AddFiles(f ...*File) *TaskTemplateUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ttu.AddFileIDs(ids...)
+}
+
+// Mutation returns the TaskTemplateMutation object of the builder.
+func (ttu *TaskTemplateUpdate) Mutation() *TaskTemplateMutation {
+	return ttu.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (ttu *TaskTemplateUpdate) ClearBoard() *TaskTemplateUpdate {
+	ttu.mutation.ClearBoard()
+	return ttu
+}
+
+// ClearFiles clears all "files" edges to the File entity.
+func (ttu *TaskTemplateUpdate) ClearFiles() *TaskTemplateUpdate {
+	ttu.mutation.ClearFiles()
+	return ttu
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (ttu *TaskTemplateUpdate) RemoveFileIDs(ids ...string) *TaskTemplateUpdate {
+	ttu.mutation.RemoveFileIDs(ids...)
+	return ttu
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (ttu *TaskTemplateUpdate) RemoveFiles(f ...*File) *TaskTemplateUpdate {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ttu.RemoveFileIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ttu *TaskTemplateUpdate) Save(ctx context.Context) (int, error) {
+	ttu.defaults()
+	return withHooks(ctx, ttu.sqlSave, ttu.mutation, ttu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttu *TaskTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := ttu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ttu *TaskTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := ttu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttu *TaskTemplateUpdate) ExecX(ctx context.Context) {
+	if err := ttu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttu *TaskTemplateUpdate) defaults() {
+	if _, ok := ttu.mutation.UpdatedAt(); !ok {
+		v := tasktemplate.UpdateDefaultUpdatedAt()
+		ttu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttu *TaskTemplateUpdate) check() error {
+	if ttu.mutation.BoardCleared() && len(ttu.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskTemplate.board"`)
+	}
+	return nil
+}
+
+func (ttu *TaskTemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ttu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tasktemplate.Table, tasktemplate.Columns, sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString))
+	if ps := ttu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttu.mutation.Title(); ok {
+		_spec.SetField(tasktemplate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ttu.mutation.Description(); ok {
+		_spec.SetField(tasktemplate.FieldDescription, field.TypeString, value)
+	}
+	if ttu.mutation.DescriptionCleared() {
+		_spec.ClearField(tasktemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := ttu.mutation.LabelIds(); ok {
+		_spec.SetField(tasktemplate.FieldLabelIds, field.TypeJSON, value)
+	}
+	if value, ok := ttu.mutation.AppendedLabelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldLabelIds, value)
+		})
+	}
+	if ttu.mutation.LabelIdsCleared() {
+		_spec.ClearField(tasktemplate.FieldLabelIds, field.TypeJSON)
+	}
+	if value, ok := ttu.mutation.DefaultAssigneeID(); ok {
+		_spec.SetField(tasktemplate.FieldDefaultAssigneeID, field.TypeString, value)
+	}
+	if ttu.mutation.DefaultAssigneeIDCleared() {
+		_spec.ClearField(tasktemplate.FieldDefaultAssigneeID, field.TypeString)
+	}
+	if value, ok := ttu.mutation.Subtasks(); ok {
+		_spec.SetField(tasktemplate.FieldSubtasks, field.TypeJSON, value)
+	}
+	if value, ok := ttu.mutation.AppendedSubtasks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldSubtasks, value)
+		})
+	}
+	if ttu.mutation.SubtasksCleared() {
+		_spec.ClearField(tasktemplate.FieldSubtasks, field.TypeJSON)
+	}
+	if value, ok := ttu.mutation.CreatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ttu.mutation.UpdatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ttu.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.BoardTable,
+			Columns: []string{tasktemplate.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttu.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.BoardTable,
+			Columns: []string{tasktemplate.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ttu.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tasktemplate.FilesTable,
+			Columns: tasktemplate.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttu.mutation.RemovedFilesIDs(); len(nodes) > 0 && !ttu.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tasktemplate.FilesTable,
+			Columns: tasktemplate.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttu.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tasktemplate.FilesTable,
+			Columns: tasktemplate.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ttu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasktemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ttu.mutation.done = true
+	return n, nil
+}
+
+// TaskTemplateUpdateOne is the builder for updating a single TaskTemplate entity.
+type TaskTemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskTemplateMutation
+}
+
+// SetBoardID sets the "board_id" field.
+func (ttuo *TaskTemplateUpdateOne) SetBoardID(s string) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetBoardID(s)
+	return ttuo
+}
+
+// SetNillableBoardID sets the "board_id" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableBoardID(s *string) *TaskTemplateUpdateOne {
+	if s != nil {
+		ttuo.SetBoardID(*s)
+	}
+	return ttuo
+}
+
+// SetTitle sets the "title" field.
+func (ttuo *TaskTemplateUpdateOne) SetTitle(s string) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetTitle(s)
+	return ttuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableTitle(s *string) *TaskTemplateUpdateOne {
+	if s != nil {
+		ttuo.SetTitle(*s)
+	}
+	return ttuo
+}
+
+// SetDescription sets the "description" field.
+func (ttuo *TaskTemplateUpdateOne) SetDescription(s string) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetDescription(s)
+	return ttuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableDescription(s *string) *TaskTemplateUpdateOne {
+	if s != nil {
+		ttuo.SetDescription(*s)
+	}
+	return ttuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ttuo *TaskTemplateUpdateOne) ClearDescription() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearDescription()
+	return ttuo
+}
+
+// SetLabelIds sets the "label_ids" field.
+func (ttuo *TaskTemplateUpdateOne) SetLabelIds(s []string) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetLabelIds(s)
+	return ttuo
+}
+
+// AppendLabelIds appends s to the "label_ids" field.
+func (ttuo *TaskTemplateUpdateOne) AppendLabelIds(s []string) *TaskTemplateUpdateOne {
+	ttuo.mutation.AppendLabelIds(s)
+	return ttuo
+}
+
+// ClearLabelIds clears the value of the "label_ids" field.
+func (ttuo *TaskTemplateUpdateOne) ClearLabelIds() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearLabelIds()
+	return ttuo
+}
+
+// SetDefaultAssigneeID sets the "default_assignee_id" field.
+func (ttuo *TaskTemplateUpdateOne) SetDefaultAssigneeID(s string) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetDefaultAssigneeID(s)
+	return ttuo
+}
+
+// SetNillableDefaultAssigneeID sets the "default_assignee_id" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableDefaultAssigneeID(s *string) *TaskTemplateUpdateOne {
+	if s != nil {
+		ttuo.SetDefaultAssigneeID(*s)
+	}
+	return ttuo
+}
+
+// ClearDefaultAssigneeID clears the value of the "default_assignee_id" field.
+func (ttuo *TaskTemplateUpdateOne) ClearDefaultAssigneeID() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearDefaultAssigneeID()
+	return ttuo
+}
+
+// SetSubtasks sets the "subtasks" field.
+func (ttuo *TaskTemplateUpdateOne) SetSubtasks(s []string) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetSubtasks(s)
+	return ttuo
+}
+
+// AppendSubtasks appends s to the "subtasks" field.
+func (ttuo *TaskTemplateUpdateOne) AppendSubtasks(s []string) *TaskTemplateUpdateOne {
+	ttuo.mutation.AppendSubtasks(s)
+	return ttuo
+}
+
+// ClearSubtasks clears the value of the "subtasks" field.
+func (ttuo *TaskTemplateUpdateOne) ClearSubtasks() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearSubtasks()
+	return ttuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ttuo *TaskTemplateUpdateOne) SetCreatedAt(t time.Time) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetCreatedAt(t)
+	return ttuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ttuo *TaskTemplateUpdateOne) SetNillableCreatedAt(t *time.Time) *TaskTemplateUpdateOne {
+	if t != nil {
+		ttuo.SetCreatedAt(*t)
+	}
+	return ttuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ttuo *TaskTemplateUpdateOne) SetUpdatedAt(t time.Time) *TaskTemplateUpdateOne {
+	ttuo.mutation.SetUpdatedAt(t)
+	return ttuo
+}
+
+// SetBoard sets the "board" edge to the Board entity.
+func (ttuo *TaskTemplateUpdateOne) SetBoard(b *Board) *TaskTemplateUpdateOne {
+	return ttuo.SetBoardID(b.ID)
+}
+
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (ttuo *TaskTemplateUpdateOne) AddFileIDs(ids ...string) *TaskTemplateUpdateOne {
+	ttuo.mutation.AddFileIDs(ids...)
+	return ttuo
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (ttuo *TaskTemplateUpdateOne) AddFiles(f ...*File) *TaskTemplateUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ttuo.AddFileIDs(ids...)
+}
+
+// Mutation returns the TaskTemplateMutation object of the builder.
+func (ttuo *TaskTemplateUpdateOne) Mutation() *TaskTemplateMutation {
+	return ttuo.mutation
+}
+
+// ClearBoard clears the "board" edge to the Board entity.
+func (ttuo *TaskTemplateUpdateOne) ClearBoard() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearBoard()
+	return ttuo
+}
+
+// ClearFiles clears all "files" edges to the File entity.
+func (ttuo *TaskTemplateUpdateOne) ClearFiles() *TaskTemplateUpdateOne {
+	ttuo.mutation.ClearFiles()
+	return ttuo
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (ttuo *TaskTemplateUpdateOne) RemoveFileIDs(ids ...string) *TaskTemplateUpdateOne {
+	ttuo.mutation.RemoveFileIDs(ids...)
+	return ttuo
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (ttuo *TaskTemplateUpdateOne) RemoveFiles(f ...*File) *TaskTemplateUpdateOne {
+	ids := make([]string, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ttuo.RemoveFileIDs(ids...)
+}
+
+// Where appends a list predicates to the TaskTemplateUpdate builder.
+func (ttuo *TaskTemplateUpdateOne) Where(ps ...predicate.TaskTemplate) *TaskTemplateUpdateOne {
+	ttuo.mutation.Where(ps...)
+	return ttuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ttuo *TaskTemplateUpdateOne) Select(field string, fields ...string) *TaskTemplateUpdateOne {
+	ttuo.fields = append([]string{field}, fields...)
+	return ttuo
+}
+
+// Save executes the query and returns the updated TaskTemplate entity.
+func (ttuo *TaskTemplateUpdateOne) Save(ctx context.Context) (*TaskTemplate, error) {
+	ttuo.defaults()
+	return withHooks(ctx, ttuo.sqlSave, ttuo.mutation, ttuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ttuo *TaskTemplateUpdateOne) SaveX(ctx context.Context) *TaskTemplate {
+	node, err := ttuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ttuo *TaskTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ttuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ttuo *TaskTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := ttuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ttuo *TaskTemplateUpdateOne) defaults() {
+	if _, ok := ttuo.mutation.UpdatedAt(); !ok {
+		v := tasktemplate.UpdateDefaultUpdatedAt()
+		ttuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ttuo *TaskTemplateUpdateOne) check() error {
+	if ttuo.mutation.BoardCleared() && len(ttuo.mutation.BoardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskTemplate.board"`)
+	}
+	return nil
+}
+
+func (ttuo *TaskTemplateUpdateOne) sqlSave(ctx context.Context) (_node *TaskTemplate, err error) {
+	if err := ttuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tasktemplate.Table, tasktemplate.Columns, sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeString))
+	id, ok := ttuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ttuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tasktemplate.FieldID)
+		for _, f := range fields {
+			if !tasktemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tasktemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ttuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ttuo.mutation.Title(); ok {
+		_spec.SetField(tasktemplate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ttuo.mutation.Description(); ok {
+		_spec.SetField(tasktemplate.FieldDescription, field.TypeString, value)
+	}
+	if ttuo.mutation.DescriptionCleared() {
+		_spec.ClearField(tasktemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := ttuo.mutation.LabelIds(); ok {
+		_spec.SetField(tasktemplate.FieldLabelIds, field.TypeJSON, value)
+	}
+	if value, ok := ttuo.mutation.AppendedLabelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldLabelIds, value)
+		})
+	}
+	if ttuo.mutation.LabelIdsCleared() {
+		_spec.ClearField(tasktemplate.FieldLabelIds, field.TypeJSON)
+	}
+	if value, ok := ttuo.mutation.DefaultAssigneeID(); ok {
+		_spec.SetField(tasktemplate.FieldDefaultAssigneeID, field.TypeString, value)
+	}
+	if ttuo.mutation.DefaultAssigneeIDCleared() {
+		_spec.ClearField(tasktemplate.FieldDefaultAssigneeID, field.TypeString)
+	}
+	if value, ok := ttuo.mutation.Subtasks(); ok {
+		_spec.SetField(tasktemplate.FieldSubtasks, field.TypeJSON, value)
+	}
+	if value, ok := ttuo.mutation.AppendedSubtasks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldSubtasks, value)
+		})
+	}
+	if ttuo.mutation.SubtasksCleared() {
+		_spec.ClearField(tasktemplate.FieldSubtasks, field.TypeJSON)
+	}
+	if value, ok := ttuo.mutation.CreatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ttuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ttuo.mutation.BoardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.BoardTable,
+			Columns: []string{tasktemplate.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttuo.mutation.BoardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tasktemplate.BoardTable,
+			Columns: []string{tasktemplate.BoardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(board.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ttuo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tasktemplate.FilesTable,
+			Columns: tasktemplate.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttuo.mutation.RemovedFilesIDs(); len(nodes) > 0 && !ttuo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tasktemplate.FilesTable,
+			Columns: tasktemplate.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ttuo.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   tasktemplate.FilesTable,
+			Columns: tasktemplate.FilesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskTemplate{config: ttuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ttuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasktemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ttuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+)
+
+// Timer is the model entity for the Timer schema.
+type Timer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// MemberID holds the value of the "member_id" field.
+	MemberID string `json:"member_id,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TimerQuery when eager-loading is set.
+	Edges        TimerEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TimerEdges holds the relations/edges for other nodes in the graph.
+type TimerEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// Member holds the value of the member edge.
+	Member *Member `json:"member,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TimerEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// MemberOrErr returns the Member value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TimerEdges) MemberOrErr() (*Member, error) {
+	if e.Member != nil {
+		return e.Member, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "member"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Timer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case timer.FieldID:
+			values[i] = new(sql.NullInt64)
+		case timer.FieldTaskID, timer.FieldMemberID:
+			values[i] = new(sql.NullString)
+		case timer.FieldStartedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Timer fields.
+func (t *Timer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case timer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int(value.Int64)
+		case timer.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				t.TaskID = value.String
+			}
+		case timer.FieldMemberID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value.Valid {
+				t.MemberID = value.String
+			}
+		case timer.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				t.StartedAt = value.Time
+			}
+		default:
+			t.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Timer.
+// This includes values selected through modifiers, order, etc.
+func (t *Timer) Value(name string) (ent.Value, error) {
+	return t.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the Timer entity.
+func (t *Timer) QueryTask() *TaskQuery {
+	return NewTimerClient(t.config).QueryTask(t)
+}
+
+// QueryMember queries the "member" edge of the Timer entity.
+func (t *Timer) QueryMember() *MemberQuery {
+	return NewTimerClient(t.config).QueryMember(t)
+}
+
+// Update returns a builder for updating this Timer.
+// Note that you need to call Timer.Unwrap() before calling this method if this Timer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Timer) Update() *TimerUpdateOne {
+	return NewTimerClient(t.config).UpdateOne(t)
+}
+
+// Unwrap unwraps the Timer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Timer) Unwrap() *Timer {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Timer is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Timer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Timer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(t.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("member_id=")
+	builder.WriteString(t.MemberID)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(t.StartedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Timers is a parsable slice of Timer.
+type Timers []*Timer
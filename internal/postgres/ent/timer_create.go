@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+)
+
+// TimerCreate is the builder for creating a Timer entity.
+type TimerCreate struct {
+	config
+	mutation *TimerMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (tc *TimerCreate) SetTaskID(s string) *TimerCreate {
+	tc.mutation.SetTaskID(s)
+	return tc
+}
+
+// SetMemberID sets the "member_id" field.
+func (tc *TimerCreate) SetMemberID(s string) *TimerCreate {
+	tc.mutation.SetMemberID(s)
+	return tc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tc *TimerCreate) SetStartedAt(t time.Time) *TimerCreate {
+	tc.mutation.SetStartedAt(t)
+	return tc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tc *TimerCreate) SetNillableStartedAt(t *time.Time) *TimerCreate {
+	if t != nil {
+		tc.SetStartedAt(*t)
+	}
+	return tc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tc *TimerCreate) SetTask(t *Task) *TimerCreate {
+	return tc.SetTaskID(t.ID)
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (tc *TimerCreate) SetMember(m *Member) *TimerCreate {
+	return tc.SetMemberID(m.ID)
+}
+
+// Mutation returns the TimerMutation object of the builder.
+func (tc *TimerCreate) Mutation() *TimerMutation {
+	return tc.mutation
+}
+
+// Save creates the Timer in the database.
+func (tc *TimerCreate) Save(ctx context.Context) (*Timer, error) {
+	tc.defaults()
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TimerCreate) SaveX(ctx context.Context) *Timer {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TimerCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TimerCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TimerCreate) defaults() {
+	if _, ok := tc.mutation.StartedAt(); !ok {
+		v := timer.DefaultStartedAt()
+		tc.mutation.SetStartedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TimerCreate) check() error {
+	if _, ok := tc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "Timer.task_id"`)}
+	}
+	if _, ok := tc.mutation.MemberID(); !ok {
+		return &ValidationError{Name: "member_id", err: errors.New(`ent: missing required field "Timer.member_id"`)}
+	}
+	if _, ok := tc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Timer.started_at"`)}
+	}
+	if len(tc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "Timer.task"`)}
+	}
+	if len(tc.mutation.MemberIDs()) == 0 {
+		return &ValidationError{Name: "member", err: errors.New(`ent: missing required edge "Timer.member"`)}
+	}
+	return nil
+}
+
+func (tc *TimerCreate) sqlSave(ctx context.Context) (*Timer, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *TimerCreate) createSpec() (*Timer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Timer{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(timer.Table, sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt))
+	)
+	if value, ok := tc.mutation.StartedAt(); ok {
+		_spec.SetField(timer.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if nodes := tc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.TaskTable,
+			Columns: []string{timer.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.MemberTable,
+			Columns: []string{timer.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MemberID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TimerCreateBulk is the builder for creating many Timer entities in bulk.
+type TimerCreateBulk struct {
+	config
+	err      error
+	builders []*TimerCreate
+}
+
+// Save creates the Timer entities in the database.
+func (tcb *TimerCreateBulk) Save(ctx context.Context) ([]*Timer, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Timer, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TimerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TimerCreateBulk) SaveX(ctx context.Context) []*Timer {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TimerCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TimerCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
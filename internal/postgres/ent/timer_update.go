@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+)
+
+// TimerUpdate is the builder for updating Timer entities.
+type TimerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TimerMutation
+}
+
+// Where appends a list predicates to the TimerUpdate builder.
+func (tu *TimerUpdate) Where(ps ...predicate.Timer) *TimerUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetTaskID sets the "task_id" field.
+func (tu *TimerUpdate) SetTaskID(s string) *TimerUpdate {
+	tu.mutation.SetTaskID(s)
+	return tu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tu *TimerUpdate) SetNillableTaskID(s *string) *TimerUpdate {
+	if s != nil {
+		tu.SetTaskID(*s)
+	}
+	return tu
+}
+
+// SetMemberID sets the "member_id" field.
+func (tu *TimerUpdate) SetMemberID(s string) *TimerUpdate {
+	tu.mutation.SetMemberID(s)
+	return tu
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (tu *TimerUpdate) SetNillableMemberID(s *string) *TimerUpdate {
+	if s != nil {
+		tu.SetMemberID(*s)
+	}
+	return tu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tu *TimerUpdate) SetStartedAt(t time.Time) *TimerUpdate {
+	tu.mutation.SetStartedAt(t)
+	return tu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tu *TimerUpdate) SetNillableStartedAt(t *time.Time) *TimerUpdate {
+	if t != nil {
+		tu.SetStartedAt(*t)
+	}
+	return tu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tu *TimerUpdate) SetTask(t *Task) *TimerUpdate {
+	return tu.SetTaskID(t.ID)
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (tu *TimerUpdate) SetMember(m *Member) *TimerUpdate {
+	return tu.SetMemberID(m.ID)
+}
+
+// Mutation returns the TimerMutation object of the builder.
+func (tu *TimerUpdate) Mutation() *TimerMutation {
+	return tu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (tu *TimerUpdate) ClearTask() *TimerUpdate {
+	tu.mutation.ClearTask()
+	return tu
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (tu *TimerUpdate) ClearMember() *TimerUpdate {
+	tu.mutation.ClearMember()
+	return tu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TimerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TimerUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TimerUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TimerUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TimerUpdate) check() error {
+	if tu.mutation.TaskCleared() && len(tu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Timer.task"`)
+	}
+	if tu.mutation.MemberCleared() && len(tu.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Timer.member"`)
+	}
+	return nil
+}
+
+func (tu *TimerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(timer.Table, timer.Columns, sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.StartedAt(); ok {
+		_spec.SetField(timer.FieldStartedAt, field.TypeTime, value)
+	}
+	if tu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.TaskTable,
+			Columns: []string{timer.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.TaskTable,
+			Columns: []string{timer.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.MemberTable,
+			Columns: []string{timer.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.MemberTable,
+			Columns: []string{timer.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TimerUpdateOne is the builder for updating a single Timer entity.
+type TimerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TimerMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (tuo *TimerUpdateOne) SetTaskID(s string) *TimerUpdateOne {
+	tuo.mutation.SetTaskID(s)
+	return tuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tuo *TimerUpdateOne) SetNillableTaskID(s *string) *TimerUpdateOne {
+	if s != nil {
+		tuo.SetTaskID(*s)
+	}
+	return tuo
+}
+
+// SetMemberID sets the "member_id" field.
+func (tuo *TimerUpdateOne) SetMemberID(s string) *TimerUpdateOne {
+	tuo.mutation.SetMemberID(s)
+	return tuo
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (tuo *TimerUpdateOne) SetNillableMemberID(s *string) *TimerUpdateOne {
+	if s != nil {
+		tuo.SetMemberID(*s)
+	}
+	return tuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (tuo *TimerUpdateOne) SetStartedAt(t time.Time) *TimerUpdateOne {
+	tuo.mutation.SetStartedAt(t)
+	return tuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (tuo *TimerUpdateOne) SetNillableStartedAt(t *time.Time) *TimerUpdateOne {
+	if t != nil {
+		tuo.SetStartedAt(*t)
+	}
+	return tuo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tuo *TimerUpdateOne) SetTask(t *Task) *TimerUpdateOne {
+	return tuo.SetTaskID(t.ID)
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (tuo *TimerUpdateOne) SetMember(m *Member) *TimerUpdateOne {
+	return tuo.SetMemberID(m.ID)
+}
+
+// Mutation returns the TimerMutation object of the builder.
+func (tuo *TimerUpdateOne) Mutation() *TimerMutation {
+	return tuo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (tuo *TimerUpdateOne) ClearTask() *TimerUpdateOne {
+	tuo.mutation.ClearTask()
+	return tuo
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (tuo *TimerUpdateOne) ClearMember() *TimerUpdateOne {
+	tuo.mutation.ClearMember()
+	return tuo
+}
+
+// Where appends a list predicates to the TimerUpdate builder.
+func (tuo *TimerUpdateOne) Where(ps ...predicate.Timer) *TimerUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TimerUpdateOne) Select(field string, fields ...string) *TimerUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Timer entity.
+func (tuo *TimerUpdateOne) Save(ctx context.Context) (*Timer, error) {
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TimerUpdateOne) SaveX(ctx context.Context) *Timer {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TimerUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TimerUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TimerUpdateOne) check() error {
+	if tuo.mutation.TaskCleared() && len(tuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Timer.task"`)
+	}
+	if tuo.mutation.MemberCleared() && len(tuo.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Timer.member"`)
+	}
+	return nil
+}
+
+func (tuo *TimerUpdateOne) sqlSave(ctx context.Context) (_node *Timer, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(timer.Table, timer.Columns, sqlgraph.NewFieldSpec(timer.FieldID, field.TypeInt))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Timer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, timer.FieldID)
+		for _, f := range fields {
+			if !timer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != timer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.StartedAt(); ok {
+		_spec.SetField(timer.FieldStartedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.TaskTable,
+			Columns: []string{timer.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.TaskTable,
+			Columns: []string{timer.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.MemberTable,
+			Columns: []string{timer.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timer.MemberTable,
+			Columns: []string{timer.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Timer{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
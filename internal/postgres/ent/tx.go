@@ -12,10 +12,40 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Activity is the client for interacting with the Activity builders.
+	Activity *ActivityClient
+	// Board is the client for interacting with the Board builders.
+	Board *BoardClient
+	// ChecklistItem is the client for interacting with the ChecklistItem builders.
+	ChecklistItem *ChecklistItemClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// CustomField is the client for interacting with the CustomField builders.
+	CustomField *CustomFieldClient
+	// CustomFieldValue is the client for interacting with the CustomFieldValue builders.
+	CustomFieldValue *CustomFieldValueClient
+	// Epic is the client for interacting with the Epic builders.
+	Epic *EpicClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// Label is the client for interacting with the Label builders.
+	Label *LabelClient
+	// Member is the client for interacting with the Member builders.
+	Member *MemberClient
+	// RecurringTask is the client for interacting with the RecurringTask builders.
+	RecurringTask *RecurringTaskClient
+	// Sprint is the client for interacting with the Sprint builders.
+	Sprint *SprintClient
+	// StatusChange is the client for interacting with the StatusChange builders.
+	StatusChange *StatusChangeClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskTemplate is the client for interacting with the TaskTemplate builders.
+	TaskTemplate *TaskTemplateClient
+	// Timer is the client for interacting with the Timer builders.
+	Timer *TimerClient
+	// Worklog is the client for interacting with the Worklog builders.
+	Worklog *WorklogClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +177,23 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Activity = NewActivityClient(tx.config)
+	tx.Board = NewBoardClient(tx.config)
+	tx.ChecklistItem = NewChecklistItemClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
+	tx.CustomField = NewCustomFieldClient(tx.config)
+	tx.CustomFieldValue = NewCustomFieldValueClient(tx.config)
+	tx.Epic = NewEpicClient(tx.config)
 	tx.File = NewFileClient(tx.config)
+	tx.Label = NewLabelClient(tx.config)
+	tx.Member = NewMemberClient(tx.config)
+	tx.RecurringTask = NewRecurringTaskClient(tx.config)
+	tx.Sprint = NewSprintClient(tx.config)
+	tx.StatusChange = NewStatusChangeClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.TaskTemplate = NewTaskTemplateClient(tx.config)
+	tx.Timer = NewTimerClient(tx.config)
+	tx.Worklog = NewWorklogClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +203,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: File.QueryXXX(), the query will be executed
+// applies a query, for example: Activity.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
+)
+
+// Worklog is the model entity for the Worklog schema.
+type Worklog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// MemberID holds the value of the "member_id" field.
+	MemberID string `json:"member_id,omitempty"`
+	// DurationMinutes holds the value of the "duration_minutes" field.
+	DurationMinutes int `json:"duration_minutes,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Note holds the value of the "note" field.
+	Note *string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorklogQuery when eager-loading is set.
+	Edges        WorklogEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorklogEdges holds the relations/edges for other nodes in the graph.
+type WorklogEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// Member holds the value of the member edge.
+	Member *Member `json:"member,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorklogEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// MemberOrErr returns the Member value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorklogEdges) MemberOrErr() (*Member, error) {
+	if e.Member != nil {
+		return e.Member, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: member.Label}
+	}
+	return nil, &NotLoadedError{edge: "member"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Worklog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case worklog.FieldDurationMinutes:
+			values[i] = new(sql.NullInt64)
+		case worklog.FieldID, worklog.FieldTaskID, worklog.FieldMemberID, worklog.FieldNote:
+			values[i] = new(sql.NullString)
+		case worklog.FieldDate, worklog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Worklog fields.
+func (w *Worklog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case worklog.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				w.ID = value.String
+			}
+		case worklog.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				w.TaskID = value.String
+			}
+		case worklog.FieldMemberID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field member_id", values[i])
+			} else if value.Valid {
+				w.MemberID = value.String
+			}
+		case worklog.FieldDurationMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_minutes", values[i])
+			} else if value.Valid {
+				w.DurationMinutes = int(value.Int64)
+			}
+		case worklog.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				w.Date = value.Time
+			}
+		case worklog.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				w.Note = new(string)
+				*w.Note = value.String
+			}
+		case worklog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				w.CreatedAt = value.Time
+			}
+		default:
+			w.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Worklog.
+// This includes values selected through modifiers, order, etc.
+func (w *Worklog) Value(name string) (ent.Value, error) {
+	return w.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the Worklog entity.
+func (w *Worklog) QueryTask() *TaskQuery {
+	return NewWorklogClient(w.config).QueryTask(w)
+}
+
+// QueryMember queries the "member" edge of the Worklog entity.
+func (w *Worklog) QueryMember() *MemberQuery {
+	return NewWorklogClient(w.config).QueryMember(w)
+}
+
+// Update returns a builder for updating this Worklog.
+// Note that you need to call Worklog.Unwrap() before calling this method if this Worklog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Worklog) Update() *WorklogUpdateOne {
+	return NewWorklogClient(w.config).UpdateOne(w)
+}
+
+// Unwrap unwraps the Worklog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (w *Worklog) Unwrap() *Worklog {
+	_tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Worklog is not a transactional entity")
+	}
+	w.config.driver = _tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Worklog) String() string {
+	var builder strings.Builder
+	builder.WriteString("Worklog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", w.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(w.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("member_id=")
+	builder.WriteString(w.MemberID)
+	builder.WriteString(", ")
+	builder.WriteString("duration_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", w.DurationMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(w.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := w.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Worklogs is a parsable slice of Worklog.
+type Worklogs []*Worklog
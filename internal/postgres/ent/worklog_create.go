@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
+)
+
+// WorklogCreate is the builder for creating a Worklog entity.
+type WorklogCreate struct {
+	config
+	mutation *WorklogMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (wc *WorklogCreate) SetTaskID(s string) *WorklogCreate {
+	wc.mutation.SetTaskID(s)
+	return wc
+}
+
+// SetMemberID sets the "member_id" field.
+func (wc *WorklogCreate) SetMemberID(s string) *WorklogCreate {
+	wc.mutation.SetMemberID(s)
+	return wc
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (wc *WorklogCreate) SetDurationMinutes(i int) *WorklogCreate {
+	wc.mutation.SetDurationMinutes(i)
+	return wc
+}
+
+// SetDate sets the "date" field.
+func (wc *WorklogCreate) SetDate(t time.Time) *WorklogCreate {
+	wc.mutation.SetDate(t)
+	return wc
+}
+
+// SetNote sets the "note" field.
+func (wc *WorklogCreate) SetNote(s string) *WorklogCreate {
+	wc.mutation.SetNote(s)
+	return wc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (wc *WorklogCreate) SetNillableNote(s *string) *WorklogCreate {
+	if s != nil {
+		wc.SetNote(*s)
+	}
+	return wc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WorklogCreate) SetCreatedAt(t time.Time) *WorklogCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WorklogCreate) SetNillableCreatedAt(t *time.Time) *WorklogCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetID sets the "id" field.
+func (wc *WorklogCreate) SetID(s string) *WorklogCreate {
+	wc.mutation.SetID(s)
+	return wc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (wc *WorklogCreate) SetTask(t *Task) *WorklogCreate {
+	return wc.SetTaskID(t.ID)
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (wc *WorklogCreate) SetMember(m *Member) *WorklogCreate {
+	return wc.SetMemberID(m.ID)
+}
+
+// Mutation returns the WorklogMutation object of the builder.
+func (wc *WorklogCreate) Mutation() *WorklogMutation {
+	return wc.mutation
+}
+
+// Save creates the Worklog in the database.
+func (wc *WorklogCreate) Save(ctx context.Context) (*Worklog, error) {
+	wc.defaults()
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WorklogCreate) SaveX(ctx context.Context) *Worklog {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WorklogCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WorklogCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WorklogCreate) defaults() {
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := worklog.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WorklogCreate) check() error {
+	if _, ok := wc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "Worklog.task_id"`)}
+	}
+	if _, ok := wc.mutation.MemberID(); !ok {
+		return &ValidationError{Name: "member_id", err: errors.New(`ent: missing required field "Worklog.member_id"`)}
+	}
+	if _, ok := wc.mutation.DurationMinutes(); !ok {
+		return &ValidationError{Name: "duration_minutes", err: errors.New(`ent: missing required field "Worklog.duration_minutes"`)}
+	}
+	if _, ok := wc.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Worklog.date"`)}
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Worklog.created_at"`)}
+	}
+	if len(wc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "Worklog.task"`)}
+	}
+	if len(wc.mutation.MemberIDs()) == 0 {
+		return &ValidationError{Name: "member", err: errors.New(`ent: missing required edge "Worklog.member"`)}
+	}
+	return nil
+}
+
+func (wc *WorklogCreate) sqlSave(ctx context.Context) (*Worklog, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Worklog.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WorklogCreate) createSpec() (*Worklog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Worklog{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(worklog.Table, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString))
+	)
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wc.mutation.DurationMinutes(); ok {
+		_spec.SetField(worklog.FieldDurationMinutes, field.TypeInt, value)
+		_node.DurationMinutes = value
+	}
+	if value, ok := wc.mutation.Date(); ok {
+		_spec.SetField(worklog.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := wc.mutation.Note(); ok {
+		_spec.SetField(worklog.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.SetField(worklog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := wc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.MemberTable,
+			Columns: []string{worklog.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MemberID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorklogCreateBulk is the builder for creating many Worklog entities in bulk.
+type WorklogCreateBulk struct {
+	config
+	err      error
+	builders []*WorklogCreate
+}
+
+// Save creates the Worklog entities in the database.
+func (wcb *WorklogCreateBulk) Save(ctx context.Context) ([]*Worklog, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Worklog, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorklogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WorklogCreateBulk) SaveX(ctx context.Context) []*Worklog {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WorklogCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WorklogCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
+)
+
+// WorklogDelete is the builder for deleting a Worklog entity.
+type WorklogDelete struct {
+	config
+	hooks    []Hook
+	mutation *WorklogMutation
+}
+
+// Where appends a list predicates to the WorklogDelete builder.
+func (wd *WorklogDelete) Where(ps ...predicate.Worklog) *WorklogDelete {
+	wd.mutation.Where(ps...)
+	return wd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wd *WorklogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wd.sqlExec, wd.mutation, wd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wd *WorklogDelete) ExecX(ctx context.Context) int {
+	n, err := wd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wd *WorklogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(worklog.Table, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString))
+	if ps := wd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wd.mutation.done = true
+	return affected, err
+}
+
+// WorklogDeleteOne is the builder for deleting a single Worklog entity.
+type WorklogDeleteOne struct {
+	wd *WorklogDelete
+}
+
+// Where appends a list predicates to the WorklogDelete builder.
+func (wdo *WorklogDeleteOne) Where(ps ...predicate.Worklog) *WorklogDeleteOne {
+	wdo.wd.mutation.Where(ps...)
+	return wdo
+}
+
+// Exec executes the deletion query.
+func (wdo *WorklogDeleteOne) Exec(ctx context.Context) error {
+	n, err := wdo.wd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{worklog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdo *WorklogDeleteOne) ExecX(ctx context.Context) {
+	if err := wdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
+)
+
+// WorklogQuery is the builder for querying Worklog entities.
+type WorklogQuery struct {
+	config
+	ctx        *QueryContext
+	order      []worklog.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Worklog
+	withTask   *TaskQuery
+	withMember *MemberQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WorklogQuery builder.
+func (wq *WorklogQuery) Where(ps ...predicate.Worklog) *WorklogQuery {
+	wq.predicates = append(wq.predicates, ps...)
+	return wq
+}
+
+// Limit the number of records to be returned by this query.
+func (wq *WorklogQuery) Limit(limit int) *WorklogQuery {
+	wq.ctx.Limit = &limit
+	return wq
+}
+
+// Offset to start from.
+func (wq *WorklogQuery) Offset(offset int) *WorklogQuery {
+	wq.ctx.Offset = &offset
+	return wq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wq *WorklogQuery) Unique(unique bool) *WorklogQuery {
+	wq.ctx.Unique = &unique
+	return wq
+}
+
+// Order specifies how the records should be ordered.
+func (wq *WorklogQuery) Order(o ...worklog.OrderOption) *WorklogQuery {
+	wq.order = append(wq.order, o...)
+	return wq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (wq *WorklogQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: wq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(worklog.Table, worklog.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, worklog.TaskTable, worklog.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMember chains the current query on the "member" edge.
+func (wq *WorklogQuery) QueryMember() *MemberQuery {
+	query := (&MemberClient{config: wq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(worklog.Table, worklog.FieldID, selector),
+			sqlgraph.To(member.Table, member.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, worklog.MemberTable, worklog.MemberColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Worklog entity from the query.
+// Returns a *NotFoundError when no Worklog was found.
+func (wq *WorklogQuery) First(ctx context.Context) (*Worklog, error) {
+	nodes, err := wq.Limit(1).All(setContextOp(ctx, wq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{worklog.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wq *WorklogQuery) FirstX(ctx context.Context) *Worklog {
+	node, err := wq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Worklog ID from the query.
+// Returns a *NotFoundError when no Worklog ID was found.
+func (wq *WorklogQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = wq.Limit(1).IDs(setContextOp(ctx, wq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{worklog.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wq *WorklogQuery) FirstIDX(ctx context.Context) string {
+	id, err := wq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Worklog entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Worklog entity is found.
+// Returns a *NotFoundError when no Worklog entities are found.
+func (wq *WorklogQuery) Only(ctx context.Context) (*Worklog, error) {
+	nodes, err := wq.Limit(2).All(setContextOp(ctx, wq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{worklog.Label}
+	default:
+		return nil, &NotSingularError{worklog.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wq *WorklogQuery) OnlyX(ctx context.Context) *Worklog {
+	node, err := wq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Worklog ID in the query.
+// Returns a *NotSingularError when more than one Worklog ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wq *WorklogQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = wq.Limit(2).IDs(setContextOp(ctx, wq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{worklog.Label}
+	default:
+		err = &NotSingularError{worklog.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wq *WorklogQuery) OnlyIDX(ctx context.Context) string {
+	id, err := wq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Worklogs.
+func (wq *WorklogQuery) All(ctx context.Context) ([]*Worklog, error) {
+	ctx = setContextOp(ctx, wq.ctx, ent.OpQueryAll)
+	if err := wq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Worklog, *WorklogQuery]()
+	return withInterceptors[[]*Worklog](ctx, wq, qr, wq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wq *WorklogQuery) AllX(ctx context.Context) []*Worklog {
+	nodes, err := wq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Worklog IDs.
+func (wq *WorklogQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if wq.ctx.Unique == nil && wq.path != nil {
+		wq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wq.ctx, ent.OpQueryIDs)
+	if err = wq.Select(worklog.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wq *WorklogQuery) IDsX(ctx context.Context) []string {
+	ids, err := wq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wq *WorklogQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wq.ctx, ent.OpQueryCount)
+	if err := wq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wq, querierCount[*WorklogQuery](), wq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wq *WorklogQuery) CountX(ctx context.Context) int {
+	count, err := wq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wq *WorklogQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wq.ctx, ent.OpQueryExist)
+	switch _, err := wq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wq *WorklogQuery) ExistX(ctx context.Context) bool {
+	exist, err := wq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WorklogQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wq *WorklogQuery) Clone() *WorklogQuery {
+	if wq == nil {
+		return nil
+	}
+	return &WorklogQuery{
+		config:     wq.config,
+		ctx:        wq.ctx.Clone(),
+		order:      append([]worklog.OrderOption{}, wq.order...),
+		inters:     append([]Interceptor{}, wq.inters...),
+		predicates: append([]predicate.Worklog{}, wq.predicates...),
+		withTask:   wq.withTask.Clone(),
+		withMember: wq.withMember.Clone(),
+		// clone intermediate query.
+		sql:  wq.sql.Clone(),
+		path: wq.path,
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WorklogQuery) WithTask(opts ...func(*TaskQuery)) *WorklogQuery {
+	query := (&TaskClient{config: wq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withTask = query
+	return wq
+}
+
+// WithMember tells the query-builder to eager-load the nodes that are connected to
+// the "member" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WorklogQuery) WithMember(opts ...func(*MemberQuery)) *WorklogQuery {
+	query := (&MemberClient{config: wq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withMember = query
+	return wq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Worklog.Query().
+//		GroupBy(worklog.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wq *WorklogQuery) GroupBy(field string, fields ...string) *WorklogGroupBy {
+	wq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WorklogGroupBy{build: wq}
+	grbuild.flds = &wq.ctx.Fields
+	grbuild.label = worklog.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//	}
+//
+//	client.Worklog.Query().
+//		Select(worklog.FieldTaskID).
+//		Scan(ctx, &v)
+func (wq *WorklogQuery) Select(fields ...string) *WorklogSelect {
+	wq.ctx.Fields = append(wq.ctx.Fields, fields...)
+	sbuild := &WorklogSelect{WorklogQuery: wq}
+	sbuild.label = worklog.Label
+	sbuild.flds, sbuild.scan = &wq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WorklogSelect configured with the given aggregations.
+func (wq *WorklogQuery) Aggregate(fns ...AggregateFunc) *WorklogSelect {
+	return wq.Select().Aggregate(fns...)
+}
+
+func (wq *WorklogQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wq.ctx.Fields {
+		if !worklog.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wq.path != nil {
+		prev, err := wq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wq.sql = prev
+	}
+	return nil
+}
+
+func (wq *WorklogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Worklog, error) {
+	var (
+		nodes       = []*Worklog{}
+		_spec       = wq.querySpec()
+		loadedTypes = [2]bool{
+			wq.withTask != nil,
+			wq.withMember != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Worklog).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Worklog{config: wq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wq.withTask; query != nil {
+		if err := wq.loadTask(ctx, query, nodes, nil,
+			func(n *Worklog, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withMember; query != nil {
+		if err := wq.loadMember(ctx, query, nodes, nil,
+			func(n *Worklog, e *Member) { n.Edges.Member = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wq *WorklogQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*Worklog, init func(*Worklog), assign func(*Worklog, *Task)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Worklog)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (wq *WorklogQuery) loadMember(ctx context.Context, query *MemberQuery, nodes []*Worklog, init func(*Worklog), assign func(*Worklog, *Member)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Worklog)
+	for i := range nodes {
+		fk := nodes[i].MemberID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(member.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "member_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wq *WorklogQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wq.querySpec()
+	_spec.Node.Columns = wq.ctx.Fields
+	if len(wq.ctx.Fields) > 0 {
+		_spec.Unique = wq.ctx.Unique != nil && *wq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wq.driver, _spec)
+}
+
+func (wq *WorklogQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(worklog.Table, worklog.Columns, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString))
+	_spec.From = wq.sql
+	if unique := wq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, worklog.FieldID)
+		for i := range fields {
+			if fields[i] != worklog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if wq.withTask != nil {
+			_spec.Node.AddColumnOnce(worklog.FieldTaskID)
+		}
+		if wq.withMember != nil {
+			_spec.Node.AddColumnOnce(worklog.FieldMemberID)
+		}
+	}
+	if ps := wq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wq *WorklogQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wq.driver.Dialect())
+	t1 := builder.Table(worklog.Table)
+	columns := wq.ctx.Fields
+	if len(columns) == 0 {
+		columns = worklog.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wq.sql != nil {
+		selector = wq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wq.ctx.Unique != nil && *wq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range wq.predicates {
+		p(selector)
+	}
+	for _, p := range wq.order {
+		p(selector)
+	}
+	if offset := wq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WorklogGroupBy is the group-by builder for Worklog entities.
+type WorklogGroupBy struct {
+	selector
+	build *WorklogQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wgb *WorklogGroupBy) Aggregate(fns ...AggregateFunc) *WorklogGroupBy {
+	wgb.fns = append(wgb.fns, fns...)
+	return wgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wgb *WorklogGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorklogQuery, *WorklogGroupBy](ctx, wgb.build, wgb, wgb.build.inters, v)
+}
+
+func (wgb *WorklogGroupBy) sqlScan(ctx context.Context, root *WorklogQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wgb.fns))
+	for _, fn := range wgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wgb.flds)+len(wgb.fns))
+		for _, f := range *wgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WorklogSelect is the builder for selecting fields of Worklog entities.
+type WorklogSelect struct {
+	*WorklogQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ws *WorklogSelect) Aggregate(fns ...AggregateFunc) *WorklogSelect {
+	ws.fns = append(ws.fns, fns...)
+	return ws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ws *WorklogSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ws.ctx, ent.OpQuerySelect)
+	if err := ws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorklogQuery, *WorklogSelect](ctx, ws.WorklogQuery, ws, ws.inters, v)
+}
+
+func (ws *WorklogSelect) sqlScan(ctx context.Context, root *WorklogQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ws.fns))
+	for _, fn := range ws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
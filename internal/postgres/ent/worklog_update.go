@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
+)
+
+// WorklogUpdate is the builder for updating Worklog entities.
+type WorklogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorklogMutation
+}
+
+// Where appends a list predicates to the WorklogUpdate builder.
+func (wu *WorklogUpdate) Where(ps ...predicate.Worklog) *WorklogUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetTaskID sets the "task_id" field.
+func (wu *WorklogUpdate) SetTaskID(s string) *WorklogUpdate {
+	wu.mutation.SetTaskID(s)
+	return wu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (wu *WorklogUpdate) SetNillableTaskID(s *string) *WorklogUpdate {
+	if s != nil {
+		wu.SetTaskID(*s)
+	}
+	return wu
+}
+
+// SetMemberID sets the "member_id" field.
+func (wu *WorklogUpdate) SetMemberID(s string) *WorklogUpdate {
+	wu.mutation.SetMemberID(s)
+	return wu
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (wu *WorklogUpdate) SetNillableMemberID(s *string) *WorklogUpdate {
+	if s != nil {
+		wu.SetMemberID(*s)
+	}
+	return wu
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (wu *WorklogUpdate) SetDurationMinutes(i int) *WorklogUpdate {
+	wu.mutation.ResetDurationMinutes()
+	wu.mutation.SetDurationMinutes(i)
+	return wu
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (wu *WorklogUpdate) SetNillableDurationMinutes(i *int) *WorklogUpdate {
+	if i != nil {
+		wu.SetDurationMinutes(*i)
+	}
+	return wu
+}
+
+// AddDurationMinutes adds i to the "duration_minutes" field.
+func (wu *WorklogUpdate) AddDurationMinutes(i int) *WorklogUpdate {
+	wu.mutation.AddDurationMinutes(i)
+	return wu
+}
+
+// SetDate sets the "date" field.
+func (wu *WorklogUpdate) SetDate(t time.Time) *WorklogUpdate {
+	wu.mutation.SetDate(t)
+	return wu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (wu *WorklogUpdate) SetNillableDate(t *time.Time) *WorklogUpdate {
+	if t != nil {
+		wu.SetDate(*t)
+	}
+	return wu
+}
+
+// SetNote sets the "note" field.
+func (wu *WorklogUpdate) SetNote(s string) *WorklogUpdate {
+	wu.mutation.SetNote(s)
+	return wu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (wu *WorklogUpdate) SetNillableNote(s *string) *WorklogUpdate {
+	if s != nil {
+		wu.SetNote(*s)
+	}
+	return wu
+}
+
+// ClearNote clears the value of the "note" field.
+func (wu *WorklogUpdate) ClearNote() *WorklogUpdate {
+	wu.mutation.ClearNote()
+	return wu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wu *WorklogUpdate) SetCreatedAt(t time.Time) *WorklogUpdate {
+	wu.mutation.SetCreatedAt(t)
+	return wu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wu *WorklogUpdate) SetNillableCreatedAt(t *time.Time) *WorklogUpdate {
+	if t != nil {
+		wu.SetCreatedAt(*t)
+	}
+	return wu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (wu *WorklogUpdate) SetTask(t *Task) *WorklogUpdate {
+	return wu.SetTaskID(t.ID)
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (wu *WorklogUpdate) SetMember(m *Member) *WorklogUpdate {
+	return wu.SetMemberID(m.ID)
+}
+
+// Mutation returns the WorklogMutation object of the builder.
+func (wu *WorklogUpdate) Mutation() *WorklogMutation {
+	return wu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (wu *WorklogUpdate) ClearTask() *WorklogUpdate {
+	wu.mutation.ClearTask()
+	return wu
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (wu *WorklogUpdate) ClearMember() *WorklogUpdate {
+	wu.mutation.ClearMember()
+	return wu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WorklogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WorklogUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WorklogUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WorklogUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WorklogUpdate) check() error {
+	if wu.mutation.TaskCleared() && len(wu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Worklog.task"`)
+	}
+	if wu.mutation.MemberCleared() && len(wu.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Worklog.member"`)
+	}
+	return nil
+}
+
+func (wu *WorklogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(worklog.Table, worklog.Columns, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.DurationMinutes(); ok {
+		_spec.SetField(worklog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(worklog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Date(); ok {
+		_spec.SetField(worklog.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Note(); ok {
+		_spec.SetField(worklog.FieldNote, field.TypeString, value)
+	}
+	if wu.mutation.NoteCleared() {
+		_spec.ClearField(worklog.FieldNote, field.TypeString)
+	}
+	if value, ok := wu.mutation.CreatedAt(); ok {
+		_spec.SetField(worklog.FieldCreatedAt, field.TypeTime, value)
+	}
+	if wu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.MemberTable,
+			Columns: []string{worklog.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.MemberTable,
+			Columns: []string{worklog.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{worklog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WorklogUpdateOne is the builder for updating a single Worklog entity.
+type WorklogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorklogMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (wuo *WorklogUpdateOne) SetTaskID(s string) *WorklogUpdateOne {
+	wuo.mutation.SetTaskID(s)
+	return wuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (wuo *WorklogUpdateOne) SetNillableTaskID(s *string) *WorklogUpdateOne {
+	if s != nil {
+		wuo.SetTaskID(*s)
+	}
+	return wuo
+}
+
+// SetMemberID sets the "member_id" field.
+func (wuo *WorklogUpdateOne) SetMemberID(s string) *WorklogUpdateOne {
+	wuo.mutation.SetMemberID(s)
+	return wuo
+}
+
+// SetNillableMemberID sets the "member_id" field if the given value is not nil.
+func (wuo *WorklogUpdateOne) SetNillableMemberID(s *string) *WorklogUpdateOne {
+	if s != nil {
+		wuo.SetMemberID(*s)
+	}
+	return wuo
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (wuo *WorklogUpdateOne) SetDurationMinutes(i int) *WorklogUpdateOne {
+	wuo.mutation.ResetDurationMinutes()
+	wuo.mutation.SetDurationMinutes(i)
+	return wuo
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (wuo *WorklogUpdateOne) SetNillableDurationMinutes(i *int) *WorklogUpdateOne {
+	if i != nil {
+		wuo.SetDurationMinutes(*i)
+	}
+	return wuo
+}
+
+// AddDurationMinutes adds i to the "duration_minutes" field.
+func (wuo *WorklogUpdateOne) AddDurationMinutes(i int) *WorklogUpdateOne {
+	wuo.mutation.AddDurationMinutes(i)
+	return wuo
+}
+
+// SetDate sets the "date" field.
+func (wuo *WorklogUpdateOne) SetDate(t time.Time) *WorklogUpdateOne {
+	wuo.mutation.SetDate(t)
+	return wuo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (wuo *WorklogUpdateOne) SetNillableDate(t *time.Time) *WorklogUpdateOne {
+	if t != nil {
+		wuo.SetDate(*t)
+	}
+	return wuo
+}
+
+// SetNote sets the "note" field.
+func (wuo *WorklogUpdateOne) SetNote(s string) *WorklogUpdateOne {
+	wuo.mutation.SetNote(s)
+	return wuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (wuo *WorklogUpdateOne) SetNillableNote(s *string) *WorklogUpdateOne {
+	if s != nil {
+		wuo.SetNote(*s)
+	}
+	return wuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (wuo *WorklogUpdateOne) ClearNote() *WorklogUpdateOne {
+	wuo.mutation.ClearNote()
+	return wuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wuo *WorklogUpdateOne) SetCreatedAt(t time.Time) *WorklogUpdateOne {
+	wuo.mutation.SetCreatedAt(t)
+	return wuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wuo *WorklogUpdateOne) SetNillableCreatedAt(t *time.Time) *WorklogUpdateOne {
+	if t != nil {
+		wuo.SetCreatedAt(*t)
+	}
+	return wuo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (wuo *WorklogUpdateOne) SetTask(t *Task) *WorklogUpdateOne {
+	return wuo.SetTaskID(t.ID)
+}
+
+// SetMember sets the "member" edge to the Member entity.
+func (wuo *WorklogUpdateOne) SetMember(m *Member) *WorklogUpdateOne {
+	return wuo.SetMemberID(m.ID)
+}
+
+// Mutation returns the WorklogMutation object of the builder.
+func (wuo *WorklogUpdateOne) Mutation() *WorklogMutation {
+	return wuo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (wuo *WorklogUpdateOne) ClearTask() *WorklogUpdateOne {
+	wuo.mutation.ClearTask()
+	return wuo
+}
+
+// ClearMember clears the "member" edge to the Member entity.
+func (wuo *WorklogUpdateOne) ClearMember() *WorklogUpdateOne {
+	wuo.mutation.ClearMember()
+	return wuo
+}
+
+// Where appends a list predicates to the WorklogUpdate builder.
+func (wuo *WorklogUpdateOne) Where(ps ...predicate.Worklog) *WorklogUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WorklogUpdateOne) Select(field string, fields ...string) *WorklogUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Worklog entity.
+func (wuo *WorklogUpdateOne) Save(ctx context.Context) (*Worklog, error) {
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WorklogUpdateOne) SaveX(ctx context.Context) *Worklog {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WorklogUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WorklogUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WorklogUpdateOne) check() error {
+	if wuo.mutation.TaskCleared() && len(wuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Worklog.task"`)
+	}
+	if wuo.mutation.MemberCleared() && len(wuo.mutation.MemberIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Worklog.member"`)
+	}
+	return nil
+}
+
+func (wuo *WorklogUpdateOne) sqlSave(ctx context.Context) (_node *Worklog, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(worklog.Table, worklog.Columns, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeString))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Worklog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, worklog.FieldID)
+		for _, f := range fields {
+			if !worklog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != worklog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.DurationMinutes(); ok {
+		_spec.SetField(worklog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(worklog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Date(); ok {
+		_spec.SetField(worklog.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Note(); ok {
+		_spec.SetField(worklog.FieldNote, field.TypeString, value)
+	}
+	if wuo.mutation.NoteCleared() {
+		_spec.ClearField(worklog.FieldNote, field.TypeString)
+	}
+	if value, ok := wuo.mutation.CreatedAt(); ok {
+		_spec.SetField(worklog.FieldCreatedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.TaskTable,
+			Columns: []string{worklog.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.MemberCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.MemberTable,
+			Columns: []string{worklog.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.MemberIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   worklog.MemberTable,
+			Columns: []string{worklog.MemberColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(member.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Worklog{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{worklog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
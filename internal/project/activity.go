@@ -0,0 +1,243 @@
+package project
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type ActivityID string
+
+// ChangeSource is where a change to a task came from
+type ChangeSource string
+
+const (
+	ChangeSourceAPI       ChangeSource = "api"
+	ChangeSourceAssistant ChangeSource = "assistant"
+	// Tasks created on a schedule by recurring tasks
+	ChangeSourceScheduler ChangeSource = "scheduler"
+)
+
+// TaskField is a field of a task that's recorded in its history
+type TaskField string
+
+const (
+	TaskFieldCreated     TaskField = "created"
+	TaskFieldTitle       TaskField = "title"
+	TaskFieldDescription TaskField = "description"
+	TaskFieldDueDate     TaskField = "due_date"
+	TaskFieldAssignees   TaskField = "assignees"
+	TaskFieldWatchers    TaskField = "watchers"
+	TaskFieldStatus      TaskField = "status"
+	TaskFieldPriority    TaskField = "priority"
+	TaskFieldStoryPoints TaskField = "story_points"
+	TaskFieldParent      TaskField = "parent_id"
+	TaskFieldSprint      TaskField = "sprint_id"
+	TaskFieldEpic        TaskField = "epic_id"
+	TaskFieldLabels      TaskField = "labels"
+	TaskFieldBlockedBy   TaskField = "blocked_by"
+	TaskFieldFiles       TaskField = "files"
+	TaskFieldArchivedAt  TaskField = "archived_at"
+	TaskFieldChecklist   TaskField = "checklist"
+	TaskFieldCustom      TaskField = "custom_fields"
+)
+
+// The order the changes of a single update are recorded in
+var trackedTaskFields = []TaskField{
+	TaskFieldTitle,
+	TaskFieldDescription,
+	TaskFieldDueDate,
+	TaskFieldAssignees,
+	TaskFieldWatchers,
+	TaskFieldStatus,
+	TaskFieldPriority,
+	TaskFieldStoryPoints,
+	TaskFieldParent,
+	TaskFieldSprint,
+	TaskFieldEpic,
+	TaskFieldLabels,
+	TaskFieldBlockedBy,
+	TaskFieldArchivedAt,
+	TaskFieldChecklist,
+	TaskFieldCustom,
+}
+
+// Actor is who is changing tasks and through what
+type Actor struct {
+	Name   string
+	Source ChangeSource
+}
+
+const UnknownActor = "unknown"
+
+type actorKey struct{}
+
+// WithActor attaches who is making the changes to the context, the task repository records it
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns who is making the changes, changes without an actor come from the api
+func ActorFrom(ctx context.Context) Actor {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	if !ok {
+		return Actor{Name: UnknownActor, Source: ChangeSourceAPI}
+	}
+	if actor.Name == "" {
+		actor.Name = UnknownActor
+	}
+	return actor
+}
+
+// Activity is an entry of a task's append-only history: a field that changed
+type Activity struct {
+	id        ActivityID
+	taskID    TaskID
+	actor     Actor
+	field     TaskField
+	from      *string
+	to        *string
+	changedAt time.Time
+}
+
+// TaskFieldValues are the recorded fields of a task at a point in time, formatted for the history
+type TaskFieldValues map[TaskField]*string
+
+// RecordedFields captures the fields of the task before it changes
+func RecordedFields(t *Task) TaskFieldValues {
+	labels := lo.Map(t.labels, func(l Label, _ int) string { return l.name })
+	slices.Sort(labels)
+
+	assignees := MemberNames(t.assignees)
+	slices.Sort(assignees)
+
+	watchers := MemberNames(t.watchers)
+	slices.Sort(watchers)
+
+	blockers := lo.Map(t.blockerIDs(), func(id TaskID, _ int) string { return string(id) })
+	slices.Sort(blockers)
+
+	return TaskFieldValues{
+		TaskFieldTitle:       lo.ToPtr(t.title),
+		TaskFieldDescription: copyString(t.description),
+		TaskFieldDueDate:     formatTime(t.dueDate),
+		TaskFieldAssignees:   joinNonEmpty(assignees),
+		TaskFieldWatchers:    joinNonEmpty(watchers),
+		TaskFieldStatus:      lo.ToPtr(string(t.status)),
+		TaskFieldPriority:    copyString((*string)(t.priority)),
+		TaskFieldStoryPoints: formatInt(t.storyPoints),
+		TaskFieldParent:      copyString((*string)(t.parentID)),
+		TaskFieldSprint:      copyString((*string)(t.sprintID)),
+		TaskFieldEpic:        copyString((*string)(t.epicID)),
+		TaskFieldLabels:      joinNonEmpty(labels),
+		TaskFieldBlockedBy:   joinNonEmpty(blockers),
+		TaskFieldArchivedAt:  formatTime(t.archivedAt),
+		TaskFieldChecklist:   t.formatChecklist(),
+		TaskFieldCustom:      t.formatCustomFields(),
+	}
+}
+
+// NewTaskActivities lists the fields that differ between before and after
+func NewTaskActivities(
+	taskID TaskID,
+	actor Actor,
+	before TaskFieldValues,
+	after TaskFieldValues,
+) ([]Activity, error) {
+	activities := []Activity{}
+	for _, field := range trackedTaskFields {
+		from, to := before[field], after[field]
+		if lo.FromPtr(from) == lo.FromPtr(to) {
+			continue
+		}
+
+		activity, err := NewActivity(taskID, actor, field, from, to)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, *activity)
+	}
+	return activities, nil
+}
+
+func NewActivity(
+	taskID TaskID,
+	actor Actor,
+	field TaskField,
+	from *string,
+	to *string,
+) (*Activity, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Activity{
+		id:        ActivityID(id.String()),
+		taskID:    taskID,
+		actor:     actor,
+		field:     field,
+		from:      from,
+		to:        to,
+		changedAt: time.Now(),
+	}, nil
+}
+
+// copyString keeps the value even if the field it points to changes later
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	return lo.ToPtr(*s)
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return lo.ToPtr(t.UTC().Format(time.RFC3339))
+}
+
+func formatInt(i *int) *string {
+	if i == nil {
+		return nil
+	}
+	return lo.ToPtr(strconv.Itoa(*i))
+}
+
+func joinNonEmpty(values []string) *string {
+	if len(values) == 0 {
+		return nil
+	}
+	return lo.ToPtr(strings.Join(values, ", "))
+}
+
+type ActivitySnapshot struct {
+	ID        ActivityID   `json:"id"`
+	TaskID    TaskID       `json:"task_id"`
+	Actor     string       `json:"actor"`
+	Source    ChangeSource `json:"source"`
+	Field     TaskField    `json:"field"`
+	From      *string      `json:"from"`
+	To        *string      `json:"to"`
+	ChangedAt time.Time    `json:"changed_at"`
+}
+
+// Used by the db adapters
+func (a *Activity) GetSnapshot() ActivitySnapshot {
+	return ActivitySnapshot{
+		ID:        a.id,
+		TaskID:    a.taskID,
+		Actor:     a.actor.Name,
+		Source:    a.actor.Source,
+		Field:     a.field,
+		From:      a.from,
+		To:        a.to,
+		ChangedAt: a.changedAt,
+	}
+}
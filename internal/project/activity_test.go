@@ -0,0 +1,64 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActorFrom(t *testing.T) {
+	t.Run("defaults to an unknown api caller", func(t *testing.T) {
+		actor := ActorFrom(context.Background())
+		assert.Equal(t, Actor{Name: UnknownActor, Source: ChangeSourceAPI}, actor)
+	})
+
+	t.Run("actor from the context", func(t *testing.T) {
+		ctx := WithActor(context.Background(), Actor{Name: "Mary", Source: ChangeSourceAssistant})
+		assert.Equal(t, Actor{Name: "Mary", Source: ChangeSourceAssistant}, ActorFrom(ctx))
+	})
+}
+
+func TestNewTaskActivities(t *testing.T) {
+	actor := Actor{Name: "Mary", Source: ChangeSourceAPI}
+
+	t.Run("records the changed fields", func(t *testing.T) {
+		task := createValidTask(t)
+		before := RecordedFields(task)
+
+		assignee := createValidMember(t, "member-2", DefaultBoardID, "Mary", MemberRoleMember)
+		status := TaskStatusInProgress
+		require.NoError(t, task.Edit(DefaultWorkflow(), nil, nil, nil, []Member{*assignee}, &status, nil, nil))
+		require.NoError(t, task.AddLabel(createValidLabel(t, "1", DefaultBoardID, "bug")))
+
+		activities, err := NewTaskActivities(task.id, actor, before, RecordedFields(task))
+		require.NoError(t, err)
+		require.Len(t, activities, 3)
+
+		assignment := activities[0].GetSnapshot()
+		assert.Equal(t, TaskFieldAssignees, assignment.Field)
+		assert.Equal(t, lo.ToPtr("John Doe"), assignment.From)
+		assert.Equal(t, lo.ToPtr("Mary"), assignment.To)
+		assert.Equal(t, "Mary", assignment.Actor)
+		assert.Equal(t, ChangeSourceAPI, assignment.Source)
+
+		statusChange := activities[1].GetSnapshot()
+		assert.Equal(t, TaskFieldStatus, statusChange.Field)
+		assert.Equal(t, lo.ToPtr(string(TaskStatusPending)), statusChange.From)
+		assert.Equal(t, lo.ToPtr(string(TaskStatusInProgress)), statusChange.To)
+
+		labels := activities[2].GetSnapshot()
+		assert.Equal(t, TaskFieldLabels, labels.Field)
+		assert.Nil(t, labels.From)
+		assert.Equal(t, lo.ToPtr("bug"), labels.To)
+	})
+
+	t.Run("nothing changed", func(t *testing.T) {
+		task := createValidTask(t)
+		activities, err := NewTaskActivities(task.id, actor, RecordedFields(task), RecordedFields(task))
+		require.NoError(t, err)
+		assert.Empty(t, activities)
+	})
+}
@@ -13,6 +13,7 @@ import (
 type ChromemDB struct {
 	collection   *chromem.Collection
 	taskField    string
+	boardField   string
 	docNameField string
 }
 
@@ -32,7 +33,12 @@ func NewChromemDB(
 	if err != nil {
 		return nil, err
 	}
-	return &ChromemDB{collection: c, taskField: "task_id", docNameField: "document_name"}, nil
+	return &ChromemDB{
+		collection:   c,
+		taskField:    "task_id",
+		boardField:   "board_id",
+		docNameField: "document_name",
+	}, nil
 }
 
 func (c *ChromemDB) AddDocuments(ctx context.Context, docs []project.Document) error {
@@ -44,6 +50,7 @@ func (c *ChromemDB) AddDocuments(ctx context.Context, docs []project.Document) e
 			Content: doc.Content,
 			Metadata: map[string]string{
 				c.taskField:    string(doc.TaskID),
+				c.boardField:   string(doc.BoardID),
 				c.docNameField: doc.Name,
 			},
 		}
@@ -91,6 +98,62 @@ func (c *ChromemDB) SearchAllDocuments(
 		return nil, fmt.Errorf("searching all documents for query %q failed: %w", query, err)
 	}
 
+	return c.toDocumentSimilarities(res)
+}
+
+func (c *ChromemDB) SearchDocumentsForBoard(
+	ctx context.Context,
+	boardID project.BoardID,
+	query string,
+) ([]project.DocumentSimilarity, error) {
+	res, err := c.collection.Query(ctx, query, 1, map[string]string{
+		c.boardField: string(boardID),
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"searching documents for board id %q and query %q failed: %w",
+			boardID,
+			query,
+			err,
+		)
+	}
+
+	return c.toDocumentSimilarities(res)
+}
+
+func (c *ChromemDB) DeleteDocumentsForTask(ctx context.Context, taskID project.TaskID) error {
+	err := c.collection.Delete(ctx, map[string]string{c.taskField: string(taskID)}, nil)
+	if err != nil {
+		return fmt.Errorf("deleting documents for task id %q failed: %w", taskID, err)
+	}
+	return nil
+}
+
+// MigrateBoards tags the documents embedded before boards existed with the board of their task,
+// given by document ID. The embeddings are kept as they are.
+func (c *ChromemDB) MigrateBoards(ctx context.Context, boards map[string]project.BoardID) error {
+	for id, boardID := range boards {
+		doc, err := c.collection.GetByID(ctx, id)
+		if err != nil {
+			// Only some files have an embedding
+			continue
+		}
+
+		if _, ok := doc.Metadata[c.boardField]; ok {
+			continue
+		}
+
+		doc.Metadata[c.boardField] = string(boardID)
+		if err := c.collection.AddDocument(ctx, doc); err != nil {
+			return fmt.Errorf("tagging document %q with board id %q failed: %w", id, boardID, err)
+		}
+	}
+	return nil
+}
+
+func (c *ChromemDB) toDocumentSimilarities(
+	res []chromem.Result,
+) ([]project.DocumentSimilarity, error) {
 	if len(res) == 0 {
 		return nil, errors.New("no results found")
 	}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/philippgille/chromem-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -73,18 +74,21 @@ func TestChromemDB(t *testing.T) {
 		{
 			ID:      "1",
 			TaskID:  "task1",
+			BoardID: "board1",
 			Name:    "doc1",
 			Content: "The sky is blue because of Rayleigh scattering.",
 		},
 		{
 			ID:      "2",
 			TaskID:  "task1",
+			BoardID: "board1",
 			Name:    "doc2",
 			Content: "Leaves are green because chlorophyll absorbs red and blue light.",
 		},
 		{
 			ID:      "3",
 			TaskID:  "task2",
+			BoardID: "board2",
 			Name:    "doc3",
 			Content: "Water boils at 100 degrees Celsius at sea level.",
 		},
@@ -113,6 +117,32 @@ func TestChromemDB(t *testing.T) {
 		assert.Contains(t, results[0].Content, "Water boils")
 	})
 
+	// Test searching documents for a specific board
+	t.Run("search documents for board", func(t *testing.T) {
+		results, err := db.SearchDocumentsForBoard(ctx, "board1", "Why is the sky blue?")
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, "1", results[0].ID)
+
+		// Documents from other boards aren't visible
+		results, err = db.SearchDocumentsForBoard(ctx, "board1", "What temperature does water boil at?")
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.NotEqual(t, "3", results[0].ID)
+	})
+
+	t.Run("delete documents for task", func(t *testing.T) {
+		require.NoError(t, db.DeleteDocumentsForTask(ctx, "task1"))
+
+		_, err := db.SearchDocumentsForTask(ctx, "task1", "Why is the sky blue?")
+		assert.Error(t, err)
+
+		// Other tasks keep their documents
+		result, err := db.SearchDocumentsForTask(ctx, "task2", "What temperature does water boil at?")
+		require.NoError(t, err)
+		assert.Equal(t, "3", result.ID)
+	})
+
 	// Test searching with no results
 	t.Run("search with no results", func(t *testing.T) {
 		_, err := db.SearchDocumentsForTask(ctx, "nonexistent-task", "query")
@@ -120,3 +150,44 @@ func TestChromemDB(t *testing.T) {
 		assert.Contains(t, err.Error(), "no results found")
 	})
 }
+
+func TestChromemDBMigrateBoards(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewChromemDB(filepath.Join(t.TempDir(), "test.db"), "test-collection", mockEmbedding)
+	require.NoError(t, err)
+
+	// Embedded before boards existed
+	err = db.collection.AddDocument(ctx, chromem.Document{
+		ID:       "1",
+		Content:  "The sky is blue because of Rayleigh scattering.",
+		Metadata: map[string]string{db.taskField: "task1", db.docNameField: "doc1"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AddDocuments(ctx, []project.Document{{
+		ID:      "2",
+		TaskID:  "task2",
+		BoardID: "board2",
+		Name:    "doc2",
+		Content: "Water boils at 100 degrees Celsius.",
+	}}))
+
+	err = db.MigrateBoards(ctx, map[string]project.BoardID{
+		"1": "board1",
+		"2": "board1",
+		// A file without an embedding
+		"3": "board1",
+	})
+	require.NoError(t, err)
+
+	results, err := db.SearchDocumentsForBoard(ctx, "board1", "Why is the sky blue?")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "1", results[0].ID)
+
+	// Documents that have a board keep it
+	results, err = db.SearchDocumentsForBoard(ctx, "board2", "What temperature does water boil at?")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "2", results[0].ID)
+}
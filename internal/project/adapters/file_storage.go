@@ -8,6 +8,7 @@ import (
 	"github.com/DeluxeOwl/cogniboard/internal/project"
 	"gocloud.dev/blob"
 	_ "gocloud.dev/blob/fileblob"
+	"gocloud.dev/gcerrors"
 )
 
 type fileStorage struct {
@@ -48,21 +49,21 @@ func (s *fileStorage) Get(
 	taskID project.TaskID,
 	name string,
 ) (io.ReadCloser, error) {
-	iter := s.bucket.List(&blob.ListOptions{Prefix: string(taskID)})
-	obj, err := iter.Next(ctx)
-	if err == io.EOF {
+	r, err := s.bucket.NewReader(ctx, s.buildKey(taskID, name), nil)
+	if gcerrors.Code(err) == gcerrors.NotFound {
 		return nil, fmt.Errorf("file not found: %s", name)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to list files: %w", err)
+		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
-	return s.bucket.NewReader(ctx, obj.Key, nil)
+	return r, nil
 }
 
 func (s *fileStorage) Delete(ctx context.Context, taskID project.TaskID, name string) error {
 	key := s.buildKey(taskID, name)
-	if err := s.bucket.Delete(ctx, key); err != nil {
+	// Deleting is idempotent so a failed cascade can be retried
+	if err := s.bucket.Delete(ctx, key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
 		return fmt.Errorf("failed to delete file: %w", err)
 	}
 	return nil
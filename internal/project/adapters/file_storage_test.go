@@ -92,6 +92,20 @@ func TestFileStorage_Get(t *testing.T) {
 		assert.Equal(t, content, string(data))
 	})
 
+	t.Run("gets the file by name", func(t *testing.T) {
+		taskID := project.TaskID("task456")
+		require.NoError(t, storage.Store(ctx, taskID, "a.txt", strings.NewReader("first")))
+		require.NoError(t, storage.Store(ctx, taskID, "b.txt", strings.NewReader("second")))
+
+		reader, err := storage.Get(ctx, taskID, "b.txt")
+		require.NoError(t, err)
+		defer reader.Close()
+
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "second", string(data))
+	})
+
 	t.Run("returns error for non-existent file", func(t *testing.T) {
 		taskID := project.TaskID("nonexistent")
 		fileName := "nonexistent.txt"
@@ -103,6 +117,23 @@ func TestFileStorage_Get(t *testing.T) {
 	})
 }
 
+func TestFileStorage_Delete(t *testing.T) {
+	ctx := context.Background()
+	storage, err := NewFileStorage(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	taskID := project.TaskID("task123")
+	fileName := "test.txt"
+	require.NoError(t, storage.Store(ctx, taskID, fileName, strings.NewReader("test content")))
+
+	require.NoError(t, storage.Delete(ctx, taskID, fileName))
+	_, err = storage.Get(ctx, taskID, fileName)
+	require.Error(t, err)
+
+	// Deleting a missing file is not an error, so deleting a task can be retried
+	require.NoError(t, storage.Delete(ctx, taskID, fileName))
+}
+
 func TestFileStorage_buildKey(t *testing.T) {
 	storage := &fileStorage{}
 
@@ -2,17 +2,22 @@ package adapters
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"mime/multipart"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/DeluxeOwl/cogniboard/internal/project"
 	"github.com/DeluxeOwl/cogniboard/internal/project/app"
 	"github.com/DeluxeOwl/cogniboard/internal/project/app/commands"
 	"github.com/DeluxeOwl/cogniboard/internal/project/app/operations"
+	"github.com/DeluxeOwl/cogniboard/internal/project/app/queries"
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/samber/lo"
 )
 
 // Huma handles HTTP requests using the huma framework
@@ -24,13 +29,21 @@ type Huma struct {
 
 // NewHuma creates a new huma HTTP server
 func NewHuma(api huma.API, app *app.Application, logger *slog.Logger) *Huma {
+	// Huma builds all of its errors with it, including the ones of the request validation
+	huma.NewError = newProblem
+
 	return &Huma{api: api, app: app, logger: logger}
 }
 
+// ActorHeader names who is making the request, it's recorded in the history of the tasks they change
+const ActorHeader = "X-Actor"
+
 // Register registers all HTTP routes with huma
 func (h *Huma) Register() {
 	var maxBodyBytes int64 = 50 * 1024 * 1024
 
+	h.api.UseMiddleware(withActor)
+
 	huma.Register(h.api, huma.Operation{
 		OperationID:  "task-create",
 		Method:       http.MethodPost,
@@ -53,6 +66,13 @@ func (h *Huma) Register() {
 		Summary:     "Get all tasks",
 	}, h.getTasks)
 
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-get",
+		Method:      http.MethodGet,
+		Path:        "/tasks/{taskId}",
+		Summary:     "Get a task with its files, history and comments",
+	}, h.getTask)
+
 	huma.Register(h.api, huma.Operation{
 		OperationID:  "task-edit",
 		Method:       http.MethodPost,
@@ -67,14 +87,544 @@ func (h *Huma) Register() {
 		Path:        "/tasks/{taskId}/status",
 		Summary:     "Change task status",
 	}, h.changeTaskStatus)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-move",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/move",
+		Summary:     "Move a task to a position in a column",
+	}, h.moveTask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-add-subtask",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/subtasks/add",
+		Summary:     "Make a task a subtask of this task",
+	}, h.addSubtask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-remove-subtask",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/subtasks/remove",
+		Summary:     "Turn a subtask of this task back into a standalone task",
+	}, h.removeSubtask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-add-dependency",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/dependencies/add",
+		Summary:     "Mark a task as blocked by another task",
+	}, h.addDependency)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-remove-dependency",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/dependencies/remove",
+		Summary:     "Remove a task's blocker",
+	}, h.removeDependency)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-add-label",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/labels/add",
+		Summary:     "Add a label to a task",
+	}, h.addLabel)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-remove-label",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/labels/remove",
+		Summary:     "Remove a label from a task",
+	}, h.removeLabel)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-custom-field-set",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/custom-fields/set",
+		Summary:     "Set or clear a task's value for one of its board's custom fields",
+	}, h.setCustomFieldValue)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-add-assignee",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/assignees/add",
+		Summary:     "Assign a board member to a task",
+	}, h.addAssignee)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-remove-assignee",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/assignees/remove",
+		Summary:     "Unassign a board member from a task",
+	}, h.removeAssignee)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-add-watcher",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/watchers/add",
+		Summary:     "Make a board member watch a task",
+	}, h.addWatcher)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-remove-watcher",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/watchers/remove",
+		Summary:     "Stop a board member from watching a task",
+	}, h.removeWatcher)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-checklist-add",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/checklist/add",
+		Summary:     "Add an item to the end of a task's checklist",
+	}, h.addChecklistItem)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-checklist-toggle",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/checklist/{itemId}/toggle",
+		Summary:     "Tick or untick an item of a task's checklist",
+	}, h.toggleChecklistItem)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-checklist-move",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/checklist/{itemId}/move",
+		Summary:     "Move an item to a position of a task's checklist",
+	}, h.moveChecklistItem)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-checklist-remove",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/checklist/{itemId}/remove",
+		Summary:     "Remove an item from a task's checklist",
+	}, h.removeChecklistItem)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "tasks-archived",
+		Method:      http.MethodGet,
+		Path:        "/tasks/archived",
+		Summary:     "Get the archived tasks",
+	}, h.getArchivedTasks)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-archive",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/archive",
+		Summary:     "Archive a task, hiding it from the board",
+	}, h.archiveTask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-restore",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/restore",
+		Summary:     "Restore an archived task",
+	}, h.restoreTask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-delete",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/delete",
+		Summary:     "Delete a task for good, with its files, comments and history",
+	}, h.deleteTask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-clone",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/clone",
+		Summary:     "Copy a task with its details, labels, people and files",
+	}, h.cloneTask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-comment-create",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/comments/create",
+		Summary:     "Comment on a task or reply to a comment",
+	}, h.addComment)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-comments",
+		Method:      http.MethodGet,
+		Path:        "/tasks/{taskId}/comments",
+		Summary:     "Get the comment threads of a task",
+	}, h.getTaskComments)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-history",
+		Method:      http.MethodGet,
+		Path:        "/tasks/{taskId}/history",
+		Summary:     "Get who changed what on a task and when, oldest first",
+	}, h.getTaskHistory)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-worklog-create",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/worklogs/create",
+		Summary:     "Log time spent on a task",
+	}, h.logWork)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-worklogs",
+		Method:      http.MethodGet,
+		Path:        "/tasks/{taskId}/worklogs",
+		Summary:     "Get the time logged on a task, oldest first",
+	}, h.getTaskWorklogs)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-timer-start",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/timer/start",
+		Summary:     "Start a member's timer on a task",
+	}, h.startTimer)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "task-timer-stop",
+		Method:      http.MethodPost,
+		Path:        "/tasks/{taskId}/timer/stop",
+		Summary:     "Stop a member's timer on a task and log the time",
+	}, h.stopTimer)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "comment-edit",
+		Method:      http.MethodPost,
+		Path:        "/comments/{commentId}/edit",
+		Summary:     "Edit a comment",
+	}, h.editComment)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "comment-delete",
+		Method:      http.MethodPost,
+		Path:        "/comments/{commentId}/delete",
+		Summary:     "Delete a comment, its replies are kept",
+	}, h.deleteComment)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-create",
+		Method:      http.MethodPost,
+		Path:        "/boards/create",
+		Summary:     "Create a board",
+	}, h.createBoard)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "boards",
+		Method:      http.MethodGet,
+		Path:        "/boards",
+		Summary:     "Get all boards",
+	}, h.getBoards)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-rename",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/rename",
+		Summary:     "Rename a board",
+	}, h.renameBoard)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-archive",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/archive",
+		Summary:     "Archive a board",
+	}, h.archiveBoard)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-change-workflow",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/workflow",
+		Summary:     "Change a board's workflow columns and transitions",
+	}, h.changeBoardWorkflow)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-set-wip-limit",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/wip-limits",
+		Summary:     "Set the WIP limits of a status column",
+	}, h.setWIPLimit)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-label-create",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/labels/create",
+		Summary:     "Create a label on a board",
+	}, h.createLabel)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-labels",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/labels",
+		Summary:     "Get all labels of a board",
+	}, h.getBoardLabels)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-custom-field-create",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/custom-fields/create",
+		Summary:     "Define a custom field on a board's tasks",
+	}, h.createCustomField)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-custom-fields",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/custom-fields",
+		Summary:     "Get all custom fields of a board",
+	}, h.getBoardCustomFields)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-sprint-create",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/sprints/create",
+		Summary:     "Plan a sprint on a board",
+	}, h.createSprint)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-sprints",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/sprints",
+		Summary:     "Get all sprints of a board",
+	}, h.getBoardSprints)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-member-create",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/members/create",
+		Summary:     "Add a member to a board",
+	}, h.createMember)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-members",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/members",
+		Summary:     "Get all members of a board",
+	}, h.getBoardMembers)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-member-timesheet",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/members/{memberId}/timesheet",
+		Summary:     "Get the time a member logged on each day of a date range",
+	}, h.getMemberTimesheet)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-recurring-task-create",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/recurring-tasks/create",
+		Summary:     "Create a recurring task, a task is created from it each time it falls due",
+	}, h.createRecurringTask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-recurring-tasks",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/recurring-tasks",
+		Summary:     "Get the recurring tasks of a board",
+	}, h.getBoardRecurringTasks)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "recurring-task-delete",
+		Method:      http.MethodPost,
+		Path:        "/recurring-tasks/{recurringTaskId}/delete",
+		Summary:     "Delete a recurring task, the tasks created from it are kept",
+	}, h.deleteRecurringTask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID:  "board-template-create",
+		Method:       http.MethodPost,
+		Path:         "/boards/{boardId}/templates/create",
+		Summary:      "Create a task template, with subtasks and files copied to each task created from it",
+		MaxBodyBytes: maxBodyBytes,
+	}, h.createTaskTemplate)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-templates",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/templates",
+		Summary:     "Get the task templates of a board",
+	}, h.getBoardTemplates)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "template-delete",
+		Method:      http.MethodPost,
+		Path:        "/templates/{templateId}/delete",
+		Summary:     "Delete a task template, the tasks created from it are kept",
+	}, h.deleteTaskTemplate)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "template-task-create",
+		Method:      http.MethodPost,
+		Path:        "/templates/{templateId}/tasks/create",
+		Summary:     "Create a task from a template",
+	}, h.createTaskFromTemplate)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-current-sprint",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/sprints/current",
+		Summary:     "Get the active sprint of a board with its tasks",
+	}, h.getCurrentSprint)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "sprint-start",
+		Method:      http.MethodPost,
+		Path:        "/sprints/{sprintId}/start",
+		Summary:     "Start a planned sprint",
+	}, h.startSprint)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "sprint-close",
+		Method:      http.MethodPost,
+		Path:        "/sprints/{sprintId}/close",
+		Summary:     "Close the active sprint, rolling unfinished tasks over",
+	}, h.closeSprint)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "sprint-add-task",
+		Method:      http.MethodPost,
+		Path:        "/sprints/{sprintId}/tasks/add",
+		Summary:     "Move a task into a sprint",
+	}, h.addTaskToSprint)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "sprint-remove-task",
+		Method:      http.MethodPost,
+		Path:        "/sprints/{sprintId}/tasks/remove",
+		Summary:     "Move a task out of a sprint, back to the backlog",
+	}, h.removeTaskFromSprint)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-epic-create",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/epics/create",
+		Summary:     "Create an epic on a board",
+	}, h.createEpic)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-epics",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/epics",
+		Summary:     "Get all epics of a board",
+	}, h.getBoardEpics)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-epic-progress",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/epics/{epicId}/progress",
+		Summary:     "Get an epic of a board with its tasks and progress",
+	}, h.getEpicProgress)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "epic-add-task",
+		Method:      http.MethodPost,
+		Path:        "/epics/{epicId}/tasks/add",
+		Summary:     "Link a task to an epic",
+	}, h.addTaskToEpic)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "epic-remove-task",
+		Method:      http.MethodPost,
+		Path:        "/epics/{epicId}/tasks/remove",
+		Summary:     "Unlink a task from an epic",
+	}, h.removeTaskFromEpic)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-tasks",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/tasks",
+		Summary:     "Get all tasks of a board",
+	}, h.getBoardTasks)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-critical-path",
+		Method:      http.MethodGet,
+		Path:        "/boards/{boardId}/critical-path",
+		Summary:     "Get the longest chain of unfinished tasks blocking each other",
+	}, h.getBoardCriticalPath)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID:  "board-task-create",
+		Method:       http.MethodPost,
+		Path:         "/boards/{boardId}/tasks/create",
+		Summary:      "Create a task on a board",
+		MaxBodyBytes: maxBodyBytes,
+	}, h.createBoardTask)
+
+	huma.Register(h.api, huma.Operation{
+		OperationID: "board-chat",
+		Method:      http.MethodPost,
+		Path:        "/boards/{boardId}/chat",
+		Summary:     "Chat about a board",
+	}, h.chatWithBoard)
+}
+
+// withActor attaches who is calling the api to the request's context
+func withActor(ctx huma.Context, next func(huma.Context)) {
+	next(huma.WithContext(ctx, project.WithActor(ctx.Context(), project.Actor{
+		Name:   ctx.Header(ActorHeader),
+		Source: project.ChangeSourceAPI,
+	})))
 }
 
-// handleError is a helper function to handle errors consistently
+// Status of the problems made from each kind of domain error
+var errorKindStatus = map[project.ErrorKind]int{
+	project.ErrorKindNotFound:    http.StatusNotFound,
+	project.ErrorKindValidation:  http.StatusUnprocessableEntity,
+	project.ErrorKindConflict:    http.StatusConflict,
+	project.ErrorKindForbidden:   http.StatusForbidden,
+	project.ErrorKindUnavailable: http.StatusServiceUnavailable,
+}
+
+// handleError turns the errors of the application into problems, domain errors get the
+// status of their kind and keep their code, anything else is an internal error
 func handleError(err error) error {
-	if err != nil {
-		return huma.Error422UnprocessableEntity("operation failed", err)
+	if err == nil {
+		return nil
+	}
+
+	var domainErr *project.Error
+	if !errors.As(err, &domainErr) {
+		// The error is already logged by the handler, its details stay on the server
+		return huma.Error500InternalServerError("operation failed")
+	}
+
+	status := errorKindStatus[domainErr.Kind()]
+	// HTTP has a status of its own for changes made from an outdated version
+	if errors.Is(err, project.ErrTaskVersionMismatch) {
+		status = http.StatusPreconditionFailed
+	}
+
+	if domainErr.Kind() == project.ErrorKindUnavailable {
+		// Like for internal errors, what couldn't be reached stays on the server
+		return huma.NewError(status, domainErr.Error(), domainErr)
+	}
+
+	return huma.NewError(status, domainErr.Error(), err)
+}
+
+// Problem is an RFC 9457 problem details response, its code stays the same for a given
+// error so that clients can switch on it
+type Problem struct {
+	huma.ErrorModel
+	Code string `json:"code" doc:"Stable code of the error, like task_not_found" example:"task_not_found"`
+}
+
+var newErrorModel = huma.NewError
+
+// newProblem is used by huma for every error response, the code is the one of the
+// domain error if there is one, it's derived from the status otherwise
+func newProblem(status int, msg string, errs ...error) huma.StatusError {
+	problem := &Problem{
+		Code: strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_"),
 	}
-	return nil
+	if model, ok := newErrorModel(status, msg, errs...).(*huma.ErrorModel); ok {
+		problem.ErrorModel = *model
+	}
+
+	for _, err := range errs {
+		var domainErr *project.Error
+		if errors.As(err, &domainErr) {
+			problem.Code = domainErr.Code()
+			break
+		}
+	}
+
+	return problem
 }
 
 // In DTOs - for input adapters: e.g REST api
@@ -83,6 +633,23 @@ func (h *Huma) chatWithProject(
 	ctx context.Context,
 	input *struct{ Body operations.ChatWithProject },
 ) (*huma.StreamResponse, error) {
+	return h.streamChat(project.DefaultBoardID, input.Body), nil
+}
+
+func (h *Huma) chatWithBoard(
+	ctx context.Context,
+	input *struct {
+		BoardID string `path:"boardId"`
+		Body    operations.ChatWithProject
+	},
+) (*huma.StreamResponse, error) {
+	return h.streamChat(project.BoardID(input.BoardID), input.Body), nil
+}
+
+func (h *Huma) streamChat(
+	boardID project.BoardID,
+	body operations.ChatWithProject,
+) *huma.StreamResponse {
 	return &huma.StreamResponse{
 		Body: func(ctx huma.Context) {
 			ctx.SetHeader("Content-Type", "text/my-stream")
@@ -95,11 +662,13 @@ func (h *Huma) chatWithProject(
 			stream, err := h.app.Operations.ChatWithProject.Handle(
 				ctx.Context(),
 				operations.ChatWithProject{
-					Messages: input.Body.Messages,
+					Messages: body.Messages,
+					BoardID:  boardID,
 				},
 			)
 			if err != nil {
 				h.logger.Error("create stream", "err", err)
+				return
 			}
 
 			for chunk, err := range stream {
@@ -117,31 +686,56 @@ func (h *Huma) chatWithProject(
 				}
 			}
 		},
-	}, nil
+	}
 }
 
 // note: huma doesnt play well with struct embedding
 type CreateTask struct {
-	Title       string          `form:"title"         doc:"Task's name"              minLength:"1" maxLength:"50" required:"true"`
-	Description string          `form:"description"   doc:"Task's description"`
-	DueDate     time.Time       `form:"due_date"      doc:"Task's due date (if any)"                                              format:"date-time"`
-	Assignee    string          `form:"assignee_name" doc:"Task's asignee (if any)"`
-	Files       []huma.FormFile `form:"files"`
+	Title        string          `form:"title"         doc:"Task's name"              minLength:"1" maxLength:"50" required:"true"`
+	Description  string          `form:"description"   doc:"Task's description"`
+	DueDate      time.Time       `form:"due_date"      doc:"Task's due date (if any)"                                              format:"date-time"`
+	Assignee     string          `form:"assignee_name" doc:"Deprecated: use assignees"`
+	Assignees    []string        `form:"assignees"     doc:"IDs or display names of the board members the task is assigned to"`
+	Watchers     []string        `form:"watchers"      doc:"IDs or display names of the board members watching the task, they can't be assignees too"`
+	Priority     string          `form:"priority"      doc:"Task's priority from p0 (most urgent) to p3 (if any)"`
+	StoryPoints  string          `form:"story_points"  doc:"Task's estimate in story points (if any)"`
+	ParentID     string          `form:"parent_id"     doc:"Creates the task as a subtask of this task (if any)"`
+	Labels       []string        `form:"labels"        doc:"IDs or names of the board's labels"`
+	Checklist    []string        `form:"checklist"     doc:"Texts of the task's checklist items, in order"`
+	CustomFields []string        `form:"custom_fields" doc:"Values of the board's custom fields, as name=value"`
+	Files        []huma.FormFile `form:"files"`
 }
 
 func (h *Huma) createTask(ctx context.Context, input *struct {
 	RawBody huma.MultipartFormFiles[CreateTask]
 },
 ) (*struct{}, error) {
+	return nil, h.createTaskOnBoard(ctx, project.DefaultBoardID, &input.RawBody)
+}
+
+func (h *Huma) createBoardTask(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	RawBody huma.MultipartFormFiles[CreateTask]
+},
+) (*struct{}, error) {
+	return nil, h.createTaskOnBoard(ctx, project.BoardID(input.BoardID), &input.RawBody)
+}
+
+func (h *Huma) createTaskOnBoard(
+	ctx context.Context,
+	boardID project.BoardID,
+	rawBody *huma.MultipartFormFiles[CreateTask],
+) error {
 	taskID, err := project.NewTaskID()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	data := input.RawBody.Data()
+	data := rawBody.Data()
 
 	cmd := commands.CreateTask{
-		TaskID: taskID,
+		TaskID:  taskID,
+		BoardID: boardID,
 	}
 
 	if data.Title != "" {
@@ -152,25 +746,59 @@ func (h *Huma) createTask(ctx context.Context, input *struct {
 		cmd.DueDate = &data.DueDate
 	}
 
+	if len(data.Assignees) > 0 {
+		cmd.Assignees = data.Assignees
+	}
+
 	if data.Assignee != "" {
-		cmd.AssigneeName = &data.Assignee
+		cmd.Assignees = append(cmd.Assignees, data.Assignee)
+	}
+
+	if len(data.Watchers) > 0 {
+		cmd.Watchers = data.Watchers
 	}
 
 	if data.Description != "" {
 		cmd.Description = &data.Description
 	}
 
-	filesToUpload, err := h.prepareFilesForUpload(input.RawBody.Form.File)
+	if data.ParentID != "" {
+		cmd.ParentID = lo.ToPtr(project.TaskID(data.ParentID))
+	}
+
+	if len(data.Labels) > 0 {
+		cmd.Labels = data.Labels
+	}
+
+	if len(data.Checklist) > 0 {
+		cmd.Checklist = data.Checklist
+	}
+
+	if data.Priority != "" {
+		cmd.Priority = lo.ToPtr(project.TaskPriority(data.Priority))
+	}
+
+	cmd.StoryPoints, err = parseStoryPoints(data.StoryPoints)
 	if err != nil {
-		return nil, handleError(err)
+		return err
+	}
+
+	cmd.CustomFields, err = parseCustomFieldValues(data.CustomFields)
+	if err != nil {
+		return err
+	}
+
+	filesToUpload, err := h.prepareFilesForUpload(rawBody.Form.File)
+	if err != nil {
+		return handleError(err)
 	}
 
 	err = h.app.Commands.CreateTask.Handle(ctx, cmd)
 	if err != nil {
-		return nil, fmt.Errorf("task create: %w", err)
+		return handleError(err)
 	}
 
-	return nil, handleError(h.app.Commands.
+	return handleError(h.app.Commands.
 		AttachFilesToTask.
 		Handle(ctx, commands.AttachFilesToTask{
 			TaskID: taskID,
@@ -178,6 +806,76 @@ func (h *Huma) createTask(ctx context.Context, input *struct {
 		}))
 }
 
+// taskETag is the strong ETag of a task at the given version
+func taskETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// parseIfMatch reads the version of the task a change was made from, nil for * which
+// matches any version. Changes without it are refused so they can't overwrite the
+// changes they didn't see.
+func parseIfMatch(ifMatch string) (*int, error) {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" {
+		return nil, huma.NewError(
+			http.StatusPreconditionRequired,
+			"If-Match must be the ETag of the task the change was made from",
+		)
+	}
+
+	if ifMatch == "*" {
+		return nil, nil
+	}
+
+	// Weak and malformed ETags can never match the ones of the tasks
+	unquoted, err := strconv.Unquote(ifMatch)
+	if err != nil {
+		return nil, huma.Error412PreconditionFailed("If-Match is not the ETag of a task version")
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil {
+		return nil, huma.Error412PreconditionFailed("If-Match is not the ETag of a task version")
+	}
+
+	return &version, nil
+}
+
+// parseStoryPoints reads the optional story points of the task forms
+func parseStoryPoints(value string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	storyPoints, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, huma.Error422UnprocessableEntity("story points must be a number", err)
+	}
+
+	return &storyPoints, nil
+}
+
+// parseCustomFieldValues reads the custom field values of the task forms and filters,
+// given as field=value where the field is its ID or name
+func parseCustomFieldValues(values []string) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	parsed := make(map[string]string, len(values))
+	for _, v := range values {
+		field, value, found := strings.Cut(v, "=")
+		if !found || strings.TrimSpace(field) == "" {
+			return nil, huma.Error422UnprocessableEntity(
+				fmt.Sprintf("custom field values must look like name=value, got %q", v),
+			)
+		}
+		parsed[strings.TrimSpace(field)] = value
+	}
+
+	return parsed, nil
+}
+
 func (h *Huma) prepareFilesForUpload(
 	rawFiles map[string][]*multipart.FileHeader,
 ) ([]commands.FileToUpload, error) {
@@ -210,22 +908,169 @@ type ListTasks struct {
 }
 type Task struct {
 	project.TaskSnapshot
-	Files []File `json:"files"`
+	Files    []File `json:"files"`
+	Subtasks []Task `json:"subtasks"`
 }
 
 type File struct {
 	project.FileSnapshot
 }
 
-func (h *Huma) getTasks(ctx context.Context, input *struct{}) (*struct{ Body ListTasks }, error) {
-	tasks, err := h.app.Queries.AllTasks.Handle(ctx, struct{}{})
+type TaskPage struct {
+	Tasks      []Task  `json:"tasks"`
+	NextCursor *string `json:"next_cursor" doc:"Pass it as cursor to get the next page, null on the last page"`
+}
+
+func (h *Huma) getTasks(ctx context.Context, input *struct {
+	Board        string    `query:"board"            doc:"Only return the tasks of this board, by ID"`
+	Statuses     []string  `query:"status,explode"   doc:"Only return the tasks in any of these statuses"`
+	Assignees    []string  `query:"assignee,explode" doc:"Only return the tasks assigned to any of these members, by ID or display name"`
+	DueFrom      time.Time `query:"due_from"         doc:"Only return the tasks due on or after this day, e.g. 2026-01-01"                   timeFormat:"2006-01-02"`
+	DueTo        time.Time `query:"due_to"           doc:"Only return the tasks due on or before this day, e.g. 2026-01-31"                  timeFormat:"2006-01-02"`
+	Text         string    `query:"q"                doc:"Only return the tasks with this text in their title or description, ignoring case"`
+	Labels       []string  `query:"label,explode"    doc:"Only return the tasks that have all of these labels, by ID or name"`
+	CustomFields []string  `query:"field,explode"    doc:"Only return the tasks that have all of these custom field values, as name=value"`
+	UpdatedSince time.Time `query:"updated_since"    doc:"Only return the tasks changed since then"                                          format:"date-time"`
+	Sort         string    `query:"sort"             doc:"Column to sort on, column for the order of the board, empty values come last"      enum:"column,title,status,rank,priority,story_points,due_date,created_at,updated_at,completed_at" default:"column"`
+	Order        string    `query:"order"            doc:"Sort order"                                                                        enum:"asc,desc"                                                                                   default:"asc"`
+	Cursor       string    `query:"cursor"           doc:"The next_cursor of the previous page, empty for the first page"`
+	Limit        int       `query:"limit"            doc:"Number of tasks per page"                                                          minimum:"1"                                                                                       maximum:"200"        default:"50"`
+},
+) (*struct{ Body TaskPage }, error) {
+	customFields, err := parseCustomFieldValues(input.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := project.NewTaskSort(input.Sort, input.Order == "desc")
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	filter := project.TaskFilter{
+		Statuses: lo.Map(input.Statuses, func(s string, _ int) project.TaskStatus {
+			return project.TaskStatus(s)
+		}),
+		Assignees:    input.Assignees,
+		Text:         input.Text,
+		Labels:       input.Labels,
+		CustomFields: customFields,
+	}
+	if input.Board != "" {
+		filter.BoardID = lo.ToPtr(project.BoardID(input.Board))
+	}
+	if !input.DueFrom.IsZero() {
+		filter.DueFrom = &input.DueFrom
+	}
+	if !input.DueTo.IsZero() {
+		filter.DueTo = &input.DueTo
+	}
+	if !input.UpdatedSince.IsZero() {
+		filter.UpdatedSince = &input.UpdatedSince
+	}
+
+	page, err := h.app.Queries.ListTasks.Handle(ctx, queries.ListTasks{
+		Filter: filter,
+		Sort:   sort,
+		Cursor: input.Cursor,
+		Limit:  input.Limit,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body TaskPage }{
+		Body: TaskPage{
+			Tasks:      listTasksFrom(page.Tasks).Tasks,
+			NextCursor: page.NextCursor,
+		},
+	}, nil
+}
+
+type TaskDetails struct {
+	Task       Task                       `json:"task"`
+	Activities []project.ActivitySnapshot `json:"activities" doc:"Who changed what on the task and when, oldest first"`
+	Comments   []project.CommentNode      `json:"comments"`
+}
+
+type GetTaskOutput struct {
+	ETag string `header:"ETag" doc:"Version of the task, to send as If-Match when changing it"`
+	Body TaskDetails
+}
+
+func (h *Huma) getTask(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+},
+) (*GetTaskOutput, error) {
+	details, err := h.app.Queries.GetTask.Handle(ctx, queries.GetTask{
+		TaskID: project.TaskID(input.TaskID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	activities := make([]project.ActivitySnapshot, len(details.History))
+	for i := range details.History {
+		activities[i] = details.History[i].GetSnapshot()
+	}
+
+	// Subtasks are only listed in child_ids, they aren't nested
+	return &GetTaskOutput{
+		ETag: taskETag(details.Task.Version()),
+		Body: TaskDetails{
+			Task: taskFrom(project.TaskNode{
+				TaskSnapshot: details.Task.GetSnapshot(),
+				Subtasks:     []project.TaskNode{},
+			}),
+			Activities: activities,
+			Comments:   details.Comments,
+		},
+	}, nil
+}
+
+func (h *Huma) getBoardTasks(ctx context.Context, input *struct {
+	BoardID      string   `path:"boardId"`
+	Labels       []string `query:"label,explode" doc:"Only return the tasks that have all of these labels, by ID or name"`
+	CustomFields []string `query:"field,explode" doc:"Only return the tasks that have all of these custom field values, as name=value"`
+},
+) (*struct{ Body ListTasks }, error) {
+	customFields, err := parseCustomFieldValues(input.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := h.app.Queries.BoardTasks.Handle(ctx, queries.BoardTasks{
+		BoardID:      project.BoardID(input.BoardID),
+		Labels:       input.Labels,
+		CustomFields: customFields,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body ListTasks }{
+		Body: listTasksFrom(tasks),
+	}, nil
+}
+
+func (h *Huma) getBoardCriticalPath(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{ Body ListTasks }, error) {
+	tasks, err := h.app.Queries.BoardCriticalPath.Handle(ctx, queries.BoardCriticalPath{
+		BoardID: project.BoardID(input.BoardID),
+	})
 	if err != nil {
-		return nil, huma.Error400BadRequest("couldn't get tasks", err)
+		return nil, handleError(err)
 	}
 
+	// The path is kept flat and in order, subtasks aren't nested
 	dtos := make([]Task, len(tasks))
-	for i, task := range tasks {
-		dtos[i] = taskFrom(&task)
+	for i := range tasks {
+		dtos[i] = taskFrom(project.TaskNode{
+			TaskSnapshot: tasks[i].GetSnapshot(),
+			Subtasks:     []project.TaskNode{},
+		})
 	}
 
 	return &struct{ Body ListTasks }{
@@ -233,76 +1078,1605 @@ func (h *Huma) getTasks(ctx context.Context, input *struct{}) (*struct{ Body Lis
 	}, nil
 }
 
+// listTasksFrom nests subtasks under their parent tasks
+func listTasksFrom(tasks []project.Task) ListTasks {
+	tree := project.TaskTree(tasks)
+	dtos := make([]Task, len(tree))
+	for i, node := range tree {
+		dtos[i] = taskFrom(node)
+	}
+	return ListTasks{Tasks: dtos}
+}
+
 type EditTask struct {
 	Title        string          `form:"title"         doc:"Task's name"              minLength:"1" maxLength:"50" required:"true"`
 	Description  string          `form:"description"   doc:"Task's description"`
 	DueDate      time.Time       `form:"due_date"      doc:"Task's due date (if any)"                                              format:"date-time"`
-	AssigneeName string          `form:"assignee_name" doc:"Task's asignee (if any)"`
+	AssigneeName string          `form:"assignee_name" doc:"Deprecated: use assignees"`
+	Assignees    []string        `form:"assignees"     doc:"IDs or display names of the board members the task is assigned to, replaces the task's assignees"`
+	Watchers     []string        `form:"watchers"      doc:"IDs or display names of the board members watching the task, replaces the task's watchers"`
+	Priority     string          `form:"priority"      doc:"Task's priority from p0 (most urgent) to p3 (if any)"`
+	StoryPoints  string          `form:"story_points"  doc:"Task's estimate in story points (if any)"`
+	Labels       []string        `form:"labels"        doc:"IDs or names of the board's labels, replaces the task's labels"`
+	Force        bool            `form:"force"         doc:"Let the edit through even if it breaks a WIP limit"`
+	ForcedBy     string          `form:"forced_by"     doc:"ID or display name of the board admin forcing the edit past the WIP limit"`
 	Files        []huma.FormFile `form:"files"`
 }
 
 func (h *Huma) editTask(ctx context.Context, input *struct {
 	TaskID  string `path:"taskId"`
+	IfMatch string `header:"If-Match" doc:"Required, the ETag of the task the edit was made from, * to edit it whatever its version"`
 	RawBody huma.MultipartFormFiles[EditTask]
 },
 ) (*struct{}, error) {
+	version, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
 	data := input.RawBody.Data()
 
 	cmd := commands.EditTask{
-		TaskID: input.TaskID,
-		Title:  &data.Title, // validated from form
+		TaskID:  input.TaskID,
+		Title:   &data.Title, // validated from form
+		Version: version,
+		WIPOverride: commands.WIPOverride{
+			Force:    data.Force,
+			ForcedBy: data.ForcedBy,
+		},
 	}
 
 	if !data.DueDate.IsZero() {
 		cmd.DueDate = &data.DueDate
 	}
 
+	if len(data.Assignees) > 0 {
+		cmd.Assignees = data.Assignees
+	}
+
 	if data.AssigneeName != "" {
-		cmd.AssigneeName = &data.AssigneeName
+		cmd.Assignees = append(cmd.Assignees, data.AssigneeName)
+	}
+
+	if len(data.Watchers) > 0 {
+		cmd.Watchers = data.Watchers
 	}
 
 	if data.Description != "" {
 		cmd.Description = &data.Description
 	}
 
-	err := h.app.Commands.EditTask.Handle(ctx, cmd)
+	if len(data.Labels) > 0 {
+		cmd.Labels = data.Labels
+	}
+
+	if data.Priority != "" {
+		cmd.Priority = lo.ToPtr(project.TaskPriority(data.Priority))
+	}
+
+	storyPoints, err := parseStoryPoints(data.StoryPoints)
+	if err != nil {
+		return nil, err
+	}
+	cmd.StoryPoints = storyPoints
+
+	err = h.app.Commands.EditTask.Handle(ctx, cmd)
 
 	return nil, handleError(err)
 }
 
 type ChangeTaskStatus struct {
-	Status string `json:"status" doc:"New status for the task" minLength:"1"`
+	Status   string `json:"status"              doc:"New status for the task"                                  minLength:"1"`
+	Force    bool   `json:"force,omitempty"     doc:"Let the move through even if it breaks a WIP limit"`
+	ForcedBy string `json:"forced_by,omitempty" doc:"ID or display name of the board admin forcing the move past the WIP limit"`
 }
 
 func (h *Huma) changeTaskStatus(ctx context.Context, input *struct {
-	TaskID string           `path:"taskId"`
-	Body   ChangeTaskStatus `json:"body"`
+	TaskID  string           `path:"taskId"`
+	IfMatch string           `header:"If-Match" doc:"Required, the ETag of the task the change was made from, * to change it whatever its version"`
+	Body    ChangeTaskStatus `json:"body"`
 },
 ) (*struct{}, error) {
-	err := h.app.Commands.ChangeTaskStatus.Handle(ctx, commands.ChangeTaskStatus{
+	version, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.app.Commands.ChangeTaskStatus.Handle(ctx, commands.ChangeTaskStatus{
 		TaskID: input.TaskID,
 		Status: input.Body.Status,
+		WIPOverride: commands.WIPOverride{
+			Force:    input.Body.Force,
+			ForcedBy: input.Body.ForcedBy,
+		},
+		Version: version,
 	})
 
 	return nil, handleError(err)
 }
 
-func taskFrom(task *project.Task) Task {
-	snap := task.GetSnapshot()
-	dto := Task{
-		TaskSnapshot: *task.GetSnapshot(),
-		Files:        make([]File, len(snap.Files)),
-	}
+type MoveTask struct {
+	Status   string `json:"status"              doc:"Column to move the task to, can be its current one"      minLength:"1"`
+	After    string `json:"after,omitempty"     doc:"ID of the task right above the new position, empty for the top of the column"`
+	Before   string `json:"before,omitempty"    doc:"ID of the task right below the new position, empty for the bottom of the column"`
+	Force    bool   `json:"force,omitempty"     doc:"Let the move through even if it breaks a WIP limit"`
+	ForcedBy string `json:"forced_by,omitempty" doc:"ID or display name of the board admin forcing the move past the WIP limit"`
+}
 
-	for i := range snap.Files {
-		dto.Files[i] = fileFrom(&snap.Files[i])
+func (h *Huma) moveTask(ctx context.Context, input *struct {
+	TaskID  string   `path:"taskId"`
+	IfMatch string   `header:"If-Match" doc:"Required, the ETag of the task the move was made from, * to move it whatever its version"`
+	Body    MoveTask `json:"body"`
+},
+) (*struct{}, error) {
+	version, err := parseIfMatch(input.IfMatch)
+	if err != nil {
+		return nil, err
 	}
 
-	return dto
+	err = h.app.Commands.MoveTask.Handle(ctx, commands.MoveTask{
+		TaskID:   input.TaskID,
+		Status:   input.Body.Status,
+		AfterID:  lo.EmptyableToPtr(input.Body.After),
+		BeforeID: lo.EmptyableToPtr(input.Body.Before),
+		WIPOverride: commands.WIPOverride{
+			Force:    input.Body.Force,
+			ForcedBy: input.Body.ForcedBy,
+		},
+		Version: version,
+	})
+
+	return nil, handleError(err)
 }
 
-func fileFrom(file *project.File) File {
-	return File{
-		file.GetSnapshot(),
+type Subtask struct {
+	TaskID string `json:"task_id" doc:"The subtask's ID" minLength:"1"`
+}
+
+func (h *Huma) addSubtask(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   Subtask
+},
+) (*struct{}, error) {
+	err := h.app.Commands.AddSubtask.Handle(ctx, commands.AddSubtask{
+		ParentID: input.TaskID,
+		ChildID:  input.Body.TaskID,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) removeSubtask(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   Subtask
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RemoveSubtask.Handle(ctx, commands.RemoveSubtask{
+		ParentID: input.TaskID,
+		ChildID:  input.Body.TaskID,
+	})
+
+	return nil, handleError(err)
+}
+
+type Dependency struct {
+	BlockedByID string `json:"blocked_by_id" doc:"The ID of the task that has to be completed first" minLength:"1"`
+}
+
+func (h *Huma) addDependency(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   Dependency
+},
+) (*struct{}, error) {
+	err := h.app.Commands.AddDependency.Handle(ctx, commands.AddTaskDependency{
+		TaskID:      input.TaskID,
+		BlockedByID: input.Body.BlockedByID,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) removeDependency(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   Dependency
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RemoveDependency.Handle(ctx, commands.RemoveTaskDependency{
+		TaskID:      input.TaskID,
+		BlockedByID: input.Body.BlockedByID,
+	})
+
+	return nil, handleError(err)
+}
+
+type TaskLabel struct {
+	LabelID string `json:"label_id" doc:"The label's ID" minLength:"1"`
+}
+
+func (h *Huma) addLabel(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   TaskLabel
+},
+) (*struct{}, error) {
+	err := h.app.Commands.AddLabel.Handle(ctx, commands.AddLabel{
+		TaskID:  input.TaskID,
+		LabelID: input.Body.LabelID,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) removeLabel(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   TaskLabel
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RemoveLabel.Handle(ctx, commands.RemoveLabel{
+		TaskID:  input.TaskID,
+		LabelID: input.Body.LabelID,
+	})
+
+	return nil, handleError(err)
+}
+
+type TaskCustomFieldValue struct {
+	Field string  `json:"field"           doc:"ID or name of one of the board's custom fields" minLength:"1"`
+	Value *string `json:"value,omitempty" doc:"The task's value, leave it out to clear it. Dates look like 2026-01-31 and users are member IDs or display names"`
+}
+
+func (h *Huma) setCustomFieldValue(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   TaskCustomFieldValue
+},
+) (*struct{}, error) {
+	err := h.app.Commands.SetCustomFieldValue.Handle(ctx, commands.SetCustomFieldValue{
+		TaskID: input.TaskID,
+		Field:  input.Body.Field,
+		Value:  input.Body.Value,
+	})
+
+	return nil, handleError(err)
+}
+
+type TaskAssignee struct {
+	Member   string `json:"member"              doc:"ID or display name of a board member"                              minLength:"1"`
+	Force    bool   `json:"force,omitempty"     doc:"Let the assignment through even if it breaks a WIP limit"`
+	ForcedBy string `json:"forced_by,omitempty" doc:"ID or display name of the board admin forcing the assignment past the WIP limit"`
+}
+
+func (h *Huma) addAssignee(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   TaskAssignee
+},
+) (*struct{}, error) {
+	err := h.app.Commands.AddAssignee.Handle(ctx, commands.AddAssignee{
+		TaskID: input.TaskID,
+		Member: input.Body.Member,
+		WIPOverride: commands.WIPOverride{
+			Force:    input.Body.Force,
+			ForcedBy: input.Body.ForcedBy,
+		},
+	})
+
+	return nil, handleError(err)
+}
+
+type TaskMember struct {
+	Member string `json:"member" doc:"ID or display name of a board member" minLength:"1"`
+}
+
+func (h *Huma) removeAssignee(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   TaskMember
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RemoveAssignee.Handle(ctx, commands.RemoveAssignee{
+		TaskID: input.TaskID,
+		Member: input.Body.Member,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) addWatcher(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   TaskMember
+},
+) (*struct{}, error) {
+	err := h.app.Commands.AddWatcher.Handle(ctx, commands.AddWatcher{
+		TaskID: input.TaskID,
+		Member: input.Body.Member,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) removeWatcher(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   TaskMember
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RemoveWatcher.Handle(ctx, commands.RemoveWatcher{
+		TaskID: input.TaskID,
+		Member: input.Body.Member,
+	})
+
+	return nil, handleError(err)
+}
+
+type AddChecklistItem struct {
+	Text string `json:"text" doc:"What has to be done" minLength:"1" maxLength:"200"`
+}
+
+type AddedChecklistItem struct {
+	ID project.ChecklistItemID `json:"id" doc:"The added item's ID"`
+}
+
+func (h *Huma) addChecklistItem(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   AddChecklistItem
+},
+) (*struct{ Body AddedChecklistItem }, error) {
+	itemID, err := project.NewChecklistItemID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.app.Commands.AddChecklistItem.Handle(ctx, commands.AddChecklistItem{
+		TaskID: input.TaskID,
+		ItemID: itemID,
+		Text:   input.Body.Text,
+	})
+	if err != nil {
+		return nil, handleError(err)
 	}
+
+	return &struct{ Body AddedChecklistItem }{
+		Body: AddedChecklistItem{ID: itemID},
+	}, nil
+}
+
+type ToggleChecklistItem struct {
+	Done *bool `json:"done,omitempty" doc:"Whether the item is done, flips it when left out"`
+}
+
+func (h *Huma) toggleChecklistItem(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	ItemID string `path:"itemId"`
+	Body   ToggleChecklistItem
+},
+) (*struct{}, error) {
+	err := h.app.Commands.ToggleChecklistItem.Handle(ctx, commands.ToggleChecklistItem{
+		TaskID: input.TaskID,
+		ItemID: input.ItemID,
+		Done:   input.Body.Done,
+	})
+
+	return nil, handleError(err)
+}
+
+type MoveChecklistItem struct {
+	Position int `json:"position" doc:"New position of the item, 0 is the top of the checklist" minimum:"0"`
+}
+
+func (h *Huma) moveChecklistItem(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	ItemID string `path:"itemId"`
+	Body   MoveChecklistItem
+},
+) (*struct{}, error) {
+	err := h.app.Commands.MoveChecklistItem.Handle(ctx, commands.MoveChecklistItem{
+		TaskID:   input.TaskID,
+		ItemID:   input.ItemID,
+		Position: input.Body.Position,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) removeChecklistItem(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	ItemID string `path:"itemId"`
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RemoveChecklistItem.Handle(ctx, commands.RemoveChecklistItem{
+		TaskID: input.TaskID,
+		ItemID: input.ItemID,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) getArchivedTasks(ctx context.Context, input *struct{}) (*struct{ Body ListTasks }, error) {
+	tasks, err := h.app.Queries.ArchivedTasks.Handle(ctx, queries.ArchivedTasks{})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body ListTasks }{
+		Body: listTasksFrom(tasks),
+	}, nil
+}
+
+func (h *Huma) archiveTask(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+},
+) (*struct{}, error) {
+	err := h.app.Commands.ArchiveTask.Handle(ctx, commands.ArchiveTask{
+		TaskID: input.TaskID,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) restoreTask(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RestoreTask.Handle(ctx, commands.RestoreTask{
+		TaskID: input.TaskID,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) deleteTask(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+},
+) (*struct{}, error) {
+	err := h.app.Commands.DeleteTask.Handle(ctx, commands.DeleteTask{
+		TaskID: input.TaskID,
+	})
+
+	return nil, handleError(err)
+}
+
+type ClonedTask struct {
+	ID project.TaskID `json:"id" doc:"The clone's ID"`
+}
+
+func (h *Huma) cloneTask(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+},
+) (*struct{ Body ClonedTask }, error) {
+	cloneID, err := project.NewTaskID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.app.Commands.CloneTask.Handle(ctx, commands.CloneTask{
+		TaskID:  input.TaskID,
+		CloneID: cloneID,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body ClonedTask }{
+		Body: ClonedTask{ID: cloneID},
+	}, nil
+}
+
+type CreateComment struct {
+	Author   string `json:"author"              doc:"Who is writing the comment"                 minLength:"1"`
+	Body     string `json:"body"                doc:"Markdown, assignees can be @mentioned"      minLength:"1" maxLength:"5000"`
+	ParentID string `json:"parent_id,omitempty" doc:"The comment this one replies to (if any)"`
+}
+
+type CreatedComment struct {
+	ID project.CommentID `json:"id" doc:"The created comment's ID"`
+}
+
+func (h *Huma) addComment(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   CreateComment
+},
+) (*struct{ Body CreatedComment }, error) {
+	commentID, err := project.NewCommentID()
+	if err != nil {
+		return nil, err
+	}
+
+	var parentID *string
+	if input.Body.ParentID != "" {
+		parentID = &input.Body.ParentID
+	}
+
+	err = h.app.Commands.AddComment.Handle(ctx, commands.AddComment{
+		CommentID: commentID,
+		TaskID:    input.TaskID,
+		ParentID:  parentID,
+		Author:    input.Body.Author,
+		Body:      input.Body.Body,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedComment }{
+		Body: CreatedComment{ID: commentID},
+	}, nil
+}
+
+type ListComments struct {
+	Comments []project.CommentNode `json:"comments"`
+}
+
+func (h *Huma) getTaskComments(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+},
+) (*struct{ Body ListComments }, error) {
+	thread, err := h.app.Queries.TaskComments.Handle(ctx, queries.TaskComments{
+		TaskID: project.TaskID(input.TaskID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body ListComments }{
+		Body: ListComments{Comments: thread},
+	}, nil
+}
+
+type TaskHistory struct {
+	Activities []project.ActivitySnapshot `json:"activities"`
+}
+
+func (h *Huma) getTaskHistory(ctx context.Context, input *struct {
+	TaskID string    `path:"taskId"`
+	Since  time.Time `query:"since" doc:"Only return the changes made from this time on" format:"date-time"`
+},
+) (*struct{ Body TaskHistory }, error) {
+	var since *time.Time
+	if !input.Since.IsZero() {
+		since = &input.Since
+	}
+
+	history, err := h.app.Queries.TaskHistory.Handle(ctx, queries.TaskHistory{
+		TaskID: project.TaskID(input.TaskID),
+		Since:  since,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]project.ActivitySnapshot, len(history))
+	for i := range history {
+		dtos[i] = history[i].GetSnapshot()
+	}
+
+	return &struct{ Body TaskHistory }{
+		Body: TaskHistory{Activities: dtos},
+	}, nil
+}
+
+type LogWork struct {
+	Member  string `json:"member"         doc:"ID or display name of a board member"        minLength:"1"`
+	Minutes int    `json:"minutes"        doc:"Time spent on the task"                      minimum:"1"`
+	Date    string `json:"date,omitempty" doc:"The day the work was done, today if left out" format:"date"`
+	Note    string `json:"note,omitempty" doc:"What was done"                               maxLength:"500"`
+}
+
+type CreatedWorklog struct {
+	ID project.WorklogID `json:"id" doc:"The created worklog's ID"`
+}
+
+func (h *Huma) logWork(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   LogWork
+},
+) (*struct{ Body CreatedWorklog }, error) {
+	worklogID, err := project.NewWorklogID()
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now()
+	if input.Body.Date != "" {
+		date, err = time.Parse(time.DateOnly, input.Body.Date)
+		if err != nil {
+			return nil, huma.Error422UnprocessableEntity("date must look like 2026-01-31", err)
+		}
+	}
+
+	err = h.app.Commands.LogWork.Handle(ctx, commands.LogWork{
+		WorklogID: worklogID,
+		TaskID:    input.TaskID,
+		Member:    input.Body.Member,
+		Duration:  time.Duration(input.Body.Minutes) * time.Minute,
+		Date:      date,
+		Note:      lo.EmptyableToPtr(input.Body.Note),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedWorklog }{
+		Body: CreatedWorklog{ID: worklogID},
+	}, nil
+}
+
+type ListWorklogs struct {
+	Worklogs []project.WorklogSnapshot `json:"worklogs"`
+}
+
+func (h *Huma) getTaskWorklogs(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+},
+) (*struct{ Body ListWorklogs }, error) {
+	worklogs, err := h.app.Queries.TaskWorklogs.Handle(ctx, queries.TaskWorklogs{
+		TaskID: project.TaskID(input.TaskID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]project.WorklogSnapshot, len(worklogs))
+	for i := range worklogs {
+		dtos[i] = worklogs[i].GetSnapshot()
+	}
+
+	return &struct{ Body ListWorklogs }{
+		Body: ListWorklogs{Worklogs: dtos},
+	}, nil
+}
+
+type TaskTimer struct {
+	Member string `json:"member" doc:"ID or display name of a board member" minLength:"1"`
+}
+
+func (h *Huma) startTimer(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   TaskTimer
+},
+) (*struct{}, error) {
+	err := h.app.Commands.StartTimer.Handle(ctx, commands.StartTimer{
+		TaskID: input.TaskID,
+		Member: input.Body.Member,
+	})
+
+	return nil, handleError(err)
+}
+
+type StopTimer struct {
+	Member string `json:"member"         doc:"ID or display name of a board member" minLength:"1"`
+	Note   string `json:"note,omitempty" doc:"What was done"                        maxLength:"500"`
+}
+
+func (h *Huma) stopTimer(ctx context.Context, input *struct {
+	TaskID string `path:"taskId"`
+	Body   StopTimer
+},
+) (*struct{ Body CreatedWorklog }, error) {
+	worklogID, err := project.NewWorklogID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.app.Commands.StopTimer.Handle(ctx, commands.StopTimer{
+		WorklogID: worklogID,
+		TaskID:    input.TaskID,
+		Member:    input.Body.Member,
+		Note:      lo.EmptyableToPtr(input.Body.Note),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedWorklog }{
+		Body: CreatedWorklog{ID: worklogID},
+	}, nil
+}
+
+type EditComment struct {
+	Author string `json:"author" doc:"Who is editing, only the author can edit a comment" minLength:"1"`
+	Body   string `json:"body"   doc:"Markdown, assignees can be @mentioned"               minLength:"1" maxLength:"5000"`
+}
+
+func (h *Huma) editComment(ctx context.Context, input *struct {
+	CommentID string `path:"commentId"`
+	Body      EditComment
+},
+) (*struct{}, error) {
+	err := h.app.Commands.EditComment.Handle(ctx, commands.EditComment{
+		CommentID: input.CommentID,
+		Author:    input.Body.Author,
+		Body:      input.Body.Body,
+	})
+
+	return nil, handleError(err)
+}
+
+type DeleteComment struct {
+	Author string `json:"author" doc:"Who is deleting, only the author can delete a comment" minLength:"1"`
+}
+
+func (h *Huma) deleteComment(ctx context.Context, input *struct {
+	CommentID string `path:"commentId"`
+	Body      DeleteComment
+},
+) (*struct{}, error) {
+	err := h.app.Commands.DeleteComment.Handle(ctx, commands.DeleteComment{
+		CommentID: input.CommentID,
+		Author:    input.Body.Author,
+	})
+
+	return nil, handleError(err)
+}
+
+func taskFrom(node project.TaskNode) Task {
+	snap := node.TaskSnapshot
+	dto := Task{
+		TaskSnapshot: *snap,
+		Files:        make([]File, len(snap.Files)),
+		Subtasks:     make([]Task, len(node.Subtasks)),
+	}
+
+	for i := range snap.Files {
+		dto.Files[i] = fileFrom(&snap.Files[i])
+	}
+
+	for i, subtask := range node.Subtasks {
+		dto.Subtasks[i] = taskFrom(subtask)
+	}
+
+	return dto
+}
+
+func fileFrom(file *project.File) File {
+	return File{
+		file.GetSnapshot(),
+	}
+}
+
+type CreateBoard struct {
+	Name string `json:"name" doc:"Board's name" minLength:"1" maxLength:"50"`
+}
+
+type CreatedBoard struct {
+	ID project.BoardID `json:"id" doc:"The created board's ID"`
+}
+
+func (h *Huma) createBoard(ctx context.Context, input *struct {
+	Body CreateBoard
+},
+) (*struct{ Body CreatedBoard }, error) {
+	boardID, err := project.NewBoardID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.app.Commands.CreateBoard.Handle(ctx, commands.CreateBoard{
+		BoardID: boardID,
+		Name:    input.Body.Name,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedBoard }{
+		Body: CreatedBoard{ID: boardID},
+	}, nil
+}
+
+type ListBoards struct {
+	Boards []project.BoardSnapshot `json:"boards"`
+}
+
+func (h *Huma) getBoards(ctx context.Context, input *struct {
+	IncludeArchived bool `query:"include_archived" doc:"Also return archived boards"`
+},
+) (*struct{ Body ListBoards }, error) {
+	boards, err := h.app.Queries.AllBoards.Handle(ctx, queries.AllBoards{
+		IncludeArchived: input.IncludeArchived,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]project.BoardSnapshot, len(boards))
+	for i, board := range boards {
+		dtos[i] = *board.GetSnapshot()
+	}
+
+	return &struct{ Body ListBoards }{
+		Body: ListBoards{Boards: dtos},
+	}, nil
+}
+
+type RenameBoard struct {
+	Name string `json:"name" doc:"Board's new name" minLength:"1" maxLength:"50"`
+}
+
+func (h *Huma) renameBoard(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Body    RenameBoard
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RenameBoard.Handle(ctx, commands.RenameBoard{
+		BoardID: input.BoardID,
+		Name:    input.Body.Name,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) archiveBoard(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{}, error) {
+	err := h.app.Commands.ArchiveBoard.Handle(ctx, commands.ArchiveBoard{
+		BoardID: input.BoardID,
+	})
+
+	return nil, handleError(err)
+}
+
+type ChangeBoardWorkflow struct {
+	Statuses    []string            `json:"statuses"              doc:"The board's columns in order, must contain pending and completed" minItems:"2"`
+	Transitions map[string][]string `json:"transitions,omitempty" doc:"Allowed moves from a status, a status without an entry can move to any column"`
+}
+
+func (h *Huma) changeBoardWorkflow(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Body    ChangeBoardWorkflow
+},
+) (*struct{}, error) {
+	err := h.app.Commands.ChangeBoardWorkflow.Handle(ctx, commands.ChangeBoardWorkflow{
+		BoardID:     input.BoardID,
+		Statuses:    input.Body.Statuses,
+		Transitions: input.Body.Transitions,
+	})
+
+	return nil, handleError(err)
+}
+
+type SetWIPLimit struct {
+	Status      string `json:"status"       doc:"The status column to limit"                      minLength:"1"`
+	Board       int    `json:"board"        doc:"Max tasks in the column, 0 removes the limit"      minimum:"0"`
+	PerAssignee int    `json:"per_assignee" doc:"Max tasks per assignee in the column, 0 removes the limit" minimum:"0"`
+}
+
+func (h *Huma) setWIPLimit(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Body    SetWIPLimit
+},
+) (*struct{}, error) {
+	err := h.app.Commands.SetWIPLimit.Handle(ctx, commands.SetWIPLimit{
+		BoardID:     input.BoardID,
+		Status:      input.Body.Status,
+		Board:       input.Body.Board,
+		PerAssignee: input.Body.PerAssignee,
+	})
+
+	return nil, handleError(err)
+}
+
+type CreateLabel struct {
+	Name  string `json:"name"  doc:"Label's name"                    minLength:"1" maxLength:"30"`
+	Color string `json:"color" doc:"Label's color, e.g. #d73a4a" pattern:"^#[0-9a-fA-F]{6}$"`
+}
+
+type CreatedLabel struct {
+	ID project.LabelID `json:"id" doc:"The created label's ID"`
+}
+
+func (h *Huma) createLabel(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Body    CreateLabel
+},
+) (*struct{ Body CreatedLabel }, error) {
+	labelID, err := project.NewLabelID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.app.Commands.CreateLabel.Handle(ctx, commands.CreateLabel{
+		LabelID: labelID,
+		BoardID: project.BoardID(input.BoardID),
+		Name:    input.Body.Name,
+		Color:   input.Body.Color,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedLabel }{
+		Body: CreatedLabel{ID: labelID},
+	}, nil
+}
+
+type ListLabels struct {
+	Labels []project.LabelSnapshot `json:"labels"`
+}
+
+func (h *Huma) getBoardLabels(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{ Body ListLabels }, error) {
+	labels, err := h.app.Queries.BoardLabels.Handle(ctx, queries.BoardLabels{
+		BoardID: project.BoardID(input.BoardID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]project.LabelSnapshot, len(labels))
+	for i, label := range labels {
+		dtos[i] = label.GetSnapshot()
+	}
+
+	return &struct{ Body ListLabels }{
+		Body: ListLabels{Labels: dtos},
+	}, nil
+}
+
+type CreateCustomField struct {
+	Name    string                  `json:"name"              doc:"Custom field's name"                  minLength:"1" maxLength:"30"`
+	Type    project.CustomFieldType `json:"type"              doc:"Type of the field's values"           enum:"text,number,date,select,user"`
+	Options []string                `json:"options,omitempty" doc:"Values a select field can take, only for select fields"`
+}
+
+type CreatedCustomField struct {
+	ID project.CustomFieldID `json:"id" doc:"The created custom field's ID"`
+}
+
+func (h *Huma) createCustomField(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Body    CreateCustomField
+},
+) (*struct{ Body CreatedCustomField }, error) {
+	fieldID, err := project.NewCustomFieldID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.app.Commands.CreateCustomField.Handle(ctx, commands.CreateCustomField{
+		FieldID: fieldID,
+		BoardID: project.BoardID(input.BoardID),
+		Name:    input.Body.Name,
+		Type:    input.Body.Type,
+		Options: input.Body.Options,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedCustomField }{
+		Body: CreatedCustomField{ID: fieldID},
+	}, nil
+}
+
+type ListCustomFields struct {
+	CustomFields []project.CustomFieldSnapshot `json:"custom_fields"`
+}
+
+func (h *Huma) getBoardCustomFields(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{ Body ListCustomFields }, error) {
+	fields, err := h.app.Queries.BoardCustomFields.Handle(ctx, queries.BoardCustomFields{
+		BoardID: project.BoardID(input.BoardID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]project.CustomFieldSnapshot, len(fields))
+	for i, field := range fields {
+		dtos[i] = field.GetSnapshot()
+	}
+
+	return &struct{ Body ListCustomFields }{
+		Body: ListCustomFields{CustomFields: dtos},
+	}, nil
+}
+
+type CreateMember struct {
+	DisplayName string `json:"display_name"    doc:"Member's name, tasks can be assigned by it"  minLength:"1" maxLength:"50"`
+	Email       string `json:"email,omitempty" doc:"Member's email (if any)"                                   format:"email"`
+	Role        string `json:"role"            doc:"Member's role, only admins can force WIP limits" enum:"admin,member"`
+}
+
+type CreatedMember struct {
+	ID project.MemberID `json:"id" doc:"The created member's ID"`
+}
+
+func (h *Huma) createMember(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Body    CreateMember
+},
+) (*struct{ Body CreatedMember }, error) {
+	memberID, err := project.NewMemberID()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := commands.CreateMember{
+		MemberID:    memberID,
+		BoardID:     project.BoardID(input.BoardID),
+		DisplayName: input.Body.DisplayName,
+		Role:        project.MemberRole(input.Body.Role),
+	}
+
+	if input.Body.Email != "" {
+		cmd.Email = &input.Body.Email
+	}
+
+	if err := h.app.Commands.CreateMember.Handle(ctx, cmd); err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedMember }{
+		Body: CreatedMember{ID: memberID},
+	}, nil
+}
+
+type ListMembers struct {
+	Members []project.MemberSnapshot `json:"members"`
+}
+
+func (h *Huma) getBoardMembers(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{ Body ListMembers }, error) {
+	members, err := h.app.Queries.BoardMembers.Handle(ctx, queries.BoardMembers{
+		BoardID: project.BoardID(input.BoardID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]project.MemberSnapshot, len(members))
+	for i, member := range members {
+		dtos[i] = member.GetSnapshot()
+	}
+
+	return &struct{ Body ListMembers }{
+		Body: ListMembers{Members: dtos},
+	}, nil
+}
+
+type Timesheet struct {
+	MemberID     project.MemberID          `json:"member_id"`
+	From         time.Time                 `json:"from"`
+	To           time.Time                 `json:"to"`
+	TotalMinutes int                       `json:"total_minutes"`
+	Days         []project.TimesheetDay    `json:"days"`
+	Worklogs     []project.WorklogSnapshot `json:"worklogs"`
+}
+
+func (h *Huma) getMemberTimesheet(ctx context.Context, input *struct {
+	BoardID string    `path:"boardId"`
+	Member  string    `path:"memberId" doc:"ID or display name of a board member"`
+	From    time.Time `query:"from"    doc:"First day of the timesheet, e.g. 2026-01-01" timeFormat:"2006-01-02" required:"true"`
+	To      time.Time `query:"to"      doc:"Last day of the timesheet, e.g. 2026-01-31"  timeFormat:"2006-01-02" required:"true"`
+},
+) (*struct{ Body Timesheet }, error) {
+	timesheet, err := h.app.Queries.MemberTimesheet.Handle(ctx, queries.MemberTimesheet{
+		BoardID: project.BoardID(input.BoardID),
+		Member:  input.Member,
+		From:    input.From,
+		To:      input.To,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	worklogs := make([]project.WorklogSnapshot, len(timesheet.Worklogs))
+	for i := range timesheet.Worklogs {
+		worklogs[i] = timesheet.Worklogs[i].GetSnapshot()
+	}
+
+	return &struct{ Body Timesheet }{
+		Body: Timesheet{
+			MemberID:     timesheet.MemberID,
+			From:         timesheet.From,
+			To:           timesheet.To,
+			TotalMinutes: timesheet.TotalMinutes(),
+			Days:         timesheet.Days,
+			Worklogs:     worklogs,
+		},
+	}, nil
+}
+
+type CreateSprint struct {
+	Name      string    `json:"name"            doc:"Sprint's name"                  minLength:"1" maxLength:"50"`
+	Goal      string    `json:"goal,omitempty"  doc:"What the sprint should achieve"`
+	StartDate time.Time `json:"start_date"      doc:"When the sprint starts"                                      format:"date-time"`
+	EndDate   time.Time `json:"end_date"        doc:"When the sprint ends"                                        format:"date-time"`
+}
+
+type CreatedSprint struct {
+	ID project.SprintID `json:"id" doc:"The created sprint's ID"`
+}
+
+func (h *Huma) createSprint(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Body    CreateSprint
+},
+) (*struct{ Body CreatedSprint }, error) {
+	sprintID, err := project.NewSprintID()
+	if err != nil {
+		return nil, err
+	}
+
+	var goal *string
+	if input.Body.Goal != "" {
+		goal = &input.Body.Goal
+	}
+
+	err = h.app.Commands.CreateSprint.Handle(ctx, commands.CreateSprint{
+		SprintID:  sprintID,
+		BoardID:   project.BoardID(input.BoardID),
+		Name:      input.Body.Name,
+		Goal:      goal,
+		StartDate: input.Body.StartDate,
+		EndDate:   input.Body.EndDate,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedSprint }{
+		Body: CreatedSprint{ID: sprintID},
+	}, nil
+}
+
+type ListSprints struct {
+	Sprints []project.SprintSnapshot `json:"sprints"`
+}
+
+func (h *Huma) getBoardSprints(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{ Body ListSprints }, error) {
+	sprints, err := h.app.Queries.BoardSprints.Handle(ctx, queries.BoardSprints{
+		BoardID: project.BoardID(input.BoardID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]project.SprintSnapshot, len(sprints))
+	for i := range sprints {
+		dtos[i] = *sprints[i].GetSnapshot()
+	}
+
+	return &struct{ Body ListSprints }{
+		Body: ListSprints{Sprints: dtos},
+	}, nil
+}
+
+type CreateRecurringTask struct {
+	Title       string    `json:"title"                  doc:"Title of the tasks created"                                                  minLength:"1" maxLength:"50"`
+	Description string    `json:"description,omitempty"  doc:"Description of the tasks created"`
+	Assignees   []string  `json:"assignees,omitempty"    doc:"IDs or display names of the board members the tasks are assigned to"`
+	Labels      []string  `json:"labels,omitempty"       doc:"IDs or names of the board's labels"`
+	Priority    string    `json:"priority,omitempty"     doc:"From p0 (most urgent) to p3"                                                                              enum:"p0,p1,p2,p3"`
+	StoryPoints *int      `json:"story_points,omitempty" doc:"Estimate of the tasks created"                                                                                               minimum:"0"`
+	Rule        string    `json:"rule"                   doc:"RRULE subset: FREQ=DAILY|WEEKLY|MONTHLY, INTERVAL, BYDAY, BYMONTHDAY, UNTIL" minLength:"1"                                               example:"FREQ=WEEKLY;BYDAY=MO"`
+	StartsAt    time.Time `json:"starts_at,omitempty"    doc:"When the first occurrence can be, defaults to now"                                                                                                                      format:"date-time"`
+}
+
+type CreatedRecurringTask struct {
+	ID project.RecurringTaskID `json:"id" doc:"The created recurring task's ID"`
+}
+
+func (h *Huma) createRecurringTask(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Body    CreateRecurringTask
+},
+) (*struct{ Body CreatedRecurringTask }, error) {
+	recurringID, err := project.NewRecurringTaskID()
+	if err != nil {
+		return nil, err
+	}
+
+	var startsAt *time.Time
+	if !input.Body.StartsAt.IsZero() {
+		startsAt = &input.Body.StartsAt
+	}
+
+	err = h.app.Commands.CreateRecurringTask.Handle(ctx, commands.CreateRecurringTask{
+		ID:          recurringID,
+		BoardID:     project.BoardID(input.BoardID),
+		Title:       input.Body.Title,
+		Description: lo.EmptyableToPtr(input.Body.Description),
+		Assignees:   input.Body.Assignees,
+		Labels:      input.Body.Labels,
+		Priority:    (*project.TaskPriority)(lo.EmptyableToPtr(input.Body.Priority)),
+		StoryPoints: input.Body.StoryPoints,
+		Rule:        input.Body.Rule,
+		StartsAt:    startsAt,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedRecurringTask }{
+		Body: CreatedRecurringTask{ID: recurringID},
+	}, nil
+}
+
+type ListRecurringTasks struct {
+	RecurringTasks []project.RecurringTaskSnapshot `json:"recurring_tasks"`
+}
+
+func (h *Huma) getBoardRecurringTasks(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{ Body ListRecurringTasks }, error) {
+	recurring, err := h.app.Queries.BoardRecurring.Handle(ctx, queries.BoardRecurringTasks{
+		BoardID: project.BoardID(input.BoardID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]project.RecurringTaskSnapshot, len(recurring))
+	for i := range recurring {
+		dtos[i] = *recurring[i].GetSnapshot()
+	}
+
+	return &struct{ Body ListRecurringTasks }{
+		Body: ListRecurringTasks{RecurringTasks: dtos},
+	}, nil
+}
+
+func (h *Huma) deleteRecurringTask(ctx context.Context, input *struct {
+	RecurringTaskID string `path:"recurringTaskId"`
+},
+) (*struct{}, error) {
+	err := h.app.Commands.DeleteRecurringTask.Handle(ctx, commands.DeleteRecurringTask{
+		ID: input.RecurringTaskID,
+	})
+
+	return nil, handleError(err)
+}
+
+type CreateTaskTemplate struct {
+	Title           string          `form:"title"            doc:"Title of the tasks created"                                 minLength:"1" maxLength:"50" required:"true"`
+	Description     string          `form:"description"      doc:"Description of the tasks created"`
+	Labels          []string        `form:"labels"           doc:"IDs or names of the board's labels"`
+	DefaultAssignee string          `form:"default_assignee" doc:"ID or display name of the board member the tasks are assigned to"`
+	Subtasks        []string        `form:"subtasks"         doc:"Titles of the subtasks created with each task"`
+	Files           []huma.FormFile `form:"files"`
+}
+
+type CreatedTaskTemplate struct {
+	ID project.TaskTemplateID `json:"id" doc:"The created template's ID"`
+}
+
+func (h *Huma) createTaskTemplate(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	RawBody huma.MultipartFormFiles[CreateTaskTemplate]
+},
+) (*struct{ Body CreatedTaskTemplate }, error) {
+	templateID, err := project.NewTaskTemplateID()
+	if err != nil {
+		return nil, err
+	}
+
+	data := input.RawBody.Data()
+
+	filesToUpload, err := h.prepareFilesForUpload(input.RawBody.Form.File)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	err = h.app.Commands.CreateTaskTemplate.Handle(ctx, commands.CreateTaskTemplate{
+		ID:              templateID,
+		BoardID:         project.BoardID(input.BoardID),
+		Title:           data.Title,
+		Description:     lo.EmptyableToPtr(data.Description),
+		Labels:          data.Labels,
+		DefaultAssignee: lo.EmptyableToPtr(data.DefaultAssignee),
+		Subtasks:        data.Subtasks,
+		Files:           filesToUpload,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedTaskTemplate }{
+		Body: CreatedTaskTemplate{ID: templateID},
+	}, nil
+}
+
+type ListTaskTemplates struct {
+	Templates []TaskTemplate `json:"templates"`
+}
+
+type TaskTemplate struct {
+	project.TaskTemplateSnapshot
+	Files []File `json:"files"`
+}
+
+func (h *Huma) getBoardTemplates(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{ Body ListTaskTemplates }, error) {
+	templates, err := h.app.Queries.BoardTemplates.Handle(ctx, queries.BoardTemplates{
+		BoardID: project.BoardID(input.BoardID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]TaskTemplate, len(templates))
+	for i := range templates {
+		snap := templates[i].GetSnapshot()
+		dtos[i] = TaskTemplate{
+			TaskTemplateSnapshot: *snap,
+			Files: lo.Map(snap.Files, func(f project.File, _ int) File {
+				return File{FileSnapshot: f.GetSnapshot()}
+			}),
+		}
+	}
+
+	return &struct{ Body ListTaskTemplates }{
+		Body: ListTaskTemplates{Templates: dtos},
+	}, nil
+}
+
+func (h *Huma) deleteTaskTemplate(ctx context.Context, input *struct {
+	TemplateID string `path:"templateId"`
+},
+) (*struct{}, error) {
+	err := h.app.Commands.DeleteTaskTemplate.Handle(ctx, commands.DeleteTaskTemplate{
+		ID: input.TemplateID,
+	})
+
+	return nil, handleError(err)
+}
+
+type CreateTaskFromTemplate struct {
+	DueDate time.Time `json:"due_date,omitempty" doc:"Task's due date (if any)" format:"date-time"`
+}
+
+type CreatedTask struct {
+	ID project.TaskID `json:"id" doc:"The created task's ID"`
+}
+
+func (h *Huma) createTaskFromTemplate(ctx context.Context, input *struct {
+	TemplateID string `path:"templateId"`
+	Body       CreateTaskFromTemplate
+},
+) (*struct{ Body CreatedTask }, error) {
+	taskID, err := project.NewTaskID()
+	if err != nil {
+		return nil, err
+	}
+
+	var dueDate *time.Time
+	if !input.Body.DueDate.IsZero() {
+		dueDate = &input.Body.DueDate
+	}
+
+	err = h.app.Commands.CreateTaskFromTemplate.Handle(ctx, commands.CreateTaskFromTemplate{
+		TaskID:     taskID,
+		TemplateID: input.TemplateID,
+		DueDate:    dueDate,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedTask }{
+		Body: CreatedTask{ID: taskID},
+	}, nil
+}
+
+type CurrentSprint struct {
+	Sprint project.SprintSnapshot `json:"sprint"`
+	Tasks  []Task                 `json:"tasks"`
+	Stats  project.SprintStats    `json:"stats"`
+}
+
+func (h *Huma) getCurrentSprint(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{ Body CurrentSprint }, error) {
+	current, err := h.app.Queries.CurrentSprint.Handle(ctx, queries.CurrentSprint{
+		BoardID: project.BoardID(input.BoardID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CurrentSprint }{
+		Body: CurrentSprint{
+			Sprint: *current.Sprint.GetSnapshot(),
+			Tasks:  listTasksFrom(current.Tasks).Tasks,
+			Stats:  current.Stats,
+		},
+	}, nil
+}
+
+func (h *Huma) startSprint(ctx context.Context, input *struct {
+	SprintID string `path:"sprintId"`
+},
+) (*struct{}, error) {
+	err := h.app.Commands.StartSprint.Handle(ctx, commands.StartSprint{
+		SprintID: input.SprintID,
+	})
+
+	return nil, handleError(err)
+}
+
+type CloseSprint struct {
+	RollOverTo string `json:"roll_over_to_sprint_id,omitempty" doc:"The sprint unfinished tasks move to, they go back to the backlog if empty"`
+}
+
+func (h *Huma) closeSprint(ctx context.Context, input *struct {
+	SprintID string `path:"sprintId"`
+	Body     CloseSprint
+},
+) (*struct{}, error) {
+	var rollOverTo *string
+	if input.Body.RollOverTo != "" {
+		rollOverTo = &input.Body.RollOverTo
+	}
+
+	err := h.app.Commands.CloseSprint.Handle(ctx, commands.CloseSprint{
+		SprintID:   input.SprintID,
+		RollOverTo: rollOverTo,
+	})
+
+	return nil, handleError(err)
+}
+
+type SprintTask struct {
+	TaskID string `json:"task_id" doc:"The task's ID" minLength:"1"`
+}
+
+func (h *Huma) addTaskToSprint(ctx context.Context, input *struct {
+	SprintID string `path:"sprintId"`
+	Body     SprintTask
+},
+) (*struct{}, error) {
+	err := h.app.Commands.AddTaskToSprint.Handle(ctx, commands.AddTaskToSprint{
+		SprintID: input.SprintID,
+		TaskID:   input.Body.TaskID,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) removeTaskFromSprint(ctx context.Context, input *struct {
+	SprintID string `path:"sprintId"`
+	Body     SprintTask
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RemoveFromSprint.Handle(ctx, commands.RemoveTaskFromSprint{
+		SprintID: input.SprintID,
+		TaskID:   input.Body.TaskID,
+	})
+
+	return nil, handleError(err)
+}
+
+type CreateEpic struct {
+	Name        string    `json:"name"                  doc:"Epic's name"                   minLength:"1" maxLength:"100"`
+	Description string    `json:"description,omitempty" doc:"What the epic delivers"`
+	TargetDate  time.Time `json:"target_date"           doc:"When the epic should be done"                                format:"date-time"`
+}
+
+type CreatedEpic struct {
+	ID project.EpicID `json:"id" doc:"The created epic's ID"`
+}
+
+func (h *Huma) createEpic(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Body    CreateEpic
+},
+) (*struct{ Body CreatedEpic }, error) {
+	epicID, err := project.NewEpicID()
+	if err != nil {
+		return nil, err
+	}
+
+	var description *string
+	if input.Body.Description != "" {
+		description = &input.Body.Description
+	}
+
+	err = h.app.Commands.CreateEpic.Handle(ctx, commands.CreateEpic{
+		EpicID:      epicID,
+		BoardID:     project.BoardID(input.BoardID),
+		Name:        input.Body.Name,
+		Description: description,
+		TargetDate:  input.Body.TargetDate,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body CreatedEpic }{
+		Body: CreatedEpic{ID: epicID},
+	}, nil
+}
+
+type ListEpics struct {
+	Epics []project.EpicSnapshot `json:"epics"`
+}
+
+func (h *Huma) getBoardEpics(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+},
+) (*struct{ Body ListEpics }, error) {
+	epics, err := h.app.Queries.BoardEpics.Handle(ctx, queries.BoardEpics{
+		BoardID: project.BoardID(input.BoardID),
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	dtos := make([]project.EpicSnapshot, len(epics))
+	for i := range epics {
+		dtos[i] = *epics[i].GetSnapshot()
+	}
+
+	return &struct{ Body ListEpics }{
+		Body: ListEpics{Epics: dtos},
+	}, nil
+}
+
+type EpicRollup struct {
+	Epic     project.EpicSnapshot `json:"epic"`
+	Tasks    []Task               `json:"tasks"`
+	Progress project.EpicProgress `json:"progress"`
+}
+
+func (h *Huma) getEpicProgress(ctx context.Context, input *struct {
+	BoardID string `path:"boardId"`
+	Epic    string `path:"epicId"  doc:"ID or name of the epic"`
+},
+) (*struct{ Body EpicRollup }, error) {
+	rollup, err := h.app.Queries.EpicProgress.Handle(ctx, queries.EpicProgress{
+		BoardID: project.BoardID(input.BoardID),
+		Epic:    input.Epic,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &struct{ Body EpicRollup }{
+		Body: EpicRollup{
+			Epic:     *rollup.Epic.GetSnapshot(),
+			Tasks:    listTasksFrom(rollup.Tasks).Tasks,
+			Progress: rollup.Progress,
+		},
+	}, nil
+}
+
+type EpicTask struct {
+	TaskID string `json:"task_id" doc:"The task's ID" minLength:"1"`
+}
+
+func (h *Huma) addTaskToEpic(ctx context.Context, input *struct {
+	EpicID string `path:"epicId"`
+	Body   EpicTask
+},
+) (*struct{}, error) {
+	err := h.app.Commands.AddTaskToEpic.Handle(ctx, commands.AddTaskToEpic{
+		EpicID: input.EpicID,
+		TaskID: input.Body.TaskID,
+	})
+
+	return nil, handleError(err)
+}
+
+func (h *Huma) removeTaskFromEpic(ctx context.Context, input *struct {
+	EpicID string `path:"epicId"`
+	Body   EpicTask
+},
+) (*struct{}, error) {
+	err := h.app.Commands.RemoveFromEpic.Handle(ctx, commands.RemoveTaskFromEpic{
+		EpicID: input.EpicID,
+		TaskID: input.Body.TaskID,
+	})
+
+	return nil, handleError(err)
 }
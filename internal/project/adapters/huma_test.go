@@ -0,0 +1,88 @@
+package adapters
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HandleError(t *testing.T) {
+	huma.NewError = newProblem
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"not found", fmt.Errorf("%w: 1", project.ErrTaskNotFound), http.StatusNotFound, "task_not_found"},
+		{"validation", project.ErrTitleTooLong, http.StatusUnprocessableEntity, "title_too_long"},
+		{"conflict", project.ErrWIPLimitExceeded, http.StatusConflict, "wip_limit_exceeded"},
+		{
+			"wip limit",
+			fmt.Errorf("update function: %w", &project.WIPLimitError{Status: project.TaskStatusInProgress, Limit: 2}),
+			http.StatusConflict,
+			"wip_limit_exceeded",
+		},
+		{
+			"transition",
+			fmt.Errorf("update function: %w", &project.TransitionError{
+				From: project.TaskStatusPending,
+				To:   project.TaskStatusCompleted,
+			}),
+			http.StatusConflict,
+			"transition_not_allowed",
+		},
+		{"outdated version", project.ErrTaskVersionMismatch, http.StatusPreconditionFailed, "task_version_mismatch"},
+		{"forbidden", project.ErrNotCommentAuthor, http.StatusForbidden, "not_comment_author"},
+		{"unavailable", project.ErrStorageUnavailable, http.StatusServiceUnavailable, "storage_unavailable"},
+		{"internal", errors.New("boom"), http.StatusInternalServerError, "internal_server_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var problem *Problem
+			require.True(t, errors.As(handleError(tt.err), &problem))
+			assert.Equal(t, tt.status, problem.Status)
+			assert.Equal(t, tt.code, problem.Code)
+			assert.Equal(t, "application/problem+json", problem.ContentType("application/json"))
+		})
+	}
+}
+
+func Test_ParseIfMatch(t *testing.T) {
+	huma.NewError = newProblem
+
+	t.Run("reads the version of the ETag", func(t *testing.T) {
+		version, err := parseIfMatch(taskETag(3))
+		require.NoError(t, err)
+		assert.Equal(t, lo.ToPtr(3), version)
+	})
+
+	t.Run("matches any version", func(t *testing.T) {
+		version, err := parseIfMatch("*")
+		require.NoError(t, err)
+		assert.Nil(t, version)
+	})
+
+	t.Run("is required", func(t *testing.T) {
+		var problem *Problem
+		_, err := parseIfMatch("")
+		require.True(t, errors.As(err, &problem))
+		assert.Equal(t, http.StatusPreconditionRequired, problem.Status)
+	})
+
+	t.Run("never matches weak ETags", func(t *testing.T) {
+		var problem *Problem
+		_, err := parseIfMatch(`W/"3"`)
+		require.True(t, errors.As(err, &problem))
+		assert.Equal(t, http.StatusPreconditionFailed, problem.Status)
+	})
+}
@@ -206,9 +206,13 @@ func convertChatTool(tool project.ChatTool) *openai.ChatCompletionToolParam {
 	}
 	for _, param := range tool.GetToolParams() {
 		props := funcParams["properties"].(map[string]any)
-		props[param.Name] = map[string]string{
+		schema := map[string]any{
 			"type": param.ParamType,
 		}
+		if param.ItemsType != "" {
+			schema["items"] = map[string]string{"type": param.ItemsType}
+		}
+		props[param.Name] = schema
 
 		if param.Required {
 			req := funcParams["required"].([]string)
@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+func (r *PostgresTaskRepository) TaskHistory(
+	ctx context.Context,
+	id project.TaskID,
+	since *time.Time,
+) ([]project.Activity, error) {
+	query := r.client.Activity.Query().
+		Where(activity.TaskIDEQ(string(id)))
+
+	if since != nil {
+		query.Where(activity.ChangedAtGTE(*since))
+	}
+
+	entActivities, err := query.
+		Order(ent.Asc(activity.FieldChangedAt), ent.Asc(activity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query task history: %w", err)
+	}
+
+	activities := make([]project.Activity, len(entActivities))
+	for i, a := range entActivities {
+		activities[i] = project.UnmarshalActivityFromDB(a)
+	}
+
+	return activities, nil
+}
+
+// saveActivities appends to the history of tasks, in the transaction that made the changes
+func saveActivities(ctx context.Context, tx *ent.Tx, activities []project.Activity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.ActivityCreate, len(activities))
+	for i, a := range activities {
+		snap := a.GetSnapshot()
+		builders[i] = tx.Activity.Create().
+			SetID(string(snap.ID)).
+			SetTaskID(string(snap.TaskID)).
+			SetActor(snap.Actor).
+			SetSource(activity.Source(snap.Source)).
+			SetField(string(snap.Field)).
+			SetNillableFromValue(snap.From).
+			SetNillableToValue(snap.To).
+			SetChangedAt(snap.ChangedAt)
+	}
+
+	if _, err := tx.Activity.CreateBulk(builders...).Save(ctx); err != nil {
+		return fmt.Errorf("save activities: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoTaskHistory(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	repo, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	task := createTaskWithID(t, "tracked task", nil, nil, nil)
+	apiCtx := project.WithActor(ctx, project.Actor{Name: "John", Source: project.ChangeSourceAPI})
+	require.NoError(t, repo.Create(apiCtx, task))
+	taskID := task.GetSnapshot().ID
+
+	createdAt := time.Now()
+
+	assistantCtx := project.WithActor(
+		ctx,
+		project.Actor{Name: "Mary", Source: project.ChangeSourceAssistant},
+	)
+	err = repo.UpdateTask(
+		assistantCtx,
+		taskID,
+		func(t *project.Task) (*project.Task, error) {
+			return t, t.ChangeStatus(project.DefaultWorkflow(), project.TaskStatusInProgress)
+		},
+	)
+	require.NoError(t, err)
+
+	t.Run("records every change", func(t *testing.T) {
+		history, err := repo.TaskHistory(ctx, taskID, nil)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+
+		created := history[0].GetSnapshot()
+		require.Equal(t, project.TaskFieldCreated, created.Field)
+		require.Equal(t, "John", created.Actor)
+		require.Equal(t, project.ChangeSourceAPI, created.Source)
+
+		statusChange := history[1].GetSnapshot()
+		require.Equal(t, project.TaskFieldStatus, statusChange.Field)
+		require.Equal(t, string(project.TaskStatusPending), *statusChange.From)
+		require.Equal(t, string(project.TaskStatusInProgress), *statusChange.To)
+		require.Equal(t, "Mary", statusChange.Actor)
+		require.Equal(t, project.ChangeSourceAssistant, statusChange.Source)
+	})
+
+	t.Run("history since a time", func(t *testing.T) {
+		history, err := repo.TaskHistory(ctx, taskID, &createdAt)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		require.Equal(t, project.TaskFieldStatus, history[0].GetSnapshot().Field)
+	})
+}
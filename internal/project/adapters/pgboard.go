@@ -0,0 +1,205 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type PostgresBoardRepository struct {
+	client *ent.Client
+}
+
+var _ project.BoardRepository = &PostgresBoardRepository{}
+
+func NewPostgresBoardRepository(client *ent.Client) (*PostgresBoardRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresBoardRepository{client: client}, nil
+}
+
+// EnsureDefaultBoard creates the default board if it's missing and moves
+// the tasks that were created before boards existed onto it
+func (r *PostgresBoardRepository) EnsureDefaultBoard(ctx context.Context) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		exists, err := tx.Board.Query().
+			Where(board.IDEQ(string(project.DefaultBoardID))).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("query default board: %w", err)
+		}
+
+		if !exists {
+			defaultBoard, err := project.NewBoard(project.DefaultBoardID, "Default")
+			if err != nil {
+				return fmt.Errorf("new default board: %w", err)
+			}
+
+			if err := createBoard(ctx, tx.Client(), defaultBoard); err != nil {
+				return fmt.Errorf("create default board: %w", err)
+			}
+		}
+
+		_, err = tx.Task.Update().
+			Where(task.BoardIDIsNil()).
+			SetBoardID(string(project.DefaultBoardID)).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("backfill tasks board: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *PostgresBoardRepository) Create(ctx context.Context, b *project.Board) error {
+	return createBoard(ctx, r.client, b)
+}
+
+func createBoard(ctx context.Context, client *ent.Client, b *project.Board) error {
+	snap := b.GetSnapshot()
+	statuses, transitions := marshalWorkflow(snap.Workflow)
+	wipLimits, assigneeWIPLimits := marshalWIPLimits(snap.WIPLimits)
+
+	_, err := client.Board.Create().
+		SetID(string(snap.ID)).
+		SetName(snap.Name).
+		SetStatuses(statuses).
+		SetTransitions(transitions).
+		SetWipLimits(wipLimits).
+		SetAssigneeWipLimits(assigneeWIPLimits).
+		SetCreatedAt(snap.CreatedAt).
+		SetUpdatedAt(snap.UpdatedAt).
+		SetNillableArchivedAt(snap.ArchivedAt).
+		Save(ctx)
+
+	return err
+}
+
+func (r *PostgresBoardRepository) GetByID(
+	ctx context.Context,
+	id project.BoardID,
+) (*project.Board, error) {
+	b, err := r.client.Board.Query().
+		Where(board.IDEQ(string(id))).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", project.ErrBoardNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return project.UnmarshalBoardFromDB(b)
+}
+
+func (r *PostgresBoardRepository) UpdateBoard(
+	ctx context.Context,
+	id project.BoardID,
+	updateFn func(b *project.Board) (*project.Board, error),
+) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		// The board's tasks can't be updated until the board is saved
+		existingBoard, err := tx.Board.Query().
+			Where(board.IDEQ(string(id)), forUpdate).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", project.ErrBoardNotFound, id)
+		}
+		if err != nil {
+			return fmt.Errorf("query board: %w", err)
+		}
+
+		domainBoard, err := project.UnmarshalBoardFromDB(existingBoard)
+		if err != nil {
+			return fmt.Errorf("convert to domain model: %w", err)
+		}
+
+		updatedBoard, err := updateFn(domainBoard)
+		if err != nil {
+			return fmt.Errorf("update function: %w", err)
+		}
+
+		snap := updatedBoard.GetSnapshot()
+		statuses, transitions := marshalWorkflow(snap.Workflow)
+		wipLimits, assigneeWIPLimits := marshalWIPLimits(snap.WIPLimits)
+
+		_, err = tx.Board.UpdateOneID(string(id)).
+			SetName(snap.Name).
+			SetStatuses(statuses).
+			SetTransitions(transitions).
+			SetWipLimits(wipLimits).
+			SetAssigneeWipLimits(assigneeWIPLimits).
+			SetNillableArchivedAt(snap.ArchivedAt).
+			SetUpdatedAt(snap.UpdatedAt).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("save board: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *PostgresBoardRepository) AllBoards(ctx context.Context) ([]project.Board, error) {
+	entBoards, err := r.client.Board.Query().
+		Order(ent.Asc(board.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query boards: %w", err)
+	}
+
+	boards := make([]project.Board, 0, len(entBoards))
+	for _, entBoard := range entBoards {
+		domainBoard, err := project.UnmarshalBoardFromDB(entBoard)
+		if err != nil {
+			return nil, fmt.Errorf("convert board %s: %w", entBoard.ID, err)
+		}
+		boards = append(boards, *domainBoard)
+	}
+
+	return boards, nil
+}
+
+func marshalWorkflow(w project.WorkflowSnapshot) ([]string, map[string][]string) {
+	statuses := make([]string, len(w.Statuses))
+	for i, status := range w.Statuses {
+		statuses[i] = string(status)
+	}
+
+	transitions := make(map[string][]string, len(w.Transitions))
+	for from, targets := range w.Transitions {
+		// Keep statuses without targets, they can't move anywhere
+		transitions[string(from)] = make([]string, len(targets))
+		for i, to := range targets {
+			transitions[string(from)][i] = string(to)
+		}
+	}
+
+	return statuses, transitions
+}
+
+func marshalWIPLimits(limits map[project.TaskStatus]project.WIPLimit) (
+	wipLimits map[string]int,
+	assigneeWIPLimits map[string]int,
+) {
+	wipLimits = make(map[string]int, len(limits))
+	assigneeWIPLimits = make(map[string]int, len(limits))
+	for status, limit := range limits {
+		if limit.Board > 0 {
+			wipLimits[string(status)] = limit.Board
+		}
+		if limit.PerAssignee > 0 {
+			assigneeWIPLimits[string(status)] = limit.PerAssignee
+		}
+	}
+
+	return wipLimits, assigneeWIPLimits
+}
@@ -0,0 +1,164 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPostgresBoardRepo(
+	ctx context.Context,
+	t *testing.T,
+) (boards *PostgresBoardRepository, tasks *PostgresTaskRepository, cleanup func()) {
+	db, cleanup := setupPostgres(ctx, t)
+
+	boards, err := NewPostgresBoardRepository(db)
+	require.NoError(t, err)
+
+	tasks, err = NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	return boards, tasks, cleanup
+}
+
+func Test_RepoBoards(t *testing.T) {
+	ctx := context.Background()
+	boards, tasks, cleanup := setupPostgresBoardRepo(ctx, t)
+	defer cleanup()
+
+	t.Run("default board exists", func(t *testing.T) {
+		board, err := boards.GetByID(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.False(t, board.IsArchived())
+	})
+
+	t.Run("tasks are scoped to their board", func(t *testing.T) {
+		boardID, err := project.NewBoardID()
+		require.NoError(t, err)
+
+		board, err := project.NewBoard(boardID, "Mobile app")
+		require.NoError(t, err)
+		require.NoError(t, boards.Create(ctx, board))
+
+		taskID, err := project.NewTaskID()
+		require.NoError(t, err)
+		task, err := project.NewTask(taskID, boardID, "board task", nil, nil, nil, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, tasks.Create(ctx, task))
+
+		otherTask := createTaskWithID(t, "default board task", nil, nil, nil)
+		require.NoError(t, tasks.Create(ctx, otherTask))
+
+		boardTasks, err := tasks.BoardTasks(ctx, boardID)
+		require.NoError(t, err)
+		require.Len(t, boardTasks, 1)
+		require.Equal(t, taskID, boardTasks[0].GetSnapshot().ID)
+
+		_, err = tasks.BoardTasks(ctx, "unknown-board")
+		require.ErrorIs(t, err, project.ErrBoardNotFound)
+	})
+
+	t.Run("renames and archives a board", func(t *testing.T) {
+		boardID, err := project.NewBoardID()
+		require.NoError(t, err)
+
+		board, err := project.NewBoard(boardID, "Platform")
+		require.NoError(t, err)
+		require.NoError(t, boards.Create(ctx, board))
+
+		err = boards.UpdateBoard(ctx, boardID, func(b *project.Board) (*project.Board, error) {
+			if err := b.Rename("Platform team"); err != nil {
+				return nil, err
+			}
+			if err := b.Archive(); err != nil {
+				return nil, err
+			}
+			return b, nil
+		})
+		require.NoError(t, err)
+
+		updated, err := boards.GetByID(ctx, boardID)
+		require.NoError(t, err)
+		require.Equal(t, "Platform team", updated.GetSnapshot().Name)
+		require.True(t, updated.IsArchived())
+	})
+
+	t.Run("persists the board's workflow", func(t *testing.T) {
+		boardID, err := project.NewBoardID()
+		require.NoError(t, err)
+
+		board, err := project.NewBoard(boardID, "Workflow")
+		require.NoError(t, err)
+		require.NoError(t, boards.Create(ctx, board))
+
+		workflow, err := project.NewWorkflow(
+			[]project.TaskStatus{"pending", "in_progress", "qa", "completed"},
+			map[project.TaskStatus][]project.TaskStatus{
+				"qa":        {"completed", "in_progress"},
+				"completed": {},
+			},
+		)
+		require.NoError(t, err)
+
+		err = boards.UpdateBoard(ctx, boardID, func(b *project.Board) (*project.Board, error) {
+			if err := b.ChangeWorkflow(workflow, nil); err != nil {
+				return nil, err
+			}
+			return b, nil
+		})
+		require.NoError(t, err)
+
+		updated, err := boards.GetByID(ctx, boardID)
+		require.NoError(t, err)
+		require.Equal(t, workflow.GetSnapshot(), updated.Workflow().GetSnapshot())
+	})
+
+	t.Run("persists wip limits", func(t *testing.T) {
+		boardID, err := project.NewBoardID()
+		require.NoError(t, err)
+
+		board, err := project.NewBoard(boardID, "WIP")
+		require.NoError(t, err)
+		require.NoError(t, boards.Create(ctx, board))
+
+		limit := project.WIPLimit{Board: 4, PerAssignee: 2}
+		err = boards.UpdateBoard(ctx, boardID, func(b *project.Board) (*project.Board, error) {
+			if err := b.SetWIPLimit(project.TaskStatusInProgress, limit); err != nil {
+				return nil, err
+			}
+			return b, nil
+		})
+		require.NoError(t, err)
+
+		updated, err := boards.GetByID(ctx, boardID)
+		require.NoError(t, err)
+		require.Equal(t, limit, updated.WIPLimit(project.TaskStatusInProgress))
+	})
+
+	t.Run("tasks wait for the update of their board", func(t *testing.T) {
+		task := createTaskWithID(t, "moved while the workflow changes", nil, nil, nil)
+		require.NoError(t, tasks.Create(ctx, task))
+
+		moved := make(chan error, 1)
+		err := boards.UpdateBoard(ctx, project.DefaultBoardID, func(b *project.Board) (*project.Board, error) {
+			go func() {
+				moved <- tasks.UpdateTask(ctx, task.GetSnapshot().ID, func(t *project.Task) (*project.Task, error) {
+					return t, t.ChangeStatus(project.DefaultWorkflow(), project.TaskStatusInProgress)
+				})
+			}()
+
+			select {
+			case err := <-moved:
+				return nil, fmt.Errorf("task updated before the board was saved: %v", err)
+			case <-time.After(200 * time.Millisecond):
+				return b, nil
+			}
+		})
+		require.NoError(t, err)
+		require.NoError(t, <-moved)
+	})
+}
@@ -0,0 +1,120 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/comment"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type PostgresCommentRepository struct {
+	client *ent.Client
+}
+
+var _ project.CommentRepository = &PostgresCommentRepository{}
+
+func NewPostgresCommentRepository(client *ent.Client) (*PostgresCommentRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresCommentRepository{client: client}, nil
+}
+
+func (r *PostgresCommentRepository) Create(ctx context.Context, c *project.Comment) error {
+	snap := c.GetSnapshot()
+
+	create := r.client.Comment.Create().
+		SetID(string(snap.ID)).
+		SetTaskID(string(snap.TaskID)).
+		SetAuthor(snap.Author).
+		SetBody(snap.Body).
+		SetMentions(snap.Mentions).
+		SetCreatedAt(snap.CreatedAt).
+		SetUpdatedAt(snap.UpdatedAt)
+
+	if snap.ParentID != nil {
+		create.SetParentID(string(*snap.ParentID))
+	}
+
+	_, err := create.Save(ctx)
+	return err
+}
+
+func (r *PostgresCommentRepository) GetByID(
+	ctx context.Context,
+	id project.CommentID,
+) (*project.Comment, error) {
+	c, err := r.client.Comment.Query().
+		Where(comment.IDEQ(string(id))).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", project.ErrCommentNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return project.UnmarshalCommentFromDB(c), nil
+}
+
+func (r *PostgresCommentRepository) UpdateComment(
+	ctx context.Context,
+	id project.CommentID,
+	updateFn func(c *project.Comment) (*project.Comment, error),
+) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		existingComment, err := tx.Comment.Query().
+			Where(comment.IDEQ(string(id))).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", project.ErrCommentNotFound, id)
+		}
+		if err != nil {
+			return fmt.Errorf("query comment: %w", err)
+		}
+
+		updatedComment, err := updateFn(project.UnmarshalCommentFromDB(existingComment))
+		if err != nil {
+			return fmt.Errorf("update function: %w", err)
+		}
+
+		snap := updatedComment.GetSnapshot()
+
+		_, err = tx.Comment.UpdateOneID(string(id)).
+			SetBody(snap.Body).
+			SetMentions(snap.Mentions).
+			SetUpdatedAt(snap.UpdatedAt).
+			SetNillableEditedAt(snap.EditedAt).
+			SetNillableDeletedAt(snap.DeletedAt).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("save comment: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *PostgresCommentRepository) TaskComments(
+	ctx context.Context,
+	taskID project.TaskID,
+) ([]project.Comment, error) {
+	entComments, err := r.client.Comment.Query().
+		Where(comment.TaskIDEQ(string(taskID))).
+		Order(ent.Asc(comment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query task comments: %w", err)
+	}
+
+	comments := make([]project.Comment, len(entComments))
+	for i, c := range entComments {
+		comments[i] = *project.UnmarshalCommentFromDB(c)
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,78 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoComments(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	comments, err := NewPostgresCommentRepository(db)
+	require.NoError(t, err)
+
+	tasks, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	task := createTaskWithID(t, "discussed task", nil, nil, nil)
+	require.NoError(t, tasks.Create(ctx, task))
+	taskID := task.GetSnapshot().ID
+
+	newComment := func(t *testing.T, parent *project.Comment, body string) *project.Comment {
+		id, err := project.NewCommentID()
+		require.NoError(t, err)
+		comment, err := project.NewComment(id, taskID, parent, "John", body, []string{"Mary"})
+		require.NoError(t, err)
+		require.NoError(t, comments.Create(ctx, comment))
+		return comment
+	}
+
+	question := newComment(t, nil, "@Mary should this use **markdown**?")
+	answer := newComment(t, question, "Yes")
+
+	t.Run("lists the comments of a task", func(t *testing.T) {
+		taskComments, err := comments.TaskComments(ctx, taskID)
+		require.NoError(t, err)
+		require.Len(t, taskComments, 2)
+
+		snap := taskComments[0].GetSnapshot()
+		require.Equal(t, question.ID(), snap.ID)
+		require.Equal(t, []string{"Mary"}, snap.Mentions)
+		require.Equal(t, question.ID(), *taskComments[1].GetSnapshot().ParentID)
+	})
+
+	t.Run("persists edits and deletes", func(t *testing.T) {
+		err := comments.UpdateComment(
+			ctx,
+			answer.ID(),
+			func(c *project.Comment) (*project.Comment, error) {
+				return c, c.Edit("John", "Yes, ask @Mary", []string{"Mary"})
+			},
+		)
+		require.NoError(t, err)
+
+		commentFromDB, err := comments.GetByID(ctx, answer.ID())
+		require.NoError(t, err)
+		require.Equal(t, "Yes, ask @Mary", commentFromDB.GetSnapshot().Body)
+		require.NotNil(t, commentFromDB.GetSnapshot().EditedAt)
+
+		err = comments.UpdateComment(
+			ctx,
+			question.ID(),
+			func(c *project.Comment) (*project.Comment, error) {
+				return c, c.Delete("John")
+			},
+		)
+		require.NoError(t, err)
+
+		commentFromDB, err = comments.GetByID(ctx, question.ID())
+		require.NoError(t, err)
+		require.True(t, commentFromDB.IsDeleted())
+		require.Empty(t, commentFromDB.GetSnapshot().Mentions)
+	})
+}
@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type PostgresCustomFieldRepository struct {
+	client *ent.Client
+}
+
+var _ project.CustomFieldRepository = &PostgresCustomFieldRepository{}
+
+func NewPostgresCustomFieldRepository(client *ent.Client) (*PostgresCustomFieldRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresCustomFieldRepository{client: client}, nil
+}
+
+func (r *PostgresCustomFieldRepository) Create(
+	ctx context.Context,
+	f *project.CustomField,
+) error {
+	snap := f.GetSnapshot()
+
+	_, err := r.client.CustomField.Create().
+		SetID(string(snap.ID)).
+		SetBoardID(string(snap.BoardID)).
+		SetName(snap.Name).
+		SetType(customfield.Type(snap.Type)).
+		SetOptions(snap.Options).
+		SetCreatedAt(snap.CreatedAt).
+		Save(ctx)
+
+	return err
+}
+
+func (r *PostgresCustomFieldRepository) GetByID(
+	ctx context.Context,
+	id project.CustomFieldID,
+) (*project.CustomField, error) {
+	f, err := r.client.CustomField.Query().
+		Where(customfield.IDEQ(string(id))).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", project.ErrCustomFieldNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return project.UnmarshalCustomFieldFromDB(f), nil
+}
+
+func (r *PostgresCustomFieldRepository) BoardCustomFields(
+	ctx context.Context,
+	boardID project.BoardID,
+) ([]project.CustomField, error) {
+	entFields, err := r.client.CustomField.Query().
+		Where(customfield.BoardIDEQ(string(boardID))).
+		Order(ent.Asc(customfield.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query board custom fields: %w", err)
+	}
+
+	fields := make([]project.CustomField, len(entFields))
+	for i, f := range entFields {
+		fields[i] = *project.UnmarshalCustomFieldFromDB(f)
+	}
+
+	return fields, nil
+}
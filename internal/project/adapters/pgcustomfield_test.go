@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoCustomFields(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	customFields, err := NewPostgresCustomFieldRepository(db)
+	require.NoError(t, err)
+
+	tasks, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	newField := func(
+		t *testing.T,
+		name string,
+		fieldType project.CustomFieldType,
+		options []string,
+	) *project.CustomField {
+		id, err := project.NewCustomFieldID()
+		require.NoError(t, err)
+		field, err := project.NewCustomField(id, project.DefaultBoardID, name, fieldType, options)
+		require.NoError(t, err)
+		require.NoError(t, customFields.Create(ctx, field))
+		return field
+	}
+
+	environment := newField(
+		t,
+		"environment",
+		project.CustomFieldTypeSelect,
+		[]string{"staging", "production"},
+	)
+	customer := newField(t, "customer", project.CustomFieldTypeText, nil)
+
+	t.Run("lists the board's custom fields", func(t *testing.T) {
+		boardFields, err := customFields.BoardCustomFields(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Len(t, boardFields, 2)
+		require.Equal(t, "customer", boardFields[0].GetSnapshot().Name)
+		require.Equal(t, []string{"staging", "production"}, boardFields[1].GetSnapshot().Options)
+	})
+
+	t.Run("persists the custom field values of a task", func(t *testing.T) {
+		task := createTaskWithID(t, "task with custom fields", nil, nil, nil)
+		require.NoError(t, task.SetCustomFieldValue(*customer, lo.ToPtr("Acme"), nil))
+		require.NoError(t, tasks.Create(ctx, task))
+		taskID := task.GetSnapshot().ID
+
+		err := tasks.UpdateTask(ctx, taskID, func(t *project.Task) (*project.Task, error) {
+			if err := t.SetCustomFieldValue(*customer, nil, nil); err != nil {
+				return nil, err
+			}
+			return t, t.SetCustomFieldValue(*environment, lo.ToPtr("Production"), nil)
+		})
+		require.NoError(t, err)
+
+		taskFromDB, err := tasks.GetByID(ctx, taskID)
+		require.NoError(t, err)
+		require.Equal(t, []project.CustomFieldValueSnapshot{{
+			FieldID: environment.ID(),
+			Name:    "environment",
+			Type:    project.CustomFieldTypeSelect,
+			Value:   "production",
+		}}, taskFromDB.GetSnapshot().CustomFields)
+
+		require.NoError(t, tasks.Delete(ctx, taskID))
+		require.Zero(t, db.CustomFieldValue.Query().CountX(ctx))
+	})
+}
@@ -0,0 +1,81 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/epic"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type PostgresEpicRepository struct {
+	client *ent.Client
+}
+
+var _ project.EpicRepository = &PostgresEpicRepository{}
+
+func NewPostgresEpicRepository(client *ent.Client) (*PostgresEpicRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresEpicRepository{client: client}, nil
+}
+
+func (r *PostgresEpicRepository) Create(ctx context.Context, e *project.Epic) error {
+	snap := e.GetSnapshot()
+
+	_, err := r.client.Epic.Create().
+		SetID(string(snap.ID)).
+		SetBoardID(string(snap.BoardID)).
+		SetName(snap.Name).
+		SetNillableDescription(snap.Description).
+		SetTargetDate(snap.TargetDate).
+		SetCreatedAt(snap.CreatedAt).
+		Save(ctx)
+
+	return err
+}
+
+func (r *PostgresEpicRepository) GetByID(
+	ctx context.Context,
+	id project.EpicID,
+) (*project.Epic, error) {
+	e, err := r.client.Epic.Query().
+		Where(epic.IDEQ(string(id))).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", project.ErrEpicNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return project.UnmarshalEpicFromDB(e)
+}
+
+func (r *PostgresEpicRepository) BoardEpics(
+	ctx context.Context,
+	boardID project.BoardID,
+) ([]project.Epic, error) {
+	entEpics, err := r.client.Epic.Query().
+		Where(epic.BoardIDEQ(string(boardID))).
+		Order(ent.Asc(epic.FieldTargetDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query board epics: %w", err)
+	}
+
+	epics := make([]project.Epic, 0, len(entEpics))
+	for _, entEpic := range entEpics {
+		domainEpic, err := project.UnmarshalEpicFromDB(entEpic)
+		if err != nil {
+			return nil, fmt.Errorf("convert epic %s: %w", entEpic.ID, err)
+		}
+		epics = append(epics, *domainEpic)
+	}
+
+	return epics, nil
+}
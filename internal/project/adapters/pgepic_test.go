@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoEpics(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	epics, err := NewPostgresEpicRepository(db)
+	require.NoError(t, err)
+
+	tasks, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	newEpic := func(t *testing.T, name string, targetDate time.Time) *project.Epic {
+		id, err := project.NewEpicID()
+		require.NoError(t, err)
+		description := "Ship " + name
+		epic, err := project.NewEpic(id, project.DefaultBoardID, name, &description, targetDate)
+		require.NoError(t, err)
+		require.NoError(t, epics.Create(ctx, epic))
+		return epic
+	}
+
+	now := time.Now()
+	later := newEpic(t, "Biometric login GA", now.AddDate(0, 6, 0))
+	sooner := newEpic(t, "KYC", now.AddDate(0, 3, 0))
+
+	t.Run("lists the board's epics by target date", func(t *testing.T) {
+		boardEpics, err := epics.BoardEpics(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Len(t, boardEpics, 2)
+		require.Equal(t, sooner.ID(), boardEpics[0].ID())
+		require.Equal(t, later.ID(), boardEpics[1].ID())
+	})
+
+	t.Run("persists the task's epic", func(t *testing.T) {
+		task := createTaskWithID(t, "verify documents", nil, nil, nil)
+		require.NoError(t, tasks.Create(ctx, task))
+		taskID := task.GetSnapshot().ID
+
+		err := tasks.UpdateTask(ctx, taskID, func(t *project.Task) (*project.Task, error) {
+			return t, t.AddToEpic(sooner)
+		})
+		require.NoError(t, err)
+
+		fromDB, err := tasks.GetByID(ctx, taskID)
+		require.NoError(t, err)
+		require.Equal(t, sooner.ID(), *fromDB.EpicID())
+
+		err = tasks.UpdateTask(ctx, taskID, func(t *project.Task) (*project.Task, error) {
+			return t, t.RemoveFromEpic(sooner.ID())
+		})
+		require.NoError(t, err)
+
+		fromDB, err = tasks.GetByID(ctx, taskID)
+		require.NoError(t, err)
+		require.Nil(t, fromDB.EpicID())
+	})
+
+	t.Run("counts the overdue tasks of the epic", func(t *testing.T) {
+		tomorrow := now.AddDate(0, 0, 1)
+		task := createTaskWithID(t, "screen sanctions", nil, &tomorrow, nil)
+		require.NoError(t, task.AddToEpic(later))
+		require.NoError(t, tasks.Create(ctx, task))
+		taskID := task.GetSnapshot().ID
+
+		// The task was due yesterday, which it can't be created with
+		err := db.Task.UpdateOneID(string(taskID)).SetDueDate(now.AddDate(0, 0, -1)).Exec(ctx)
+		require.NoError(t, err)
+
+		_, err = tasks.GetByID(ctx, taskID)
+		require.NoError(t, err)
+
+		boardTasks, err := tasks.BoardTasks(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+
+		epicTasks := project.EpicTasks(boardTasks, later.ID())
+		require.Len(t, epicTasks, 1)
+		require.Equal(t, 1, project.NewEpicProgress(later, epicTasks, time.Now()).OverdueTasks)
+	})
+}
@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ReportUnavailableStorage makes the queries and mutations of the client fail with
+// project.ErrStorageUnavailable when the database can't be reached, so that it isn't
+// mistaken for a failure of the request itself
+func ReportUnavailableStorage(client *ent.Client) {
+	client.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			return v, storageError(err)
+		})
+	})
+
+	client.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			v, err := next.Query(ctx, q)
+			return v, storageError(err)
+		})
+	}))
+}
+
+func storageError(err error) error {
+	if err == nil || errors.Is(err, project.ErrStorageUnavailable) || !isUnavailable(err) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", project.ErrStorageUnavailable, err)
+}
+
+// Postgres error codes of a lost connection or of a server that's shutting down or starting up
+var unavailableCodes = []string{
+	"08000", "08001", "08003", "08004", "08006",
+	"57P01", "57P02", "57P03",
+}
+
+func isUnavailable(err error) bool {
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	var pgErr *pgconn.PgError
+
+	switch {
+	case errors.As(err, &connectErr), errors.As(err, &netErr):
+		return true
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone):
+		return true
+	case errors.As(err, &pgErr):
+		return slices.Contains(unavailableCodes, pgErr.Code)
+	}
+
+	return false
+}
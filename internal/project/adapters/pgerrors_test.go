@@ -0,0 +1,33 @@
+package adapters
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StorageError(t *testing.T) {
+	t.Run("reports lost connections as unavailable storage", func(t *testing.T) {
+		for _, err := range []error{
+			driver.ErrBadConn,
+			&net.OpError{Op: "dial", Err: errors.New("connection refused")},
+			&pgconn.PgError{Code: "57P01"},
+		} {
+			wrapped := storageError(fmt.Errorf("query task: %w", err))
+			assert.ErrorIs(t, wrapped, project.ErrStorageUnavailable)
+			assert.ErrorIs(t, wrapped, err)
+		}
+	})
+
+	t.Run("leaves the other errors alone", func(t *testing.T) {
+		err := &pgconn.PgError{Code: "23505"}
+		assert.Equal(t, err, storageError(err))
+		assert.NoError(t, storageError(nil))
+	})
+}
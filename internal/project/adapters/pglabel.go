@@ -0,0 +1,76 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type PostgresLabelRepository struct {
+	client *ent.Client
+}
+
+var _ project.LabelRepository = &PostgresLabelRepository{}
+
+func NewPostgresLabelRepository(client *ent.Client) (*PostgresLabelRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresLabelRepository{client: client}, nil
+}
+
+func (r *PostgresLabelRepository) Create(ctx context.Context, l *project.Label) error {
+	snap := l.GetSnapshot()
+
+	_, err := r.client.Label.Create().
+		SetID(string(snap.ID)).
+		SetBoardID(string(snap.BoardID)).
+		SetName(snap.Name).
+		SetColor(snap.Color).
+		SetCreatedAt(snap.CreatedAt).
+		Save(ctx)
+
+	return err
+}
+
+func (r *PostgresLabelRepository) GetByID(
+	ctx context.Context,
+	id project.LabelID,
+) (*project.Label, error) {
+	l, err := r.client.Label.Query().
+		Where(label.IDEQ(string(id))).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", project.ErrLabelNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return project.UnmarshalLabelFromDB(l), nil
+}
+
+func (r *PostgresLabelRepository) BoardLabels(
+	ctx context.Context,
+	boardID project.BoardID,
+) ([]project.Label, error) {
+	entLabels, err := r.client.Label.Query().
+		Where(label.BoardIDEQ(string(boardID))).
+		Order(ent.Asc(label.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query board labels: %w", err)
+	}
+
+	labels := make([]project.Label, len(entLabels))
+	for i, l := range entLabels {
+		labels[i] = *project.UnmarshalLabelFromDB(l)
+	}
+
+	return labels, nil
+}
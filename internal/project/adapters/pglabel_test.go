@@ -0,0 +1,60 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoLabels(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	labels, err := NewPostgresLabelRepository(db)
+	require.NoError(t, err)
+
+	tasks, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	newLabel := func(t *testing.T, name string) *project.Label {
+		id, err := project.NewLabelID()
+		require.NoError(t, err)
+		label, err := project.NewLabel(id, project.DefaultBoardID, name, "#d73a4a")
+		require.NoError(t, err)
+		require.NoError(t, labels.Create(ctx, label))
+		return label
+	}
+
+	bug := newLabel(t, "bug")
+	feature := newLabel(t, "feature")
+
+	t.Run("lists the board's labels", func(t *testing.T) {
+		boardLabels, err := labels.BoardLabels(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Len(t, boardLabels, 2)
+		require.Equal(t, "bug", boardLabels[0].GetSnapshot().Name)
+	})
+
+	t.Run("persists the labels of a task", func(t *testing.T) {
+		task := createTaskWithID(t, "labeled task", nil, nil, nil)
+		require.NoError(t, task.AddLabel(*bug))
+		require.NoError(t, tasks.Create(ctx, task))
+
+		err := tasks.UpdateTask(
+			ctx,
+			task.GetSnapshot().ID,
+			func(t *project.Task) (*project.Task, error) {
+				return t, t.SetLabels([]project.Label{*feature})
+			},
+		)
+		require.NoError(t, err)
+
+		taskFromDB, err := tasks.GetByID(ctx, task.GetSnapshot().ID)
+		require.NoError(t, err)
+		require.Len(t, taskFromDB.GetSnapshot().Labels, 1)
+		require.Equal(t, feature.ID(), taskFromDB.GetSnapshot().Labels[0].ID)
+	})
+}
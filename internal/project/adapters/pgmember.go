@@ -0,0 +1,163 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type PostgresMemberRepository struct {
+	client *ent.Client
+}
+
+var _ project.MemberRepository = &PostgresMemberRepository{}
+
+func NewPostgresMemberRepository(client *ent.Client) (*PostgresMemberRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresMemberRepository{client: client}, nil
+}
+
+func (r *PostgresMemberRepository) Create(ctx context.Context, m *project.Member) error {
+	return createMember(ctx, r.client, m)
+}
+
+func createMember(ctx context.Context, client *ent.Client, m *project.Member) error {
+	snap := m.GetSnapshot()
+
+	_, err := client.Member.Create().
+		SetID(string(snap.ID)).
+		SetBoardID(string(snap.BoardID)).
+		SetDisplayName(snap.DisplayName).
+		SetNillableEmail(snap.Email).
+		SetRole(member.Role(snap.Role)).
+		SetCreatedAt(snap.CreatedAt).
+		Save(ctx)
+
+	return err
+}
+
+func (r *PostgresMemberRepository) GetByID(
+	ctx context.Context,
+	id project.MemberID,
+) (*project.Member, error) {
+	m, err := r.client.Member.Query().
+		Where(member.IDEQ(string(id))).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", project.ErrMemberNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return project.UnmarshalMemberFromDB(m), nil
+}
+
+func (r *PostgresMemberRepository) BoardMembers(
+	ctx context.Context,
+	boardID project.BoardID,
+) ([]project.Member, error) {
+	return boardMembers(ctx, r.client, boardID)
+}
+
+func boardMembers(
+	ctx context.Context,
+	client *ent.Client,
+	boardID project.BoardID,
+) ([]project.Member, error) {
+	entMembers, err := client.Member.Query().
+		Where(member.BoardIDEQ(string(boardID))).
+		Order(ent.Asc(member.FieldDisplayName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query board members: %w", err)
+	}
+
+	members := make([]project.Member, len(entMembers))
+	for i, m := range entMembers {
+		members[i] = *project.UnmarshalMemberFromDB(m)
+	}
+
+	return members, nil
+}
+
+// MigrateAssignees moves the single assignee of tasks to their set of assignees.
+// Tasks from before members existed have a free-text assignee name, each distinct name
+// becomes a member of the task's board that the task is then assigned to
+func (r *PostgresMemberRepository) MigrateAssignees(ctx context.Context) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entTasks, err := tx.Task.Query().
+			Where(task.Or(task.AssigneeNameNotNil(), task.AssigneeIDNotNil())).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query tasks to migrate: %w", err)
+		}
+
+		membersOf := make(map[project.BoardID][]project.Member)
+		for _, entTask := range entTasks {
+			update := tx.Task.UpdateOneID(entTask.ID).
+				ClearAssigneeID().
+				ClearAssigneeName()
+
+			if entTask.AssigneeID != nil {
+				update.AddAssigneeIDs(*entTask.AssigneeID)
+			} else if strings.TrimSpace(*entTask.AssigneeName) != "" {
+				// Blank names never pointed at anyone, they're only cleared
+				boardID := project.DefaultBoardID
+				if entTask.BoardID != nil {
+					boardID = project.BoardID(*entTask.BoardID)
+				}
+
+				if _, ok := membersOf[boardID]; !ok {
+					members, err := boardMembers(ctx, tx.Client(), boardID)
+					if err != nil {
+						return err
+					}
+					membersOf[boardID] = members
+				}
+
+				assignee, err := project.FindMember(membersOf[boardID], *entTask.AssigneeName)
+				if errors.Is(err, project.ErrMemberNotFound) {
+					assignee, err = newMigratedMember(boardID, *entTask.AssigneeName)
+					if err != nil {
+						return fmt.Errorf("migrate assignee of task %s: %w", entTask.ID, err)
+					}
+
+					if err := createMember(ctx, tx.Client(), assignee); err != nil {
+						return fmt.Errorf("create member: %w", err)
+					}
+					membersOf[boardID] = append(membersOf[boardID], *assignee)
+				}
+				if err != nil {
+					return err
+				}
+
+				update.AddAssigneeIDs(string(assignee.ID()))
+			}
+
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("assign task %s: %w", entTask.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func newMigratedMember(boardID project.BoardID, name string) (*project.Member, error) {
+	id, err := project.NewMemberID()
+	if err != nil {
+		return nil, err
+	}
+
+	return project.NewMember(id, boardID, name, nil, project.MemberRoleMember)
+}
@@ -0,0 +1,106 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func createBoardMember(
+	ctx context.Context,
+	t *testing.T,
+	db *ent.Client,
+	name string,
+) *project.Member {
+	id, err := project.NewMemberID()
+	require.NoError(t, err)
+	member, err := project.NewMember(id, project.DefaultBoardID, name, nil, project.MemberRoleMember)
+	require.NoError(t, err)
+	require.NoError(t, createMember(ctx, db, member))
+	return member
+}
+
+func memberNames(members []project.MemberSnapshot) []string {
+	return lo.Map(members, func(m project.MemberSnapshot, _ int) string { return m.DisplayName })
+}
+
+func Test_RepoMembers(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	members, err := NewPostgresMemberRepository(db)
+	require.NoError(t, err)
+
+	tasks, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	t.Run("lists the board's members", func(t *testing.T) {
+		id, err := project.NewMemberID()
+		require.NoError(t, err)
+		mary, err := project.NewMember(
+			id,
+			project.DefaultBoardID,
+			"Mary",
+			lo.ToPtr("mary@example.com"),
+			project.MemberRoleAdmin,
+		)
+		require.NoError(t, err)
+		require.NoError(t, members.Create(ctx, mary))
+		createBoardMember(ctx, t, db, "John")
+
+		boardMembers, err := members.BoardMembers(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"John", "Mary"}, project.MemberNames(boardMembers))
+
+		fromDB, err := members.GetByID(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, lo.ToPtr("mary@example.com"), fromDB.GetSnapshot().Email)
+		require.True(t, fromDB.IsAdmin())
+	})
+
+	t.Run("migrates single assignees", func(t *testing.T) {
+		legacyTask := func(t *testing.T) *ent.TaskCreate {
+			id, err := project.NewTaskID()
+			require.NoError(t, err)
+			return db.Task.Create().
+				SetID(string(id)).
+				SetTitle("legacy task").
+				SetBoardID(string(project.DefaultBoardID))
+		}
+
+		for _, name := range []string{"Laura", "laura", "John", " "} {
+			_, err := legacyTask(t).SetAssigneeName(name).Save(ctx)
+			require.NoError(t, err)
+		}
+
+		mary, err := project.FindMember(
+			lo.Must(members.BoardMembers(ctx, project.DefaultBoardID)),
+			"Mary",
+		)
+		require.NoError(t, err)
+		_, err = legacyTask(t).SetAssigneeID(string(mary.ID())).Save(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, members.MigrateAssignees(ctx))
+
+		boardMembers, err := members.BoardMembers(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"John", "Laura", "Mary"}, project.MemberNames(boardMembers))
+
+		boardTasks, err := tasks.BoardTasks(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		assigned := lo.CountBy(boardTasks, func(t project.Task) bool { return len(t.Assignees()) == 1 })
+		require.Equal(t, 4, assigned)
+
+		// Running it again doesn't change anything
+		require.NoError(t, members.MigrateAssignees(ctx))
+		boardMembers, err = members.BoardMembers(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Len(t, boardMembers, 3)
+	})
+}
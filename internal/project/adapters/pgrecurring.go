@@ -0,0 +1,160 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/recurringtask"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+)
+
+type PostgresRecurringTaskRepository struct {
+	client *ent.Client
+}
+
+var _ project.RecurringTaskRepository = &PostgresRecurringTaskRepository{}
+
+func NewPostgresRecurringTaskRepository(
+	client *ent.Client,
+) (*PostgresRecurringTaskRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresRecurringTaskRepository{client: client}, nil
+}
+
+func (r *PostgresRecurringTaskRepository) Create(
+	ctx context.Context,
+	recurring *project.RecurringTask,
+) error {
+	snap := recurring.GetSnapshot()
+
+	_, err := r.client.RecurringTask.Create().
+		SetID(string(snap.ID)).
+		SetBoardID(string(snap.BoardID)).
+		SetTitle(snap.Title).
+		SetNillableDescription(snap.Description).
+		SetAssigneeIds(lo.Map(snap.AssigneeIDs, func(id project.MemberID, _ int) string { return string(id) })).
+		SetLabelIds(lo.Map(snap.LabelIDs, func(id project.LabelID, _ int) string { return string(id) })).
+		SetNillablePriority(recurringPriority(snap.Priority)).
+		SetNillableStoryPoints(snap.StoryPoints).
+		SetRule(snap.Rule).
+		SetStartsAt(snap.StartsAt).
+		SetNillableNextAt(snap.NextAt).
+		SetNillableLastAt(snap.LastAt).
+		SetCreatedAt(snap.CreatedAt).
+		SetUpdatedAt(snap.UpdatedAt).
+		Save(ctx)
+
+	return err
+}
+
+func (r *PostgresRecurringTaskRepository) UpdateRecurringTask(
+	ctx context.Context,
+	id project.RecurringTaskID,
+	updateFn func(r *project.RecurringTask) (*project.RecurringTask, error),
+) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		existing, err := tx.RecurringTask.Query().
+			Where(recurringtask.IDEQ(string(id))).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", project.ErrRecurringNotFound, id)
+		}
+		if err != nil {
+			return fmt.Errorf("query recurring task: %w", err)
+		}
+
+		domainRecurring, err := project.UnmarshalRecurringTaskFromDB(existing)
+		if err != nil {
+			return fmt.Errorf("convert to domain model: %w", err)
+		}
+
+		updated, err := updateFn(domainRecurring)
+		if err != nil {
+			return fmt.Errorf("update function: %w", err)
+		}
+
+		snap := updated.GetSnapshot()
+
+		update := tx.RecurringTask.UpdateOneID(string(id)).
+			SetUpdatedAt(snap.UpdatedAt)
+
+		if snap.NextAt != nil {
+			update.SetNextAt(*snap.NextAt)
+		} else {
+			update.ClearNextAt()
+		}
+
+		if snap.LastAt != nil {
+			update.SetLastAt(*snap.LastAt)
+		} else {
+			update.ClearLastAt()
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("save recurring task: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *PostgresRecurringTaskRepository) Delete(
+	ctx context.Context,
+	id project.RecurringTaskID,
+) error {
+	return r.client.RecurringTask.DeleteOneID(string(id)).Exec(ctx)
+}
+
+func (r *PostgresRecurringTaskRepository) BoardRecurringTasks(
+	ctx context.Context,
+	boardID project.BoardID,
+) ([]project.RecurringTask, error) {
+	entRecurring, err := r.client.RecurringTask.Query().
+		Where(recurringtask.BoardIDEQ(string(boardID))).
+		Order(ent.Asc(recurringtask.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query board recurring tasks: %w", err)
+	}
+
+	return unmarshalRecurringTasks(entRecurring)
+}
+
+func (r *PostgresRecurringTaskRepository) DueRecurringTasks(
+	ctx context.Context,
+	now time.Time,
+) ([]project.RecurringTask, error) {
+	entRecurring, err := r.client.RecurringTask.Query().
+		Where(recurringtask.NextAtLTE(now)).
+		Order(ent.Asc(recurringtask.FieldNextAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query due recurring tasks: %w", err)
+	}
+
+	return unmarshalRecurringTasks(entRecurring)
+}
+
+func unmarshalRecurringTasks(entRecurring []*ent.RecurringTask) ([]project.RecurringTask, error) {
+	recurring := make([]project.RecurringTask, 0, len(entRecurring))
+	for _, entTask := range entRecurring {
+		domainRecurring, err := project.UnmarshalRecurringTaskFromDB(entTask)
+		if err != nil {
+			return nil, fmt.Errorf("convert recurring task %s: %w", entTask.ID, err)
+		}
+		recurring = append(recurring, *domainRecurring)
+	}
+
+	return recurring, nil
+}
+
+func recurringPriority(priority *project.TaskPriority) *recurringtask.Priority {
+	return (*recurringtask.Priority)(priority)
+}
@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoRecurringTasks(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	recurringTasks, err := NewPostgresRecurringTaskRepository(db)
+	require.NoError(t, err)
+
+	tasks, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	rule, err := project.ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=MO")
+	require.NoError(t, err)
+
+	// A monday
+	start := time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC)
+
+	id, err := project.NewRecurringTaskID()
+	require.NoError(t, err)
+	recurring, err := project.NewRecurringTask(
+		id,
+		project.DefaultBoardID,
+		"Rotate on-call",
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		rule,
+		start,
+	)
+	require.NoError(t, err)
+	require.NoError(t, recurringTasks.Create(ctx, recurring))
+
+	t.Run("lists the board's recurring tasks", func(t *testing.T) {
+		boardRecurring, err := recurringTasks.BoardRecurringTasks(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Len(t, boardRecurring, 1)
+		require.Equal(t, "FREQ=WEEKLY;BYDAY=MO", boardRecurring[0].GetSnapshot().Rule)
+	})
+
+	t.Run("lists the due recurring tasks", func(t *testing.T) {
+		due, err := recurringTasks.DueRecurringTasks(ctx, start.Add(-time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, due)
+
+		due, err = recurringTasks.DueRecurringTasks(ctx, start)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+	})
+
+	t.Run("persists the next occurrence", func(t *testing.T) {
+		err := recurringTasks.UpdateRecurringTask(
+			ctx,
+			id,
+			func(r *project.RecurringTask) (*project.RecurringTask, error) {
+				r.Advance(start)
+				return r, nil
+			},
+		)
+		require.NoError(t, err)
+
+		boardRecurring, err := recurringTasks.BoardRecurringTasks(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		snap := boardRecurring[0].GetSnapshot()
+		require.Equal(t, start, *snap.LastAt)
+		require.Equal(t, start.AddDate(0, 0, 7), *snap.NextAt)
+	})
+
+	t.Run("creating the task of an occurrence twice fails", func(t *testing.T) {
+		task, err := project.NewTask(
+			recurring.OccurrenceTaskID(start),
+			project.DefaultBoardID,
+			"Rotate on-call",
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		require.NoError(t, err)
+		require.NoError(t, tasks.Create(ctx, task))
+		require.ErrorIs(t, tasks.Create(ctx, task), project.ErrTaskAlreadyExists)
+	})
+
+	t.Run("deletes the recurring task", func(t *testing.T) {
+		require.NoError(t, recurringTasks.Delete(ctx, id))
+
+		boardRecurring, err := recurringTasks.BoardRecurringTasks(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Empty(t, boardRecurring)
+	})
+}
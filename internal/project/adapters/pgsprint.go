@@ -0,0 +1,130 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/sprint"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type PostgresSprintRepository struct {
+	client *ent.Client
+}
+
+var _ project.SprintRepository = &PostgresSprintRepository{}
+
+func NewPostgresSprintRepository(client *ent.Client) (*PostgresSprintRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresSprintRepository{client: client}, nil
+}
+
+func (r *PostgresSprintRepository) Create(ctx context.Context, s *project.Sprint) error {
+	snap := s.GetSnapshot()
+
+	_, err := r.client.Sprint.Create().
+		SetID(string(snap.ID)).
+		SetBoardID(string(snap.BoardID)).
+		SetName(snap.Name).
+		SetNillableGoal(snap.Goal).
+		SetStartDate(snap.StartDate).
+		SetEndDate(snap.EndDate).
+		SetStatus(sprint.Status(snap.Status)).
+		SetCreatedAt(snap.CreatedAt).
+		SetNillableStartedAt(snap.StartedAt).
+		SetNillableClosedAt(snap.ClosedAt).
+		Save(ctx)
+
+	return err
+}
+
+func (r *PostgresSprintRepository) GetByID(
+	ctx context.Context,
+	id project.SprintID,
+) (*project.Sprint, error) {
+	s, err := r.client.Sprint.Query().
+		Where(sprint.IDEQ(string(id))).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", project.ErrSprintNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return project.UnmarshalSprintFromDB(s)
+}
+
+func (r *PostgresSprintRepository) UpdateSprint(
+	ctx context.Context,
+	id project.SprintID,
+	updateFn func(s *project.Sprint) (*project.Sprint, error),
+) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		existingSprint, err := tx.Sprint.Query().
+			Where(sprint.IDEQ(string(id))).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", project.ErrSprintNotFound, id)
+		}
+		if err != nil {
+			return fmt.Errorf("query sprint: %w", err)
+		}
+
+		domainSprint, err := project.UnmarshalSprintFromDB(existingSprint)
+		if err != nil {
+			return fmt.Errorf("convert to domain model: %w", err)
+		}
+
+		updatedSprint, err := updateFn(domainSprint)
+		if err != nil {
+			return fmt.Errorf("update function: %w", err)
+		}
+
+		snap := updatedSprint.GetSnapshot()
+
+		_, err = tx.Sprint.UpdateOneID(string(id)).
+			SetName(snap.Name).
+			SetNillableGoal(snap.Goal).
+			SetStartDate(snap.StartDate).
+			SetEndDate(snap.EndDate).
+			SetStatus(sprint.Status(snap.Status)).
+			SetNillableStartedAt(snap.StartedAt).
+			SetNillableClosedAt(snap.ClosedAt).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("save sprint: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *PostgresSprintRepository) BoardSprints(
+	ctx context.Context,
+	boardID project.BoardID,
+) ([]project.Sprint, error) {
+	entSprints, err := r.client.Sprint.Query().
+		Where(sprint.BoardIDEQ(string(boardID))).
+		Order(ent.Asc(sprint.FieldStartDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query board sprints: %w", err)
+	}
+
+	sprints := make([]project.Sprint, 0, len(entSprints))
+	for _, entSprint := range entSprints {
+		domainSprint, err := project.UnmarshalSprintFromDB(entSprint)
+		if err != nil {
+			return nil, fmt.Errorf("convert sprint %s: %w", entSprint.ID, err)
+		}
+		sprints = append(sprints, *domainSprint)
+	}
+
+	return sprints, nil
+}
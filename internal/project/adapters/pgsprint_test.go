@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoSprints(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	sprints, err := NewPostgresSprintRepository(db)
+	require.NoError(t, err)
+
+	tasks, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	newSprint := func(t *testing.T, name string, start time.Time) *project.Sprint {
+		id, err := project.NewSprintID()
+		require.NoError(t, err)
+		goal := "Finish " + name
+		sprint, err := project.NewSprint(
+			id,
+			project.DefaultBoardID,
+			name,
+			&goal,
+			start,
+			start.Add(14*24*time.Hour),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sprints.Create(ctx, sprint))
+		return sprint
+	}
+
+	now := time.Now()
+	second := newSprint(t, "Sprint 2", now.Add(14*24*time.Hour))
+	first := newSprint(t, "Sprint 1", now)
+
+	t.Run("lists the board's sprints by start date", func(t *testing.T) {
+		boardSprints, err := sprints.BoardSprints(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Len(t, boardSprints, 2)
+		require.Equal(t, first.ID(), boardSprints[0].ID())
+		require.Equal(t, second.ID(), boardSprints[1].ID())
+	})
+
+	t.Run("persists the sprint's lifecycle", func(t *testing.T) {
+		err := sprints.UpdateSprint(
+			ctx,
+			first.ID(),
+			func(s *project.Sprint) (*project.Sprint, error) {
+				return s, s.Start(nil)
+			},
+		)
+		require.NoError(t, err)
+
+		sprintFromDB, err := sprints.GetByID(ctx, first.ID())
+		require.NoError(t, err)
+		snap := sprintFromDB.GetSnapshot()
+		require.Equal(t, project.SprintStatusActive, snap.Status)
+		require.NotNil(t, snap.StartedAt)
+		require.Equal(t, "Finish Sprint 1", *snap.Goal)
+	})
+
+	t.Run("persists the sprint of a task", func(t *testing.T) {
+		task := createTaskWithID(t, "sprint task", nil, nil, nil)
+		require.NoError(t, task.MoveToSprint(first))
+		require.NoError(t, tasks.Create(ctx, task))
+
+		taskFromDB, err := tasks.GetByID(ctx, task.GetSnapshot().ID)
+		require.NoError(t, err)
+		require.Equal(t, first.ID(), *taskFromDB.SprintID())
+
+		err = tasks.UpdateTask(
+			ctx,
+			task.GetSnapshot().ID,
+			func(t *project.Task) (*project.Task, error) {
+				return t, t.MoveToBacklog()
+			},
+		)
+		require.NoError(t, err)
+
+		taskFromDB, err = tasks.GetByID(ctx, task.GetSnapshot().ID)
+		require.NoError(t, err)
+		require.Nil(t, taskFromDB.SprintID())
+	})
+}
@@ -4,11 +4,32 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
 
 	// postgres driver
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/activity"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/board"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/checklistitem"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/comment"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfield"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/customfieldvalue"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/label"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/member"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/predicate"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/statuschange"
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/task"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
 	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
 )
 
 type PostgresTaskRepository struct {
@@ -57,44 +78,110 @@ func (r *PostgresTaskRepository) AddFiles(
 		// Then link the files to the task
 		_, err := tx.Task.UpdateOneID(string(taskID)).
 			AddFiles(entFiles...).
+			AddVersion(1).
 			Save(ctx)
 		if err != nil {
 			return fmt.Errorf("update task: %w", err)
 		}
 
-		return nil
+		names := make([]string, len(files))
+		for i, f := range files {
+			names[i] = f.GetSnapshot().Name
+		}
+		attached, err := project.NewActivity(
+			taskID,
+			project.ActorFrom(ctx),
+			project.TaskFieldFiles,
+			nil,
+			lo.ToPtr(strings.Join(names, ", ")),
+		)
+		if err != nil {
+			return fmt.Errorf("record activity: %w", err)
+		}
+
+		return saveActivities(ctx, tx, []project.Activity{*attached})
 	})
 }
 
-func (r *PostgresTaskRepository) Create(ctx context.Context, task *project.Task) error {
-	t := task.GetSnapshot()
+func (r *PostgresTaskRepository) Create(ctx context.Context, newTask *project.Task) error {
+	t := newTask.GetSnapshot()
 
-	_, err := r.client.Task.Create().
-		SetID(string(t.ID)).
-		SetTitle(t.Title).
-		SetCreatedAt(t.CreatedAt).
-		SetNillableAssigneeName(t.Assignee).
-		SetNillableCompletedAt(t.CompletedAt).
-		SetNillableDescription(t.Description).
-		SetNillableDueDate(t.DueDate).
-		Save(ctx)
+	created, err := project.NewActivity(
+		t.ID,
+		project.ActorFrom(ctx),
+		project.TaskFieldCreated,
+		nil,
+		&t.Title,
+	)
+	if err != nil {
+		return fmt.Errorf("record activity: %w", err)
+	}
 
-	return err
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		exists, err := tx.Task.Query().Where(task.IDEQ(string(t.ID))).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check task: %w", err)
+		}
+		if exists {
+			return fmt.Errorf("%w: %s", project.ErrTaskAlreadyExists, t.ID)
+		}
+
+		_, err = tx.Task.Create().
+			SetID(string(t.ID)).
+			SetBoardID(string(t.BoardID)).
+			SetNillableParentID((*string)(t.ParentID)).
+			SetNillableSprintID((*string)(t.SprintID)).
+			SetNillableEpicID((*string)(t.EpicID)).
+			SetTitle(t.Title).
+			SetCreatedAt(t.CreatedAt).
+			SetUpdatedAt(t.UpdatedAt).
+			SetNillableCompletedAt(t.CompletedAt).
+			SetNillableDescription(t.Description).
+			SetNillableDueDate(t.DueDate).
+			SetStatus(string(t.Status)).
+			SetRank(string(t.Rank)).
+			SetNillablePriority(entPriority(t.Priority)).
+			SetNillableStoryPoints(t.StoryPoints).
+			AddBlockedByIDs(taskIDStrings(t.BlockedBy)...).
+			AddLabelIDs(labelIDStrings(t.Labels)...).
+			AddAssigneeIDs(memberIDStrings(t.Assignees)...).
+			AddWatcherIDs(memberIDStrings(t.Watchers)...).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create task: %w", err)
+		}
+
+		if err := saveChecklist(ctx, tx, t.ID, t.Checklist); err != nil {
+			return err
+		}
+
+		if err := saveCustomFieldValues(ctx, tx, t.ID, t.CustomFields); err != nil {
+			return err
+		}
+
+		if err := saveStatusChanges(ctx, tx, t.ID, t.StatusChanges); err != nil {
+			return err
+		}
+
+		return saveActivities(ctx, tx, []project.Activity{*created})
+	})
 }
 
 func (r *PostgresTaskRepository) GetByID(
 	ctx context.Context,
 	id project.TaskID,
 ) (*project.Task, error) {
-	task, err := r.client.Task.Query().
+	task, err := withTaskEdges(r.client.Task.Query()).
 		Where(task.IDEQ(string(id))).
-		WithFiles().
 		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", project.ErrTaskNotFound, id)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return project.UnmarshalTaskFromDB(task)
+	return project.UnmarshalTaskFromDB(task), nil
 }
 
 func (r *PostgresTaskRepository) UpdateTask(
@@ -103,20 +190,25 @@ func (r *PostgresTaskRepository) UpdateTask(
 	updateFn func(t *project.Task) (*project.Task, error),
 ) error {
 	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := lockTaskBoard(ctx, tx, id); err != nil {
+			return err
+		}
+
 		// Get existing task with files
-		existingTask, err := tx.Task.Query().
+		existingTask, err := withTaskEdges(tx.Task.Query()).
 			Where(task.IDEQ(string(id))).
-			WithFiles().
 			First(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", project.ErrTaskNotFound, id)
+		}
 		if err != nil {
 			return fmt.Errorf("query task: %w", err)
 		}
 
 		// Convert to domain model
-		domainTask, err := project.UnmarshalTaskFromDB(existingTask)
-		if err != nil {
-			return fmt.Errorf("convert to domain model: %w", err)
-		}
+		domainTask := project.UnmarshalTaskFromDB(existingTask)
+
+		before := project.RecordedFields(domainTask)
 
 		// Apply update function
 		updatedTask, err := updateFn(domainTask)
@@ -126,50 +218,540 @@ func (r *PostgresTaskRepository) UpdateTask(
 
 		snap := updatedTask.GetSnapshot()
 
-		// Update in database
-		_, err = tx.Task.UpdateOneID(string(id)).
+		// Only update the task if nobody else saved it since it was read
+		update := tx.Task.UpdateOneID(string(id)).
+			Where(task.VersionEQ(existingTask.Version)).
+			AddVersion(1).
 			SetTitle(snap.Title).
 			SetNillableDescription(snap.Description).
 			SetNillableDueDate(snap.DueDate).
-			SetNillableAssigneeName(snap.Assignee).
 			SetNillableCompletedAt(snap.CompletedAt).
-			SetStatus(task.Status(snap.Status)).
-			SetUpdatedAt(snap.UpdatedAt).
-			Save(ctx)
+			SetStatus(string(snap.Status)).
+			SetRank(string(snap.Rank)).
+			SetNillablePriority(entPriority(snap.Priority)).
+			SetNillableStoryPoints(snap.StoryPoints).
+			SetUpdatedAt(snap.UpdatedAt)
+
+		if snap.ParentID != nil {
+			update.SetParentID(string(*snap.ParentID))
+		} else {
+			update.ClearParentID()
+		}
+
+		if snap.ArchivedAt != nil {
+			update.SetArchivedAt(*snap.ArchivedAt)
+		} else {
+			update.ClearArchivedAt()
+		}
+
+		update.
+			ClearBlockedBy().
+			AddBlockedByIDs(taskIDStrings(snap.BlockedBy)...).
+			ClearLabels().
+			AddLabelIDs(labelIDStrings(snap.Labels)...).
+			ClearAssignees().
+			AddAssigneeIDs(memberIDStrings(snap.Assignees)...).
+			ClearWatchers().
+			AddWatcherIDs(memberIDStrings(snap.Watchers)...)
+
+		if snap.SprintID != nil {
+			update.SetSprintID(string(*snap.SprintID))
+		} else {
+			update.ClearSprintID()
+		}
+
+		if snap.EpicID != nil {
+			update.SetEpicID(string(*snap.EpicID))
+		} else {
+			update.ClearEpicID()
+		}
+
+		if snap.WIPOverride != nil {
+			update.
+				SetWipOverrideBy(snap.WIPOverride.ForcedBy).
+				SetWipOverrideStatus(string(snap.WIPOverride.Status)).
+				SetWipOverrideAt(snap.WIPOverride.ForcedAt)
+		} else {
+			update.
+				ClearWipOverrideBy().
+				ClearWipOverrideStatus().
+				ClearWipOverrideAt()
+		}
+
+		_, err = update.Save(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", project.ErrTaskChangedConcurrently, id)
+		}
 		if err != nil {
 			return fmt.Errorf("save task: %w", err)
 		}
 
+		if err := saveChecklist(ctx, tx, id, snap.Checklist); err != nil {
+			return err
+		}
+
+		if err := saveCustomFieldValues(ctx, tx, id, snap.CustomFields); err != nil {
+			return err
+		}
+
+		// Status changes are only ever appended
+		recorded := len(existingTask.Edges.StatusChanges)
+		if err := saveStatusChanges(ctx, tx, id, snap.StatusChanges[recorded:]); err != nil {
+			return err
+		}
+
+		activities, err := project.NewTaskActivities(
+			id,
+			project.ActorFrom(ctx),
+			before,
+			project.RecordedFields(updatedTask),
+		)
+		if err != nil {
+			return fmt.Errorf("record activities: %w", err)
+		}
+
+		return saveActivities(ctx, tx, activities)
+	})
+}
+
+func (r *PostgresTaskRepository) Delete(ctx context.Context, id project.TaskID) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		// The other tasks of the board can't link to the task while it's deleted
+		if err := lockTaskBoard(ctx, tx, id); err != nil {
+			return err
+		}
+
+		if _, err := tx.Comment.Delete().Where(comment.TaskIDEQ(string(id))).Exec(ctx); err != nil {
+			return fmt.Errorf("delete comments: %w", err)
+		}
+
+		if _, err := tx.Activity.Delete().Where(activity.TaskIDEQ(string(id))).Exec(ctx); err != nil {
+			return fmt.Errorf("delete activities: %w", err)
+		}
+
+		_, err := tx.ChecklistItem.Delete().
+			Where(checklistitem.TaskIDEQ(string(id))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete checklist: %w", err)
+		}
+
+		_, err = tx.CustomFieldValue.Delete().
+			Where(customfieldvalue.TaskIDEQ(string(id))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete custom field values: %w", err)
+		}
+
+		if _, err := tx.Worklog.Delete().Where(worklog.TaskIDEQ(string(id))).Exec(ctx); err != nil {
+			return fmt.Errorf("delete worklogs: %w", err)
+		}
+
+		if _, err := tx.Timer.Delete().Where(timer.TaskIDEQ(string(id))).Exec(ctx); err != nil {
+			return fmt.Errorf("delete timers: %w", err)
+		}
+
+		_, err = tx.StatusChange.Delete().
+			Where(statuschange.TaskIDEQ(string(id))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete status changes: %w", err)
+		}
+
+		_, err = tx.File.Delete().
+			Where(file.HasTaskWith(task.IDEQ(string(id)))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete files: %w", err)
+		}
+
+		if err := tx.Task.DeleteOneID(string(id)).Exec(ctx); err != nil {
+			return fmt.Errorf("delete task: %w", err)
+		}
+
 		return nil
 	})
 }
 
-func (r *PostgresTaskRepository) AllTasks(ctx context.Context) ([]project.Task, error) {
-	// Query all tasks with their files
-	entTasks, err := r.client.Task.Query().
-		WithFiles().
+func (r *PostgresTaskRepository) BoardTasks(
+	ctx context.Context,
+	boardID project.BoardID,
+) ([]project.Task, error) {
+	return r.boardTasks(ctx, boardID, task.ArchivedAtIsNil())
+}
+
+func (r *PostgresTaskRepository) BoardTasksWithArchived(
+	ctx context.Context,
+	boardID project.BoardID,
+) ([]project.Task, error) {
+	return r.boardTasks(ctx, boardID)
+}
+
+func (r *PostgresTaskRepository) boardTasks(
+	ctx context.Context,
+	boardID project.BoardID,
+	predicates ...predicate.Task,
+) ([]project.Task, error) {
+	if err := ensureBoardExists(ctx, r.client, boardID); err != nil {
+		return nil, err
+	}
+
+	entTasks, err := withTaskEdges(r.client.Task.Query()).
+		Where(append(predicates, task.BoardIDEQ(string(boardID)))...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query board tasks: %w", err)
+	}
+
+	return unmarshalTasks(entTasks)
+}
+
+func (r *PostgresTaskRepository) ListTasks(
+	ctx context.Context,
+	filter project.TaskFilter,
+	sort project.TaskSort,
+	after *project.TaskCursor,
+	limit int,
+) ([]project.Task, error) {
+	if filter.BoardID != nil {
+		if err := ensureBoardExists(ctx, r.client, *filter.BoardID); err != nil {
+			return nil, err
+		}
+	}
+
+	predicates := taskFilterPredicates(filter)
+	var orderBy []task.OrderOption
+
+	switch sort.Field {
+	case project.TaskSortColumn, project.TaskSortStatus:
+		if after != nil {
+			past, err := r.pastWorkflowCursor(ctx, sort, after)
+			if err != nil {
+				return nil, err
+			}
+			predicates = append(predicates, past)
+		}
+
+		orderBy = append(orderBy, func(s *sql.Selector) {
+			for _, key := range workflowSortKeys(s, sort.Field) {
+				if sort.Descending {
+					key += " DESC"
+				}
+				s.OrderExpr(sql.Expr(key))
+			}
+		})
+	default:
+		column, ok := taskSortColumns[sort.Field]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", project.ErrInvalidTaskSort, sort.Field)
+		}
+
+		if after != nil {
+			past, err := pastCursor(column, sort, after)
+			if err != nil {
+				return nil, err
+			}
+			predicates = append(predicates, past)
+		}
+
+		order := []sql.OrderTermOption{sql.OrderNullsLast()}
+		if sort.Descending {
+			order = append(order, sql.OrderDesc())
+		}
+		orderBy = append(orderBy,
+			sql.OrderByField(column, order...).ToFunc(),
+			sql.OrderByField(task.FieldID, order...).ToFunc(),
+		)
+	}
+
+	entTasks, err := withTaskEdges(r.client.Task.Query()).
+		Where(predicates...).
+		Order(orderBy...).
+		Limit(limit).
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("query tasks: %w", err)
 	}
 
+	return unmarshalTasks(entTasks)
+}
+
+func (r *PostgresTaskRepository) ArchivedTasks(ctx context.Context) ([]project.Task, error) {
+	entTasks, err := withTaskEdges(r.client.Task.Query()).
+		Where(task.ArchivedAtNotNil()).
+		Order(ent.Desc(task.FieldArchivedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query archived tasks: %w", err)
+	}
+
+	return unmarshalTasks(entTasks)
+}
+
+// MigrateRanks puts the tasks created before ranks existed at the bottom of their column,
+// in the order they were created
+func (r *PostgresTaskRepository) MigrateRanks(ctx context.Context) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entTasks, err := tx.Task.Query().
+			Order(ent.Asc(task.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("query tasks to rank: %w", err)
+		}
+
+		type column struct {
+			boardID string
+			status  string
+		}
+		columnOf := func(t *ent.Task) column {
+			return column{boardID: lo.FromPtr(t.BoardID), status: t.Status}
+		}
+
+		lastRank := make(map[column]project.Rank)
+		for _, entTask := range entTasks {
+			col := columnOf(entTask)
+			if rank := project.Rank(entTask.Rank); rank > lastRank[col] {
+				lastRank[col] = rank
+			}
+		}
+
+		for _, entTask := range entTasks {
+			if entTask.Rank != "" {
+				continue
+			}
+
+			col := columnOf(entTask)
+			rank, err := project.RankBetween(lastRank[col], "")
+			if err != nil {
+				return fmt.Errorf("rank task %s: %w", entTask.ID, err)
+			}
+
+			err = tx.Task.UpdateOneID(entTask.ID).
+				SetRank(string(rank)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("rank task %s: %w", entTask.ID, err)
+			}
+			lastRank[col] = rank
+		}
+
+		return nil
+	})
+}
+
+// FileBoards returns the board of the task of every file, keyed by file ID
+func (r *PostgresTaskRepository) FileBoards(ctx context.Context) (map[string]project.BoardID, error) {
+	entFiles, err := r.client.File.Query().
+		Where(file.HasTask()).
+		WithTask().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query files: %w", err)
+	}
+
+	boards := make(map[string]project.BoardID, len(entFiles))
+	for _, entFile := range entFiles {
+		// A file is only ever attached to one task
+		boards[entFile.ID] = project.BoardID(lo.FromPtr(entFile.Edges.Task[0].BoardID))
+	}
+	return boards, nil
+}
+
+// saveChecklist replaces the task's checklist items, their position is their index in the checklist
+func saveChecklist(
+	ctx context.Context,
+	tx *ent.Tx,
+	taskID project.TaskID,
+	checklist []project.ChecklistItemSnapshot,
+) error {
+	_, err := tx.ChecklistItem.Delete().
+		Where(checklistitem.TaskIDEQ(string(taskID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("clear checklist: %w", err)
+	}
+
+	builders := make([]*ent.ChecklistItemCreate, len(checklist))
+	for i, item := range checklist {
+		builders[i] = tx.ChecklistItem.Create().
+			SetID(string(item.ID)).
+			SetTaskID(string(taskID)).
+			SetText(item.Text).
+			SetDone(item.Done).
+			SetPosition(i)
+	}
+
+	if err := tx.ChecklistItem.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("save checklist: %w", err)
+	}
+
+	return nil
+}
+
+// saveCustomFieldValues replaces the task's custom field values
+func saveCustomFieldValues(
+	ctx context.Context,
+	tx *ent.Tx,
+	taskID project.TaskID,
+	values []project.CustomFieldValueSnapshot,
+) error {
+	_, err := tx.CustomFieldValue.Delete().
+		Where(customfieldvalue.TaskIDEQ(string(taskID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("clear custom field values: %w", err)
+	}
+
+	builders := make([]*ent.CustomFieldValueCreate, len(values))
+	for i, v := range values {
+		builders[i] = tx.CustomFieldValue.Create().
+			SetTaskID(string(taskID)).
+			SetFieldID(string(v.FieldID)).
+			SetValue(v.Value)
+	}
+
+	if err := tx.CustomFieldValue.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("save custom field values: %w", err)
+	}
+
+	return nil
+}
+
+// saveStatusChanges records new status changes of the task
+func saveStatusChanges(
+	ctx context.Context,
+	tx *ent.Tx,
+	taskID project.TaskID,
+	changes []project.StatusChangeSnapshot,
+) error {
+	builders := make([]*ent.StatusChangeCreate, len(changes))
+	for i, c := range changes {
+		builders[i] = tx.StatusChange.Create().
+			SetTaskID(string(taskID)).
+			SetFromStatus(string(c.From)).
+			SetToStatus(string(c.To)).
+			SetChangedAt(c.ChangedAt)
+	}
+
+	if err := tx.StatusChange.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("save status changes: %w", err)
+	}
+
+	return nil
+}
+
+// withTaskEdges loads everything the domain task is built from
+func withTaskEdges(q *ent.TaskQuery) *ent.TaskQuery {
+	return q.
+		WithFiles().
+		WithParent().
+		WithChildren().
+		WithBlockedBy().
+		WithBlocks().
+		WithLabels().
+		WithAssignees().
+		WithWatchers().
+		WithChecklistItems().
+		WithCustomFieldValues(func(q *ent.CustomFieldValueQuery) {
+			q.WithCustomField()
+		}).
+		WithWorklogs().
+		WithStatusChanges()
+}
+
+func unmarshalTasks(entTasks []*ent.Task) ([]project.Task, error) {
 	// Convert to domain tasks
 	tasks := make([]project.Task, 0, len(entTasks))
 	for _, entTask := range entTasks {
-		domainTask, err := project.UnmarshalTaskFromDB(entTask)
-		if err != nil {
-			return nil, fmt.Errorf("convert task %s: %w", entTask.ID, err)
-		}
-		tasks = append(tasks, *domainTask)
+		tasks = append(tasks, *project.UnmarshalTaskFromDB(entTask))
 	}
 
 	return tasks, nil
 }
 
+// lockTaskBoard makes the updates of the tasks of a board run one after the other until the
+// transaction ends, so that the WIP limits checked while updating a task count the tasks
+// the other updates just moved
+func lockTaskBoard(ctx context.Context, tx *ent.Tx, id project.TaskID) error {
+	t, err := tx.Task.Query().
+		Where(task.IDEQ(string(id))).
+		Select(task.FieldBoardID).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("%w: %s", project.ErrTaskNotFound, id)
+	}
+	if err != nil {
+		return fmt.Errorf("query task board: %w", err)
+	}
+
+	// Tasks created before boards existed don't have one to lock
+	if t.BoardID == nil {
+		return nil
+	}
+
+	_, err = tx.Board.Query().
+		Where(board.IDEQ(*t.BoardID), forUpdate).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("lock board: %w", err)
+	}
+
+	return nil
+}
+
+// forUpdate locks the selected rows until the transaction ends
+func forUpdate(s *sql.Selector) {
+	s.ForUpdate()
+}
+
+// ensureBoardExists returns ErrBoardNotFound if there's no such board,
+// so that listing its tasks isn't mistaken for an empty board
+func ensureBoardExists(ctx context.Context, client *ent.Client, id project.BoardID) error {
+	exists, err := client.Board.Query().
+		Where(board.IDEQ(string(id))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("query board: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: %s", project.ErrBoardNotFound, id)
+	}
+
+	return nil
+}
+
+func taskIDStrings(ids []project.TaskID) []string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = string(id)
+	}
+	return strs
+}
+
+func entPriority(priority *project.TaskPriority) *task.Priority {
+	return (*task.Priority)(priority)
+}
+
+func labelIDStrings(labels []project.LabelSnapshot) []string {
+	strs := make([]string, len(labels))
+	for i, l := range labels {
+		strs[i] = string(l.ID)
+	}
+	return strs
+}
+
+func memberIDStrings(members []project.MemberSnapshot) []string {
+	strs := make([]string, len(members))
+	for i, m := range members {
+		strs[i] = string(m.ID)
+	}
+	return strs
+}
+
 func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
 	tx, err := client.Tx(ctx)
 	if err != nil {
-		return err
+		return storageError(err)
 	}
 	defer func() {
 		if v := recover(); v != nil {
@@ -184,7 +766,214 @@ func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error)
 		return err
 	}
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("committing transaction: %w", err)
+		return fmt.Errorf("committing transaction: %w", storageError(err))
 	}
 	return nil
 }
+
+var taskSortColumns = map[project.TaskSortField]string{
+	project.TaskSortTitle:       task.FieldTitle,
+	project.TaskSortRank:        task.FieldRank,
+	project.TaskSortPriority:    task.FieldPriority,
+	project.TaskSortStoryPoints: task.FieldStoryPoints,
+	project.TaskSortDueDate:     task.FieldDueDate,
+	project.TaskSortCreatedAt:   task.FieldCreatedAt,
+	project.TaskSortUpdatedAt:   task.FieldUpdatedAt,
+	project.TaskSortCompletedAt: task.FieldCompletedAt,
+}
+
+func taskFilterPredicates(filter project.TaskFilter) []predicate.Task {
+	predicates := []predicate.Task{task.ArchivedAtIsNil()}
+
+	if filter.BoardID != nil {
+		predicates = append(predicates, task.BoardIDEQ(string(*filter.BoardID)))
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := lo.Map(filter.Statuses, func(s project.TaskStatus, _ int) string {
+			return string(s)
+		})
+		predicates = append(predicates, task.StatusIn(statuses...))
+	}
+
+	if len(filter.Assignees) > 0 {
+		members := lo.Map(filter.Assignees, func(idOrName string, _ int) predicate.Member {
+			idOrName = strings.TrimSpace(idOrName)
+			return member.Or(member.IDEQ(idOrName), member.DisplayNameEqualFold(idOrName))
+		})
+		predicates = append(predicates, task.HasAssigneesWith(member.Or(members...)))
+	}
+
+	if filter.DueFrom != nil {
+		predicates = append(predicates, task.DueDateGTE(project.Day(*filter.DueFrom)))
+	}
+
+	if filter.DueTo != nil {
+		predicates = append(predicates, task.DueDateLT(project.Day(*filter.DueTo).AddDate(0, 0, 1)))
+	}
+
+	if text := strings.TrimSpace(filter.Text); text != "" {
+		predicates = append(predicates, task.Or(
+			task.TitleContainsFold(text),
+			task.DescriptionContainsFold(text),
+		))
+	}
+
+	for _, idOrName := range filter.Labels {
+		predicates = append(predicates, task.HasLabelsWith(
+			label.Or(label.IDEQ(idOrName), label.NameEqualFold(idOrName)),
+		))
+	}
+
+	for idOrName, value := range filter.CustomFields {
+		values := lo.Map(
+			project.CustomFieldFilterValues(value),
+			func(v string, _ int) predicate.CustomFieldValue {
+				return customfieldvalue.ValueEqualFold(v)
+			},
+		)
+		predicates = append(predicates, task.HasCustomFieldValuesWith(
+			customfieldvalue.HasCustomFieldWith(
+				customfield.Or(customfield.IDEQ(idOrName), customfield.NameEqualFold(idOrName)),
+			),
+			customfieldvalue.Or(values...),
+		))
+	}
+
+	if filter.UpdatedSince != nil {
+		predicates = append(predicates, task.UpdatedAtGTE(*filter.UpdatedSince))
+	}
+
+	return predicates
+}
+
+// pastCursor keeps the tasks that come after the cursor in the order of sort,
+// tasks without a value for the sorted column come last
+func pastCursor(
+	column string,
+	sort project.TaskSort,
+	after *project.TaskCursor,
+) (predicate.Task, error) {
+	value, err := parseSortValue(sort.Field, after.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	past := sql.GT
+	if sort.Descending {
+		past = sql.LT
+	}
+
+	return func(s *sql.Selector) {
+		col, id := s.C(column), s.C(task.FieldID)
+		if value == nil {
+			s.Where(sql.And(sql.IsNull(col), past(id, string(after.ID))))
+			return
+		}
+
+		s.Where(sql.Or(
+			past(col, value),
+			sql.And(sql.EQ(col, value), past(id, string(after.ID))),
+			sql.IsNull(col),
+		))
+	}, nil
+}
+
+// workflowSortKeys are the expressions the tasks are sorted on to follow the workflows of their boards,
+// in the same order as project.SortByColumn
+func workflowSortKeys(s *sql.Selector, field project.TaskSortField) []string {
+	position := workflowPosition(s.C(task.FieldBoardID), s.C(task.FieldStatus))
+	if field == project.TaskSortStatus {
+		return []string{position, s.C(task.FieldID)}
+	}
+
+	return []string{
+		fmt.Sprintf("COALESCE(%s, '')", s.C(task.FieldBoardID)),
+		position,
+		s.C(task.FieldRank),
+		s.C(task.FieldID),
+	}
+}
+
+// workflowPosition is the position of the status in the workflow of the board, counting from 1,
+// statuses the workflow doesn't have anymore come after the others
+func workflowPosition(boardID, status string) string {
+	return fmt.Sprintf(
+		"COALESCE((SELECT w.place FROM %s AS wb "+
+			"CROSS JOIN LATERAL jsonb_array_elements_text(wb.%s) WITH ORDINALITY AS w(status, place) "+
+			"WHERE wb.%s = %s AND w.status = %s), %d)",
+		board.Table, board.FieldStatuses, board.FieldID, boardID, status, math.MaxInt32,
+	)
+}
+
+// pastWorkflowCursor keeps the tasks after the cursor in the order of workflowSortKeys
+func (r *PostgresTaskRepository) pastWorkflowCursor(
+	ctx context.Context,
+	sort project.TaskSort,
+	after *project.TaskCursor,
+) (predicate.Task, error) {
+	position := math.MaxInt32
+	entBoard, err := r.client.Board.Get(ctx, string(after.BoardID))
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("query workflow: %w", err)
+	}
+	if entBoard != nil {
+		if i := slices.Index(entBoard.Statuses, string(after.Status)); i >= 0 {
+			position = i + 1
+		}
+	}
+
+	values := []any{position, string(after.ID)}
+	if sort.Field == project.TaskSortColumn {
+		if after.Value == nil {
+			return nil, project.ErrInvalidTaskCursor
+		}
+		values = []any{string(after.BoardID), position, *after.Value, string(after.ID)}
+	}
+
+	past := " > "
+	if sort.Descending {
+		past = " < "
+	}
+
+	return func(s *sql.Selector) {
+		keys := workflowSortKeys(s, sort.Field)
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(" + strings.Join(keys, ", ") + ")" + past + "(")
+			for i, value := range values {
+				if i > 0 {
+					b.Comma()
+				}
+				b.Arg(value)
+			}
+			b.WriteString(")")
+		}))
+	}, nil
+}
+
+// parseSortValue turns the value kept in a cursor back into the type of its column
+func parseSortValue(field project.TaskSortField, value *string) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field {
+	case project.TaskSortStoryPoints:
+		points, err := strconv.Atoi(*value)
+		if err != nil {
+			return nil, project.ErrInvalidTaskCursor
+		}
+		return points, nil
+	case project.TaskSortDueDate,
+		project.TaskSortCreatedAt,
+		project.TaskSortUpdatedAt,
+		project.TaskSortCompletedAt:
+		t, err := time.Parse(time.RFC3339Nano, *value)
+		if err != nil {
+			return nil, project.ErrInvalidTaskCursor
+		}
+		return t, nil
+	default:
+		return *value, nil
+	}
+}
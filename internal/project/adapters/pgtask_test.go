@@ -3,12 +3,15 @@ package adapters
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/DeluxeOwl/cogniboard/internal/postgres"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
 	"github.com/DeluxeOwl/cogniboard/internal/project"
 	"github.com/docker/go-connections/nat"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -39,10 +42,10 @@ var (
 	}
 )
 
-func setupPostgresRepo(
+func setupPostgres(
 	ctx context.Context,
 	t *testing.T,
-) (repo *PostgresTaskRepository, cleanup func()) {
+) (client *ent.Client, cleanup func()) {
 	req := testcontainers.ContainerRequest{
 		Image:        pgImage,
 		ExposedPorts: []string{pgPort},
@@ -69,25 +72,47 @@ func setupPostgresRepo(
 	require.NoError(t, err)
 	require.NotNil(t, db)
 
-	repo, err = NewPostgresTaskRepository(db)
+	boards, err := NewPostgresBoardRepository(db)
 	require.NoError(t, err)
+	require.NoError(t, boards.EnsureDefaultBoard(ctx))
 
-	return repo, func() {
+	return db, func() {
 		testcontainers.CleanupContainer(t, postgresContainer)
 	}
 }
 
+func setupPostgresRepo(
+	ctx context.Context,
+	t *testing.T,
+) (repo *PostgresTaskRepository, cleanup func()) {
+	db, cleanup := setupPostgres(ctx, t)
+
+	repo, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	return repo, cleanup
+}
+
 func createTaskWithID(
 	t *testing.T,
 	title string,
 	description *string,
 	dueDate *time.Time,
-	assigneeName *string,
+	assignees []project.Member,
 ) (task *project.Task) {
 	taskID, err := project.NewTaskID()
 	require.NoError(t, err)
 
-	task, err = project.NewTask(taskID, title, description, dueDate, assigneeName)
+	task, err = project.NewTask(
+		taskID,
+		project.DefaultBoardID,
+		title,
+		description,
+		dueDate,
+		assignees,
+		nil,
+		nil,
+	)
 	require.NoError(t, err)
 
 	return task
@@ -111,9 +136,12 @@ func Test_RepoCreate(t *testing.T) {
 
 func Test_RepoGetByID(t *testing.T) {
 	ctx := context.Background()
-	repo, cleanup := setupPostgresRepo(ctx, t)
+	db, cleanup := setupPostgres(ctx, t)
 	defer cleanup()
 
+	repo, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
 	t.Run("returns error when task does not exist", func(t *testing.T) {
 		taskID, err := project.NewTaskID()
 		require.NoError(t, err)
@@ -121,14 +149,15 @@ func Test_RepoGetByID(t *testing.T) {
 		task, err := repo.GetByID(ctx, taskID)
 		require.Error(t, err)
 		require.Nil(t, task)
-		require.Contains(t, err.Error(), "task not found")
+		require.ErrorIs(t, err, project.ErrTaskNotFound)
 	})
 
 	t.Run("successfully retrieves existing task with all fields", func(t *testing.T) {
 		description := "test description"
 		dueDate := time.Now().Add(24 * time.Hour)
-		assigneeName := "john"
-		task := createTaskWithID(t, "test task", &description, &dueDate, &assigneeName)
+		assignee := createBoardMember(ctx, t, db, "john")
+		task := createTaskWithID(t, "test task", &description, &dueDate, []project.Member{*assignee})
+		require.NoError(t, task.AddWatcher(*createBoardMember(ctx, t, db, "mary")))
 
 		err := repo.Create(ctx, task)
 		require.NoError(t, err)
@@ -142,6 +171,8 @@ func Test_RepoGetByID(t *testing.T) {
 		require.Equal(t, task.GetSnapshot().Description, taskFromDB.GetSnapshot().Description)
 		require.Equal(t, task.GetSnapshot().DueDate.UTC(), taskFromDB.GetSnapshot().DueDate.UTC())
 		require.Equal(t, task.GetSnapshot().Assignee, taskFromDB.GetSnapshot().Assignee)
+		require.Equal(t, memberNames(task.GetSnapshot().Assignees), memberNames(taskFromDB.GetSnapshot().Assignees))
+		require.Equal(t, memberNames(task.GetSnapshot().Watchers), memberNames(taskFromDB.GetSnapshot().Watchers))
 		require.Equal(t, task.GetSnapshot().Status, taskFromDB.GetSnapshot().Status)
 		// Truncate timestamps to milliseconds for comparison
 		require.Equal(t, task.GetSnapshot().CreatedAt.UTC().Truncate(time.Millisecond),
@@ -154,9 +185,12 @@ func Test_RepoGetByID(t *testing.T) {
 
 func Test_RepoUpdateTask(t *testing.T) {
 	ctx := context.Background()
-	repo, cleanup := setupPostgresRepo(ctx, t)
+	db, cleanup := setupPostgres(ctx, t)
 	defer cleanup()
 
+	repo, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
 	t.Run("returns error when task does not exist", func(t *testing.T) {
 		taskID, err := project.NewTaskID()
 		require.NoError(t, err)
@@ -164,8 +198,7 @@ func Test_RepoUpdateTask(t *testing.T) {
 		err = repo.UpdateTask(ctx, taskID, func(t *project.Task) (*project.Task, error) {
 			return t, nil
 		})
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "task not found")
+		require.ErrorIs(t, err, project.ErrTaskNotFound)
 	})
 
 	t.Run("successfully updates task status", func(t *testing.T) {
@@ -177,7 +210,7 @@ func Test_RepoUpdateTask(t *testing.T) {
 			ctx,
 			task.GetSnapshot().ID,
 			func(t *project.Task) (*project.Task, error) {
-				err := t.ChangeStatus(project.TaskStatusCompleted)
+				err := t.ChangeStatus(project.DefaultWorkflow(), project.TaskStatusCompleted)
 				if err != nil {
 					return nil, err
 				}
@@ -193,6 +226,44 @@ func Test_RepoUpdateTask(t *testing.T) {
 		require.NotNil(t, snap.CompletedAt)
 	})
 
+	t.Run("bumps the version of the task", func(t *testing.T) {
+		task := createTaskWithID(t, "test task", nil, nil, nil)
+		require.NoError(t, repo.Create(ctx, task))
+
+		err := repo.UpdateTask(ctx, task.GetSnapshot().ID, func(t *project.Task) (*project.Task, error) {
+			return t, nil
+		})
+		require.NoError(t, err)
+
+		updatedTask, err := repo.GetByID(ctx, task.GetSnapshot().ID)
+		require.NoError(t, err)
+		require.Equal(t, 2, updatedTask.Version())
+	})
+
+	t.Run("rejects an update when the task was saved in between", func(t *testing.T) {
+		task := createTaskWithID(t, "test task", nil, nil, nil)
+		require.NoError(t, repo.Create(ctx, task))
+		id := task.GetSnapshot().ID
+
+		err := repo.UpdateTask(ctx, id, func(outdated *project.Task) (*project.Task, error) {
+			// Someone else saves the task while this update runs
+			err := repo.UpdateTask(ctx, id, func(t *project.Task) (*project.Task, error) {
+				err := t.Edit(project.DefaultWorkflow(), lo.ToPtr("theirs"), nil, nil, nil, nil, nil, nil)
+				return t, err
+			})
+			require.NoError(t, err)
+
+			err = outdated.Edit(project.DefaultWorkflow(), lo.ToPtr("mine"), nil, nil, nil, nil, nil, nil)
+			return outdated, err
+		})
+		require.ErrorIs(t, err, project.ErrTaskChangedConcurrently)
+
+		taskFromDB, err := repo.GetByID(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, "theirs", taskFromDB.GetSnapshot().Title)
+		require.Equal(t, 2, taskFromDB.Version())
+	})
+
 	t.Run("rolls back transaction on update function error", func(t *testing.T) {
 		task := createTaskWithID(t, "test task", nil, nil, nil)
 		err := repo.Create(ctx, task)
@@ -203,7 +274,7 @@ func Test_RepoUpdateTask(t *testing.T) {
 			ctx,
 			task.GetSnapshot().ID,
 			func(t *project.Task) (*project.Task, error) {
-				err := t.ChangeStatus(project.TaskStatusCompleted)
+				err := t.ChangeStatus(project.DefaultWorkflow(), project.TaskStatusCompleted)
 				if err != nil {
 					return nil, err
 				}
@@ -222,8 +293,8 @@ func Test_RepoUpdateTask(t *testing.T) {
 	t.Run("successfully updates task fields and sets updated_at", func(t *testing.T) {
 		description := "initial description"
 		dueDate := time.Now().Add(24 * time.Hour)
-		assignee := "initial assignee"
-		task := createTaskWithID(t, "initial title", &description, &dueDate, &assignee)
+		assignee := createBoardMember(ctx, t, db, "initial assignee")
+		task := createTaskWithID(t, "initial title", &description, &dueDate, []project.Member{*assignee})
 
 		err := repo.Create(ctx, task)
 		require.NoError(t, err)
@@ -238,14 +309,14 @@ func Test_RepoUpdateTask(t *testing.T) {
 		newTitle := "updated title"
 		newDescription := "updated description"
 		newDueDate := time.Now().Add(48 * time.Hour)
-		newAssignee := "updated assignee"
+		newAssignee := createBoardMember(ctx, t, db, "updated assignee")
 
 		err = repo.UpdateTask(
 			ctx,
 			task.GetSnapshot().ID,
 			func(t *project.Task) (*project.Task, error) {
 				newStatus := project.TaskStatusInProgress
-				err := t.Edit(&newTitle, &newDescription, &newDueDate, &newAssignee, &newStatus)
+				err := t.Edit(project.DefaultWorkflow(), &newTitle, &newDescription, &newDueDate, []project.Member{*assignee, *newAssignee}, &newStatus, nil, nil)
 				if err != nil {
 					return nil, err
 				}
@@ -262,7 +333,7 @@ func Test_RepoUpdateTask(t *testing.T) {
 		require.Equal(t, newTitle, snap.Title)
 		require.Equal(t, &newDescription, snap.Description)
 		require.Equal(t, newDueDate.UTC(), snap.DueDate.UTC())
-		require.Equal(t, &newAssignee, snap.Assignee)
+		require.Equal(t, []string{"initial assignee", "updated assignee"}, memberNames(snap.Assignees))
 		require.Equal(t, project.TaskStatusInProgress, snap.Status)
 
 		// Verify timestamps
@@ -281,7 +352,7 @@ func Test_RepoUpdateTask(t *testing.T) {
 			ctx,
 			task.GetSnapshot().ID,
 			func(t *project.Task) (*project.Task, error) {
-				err := t.Edit(&newTitle, nil, nil, nil, nil)
+				err := t.Edit(project.DefaultWorkflow(), &newTitle, nil, nil, nil, nil, nil, nil)
 				if err != nil {
 					return nil, err
 				}
@@ -303,7 +374,7 @@ func Test_RepoUpdateTask(t *testing.T) {
 		// Update only description
 		newDescription := "new description"
 		err = repo.UpdateTask(ctx, snap.ID, func(t *project.Task) (*project.Task, error) {
-			err := t.Edit(nil, &newDescription, nil, nil, nil)
+			err := t.Edit(project.DefaultWorkflow(), nil, &newDescription, nil, nil, nil, nil, nil)
 			if err != nil {
 				return nil, err
 			}
@@ -319,4 +390,512 @@ func Test_RepoUpdateTask(t *testing.T) {
 		require.Nil(t, snap.DueDate)
 		require.Nil(t, snap.Assignee)
 	})
+
+	t.Run("moves into a column at its WIP limit run one at a time", func(t *testing.T) {
+		boardTasks, err := repo.BoardTasks(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		inProgress := lo.CountBy(boardTasks, func(t project.Task) bool {
+			return t.GetSnapshot().Status == project.TaskStatusInProgress
+		})
+
+		board, err := project.NewBoard(project.DefaultBoardID, "Default")
+		require.NoError(t, err)
+		limit := project.WIPLimit{Board: inProgress + 1}
+		require.NoError(t, board.SetWIPLimit(project.TaskStatusInProgress, limit))
+
+		first := createTaskWithID(t, "first", nil, nil, nil)
+		second := createTaskWithID(t, "second", nil, nil, nil)
+		require.NoError(t, repo.Create(ctx, first))
+		require.NoError(t, repo.Create(ctx, second))
+
+		move := func(t *project.Task) (*project.Task, error) {
+			if err := t.ChangeStatus(project.DefaultWorkflow(), project.TaskStatusInProgress); err != nil {
+				return nil, err
+			}
+
+			boardTasks, err := repo.BoardTasks(ctx, project.DefaultBoardID)
+			if err != nil {
+				return nil, err
+			}
+			// Leaves time for the other move to count the same tasks if it isn't waiting
+			time.Sleep(100 * time.Millisecond)
+			return t, board.CheckWIPLimit(t, boardTasks)
+		}
+
+		errs := make([]error, 2)
+		var wg sync.WaitGroup
+		for i, task := range []*project.Task{first, second} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = repo.UpdateTask(ctx, task.GetSnapshot().ID, move)
+			}()
+		}
+		wg.Wait()
+
+		failed := lo.Filter(errs, func(err error, _ int) bool { return err != nil })
+		require.Len(t, failed, 1)
+		require.ErrorIs(t, failed[0], project.ErrWIPLimitExceeded)
+	})
+}
+
+func Test_RepoPriorityAndStoryPoints(t *testing.T) {
+	ctx := context.Background()
+	repo, cleanup := setupPostgresRepo(ctx, t)
+	defer cleanup()
+
+	task := createTaskWithID(t, "estimated task", nil, nil, nil)
+	require.NoError(t, repo.Create(ctx, task))
+
+	priority := project.TaskPriorityP1
+	storyPoints := 3
+	err := repo.UpdateTask(
+		ctx,
+		task.GetSnapshot().ID,
+		func(t *project.Task) (*project.Task, error) {
+			err := t.Edit(project.DefaultWorkflow(), nil, nil, nil, nil, nil, &priority, &storyPoints)
+			return t, err
+		},
+	)
+	require.NoError(t, err)
+
+	taskFromDB, err := repo.GetByID(ctx, task.GetSnapshot().ID)
+	require.NoError(t, err)
+	require.Equal(t, &priority, taskFromDB.GetSnapshot().Priority)
+	require.Equal(t, &storyPoints, taskFromDB.GetSnapshot().StoryPoints)
+}
+
+func Test_RepoSubtasks(t *testing.T) {
+	ctx := context.Background()
+	repo, cleanup := setupPostgresRepo(ctx, t)
+	defer cleanup()
+
+	parent := createTaskWithID(t, "parent", nil, nil, nil)
+	require.NoError(t, repo.Create(ctx, parent))
+
+	child := createTaskWithID(t, "child", nil, nil, nil)
+	require.NoError(t, parent.AddSubtask(child))
+	require.NoError(t, repo.Create(ctx, child))
+
+	parentFromDB, err := repo.GetByID(ctx, parent.GetSnapshot().ID)
+	require.NoError(t, err)
+	require.Equal(t, []project.TaskID{child.GetSnapshot().ID}, parentFromDB.GetSnapshot().ChildIDs)
+
+	err = repo.UpdateTask(
+		ctx,
+		child.GetSnapshot().ID,
+		func(t *project.Task) (*project.Task, error) {
+			return t, parentFromDB.RemoveSubtask(t)
+		},
+	)
+	require.NoError(t, err)
+
+	childFromDB, err := repo.GetByID(ctx, child.GetSnapshot().ID)
+	require.NoError(t, err)
+	require.Nil(t, childFromDB.GetSnapshot().ParentID)
+
+	parentFromDB, err = repo.GetByID(ctx, parent.GetSnapshot().ID)
+	require.NoError(t, err)
+	require.Empty(t, parentFromDB.GetSnapshot().ChildIDs)
+}
+
+func Test_RepoDependencies(t *testing.T) {
+	ctx := context.Background()
+	repo, cleanup := setupPostgresRepo(ctx, t)
+	defer cleanup()
+
+	blocker := createTaskWithID(t, "blocker", nil, nil, nil)
+	require.NoError(t, repo.Create(ctx, blocker))
+
+	blocked := createTaskWithID(t, "blocked", nil, nil, nil)
+	require.NoError(t, repo.Create(ctx, blocked))
+
+	err := repo.UpdateTask(
+		ctx,
+		blocked.GetSnapshot().ID,
+		func(t *project.Task) (*project.Task, error) {
+			return t, t.AddBlocker(blocker, nil)
+		},
+	)
+	require.NoError(t, err)
+
+	blockedFromDB, err := repo.GetByID(ctx, blocked.GetSnapshot().ID)
+	require.NoError(t, err)
+	require.Equal(t, []project.TaskID{blocker.GetSnapshot().ID}, blockedFromDB.GetSnapshot().BlockedBy)
+	require.True(t, blockedFromDB.IsBlocked())
+
+	blockerFromDB, err := repo.GetByID(ctx, blocker.GetSnapshot().ID)
+	require.NoError(t, err)
+	require.Equal(t, []project.TaskID{blocked.GetSnapshot().ID}, blockerFromDB.GetSnapshot().Blocks)
+
+	err = repo.UpdateTask(
+		ctx,
+		blocked.GetSnapshot().ID,
+		func(t *project.Task) (*project.Task, error) {
+			return t, t.RemoveBlocker(blocker.GetSnapshot().ID)
+		},
+	)
+	require.NoError(t, err)
+
+	blockedFromDB, err = repo.GetByID(ctx, blocked.GetSnapshot().ID)
+	require.NoError(t, err)
+	require.Empty(t, blockedFromDB.GetSnapshot().BlockedBy)
+
+	t.Run("links added at the same time can't close a cycle", func(t *testing.T) {
+		first, second := blocker.GetSnapshot().ID, blocked.GetSnapshot().ID
+		block := func(blockerID project.TaskID) func(t *project.Task) (*project.Task, error) {
+			return func(t *project.Task) (*project.Task, error) {
+				blocker, err := repo.GetByID(ctx, blockerID)
+				if err != nil {
+					return nil, err
+				}
+				boardTasks, err := repo.BoardTasks(ctx, t.BoardID())
+				if err != nil {
+					return nil, err
+				}
+				// Leaves time for the other link to read the same graph if it isn't waiting
+				time.Sleep(100 * time.Millisecond)
+				return t, t.AddBlocker(blocker, boardTasks)
+			}
+		}
+
+		errs := make([]error, 2)
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			errs[0] = repo.UpdateTask(ctx, first, block(second))
+		}()
+		go func() {
+			defer wg.Done()
+			errs[1] = repo.UpdateTask(ctx, second, block(first))
+		}()
+		wg.Wait()
+
+		failed := lo.Filter(errs, func(err error, _ int) bool { return err != nil })
+		require.Len(t, failed, 1)
+		require.ErrorIs(t, failed[0], project.ErrDependencyCycle)
+	})
+}
+
+func Test_RepoArchiveAndDelete(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	repo, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	comments, err := NewPostgresCommentRepository(db)
+	require.NoError(t, err)
+
+	task := createTaskWithID(t, "old task", nil, nil, nil)
+	require.NoError(t, repo.Create(ctx, task))
+	taskID := task.GetSnapshot().ID
+
+	t.Run("archived tasks are hidden", func(t *testing.T) {
+		err := repo.UpdateTask(ctx, taskID, func(t *project.Task) (*project.Task, error) {
+			return t, t.Archive()
+		})
+		require.NoError(t, err)
+
+		boardTasks, err := repo.BoardTasks(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Empty(t, boardTasks)
+
+		boardTasks, err = repo.BoardTasksWithArchived(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Len(t, boardTasks, 1)
+
+		archived, err := repo.ArchivedTasks(ctx)
+		require.NoError(t, err)
+		require.Len(t, archived, 1)
+		require.NotNil(t, archived[0].GetSnapshot().ArchivedAt)
+
+		err = repo.UpdateTask(ctx, taskID, func(t *project.Task) (*project.Task, error) {
+			return t, t.Restore()
+		})
+		require.NoError(t, err)
+
+		boardTasks, err = repo.BoardTasks(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Len(t, boardTasks, 1)
+	})
+
+	t.Run("delete removes the task with its files and comments", func(t *testing.T) {
+		file, err := project.NewFile("notes.txt", 10)
+		require.NoError(t, err)
+		require.NoError(t, repo.AddFiles(ctx, taskID, []project.File{file}))
+
+		commentID, err := project.NewCommentID()
+		require.NoError(t, err)
+		comment, err := project.NewComment(commentID, taskID, nil, "John", "done?", nil)
+		require.NoError(t, err)
+		require.NoError(t, comments.Create(ctx, comment))
+
+		require.NoError(t, repo.Delete(ctx, taskID))
+
+		_, err = repo.GetByID(ctx, taskID)
+		require.Error(t, err)
+		require.Zero(t, db.File.Query().CountX(ctx))
+		require.Zero(t, db.Comment.Query().CountX(ctx))
+		require.Zero(t, db.Activity.Query().CountX(ctx))
+	})
+}
+
+func Test_RepoRanks(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	repo, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	t.Run("persists the rank", func(t *testing.T) {
+		task := createTaskWithID(t, "ranked task", nil, nil, nil)
+		require.NoError(t, repo.Create(ctx, task))
+
+		err := repo.UpdateTask(ctx, task.GetSnapshot().ID, func(t *project.Task) (*project.Task, error) {
+			return t, t.Move(project.DefaultWorkflow(), project.TaskStatusInProgress, nil, nil)
+		})
+		require.NoError(t, err)
+
+		fromDB, err := repo.GetByID(ctx, task.GetSnapshot().ID)
+		require.NoError(t, err)
+		require.Equal(t, project.TaskStatusInProgress, fromDB.GetSnapshot().Status)
+		require.Equal(t, project.Rank("i"), fromDB.Rank())
+	})
+
+	t.Run("migrates unranked tasks to the bottom of their column", func(t *testing.T) {
+		var legacyIDs []string
+		for i := range 2 {
+			id, err := project.NewTaskID()
+			require.NoError(t, err)
+			_, err = db.Task.Create().
+				SetID(string(id)).
+				SetTitle("legacy task").
+				SetBoardID(string(project.DefaultBoardID)).
+				SetStatus(string(project.TaskStatusInProgress)).
+				SetCreatedAt(time.Now().Add(time.Duration(i) * time.Minute)).
+				Save(ctx)
+			require.NoError(t, err)
+			legacyIDs = append(legacyIDs, string(id))
+		}
+
+		require.NoError(t, repo.MigrateRanks(ctx))
+
+		first, err := repo.GetByID(ctx, project.TaskID(legacyIDs[0]))
+		require.NoError(t, err)
+		second, err := repo.GetByID(ctx, project.TaskID(legacyIDs[1]))
+		require.NoError(t, err)
+
+		require.Greater(t, first.Rank(), project.Rank("i"))
+		require.Greater(t, second.Rank(), first.Rank())
+	})
+}
+
+func Test_RepoChecklist(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	repo, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	task := createTaskWithID(t, "task with a checklist", nil, nil, nil)
+	require.NoError(t, task.AddChecklistItem("item-1", "Write the tests"))
+	require.NoError(t, repo.Create(ctx, task))
+	taskID := task.GetSnapshot().ID
+
+	t.Run("persists the checklist in order", func(t *testing.T) {
+		err := repo.UpdateTask(ctx, taskID, func(t *project.Task) (*project.Task, error) {
+			if err := t.AddChecklistItem("item-2", "Update the docs"); err != nil {
+				return nil, err
+			}
+			if err := t.MoveChecklistItem("item-2", 0); err != nil {
+				return nil, err
+			}
+			return t, t.ToggleChecklistItem("item-1", nil)
+		})
+		require.NoError(t, err)
+
+		fromDB, err := repo.GetByID(ctx, taskID)
+		require.NoError(t, err)
+
+		snap := fromDB.GetSnapshot()
+		require.Equal(t, []project.ChecklistItemSnapshot{
+			{ID: "item-2", Text: "Update the docs", Done: false},
+			{ID: "item-1", Text: "Write the tests", Done: true},
+		}, snap.Checklist)
+		require.Equal(t, 50.0, *snap.ChecklistProgress)
+	})
+
+	t.Run("delete removes the checklist", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, taskID))
+		require.Zero(t, db.ChecklistItem.Query().CountX(ctx))
+	})
+}
+
+func Test_RepoStatusChanges(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	repo, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	task := createTaskWithID(t, "task that moves", nil, nil, nil)
+	require.NoError(t, repo.Create(ctx, task))
+	taskID := task.GetSnapshot().ID
+	workflow := project.DefaultWorkflow()
+
+	t.Run("appends the status changes", func(t *testing.T) {
+		for _, status := range []project.TaskStatus{
+			project.TaskStatusInProgress,
+			project.TaskStatusInReview,
+		} {
+			err := repo.UpdateTask(ctx, taskID, func(t *project.Task) (*project.Task, error) {
+				return t, t.ChangeStatus(workflow, status)
+			})
+			require.NoError(t, err)
+		}
+
+		fromDB, err := repo.GetByID(ctx, taskID)
+		require.NoError(t, err)
+
+		changes := fromDB.GetSnapshot().StatusChanges
+		require.Len(t, changes, 2)
+		require.Equal(t, project.TaskStatusPending, changes[0].From)
+		require.Equal(t, project.TaskStatusInProgress, changes[0].To)
+		require.Equal(t, project.TaskStatusInReview, changes[1].To)
+		require.Contains(t, fromDB.GetSnapshot().TimeInStatusSeconds, project.TaskStatusInReview)
+	})
+
+	t.Run("delete removes the status changes", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, taskID))
+		require.Zero(t, db.StatusChange.Query().CountX(ctx))
+	})
+}
+
+func Test_RepoListTasks(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	repo, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	john := createBoardMember(ctx, t, db, "john")
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(72 * time.Hour)
+	flow := "Review the login flow"
+
+	loginPage := createTaskWithID(t, "write login page", nil, &soon, []project.Member{*john})
+	loginFlow := createTaskWithID(t, "review", &flow, &later, nil)
+	deploy := createTaskWithID(t, "deploy", nil, nil, nil)
+	archived := createTaskWithID(t, "archived login", nil, nil, nil)
+	for _, task := range []*project.Task{loginPage, loginFlow, deploy, archived} {
+		require.NoError(t, repo.Create(ctx, task))
+	}
+	err = repo.UpdateTask(ctx, archived.GetSnapshot().ID, func(t *project.Task) (*project.Task, error) {
+		return t, t.Archive()
+	})
+	require.NoError(t, err)
+
+	titles := func(tasks []project.Task) []string {
+		return lo.Map(tasks, func(t project.Task, _ int) string { return t.GetSnapshot().Title })
+	}
+
+	list := func(filter project.TaskFilter, sort project.TaskSort) []string {
+		tasks, err := repo.ListTasks(ctx, filter, sort, nil, 10)
+		require.NoError(t, err)
+		return titles(tasks)
+	}
+
+	// pages lists the tasks one page of one task at a time
+	pages := func(sort project.TaskSort) []string {
+		var pages []string
+		var after *project.TaskCursor
+		for {
+			page, err := repo.ListTasks(ctx, project.TaskFilter{}, sort, after, 1)
+			require.NoError(t, err)
+			if len(page) == 0 {
+				return pages
+			}
+			pages = append(pages, titles(page)...)
+			after = lo.ToPtr(project.NewTaskCursor(sort, &page[0]))
+		}
+	}
+
+	byCreation := project.TaskSort{Field: project.TaskSortCreatedAt}
+
+	t.Run("filters on text in the title or description", func(t *testing.T) {
+		filter := project.TaskFilter{Text: "LOGIN"}
+		require.Equal(t, []string{"write login page", "review"}, list(filter, byCreation))
+	})
+
+	t.Run("filters on assignees by name", func(t *testing.T) {
+		filter := project.TaskFilter{Assignees: []string{"John"}}
+		require.Equal(t, []string{"write login page"}, list(filter, byCreation))
+	})
+
+	t.Run("filters on due dates", func(t *testing.T) {
+		filter := project.TaskFilter{DueFrom: &later, DueTo: &later}
+		require.Equal(t, []string{"review"}, list(filter, byCreation))
+	})
+
+	t.Run("pages through the sorted tasks", func(t *testing.T) {
+		for _, sort := range []project.TaskSort{
+			{Field: project.TaskSortDueDate},
+			{Field: project.TaskSortDueDate, Descending: true},
+		} {
+			expected := []string{"write login page", "review", "deploy"}
+			if sort.Descending {
+				expected = []string{"review", "write login page", "deploy"}
+			}
+			require.Equal(t, expected, pages(sort))
+		}
+	})
+
+	t.Run("filters on the board", func(t *testing.T) {
+		filter := project.TaskFilter{BoardID: lo.ToPtr(project.DefaultBoardID)}
+		require.Equal(t, []string{"write login page", "review", "deploy"}, list(filter, byCreation))
+
+		filter = project.TaskFilter{BoardID: lo.ToPtr(project.BoardID("other-board"))}
+		_, err := repo.ListTasks(ctx, filter, byCreation, nil, 10)
+		require.ErrorIs(t, err, project.ErrBoardNotFound)
+	})
+
+	t.Run("lists overdue tasks", func(t *testing.T) {
+		yesterday := time.Now().Add(-24 * time.Hour)
+		err := db.Task.UpdateOneID(string(loginPage.GetSnapshot().ID)).SetDueDate(yesterday).Exec(ctx)
+		require.NoError(t, err)
+
+		lastWeek := time.Now().Add(-7 * 24 * time.Hour)
+		filter := project.TaskFilter{DueFrom: &lastWeek, DueTo: lo.ToPtr(time.Now())}
+		require.Equal(t, []string{"write login page"}, list(filter, byCreation))
+	})
+
+	t.Run("pages through the tasks in the order of the board", func(t *testing.T) {
+		ranks := map[*project.Task]string{loginFlow: "a", loginPage: "b", deploy: "c"}
+		for task, rank := range ranks {
+			err := db.Task.UpdateOneID(string(task.GetSnapshot().ID)).SetRank(rank).Exec(ctx)
+			require.NoError(t, err)
+		}
+
+		// Alphabetically in_progress would come before pending
+		err := db.Task.UpdateOneID(string(loginFlow.GetSnapshot().ID)).
+			SetStatus(string(project.TaskStatusInProgress)).
+			Exec(ctx)
+		require.NoError(t, err)
+
+		byColumn := project.TaskSort{Field: project.TaskSortColumn}
+		require.Equal(t, []string{"write login page", "deploy", "review"}, pages(byColumn))
+
+		byColumn.Descending = true
+		require.Equal(t, []string{"review", "deploy", "write login page"}, pages(byColumn))
+
+		byStatus := project.TaskSort{Field: project.TaskSortStatus}
+		require.Equal(t, "review", pages(byStatus)[2])
+	})
 }
@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/file"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/tasktemplate"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+)
+
+type PostgresTaskTemplateRepository struct {
+	client *ent.Client
+}
+
+var _ project.TaskTemplateRepository = &PostgresTaskTemplateRepository{}
+
+func NewPostgresTaskTemplateRepository(
+	client *ent.Client,
+) (*PostgresTaskTemplateRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresTaskTemplateRepository{client: client}, nil
+}
+
+func (r *PostgresTaskTemplateRepository) Create(
+	ctx context.Context,
+	template *project.TaskTemplate,
+) error {
+	snap := template.GetSnapshot()
+
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		entFiles := make([]*ent.File, len(snap.Files))
+		for i, f := range snap.Files {
+			fileSnap := f.GetSnapshot()
+			entFile, err := tx.File.Create().
+				SetID(fileSnap.ID).
+				SetName(fileSnap.Name).
+				SetSize(fileSnap.Size).
+				SetMimeType(fileSnap.MimeType).
+				SetUploadedAt(fileSnap.UploadedAt).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("create file: %w", err)
+			}
+			entFiles[i] = entFile
+		}
+
+		_, err := tx.TaskTemplate.Create().
+			SetID(string(snap.ID)).
+			SetBoardID(string(snap.BoardID)).
+			SetTitle(snap.Title).
+			SetNillableDescription(snap.Description).
+			SetLabelIds(lo.Map(snap.LabelIDs, func(id project.LabelID, _ int) string { return string(id) })).
+			SetNillableDefaultAssigneeID((*string)(snap.DefaultAssigneeID)).
+			SetSubtasks(snap.Subtasks).
+			AddFiles(entFiles...).
+			SetCreatedAt(snap.CreatedAt).
+			SetUpdatedAt(snap.UpdatedAt).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create template: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *PostgresTaskTemplateRepository) GetByID(
+	ctx context.Context,
+	id project.TaskTemplateID,
+) (*project.TaskTemplate, error) {
+	t, err := r.client.TaskTemplate.Query().
+		Where(tasktemplate.IDEQ(string(id))).
+		WithFiles().
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", project.ErrTemplateNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return project.UnmarshalTaskTemplateFromDB(t), nil
+}
+
+func (r *PostgresTaskTemplateRepository) Delete(
+	ctx context.Context,
+	id project.TaskTemplateID,
+) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		_, err := tx.File.Delete().
+			Where(file.HasTemplateWith(tasktemplate.IDEQ(string(id)))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("delete files: %w", err)
+		}
+
+		if err := tx.TaskTemplate.DeleteOneID(string(id)).Exec(ctx); err != nil {
+			return fmt.Errorf("delete template: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *PostgresTaskTemplateRepository) BoardTemplates(
+	ctx context.Context,
+	boardID project.BoardID,
+) ([]project.TaskTemplate, error) {
+	entTemplates, err := r.client.TaskTemplate.Query().
+		Where(tasktemplate.BoardIDEQ(string(boardID))).
+		WithFiles().
+		Order(ent.Asc(tasktemplate.FieldTitle)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query board templates: %w", err)
+	}
+
+	templates := make([]project.TaskTemplate, len(entTemplates))
+	for i, t := range entTemplates {
+		templates[i] = *project.UnmarshalTaskTemplateFromDB(t)
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoTaskTemplates(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	templates, err := NewPostgresTaskTemplateRepository(db)
+	require.NoError(t, err)
+
+	id, err := project.NewTaskTemplateID()
+	require.NoError(t, err)
+	template, err := project.NewTaskTemplate(
+		id,
+		project.DefaultBoardID,
+		"Release checklist",
+		lo.ToPtr("Steps to ship a release"),
+		nil,
+		nil,
+		[]string{"Tag the release", "Write the changelog"},
+	)
+	require.NoError(t, err)
+
+	file, err := project.NewFile("checklist.md", 42)
+	require.NoError(t, err)
+	template.AddFile(file)
+
+	require.NoError(t, templates.Create(ctx, template))
+
+	t.Run("persists the template with its files", func(t *testing.T) {
+		templateFromDB, err := templates.GetByID(ctx, id)
+		require.NoError(t, err)
+
+		snap := templateFromDB.GetSnapshot()
+		require.Equal(t, "Release checklist", snap.Title)
+		require.Equal(t, []string{"Tag the release", "Write the changelog"}, snap.Subtasks)
+		require.Len(t, snap.Files, 1)
+		require.Equal(t, "checklist.md", snap.Files[0].GetSnapshot().Name)
+	})
+
+	t.Run("lists the board's templates", func(t *testing.T) {
+		boardTemplates, err := templates.BoardTemplates(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Len(t, boardTemplates, 1)
+		require.Equal(t, id, boardTemplates[0].ID())
+	})
+
+	t.Run("deletes the template", func(t *testing.T) {
+		require.NoError(t, templates.Delete(ctx, id))
+
+		boardTemplates, err := templates.BoardTemplates(ctx, project.DefaultBoardID)
+		require.NoError(t, err)
+		require.Empty(t, boardTemplates)
+	})
+}
@@ -0,0 +1,154 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/timer"
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent/worklog"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type PostgresWorklogRepository struct {
+	client *ent.Client
+}
+
+var _ project.WorklogRepository = &PostgresWorklogRepository{}
+
+func NewPostgresWorklogRepository(client *ent.Client) (*PostgresWorklogRepository, error) {
+	if client == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	return &PostgresWorklogRepository{client: client}, nil
+}
+
+func (r *PostgresWorklogRepository) Create(ctx context.Context, w *project.Worklog) error {
+	return createWorklog(ctx, r.client.Worklog, w)
+}
+
+func createWorklog(ctx context.Context, client *ent.WorklogClient, w *project.Worklog) error {
+	snap := w.GetSnapshot()
+
+	_, err := client.Create().
+		SetID(string(snap.ID)).
+		SetTaskID(string(snap.TaskID)).
+		SetMemberID(string(snap.MemberID)).
+		SetDurationMinutes(snap.DurationMinutes).
+		SetDate(snap.Date).
+		SetNillableNote(snap.Note).
+		SetCreatedAt(snap.CreatedAt).
+		Save(ctx)
+
+	return err
+}
+
+func (r *PostgresWorklogRepository) TaskWorklogs(
+	ctx context.Context,
+	taskID project.TaskID,
+) ([]project.Worklog, error) {
+	entWorklogs, err := r.client.Worklog.Query().
+		Where(worklog.TaskIDEQ(string(taskID))).
+		Order(ent.Asc(worklog.FieldDate), ent.Asc(worklog.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query task worklogs: %w", err)
+	}
+
+	return unmarshalWorklogs(entWorklogs), nil
+}
+
+func (r *PostgresWorklogRepository) MemberWorklogs(
+	ctx context.Context,
+	memberID project.MemberID,
+	from, to time.Time,
+) ([]project.Worklog, error) {
+	entWorklogs, err := r.client.Worklog.Query().
+		Where(
+			worklog.MemberIDEQ(string(memberID)),
+			worklog.DateGTE(project.Day(from)),
+			worklog.DateLTE(project.Day(to)),
+		).
+		Order(ent.Asc(worklog.FieldDate), ent.Asc(worklog.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query member worklogs: %w", err)
+	}
+
+	return unmarshalWorklogs(entWorklogs), nil
+}
+
+func (r *PostgresWorklogRepository) StartTimer(ctx context.Context, t *project.Timer) error {
+	snap := t.GetSnapshot()
+
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		running, err := tx.Timer.Query().
+			Where(timer.MemberIDEQ(string(snap.MemberID))).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("check running timer: %w", err)
+		}
+		if running {
+			return fmt.Errorf("%w: %s", project.ErrTimerAlreadyRunning, snap.MemberID)
+		}
+
+		_, err = tx.Timer.Create().
+			SetTaskID(string(snap.TaskID)).
+			SetMemberID(string(snap.MemberID)).
+			SetStartedAt(snap.StartedAt).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			// Another timer was started at the same time
+			return fmt.Errorf("%w: %s", project.ErrTimerAlreadyRunning, snap.MemberID)
+		}
+		if err != nil {
+			return fmt.Errorf("start timer: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *PostgresWorklogRepository) StopTimer(
+	ctx context.Context,
+	memberID project.MemberID,
+	stopFn func(t *project.Timer) (*project.Worklog, error),
+) error {
+	return WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		running, err := tx.Timer.Query().
+			Where(timer.MemberIDEQ(string(memberID))).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %s", project.ErrNoRunningTimer, memberID)
+		}
+		if err != nil {
+			return fmt.Errorf("query running timer: %w", err)
+		}
+
+		logged, err := stopFn(project.UnmarshalTimerFromDB(running))
+		if err != nil {
+			return fmt.Errorf("stop function: %w", err)
+		}
+
+		if err := tx.Timer.DeleteOne(running).Exec(ctx); err != nil {
+			return fmt.Errorf("delete timer: %w", err)
+		}
+
+		if err := createWorklog(ctx, tx.Worklog, logged); err != nil {
+			return fmt.Errorf("create worklog: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func unmarshalWorklogs(entWorklogs []*ent.Worklog) []project.Worklog {
+	worklogs := make([]project.Worklog, len(entWorklogs))
+	for i, w := range entWorklogs {
+		worklogs[i] = *project.UnmarshalWorklogFromDB(w)
+	}
+	return worklogs
+}
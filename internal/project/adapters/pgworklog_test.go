@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoWorklogs(t *testing.T) {
+	ctx := context.Background()
+	db, cleanup := setupPostgres(ctx, t)
+	defer cleanup()
+
+	worklogs, err := NewPostgresWorklogRepository(db)
+	require.NoError(t, err)
+
+	tasks, err := NewPostgresTaskRepository(db)
+	require.NoError(t, err)
+
+	john := createBoardMember(ctx, t, db, "John")
+	task := createTaskWithID(t, "tracked task", nil, nil, nil)
+	require.NoError(t, tasks.Create(ctx, task))
+	taskID := task.GetSnapshot().ID
+
+	today := project.Day(time.Now())
+	logWork := func(t *testing.T, date time.Time, duration time.Duration) {
+		id, err := project.NewWorklogID()
+		require.NoError(t, err)
+		worklog, err := project.NewWorklog(id, task, *john, duration, date, nil)
+		require.NoError(t, err)
+		require.NoError(t, worklogs.Create(ctx, worklog))
+	}
+
+	logWork(t, today, time.Hour)
+	logWork(t, today.AddDate(0, 0, -7), 30*time.Minute)
+
+	t.Run("sums the time spent on the task", func(t *testing.T) {
+		taskFromDB, err := tasks.GetByID(ctx, taskID)
+		require.NoError(t, err)
+		require.Equal(t, 90, taskFromDB.GetSnapshot().TimeSpentMinutes)
+
+		taskWorklogs, err := worklogs.TaskWorklogs(ctx, taskID)
+		require.NoError(t, err)
+		require.Len(t, taskWorklogs, 2)
+		require.Equal(t, today.AddDate(0, 0, -7), taskWorklogs[0].GetSnapshot().Date)
+	})
+
+	t.Run("lists the member's worklogs in a date range", func(t *testing.T) {
+		memberWorklogs, err := worklogs.MemberWorklogs(ctx, john.ID(), today.AddDate(0, 0, -1), today)
+		require.NoError(t, err)
+		require.Len(t, memberWorklogs, 1)
+		require.Equal(t, 60, memberWorklogs[0].GetSnapshot().DurationMinutes)
+	})
+
+	t.Run("a member runs one timer at a time", func(t *testing.T) {
+		timer, err := project.StartTimer(task, *john)
+		require.NoError(t, err)
+		require.NoError(t, worklogs.StartTimer(ctx, timer))
+		require.ErrorIs(t, worklogs.StartTimer(ctx, timer), project.ErrTimerAlreadyRunning)
+
+		id, err := project.NewWorklogID()
+		require.NoError(t, err)
+		err = worklogs.StopTimer(ctx, john.ID(), func(t *project.Timer) (*project.Worklog, error) {
+			return t.Stop(id, task, *john, nil)
+		})
+		require.NoError(t, err)
+
+		err = worklogs.StopTimer(ctx, john.ID(), func(t *project.Timer) (*project.Worklog, error) {
+			return t.Stop(id, task, *john, nil)
+		})
+		require.ErrorIs(t, err, project.ErrNoRunningTimer)
+
+		taskWorklogs, err := worklogs.TaskWorklogs(ctx, taskID)
+		require.NoError(t, err)
+		require.Len(t, taskWorklogs, 3)
+	})
+
+	t.Run("delete removes the worklogs", func(t *testing.T) {
+		require.NoError(t, tasks.Delete(ctx, taskID))
+		require.Zero(t, db.Worklog.Query().CountX(ctx))
+	})
+}
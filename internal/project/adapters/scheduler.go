@@ -0,0 +1,39 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project/app"
+	"github.com/DeluxeOwl/cogniboard/internal/project/app/commands"
+)
+
+// Scheduler is an input adapter like the http api, it runs the background jobs of the app in the server process
+type Scheduler struct {
+	app      *app.Application
+	interval time.Duration
+}
+
+func NewScheduler(app *app.Application, interval time.Duration) *Scheduler {
+	return &Scheduler{app: app, interval: interval}
+}
+
+// Run creates the tasks of the due recurring tasks right away, then every interval until ctx is done
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		// Errors are logged by the command's decorators, the recurring tasks that failed
+		// are still due so they're retried on the next tick
+		_ = s.app.Commands.MaterializeRecurringTasks.Handle(ctx, commands.MaterializeRecurringTasks{
+			Now: time.Now(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -17,14 +17,81 @@ type Application struct {
 }
 
 type Commands struct {
-	CreateTask        commands.CreateTaskHandler
-	ChangeTaskStatus  commands.ChangeTaskStatusHandler
-	EditTask          commands.EditTaskHandler
-	AttachFilesToTask commands.AttachFilesToTaskHandler
+	CreateTask          commands.CreateTaskHandler
+	ChangeTaskStatus    commands.ChangeTaskStatusHandler
+	EditTask            commands.EditTaskHandler
+	AttachFilesToTask   commands.AttachFilesToTaskHandler
+	CreateBoard         commands.CreateBoardHandler
+	RenameBoard         commands.RenameBoardHandler
+	ArchiveBoard        commands.ArchiveBoardHandler
+	ChangeBoardWorkflow commands.ChangeBoardWorkflowHandler
+	SetWIPLimit         commands.SetWIPLimitHandler
+	AddSubtask          commands.AddSubtaskHandler
+	RemoveSubtask       commands.RemoveSubtaskHandler
+	AddDependency       commands.AddTaskDependencyHandler
+	RemoveDependency    commands.RemoveTaskDependencyHandler
+	CreateLabel         commands.CreateLabelHandler
+	AddLabel            commands.AddLabelHandler
+	RemoveLabel         commands.RemoveLabelHandler
+	CreateSprint        commands.CreateSprintHandler
+	StartSprint         commands.StartSprintHandler
+	CloseSprint         commands.CloseSprintHandler
+	AddTaskToSprint     commands.AddTaskToSprintHandler
+	RemoveFromSprint    commands.RemoveTaskFromSprintHandler
+	AddComment          commands.AddCommentHandler
+	EditComment         commands.EditCommentHandler
+	DeleteComment       commands.DeleteCommentHandler
+	CreateMember        commands.CreateMemberHandler
+	AddAssignee         commands.AddAssigneeHandler
+	RemoveAssignee      commands.RemoveAssigneeHandler
+	AddWatcher          commands.AddWatcherHandler
+	RemoveWatcher       commands.RemoveWatcherHandler
+	ArchiveTask         commands.ArchiveTaskHandler
+	RestoreTask         commands.RestoreTaskHandler
+	DeleteTask          commands.DeleteTaskHandler
+	MoveTask            commands.MoveTaskHandler
+	CreateRecurringTask commands.CreateRecurringTaskHandler
+	DeleteRecurringTask commands.DeleteRecurringTaskHandler
+	// Run by the scheduler, creates the tasks of the recurring tasks that are due
+	MaterializeRecurringTasks commands.MaterializeRecurringTasksHandler
+	CreateTaskTemplate        commands.CreateTaskTemplateHandler
+	DeleteTaskTemplate        commands.DeleteTaskTemplateHandler
+	CreateTaskFromTemplate    commands.CreateTaskFromTemplateHandler
+	CloneTask                 commands.CloneTaskHandler
+	AddChecklistItem          commands.AddChecklistItemHandler
+	ToggleChecklistItem       commands.ToggleChecklistItemHandler
+	MoveChecklistItem         commands.MoveChecklistItemHandler
+	RemoveChecklistItem       commands.RemoveChecklistItemHandler
+	CreateCustomField         commands.CreateCustomFieldHandler
+	SetCustomFieldValue       commands.SetCustomFieldValueHandler
+	LogWork                   commands.LogWorkHandler
+	StartTimer                commands.StartTimerHandler
+	StopTimer                 commands.StopTimerHandler
+	CreateEpic                commands.CreateEpicHandler
+	AddTaskToEpic             commands.AddTaskToEpicHandler
+	RemoveFromEpic            commands.RemoveTaskFromEpicHandler
 }
 
 type Queries struct {
-	AllTasks queries.AllTasksHandler
+	GetTask           queries.GetTaskHandler
+	ListTasks         queries.ListTasksHandler
+	BoardTasks        queries.BoardTasksHandler
+	BoardCriticalPath queries.BoardCriticalPathHandler
+	AllBoards         queries.AllBoardsHandler
+	BoardLabels       queries.BoardLabelsHandler
+	BoardSprints      queries.BoardSprintsHandler
+	CurrentSprint     queries.CurrentSprintHandler
+	TaskComments      queries.TaskCommentsHandler
+	TaskHistory       queries.TaskHistoryHandler
+	BoardMembers      queries.BoardMembersHandler
+	ArchivedTasks     queries.ArchivedTasksHandler
+	BoardRecurring    queries.BoardRecurringTasksHandler
+	BoardTemplates    queries.BoardTemplatesHandler
+	BoardCustomFields queries.BoardCustomFieldsHandler
+	TaskWorklogs      queries.TaskWorklogsHandler
+	MemberTimesheet   queries.MemberTimesheetHandler
+	BoardEpics        queries.BoardEpicsHandler
+	EpicProgress      queries.EpicProgressHandler
 }
 
 type Operations struct {
@@ -34,6 +101,16 @@ type Operations struct {
 // New creates a new Application instance with the provided dependencies
 func New(
 	repo project.TaskRepository,
+	boards project.BoardRepository,
+	labels project.LabelRepository,
+	sprints project.SprintRepository,
+	comments project.CommentRepository,
+	members project.MemberRepository,
+	recurring project.RecurringTaskRepository,
+	templates project.TaskTemplateRepository,
+	customFields project.CustomFieldRepository,
+	worklogs project.WorklogRepository,
+	epics project.EpicRepository,
 	logger *slog.Logger,
 	fileStorage project.FileStorage,
 	chatService operations.ChatService,
@@ -43,6 +120,36 @@ func New(
 	if repo == nil {
 		return nil, errors.New("repo cannot be nil")
 	}
+	if boards == nil {
+		return nil, errors.New("boards cannot be nil")
+	}
+	if labels == nil {
+		return nil, errors.New("labels cannot be nil")
+	}
+	if sprints == nil {
+		return nil, errors.New("sprints cannot be nil")
+	}
+	if comments == nil {
+		return nil, errors.New("comments cannot be nil")
+	}
+	if members == nil {
+		return nil, errors.New("members cannot be nil")
+	}
+	if recurring == nil {
+		return nil, errors.New("recurring cannot be nil")
+	}
+	if templates == nil {
+		return nil, errors.New("templates cannot be nil")
+	}
+	if customFields == nil {
+		return nil, errors.New("custom fields cannot be nil")
+	}
+	if worklogs == nil {
+		return nil, errors.New("worklogs cannot be nil")
+	}
+	if epics == nil {
+		return nil, errors.New("epics cannot be nil")
+	}
 	if logger == nil {
 		return nil, errors.New("logger cannot be nil")
 	}
@@ -59,26 +166,189 @@ func New(
 		return nil, errors.New("img describer cannot be nil")
 	}
 
+	createTask := commands.NewCreateTaskHandler(
+		repo,
+		boards,
+		labels,
+		members,
+		customFields,
+		logger,
+	)
+
+	attachFiles := commands.NewAttachFilesToTaskHandler(
+		repo,
+		logger,
+		fileStorage,
+		embeddings,
+		imageDescriber,
+	)
+
 	return &Application{
 		Commands: Commands{
-			CreateTask:       commands.NewCreateTaskHandler(repo, logger),
-			ChangeTaskStatus: commands.NewChangeStatusHandler(repo, logger),
-			EditTask:         commands.NewEditTaskHandler(repo, logger),
-			AttachFilesToTask: commands.NewAttachFilesToTaskHandler(
+			CreateTask:       createTask,
+			ChangeTaskStatus: commands.NewChangeStatusHandler(repo, boards, members, logger),
+			EditTask: commands.NewEditTaskHandler(
+				repo,
+				boards,
+				labels,
+				members,
+				logger,
+			),
+			AttachFilesToTask: attachFiles,
+			CreateBoard:       commands.NewCreateBoardHandler(boards, logger),
+			RenameBoard:       commands.NewRenameBoardHandler(boards, logger),
+			ArchiveBoard:      commands.NewArchiveBoardHandler(boards, logger),
+			ChangeBoardWorkflow: commands.NewChangeBoardWorkflowHandler(
+				boards,
+				repo,
+				logger,
+			),
+			SetWIPLimit:   commands.NewSetWIPLimitHandler(boards, logger),
+			AddSubtask:    commands.NewAddSubtaskHandler(repo, logger),
+			RemoveSubtask: commands.NewRemoveSubtaskHandler(repo, logger),
+			AddDependency: commands.NewAddTaskDependencyHandler(repo, logger),
+			RemoveDependency: commands.NewRemoveTaskDependencyHandler(
+				repo,
+				logger,
+			),
+			CreateLabel:  commands.NewCreateLabelHandler(labels, boards, logger),
+			AddLabel:     commands.NewAddLabelHandler(repo, labels, logger),
+			RemoveLabel:  commands.NewRemoveLabelHandler(repo, logger),
+			CreateSprint: commands.NewCreateSprintHandler(sprints, boards, logger),
+			StartSprint:  commands.NewStartSprintHandler(sprints, logger),
+			CloseSprint:  commands.NewCloseSprintHandler(sprints, repo, logger),
+			AddTaskToSprint: commands.NewAddTaskToSprintHandler(
+				sprints,
+				repo,
+				logger,
+			),
+			RemoveFromSprint: commands.NewRemoveTaskFromSprintHandler(repo, logger),
+			AddComment: commands.NewAddCommentHandler(
+				comments,
+				repo,
+				members,
+				logger,
+			),
+			EditComment: commands.NewEditCommentHandler(
+				comments,
+				repo,
+				members,
+				logger,
+			),
+			DeleteComment: commands.NewDeleteCommentHandler(comments, logger),
+			CreateMember:  commands.NewCreateMemberHandler(members, boards, logger),
+			AddAssignee: commands.NewAddAssigneeHandler(
+				repo,
+				boards,
+				members,
+				logger,
+			),
+			RemoveAssignee: commands.NewRemoveAssigneeHandler(repo, members, logger),
+			AddWatcher:     commands.NewAddWatcherHandler(repo, members, logger),
+			RemoveWatcher:  commands.NewRemoveWatcherHandler(repo, members, logger),
+			ArchiveTask:    commands.NewArchiveTaskHandler(repo, logger),
+			RestoreTask:    commands.NewRestoreTaskHandler(repo, logger),
+			DeleteTask: commands.NewDeleteTaskHandler(
 				repo,
 				logger,
 				fileStorage,
 				embeddings,
-				imageDescriber,
 			),
+			MoveTask: commands.NewMoveTaskHandler(repo, boards, members, logger),
+			CreateRecurringTask: commands.NewCreateRecurringTaskHandler(
+				recurring,
+				boards,
+				labels,
+				members,
+				logger,
+			),
+			DeleteRecurringTask: commands.NewDeleteRecurringTaskHandler(recurring, logger),
+			MaterializeRecurringTasks: commands.NewMaterializeRecurringTasksHandler(
+				recurring,
+				createTask,
+				logger,
+			),
+			CreateTaskTemplate: commands.NewCreateTaskTemplateHandler(
+				templates,
+				boards,
+				labels,
+				members,
+				logger,
+				fileStorage,
+			),
+			DeleteTaskTemplate: commands.NewDeleteTaskTemplateHandler(
+				templates,
+				logger,
+				fileStorage,
+			),
+			CreateTaskFromTemplate: commands.NewCreateTaskFromTemplateHandler(
+				templates,
+				createTask,
+				attachFiles,
+				logger,
+				fileStorage,
+			),
+			CloneTask: commands.NewCloneTaskHandler(
+				repo,
+				createTask,
+				attachFiles,
+				logger,
+				fileStorage,
+			),
+			AddChecklistItem:    commands.NewAddChecklistItemHandler(repo, logger),
+			ToggleChecklistItem: commands.NewToggleChecklistItemHandler(repo, logger),
+			MoveChecklistItem:   commands.NewMoveChecklistItemHandler(repo, logger),
+			RemoveChecklistItem: commands.NewRemoveChecklistItemHandler(repo, logger),
+			CreateCustomField:   commands.NewCreateCustomFieldHandler(customFields, boards, logger),
+			SetCustomFieldValue: commands.NewSetCustomFieldValueHandler(
+				repo,
+				customFields,
+				members,
+				logger,
+			),
+			LogWork:    commands.NewLogWorkHandler(worklogs, repo, members, logger),
+			StartTimer: commands.NewStartTimerHandler(worklogs, repo, members, logger),
+			StopTimer:  commands.NewStopTimerHandler(worklogs, repo, members, logger),
+			CreateEpic: commands.NewCreateEpicHandler(epics, boards, logger),
+			AddTaskToEpic: commands.NewAddTaskToEpicHandler(
+				epics,
+				repo,
+				logger,
+			),
+			RemoveFromEpic: commands.NewRemoveTaskFromEpicHandler(repo, logger),
 		},
 		Queries: Queries{
-			AllTasks: queries.NewAllTasksHandler(repo, logger),
+			GetTask:           queries.NewGetTaskHandler(repo, repo, comments, logger),
+			ListTasks:         queries.NewListTasksHandler(repo, logger),
+			BoardTasks:        queries.NewBoardTasksHandler(repo, boards, logger),
+			BoardCriticalPath: queries.NewBoardCriticalPathHandler(repo, logger),
+			AllBoards:         queries.NewAllBoardsHandler(boards, logger),
+			BoardLabels:       queries.NewBoardLabelsHandler(labels, logger),
+			BoardSprints:      queries.NewBoardSprintsHandler(sprints, logger),
+			CurrentSprint:     queries.NewCurrentSprintHandler(sprints, repo, logger),
+			TaskComments:      queries.NewTaskCommentsHandler(comments, logger),
+			TaskHistory:       queries.NewTaskHistoryHandler(repo, logger),
+			BoardMembers:      queries.NewBoardMembersHandler(members, logger),
+			ArchivedTasks:     queries.NewArchivedTasksHandler(repo, logger),
+			BoardRecurring:    queries.NewBoardRecurringTasksHandler(recurring, logger),
+			BoardTemplates:    queries.NewBoardTemplatesHandler(templates, logger),
+			BoardCustomFields: queries.NewBoardCustomFieldsHandler(customFields, logger),
+			TaskWorklogs:      queries.NewTaskWorklogsHandler(worklogs, logger),
+			MemberTimesheet:   queries.NewMemberTimesheetHandler(worklogs, members, logger),
+			BoardEpics:        queries.NewBoardEpicsHandler(epics, logger),
+			EpicProgress:      queries.NewEpicProgressHandler(epics, repo, logger),
 		},
 		Operations: Operations{
 			ChatWithProject: operations.NewChatWithProjectHandler(
 				chatService,
 				repo,
+				boards,
+				labels,
+				sprints,
+				comments,
+				members,
+				customFields,
+				epics,
 				logger,
 				embeddings,
 			),
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type ArchiveBoard struct {
+	BoardID string
+}
+
+type ArchiveBoardHandler decorator.CommandHandler[ArchiveBoard]
+
+type archiveBoardHandler struct {
+	repo project.BoardRepository
+}
+
+func NewArchiveBoardHandler(repo project.BoardRepository, logger *slog.Logger) ArchiveBoardHandler {
+	return decorator.ApplyCommandDecorators(
+		&archiveBoardHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *archiveBoardHandler) Handle(ctx context.Context, cmd ArchiveBoard) error {
+	return h.repo.UpdateBoard(
+		ctx,
+		project.BoardID(cmd.BoardID),
+		func(b *project.Board) (*project.Board, error) {
+			if err := b.Archive(); err != nil {
+				return nil, err
+			}
+			return b, nil
+		},
+	)
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type ChangeBoardWorkflow struct {
+	BoardID     string
+	Statuses    []string
+	Transitions map[string][]string
+}
+
+type ChangeBoardWorkflowHandler decorator.CommandHandler[ChangeBoardWorkflow]
+
+type changeBoardWorkflowHandler struct {
+	boards project.BoardRepository
+	tasks  project.TaskRepository
+}
+
+func NewChangeBoardWorkflowHandler(
+	boards project.BoardRepository,
+	tasks project.TaskRepository,
+	logger *slog.Logger,
+) ChangeBoardWorkflowHandler {
+	return decorator.ApplyCommandDecorators(
+		&changeBoardWorkflowHandler{boards: boards, tasks: tasks},
+		logger,
+	)
+}
+
+func (h *changeBoardWorkflowHandler) Handle(ctx context.Context, cmd ChangeBoardWorkflow) error {
+	statuses := make([]project.TaskStatus, len(cmd.Statuses))
+	for i, status := range cmd.Statuses {
+		statuses[i] = project.TaskStatus(status)
+	}
+
+	transitions := make(map[project.TaskStatus][]project.TaskStatus, len(cmd.Transitions))
+	for from, targets := range cmd.Transitions {
+		transitions[project.TaskStatus(from)] = make([]project.TaskStatus, len(targets))
+		for i, to := range targets {
+			transitions[project.TaskStatus(from)][i] = project.TaskStatus(to)
+		}
+	}
+
+	workflow, err := project.NewWorkflow(statuses, transitions)
+	if err != nil {
+		return err
+	}
+
+	boardID := project.BoardID(cmd.BoardID)
+
+	return h.boards.UpdateBoard(ctx, boardID, func(b *project.Board) (*project.Board, error) {
+		// Tasks are read while the board is locked, none can move into a removed status meanwhile
+		tasks, err := h.tasks.BoardTasks(ctx, boardID)
+		if err != nil {
+			return nil, fmt.Errorf("get board tasks: %w", err)
+		}
+
+		statusesInUse := make([]project.TaskStatus, len(tasks))
+		for i, task := range tasks {
+			statusesInUse[i] = task.GetSnapshot().Status
+		}
+
+		if err := b.ChangeWorkflow(workflow, statusesInUse); err != nil {
+			return nil, err
+		}
+		return b, nil
+	})
+}
@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CreateBoard struct {
+	BoardID project.BoardID
+	Name    string
+}
+
+type CreateBoardHandler decorator.CommandHandler[CreateBoard]
+
+type createBoardHandler struct {
+	repo project.BoardRepository
+}
+
+func NewCreateBoardHandler(repo project.BoardRepository, logger *slog.Logger) CreateBoardHandler {
+	return decorator.ApplyCommandDecorators(
+		&createBoardHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *createBoardHandler) Handle(ctx context.Context, cmd CreateBoard) error {
+	board, err := project.NewBoard(cmd.BoardID, cmd.Name)
+	if err != nil {
+		return err
+	}
+
+	return h.repo.Create(ctx, board)
+}
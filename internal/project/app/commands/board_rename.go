@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type RenameBoard struct {
+	BoardID string
+	Name    string
+}
+
+type RenameBoardHandler decorator.CommandHandler[RenameBoard]
+
+type renameBoardHandler struct {
+	repo project.BoardRepository
+}
+
+func NewRenameBoardHandler(repo project.BoardRepository, logger *slog.Logger) RenameBoardHandler {
+	return decorator.ApplyCommandDecorators(
+		&renameBoardHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *renameBoardHandler) Handle(ctx context.Context, cmd RenameBoard) error {
+	return h.repo.UpdateBoard(
+		ctx,
+		project.BoardID(cmd.BoardID),
+		func(b *project.Board) (*project.Board, error) {
+			if err := b.Rename(cmd.Name); err != nil {
+				return nil, err
+			}
+			return b, nil
+		},
+	)
+}
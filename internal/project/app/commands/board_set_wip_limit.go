@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type SetWIPLimit struct {
+	BoardID     string
+	Status      string
+	Board       int
+	PerAssignee int
+}
+
+type SetWIPLimitHandler decorator.CommandHandler[SetWIPLimit]
+
+type setWIPLimitHandler struct {
+	boards project.BoardRepository
+}
+
+func NewSetWIPLimitHandler(boards project.BoardRepository, logger *slog.Logger) SetWIPLimitHandler {
+	return decorator.ApplyCommandDecorators(
+		&setWIPLimitHandler{boards: boards},
+		logger,
+	)
+}
+
+func (h *setWIPLimitHandler) Handle(ctx context.Context, cmd SetWIPLimit) error {
+	return h.boards.UpdateBoard(
+		ctx,
+		project.BoardID(cmd.BoardID),
+		func(b *project.Board) (*project.Board, error) {
+			err := b.SetWIPLimit(project.TaskStatus(cmd.Status), project.WIPLimit{
+				Board:       cmd.Board,
+				PerAssignee: cmd.PerAssignee,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return b, nil
+		},
+	)
+}
@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+// taskAssignees lists the members that can be @mentioned in the comments of a task
+func taskAssignees(
+	ctx context.Context,
+	repo project.TaskRepository,
+	members project.MemberRepository,
+	taskID project.TaskID,
+) ([]string, error) {
+	task, err := repo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("get task: %w", err)
+	}
+
+	boardMembers, err := members.BoardMembers(ctx, task.BoardID())
+	if err != nil {
+		return nil, fmt.Errorf("get board members: %w", err)
+	}
+
+	return project.MemberNames(boardMembers), nil
+}
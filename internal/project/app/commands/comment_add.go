@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type AddComment struct {
+	CommentID project.CommentID
+	TaskID    string
+	// The comment this one replies to, nil starts a new thread
+	ParentID *string
+	Author   string
+	Body     string
+}
+
+type AddCommentHandler decorator.CommandHandler[AddComment]
+
+type addCommentHandler struct {
+	comments project.CommentRepository
+	repo     project.TaskRepository
+	members  project.MemberRepository
+}
+
+func NewAddCommentHandler(
+	comments project.CommentRepository,
+	repo project.TaskRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) AddCommentHandler {
+	return decorator.ApplyCommandDecorators(
+		&addCommentHandler{comments: comments, repo: repo, members: members},
+		logger,
+	)
+}
+
+func (h *addCommentHandler) Handle(ctx context.Context, cmd AddComment) error {
+	taskID := project.TaskID(cmd.TaskID)
+
+	assignees, err := taskAssignees(ctx, h.repo, h.members, taskID)
+	if err != nil {
+		return err
+	}
+
+	var parent *project.Comment
+	if cmd.ParentID != nil {
+		parent, err = h.comments.GetByID(ctx, project.CommentID(*cmd.ParentID))
+		if err != nil {
+			return fmt.Errorf("get parent comment: %w", err)
+		}
+	}
+
+	comment, err := project.NewComment(
+		cmd.CommentID,
+		taskID,
+		parent,
+		cmd.Author,
+		cmd.Body,
+		assignees,
+	)
+	if err != nil {
+		return err
+	}
+
+	return h.comments.Create(ctx, comment)
+}
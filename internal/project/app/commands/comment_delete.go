@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type DeleteComment struct {
+	CommentID string
+	// Who is deleting, only the author can delete a comment
+	Author string
+}
+
+type DeleteCommentHandler decorator.CommandHandler[DeleteComment]
+
+type deleteCommentHandler struct {
+	comments project.CommentRepository
+}
+
+func NewDeleteCommentHandler(
+	comments project.CommentRepository,
+	logger *slog.Logger,
+) DeleteCommentHandler {
+	return decorator.ApplyCommandDecorators(
+		&deleteCommentHandler{comments: comments},
+		logger,
+	)
+}
+
+func (h *deleteCommentHandler) Handle(ctx context.Context, cmd DeleteComment) error {
+	return h.comments.UpdateComment(
+		ctx,
+		project.CommentID(cmd.CommentID),
+		func(c *project.Comment) (*project.Comment, error) {
+			if err := c.Delete(cmd.Author); err != nil {
+				return nil, err
+			}
+			return c, nil
+		},
+	)
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type EditComment struct {
+	CommentID string
+	// Who is editing, only the author can edit a comment
+	Author string
+	Body   string
+}
+
+type EditCommentHandler decorator.CommandHandler[EditComment]
+
+type editCommentHandler struct {
+	comments project.CommentRepository
+	repo     project.TaskRepository
+	members  project.MemberRepository
+}
+
+func NewEditCommentHandler(
+	comments project.CommentRepository,
+	repo project.TaskRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) EditCommentHandler {
+	return decorator.ApplyCommandDecorators(
+		&editCommentHandler{comments: comments, repo: repo, members: members},
+		logger,
+	)
+}
+
+func (h *editCommentHandler) Handle(ctx context.Context, cmd EditComment) error {
+	commentID := project.CommentID(cmd.CommentID)
+
+	existing, err := h.comments.GetByID(ctx, commentID)
+	if err != nil {
+		return fmt.Errorf("get comment: %w", err)
+	}
+
+	assignees, err := taskAssignees(ctx, h.repo, h.members, existing.TaskID())
+	if err != nil {
+		return err
+	}
+
+	return h.comments.UpdateComment(
+		ctx,
+		commentID,
+		func(c *project.Comment) (*project.Comment, error) {
+			if err := c.Edit(cmd.Author, cmd.Body, assignees); err != nil {
+				return nil, err
+			}
+			return c, nil
+		},
+	)
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CreateCustomField struct {
+	FieldID project.CustomFieldID
+	BoardID project.BoardID
+	Name    string
+	Type    project.CustomFieldType
+	// Values a select field can take
+	Options []string
+}
+
+type CreateCustomFieldHandler decorator.CommandHandler[CreateCustomField]
+
+type createCustomFieldHandler struct {
+	customFields project.CustomFieldRepository
+	boards       project.BoardRepository
+}
+
+func NewCreateCustomFieldHandler(
+	customFields project.CustomFieldRepository,
+	boards project.BoardRepository,
+	logger *slog.Logger,
+) CreateCustomFieldHandler {
+	return decorator.ApplyCommandDecorators(
+		&createCustomFieldHandler{customFields: customFields, boards: boards},
+		logger,
+	)
+}
+
+func (h *createCustomFieldHandler) Handle(ctx context.Context, cmd CreateCustomField) error {
+	board, err := h.boards.GetByID(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board: %w", err)
+	}
+
+	if board.IsArchived() {
+		return project.ErrBoardArchived
+	}
+
+	field, err := project.NewCustomField(cmd.FieldID, cmd.BoardID, cmd.Name, cmd.Type, cmd.Options)
+	if err != nil {
+		return err
+	}
+
+	boardFields, err := h.customFields.BoardCustomFields(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board custom fields: %w", err)
+	}
+
+	if err := field.CheckNameAvailable(boardFields); err != nil {
+		return err
+	}
+
+	return h.customFields.Create(ctx, field)
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+)
+
+// resolveCustomField finds the board's custom field referred to by ID or by name
+func resolveCustomField(
+	boardFields []project.CustomField,
+	idOrName string,
+) (*project.CustomField, error) {
+	field, err := project.FindCustomField(boardFields, idOrName)
+	if errors.Is(err, project.ErrCustomFieldNotFound) {
+		names := lo.Map(boardFields, func(f project.CustomField, _ int) string {
+			return f.GetSnapshot().Name
+		})
+		return nil, fmt.Errorf("%w (available: %s)", err, strings.Join(names, ", "))
+	}
+	return field, err
+}
+
+// setCustomFieldValues sets the task's values for the custom fields of its board, keyed by field ID or name
+func setCustomFieldValues(
+	ctx context.Context,
+	customFields project.CustomFieldRepository,
+	members project.MemberRepository,
+	task *project.Task,
+	values map[string]string,
+) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	boardFields, err := customFields.BoardCustomFields(ctx, task.BoardID())
+	if err != nil {
+		return fmt.Errorf("get board custom fields: %w", err)
+	}
+
+	boardMembers, err := members.BoardMembers(ctx, task.BoardID())
+	if err != nil {
+		return fmt.Errorf("get board members: %w", err)
+	}
+
+	// Sorted so that the first invalid field is always the same one
+	for _, idOrName := range slices.Sorted(maps.Keys(values)) {
+		field, err := resolveCustomField(boardFields, idOrName)
+		if err != nil {
+			return err
+		}
+
+		if err := task.SetCustomFieldValue(*field, lo.ToPtr(values[idOrName]), boardMembers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type AddTaskToEpic struct {
+	EpicID string
+	TaskID string
+}
+
+type AddTaskToEpicHandler decorator.CommandHandler[AddTaskToEpic]
+
+type addTaskToEpicHandler struct {
+	epics project.EpicRepository
+	repo  project.TaskRepository
+}
+
+func NewAddTaskToEpicHandler(
+	epics project.EpicRepository,
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) AddTaskToEpicHandler {
+	return decorator.ApplyCommandDecorators(
+		&addTaskToEpicHandler{epics: epics, repo: repo},
+		logger,
+	)
+}
+
+func (h *addTaskToEpicHandler) Handle(ctx context.Context, cmd AddTaskToEpic) error {
+	epic, err := h.epics.GetByID(ctx, project.EpicID(cmd.EpicID))
+	if err != nil {
+		return fmt.Errorf("get epic: %w", err)
+	}
+
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.AddToEpic(epic); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
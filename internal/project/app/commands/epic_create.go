@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CreateEpic struct {
+	EpicID      project.EpicID
+	BoardID     project.BoardID
+	Name        string
+	Description *string
+	TargetDate  time.Time
+}
+
+type CreateEpicHandler decorator.CommandHandler[CreateEpic]
+
+type createEpicHandler struct {
+	epics  project.EpicRepository
+	boards project.BoardRepository
+}
+
+func NewCreateEpicHandler(
+	epics project.EpicRepository,
+	boards project.BoardRepository,
+	logger *slog.Logger,
+) CreateEpicHandler {
+	return decorator.ApplyCommandDecorators(
+		&createEpicHandler{epics: epics, boards: boards},
+		logger,
+	)
+}
+
+func (h *createEpicHandler) Handle(ctx context.Context, cmd CreateEpic) error {
+	board, err := h.boards.GetByID(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board: %w", err)
+	}
+
+	if err := board.CanAddTasks(); err != nil {
+		return err
+	}
+
+	epic, err := project.NewEpic(
+		cmd.EpicID,
+		cmd.BoardID,
+		cmd.Name,
+		cmd.Description,
+		cmd.TargetDate,
+	)
+	if err != nil {
+		return err
+	}
+
+	return h.epics.Create(ctx, epic)
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type RemoveTaskFromEpic struct {
+	EpicID string
+	TaskID string
+}
+
+type RemoveTaskFromEpicHandler decorator.CommandHandler[RemoveTaskFromEpic]
+
+type removeTaskFromEpicHandler struct {
+	repo project.TaskRepository
+}
+
+func NewRemoveTaskFromEpicHandler(
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) RemoveTaskFromEpicHandler {
+	return decorator.ApplyCommandDecorators(
+		&removeTaskFromEpicHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *removeTaskFromEpicHandler) Handle(ctx context.Context, cmd RemoveTaskFromEpic) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.RemoveFromEpic(project.EpicID(cmd.EpicID)); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+// resolveLabels finds the board's labels referred to by ID or by name
+func resolveLabels(
+	ctx context.Context,
+	labels project.LabelRepository,
+	boardID project.BoardID,
+	idsOrNames []string,
+) ([]project.Label, error) {
+	if len(idsOrNames) == 0 {
+		return []project.Label{}, nil
+	}
+
+	boardLabels, err := labels.BoardLabels(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("get board labels: %w", err)
+	}
+
+	resolved := make([]project.Label, 0, len(idsOrNames))
+	for _, idOrName := range idsOrNames {
+		found := false
+		for _, label := range boardLabels {
+			if label.Matches(idOrName) {
+				resolved = append(resolved, label)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			names := make([]string, len(boardLabels))
+			for i, label := range boardLabels {
+				names[i] = label.GetSnapshot().Name
+			}
+			return nil, fmt.Errorf(
+				"%w: %s (available: %s)",
+				project.ErrLabelNotFound,
+				idOrName,
+				strings.Join(names, ", "),
+			)
+		}
+	}
+
+	return resolved, nil
+}
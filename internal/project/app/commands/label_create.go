@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CreateLabel struct {
+	LabelID project.LabelID
+	BoardID project.BoardID
+	Name    string
+	Color   string
+}
+
+type CreateLabelHandler decorator.CommandHandler[CreateLabel]
+
+type createLabelHandler struct {
+	labels project.LabelRepository
+	boards project.BoardRepository
+}
+
+func NewCreateLabelHandler(
+	labels project.LabelRepository,
+	boards project.BoardRepository,
+	logger *slog.Logger,
+) CreateLabelHandler {
+	return decorator.ApplyCommandDecorators(
+		&createLabelHandler{labels: labels, boards: boards},
+		logger,
+	)
+}
+
+func (h *createLabelHandler) Handle(ctx context.Context, cmd CreateLabel) error {
+	board, err := h.boards.GetByID(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board: %w", err)
+	}
+
+	if board.IsArchived() {
+		return project.ErrBoardArchived
+	}
+
+	label, err := project.NewLabel(cmd.LabelID, cmd.BoardID, cmd.Name, cmd.Color)
+	if err != nil {
+		return err
+	}
+
+	boardLabels, err := h.labels.BoardLabels(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board labels: %w", err)
+	}
+
+	if err := label.CheckNameAvailable(boardLabels); err != nil {
+		return err
+	}
+
+	return h.labels.Create(ctx, label)
+}
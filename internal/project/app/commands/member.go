@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+)
+
+// resolveMembers finds the board's members referred to by ID or by display name,
+// errUnknown is returned for the ones that aren't members of the board
+func resolveMembers(
+	ctx context.Context,
+	members project.MemberRepository,
+	boardID project.BoardID,
+	idsOrNames []string,
+	errUnknown error,
+) ([]project.Member, error) {
+	if idsOrNames == nil {
+		return nil, nil
+	}
+
+	boardMembers, err := members.BoardMembers(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("get board members: %w", err)
+	}
+
+	resolved := make([]project.Member, 0, len(idsOrNames))
+	for _, idOrName := range idsOrNames {
+		member, err := project.FindMember(boardMembers, idOrName)
+		if errors.Is(err, project.ErrMemberNotFound) {
+			return nil, fmt.Errorf(
+				"%w: %s (members: %s)",
+				errUnknown,
+				idOrName,
+				strings.Join(project.MemberNames(boardMembers), ", "),
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, *member)
+	}
+
+	return resolved, nil
+}
+
+// resolveMember finds the board's member referred to by ID or by display name
+func resolveMember(
+	ctx context.Context,
+	members project.MemberRepository,
+	boardID project.BoardID,
+	idOrName string,
+	errUnknown error,
+) (*project.Member, error) {
+	resolved, err := resolveMembers(ctx, members, boardID, []string{idOrName}, errUnknown)
+	if err != nil {
+		return nil, err
+	}
+	return &resolved[0], nil
+}
+
+// sameMembers reports whether both lists have the same members, in any order
+func sameMembers(a []project.MemberSnapshot, b []project.MemberSnapshot) bool {
+	ids := func(m project.MemberSnapshot, _ int) project.MemberID { return m.ID }
+	left, right := lo.Map(a, ids), lo.Map(b, ids)
+	return len(left) == len(right) && lo.Every(left, right)
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CreateMember struct {
+	MemberID    project.MemberID
+	BoardID     project.BoardID
+	DisplayName string
+	Email       *string
+	Role        project.MemberRole
+}
+
+type CreateMemberHandler decorator.CommandHandler[CreateMember]
+
+type createMemberHandler struct {
+	members project.MemberRepository
+	boards  project.BoardRepository
+}
+
+func NewCreateMemberHandler(
+	members project.MemberRepository,
+	boards project.BoardRepository,
+	logger *slog.Logger,
+) CreateMemberHandler {
+	return decorator.ApplyCommandDecorators(
+		&createMemberHandler{members: members, boards: boards},
+		logger,
+	)
+}
+
+func (h *createMemberHandler) Handle(ctx context.Context, cmd CreateMember) error {
+	board, err := h.boards.GetByID(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board: %w", err)
+	}
+
+	if board.IsArchived() {
+		return project.ErrBoardArchived
+	}
+
+	member, err := project.NewMember(
+		cmd.MemberID,
+		cmd.BoardID,
+		cmd.DisplayName,
+		cmd.Email,
+		cmd.Role,
+	)
+	if err != nil {
+		return err
+	}
+
+	boardMembers, err := h.members.BoardMembers(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board members: %w", err)
+	}
+
+	if err := member.CheckAvailable(boardMembers); err != nil {
+		return err
+	}
+
+	return h.members.Create(ctx, member)
+}
@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CreateRecurringTask struct {
+	ID          project.RecurringTaskID
+	BoardID     project.BoardID
+	Title       string
+	Description *string
+	// IDs or display names of members of the board
+	Assignees []string
+	// IDs or names of the board's labels
+	Labels      []string
+	Priority    *project.TaskPriority
+	StoryPoints *int
+	// RRULE, e.g. FREQ=WEEKLY;BYDAY=MO
+	Rule string
+	// When the first occurrence can be, defaults to now
+	StartsAt *time.Time
+}
+
+type CreateRecurringTaskHandler decorator.CommandHandler[CreateRecurringTask]
+
+type createRecurringTaskHandler struct {
+	recurring project.RecurringTaskRepository
+	boards    project.BoardRepository
+	labels    project.LabelRepository
+	members   project.MemberRepository
+}
+
+func NewCreateRecurringTaskHandler(
+	recurring project.RecurringTaskRepository,
+	boards project.BoardRepository,
+	labels project.LabelRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) CreateRecurringTaskHandler {
+	return decorator.ApplyCommandDecorators(
+		&createRecurringTaskHandler{
+			recurring: recurring,
+			boards:    boards,
+			labels:    labels,
+			members:   members,
+		},
+		logger,
+	)
+}
+
+func (h *createRecurringTaskHandler) Handle(ctx context.Context, cmd CreateRecurringTask) error {
+	board, err := h.boards.GetByID(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board: %w", err)
+	}
+
+	if err := board.CanAddTasks(); err != nil {
+		return err
+	}
+
+	rule, err := project.ParseRecurrenceRule(cmd.Rule)
+	if err != nil {
+		return err
+	}
+
+	assignees, err := resolveMembers(
+		ctx,
+		h.members,
+		cmd.BoardID,
+		cmd.Assignees,
+		project.ErrUnknownAssignee,
+	)
+	if err != nil {
+		return err
+	}
+
+	labels, err := resolveLabels(ctx, h.labels, cmd.BoardID, cmd.Labels)
+	if err != nil {
+		return err
+	}
+
+	startsAt := time.Now()
+	if cmd.StartsAt != nil {
+		startsAt = *cmd.StartsAt
+	}
+
+	recurring, err := project.NewRecurringTask(
+		cmd.ID,
+		cmd.BoardID,
+		cmd.Title,
+		cmd.Description,
+		assignees,
+		labels,
+		cmd.Priority,
+		cmd.StoryPoints,
+		rule,
+		startsAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return h.recurring.Create(ctx, recurring)
+}
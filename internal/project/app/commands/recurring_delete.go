@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+// DeleteRecurringTask stops creating tasks from the recurring task, the tasks already created are kept
+type DeleteRecurringTask struct {
+	ID string
+}
+
+type DeleteRecurringTaskHandler decorator.CommandHandler[DeleteRecurringTask]
+
+type deleteRecurringTaskHandler struct {
+	recurring project.RecurringTaskRepository
+}
+
+func NewDeleteRecurringTaskHandler(
+	recurring project.RecurringTaskRepository,
+	logger *slog.Logger,
+) DeleteRecurringTaskHandler {
+	return decorator.ApplyCommandDecorators(
+		&deleteRecurringTaskHandler{recurring: recurring},
+		logger,
+	)
+}
+
+func (h *deleteRecurringTaskHandler) Handle(ctx context.Context, cmd DeleteRecurringTask) error {
+	return h.recurring.Delete(ctx, project.RecurringTaskID(cmd.ID))
+}
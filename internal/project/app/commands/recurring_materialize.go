@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+)
+
+// MaterializeRecurringTasks creates the tasks of the recurring tasks that are due
+type MaterializeRecurringTasks struct {
+	Now time.Time
+}
+
+type MaterializeRecurringTasksHandler decorator.CommandHandler[MaterializeRecurringTasks]
+
+type materializeRecurringTasksHandler struct {
+	recurring  project.RecurringTaskRepository
+	createTask CreateTaskHandler
+	logger     *slog.Logger
+}
+
+func NewMaterializeRecurringTasksHandler(
+	recurring project.RecurringTaskRepository,
+	createTask CreateTaskHandler,
+	logger *slog.Logger,
+) MaterializeRecurringTasksHandler {
+	return decorator.ApplyCommandDecorators(
+		&materializeRecurringTasksHandler{
+			recurring:  recurring,
+			createTask: createTask,
+			logger:     logger,
+		},
+		logger,
+	)
+}
+
+// Handle is safe to run again after a failure or a restart: the task of an occurrence always
+// has the same ID, so an occurrence whose task exists is only marked as done
+func (h *materializeRecurringTasksHandler) Handle(
+	ctx context.Context,
+	cmd MaterializeRecurringTasks,
+) error {
+	due, err := h.recurring.DueRecurringTasks(ctx, cmd.Now)
+	if err != nil {
+		return fmt.Errorf("get due recurring tasks: %w", err)
+	}
+
+	ctx = project.WithActor(ctx, project.Actor{
+		Name:   "scheduler",
+		Source: project.ChangeSourceScheduler,
+	})
+
+	// One recurring task failing, e.g. because its assignee left the board, doesn't hold back the others
+	var errs []error
+	for i := range due {
+		if err := h.materialize(ctx, &due[i], cmd.Now); err != nil {
+			errs = append(errs, fmt.Errorf("recurring task %s: %w", due[i].ID(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h *materializeRecurringTasksHandler) materialize(
+	ctx context.Context,
+	recurring *project.RecurringTask,
+	now time.Time,
+) error {
+	occurrence, err := recurring.DueOccurrence(now)
+	if err != nil {
+		return err
+	}
+
+	snap := recurring.GetSnapshot()
+	taskID := recurring.OccurrenceTaskID(occurrence)
+	err = h.createTask.Handle(ctx, CreateTask{
+		TaskID:      taskID,
+		BoardID:     snap.BoardID,
+		Title:       snap.Title,
+		Description: snap.Description,
+		Assignees:   lo.Map(snap.AssigneeIDs, func(id project.MemberID, _ int) string { return string(id) }),
+		Labels:      lo.Map(snap.LabelIDs, func(id project.LabelID, _ int) string { return string(id) }),
+		Priority:    snap.Priority,
+		StoryPoints: snap.StoryPoints,
+	})
+	if errors.Is(err, project.ErrTaskAlreadyExists) {
+		h.logger.Info("task of the occurrence already exists", "task_id", taskID, "occurrence", occurrence)
+	} else if err != nil {
+		return fmt.Errorf("create task: %w", err)
+	}
+
+	return h.recurring.UpdateRecurringTask(
+		ctx,
+		recurring.ID(),
+		func(r *project.RecurringTask) (*project.RecurringTask, error) {
+			r.Advance(occurrence)
+			return r, nil
+		},
+	)
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type AddTaskToSprint struct {
+	SprintID string
+	TaskID   string
+}
+
+type AddTaskToSprintHandler decorator.CommandHandler[AddTaskToSprint]
+
+type addTaskToSprintHandler struct {
+	sprints project.SprintRepository
+	repo    project.TaskRepository
+}
+
+func NewAddTaskToSprintHandler(
+	sprints project.SprintRepository,
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) AddTaskToSprintHandler {
+	return decorator.ApplyCommandDecorators(
+		&addTaskToSprintHandler{sprints: sprints, repo: repo},
+		logger,
+	)
+}
+
+func (h *addTaskToSprintHandler) Handle(ctx context.Context, cmd AddTaskToSprint) error {
+	sprint, err := h.sprints.GetByID(ctx, project.SprintID(cmd.SprintID))
+	if err != nil {
+		return fmt.Errorf("get sprint: %w", err)
+	}
+
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.MoveToSprint(sprint); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
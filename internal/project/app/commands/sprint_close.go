@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CloseSprint struct {
+	SprintID string
+	// The sprint unfinished tasks roll into, they go back to the backlog when nil
+	RollOverTo *string
+}
+
+type CloseSprintHandler decorator.CommandHandler[CloseSprint]
+
+type closeSprintHandler struct {
+	sprints project.SprintRepository
+	repo    project.TaskRepository
+}
+
+func NewCloseSprintHandler(
+	sprints project.SprintRepository,
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) CloseSprintHandler {
+	return decorator.ApplyCommandDecorators(
+		&closeSprintHandler{sprints: sprints, repo: repo},
+		logger,
+	)
+}
+
+func (h *closeSprintHandler) Handle(ctx context.Context, cmd CloseSprint) error {
+	sprint, err := h.sprints.GetByID(ctx, project.SprintID(cmd.SprintID))
+	if err != nil {
+		return fmt.Errorf("get sprint: %w", err)
+	}
+
+	var next *project.Sprint
+	if cmd.RollOverTo != nil {
+		next, err = h.sprints.GetByID(ctx, project.SprintID(*cmd.RollOverTo))
+		if err != nil {
+			return fmt.Errorf("get next sprint: %w", err)
+		}
+
+		if err := sprint.CanRollOverTo(next); err != nil {
+			return err
+		}
+	}
+
+	err = h.sprints.UpdateSprint(ctx, sprint.ID(), func(s *project.Sprint) (*project.Sprint, error) {
+		if err := s.Close(); err != nil {
+			return nil, err
+		}
+		return s, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	boardTasks, err := h.repo.BoardTasks(ctx, sprint.BoardID())
+	if err != nil {
+		return fmt.Errorf("get board tasks: %w", err)
+	}
+
+	for _, task := range project.SprintTasks(boardTasks, sprint.ID()) {
+		if task.GetSnapshot().Status == project.TaskStatusCompleted {
+			continue
+		}
+
+		err := h.repo.UpdateTask(
+			ctx,
+			task.GetSnapshot().ID,
+			func(t *project.Task) (*project.Task, error) {
+				if next != nil {
+					return t, t.MoveToSprint(next)
+				}
+				return t, t.MoveToBacklog()
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("roll over task %s: %w", task.GetSnapshot().ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CreateSprint struct {
+	SprintID  project.SprintID
+	BoardID   project.BoardID
+	Name      string
+	Goal      *string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type CreateSprintHandler decorator.CommandHandler[CreateSprint]
+
+type createSprintHandler struct {
+	sprints project.SprintRepository
+	boards  project.BoardRepository
+}
+
+func NewCreateSprintHandler(
+	sprints project.SprintRepository,
+	boards project.BoardRepository,
+	logger *slog.Logger,
+) CreateSprintHandler {
+	return decorator.ApplyCommandDecorators(
+		&createSprintHandler{sprints: sprints, boards: boards},
+		logger,
+	)
+}
+
+func (h *createSprintHandler) Handle(ctx context.Context, cmd CreateSprint) error {
+	board, err := h.boards.GetByID(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board: %w", err)
+	}
+
+	if err := board.CanAddTasks(); err != nil {
+		return err
+	}
+
+	sprint, err := project.NewSprint(
+		cmd.SprintID,
+		cmd.BoardID,
+		cmd.Name,
+		cmd.Goal,
+		cmd.StartDate,
+		cmd.EndDate,
+	)
+	if err != nil {
+		return err
+	}
+
+	return h.sprints.Create(ctx, sprint)
+}
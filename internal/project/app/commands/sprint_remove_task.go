@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+// RemoveTaskFromSprint moves a task back to the backlog
+type RemoveTaskFromSprint struct {
+	SprintID string
+	TaskID   string
+}
+
+type RemoveTaskFromSprintHandler decorator.CommandHandler[RemoveTaskFromSprint]
+
+type removeTaskFromSprintHandler struct {
+	repo project.TaskRepository
+}
+
+func NewRemoveTaskFromSprintHandler(
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) RemoveTaskFromSprintHandler {
+	return decorator.ApplyCommandDecorators(
+		&removeTaskFromSprintHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *removeTaskFromSprintHandler) Handle(ctx context.Context, cmd RemoveTaskFromSprint) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.InSprint(project.SprintID(cmd.SprintID)); err != nil {
+				return nil, err
+			}
+			if err := t.MoveToBacklog(); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
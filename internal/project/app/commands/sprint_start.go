@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type StartSprint struct {
+	SprintID string
+}
+
+type StartSprintHandler decorator.CommandHandler[StartSprint]
+
+type startSprintHandler struct {
+	sprints project.SprintRepository
+}
+
+func NewStartSprintHandler(
+	sprints project.SprintRepository,
+	logger *slog.Logger,
+) StartSprintHandler {
+	return decorator.ApplyCommandDecorators(
+		&startSprintHandler{sprints: sprints},
+		logger,
+	)
+}
+
+func (h *startSprintHandler) Handle(ctx context.Context, cmd StartSprint) error {
+	return h.sprints.UpdateSprint(
+		ctx,
+		project.SprintID(cmd.SprintID),
+		func(s *project.Sprint) (*project.Sprint, error) {
+			boardSprints, err := h.sprints.BoardSprints(ctx, s.BoardID())
+			if err != nil {
+				return nil, fmt.Errorf("get board sprints: %w", err)
+			}
+
+			if err := s.Start(boardSprints); err != nil {
+				return nil, err
+			}
+			return s, nil
+		},
+	)
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type AddAssignee struct {
+	TaskID string
+	// ID or display name of a member of the task's board
+	Member      string
+	WIPOverride WIPOverride
+}
+
+type AddAssigneeHandler decorator.CommandHandler[AddAssignee]
+
+type addAssigneeHandler struct {
+	repo    project.TaskRepository
+	boards  project.BoardRepository
+	members project.MemberRepository
+}
+
+func NewAddAssigneeHandler(
+	repo project.TaskRepository,
+	boards project.BoardRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) AddAssigneeHandler {
+	return decorator.ApplyCommandDecorators(
+		&addAssigneeHandler{repo: repo, boards: boards, members: members},
+		logger,
+	)
+}
+
+func (h *addAssigneeHandler) Handle(ctx context.Context, cmd AddAssignee) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			board, err := h.boards.GetByID(ctx, t.BoardID())
+			if err != nil {
+				return nil, fmt.Errorf("get board: %w", err)
+			}
+
+			member, err := resolveMember(
+				ctx,
+				h.members,
+				t.BoardID(),
+				cmd.Member,
+				project.ErrUnknownAssignee,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := t.AddAssignee(*member); err != nil {
+				return nil, err
+			}
+
+			if err := enforceWIPLimit(ctx, h.repo, h.members, board, t, cmd.WIPOverride); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type AddChecklistItem struct {
+	TaskID string
+	ItemID project.ChecklistItemID
+	Text   string
+}
+
+type AddChecklistItemHandler decorator.CommandHandler[AddChecklistItem]
+
+type addChecklistItemHandler struct {
+	repo project.TaskRepository
+}
+
+func NewAddChecklistItemHandler(
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) AddChecklistItemHandler {
+	return decorator.ApplyCommandDecorators(
+		&addChecklistItemHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *addChecklistItemHandler) Handle(ctx context.Context, cmd AddChecklistItem) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.AddChecklistItem(cmd.ItemID, cmd.Text); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+// AddTaskDependency marks TaskID as blocked by BlockedByID
+type AddTaskDependency struct {
+	TaskID      string
+	BlockedByID string
+}
+
+type AddTaskDependencyHandler decorator.CommandHandler[AddTaskDependency]
+
+type addTaskDependencyHandler struct {
+	repo project.TaskRepository
+}
+
+func NewAddTaskDependencyHandler(
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) AddTaskDependencyHandler {
+	return decorator.ApplyCommandDecorators(
+		&addTaskDependencyHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *addTaskDependencyHandler) Handle(ctx context.Context, cmd AddTaskDependency) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			// Read while the board is locked, so that a link added meanwhile can't close a cycle
+			blocker, err := h.repo.GetByID(ctx, project.TaskID(cmd.BlockedByID))
+			if err != nil {
+				return nil, fmt.Errorf("get blocking task: %w", err)
+			}
+
+			// Archived tasks keep their links, restoring one mustn't bring back a cycle
+			boardTasks, err := h.repo.BoardTasksWithArchived(ctx, t.BoardID())
+			if err != nil {
+				return nil, fmt.Errorf("get board tasks: %w", err)
+			}
+
+			if err := t.AddBlocker(blocker, boardTasks); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type AddLabel struct {
+	TaskID  string
+	LabelID string
+}
+
+type AddLabelHandler decorator.CommandHandler[AddLabel]
+
+type addLabelHandler struct {
+	repo   project.TaskRepository
+	labels project.LabelRepository
+}
+
+func NewAddLabelHandler(
+	repo project.TaskRepository,
+	labels project.LabelRepository,
+	logger *slog.Logger,
+) AddLabelHandler {
+	return decorator.ApplyCommandDecorators(
+		&addLabelHandler{repo: repo, labels: labels},
+		logger,
+	)
+}
+
+func (h *addLabelHandler) Handle(ctx context.Context, cmd AddLabel) error {
+	label, err := h.labels.GetByID(ctx, project.LabelID(cmd.LabelID))
+	if err != nil {
+		return fmt.Errorf("get label: %w", err)
+	}
+
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.AddLabel(*label); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
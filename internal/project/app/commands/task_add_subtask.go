@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type AddSubtask struct {
+	ParentID string
+	ChildID  string
+}
+
+type AddSubtaskHandler decorator.CommandHandler[AddSubtask]
+
+type addSubtaskHandler struct {
+	repo project.TaskRepository
+}
+
+func NewAddSubtaskHandler(repo project.TaskRepository, logger *slog.Logger) AddSubtaskHandler {
+	return decorator.ApplyCommandDecorators(
+		&addSubtaskHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *addSubtaskHandler) Handle(ctx context.Context, cmd AddSubtask) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.ChildID),
+		func(child *project.Task) (*project.Task, error) {
+			// Read while the board is locked, so that the parent can't be completed meanwhile
+			parent, err := h.repo.GetByID(ctx, project.TaskID(cmd.ParentID))
+			if err != nil {
+				return nil, fmt.Errorf("get parent task: %w", err)
+			}
+
+			if err := parent.AddSubtask(child); err != nil {
+				return nil, err
+			}
+			return child, nil
+		},
+	)
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type AddWatcher struct {
+	TaskID string
+	// ID or display name of a member of the task's board
+	Member string
+}
+
+type AddWatcherHandler decorator.CommandHandler[AddWatcher]
+
+type addWatcherHandler struct {
+	repo    project.TaskRepository
+	members project.MemberRepository
+}
+
+func NewAddWatcherHandler(
+	repo project.TaskRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) AddWatcherHandler {
+	return decorator.ApplyCommandDecorators(
+		&addWatcherHandler{repo: repo, members: members},
+		logger,
+	)
+}
+
+func (h *addWatcherHandler) Handle(ctx context.Context, cmd AddWatcher) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			member, err := resolveMember(
+				ctx,
+				h.members,
+				t.BoardID(),
+				cmd.Member,
+				project.ErrUnknownWatcher,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := t.AddWatcher(*member); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
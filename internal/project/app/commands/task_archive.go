@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type ArchiveTask struct {
+	TaskID string
+}
+
+type ArchiveTaskHandler decorator.CommandHandler[ArchiveTask]
+
+type archiveTaskHandler struct {
+	repo project.TaskRepository
+}
+
+func NewArchiveTaskHandler(repo project.TaskRepository, logger *slog.Logger) ArchiveTaskHandler {
+	return decorator.ApplyCommandDecorators(
+		&archiveTaskHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *archiveTaskHandler) Handle(ctx context.Context, cmd ArchiveTask) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.Archive(); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -56,6 +56,12 @@ func (h *attachFilesToTaskHandler) Handle(ctx context.Context, cmd AttachFilesTo
 		return nil
 	}
 
+	task, err := h.repo.GetByID(ctx, cmd.TaskID)
+	if err != nil {
+		return fmt.Errorf("get task: %w", err)
+	}
+	boardID := task.BoardID()
+
 	files := make([]project.File, len(cmd.Files))
 	for i, file := range cmd.Files {
 		files[i] = file.Metadata
@@ -84,7 +90,7 @@ func (h *attachFilesToTaskHandler) Handle(ctx context.Context, cmd AttachFilesTo
 		// Process file asynchronously
 		go func() {
 			ctx := context.Background()
-			err := h.processFile(ctx, cmd.TaskID, &snap, &buf)
+			err := h.processFile(ctx, cmd.TaskID, boardID, &snap, &buf)
 			if err != nil {
 				h.logger.Error("file not processed", "err", err)
 			}
@@ -98,6 +104,7 @@ func (h *attachFilesToTaskHandler) Handle(ctx context.Context, cmd AttachFilesTo
 func (h *attachFilesToTaskHandler) processFile(
 	ctx context.Context,
 	taskID project.TaskID,
+	boardID project.BoardID,
 	snap *project.FileSnapshot,
 	buf *bytes.Buffer,
 ) error {
@@ -116,6 +123,7 @@ func (h *attachFilesToTaskHandler) processFile(
 		Name:    snap.Name,
 		Content: content,
 		TaskID:  taskID,
+		BoardID: boardID,
 	})
 }
 
@@ -165,3 +173,40 @@ func isMarkdown(mimeType string) bool {
 func isImage(mimeType string) bool {
 	return strings.HasPrefix(mimeType, "image/")
 }
+
+// copyFiles attaches a copy of the files stored under from to the task, the copies
+// get new IDs and are embedded again under the task like any other attached file
+func copyFiles(
+	ctx context.Context,
+	fileStorage project.FileStorage,
+	attachFiles AttachFilesToTaskHandler,
+	from project.TaskID,
+	files []project.File,
+	taskID project.TaskID,
+) error {
+	filesToUpload := make([]FileToUpload, 0, len(files))
+	for _, file := range files {
+		snap := file.GetSnapshot()
+
+		content, err := fileStorage.Get(ctx, from, snap.Name)
+		if err != nil {
+			return fmt.Errorf("get file %s: %w", snap.Name, err)
+		}
+		defer content.Close()
+
+		copied, err := project.NewFile(snap.Name, snap.Size)
+		if err != nil {
+			return fmt.Errorf("copy file %s: %w", snap.Name, err)
+		}
+
+		filesToUpload = append(filesToUpload, FileToUpload{
+			Metadata: copied,
+			Content:  content,
+		})
+	}
+
+	return attachFiles.Handle(ctx, AttachFilesToTask{
+		TaskID: taskID,
+		Files:  filesToUpload,
+	})
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/DeluxeOwl/cogniboard/internal/decorator"
@@ -9,30 +10,62 @@ import (
 )
 
 type ChangeTaskStatus struct {
-	TaskID string
-	Status string
+	TaskID      string
+	Status      string
+	WIPOverride WIPOverride
+	// Only change the status if the task is still at this version, whatever its version when nil
+	Version *int
 }
 
 type ChangeTaskStatusHandler decorator.CommandHandler[ChangeTaskStatus]
 
 type changeTaskStatusHandler struct {
-	repo project.TaskRepository
+	repo    project.TaskRepository
+	boards  project.BoardRepository
+	members project.MemberRepository
 }
 
 func NewChangeStatusHandler(
 	repo project.TaskRepository,
+	boards project.BoardRepository,
+	members project.MemberRepository,
 	logger *slog.Logger,
 ) ChangeTaskStatusHandler {
 	return decorator.ApplyCommandDecorators(
-		&changeTaskStatusHandler{repo: repo},
+		&changeTaskStatusHandler{repo: repo, boards: boards, members: members},
 		logger,
 	)
 }
 
 func (h *changeTaskStatusHandler) Handle(ctx context.Context, cmd ChangeTaskStatus) error {
 	if err := h.repo.UpdateTask(ctx, project.TaskID(cmd.TaskID), func(t *project.Task) (*project.Task, error) {
-		err := t.ChangeStatus(project.TaskStatus(cmd.Status))
+		if cmd.Version != nil {
+			if err := t.CheckVersion(*cmd.Version); err != nil {
+				return nil, err
+			}
+		}
+
+		board, err := h.boards.GetByID(ctx, t.BoardID())
 		if err != nil {
+			return nil, fmt.Errorf("get board: %w", err)
+		}
+
+		previousStatus := t.GetSnapshot().Status
+		err = t.ChangeStatus(board.Workflow(), project.TaskStatus(cmd.Status))
+		if err != nil {
+			return nil, err
+		}
+
+		// Staying in the same column can't break a WIP limit
+		if previousStatus == t.GetSnapshot().Status {
+			return t, nil
+		}
+
+		if err := placeLast(ctx, h.repo, t); err != nil {
+			return nil, err
+		}
+
+		if err := enforceWIPLimit(ctx, h.repo, h.members, board, t, cmd.WIPOverride); err != nil {
 			return nil, err
 		}
 		return t, nil
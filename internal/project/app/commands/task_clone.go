@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+)
+
+// CloneTask creates a copy of a task with its details, labels, people, checklist, custom fields and files.
+// The clone starts pending, outside of any sprint and with its checklist unchecked.
+// Its comments, history, dependencies and subtasks aren't copied.
+type CloneTask struct {
+	TaskID  string
+	CloneID project.TaskID
+}
+
+type CloneTaskHandler decorator.CommandHandler[CloneTask]
+
+type cloneTaskHandler struct {
+	repo        project.TaskRepository
+	createTask  CreateTaskHandler
+	attachFiles AttachFilesToTaskHandler
+	fileStorage project.FileStorage
+}
+
+func NewCloneTaskHandler(
+	repo project.TaskRepository,
+	createTask CreateTaskHandler,
+	attachFiles AttachFilesToTaskHandler,
+	logger *slog.Logger,
+	fileStorage project.FileStorage,
+) CloneTaskHandler {
+	return decorator.ApplyCommandDecorators(
+		&cloneTaskHandler{
+			repo:        repo,
+			createTask:  createTask,
+			attachFiles: attachFiles,
+			fileStorage: fileStorage,
+		},
+		logger,
+	)
+}
+
+func (h *cloneTaskHandler) Handle(ctx context.Context, cmd CloneTask) error {
+	task, err := h.repo.GetByID(ctx, project.TaskID(cmd.TaskID))
+	if err != nil {
+		return fmt.Errorf("get task: %w", err)
+	}
+	snap := task.GetSnapshot()
+
+	memberIDs := func(m project.MemberSnapshot, _ int) string { return string(m.ID) }
+	err = h.createTask.Handle(ctx, CreateTask{
+		TaskID:      cmd.CloneID,
+		BoardID:     snap.BoardID,
+		Title:       snap.Title,
+		Description: snap.Description,
+		DueDate:     task.CloneDueDate(time.Now()),
+		Assignees:   lo.Map(snap.Assignees, memberIDs),
+		Watchers:    lo.Map(snap.Watchers, memberIDs),
+		Priority:    snap.Priority,
+		StoryPoints: snap.StoryPoints,
+		ParentID:    snap.ParentID,
+		Labels:      lo.Map(snap.Labels, func(l project.LabelSnapshot, _ int) string { return string(l.ID) }),
+		Checklist: lo.Map(snap.Checklist, func(item project.ChecklistItemSnapshot, _ int) string {
+			return item.Text
+		}),
+		CustomFields: lo.SliceToMap(
+			snap.CustomFields,
+			func(v project.CustomFieldValueSnapshot) (string, string) {
+				return string(v.FieldID), v.Value
+			},
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("create clone: %w", err)
+	}
+
+	return copyFiles(ctx, h.fileStorage, h.attachFiles, snap.ID, snap.Files, cmd.CloneID)
+}
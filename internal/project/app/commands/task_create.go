@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -10,38 +11,147 @@ import (
 )
 
 type CreateTask struct {
-	TaskID       project.TaskID
-	Title        string
-	Description  *string
-	DueDate      *time.Time
-	AssigneeName *string
+	TaskID      project.TaskID
+	BoardID     project.BoardID
+	Title       string
+	Description *string
+	DueDate     *time.Time
+	// IDs or display names of members of the board
+	Assignees []string
+	// IDs or display names of members of the board, they can't be assignees too
+	Watchers    []string
+	Priority    *project.TaskPriority
+	StoryPoints *int
+	// Creates the task as a subtask of this task
+	ParentID *project.TaskID
+	// IDs or names of the board's labels
+	Labels []string
+	// Texts of the checklist items, in order
+	Checklist []string
+	// Values of the board's custom fields, keyed by field ID or name
+	CustomFields map[string]string
 }
 
 type CreateTaskHandler decorator.CommandHandler[CreateTask]
 
 type createTaskHandler struct {
-	repo project.TaskRepository
+	repo         project.TaskRepository
+	boards       project.BoardRepository
+	labels       project.LabelRepository
+	members      project.MemberRepository
+	customFields project.CustomFieldRepository
 }
 
-func NewCreateTaskHandler(repo project.TaskRepository, logger *slog.Logger) CreateTaskHandler {
+func NewCreateTaskHandler(
+	repo project.TaskRepository,
+	boards project.BoardRepository,
+	labels project.LabelRepository,
+	members project.MemberRepository,
+	customFields project.CustomFieldRepository,
+	logger *slog.Logger,
+) CreateTaskHandler {
 	return decorator.ApplyCommandDecorators(
-		&createTaskHandler{repo: repo},
+		&createTaskHandler{
+			repo:         repo,
+			boards:       boards,
+			labels:       labels,
+			members:      members,
+			customFields: customFields,
+		},
 		logger,
 	)
 }
 
 func (h *createTaskHandler) Handle(ctx context.Context, cmd CreateTask) error {
+	board, err := h.boards.GetByID(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board: %w", err)
+	}
+
+	if err := board.CanAddTasks(); err != nil {
+		return err
+	}
+
+	assignees, err := resolveMembers(
+		ctx,
+		h.members,
+		cmd.BoardID,
+		cmd.Assignees,
+		project.ErrUnknownAssignee,
+	)
+	if err != nil {
+		return err
+	}
+
 	task, err := project.NewTask(
 		cmd.TaskID,
+		cmd.BoardID,
 		cmd.Title,
 		cmd.Description,
 		cmd.DueDate,
-		cmd.AssigneeName,
+		assignees,
+		cmd.Priority,
+		cmd.StoryPoints,
 	)
 	if err != nil {
 		return err
 	}
 
+	labels, err := resolveLabels(ctx, h.labels, cmd.BoardID, cmd.Labels)
+	if err != nil {
+		return err
+	}
+
+	if err := task.SetLabels(labels); err != nil {
+		return err
+	}
+
+	watchers, err := resolveMembers(
+		ctx,
+		h.members,
+		cmd.BoardID,
+		cmd.Watchers,
+		project.ErrUnknownWatcher,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := task.SetWatchers(watchers); err != nil {
+		return err
+	}
+
+	for _, text := range cmd.Checklist {
+		itemID, err := project.NewChecklistItemID()
+		if err != nil {
+			return err
+		}
+
+		if err := task.AddChecklistItem(itemID, text); err != nil {
+			return err
+		}
+	}
+
+	err = setCustomFieldValues(ctx, h.customFields, h.members, task, cmd.CustomFields)
+	if err != nil {
+		return err
+	}
+
+	if cmd.ParentID != nil {
+		parent, err := h.repo.GetByID(ctx, *cmd.ParentID)
+		if err != nil {
+			return fmt.Errorf("get parent task: %w", err)
+		}
+
+		if err := parent.AddSubtask(task); err != nil {
+			return err
+		}
+	}
+
+	if err := placeLast(ctx, h.repo, task); err != nil {
+		return err
+	}
+
 	if err := h.repo.Create(ctx, task); err != nil {
 		return err
 	}
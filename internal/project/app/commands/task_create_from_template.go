@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+	"github.com/samber/lo"
+)
+
+type CreateTaskFromTemplate struct {
+	TaskID     project.TaskID
+	TemplateID string
+	DueDate    *time.Time
+}
+
+type CreateTaskFromTemplateHandler decorator.CommandHandler[CreateTaskFromTemplate]
+
+type createTaskFromTemplateHandler struct {
+	templates   project.TaskTemplateRepository
+	createTask  CreateTaskHandler
+	attachFiles AttachFilesToTaskHandler
+	fileStorage project.FileStorage
+}
+
+func NewCreateTaskFromTemplateHandler(
+	templates project.TaskTemplateRepository,
+	createTask CreateTaskHandler,
+	attachFiles AttachFilesToTaskHandler,
+	logger *slog.Logger,
+	fileStorage project.FileStorage,
+) CreateTaskFromTemplateHandler {
+	return decorator.ApplyCommandDecorators(
+		&createTaskFromTemplateHandler{
+			templates:   templates,
+			createTask:  createTask,
+			attachFiles: attachFiles,
+			fileStorage: fileStorage,
+		},
+		logger,
+	)
+}
+
+// Handle creates the task, then its subtasks and files, going through the same commands as a task created by hand
+func (h *createTaskFromTemplateHandler) Handle(
+	ctx context.Context,
+	cmd CreateTaskFromTemplate,
+) error {
+	template, err := h.templates.GetByID(ctx, project.TaskTemplateID(cmd.TemplateID))
+	if err != nil {
+		return fmt.Errorf("get template: %w", err)
+	}
+	snap := template.GetSnapshot()
+
+	var assignees []string
+	if snap.DefaultAssigneeID != nil {
+		assignees = []string{string(*snap.DefaultAssigneeID)}
+	}
+
+	err = h.createTask.Handle(ctx, CreateTask{
+		TaskID:      cmd.TaskID,
+		BoardID:     snap.BoardID,
+		Title:       snap.Title,
+		Description: snap.Description,
+		DueDate:     cmd.DueDate,
+		Assignees:   assignees,
+		Labels:      lo.Map(snap.LabelIDs, func(id project.LabelID, _ int) string { return string(id) }),
+	})
+	if err != nil {
+		return fmt.Errorf("create task: %w", err)
+	}
+
+	for _, title := range snap.Subtasks {
+		subtaskID, err := project.NewTaskID()
+		if err != nil {
+			return err
+		}
+
+		err = h.createTask.Handle(ctx, CreateTask{
+			TaskID:   subtaskID,
+			BoardID:  snap.BoardID,
+			Title:    title,
+			ParentID: &cmd.TaskID,
+		})
+		if err != nil {
+			return fmt.Errorf("create subtask %s: %w", title, err)
+		}
+	}
+
+	return copyFiles(ctx, h.fileStorage, h.attachFiles, template.FilesOwner(), snap.Files, cmd.TaskID)
+}
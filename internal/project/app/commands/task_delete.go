@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type DeleteTask struct {
+	TaskID string
+}
+
+type DeleteTaskHandler decorator.CommandHandler[DeleteTask]
+
+type deleteTaskHandler struct {
+	repo        project.TaskRepository
+	fileStorage project.FileStorage
+	embeddings  project.EmbeddingStorage
+}
+
+func NewDeleteTaskHandler(
+	repo project.TaskRepository,
+	logger *slog.Logger,
+	fileStorage project.FileStorage,
+	embeddings project.EmbeddingStorage,
+) DeleteTaskHandler {
+	return decorator.ApplyCommandDecorators(
+		&deleteTaskHandler{
+			repo:        repo,
+			fileStorage: fileStorage,
+			embeddings:  embeddings,
+		},
+		logger,
+	)
+}
+
+// Handle removes the blobs and the embeddings before the task itself,
+// if one of them fails the task is still there and the delete can be retried
+func (h *deleteTaskHandler) Handle(ctx context.Context, cmd DeleteTask) error {
+	id := project.TaskID(cmd.TaskID)
+
+	task, err := h.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := task.CanDelete(); err != nil {
+		return err
+	}
+
+	for _, file := range task.Files() {
+		name := file.GetSnapshot().Name
+		if err := h.fileStorage.Delete(ctx, id, name); err != nil {
+			return fmt.Errorf("delete file %s: %w", name, err)
+		}
+	}
+
+	if err := h.embeddings.DeleteDocumentsForTask(ctx, id); err != nil {
+		return fmt.Errorf("delete embeddings: %w", err)
+	}
+
+	return h.repo.Delete(ctx, id)
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -10,23 +11,47 @@ import (
 )
 
 type EditTask struct {
-	TaskID       string
-	Title        *string
-	Description  *string
-	DueDate      *time.Time
-	AssigneeName *string
-	Status       *string
+	TaskID      string
+	Title       *string
+	Description *string
+	DueDate     *time.Time
+	// IDs or display names of members of the board, replaces the task's assignees when not nil
+	Assignees []string
+	// IDs or display names of members of the board, replaces the task's watchers when not nil
+	Watchers    []string
+	Status      *string
+	Priority    *project.TaskPriority
+	StoryPoints *int
+	// IDs or names of the board's labels, replaces the task's labels when not nil
+	Labels      []string
+	WIPOverride WIPOverride
+	// Only edit the task if it's still at this version, whatever its version when nil
+	Version *int
 }
 
 type EditTaskHandler decorator.CommandHandler[EditTask]
 
 type editTaskHandler struct {
-	repo project.TaskRepository
+	repo    project.TaskRepository
+	boards  project.BoardRepository
+	labels  project.LabelRepository
+	members project.MemberRepository
 }
 
-func NewEditTaskHandler(repo project.TaskRepository, logger *slog.Logger) EditTaskHandler {
+func NewEditTaskHandler(
+	repo project.TaskRepository,
+	boards project.BoardRepository,
+	labels project.LabelRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) EditTaskHandler {
 	return decorator.ApplyCommandDecorators(
-		&editTaskHandler{repo: repo},
+		&editTaskHandler{
+			repo:    repo,
+			boards:  boards,
+			labels:  labels,
+			members: members,
+		},
 		logger,
 	)
 }
@@ -36,15 +61,90 @@ func (h *editTaskHandler) Handle(ctx context.Context, cmd EditTask) error {
 		ctx,
 		project.TaskID(cmd.TaskID),
 		func(t *project.Task) (*project.Task, error) {
+			if cmd.Version != nil {
+				if err := t.CheckVersion(*cmd.Version); err != nil {
+					return nil, err
+				}
+			}
+
+			board, err := h.boards.GetByID(ctx, t.BoardID())
+			if err != nil {
+				return nil, fmt.Errorf("get board: %w", err)
+			}
+
 			var status *project.TaskStatus
 			if cmd.Status != nil {
 				s := project.TaskStatus(*cmd.Status)
 				status = &s
 			}
 
-			if err := t.Edit(cmd.Title, cmd.Description, cmd.DueDate, cmd.AssigneeName, status); err != nil {
+			assignees, err := resolveMembers(
+				ctx,
+				h.members,
+				t.BoardID(),
+				cmd.Assignees,
+				project.ErrUnknownAssignee,
+			)
+			if err != nil {
 				return nil, err
 			}
+
+			before := t.GetSnapshot()
+			err = t.Edit(
+				board.Workflow(),
+				cmd.Title,
+				cmd.Description,
+				cmd.DueDate,
+				assignees,
+				status,
+				cmd.Priority,
+				cmd.StoryPoints,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if cmd.Labels != nil {
+				labels, err := resolveLabels(ctx, h.labels, t.BoardID(), cmd.Labels)
+				if err != nil {
+					return nil, err
+				}
+
+				if err := t.SetLabels(labels); err != nil {
+					return nil, err
+				}
+			}
+
+			if cmd.Watchers != nil {
+				watchers, err := resolveMembers(
+					ctx,
+					h.members,
+					t.BoardID(),
+					cmd.Watchers,
+					project.ErrUnknownWatcher,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				if err := t.SetWatchers(watchers); err != nil {
+					return nil, err
+				}
+			}
+
+			after := t.GetSnapshot()
+			if before.Status != after.Status {
+				if err := placeLast(ctx, h.repo, t); err != nil {
+					return nil, err
+				}
+			}
+
+			// Only moves and reassignments can break a WIP limit
+			if before.Status != after.Status || !sameMembers(before.Assignees, after.Assignees) {
+				if err := enforceWIPLimit(ctx, h.repo, h.members, board, t, cmd.WIPOverride); err != nil {
+					return nil, err
+				}
+			}
 			return t, nil
 		},
 	)
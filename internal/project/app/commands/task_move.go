@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+// MoveTask puts a task in a column at a given position, like a drag and drop on the board
+type MoveTask struct {
+	TaskID string
+	Status string
+	// The task right above the new position, nil for the top of the column
+	AfterID *string
+	// The task right below the new position, nil for the bottom of the column
+	BeforeID    *string
+	WIPOverride WIPOverride
+	// Only move the task if it's still at this version, whatever its version when nil
+	Version *int
+}
+
+type MoveTaskHandler decorator.CommandHandler[MoveTask]
+
+type moveTaskHandler struct {
+	repo    project.TaskRepository
+	boards  project.BoardRepository
+	members project.MemberRepository
+}
+
+func NewMoveTaskHandler(
+	repo project.TaskRepository,
+	boards project.BoardRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) MoveTaskHandler {
+	return decorator.ApplyCommandDecorators(
+		&moveTaskHandler{repo: repo, boards: boards, members: members},
+		logger,
+	)
+}
+
+func (h *moveTaskHandler) Handle(ctx context.Context, cmd MoveTask) error {
+	// The status and the rank change in the same transaction
+	return h.repo.UpdateTask(ctx, project.TaskID(cmd.TaskID), func(t *project.Task) (*project.Task, error) {
+		if cmd.Version != nil {
+			if err := t.CheckVersion(*cmd.Version); err != nil {
+				return nil, err
+			}
+		}
+
+		// Read while the board is locked, so that a task moved meanwhile can't get the same rank
+		after, err := h.neighbour(ctx, cmd.AfterID)
+		if err != nil {
+			return nil, err
+		}
+
+		before, err := h.neighbour(ctx, cmd.BeforeID)
+		if err != nil {
+			return nil, err
+		}
+
+		board, err := h.boards.GetByID(ctx, t.BoardID())
+		if err != nil {
+			return nil, fmt.Errorf("get board: %w", err)
+		}
+
+		previousStatus := t.GetSnapshot().Status
+		if err := t.Move(board.Workflow(), project.TaskStatus(cmd.Status), after, before); err != nil {
+			return nil, err
+		}
+
+		if previousStatus != t.GetSnapshot().Status {
+			if err := enforceWIPLimit(ctx, h.repo, h.members, board, t, cmd.WIPOverride); err != nil {
+				return nil, err
+			}
+		}
+		return t, nil
+	})
+}
+
+func (h *moveTaskHandler) neighbour(ctx context.Context, id *string) (*project.Task, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	task, err := h.repo.GetByID(ctx, project.TaskID(*id))
+	if err != nil {
+		return nil, fmt.Errorf("get task %s: %w", *id, err)
+	}
+	return task, nil
+}
+
+// placeLast puts a task that's new to its column at the bottom of it
+func placeLast(ctx context.Context, tasks project.TaskRepository, t *project.Task) error {
+	boardTasks, err := tasks.BoardTasks(ctx, t.BoardID())
+	if err != nil {
+		return fmt.Errorf("get board tasks: %w", err)
+	}
+
+	t.PlaceLast(boardTasks)
+	return nil
+}
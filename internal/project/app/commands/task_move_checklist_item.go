@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type MoveChecklistItem struct {
+	TaskID string
+	ItemID string
+	// Starts at 0 for the top of the checklist
+	Position int
+}
+
+type MoveChecklistItemHandler decorator.CommandHandler[MoveChecklistItem]
+
+type moveChecklistItemHandler struct {
+	repo project.TaskRepository
+}
+
+func NewMoveChecklistItemHandler(
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) MoveChecklistItemHandler {
+	return decorator.ApplyCommandDecorators(
+		&moveChecklistItemHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *moveChecklistItemHandler) Handle(ctx context.Context, cmd MoveChecklistItem) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			err := t.MoveChecklistItem(project.ChecklistItemID(cmd.ItemID), cmd.Position)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type RemoveAssignee struct {
+	TaskID string
+	// ID or display name of a member of the task's board
+	Member string
+}
+
+type RemoveAssigneeHandler decorator.CommandHandler[RemoveAssignee]
+
+type removeAssigneeHandler struct {
+	repo    project.TaskRepository
+	members project.MemberRepository
+}
+
+func NewRemoveAssigneeHandler(
+	repo project.TaskRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) RemoveAssigneeHandler {
+	return decorator.ApplyCommandDecorators(
+		&removeAssigneeHandler{repo: repo, members: members},
+		logger,
+	)
+}
+
+func (h *removeAssigneeHandler) Handle(ctx context.Context, cmd RemoveAssignee) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			member, err := resolveMember(
+				ctx,
+				h.members,
+				t.BoardID(),
+				cmd.Member,
+				project.ErrNotAssigned,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := t.RemoveAssignee(member.ID()); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type RemoveChecklistItem struct {
+	TaskID string
+	ItemID string
+}
+
+type RemoveChecklistItemHandler decorator.CommandHandler[RemoveChecklistItem]
+
+type removeChecklistItemHandler struct {
+	repo project.TaskRepository
+}
+
+func NewRemoveChecklistItemHandler(
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) RemoveChecklistItemHandler {
+	return decorator.ApplyCommandDecorators(
+		&removeChecklistItemHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *removeChecklistItemHandler) Handle(ctx context.Context, cmd RemoveChecklistItem) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.RemoveChecklistItem(project.ChecklistItemID(cmd.ItemID)); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
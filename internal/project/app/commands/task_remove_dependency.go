@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type RemoveTaskDependency struct {
+	TaskID      string
+	BlockedByID string
+}
+
+type RemoveTaskDependencyHandler decorator.CommandHandler[RemoveTaskDependency]
+
+type removeTaskDependencyHandler struct {
+	repo project.TaskRepository
+}
+
+func NewRemoveTaskDependencyHandler(
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) RemoveTaskDependencyHandler {
+	return decorator.ApplyCommandDecorators(
+		&removeTaskDependencyHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *removeTaskDependencyHandler) Handle(ctx context.Context, cmd RemoveTaskDependency) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.RemoveBlocker(project.TaskID(cmd.BlockedByID)); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type RemoveLabel struct {
+	TaskID  string
+	LabelID string
+}
+
+type RemoveLabelHandler decorator.CommandHandler[RemoveLabel]
+
+type removeLabelHandler struct {
+	repo project.TaskRepository
+}
+
+func NewRemoveLabelHandler(repo project.TaskRepository, logger *slog.Logger) RemoveLabelHandler {
+	return decorator.ApplyCommandDecorators(
+		&removeLabelHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *removeLabelHandler) Handle(ctx context.Context, cmd RemoveLabel) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.RemoveLabel(project.LabelID(cmd.LabelID)); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type RemoveSubtask struct {
+	ParentID string
+	ChildID  string
+}
+
+type RemoveSubtaskHandler decorator.CommandHandler[RemoveSubtask]
+
+type removeSubtaskHandler struct {
+	repo project.TaskRepository
+}
+
+func NewRemoveSubtaskHandler(repo project.TaskRepository, logger *slog.Logger) RemoveSubtaskHandler {
+	return decorator.ApplyCommandDecorators(
+		&removeSubtaskHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *removeSubtaskHandler) Handle(ctx context.Context, cmd RemoveSubtask) error {
+	parent, err := h.repo.GetByID(ctx, project.TaskID(cmd.ParentID))
+	if err != nil {
+		return fmt.Errorf("get parent task: %w", err)
+	}
+
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.ChildID),
+		func(child *project.Task) (*project.Task, error) {
+			if err := parent.RemoveSubtask(child); err != nil {
+				return nil, err
+			}
+			return child, nil
+		},
+	)
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type RemoveWatcher struct {
+	TaskID string
+	// ID or display name of a member of the task's board
+	Member string
+}
+
+type RemoveWatcherHandler decorator.CommandHandler[RemoveWatcher]
+
+type removeWatcherHandler struct {
+	repo    project.TaskRepository
+	members project.MemberRepository
+}
+
+func NewRemoveWatcherHandler(
+	repo project.TaskRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) RemoveWatcherHandler {
+	return decorator.ApplyCommandDecorators(
+		&removeWatcherHandler{repo: repo, members: members},
+		logger,
+	)
+}
+
+func (h *removeWatcherHandler) Handle(ctx context.Context, cmd RemoveWatcher) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			member, err := resolveMember(
+				ctx,
+				h.members,
+				t.BoardID(),
+				cmd.Member,
+				project.ErrNotWatching,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := t.RemoveWatcher(member.ID()); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type RestoreTask struct {
+	TaskID string
+}
+
+type RestoreTaskHandler decorator.CommandHandler[RestoreTask]
+
+type restoreTaskHandler struct {
+	repo project.TaskRepository
+}
+
+func NewRestoreTaskHandler(repo project.TaskRepository, logger *slog.Logger) RestoreTaskHandler {
+	return decorator.ApplyCommandDecorators(
+		&restoreTaskHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *restoreTaskHandler) Handle(ctx context.Context, cmd RestoreTask) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.Restore(); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type SetCustomFieldValue struct {
+	TaskID string
+	// ID or name of one of the board's custom fields
+	Field string
+	// Nil clears the task's value, users are given by member ID or display name
+	Value *string
+}
+
+type SetCustomFieldValueHandler decorator.CommandHandler[SetCustomFieldValue]
+
+type setCustomFieldValueHandler struct {
+	repo         project.TaskRepository
+	customFields project.CustomFieldRepository
+	members      project.MemberRepository
+}
+
+func NewSetCustomFieldValueHandler(
+	repo project.TaskRepository,
+	customFields project.CustomFieldRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) SetCustomFieldValueHandler {
+	return decorator.ApplyCommandDecorators(
+		&setCustomFieldValueHandler{repo: repo, customFields: customFields, members: members},
+		logger,
+	)
+}
+
+func (h *setCustomFieldValueHandler) Handle(ctx context.Context, cmd SetCustomFieldValue) error {
+	task, err := h.repo.GetByID(ctx, project.TaskID(cmd.TaskID))
+	if err != nil {
+		return fmt.Errorf("get task: %w", err)
+	}
+
+	boardFields, err := h.customFields.BoardCustomFields(ctx, task.BoardID())
+	if err != nil {
+		return fmt.Errorf("get board custom fields: %w", err)
+	}
+
+	field, err := resolveCustomField(boardFields, cmd.Field)
+	if err != nil {
+		return err
+	}
+
+	var boardMembers []project.Member
+	if field.Type() == project.CustomFieldTypeUser {
+		boardMembers, err = h.members.BoardMembers(ctx, task.BoardID())
+		if err != nil {
+			return fmt.Errorf("get board members: %w", err)
+		}
+	}
+
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			if err := t.SetCustomFieldValue(*field, cmd.Value, boardMembers); err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
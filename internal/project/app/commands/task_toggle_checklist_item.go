@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type ToggleChecklistItem struct {
+	TaskID string
+	ItemID string
+	// Whether the item is done, nil flips it
+	Done *bool
+}
+
+type ToggleChecklistItemHandler decorator.CommandHandler[ToggleChecklistItem]
+
+type toggleChecklistItemHandler struct {
+	repo project.TaskRepository
+}
+
+func NewToggleChecklistItemHandler(
+	repo project.TaskRepository,
+	logger *slog.Logger,
+) ToggleChecklistItemHandler {
+	return decorator.ApplyCommandDecorators(
+		&toggleChecklistItemHandler{repo: repo},
+		logger,
+	)
+}
+
+func (h *toggleChecklistItemHandler) Handle(ctx context.Context, cmd ToggleChecklistItem) error {
+	return h.repo.UpdateTask(
+		ctx,
+		project.TaskID(cmd.TaskID),
+		func(t *project.Task) (*project.Task, error) {
+			err := t.ToggleChecklistItem(project.ChecklistItemID(cmd.ItemID), cmd.Done)
+			if err != nil {
+				return nil, err
+			}
+			return t, nil
+		},
+	)
+}
@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CreateTaskTemplate struct {
+	ID          project.TaskTemplateID
+	BoardID     project.BoardID
+	Title       string
+	Description *string
+	// IDs or names of the board's labels
+	Labels []string
+	// ID or display name of a member of the board
+	DefaultAssignee *string
+	// Titles of the subtasks created with each task
+	Subtasks []string
+	// Copied to each task created from the template
+	Files []FileToUpload
+}
+
+type CreateTaskTemplateHandler decorator.CommandHandler[CreateTaskTemplate]
+
+type createTaskTemplateHandler struct {
+	templates   project.TaskTemplateRepository
+	boards      project.BoardRepository
+	labels      project.LabelRepository
+	members     project.MemberRepository
+	fileStorage project.FileStorage
+}
+
+func NewCreateTaskTemplateHandler(
+	templates project.TaskTemplateRepository,
+	boards project.BoardRepository,
+	labels project.LabelRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+	fileStorage project.FileStorage,
+) CreateTaskTemplateHandler {
+	return decorator.ApplyCommandDecorators(
+		&createTaskTemplateHandler{
+			templates:   templates,
+			boards:      boards,
+			labels:      labels,
+			members:     members,
+			fileStorage: fileStorage,
+		},
+		logger,
+	)
+}
+
+func (h *createTaskTemplateHandler) Handle(ctx context.Context, cmd CreateTaskTemplate) error {
+	board, err := h.boards.GetByID(ctx, cmd.BoardID)
+	if err != nil {
+		return fmt.Errorf("get board: %w", err)
+	}
+
+	if err := board.CanAddTasks(); err != nil {
+		return err
+	}
+
+	labels, err := resolveLabels(ctx, h.labels, cmd.BoardID, cmd.Labels)
+	if err != nil {
+		return err
+	}
+
+	var defaultAssignee *project.Member
+	if cmd.DefaultAssignee != nil {
+		defaultAssignee, err = resolveMember(
+			ctx,
+			h.members,
+			cmd.BoardID,
+			*cmd.DefaultAssignee,
+			project.ErrUnknownAssignee,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	template, err := project.NewTaskTemplate(
+		cmd.ID,
+		cmd.BoardID,
+		cmd.Title,
+		cmd.Description,
+		labels,
+		defaultAssignee,
+		cmd.Subtasks,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Template files aren't embedded, they're only searchable once copied to a task
+	for _, file := range cmd.Files {
+		name := file.Metadata.GetSnapshot().Name
+		if err := h.fileStorage.Store(ctx, template.FilesOwner(), name, file.Content); err != nil {
+			return fmt.Errorf("save file %s: %w", name, err)
+		}
+		template.AddFile(file.Metadata)
+	}
+
+	return h.templates.Create(ctx, template)
+}
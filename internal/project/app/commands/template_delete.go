@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+// DeleteTaskTemplate removes the template and its files, the tasks created from it are kept
+type DeleteTaskTemplate struct {
+	ID string
+}
+
+type DeleteTaskTemplateHandler decorator.CommandHandler[DeleteTaskTemplate]
+
+type deleteTaskTemplateHandler struct {
+	templates   project.TaskTemplateRepository
+	fileStorage project.FileStorage
+}
+
+func NewDeleteTaskTemplateHandler(
+	templates project.TaskTemplateRepository,
+	logger *slog.Logger,
+	fileStorage project.FileStorage,
+) DeleteTaskTemplateHandler {
+	return decorator.ApplyCommandDecorators(
+		&deleteTaskTemplateHandler{
+			templates:   templates,
+			fileStorage: fileStorage,
+		},
+		logger,
+	)
+}
+
+// Handle removes the blobs before the template, so a failed delete can be retried
+func (h *deleteTaskTemplateHandler) Handle(ctx context.Context, cmd DeleteTaskTemplate) error {
+	template, err := h.templates.GetByID(ctx, project.TaskTemplateID(cmd.ID))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range template.Files() {
+		name := file.GetSnapshot().Name
+		if err := h.fileStorage.Delete(ctx, template.FilesOwner(), name); err != nil {
+			return fmt.Errorf("delete file %s: %w", name, err)
+		}
+	}
+
+	return h.templates.Delete(ctx, template.ID())
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type StartTimer struct {
+	TaskID string
+	// ID or display name of a member of the task's board
+	Member string
+}
+
+type StartTimerHandler decorator.CommandHandler[StartTimer]
+
+type startTimerHandler struct {
+	worklogs project.WorklogRepository
+	repo     project.TaskRepository
+	members  project.MemberRepository
+}
+
+func NewStartTimerHandler(
+	worklogs project.WorklogRepository,
+	repo project.TaskRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) StartTimerHandler {
+	return decorator.ApplyCommandDecorators(
+		&startTimerHandler{worklogs: worklogs, repo: repo, members: members},
+		logger,
+	)
+}
+
+func (h *startTimerHandler) Handle(ctx context.Context, cmd StartTimer) error {
+	task, err := h.repo.GetByID(ctx, project.TaskID(cmd.TaskID))
+	if err != nil {
+		return fmt.Errorf("get task: %w", err)
+	}
+
+	member, err := resolveMember(
+		ctx,
+		h.members,
+		task.BoardID(),
+		cmd.Member,
+		project.ErrMemberNotFound,
+	)
+	if err != nil {
+		return err
+	}
+
+	timer, err := project.StartTimer(task, *member)
+	if err != nil {
+		return err
+	}
+
+	return h.worklogs.StartTimer(ctx, timer)
+}
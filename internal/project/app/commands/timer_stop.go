@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+// StopTimer logs the time measured by the member's timer on the task
+type StopTimer struct {
+	WorklogID project.WorklogID
+	TaskID    string
+	// ID or display name of a member of the task's board
+	Member string
+	Note   *string
+}
+
+type StopTimerHandler decorator.CommandHandler[StopTimer]
+
+type stopTimerHandler struct {
+	worklogs project.WorklogRepository
+	repo     project.TaskRepository
+	members  project.MemberRepository
+}
+
+func NewStopTimerHandler(
+	worklogs project.WorklogRepository,
+	repo project.TaskRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) StopTimerHandler {
+	return decorator.ApplyCommandDecorators(
+		&stopTimerHandler{worklogs: worklogs, repo: repo, members: members},
+		logger,
+	)
+}
+
+func (h *stopTimerHandler) Handle(ctx context.Context, cmd StopTimer) error {
+	task, err := h.repo.GetByID(ctx, project.TaskID(cmd.TaskID))
+	if err != nil {
+		return fmt.Errorf("get task: %w", err)
+	}
+
+	member, err := resolveMember(
+		ctx,
+		h.members,
+		task.BoardID(),
+		cmd.Member,
+		project.ErrMemberNotFound,
+	)
+	if err != nil {
+		return err
+	}
+
+	return h.worklogs.StopTimer(
+		ctx,
+		member.ID(),
+		func(t *project.Timer) (*project.Worklog, error) {
+			return t.Stop(cmd.WorklogID, task, *member, cmd.Note)
+		},
+	)
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+// WIPOverride lets a move through even if it breaks a WIP limit, recording who forced it
+type WIPOverride struct {
+	Force bool
+	// ID or display name of the admin forcing the move
+	ForcedBy string
+}
+
+// enforceWIPLimit checks the task against its column's WIP limits after it was moved or reassigned
+func enforceWIPLimit(
+	ctx context.Context,
+	tasks project.TaskRepository,
+	members project.MemberRepository,
+	board *project.Board,
+	t *project.Task,
+	override WIPOverride,
+) error {
+	boardTasks, err := tasks.BoardTasks(ctx, board.ID())
+	if err != nil {
+		return fmt.Errorf("get board tasks: %w", err)
+	}
+
+	err = board.CheckWIPLimit(t, boardTasks)
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, project.ErrWIPLimitExceeded) || !override.Force {
+		return err
+	}
+
+	if strings.TrimSpace(override.ForcedBy) == "" {
+		return project.ErrWIPOverrideRequiresAuthor
+	}
+
+	boardMembers, err := members.BoardMembers(ctx, board.ID())
+	if err != nil {
+		return fmt.Errorf("get board members: %w", err)
+	}
+
+	forcedBy, err := project.FindMember(boardMembers, override.ForcedBy)
+	if err != nil {
+		return err
+	}
+
+	return t.OverrideWIPLimit(forcedBy)
+}
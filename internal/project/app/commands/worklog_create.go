@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type LogWork struct {
+	WorklogID project.WorklogID
+	TaskID    string
+	// ID or display name of a member of the task's board
+	Member   string
+	Duration time.Duration
+	// The day the work was done
+	Date time.Time
+	Note *string
+}
+
+type LogWorkHandler decorator.CommandHandler[LogWork]
+
+type logWorkHandler struct {
+	worklogs project.WorklogRepository
+	repo     project.TaskRepository
+	members  project.MemberRepository
+}
+
+func NewLogWorkHandler(
+	worklogs project.WorklogRepository,
+	repo project.TaskRepository,
+	members project.MemberRepository,
+	logger *slog.Logger,
+) LogWorkHandler {
+	return decorator.ApplyCommandDecorators(
+		&logWorkHandler{worklogs: worklogs, repo: repo, members: members},
+		logger,
+	)
+}
+
+func (h *logWorkHandler) Handle(ctx context.Context, cmd LogWork) error {
+	task, err := h.repo.GetByID(ctx, project.TaskID(cmd.TaskID))
+	if err != nil {
+		return fmt.Errorf("get task: %w", err)
+	}
+
+	member, err := resolveMember(
+		ctx,
+		h.members,
+		task.BoardID(),
+		cmd.Member,
+		project.ErrMemberNotFound,
+	)
+	if err != nil {
+		return err
+	}
+
+	worklog, err := project.NewWorklog(
+		cmd.WorklogID,
+		task,
+		*member,
+		cmd.Duration,
+		cmd.Date,
+		cmd.Note,
+	)
+	if err != nil {
+		return err
+	}
+
+	return h.worklogs.Create(ctx, worklog)
+}
@@ -3,6 +3,7 @@ package operations
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -11,16 +12,31 @@ import (
 	"github.com/DeluxeOwl/cogniboard/internal/decorator"
 	"github.com/DeluxeOwl/cogniboard/internal/project"
 	"github.com/DeluxeOwl/cogniboard/internal/project/app/commands"
+	"github.com/DeluxeOwl/cogniboard/internal/project/app/queries"
+	"github.com/samber/lo"
 )
 
 type ChatWithProjectHandler decorator.OperationHandler[ChatWithProject, project.StreamingChunk]
 
 type chatWithProjectHandler struct {
-	chatService       ChatService
-	repo              project.TaskRepository
-	embeddings        project.EmbeddingStorage
-	logger            *slog.Logger
-	createTaskHandler commands.CreateTaskHandler
+	chatService            ChatService
+	repo                   project.TaskRepository
+	boards                 project.BoardRepository
+	labels                 project.LabelRepository
+	sprints                project.SprintRepository
+	members                project.MemberRepository
+	embeddings             project.EmbeddingStorage
+	logger                 *slog.Logger
+	createTaskHandler      commands.CreateTaskHandler
+	editTaskHandler        commands.EditTaskHandler
+	addTaskToSprintHandler commands.AddTaskToSprintHandler
+	toggleChecklistHandler commands.ToggleChecklistItemHandler
+	currentSprintHandler   queries.CurrentSprintHandler
+	taskCommentsHandler    queries.TaskCommentsHandler
+	taskHistoryHandler     queries.TaskHistoryHandler
+	listTasksHandler       queries.ListTasksHandler
+	epicsHandler           queries.BoardEpicsHandler
+	epicProgressHandler    queries.EpicProgressHandler
 }
 
 // ChatWithProjectReadModel defines the interface for reading chat interactions
@@ -31,11 +47,48 @@ type ChatWithProjectReadModel interface {
 func NewChatWithProjectHandler(
 	chatService ChatService,
 	repo project.TaskRepository,
+	boards project.BoardRepository,
+	labels project.LabelRepository,
+	sprints project.SprintRepository,
+	comments project.CommentRepository,
+	members project.MemberRepository,
+	customFields project.CustomFieldRepository,
+	epics project.EpicRepository,
 	logger *slog.Logger,
 	embeddings project.EmbeddingStorage,
 ) ChatWithProjectHandler {
 	return decorator.ApplyOperationDecorators(
-		&chatWithProjectHandler{chatService: chatService, repo: repo, embeddings: embeddings, logger: logger, createTaskHandler: commands.NewCreateTaskHandler(repo, logger)},
+		&chatWithProjectHandler{
+			chatService: chatService,
+			repo:        repo,
+			boards:      boards,
+			labels:      labels,
+			sprints:     sprints,
+			members:     members,
+			embeddings:  embeddings,
+			logger:      logger,
+			createTaskHandler: commands.NewCreateTaskHandler(
+				repo,
+				boards,
+				labels,
+				members,
+				customFields,
+				logger,
+			),
+			editTaskHandler: commands.NewEditTaskHandler(repo, boards, labels, members, logger),
+			addTaskToSprintHandler: commands.NewAddTaskToSprintHandler(
+				sprints,
+				repo,
+				logger,
+			),
+			toggleChecklistHandler: commands.NewToggleChecklistItemHandler(repo, logger),
+			currentSprintHandler:   queries.NewCurrentSprintHandler(sprints, repo, logger),
+			taskCommentsHandler:    queries.NewTaskCommentsHandler(comments, logger),
+			taskHistoryHandler:     queries.NewTaskHistoryHandler(repo, logger),
+			listTasksHandler:       queries.NewListTasksHandler(repo, logger),
+			epicsHandler:           queries.NewBoardEpicsHandler(epics, logger),
+			epicProgressHandler:    queries.NewEpicProgressHandler(epics, repo, logger),
+		},
 		logger,
 	)
 }
@@ -52,6 +105,8 @@ type Content struct {
 
 type ChatWithProject struct {
 	Messages []Message `json:"messages"`
+	// The board the chat is scoped to, the tools only see this board's tasks
+	BoardID project.BoardID `json:"-"`
 }
 
 type ChatService interface {
@@ -63,12 +118,16 @@ type ChatService interface {
 }
 
 type EditTaskArgs struct {
-	TaskID       string     `json:"taskID"`
-	Title        *string    `json:"title"`
-	Description  *string    `json:"description"`
-	DueDate      *time.Time `json:"dueDate"`
-	AssigneeName *string    `json:"assigneeName"`
-	Status       *string    `json:"status"`
+	TaskID      string     `json:"taskID"`
+	Title       *string    `json:"title"`
+	Description *string    `json:"description"`
+	DueDate     *time.Time `json:"dueDate"`
+	Assignees   []string   `json:"assignees"`
+	Watchers    []string   `json:"watchers"`
+	Status      *string    `json:"status"`
+	Priority    *string    `json:"priority"`
+	StoryPoints *int       `json:"storyPoints"`
+	Labels      []string   `json:"labels"`
 }
 
 type SearchDocumentsForTaskArgs struct {
@@ -80,10 +139,113 @@ type SearchAllDocumentsArgs struct {
 	Query string `json:"query"`
 }
 
+type GetTaskCommentsArgs struct {
+	TaskID string `json:"taskID"`
+}
+
+type GetTaskHistoryArgs struct {
+	TaskID string  `json:"taskID"`
+	Since  *string `json:"since"`
+}
+
+type CheckChecklistItemArgs struct {
+	TaskID string `json:"taskID"`
+	ItemID string `json:"itemID"`
+	Done   *bool  `json:"done"`
+}
+
+type GetTasksArgs struct {
+	Statuses     []string `json:"statuses"`
+	Assignees    []string `json:"assignees"`
+	DueFrom      *string  `json:"dueFrom"`
+	DueTo        *string  `json:"dueTo"`
+	Text         *string  `json:"text"`
+	Labels       []string `json:"labels"`
+	UpdatedSince *string  `json:"updatedSince"`
+	SortBy       *string  `json:"sortBy"`
+	Descending   *bool    `json:"descending"`
+	Cursor       *string  `json:"cursor"`
+	Limit        *int     `json:"limit"`
+}
+
+// toQuery lists the board's tasks the way the assistant asked for
+func (args GetTasksArgs) toQuery(boardID project.BoardID) (queries.ListTasks, error) {
+	parseDay := func(name string, day *string) (*time.Time, error) {
+		if day == nil || *day == "" {
+			return nil, nil
+		}
+		parsed, err := time.Parse(time.DateOnly, *day)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a YYYY-MM-DD date", name)
+		}
+		return &parsed, nil
+	}
+
+	dueFrom, err := parseDay("dueFrom", args.DueFrom)
+	if err != nil {
+		return queries.ListTasks{}, err
+	}
+
+	dueTo, err := parseDay("dueTo", args.DueTo)
+	if err != nil {
+		return queries.ListTasks{}, err
+	}
+
+	updatedSince, err := parseDay("updatedSince", args.UpdatedSince)
+	if err != nil {
+		return queries.ListTasks{}, err
+	}
+
+	sort, err := project.NewTaskSort(lo.FromPtr(args.SortBy), lo.FromPtr(args.Descending))
+	if err != nil {
+		return queries.ListTasks{}, err
+	}
+
+	return queries.ListTasks{
+		Filter: project.TaskFilter{
+			BoardID: &boardID,
+			Statuses: lo.Map(args.Statuses, func(s string, _ int) project.TaskStatus {
+				return project.TaskStatus(strings.ToLower(s))
+			}),
+			Assignees:    args.Assignees,
+			DueFrom:      dueFrom,
+			DueTo:        dueTo,
+			Text:         lo.FromPtr(args.Text),
+			Labels:       args.Labels,
+			UpdatedSince: updatedSince,
+		},
+		Sort:   sort,
+		Cursor: lo.FromPtr(args.Cursor),
+		Limit:  lo.FromPtr(args.Limit),
+	}, nil
+}
+
+// joinSortFields lists the fields the tasks can be sorted on
+func joinSortFields() string {
+	fields := lo.Map(project.TaskSortFields, func(f project.TaskSortField, _ int) string {
+		return string(f)
+	})
+	return strings.Join(fields, ", ")
+}
+
+type GetEpicProgressArgs struct {
+	Epic string `json:"epic"`
+}
+
+type MoveTaskToSprintArgs struct {
+	TaskID   string  `json:"taskID"`
+	SprintID *string `json:"sprintID"`
+}
+
 type AddTaskArgs struct {
-	Title       string  `json:"title"`
-	Description *string `json:"description"`
-	Assignee    *string `json:"assignee"`
+	Title       string   `json:"title"`
+	Description *string  `json:"description"`
+	Assignees   []string `json:"assignees"`
+	Watchers    []string `json:"watchers"`
+	ParentID    *string  `json:"parentID"`
+	Priority    *string  `json:"priority"`
+	StoryPoints *int     `json:"storyPoints"`
+	Labels      []string `json:"labels"`
 }
 
 // TODO: chat should be a domain object. For now it's fine to do some business logic here.
@@ -92,12 +254,46 @@ func (h *chatWithProjectHandler) Handle(
 	ctx context.Context,
 	operation ChatWithProject,
 ) (project.StreamingChunk, error) {
-	operation.enrichWithSystemPrompt()
+	boardID := operation.BoardID
+	if boardID == "" {
+		boardID = project.DefaultBoardID
+	}
+
+	board, err := h.boards.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("get board: %w", err)
+	}
+	workflow := board.Workflow()
+
+	labels, err := h.labels.BoardLabels(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("get board labels: %w", err)
+	}
+	labelNames := make([]string, len(labels))
+	for i, label := range labels {
+		labelNames[i] = label.GetSnapshot().Name
+	}
+
+	members, err := h.members.BoardMembers(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("get board members: %w", err)
+	}
+
+	operation.enrichWithSystemPrompt(project.MemberNames(members))
+
+	// Changes made by the tools are recorded as coming from the assistant, on behalf of the user
+	ctx = project.WithActor(ctx, project.Actor{
+		Name:   project.ActorFrom(ctx).Name,
+		Source: project.ChangeSourceAssistant,
+	})
 
 	return h.chatService.StreamChat(ctx, operation.Messages, []project.ChatTool{
 		project.Tool[AddTaskArgs]{
-			FuncName:    "add_task",
-			Description: "Adds a task to the backlog, only the title is required, description and the assignee are optional",
+			FuncName: "add_task",
+			Description: fmt.Sprintf(
+				"Adds a task to the backlog, only the title is required, description, the assignees, the watchers (notified about the task without being assigned to it, they can't be assignees too), the priority (p0 is the most urgent, p3 the least), the storyPoints estimate and the labels are optional. Pass the parentID of an existing task to add the task as its subtask. The available labels are: %s",
+				strings.Join(labelNames, ", "),
+			),
 			Params: []project.ToolParam{
 				{
 					Name:      "title",
@@ -109,24 +305,58 @@ func (h *chatWithProjectHandler) Handle(
 					ParamType: "string",
 				},
 				{
-					Name:      "assignee",
+					Name:      "assignees",
+					ParamType: "array",
+					ItemsType: "string",
+				},
+				{
+					Name:      "watchers",
+					ParamType: "array",
+					ItemsType: "string",
+				},
+				{
+					Name:      "parentID",
 					ParamType: "string",
 				},
+				{
+					Name:      "priority",
+					ParamType: "string",
+				},
+				{
+					Name:      "storyPoints",
+					ParamType: "integer",
+				},
+				{
+					Name:      "labels",
+					ParamType: "array",
+					ItemsType: "string",
+				},
 			},
 			Handler: func(ctx context.Context, ata AddTaskArgs) (string, error) {
 				taskID, err := project.NewTaskID()
 				if err != nil {
-					return "couldn't add task", err
+					return toolError("add task", err)
+				}
+
+				var parentID *project.TaskID
+				if ata.ParentID != nil {
+					parentID = lo.ToPtr(project.TaskID(*ata.ParentID))
 				}
 
 				err = h.createTaskHandler.Handle(ctx, commands.CreateTask{
-					TaskID:       taskID,
-					Title:        ata.Title,
-					Description:  ata.Description,
-					AssigneeName: ata.Assignee,
+					TaskID:      taskID,
+					BoardID:     boardID,
+					Title:       ata.Title,
+					Description: ata.Description,
+					Assignees:   ata.Assignees,
+					Watchers:    ata.Watchers,
+					ParentID:    parentID,
+					Priority:    toPriority(ata.Priority),
+					StoryPoints: ata.StoryPoints,
+					Labels:      ata.Labels,
 				})
 				if err != nil {
-					return "couldn't add task", err
+					return toolError("add task", err)
 				}
 
 				return "added task succesfully", nil
@@ -134,7 +364,7 @@ func (h *chatWithProjectHandler) Handle(
 		},
 		project.Tool[SearchAllDocumentsArgs]{
 			FuncName:    "search_all_documents",
-			Description: "Searches through all documents, attached to ANY task based on embeddings, gets back the most likely results for the user's query",
+			Description: "Searches through all documents, attached to ANY task on the board based on embeddings, gets back the most likely results for the user's query",
 			Params: []project.ToolParam{
 				{
 					Name:      "query",
@@ -143,7 +373,7 @@ func (h *chatWithProjectHandler) Handle(
 				},
 			},
 			Handler: func(ctx context.Context, sdfta SearchAllDocumentsArgs) (string, error) {
-				res, err := h.embeddings.SearchAllDocuments(ctx, sdfta.Query)
+				res, err := h.embeddings.SearchDocumentsForBoard(ctx, boardID, sdfta.Query)
 				if err != nil {
 					return "couldn't search documents", fmt.Errorf("search documents: %w", err)
 				}
@@ -172,6 +402,15 @@ func (h *chatWithProjectHandler) Handle(
 				},
 			},
 			Handler: func(ctx context.Context, sdfta SearchDocumentsForTaskArgs) (string, error) {
+				task, err := h.repo.GetByID(ctx, project.TaskID(sdfta.TaskID))
+				if err != nil {
+					return toolError("search the task", err)
+				}
+
+				if err := task.BelongsTo(boardID); err != nil {
+					return toolError("search the task", err)
+				}
+
 				res, err := h.embeddings.SearchDocumentsForTask(
 					ctx,
 					project.TaskID(sdfta.TaskID),
@@ -189,22 +428,80 @@ func (h *chatWithProjectHandler) Handle(
 				return string(marshaled), nil
 			},
 		},
-		project.Tool[struct{}]{
-			FuncName:    "get_tasks",
-			Description: "Get all available tasks from the board",
-			Params:      []project.ToolParam{},
-			Handler: func(ctx context.Context, _ struct{}) (string, error) {
-				tasks, err := h.repo.AllTasks(ctx)
+		project.Tool[GetTasksArgs]{
+			FuncName: "get_tasks",
+			Description: fmt.Sprintf(
+				"Get a page of the board's tasks, subtasks are nested under their parent task when both are on the page. All the arguments are optional: filter on statuses, assignees (IDs or display names), due dates (dueFrom and dueTo as YYYY-MM-DD, both included), a text in the title or description, labels (tasks with all of them) and updatedSince (YYYY-MM-DD). Sort with sortBy, one of %s (column, the order of the board, by default), and descending. Pages have up to %d tasks (limit), pass the next_cursor of the result as cursor to get the next page, with the same filters and sort",
+				joinSortFields(),
+				project.MaxTaskPageSize,
+			),
+			Params: []project.ToolParam{
+				{
+					Name:      "statuses",
+					ParamType: "array",
+					ItemsType: "string",
+				},
+				{
+					Name:      "assignees",
+					ParamType: "array",
+					ItemsType: "string",
+				},
+				{
+					Name:      "dueFrom",
+					ParamType: "string",
+				},
+				{
+					Name:      "dueTo",
+					ParamType: "string",
+				},
+				{
+					Name:      "text",
+					ParamType: "string",
+				},
+				{
+					Name:      "labels",
+					ParamType: "array",
+					ItemsType: "string",
+				},
+				{
+					Name:      "updatedSince",
+					ParamType: "string",
+				},
+				{
+					Name:      "sortBy",
+					ParamType: "string",
+				},
+				{
+					Name:      "descending",
+					ParamType: "boolean",
+				},
+				{
+					Name:      "cursor",
+					ParamType: "string",
+				},
+				{
+					Name:      "limit",
+					ParamType: "integer",
+				},
+			},
+			Handler: func(ctx context.Context, args GetTasksArgs) (string, error) {
+				query, err := args.toQuery(boardID)
 				if err != nil {
-					return "", fmt.Errorf("get tasks: %w", err)
+					return fmt.Sprintf("couldn't get the tasks: %s", err), nil
 				}
 
-				snaps := make([]*project.TaskSnapshot, len(tasks))
-				for i, task := range tasks {
-					snaps[i] = task.GetSnapshot()
+				page, err := h.listTasksHandler.Handle(ctx, query)
+				if err != nil {
+					return toolError("get the tasks", err)
 				}
 
-				marshaled, err := json.Marshal(snaps)
+				marshaled, err := json.Marshal(struct {
+					Tasks      []project.TaskNode `json:"tasks"`
+					NextCursor *string            `json:"next_cursor"`
+				}{
+					Tasks:      project.TaskTree(page.Tasks),
+					NextCursor: page.NextCursor,
+				})
 				if err != nil {
 					return "", fmt.Errorf("marshal tasks snapshot: %w", err)
 				}
@@ -213,8 +510,12 @@ func (h *chatWithProjectHandler) Handle(
 			},
 		},
 		project.Tool[EditTaskArgs]{
-			FuncName:    "edit_task",
-			Description: "Edit a task, only the taskID is required. The allowed values for status are pending, in_progress and in_review. This function cannot be used to mark a task as complete.",
+			FuncName: "edit_task",
+			Description: fmt.Sprintf(
+				"Edit a task, only the taskID is required. The allowed values for status are %s. This function cannot be used to mark a task as complete. The priority goes from p0 (most urgent) to p3. Passing assignees, watchers or labels replaces all the assignees, watchers or labels of the task, an empty list of assignees unassigns everyone. Watchers are notified about the task without being assigned to it, they can't be assignees too. The available labels are: %s",
+				editableStatuses(workflow),
+				strings.Join(labelNames, ", "),
+			),
 			Params: []project.ToolParam{
 				{
 					Name:      "taskID",
@@ -234,56 +535,413 @@ func (h *chatWithProjectHandler) Handle(
 					ParamType: "string",
 				},
 				{
-					Name:      "assigneeName",
-					ParamType: "string",
+					Name:      "assignees",
+					ParamType: "array",
+					ItemsType: "string",
+				},
+				{
+					Name:      "watchers",
+					ParamType: "array",
+					ItemsType: "string",
 				},
 				{
 					Name:      "status",
 					ParamType: "string",
 				},
+				{
+					Name:      "priority",
+					ParamType: "string",
+				},
+				{
+					Name:      "storyPoints",
+					ParamType: "integer",
+				},
+				{
+					Name:      "labels",
+					ParamType: "array",
+					ItemsType: "string",
+				},
 			},
 			Handler: func(ctx context.Context, cmd EditTaskArgs) (string, error) {
-				err := h.repo.UpdateTask(
+				err := h.editTask(ctx, boardID, commands.EditTask{
+					TaskID:      cmd.TaskID,
+					Title:       cmd.Title,
+					Description: cmd.Description,
+					DueDate:     cmd.DueDate,
+					Assignees:   cmd.Assignees,
+					Watchers:    cmd.Watchers,
+					Status:      cmd.Status,
+					Priority:    toPriority(cmd.Priority),
+					StoryPoints: cmd.StoryPoints,
+					Labels:      cmd.Labels,
+				})
+				if err != nil {
+					return toolError("edit task", err)
+				}
+
+				return "Edited task", nil
+			},
+		},
+		project.Tool[struct{}]{
+			FuncName:    "get_current_sprint",
+			Description: "Get the active sprint of the board with its goal, dates, the tasks planned for it and how many tasks and story points are completed",
+			Params:      []project.ToolParam{},
+			Handler: func(ctx context.Context, _ struct{}) (string, error) {
+				current, err := h.currentSprintHandler.Handle(
 					ctx,
-					project.TaskID(cmd.TaskID),
-					func(t *project.Task) (*project.Task, error) {
-						var status *project.TaskStatus
-						if cmd.Status != nil {
-							s := project.TaskStatus(*cmd.Status)
-							status = &s
-						}
-
-						if err := t.Edit(cmd.Title, cmd.Description, cmd.DueDate, cmd.AssigneeName, status); err != nil {
-							return nil, err
-						}
-						return t, nil
-					},
+					queries.CurrentSprint{BoardID: boardID},
 				)
+				if errors.Is(err, project.ErrNoActiveSprint) {
+					return "there is no active sprint on the board", nil
+				}
 				if err != nil {
-					return "couldn't edit task", nil
+					return toolError("get the current sprint", err)
 				}
 
-				return "Edited task", nil
+				tasks := make([]project.TaskSnapshot, len(current.Tasks))
+				for i, task := range current.Tasks {
+					tasks[i] = *task.GetSnapshot()
+				}
+
+				marshaled, err := json.Marshal(struct {
+					Sprint *project.SprintSnapshot `json:"sprint"`
+					Tasks  []project.TaskSnapshot  `json:"tasks"`
+					Stats  project.SprintStats     `json:"stats"`
+				}{
+					Sprint: current.Sprint.GetSnapshot(),
+					Tasks:  tasks,
+					Stats:  current.Stats,
+				})
+				if err != nil {
+					return "", fmt.Errorf("marshal sprint snapshot: %w", err)
+				}
+
+				return string(marshaled), nil
+			},
+		},
+		project.Tool[GetEpicProgressArgs]{
+			FuncName:    "get_epic_progress",
+			Description: "Get an epic of the board by ID or name with its description, target date, tasks and progress: the percentage of completed tasks, the open and overdue tasks, the projected finish date and whether it's behind its target date",
+			Params: []project.ToolParam{
+				{
+					Name:      "epic",
+					ParamType: "string",
+					Required:  true,
+				},
+			},
+			Handler: func(ctx context.Context, args GetEpicProgressArgs) (string, error) {
+				rollup, err := h.epicProgressHandler.Handle(ctx, queries.EpicProgress{
+					BoardID: boardID,
+					Epic:    args.Epic,
+				})
+				if errors.Is(err, project.ErrEpicNotFound) {
+					epics, err := h.epicsHandler.Handle(ctx, queries.BoardEpics{BoardID: boardID})
+					if err != nil {
+						return "", fmt.Errorf("get board epics: %w", err)
+					}
+
+					names := lo.Map(epics, func(e project.Epic, _ int) string {
+						return e.GetSnapshot().Name
+					})
+					if len(names) == 0 {
+						return "there are no epics on the board", nil
+					}
+					return fmt.Sprintf(
+						"couldn't find the epic, the board's epics are: %s",
+						strings.Join(names, ", "),
+					), nil
+				}
+				if err != nil {
+					return toolError("get the epic progress", err)
+				}
+
+				tasks := make([]project.TaskSnapshot, len(rollup.Tasks))
+				for i, task := range rollup.Tasks {
+					tasks[i] = *task.GetSnapshot()
+				}
+
+				marshaled, err := json.Marshal(struct {
+					Epic     *project.EpicSnapshot  `json:"epic"`
+					Tasks    []project.TaskSnapshot `json:"tasks"`
+					Progress project.EpicProgress   `json:"progress"`
+				}{
+					Epic:     rollup.Epic.GetSnapshot(),
+					Tasks:    tasks,
+					Progress: rollup.Progress,
+				})
+				if err != nil {
+					return "", fmt.Errorf("marshal epic progress: %w", err)
+				}
+
+				return string(marshaled), nil
+			},
+		},
+		project.Tool[MoveTaskToSprintArgs]{
+			FuncName:    "move_task_to_sprint",
+			Description: "Moves a task into a sprint of the board, only the taskID is required. Without a sprintID the task is moved into the active sprint",
+			Params: []project.ToolParam{
+				{
+					Name:      "taskID",
+					ParamType: "string",
+					Required:  true,
+				},
+				{
+					Name:      "sprintID",
+					ParamType: "string",
+				},
+			},
+			Handler: func(ctx context.Context, args MoveTaskToSprintArgs) (string, error) {
+				task, err := h.repo.GetByID(ctx, project.TaskID(args.TaskID))
+				if err != nil {
+					return toolError("move task", err)
+				}
+
+				if err := task.BelongsTo(boardID); err != nil {
+					return toolError("move task", err)
+				}
+
+				sprintID := lo.FromPtr(args.SprintID)
+				if sprintID == "" {
+					sprints, err := h.sprints.BoardSprints(ctx, boardID)
+					if err != nil {
+						return toolError("move task", err)
+					}
+
+					active, err := project.ActiveSprint(sprints)
+					if err != nil {
+						return toolError("move task", err)
+					}
+					sprintID = string(active.ID())
+				}
+
+				err = h.addTaskToSprintHandler.Handle(ctx, commands.AddTaskToSprint{
+					SprintID: sprintID,
+					TaskID:   args.TaskID,
+				})
+				if err != nil {
+					return toolError("move task", err)
+				}
+
+				return "Moved task to the sprint", nil
+			},
+		},
+		project.Tool[CheckChecklistItemArgs]{
+			FuncName:    "check_checklist_item",
+			Description: "Ticks an item of a task's checklist as done, the taskID and the itemID (from the task's checklist) are required. Pass done as false to untick it",
+			Params: []project.ToolParam{
+				{
+					Name:      "taskID",
+					ParamType: "string",
+					Required:  true,
+				},
+				{
+					Name:      "itemID",
+					ParamType: "string",
+					Required:  true,
+				},
+				{
+					Name:      "done",
+					ParamType: "boolean",
+				},
+			},
+			Handler: func(ctx context.Context, args CheckChecklistItemArgs) (string, error) {
+				task, err := h.repo.GetByID(ctx, project.TaskID(args.TaskID))
+				if err != nil {
+					return toolError("check the item", err)
+				}
+
+				if err := task.BelongsTo(boardID); err != nil {
+					return toolError("check the item", err)
+				}
+
+				// Ticking is the default so asking twice doesn't untick the item
+				done := lo.FromPtrOr(args.Done, true)
+				err = h.toggleChecklistHandler.Handle(ctx, commands.ToggleChecklistItem{
+					TaskID: args.TaskID,
+					ItemID: args.ItemID,
+					Done:   &done,
+				})
+				if err != nil {
+					return toolError("check the item", err)
+				}
+
+				return lo.Ternary(done, "Checked the item", "Unchecked the item"), nil
+			},
+		},
+		project.Tool[GetTaskCommentsArgs]{
+			FuncName:    "get_task_comments",
+			Description: "Get the discussion of a task: its comments with their author, markdown body, mentions and timestamps, replies are nested under the comment they answer",
+			Params: []project.ToolParam{
+				{
+					Name:      "taskID",
+					ParamType: "string",
+					Required:  true,
+				},
+			},
+			Handler: func(ctx context.Context, args GetTaskCommentsArgs) (string, error) {
+				task, err := h.repo.GetByID(ctx, project.TaskID(args.TaskID))
+				if err != nil {
+					return toolError("get the comments", err)
+				}
+
+				if err := task.BelongsTo(boardID); err != nil {
+					return toolError("get the comments", err)
+				}
+
+				thread, err := h.taskCommentsHandler.Handle(
+					ctx,
+					queries.TaskComments{TaskID: project.TaskID(args.TaskID)},
+				)
+				if err != nil {
+					return toolError("get the comments", err)
+				}
+
+				marshaled, err := json.Marshal(thread)
+				if err != nil {
+					return "", fmt.Errorf("marshal comments: %w", err)
+				}
+
+				return string(marshaled), nil
+			},
+		},
+		project.Tool[GetTaskHistoryArgs]{
+			FuncName:    "get_task_history",
+			Description: "Get the history of a task, oldest first: who changed which field from what value to what value, when, and whether it was changed through the api or by the assistant. Pass since as a YYYY-MM-DD date to only get the changes from that day on",
+			Params: []project.ToolParam{
+				{
+					Name:      "taskID",
+					ParamType: "string",
+					Required:  true,
+				},
+				{
+					Name:      "since",
+					ParamType: "string",
+				},
+			},
+			Handler: func(ctx context.Context, args GetTaskHistoryArgs) (string, error) {
+				task, err := h.repo.GetByID(ctx, project.TaskID(args.TaskID))
+				if err != nil {
+					return toolError("get the history", err)
+				}
+
+				if err := task.BelongsTo(boardID); err != nil {
+					return toolError("get the history", err)
+				}
+
+				var since *time.Time
+				if args.Since != nil && *args.Since != "" {
+					parsed, err := time.Parse(time.DateOnly, *args.Since)
+					if err != nil {
+						return "couldn't get the history: since must be a YYYY-MM-DD date", nil
+					}
+					since = &parsed
+				}
+
+				history, err := h.taskHistoryHandler.Handle(ctx, queries.TaskHistory{
+					TaskID: project.TaskID(args.TaskID),
+					Since:  since,
+				})
+				if err != nil {
+					return toolError("get the history", err)
+				}
+
+				snapshots := make([]project.ActivitySnapshot, len(history))
+				for i := range history {
+					snapshots[i] = history[i].GetSnapshot()
+				}
+
+				marshaled, err := json.Marshal(snapshots)
+				if err != nil {
+					return "", fmt.Errorf("marshal history: %w", err)
+				}
+
+				return string(marshaled), nil
 			},
 		},
 	})
 }
 
-func (op *ChatWithProject) enrichWithSystemPrompt() {
+// Times the chat tries to edit a task before giving up on the changes made at the same time
+const maxEditAttempts = 3
+
+// editTask edits the task from the version it just read, so that a change made in between
+// isn't overwritten, and tries again from the new version when there was one
+func (h *chatWithProjectHandler) editTask(
+	ctx context.Context,
+	boardID project.BoardID,
+	cmd commands.EditTask,
+) error {
+	for range maxEditAttempts {
+		task, err := h.repo.GetByID(ctx, project.TaskID(cmd.TaskID))
+		if err != nil {
+			return err
+		}
+
+		if err := task.BelongsTo(boardID); err != nil {
+			return err
+		}
+
+		cmd.Version = lo.ToPtr(task.Version())
+		err = h.editTaskHandler.Handle(ctx, cmd)
+		if !errors.Is(err, project.ErrTaskVersionMismatch) &&
+			!errors.Is(err, project.ErrTaskChangedConcurrently) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("%w: %s", project.ErrTaskChangedConcurrently, cmd.TaskID)
+}
+
+// toolError tells the assistant why a tool failed when the domain rejected the call, with the
+// kind and code of the error so it can fix the call or explain it, other errors end the chat
+func toolError(action string, err error) (string, error) {
+	var domainErr *project.Error
+	if errors.As(err, &domainErr) {
+		return fmt.Sprintf(
+			"couldn't %s: %s (kind: %s, code: %s)",
+			action,
+			err,
+			domainErr.Kind(),
+			domainErr.Code(),
+		), nil
+	}
+
+	return fmt.Sprintf("couldn't %s", action), fmt.Errorf("%s: %w", action, err)
+}
+
+func toPriority(priority *string) *project.TaskPriority {
+	if priority == nil {
+		return nil
+	}
+	return lo.ToPtr(project.TaskPriority(strings.ToLower(*priority)))
+}
+
+// editableStatuses lists the statuses the assistant can move tasks to
+func editableStatuses(workflow project.Workflow) string {
+	statuses := make([]string, 0, len(workflow.Statuses()))
+	for _, status := range workflow.Statuses() {
+		if status != project.TaskStatusCompleted {
+			statuses = append(statuses, string(status))
+		}
+	}
+	return strings.Join(statuses, ", ")
+}
+
+func (op *ChatWithProject) enrichWithSystemPrompt(assignees []string) {
 	op.Messages = append([]Message{
 		{
 			Role: "system",
 			Content: []Content{
 				{
 					Type: "text",
-					Text: NewSystemPrompt(time.Now()),
+					Text: NewSystemPrompt(time.Now(), assignees),
 				},
 			},
 		},
 	}, op.Messages...)
 }
 
-func NewSystemPrompt(currentTime time.Time) string {
+func NewSystemPrompt(currentTime time.Time, assignees []string) string {
 	additionalInstruction := "You MUST not talk about anything else other than things related to the team's kanban board and the sprint's backlog, and other project related things (such as tech questions)."
 	// additionalInstruction := ""
 	return fmt.Sprintf(`
@@ -303,21 +961,28 @@ Current context:
 <existent_assignees>
 
 Available tools:
-1. get_tasks: Retrieve information for all tasks (ID, title, description, due date, assignee, timestamps, status, associated files).
+1. get_tasks: Retrieve a page of the board's tasks, filtered and sorted as needed, with their information (ID, title, description, due date, assignees, watchers, timestamps, status, associated files, subtasks and their progress, the epic it's part of, the tasks blocking it and whether it's blocked, labels, priority, story points, the checklist with its completion percentage, the values of the board's custom fields and the time logged on it in minutes, its status changes and the seconds spent in each status).
 2. edit_task: Edit a specific task.
 3. search_documents_for_task: Searches through all documents attached to a task based on embeddings, gets back the embedding search for the user's query
-4. search_all_documents: Searches through all documents, attached to ANY task based on embeddings, gets back the most likely results for the user's query
-5. add_task: Adds a task to the backlog, only the title is required, description and the assignee are optional
+4. search_all_documents: Searches through all documents, attached to ANY task on the board based on embeddings, gets back the most likely results for the user's query
+5. add_task: Adds a task to the backlog, only the title is required, description, the assignees, the watchers, the priority, the story points and the labels are optional. It can also add a subtask to an existing task
+6. get_current_sprint: Retrieve the active sprint (goal, start and end dates), the tasks planned for it and its progress in tasks and story points
+7. move_task_to_sprint: Moves a task into a sprint, by default into the active sprint
+8. get_task_comments: Retrieve the discussion of a task (threaded comments with their author, body and mentions), use it to summarize what was discussed
+9. get_task_history: Retrieve who changed what on a task and when, optionally since a date, use it to answer questions like "what happened to this task last week?"
+10. check_checklist_item: Tick (or untick) an item of a task's checklist, e.g. an acceptance criterion that's met
+11. get_epic_progress: Retrieve an epic (a deliverable grouping tasks across sprints) by name or ID, its tasks, percent complete, open and overdue tasks and projected finish date, use it to answer questions like "how far along is the KYC epic?"
 
 Instructions:
 1. Analyze the user's message and determine the appropriate action.
-2. If you need task information, use the get_tasks tool.
+2. If you need task information, use the get_tasks tool. Filter the tasks to the ones you need rather than getting every task, and only ask for the next page when the answer needs it.
 3. If you need to edit a task, use the edit_task tool. Always use get_tasks first if you don't have enough information to use edit_task.
-4. After editing a task OR adding a task, end your response with "@refetch" to update the sprint board in real-time.
+4. After editing a task, adding a task, moving a task to a sprint OR checking a checklist item, end your response with "@refetch" to update the sprint board in real-time.
 5. Provide clear, direct answers without announcing your thought process or using formulaic starts.
 6. For complex problems, break them down systematically but present the solution conversationally.
 7. If asked by the user "where can I find this information" - respond with the task with the attached files or where you got the information from in detail: the task id, its status, and to whom the task is assigned to
-8. CogniMaster can only assign tasks to existent assignees
+8. CogniMaster can only assign tasks to existent assignees, and only existent assignees can watch tasks. A task can have several assignees
+9. A blocked task cannot be moved to in_progress until all the tasks in its blocked_by are completed
 
 Before responding, organize your thoughts inside <analysis> tags to ensure a clear, non-repetitive response. Consider the following:
 - Summarize the main request or question from the user
@@ -327,5 +992,5 @@ Before responding, organize your thoughts inside <analysis> tags to ensure a cle
 
 Now, please process the user's message and provide an appropriate response.
 Below is the user's message:
-`, additionalInstruction, currentTime.Format("2006-01-02"), strings.Join([]string{"John", "Mary", "Steve", "Laura", "Alex"}, ","))
+`, additionalInstruction, currentTime.Format("2006-01-02"), strings.Join(assignees, ","))
 }
@@ -0,0 +1,49 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type AllBoards struct {
+	IncludeArchived bool
+}
+
+type AllBoardsHandler decorator.QueryHandler[AllBoards, []project.Board]
+
+type allBoardsHandler struct {
+	boards AllBoardsReadModel
+}
+
+type AllBoardsReadModel interface {
+	AllBoards(ctx context.Context) ([]project.Board, error)
+}
+
+func NewAllBoardsHandler(repo AllBoardsReadModel, logger *slog.Logger) AllBoardsHandler {
+	return decorator.ApplyQueryDecorators(
+		&allBoardsHandler{boards: repo},
+		logger,
+	)
+}
+
+func (h *allBoardsHandler) Handle(ctx context.Context, query AllBoards) ([]project.Board, error) {
+	boards, err := h.boards.AllBoards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.IncludeArchived {
+		return boards, nil
+	}
+
+	active := make([]project.Board, 0, len(boards))
+	for _, board := range boards {
+		if !board.IsArchived() {
+			active = append(active, board)
+		}
+	}
+	return active, nil
+}
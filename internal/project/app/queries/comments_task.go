@@ -0,0 +1,46 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type TaskComments struct {
+	TaskID project.TaskID
+}
+
+type TaskCommentsHandler decorator.QueryHandler[TaskComments, []project.CommentNode]
+
+type taskCommentsHandler struct {
+	comments TaskCommentsReadModel
+}
+
+type TaskCommentsReadModel interface {
+	TaskComments(ctx context.Context, taskID project.TaskID) ([]project.Comment, error)
+}
+
+func NewTaskCommentsHandler(
+	repo TaskCommentsReadModel,
+	logger *slog.Logger,
+) TaskCommentsHandler {
+	return decorator.ApplyQueryDecorators(
+		&taskCommentsHandler{comments: repo},
+		logger,
+	)
+}
+
+func (h *taskCommentsHandler) Handle(
+	ctx context.Context,
+	query TaskComments,
+) ([]project.CommentNode, error) {
+	comments, err := h.comments.TaskComments(ctx, query.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("get task comments: %w", err)
+	}
+
+	return project.CommentThread(comments), nil
+}
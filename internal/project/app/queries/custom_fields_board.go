@@ -0,0 +1,40 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type BoardCustomFields struct {
+	BoardID project.BoardID
+}
+
+type BoardCustomFieldsHandler decorator.QueryHandler[BoardCustomFields, []project.CustomField]
+
+type boardCustomFieldsHandler struct {
+	customFields BoardCustomFieldsReadModel
+}
+
+type BoardCustomFieldsReadModel interface {
+	BoardCustomFields(ctx context.Context, boardID project.BoardID) ([]project.CustomField, error)
+}
+
+func NewBoardCustomFieldsHandler(
+	repo BoardCustomFieldsReadModel,
+	logger *slog.Logger,
+) BoardCustomFieldsHandler {
+	return decorator.ApplyQueryDecorators(
+		&boardCustomFieldsHandler{customFields: repo},
+		logger,
+	)
+}
+
+func (h *boardCustomFieldsHandler) Handle(
+	ctx context.Context,
+	query BoardCustomFields,
+) ([]project.CustomField, error) {
+	return h.customFields.BoardCustomFields(ctx, query.BoardID)
+}
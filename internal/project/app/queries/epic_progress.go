@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type EpicProgress struct {
+	BoardID project.BoardID
+	// ID or name of an epic of the board
+	Epic string
+}
+
+// EpicRollup is an epic of a board with its tasks and how far along they are
+type EpicRollup struct {
+	Epic     *project.Epic
+	Tasks    []project.Task
+	Progress project.EpicProgress
+}
+
+type EpicProgressHandler decorator.QueryHandler[EpicProgress, EpicRollup]
+
+type epicProgressHandler struct {
+	epics BoardEpicsReadModel
+	tasks BoardTasksReadModel
+}
+
+func NewEpicProgressHandler(
+	epics BoardEpicsReadModel,
+	tasks BoardTasksReadModel,
+	logger *slog.Logger,
+) EpicProgressHandler {
+	return decorator.ApplyQueryDecorators(
+		&epicProgressHandler{epics: epics, tasks: tasks},
+		logger,
+	)
+}
+
+func (h *epicProgressHandler) Handle(ctx context.Context, query EpicProgress) (EpicRollup, error) {
+	epics, err := h.epics.BoardEpics(ctx, query.BoardID)
+	if err != nil {
+		return EpicRollup{}, fmt.Errorf("get board epics: %w", err)
+	}
+
+	epic, err := project.FindEpic(epics, query.Epic)
+	if err != nil {
+		return EpicRollup{}, err
+	}
+
+	tasks, err := h.tasks.BoardTasks(ctx, query.BoardID)
+	if err != nil {
+		return EpicRollup{}, fmt.Errorf("get board tasks: %w", err)
+	}
+
+	epicTasks := project.EpicTasks(tasks, epic.ID())
+	return EpicRollup{
+		Epic:     epic,
+		Tasks:    epicTasks,
+		Progress: project.NewEpicProgress(epic, epicTasks, time.Now()),
+	}, nil
+}
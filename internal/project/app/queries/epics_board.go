@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type BoardEpics struct {
+	BoardID project.BoardID
+}
+
+type BoardEpicsHandler decorator.QueryHandler[BoardEpics, []project.Epic]
+
+type boardEpicsHandler struct {
+	epics BoardEpicsReadModel
+}
+
+type BoardEpicsReadModel interface {
+	BoardEpics(ctx context.Context, boardID project.BoardID) ([]project.Epic, error)
+}
+
+func NewBoardEpicsHandler(repo BoardEpicsReadModel, logger *slog.Logger) BoardEpicsHandler {
+	return decorator.ApplyQueryDecorators(
+		&boardEpicsHandler{epics: repo},
+		logger,
+	)
+}
+
+func (h *boardEpicsHandler) Handle(
+	ctx context.Context,
+	query BoardEpics,
+) ([]project.Epic, error) {
+	return h.epics.BoardEpics(ctx, query.BoardID)
+}
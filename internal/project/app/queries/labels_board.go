@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type BoardLabels struct {
+	BoardID project.BoardID
+}
+
+type BoardLabelsHandler decorator.QueryHandler[BoardLabels, []project.Label]
+
+type boardLabelsHandler struct {
+	labels BoardLabelsReadModel
+}
+
+type BoardLabelsReadModel interface {
+	BoardLabels(ctx context.Context, boardID project.BoardID) ([]project.Label, error)
+}
+
+func NewBoardLabelsHandler(repo BoardLabelsReadModel, logger *slog.Logger) BoardLabelsHandler {
+	return decorator.ApplyQueryDecorators(
+		&boardLabelsHandler{labels: repo},
+		logger,
+	)
+}
+
+func (h *boardLabelsHandler) Handle(
+	ctx context.Context,
+	query BoardLabels,
+) ([]project.Label, error) {
+	return h.labels.BoardLabels(ctx, query.BoardID)
+}
@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type BoardMembers struct {
+	BoardID project.BoardID
+}
+
+type BoardMembersHandler decorator.QueryHandler[BoardMembers, []project.Member]
+
+type boardMembersHandler struct {
+	members BoardMembersReadModel
+}
+
+type BoardMembersReadModel interface {
+	BoardMembers(ctx context.Context, boardID project.BoardID) ([]project.Member, error)
+}
+
+func NewBoardMembersHandler(repo BoardMembersReadModel, logger *slog.Logger) BoardMembersHandler {
+	return decorator.ApplyQueryDecorators(
+		&boardMembersHandler{members: repo},
+		logger,
+	)
+}
+
+func (h *boardMembersHandler) Handle(
+	ctx context.Context,
+	query BoardMembers,
+) ([]project.Member, error) {
+	return h.members.BoardMembers(ctx, query.BoardID)
+}
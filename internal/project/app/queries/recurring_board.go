@@ -0,0 +1,40 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type BoardRecurringTasks struct {
+	BoardID project.BoardID
+}
+
+type BoardRecurringTasksHandler decorator.QueryHandler[BoardRecurringTasks, []project.RecurringTask]
+
+type boardRecurringTasksHandler struct {
+	recurring BoardRecurringTasksReadModel
+}
+
+type BoardRecurringTasksReadModel interface {
+	BoardRecurringTasks(ctx context.Context, boardID project.BoardID) ([]project.RecurringTask, error)
+}
+
+func NewBoardRecurringTasksHandler(
+	repo BoardRecurringTasksReadModel,
+	logger *slog.Logger,
+) BoardRecurringTasksHandler {
+	return decorator.ApplyQueryDecorators(
+		&boardRecurringTasksHandler{recurring: repo},
+		logger,
+	)
+}
+
+func (h *boardRecurringTasksHandler) Handle(
+	ctx context.Context,
+	query BoardRecurringTasks,
+) ([]project.RecurringTask, error) {
+	return h.recurring.BoardRecurringTasks(ctx, query.BoardID)
+}
@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type CurrentSprint struct {
+	BoardID project.BoardID
+}
+
+// SprintBoard is the active sprint of a board with the tasks planned for it
+type SprintBoard struct {
+	Sprint *project.Sprint
+	Tasks  []project.Task
+	Stats  project.SprintStats
+}
+
+type CurrentSprintHandler decorator.QueryHandler[CurrentSprint, SprintBoard]
+
+type currentSprintHandler struct {
+	sprints BoardSprintsReadModel
+	tasks   BoardTasksReadModel
+}
+
+func NewCurrentSprintHandler(
+	sprints BoardSprintsReadModel,
+	tasks BoardTasksReadModel,
+	logger *slog.Logger,
+) CurrentSprintHandler {
+	return decorator.ApplyQueryDecorators(
+		&currentSprintHandler{sprints: sprints, tasks: tasks},
+		logger,
+	)
+}
+
+func (h *currentSprintHandler) Handle(
+	ctx context.Context,
+	query CurrentSprint,
+) (SprintBoard, error) {
+	sprints, err := h.sprints.BoardSprints(ctx, query.BoardID)
+	if err != nil {
+		return SprintBoard{}, fmt.Errorf("get board sprints: %w", err)
+	}
+
+	sprint, err := project.ActiveSprint(sprints)
+	if err != nil {
+		return SprintBoard{}, err
+	}
+
+	tasks, err := h.tasks.BoardTasks(ctx, query.BoardID)
+	if err != nil {
+		return SprintBoard{}, fmt.Errorf("get board tasks: %w", err)
+	}
+
+	sprintTasks := project.SprintTasks(tasks, sprint.ID())
+	return SprintBoard{
+		Sprint: sprint,
+		Tasks:  sprintTasks,
+		Stats:  project.NewSprintStats(sprintTasks),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type BoardSprints struct {
+	BoardID project.BoardID
+}
+
+type BoardSprintsHandler decorator.QueryHandler[BoardSprints, []project.Sprint]
+
+type boardSprintsHandler struct {
+	sprints BoardSprintsReadModel
+}
+
+type BoardSprintsReadModel interface {
+	BoardSprints(ctx context.Context, boardID project.BoardID) ([]project.Sprint, error)
+}
+
+func NewBoardSprintsHandler(repo BoardSprintsReadModel, logger *slog.Logger) BoardSprintsHandler {
+	return decorator.ApplyQueryDecorators(
+		&boardSprintsHandler{sprints: repo},
+		logger,
+	)
+}
+
+func (h *boardSprintsHandler) Handle(
+	ctx context.Context,
+	query BoardSprints,
+) ([]project.Sprint, error) {
+	return h.sprints.BoardSprints(ctx, query.BoardID)
+}
@@ -0,0 +1,69 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type GetTask struct {
+	TaskID project.TaskID
+}
+
+// TaskDetails is a task with its whole history and comment threads
+type TaskDetails struct {
+	Task     *project.Task
+	History  []project.Activity
+	Comments []project.CommentNode
+}
+
+type GetTaskHandler decorator.QueryHandler[GetTask, TaskDetails]
+
+type getTaskHandler struct {
+	tasks    GetTaskReadModel
+	history  TaskHistoryReadModel
+	comments TaskCommentsReadModel
+}
+
+type GetTaskReadModel interface {
+	// GetByID returns ErrTaskNotFound if there's no such task
+	GetByID(ctx context.Context, id project.TaskID) (*project.Task, error)
+}
+
+func NewGetTaskHandler(
+	tasks GetTaskReadModel,
+	history TaskHistoryReadModel,
+	comments TaskCommentsReadModel,
+	logger *slog.Logger,
+) GetTaskHandler {
+	return decorator.ApplyQueryDecorators(
+		&getTaskHandler{tasks: tasks, history: history, comments: comments},
+		logger,
+	)
+}
+
+func (h *getTaskHandler) Handle(ctx context.Context, query GetTask) (TaskDetails, error) {
+	task, err := h.tasks.GetByID(ctx, query.TaskID)
+	if err != nil {
+		return TaskDetails{}, fmt.Errorf("get task: %w", err)
+	}
+
+	history, err := h.history.TaskHistory(ctx, query.TaskID, nil)
+	if err != nil {
+		return TaskDetails{}, fmt.Errorf("get task history: %w", err)
+	}
+
+	comments, err := h.comments.TaskComments(ctx, query.TaskID)
+	if err != nil {
+		return TaskDetails{}, fmt.Errorf("get task comments: %w", err)
+	}
+
+	return TaskDetails{
+		Task:     task,
+		History:  history,
+		Comments: project.CommentThread(comments),
+	}, nil
+}
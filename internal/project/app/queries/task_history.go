@@ -0,0 +1,44 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type TaskHistory struct {
+	TaskID project.TaskID
+	// Only the changes made from this time on, all of them if nil
+	Since *time.Time
+}
+
+type TaskHistoryHandler decorator.QueryHandler[TaskHistory, []project.Activity]
+
+type taskHistoryHandler struct {
+	history TaskHistoryReadModel
+}
+
+type TaskHistoryReadModel interface {
+	TaskHistory(
+		ctx context.Context,
+		id project.TaskID,
+		since *time.Time,
+	) ([]project.Activity, error)
+}
+
+func NewTaskHistoryHandler(repo TaskHistoryReadModel, logger *slog.Logger) TaskHistoryHandler {
+	return decorator.ApplyQueryDecorators(
+		&taskHistoryHandler{history: repo},
+		logger,
+	)
+}
+
+func (h *taskHistoryHandler) Handle(
+	ctx context.Context,
+	query TaskHistory,
+) ([]project.Activity, error) {
+	return h.history.TaskHistory(ctx, query.TaskID, query.Since)
+}
@@ -0,0 +1,32 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type ArchivedTasks struct{}
+
+type ArchivedTasksHandler decorator.QueryHandler[ArchivedTasks, []project.Task]
+
+type archivedTasksHandler struct {
+	tasks ArchivedTasksReadModel
+}
+
+type ArchivedTasksReadModel interface {
+	ArchivedTasks(ctx context.Context) ([]project.Task, error)
+}
+
+func NewArchivedTasksHandler(repo ArchivedTasksReadModel, logger *slog.Logger) ArchivedTasksHandler {
+	return decorator.ApplyQueryDecorators(
+		&archivedTasksHandler{tasks: repo},
+		logger,
+	)
+}
+
+func (h *archivedTasksHandler) Handle(ctx context.Context, _ ArchivedTasks) ([]project.Task, error) {
+	return h.tasks.ArchivedTasks(ctx)
+}
@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type BoardTasks struct {
+	BoardID project.BoardID
+	// Only returns the tasks that have all of these labels, given by ID or name
+	Labels []string
+	// Only returns the tasks that have all of these custom field values, keyed by field ID or name
+	CustomFields map[string]string
+}
+
+type BoardTasksHandler decorator.QueryHandler[BoardTasks, []project.Task]
+
+type boardTasksHandler struct {
+	tasks  BoardTasksReadModel
+	boards BoardReadModel
+}
+
+type BoardTasksReadModel interface {
+	BoardTasks(ctx context.Context, boardID project.BoardID) ([]project.Task, error)
+}
+
+type BoardReadModel interface {
+	GetByID(ctx context.Context, id project.BoardID) (*project.Board, error)
+}
+
+func NewBoardTasksHandler(
+	repo BoardTasksReadModel,
+	boards BoardReadModel,
+	logger *slog.Logger,
+) BoardTasksHandler {
+	return decorator.ApplyQueryDecorators(
+		&boardTasksHandler{tasks: repo, boards: boards},
+		logger,
+	)
+}
+
+func (h *boardTasksHandler) Handle(ctx context.Context, query BoardTasks) ([]project.Task, error) {
+	tasks, err := h.tasks.BoardTasks(ctx, query.BoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The board is needed for the order of its columns
+	board, err := h.boards.GetByID(ctx, query.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("get board: %w", err)
+	}
+
+	tasks = project.FilterTasksByLabels(tasks, query.Labels)
+	tasks = project.FilterTasksByCustomFields(tasks, query.CustomFields)
+	project.SortByColumn(tasks, []project.Board{*board})
+	return tasks, nil
+}
@@ -0,0 +1,42 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type BoardCriticalPath struct {
+	BoardID project.BoardID
+}
+
+type BoardCriticalPathHandler decorator.QueryHandler[BoardCriticalPath, []project.Task]
+
+type boardCriticalPathHandler struct {
+	tasks BoardTasksReadModel
+}
+
+func NewBoardCriticalPathHandler(
+	repo BoardTasksReadModel,
+	logger *slog.Logger,
+) BoardCriticalPathHandler {
+	return decorator.ApplyQueryDecorators(
+		&boardCriticalPathHandler{tasks: repo},
+		logger,
+	)
+}
+
+func (h *boardCriticalPathHandler) Handle(
+	ctx context.Context,
+	query BoardCriticalPath,
+) ([]project.Task, error) {
+	tasks, err := h.tasks.BoardTasks(ctx, query.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("get board tasks: %w", err)
+	}
+
+	return project.CriticalPath(tasks), nil
+}
@@ -0,0 +1,83 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type ListTasks struct {
+	Filter project.TaskFilter
+	// In the order of the board if the field is empty
+	Sort project.TaskSort
+	// Returned with the previous page, empty for the first page
+	Cursor string
+	// Number of tasks per page, project.DefaultTaskPageSize if 0
+	Limit int
+}
+
+// TaskPage is a page of a sorted list of tasks
+type TaskPage struct {
+	Tasks []project.Task
+	// Null on the last page
+	NextCursor *string
+}
+
+type ListTasksHandler decorator.QueryHandler[ListTasks, TaskPage]
+
+type listTasksHandler struct {
+	tasks ListTasksReadModel
+}
+
+type ListTasksReadModel interface {
+	ListTasks(
+		ctx context.Context,
+		filter project.TaskFilter,
+		sort project.TaskSort,
+		after *project.TaskCursor,
+		limit int,
+	) ([]project.Task, error)
+}
+
+func NewListTasksHandler(repo ListTasksReadModel, logger *slog.Logger) ListTasksHandler {
+	return decorator.ApplyQueryDecorators(
+		&listTasksHandler{tasks: repo},
+		logger,
+	)
+}
+
+func (h *listTasksHandler) Handle(ctx context.Context, query ListTasks) (TaskPage, error) {
+	limit, err := project.PageSize(query.Limit)
+	if err != nil {
+		return TaskPage{}, err
+	}
+
+	sort := query.Sort
+	if sort.Field == "" {
+		sort.Field = project.TaskSortColumn
+	}
+
+	var after *project.TaskCursor
+	if query.Cursor != "" {
+		after, err = project.DecodeTaskCursor(query.Cursor, sort)
+		if err != nil {
+			return TaskPage{}, err
+		}
+	}
+
+	// One more task tells whether there's a next page
+	tasks, err := h.tasks.ListTasks(ctx, query.Filter, sort, after, limit+1)
+	if err != nil {
+		return TaskPage{}, err
+	}
+
+	if len(tasks) <= limit {
+		return TaskPage{Tasks: tasks}, nil
+	}
+
+	tasks = tasks[:limit]
+	next := project.NewTaskCursor(sort, &tasks[limit-1]).Encode()
+	return TaskPage{Tasks: tasks, NextCursor: &next}, nil
+}
@@ -0,0 +1,40 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type BoardTemplates struct {
+	BoardID project.BoardID
+}
+
+type BoardTemplatesHandler decorator.QueryHandler[BoardTemplates, []project.TaskTemplate]
+
+type boardTemplatesHandler struct {
+	templates BoardTemplatesReadModel
+}
+
+type BoardTemplatesReadModel interface {
+	BoardTemplates(ctx context.Context, boardID project.BoardID) ([]project.TaskTemplate, error)
+}
+
+func NewBoardTemplatesHandler(
+	repo BoardTemplatesReadModel,
+	logger *slog.Logger,
+) BoardTemplatesHandler {
+	return decorator.ApplyQueryDecorators(
+		&boardTemplatesHandler{templates: repo},
+		logger,
+	)
+}
+
+func (h *boardTemplatesHandler) Handle(
+	ctx context.Context,
+	query BoardTemplates,
+) ([]project.TaskTemplate, error) {
+	return h.templates.BoardTemplates(ctx, query.BoardID)
+}
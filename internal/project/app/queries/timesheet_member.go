@@ -0,0 +1,68 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type MemberTimesheet struct {
+	BoardID project.BoardID
+	// ID or display name of a member of the board
+	Member string
+	// First and last day of the timesheet, both included
+	From time.Time
+	To   time.Time
+}
+
+type MemberTimesheetHandler decorator.QueryHandler[MemberTimesheet, *project.Timesheet]
+
+type memberTimesheetHandler struct {
+	worklogs MemberTimesheetReadModel
+	members  BoardMembersReadModel
+}
+
+type MemberTimesheetReadModel interface {
+	MemberWorklogs(
+		ctx context.Context,
+		memberID project.MemberID,
+		from, to time.Time,
+	) ([]project.Worklog, error)
+}
+
+func NewMemberTimesheetHandler(
+	worklogs MemberTimesheetReadModel,
+	members BoardMembersReadModel,
+	logger *slog.Logger,
+) MemberTimesheetHandler {
+	return decorator.ApplyQueryDecorators(
+		&memberTimesheetHandler{worklogs: worklogs, members: members},
+		logger,
+	)
+}
+
+func (h *memberTimesheetHandler) Handle(
+	ctx context.Context,
+	query MemberTimesheet,
+) (*project.Timesheet, error) {
+	boardMembers, err := h.members.BoardMembers(ctx, query.BoardID)
+	if err != nil {
+		return nil, fmt.Errorf("get board members: %w", err)
+	}
+
+	member, err := project.FindMember(boardMembers, query.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	worklogs, err := h.worklogs.MemberWorklogs(ctx, member.ID(), query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return project.NewTimesheet(member.ID(), query.From, query.To, worklogs)
+}
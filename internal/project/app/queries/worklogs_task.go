@@ -0,0 +1,37 @@
+package queries
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/DeluxeOwl/cogniboard/internal/decorator"
+	"github.com/DeluxeOwl/cogniboard/internal/project"
+)
+
+type TaskWorklogs struct {
+	TaskID project.TaskID
+}
+
+type TaskWorklogsHandler decorator.QueryHandler[TaskWorklogs, []project.Worklog]
+
+type taskWorklogsHandler struct {
+	worklogs TaskWorklogsReadModel
+}
+
+type TaskWorklogsReadModel interface {
+	TaskWorklogs(ctx context.Context, taskID project.TaskID) ([]project.Worklog, error)
+}
+
+func NewTaskWorklogsHandler(repo TaskWorklogsReadModel, logger *slog.Logger) TaskWorklogsHandler {
+	return decorator.ApplyQueryDecorators(
+		&taskWorklogsHandler{worklogs: repo},
+		logger,
+	)
+}
+
+func (h *taskWorklogsHandler) Handle(
+	ctx context.Context,
+	query TaskWorklogs,
+) ([]project.Worklog, error) {
+	return h.worklogs.TaskWorklogs(ctx, query.TaskID)
+}
@@ -0,0 +1,166 @@
+package project
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+var (
+	ErrUnknownAssignee = newValidationError(
+		"unknown_assignee",
+		"assignee is not a member of the board",
+	)
+	ErrUnknownWatcher = newValidationError(
+		"unknown_watcher",
+		"watcher is not a member of the board",
+	)
+	ErrAlreadyAssigned = newConflictError(
+		"already_assigned",
+		"member is already assigned to the task",
+	)
+	ErrNotAssigned     = newConflictError("not_assigned", "member is not assigned to the task")
+	ErrAlreadyWatching = newConflictError(
+		"already_watching",
+		"member is already watching the task",
+	)
+	ErrNotWatching      = newConflictError("not_watching", "member is not watching the task")
+	ErrAssigneeWatching = newConflictError(
+		"assignee_watching",
+		"assignees are notified anyway, they cannot watch their task",
+	)
+)
+
+// AddAssignee assigns a member of the task's board to it, a watcher that gets assigned stops watching
+func (t *Task) AddAssignee(member Member) error {
+	if err := validateMembers([]Member{member}, t.boardID, ErrUnknownAssignee); err != nil {
+		return err
+	}
+
+	if t.IsAssignedTo(member.id) {
+		return fmt.Errorf("%w: %s", ErrAlreadyAssigned, member.displayName)
+	}
+
+	t.assignees = append(t.assignees, member)
+	t.watchers = withoutMember(t.watchers, member.id)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+func (t *Task) RemoveAssignee(id MemberID) error {
+	if !t.IsAssignedTo(id) {
+		return fmt.Errorf("%w: %s", ErrNotAssigned, id)
+	}
+
+	t.assignees = withoutMember(t.assignees, id)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// SetAssignees replaces all the assignees of the task
+func (t *Task) SetAssignees(members []Member) error {
+	if err := validateMembers(members, t.boardID, ErrUnknownAssignee); err != nil {
+		return err
+	}
+
+	t.setAssignees(members)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+func (t *Task) setAssignees(members []Member) {
+	t.assignees = uniqueMembers(members)
+	for _, assignee := range t.assignees {
+		t.watchers = withoutMember(t.watchers, assignee.id)
+	}
+}
+
+// AddWatcher subscribes a member of the task's board to it without assigning them
+func (t *Task) AddWatcher(member Member) error {
+	if err := t.canWatch(member); err != nil {
+		return err
+	}
+
+	if t.IsWatchedBy(member.id) {
+		return fmt.Errorf("%w: %s", ErrAlreadyWatching, member.displayName)
+	}
+
+	t.watchers = append(t.watchers, member)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+func (t *Task) RemoveWatcher(id MemberID) error {
+	if !t.IsWatchedBy(id) {
+		return fmt.Errorf("%w: %s", ErrNotWatching, id)
+	}
+
+	t.watchers = withoutMember(t.watchers, id)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// SetWatchers replaces all the watchers of the task
+func (t *Task) SetWatchers(members []Member) error {
+	for _, member := range members {
+		if err := t.canWatch(member); err != nil {
+			return err
+		}
+	}
+
+	t.watchers = uniqueMembers(members)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+func (t *Task) canWatch(member Member) error {
+	if err := validateMembers([]Member{member}, t.boardID, ErrUnknownWatcher); err != nil {
+		return err
+	}
+
+	if t.IsAssignedTo(member.id) {
+		return fmt.Errorf("%w: %s", ErrAssigneeWatching, member.displayName)
+	}
+
+	return nil
+}
+
+func (t *Task) Assignees() []Member {
+	return t.assignees
+}
+
+func (t *Task) Watchers() []Member {
+	return t.watchers
+}
+
+func (t *Task) IsAssignedTo(id MemberID) bool {
+	return slices.ContainsFunc(t.assignees, func(m Member) bool { return m.id == id })
+}
+
+func (t *Task) IsWatchedBy(id MemberID) bool {
+	return slices.ContainsFunc(t.watchers, func(m Member) bool { return m.id == id })
+}
+
+// validateMembers returns errUnknown if any of the members isn't part of the board
+func validateMembers(members []Member, boardID BoardID, errUnknown error) error {
+	for _, member := range members {
+		if member.boardID != boardID {
+			return fmt.Errorf("%w: %s", errUnknown, member.displayName)
+		}
+	}
+	return nil
+}
+
+func uniqueMembers(members []Member) []Member {
+	return lo.UniqBy(members, func(m Member) MemberID { return m.id })
+}
+
+func withoutMember(members []Member, id MemberID) []Member {
+	return slices.DeleteFunc(slices.Clone(members), func(m Member) bool { return m.id == id })
+}
+
+func memberSnapshots(members []Member) []MemberSnapshot {
+	return lo.Map(members, func(m Member, _ int) MemberSnapshot { return m.GetSnapshot() })
+}
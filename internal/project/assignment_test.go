@@ -0,0 +1,90 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskAssignees(t *testing.T) {
+	mary := *createValidMember(t, "member-2", DefaultBoardID, "Mary", MemberRoleMember)
+	otherBoardMember := *createValidMember(t, "member-3", "board-2", "Steve", MemberRoleMember)
+
+	t.Run("add and remove", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.AddAssignee(mary))
+		assert.ErrorIs(t, task.AddAssignee(mary), ErrAlreadyAssigned)
+		assert.Equal(t, []string{"John Doe", "Mary"}, MemberNames(task.Assignees()))
+
+		require.NoError(t, task.RemoveAssignee(mary.ID()))
+		assert.ErrorIs(t, task.RemoveAssignee(mary.ID()), ErrNotAssigned)
+		assert.Equal(t, lo.ToPtr("John Doe"), task.GetSnapshot().Assignee)
+	})
+
+	t.Run("only members of the board", func(t *testing.T) {
+		task := createValidTask(t)
+		assert.ErrorIs(t, task.AddAssignee(otherBoardMember), ErrUnknownAssignee)
+		assert.ErrorIs(t, task.SetAssignees([]Member{otherBoardMember}), ErrUnknownAssignee)
+
+		_, err := NewTask("1", DefaultBoardID, "task", nil, nil, []Member{otherBoardMember}, nil, nil)
+		assert.ErrorIs(t, err, ErrUnknownAssignee)
+
+		err = task.Edit(DefaultWorkflow(), nil, nil, nil, []Member{otherBoardMember}, nil, nil, nil)
+		assert.ErrorIs(t, err, ErrUnknownAssignee)
+		assert.Len(t, task.Assignees(), 1)
+	})
+
+	t.Run("set replaces and dedupes", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.SetAssignees([]Member{mary, mary}))
+		assert.Equal(t, []string{"Mary"}, MemberNames(task.Assignees()))
+
+		require.NoError(t, task.Edit(DefaultWorkflow(), nil, nil, nil, []Member{}, nil, nil, nil))
+		assert.Empty(t, task.GetSnapshot().Assignees)
+		assert.Nil(t, task.GetSnapshot().Assignee)
+	})
+}
+
+func TestTaskWatchers(t *testing.T) {
+	mary := *createValidMember(t, "member-2", DefaultBoardID, "Mary", MemberRoleMember)
+	otherBoardMember := *createValidMember(t, "member-3", "board-2", "Steve", MemberRoleMember)
+
+	t.Run("add and remove", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.AddWatcher(mary))
+		assert.ErrorIs(t, task.AddWatcher(mary), ErrAlreadyWatching)
+		assert.True(t, task.IsWatchedBy(mary.ID()))
+		require.Len(t, task.GetSnapshot().Watchers, 1)
+
+		require.NoError(t, task.RemoveWatcher(mary.ID()))
+		assert.ErrorIs(t, task.RemoveWatcher(mary.ID()), ErrNotWatching)
+	})
+
+	t.Run("only members of the board", func(t *testing.T) {
+		task := createValidTask(t)
+		assert.ErrorIs(t, task.AddWatcher(otherBoardMember), ErrUnknownWatcher)
+		assert.ErrorIs(t, task.SetWatchers([]Member{otherBoardMember}), ErrUnknownWatcher)
+	})
+
+	t.Run("assignees can't watch", func(t *testing.T) {
+		task := createValidTask(t)
+		john := task.Assignees()[0]
+		assert.ErrorIs(t, task.AddWatcher(john), ErrAssigneeWatching)
+		assert.ErrorIs(t, task.SetWatchers([]Member{mary, john}), ErrAssigneeWatching)
+		assert.Empty(t, task.Watchers())
+	})
+
+	t.Run("a watcher that gets assigned stops watching", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.AddWatcher(mary))
+		require.NoError(t, task.AddAssignee(mary))
+		assert.False(t, task.IsWatchedBy(mary.ID()))
+
+		require.NoError(t, task.RemoveAssignee(mary.ID()))
+		require.NoError(t, task.SetWatchers([]Member{mary}))
+		require.NoError(t, task.SetAssignees([]Member{mary}))
+		assert.Empty(t, task.Watchers())
+	})
+}
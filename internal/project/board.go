@@ -0,0 +1,182 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type BoardRepository interface {
+	Create(ctx context.Context, board *Board) error
+	GetByID(ctx context.Context, id BoardID) (*Board, error)
+	// UpdateBoard keeps the tasks of the board from being updated while updateFn runs
+	UpdateBoard(ctx context.Context, id BoardID, updateFn func(b *Board) (*Board, error)) error
+	AllBoards(ctx context.Context) ([]Board, error)
+}
+
+type BoardID string
+
+// DefaultBoardID is the board that owns tasks created before boards existed,
+// and tasks created through the routes that aren't scoped to a board
+const DefaultBoardID BoardID = "default"
+
+// Board groups tasks that belong together, e.g. the backlog of a single product
+type Board struct {
+	id         BoardID
+	name       string
+	workflow   Workflow
+	wipLimits  map[TaskStatus]WIPLimit
+	createdAt  time.Time
+	updatedAt  time.Time
+	archivedAt *time.Time
+}
+
+func NewBoardID() (BoardID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return BoardID(id.String()), nil
+}
+
+const MaxBoardNameLength = 50
+
+var (
+	ErrBoardNameEmpty   = newValidationError("board_name_empty", "board name cannot be empty")
+	ErrBoardNameTooLong = newValidationError("board_name_too_long", fmt.Sprintf(
+		"board name cannot be longer than %d characters",
+		MaxBoardNameLength,
+	))
+	ErrBoardArchived  = newConflictError("board_archived", "board is archived")
+	ErrTaskNotOnBoard = newValidationError("task_not_on_board", "task does not belong to the board")
+	ErrDefaultBoard   = newConflictError("default_board", "the default board cannot be archived")
+	ErrBoardNotFound  = newNotFoundError("board_not_found", "board not found")
+)
+
+func NewBoard(id BoardID, name string) (*Board, error) {
+	if err := validateBoardName(name); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &Board{
+		id:        id,
+		name:      strings.TrimSpace(name),
+		workflow:  DefaultWorkflow(),
+		wipLimits: make(map[TaskStatus]WIPLimit),
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+func validateBoardName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrBoardNameEmpty
+	}
+	if len(name) > MaxBoardNameLength {
+		return ErrBoardNameTooLong
+	}
+	return nil
+}
+
+func (b *Board) ID() BoardID {
+	return b.id
+}
+
+func (b *Board) IsArchived() bool {
+	return b.archivedAt != nil
+}
+
+func (b *Board) Rename(name string) error {
+	if b.IsArchived() {
+		return ErrBoardArchived
+	}
+
+	if err := validateBoardName(name); err != nil {
+		return err
+	}
+
+	b.name = strings.TrimSpace(name)
+	b.updatedAt = time.Now()
+	return nil
+}
+
+func (b *Board) Workflow() Workflow {
+	return b.workflow
+}
+
+// ChangeWorkflow replaces the board's columns and transitions.
+// Statuses that tasks on the board are still in cannot be removed.
+func (b *Board) ChangeWorkflow(workflow Workflow, statusesInUse []TaskStatus) error {
+	if b.IsArchived() {
+		return ErrBoardArchived
+	}
+
+	for _, status := range statusesInUse {
+		if !workflow.HasStatus(status) {
+			return fmt.Errorf("%w: %s", ErrWorkflowStatusStillInUse, status)
+		}
+	}
+
+	b.workflow = workflow
+	for status := range b.wipLimits {
+		if !workflow.HasStatus(status) {
+			delete(b.wipLimits, status)
+		}
+	}
+	b.updatedAt = time.Now()
+	return nil
+}
+
+// Archive hides the board, archived boards don't accept new tasks
+func (b *Board) Archive() error {
+	if b.id == DefaultBoardID {
+		return ErrDefaultBoard
+	}
+
+	if b.IsArchived() {
+		return ErrBoardArchived
+	}
+
+	now := time.Now()
+	b.archivedAt = lo.ToPtr(now)
+	b.updatedAt = now
+	return nil
+}
+
+// CanAddTasks returns an error if tasks cannot be added to the board
+func (b *Board) CanAddTasks() error {
+	if b.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrBoardArchived, b.id)
+	}
+	return nil
+}
+
+type BoardSnapshot struct {
+	ID         BoardID                 `json:"id"`
+	Name       string                  `json:"name"`
+	Workflow   WorkflowSnapshot        `json:"workflow"`
+	WIPLimits  map[TaskStatus]WIPLimit `json:"wip_limits"`
+	CreatedAt  time.Time               `json:"created_at"`
+	UpdatedAt  time.Time               `json:"updated_at"`
+	ArchivedAt *time.Time              `json:"archived_at"`
+}
+
+// Used by the db adapters
+func (b *Board) GetSnapshot() *BoardSnapshot {
+	return &BoardSnapshot{
+		ID:         b.id,
+		Name:       b.name,
+		Workflow:   b.workflow.GetSnapshot(),
+		WIPLimits:  maps.Clone(b.wipLimits),
+		CreatedAt:  b.createdAt,
+		UpdatedAt:  b.updatedAt,
+		ArchivedAt: b.archivedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoard(t *testing.T) {
+	t.Run("valid board", func(t *testing.T) {
+		board, err := NewBoard("board-1", "Mobile app")
+		require.NoError(t, err)
+		assert.Equal(t, BoardID("board-1"), board.id)
+		assert.Equal(t, "Mobile app", board.name)
+		assert.False(t, board.IsArchived())
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		board, err := NewBoard("board-1", "   ")
+		assert.ErrorIs(t, err, ErrBoardNameEmpty)
+		assert.Nil(t, board)
+	})
+
+	t.Run("name too long", func(t *testing.T) {
+		board, err := NewBoard("board-1", strings.Repeat("a", MaxBoardNameLength+1))
+		assert.ErrorIs(t, err, ErrBoardNameTooLong)
+		assert.Nil(t, board)
+	})
+}
+
+func TestBoardRename(t *testing.T) {
+	t.Run("valid rename", func(t *testing.T) {
+		board := createValidBoard(t)
+		err := board.Rename("Platform")
+		require.NoError(t, err)
+		assert.Equal(t, "Platform", board.name)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		board := createValidBoard(t)
+		err := board.Rename("")
+		assert.ErrorIs(t, err, ErrBoardNameEmpty)
+		assert.Equal(t, "Mobile app", board.name)
+	})
+
+	t.Run("archived board cannot be renamed", func(t *testing.T) {
+		board := createValidBoard(t)
+		require.NoError(t, board.Archive())
+		err := board.Rename("Platform")
+		assert.ErrorIs(t, err, ErrBoardArchived)
+	})
+}
+
+func TestBoardArchive(t *testing.T) {
+	t.Run("archived board doesn't accept tasks", func(t *testing.T) {
+		board := createValidBoard(t)
+		require.NoError(t, board.CanAddTasks())
+
+		require.NoError(t, board.Archive())
+		assert.True(t, board.IsArchived())
+		assert.ErrorIs(t, board.CanAddTasks(), ErrBoardArchived)
+	})
+
+	t.Run("cannot archive twice", func(t *testing.T) {
+		board := createValidBoard(t)
+		require.NoError(t, board.Archive())
+		assert.ErrorIs(t, board.Archive(), ErrBoardArchived)
+	})
+
+	t.Run("default board cannot be archived", func(t *testing.T) {
+		board, err := NewBoard(DefaultBoardID, "Default")
+		require.NoError(t, err)
+		assert.ErrorIs(t, board.Archive(), ErrDefaultBoard)
+	})
+}
+
+func TestTaskBelongsTo(t *testing.T) {
+	task := createValidTask(t)
+	assert.NoError(t, task.BelongsTo(DefaultBoardID))
+	assert.ErrorIs(t, task.BelongsTo("other-board"), ErrTaskNotOnBoard)
+}
+
+func createValidBoard(t *testing.T) *Board {
+	board, err := NewBoard("board-1", "Mobile app")
+	require.NoError(t, err)
+	return board
+}
@@ -41,5 +41,7 @@ func (t Tool[Params]) GetToolParams() []ToolParam { return t.Params }
 type ToolParam struct {
 	Name      string
 	ParamType string
+	// Type of the elements of array params
+	ItemsType string
 	Required  bool
 }
@@ -0,0 +1,187 @@
+package project
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type ChecklistItemID string
+
+// ChecklistItem is a step of a task that's ticked off when done, e.g. one of its acceptance criteria.
+// The items of a task are kept in the order they're shown in.
+type ChecklistItem struct {
+	id   ChecklistItemID
+	text string
+	done bool
+}
+
+func NewChecklistItemID() (ChecklistItemID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return ChecklistItemID(id.String()), nil
+}
+
+const (
+	MaxChecklistItemLength = 200
+	MaxChecklistItems      = 100
+)
+
+var (
+	ErrChecklistItemEmpty = newValidationError(
+		"checklist_item_empty",
+		"checklist item cannot be empty",
+	)
+	ErrChecklistItemTooLong = newValidationError("checklist_item_too_long", fmt.Sprintf(
+		"checklist item cannot be longer than %d characters",
+		MaxChecklistItemLength,
+	))
+	ErrChecklistFull = newConflictError("checklist_full", fmt.Sprintf(
+		"task cannot have more than %d checklist items",
+		MaxChecklistItems,
+	))
+	ErrChecklistItemNotFound = newNotFoundError(
+		"checklist_item_not_found",
+		"task has no such checklist item",
+	)
+	ErrInvalidChecklistPosition = newValidationError(
+		"invalid_checklist_position",
+		"checklist position is out of range",
+	)
+)
+
+// AddChecklistItem appends an unchecked item to the end of the task's checklist
+func (t *Task) AddChecklistItem(id ChecklistItemID, text string) error {
+	if t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskArchived, t.id)
+	}
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return ErrChecklistItemEmpty
+	}
+
+	if len(text) > MaxChecklistItemLength {
+		return ErrChecklistItemTooLong
+	}
+
+	if len(t.checklist) >= MaxChecklistItems {
+		return ErrChecklistFull
+	}
+
+	t.checklist = append(t.checklist, ChecklistItem{id: id, text: text})
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// ToggleChecklistItem sets whether the item is done, a nil done flips it
+func (t *Task) ToggleChecklistItem(id ChecklistItemID, done *bool) error {
+	if t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskArchived, t.id)
+	}
+
+	i, err := t.checklistIndex(id)
+	if err != nil {
+		return err
+	}
+
+	t.checklist[i].done = lo.FromPtrOr(done, !t.checklist[i].done)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// MoveChecklistItem moves the item to a position of the checklist, starting at 0
+func (t *Task) MoveChecklistItem(id ChecklistItemID, position int) error {
+	if t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskArchived, t.id)
+	}
+
+	i, err := t.checklistIndex(id)
+	if err != nil {
+		return err
+	}
+
+	if position < 0 || position >= len(t.checklist) {
+		return fmt.Errorf("%w: %d", ErrInvalidChecklistPosition, position)
+	}
+
+	item := t.checklist[i]
+	t.checklist = slices.Insert(slices.Delete(t.checklist, i, i+1), position, item)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+func (t *Task) RemoveChecklistItem(id ChecklistItemID) error {
+	if t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskArchived, t.id)
+	}
+
+	i, err := t.checklistIndex(id)
+	if err != nil {
+		return err
+	}
+
+	t.checklist = slices.Delete(t.checklist, i, i+1)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+func (t *Task) Checklist() []ChecklistItem {
+	return t.checklist
+}
+
+func (t *Task) checklistIndex(id ChecklistItemID) (int, error) {
+	i := slices.IndexFunc(t.checklist, func(item ChecklistItem) bool { return item.id == id })
+	if i == -1 {
+		return -1, fmt.Errorf("%w: %s", ErrChecklistItemNotFound, id)
+	}
+	return i, nil
+}
+
+// checklistProgress is the percentage of done items, nil for tasks without a checklist
+func (t *Task) checklistProgress() *float64 {
+	if len(t.checklist) == 0 {
+		return nil
+	}
+
+	done := lo.CountBy(t.checklist, func(item ChecklistItem) bool { return item.done })
+	return lo.ToPtr(float64(done) * 100 / float64(len(t.checklist)))
+}
+
+// formatChecklist is how the checklist is recorded in the task's history, one item per line
+func (t *Task) formatChecklist() *string {
+	if len(t.checklist) == 0 {
+		return nil
+	}
+
+	lines := lo.Map(t.checklist, func(item ChecklistItem, _ int) string {
+		return lo.Ternary(item.done, "[x] ", "[ ] ") + item.text
+	})
+	return lo.ToPtr(strings.Join(lines, "\n"))
+}
+
+type ChecklistItemSnapshot struct {
+	ID   ChecklistItemID `json:"id"`
+	Text string          `json:"text"`
+	Done bool            `json:"done"`
+}
+
+func (i ChecklistItem) GetSnapshot() ChecklistItemSnapshot {
+	return ChecklistItemSnapshot{
+		ID:   i.id,
+		Text: i.text,
+		Done: i.done,
+	}
+}
+
+func checklistSnapshots(items []ChecklistItem) []ChecklistItemSnapshot {
+	return lo.Map(items, func(item ChecklistItem, _ int) ChecklistItemSnapshot {
+		return item.GetSnapshot()
+	})
+}
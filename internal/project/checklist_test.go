@@ -0,0 +1,114 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checklistTexts(task *Task) []string {
+	return lo.Map(task.GetSnapshot().Checklist, func(item ChecklistItemSnapshot, _ int) string {
+		return item.Text
+	})
+}
+
+func TestTaskChecklist(t *testing.T) {
+	t.Run("add items", func(t *testing.T) {
+		task := createValidTask(t)
+		assert.Nil(t, task.GetSnapshot().ChecklistProgress)
+
+		require.NoError(t, task.AddChecklistItem("1", " Write the tests "))
+		require.NoError(t, task.AddChecklistItem("2", "Update the docs"))
+
+		assert.Equal(t, []string{"Write the tests", "Update the docs"}, checklistTexts(task))
+		assert.Equal(t, lo.ToPtr(0.0), task.GetSnapshot().ChecklistProgress)
+	})
+
+	t.Run("validates items", func(t *testing.T) {
+		task := createValidTask(t)
+		assert.ErrorIs(t, task.AddChecklistItem("1", " "), ErrChecklistItemEmpty)
+		assert.ErrorIs(
+			t,
+			task.AddChecklistItem("1", strings.Repeat("a", MaxChecklistItemLength+1)),
+			ErrChecklistItemTooLong,
+		)
+
+		for i := range MaxChecklistItems {
+			require.NoError(t, task.AddChecklistItem(ChecklistItemID(rune('a'+i)), "step"))
+		}
+		assert.ErrorIs(t, task.AddChecklistItem("last", "step"), ErrChecklistFull)
+	})
+
+	t.Run("toggle items", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.AddChecklistItem("1", "Write the tests"))
+		require.NoError(t, task.AddChecklistItem("2", "Update the docs"))
+		require.NoError(t, task.AddChecklistItem("3", "Get a review"))
+		require.NoError(t, task.AddChecklistItem("4", "Merge"))
+
+		require.NoError(t, task.ToggleChecklistItem("1", nil))
+		assert.Equal(t, lo.ToPtr(25.0), task.GetSnapshot().ChecklistProgress)
+
+		require.NoError(t, task.ToggleChecklistItem("1", nil))
+		assert.Equal(t, lo.ToPtr(0.0), task.GetSnapshot().ChecklistProgress)
+
+		// Ticking an item that's done keeps it done
+		require.NoError(t, task.ToggleChecklistItem("2", lo.ToPtr(true)))
+		require.NoError(t, task.ToggleChecklistItem("2", lo.ToPtr(true)))
+		assert.Equal(t, lo.ToPtr(25.0), task.GetSnapshot().ChecklistProgress)
+
+		assert.ErrorIs(t, task.ToggleChecklistItem("5", nil), ErrChecklistItemNotFound)
+	})
+
+	t.Run("move items", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.AddChecklistItem("1", "first"))
+		require.NoError(t, task.AddChecklistItem("2", "second"))
+		require.NoError(t, task.AddChecklistItem("3", "third"))
+
+		require.NoError(t, task.MoveChecklistItem("3", 0))
+		assert.Equal(t, []string{"third", "first", "second"}, checklistTexts(task))
+
+		require.NoError(t, task.MoveChecklistItem("3", 2))
+		assert.Equal(t, []string{"first", "second", "third"}, checklistTexts(task))
+
+		assert.ErrorIs(t, task.MoveChecklistItem("1", 3), ErrInvalidChecklistPosition)
+		assert.ErrorIs(t, task.MoveChecklistItem("1", -1), ErrInvalidChecklistPosition)
+	})
+
+	t.Run("remove items", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.AddChecklistItem("1", "first"))
+		require.NoError(t, task.AddChecklistItem("2", "second"))
+
+		require.NoError(t, task.RemoveChecklistItem("1"))
+		assert.Equal(t, []string{"second"}, checklistTexts(task))
+		assert.ErrorIs(t, task.RemoveChecklistItem("1"), ErrChecklistItemNotFound)
+	})
+
+	t.Run("archived tasks can't change", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.AddChecklistItem("1", "first"))
+		require.NoError(t, task.Archive())
+
+		assert.ErrorIs(t, task.AddChecklistItem("2", "second"), ErrTaskArchived)
+		assert.ErrorIs(t, task.ToggleChecklistItem("1", nil), ErrTaskArchived)
+	})
+
+	t.Run("recorded in the history", func(t *testing.T) {
+		task := createValidTask(t)
+		before := RecordedFields(task)
+		require.NoError(t, task.AddChecklistItem("1", "first"))
+		require.NoError(t, task.AddChecklistItem("2", "second"))
+		require.NoError(t, task.ToggleChecklistItem("1", nil))
+
+		activities, err := NewTaskActivities(task.id, Actor{Name: "John"}, before, RecordedFields(task))
+		require.NoError(t, err)
+		require.Len(t, activities, 1)
+		assert.Equal(t, TaskFieldChecklist, activities[0].GetSnapshot().Field)
+		assert.Equal(t, lo.ToPtr("[x] first\n[ ] second"), activities[0].GetSnapshot().To)
+	})
+}
@@ -0,0 +1,267 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment *Comment) error
+	GetByID(ctx context.Context, id CommentID) (*Comment, error)
+	UpdateComment(
+		ctx context.Context,
+		id CommentID,
+		updateFn func(c *Comment) (*Comment, error),
+	) error
+	TaskComments(ctx context.Context, taskID TaskID) ([]Comment, error)
+}
+
+type CommentID string
+
+// Comment is a message in the discussion of a task, written in markdown.
+// A comment can reply to another comment of the same task, forming a thread.
+type Comment struct {
+	id        CommentID
+	taskID    TaskID
+	parentID  *CommentID
+	author    string
+	body      string
+	mentions  []string
+	createdAt time.Time
+	updatedAt time.Time
+	editedAt  *time.Time
+	deletedAt *time.Time
+}
+
+func NewCommentID() (CommentID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return CommentID(id.String()), nil
+}
+
+const MaxCommentLength = 5000
+
+var (
+	ErrCommentEmpty   = newValidationError("comment_empty", "comment cannot be empty")
+	ErrCommentTooLong = newValidationError("comment_too_long", fmt.Sprintf(
+		"comment cannot be longer than %d characters",
+		MaxCommentLength,
+	))
+	ErrCommentAuthorEmpty = newValidationError(
+		"comment_author_empty",
+		"comment author cannot be empty",
+	)
+	ErrNotCommentAuthor = newForbiddenError(
+		"not_comment_author",
+		"only the author can change a comment",
+	)
+	ErrCommentDeleted   = newConflictError("comment_deleted", "comment was deleted")
+	ErrCommentOtherTask = newValidationError(
+		"comment_other_task",
+		"comment belongs to another task",
+	)
+	ErrCommentNotFound = newNotFoundError("comment_not_found", "comment not found")
+)
+
+// NewComment creates a comment on a task, parent is the comment it replies to (if any).
+// The assignees are the names of the board members that can be @mentioned in the body.
+func NewComment(
+	id CommentID,
+	taskID TaskID,
+	parent *Comment,
+	author string,
+	body string,
+	assignees []string,
+) (*Comment, error) {
+	author = strings.TrimSpace(author)
+	if author == "" {
+		return nil, ErrCommentAuthorEmpty
+	}
+
+	body, err := validateCommentBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentID *CommentID
+	if parent != nil {
+		if parent.taskID != taskID {
+			return nil, fmt.Errorf("%w: %s", ErrCommentOtherTask, parent.id)
+		}
+		if parent.IsDeleted() {
+			return nil, fmt.Errorf("%w: can't reply to %s", ErrCommentDeleted, parent.id)
+		}
+		parentID = &parent.id
+	}
+
+	now := time.Now()
+	return &Comment{
+		id:        id,
+		taskID:    taskID,
+		parentID:  parentID,
+		author:    author,
+		body:      body,
+		mentions:  mentionsIn(body, assignees),
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", ErrCommentEmpty
+	}
+
+	if len(body) > MaxCommentLength {
+		return "", ErrCommentTooLong
+	}
+
+	return body, nil
+}
+
+// mentionsIn finds the assignees @mentioned in a comment's body
+func mentionsIn(body string, assignees []string) []string {
+	mentions := []string{}
+	for _, assignee := range lo.Uniq(assignees) {
+		pattern := regexp.MustCompile(`(?i)@` + regexp.QuoteMeta(assignee) + `\b`)
+		if pattern.MatchString(body) {
+			mentions = append(mentions, assignee)
+		}
+	}
+	return mentions
+}
+
+func (c *Comment) ID() CommentID {
+	return c.id
+}
+
+func (c *Comment) TaskID() TaskID {
+	return c.taskID
+}
+
+func (c *Comment) IsDeleted() bool {
+	return c.deletedAt != nil
+}
+
+// Edit replaces the body of the comment, only its author can edit it
+func (c *Comment) Edit(by string, body string, assignees []string) error {
+	if err := c.canChange(by); err != nil {
+		return err
+	}
+
+	body, err := validateCommentBody(body)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c.body = body
+	c.mentions = mentionsIn(body, assignees)
+	c.editedAt = &now
+	c.updatedAt = now
+	return nil
+}
+
+// Delete removes the body of the comment but keeps it in place so its replies
+// still have a parent, only its author can delete it
+func (c *Comment) Delete(by string) error {
+	if err := c.canChange(by); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c.body = ""
+	c.mentions = []string{}
+	c.deletedAt = &now
+	c.updatedAt = now
+	return nil
+}
+
+func (c *Comment) canChange(by string) error {
+	if c.IsDeleted() {
+		return fmt.Errorf("%w: %s", ErrCommentDeleted, c.id)
+	}
+
+	if !strings.EqualFold(strings.TrimSpace(by), c.author) {
+		return fmt.Errorf("%w: %s wrote it", ErrNotCommentAuthor, c.author)
+	}
+
+	return nil
+}
+
+type CommentSnapshot struct {
+	ID        CommentID  `json:"id"`
+	TaskID    TaskID     `json:"task_id"`
+	ParentID  *CommentID `json:"parent_id"`
+	Author    string     `json:"author"`
+	Body      string     `json:"body"`
+	Mentions  []string   `json:"mentions"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	EditedAt  *time.Time `json:"edited_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+// Used by the db adapters
+func (c *Comment) GetSnapshot() *CommentSnapshot {
+	return &CommentSnapshot{
+		ID:        c.id,
+		TaskID:    c.taskID,
+		ParentID:  c.parentID,
+		Author:    c.author,
+		Body:      c.body,
+		Mentions:  c.mentions,
+		CreatedAt: c.createdAt,
+		UpdatedAt: c.updatedAt,
+		EditedAt:  c.editedAt,
+		DeletedAt: c.deletedAt,
+	}
+}
+
+// CommentNode is a comment with its replies nested under it
+type CommentNode struct {
+	*CommentSnapshot
+	Replies []CommentNode `json:"replies"`
+}
+
+// CommentThread nests the replies under the comments they answer, at any depth.
+// The comments are expected in the order they were written.
+func CommentThread(comments []Comment) []CommentNode {
+	present := make(map[CommentID]bool, len(comments))
+	for _, comment := range comments {
+		present[comment.id] = true
+	}
+
+	repliesTo := make(map[CommentID][]Comment)
+	roots := make([]Comment, 0, len(comments))
+	for _, comment := range comments {
+		if comment.parentID != nil && present[*comment.parentID] {
+			repliesTo[*comment.parentID] = append(repliesTo[*comment.parentID], comment)
+			continue
+		}
+		roots = append(roots, comment)
+	}
+
+	var nest func(comments []Comment) []CommentNode
+	nest = func(comments []Comment) []CommentNode {
+		nodes := make([]CommentNode, len(comments))
+		for i := range comments {
+			nodes[i] = CommentNode{
+				CommentSnapshot: comments[i].GetSnapshot(),
+				Replies:         nest(repliesTo[comments[i].id]),
+			}
+		}
+		return nodes
+	}
+
+	return nest(roots)
+}
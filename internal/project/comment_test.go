@@ -0,0 +1,124 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var commentAssignees = []string{"John", "Mary Jane"}
+
+func createValidComment(t *testing.T, id CommentID, parent *Comment) *Comment {
+	comment, err := NewComment(id, "task-1", parent, "John", "Looks good", commentAssignees)
+	require.NoError(t, err)
+	return comment
+}
+
+func TestNewComment(t *testing.T) {
+	t.Run("valid comment", func(t *testing.T) {
+		comment, err := NewComment(
+			"1",
+			"task-1",
+			nil,
+			" John ",
+			"  @mary jane can you review this? cc @john, @steve ",
+			commentAssignees,
+		)
+		require.NoError(t, err)
+
+		snap := comment.GetSnapshot()
+		assert.Equal(t, "John", snap.Author)
+		assert.Equal(t, "@mary jane can you review this? cc @john, @steve", snap.Body)
+		assert.Equal(t, []string{"John", "Mary Jane"}, snap.Mentions)
+		assert.Nil(t, snap.ParentID)
+	})
+
+	t.Run("mentions need a word boundary", func(t *testing.T) {
+		comment, err := NewComment("1", "task-1", nil, "Mary", "@Johnny see this", commentAssignees)
+		require.NoError(t, err)
+		assert.Empty(t, comment.GetSnapshot().Mentions)
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		_, err := NewComment("1", "task-1", nil, "John", " ", commentAssignees)
+		assert.ErrorIs(t, err, ErrCommentEmpty)
+	})
+
+	t.Run("body too long", func(t *testing.T) {
+		body := strings.Repeat("a", MaxCommentLength+1)
+		_, err := NewComment("1", "task-1", nil, "John", body, commentAssignees)
+		assert.ErrorIs(t, err, ErrCommentTooLong)
+	})
+
+	t.Run("no author", func(t *testing.T) {
+		_, err := NewComment("1", "task-1", nil, "", "Looks good", commentAssignees)
+		assert.ErrorIs(t, err, ErrCommentAuthorEmpty)
+	})
+
+	t.Run("reply", func(t *testing.T) {
+		parent := createValidComment(t, "1", nil)
+		reply := createValidComment(t, "2", parent)
+		assert.Equal(t, parent.ID(), *reply.GetSnapshot().ParentID)
+	})
+
+	t.Run("reply to a comment of another task", func(t *testing.T) {
+		parent := createValidComment(t, "1", nil)
+		_, err := NewComment("2", "task-2", parent, "John", "Agreed", commentAssignees)
+		assert.ErrorIs(t, err, ErrCommentOtherTask)
+	})
+
+	t.Run("reply to a deleted comment", func(t *testing.T) {
+		parent := createValidComment(t, "1", nil)
+		require.NoError(t, parent.Delete("John"))
+		_, err := NewComment("2", "task-1", parent, "Mary", "Agreed", commentAssignees)
+		assert.ErrorIs(t, err, ErrCommentDeleted)
+	})
+}
+
+func TestCommentEditAndDelete(t *testing.T) {
+	t.Run("author edits", func(t *testing.T) {
+		comment := createValidComment(t, "1", nil)
+		require.NoError(t, comment.Edit("john", "Actually @Mary Jane should look", commentAssignees))
+
+		snap := comment.GetSnapshot()
+		assert.Equal(t, "Actually @Mary Jane should look", snap.Body)
+		assert.Equal(t, []string{"Mary Jane"}, snap.Mentions)
+		assert.NotNil(t, snap.EditedAt)
+	})
+
+	t.Run("someone else edits or deletes", func(t *testing.T) {
+		comment := createValidComment(t, "1", nil)
+		assert.ErrorIs(t, comment.Edit("Mary", "Changed", commentAssignees), ErrNotCommentAuthor)
+		assert.ErrorIs(t, comment.Delete("Mary"), ErrNotCommentAuthor)
+		assert.Equal(t, "Looks good", comment.GetSnapshot().Body)
+	})
+
+	t.Run("delete keeps the comment without its body", func(t *testing.T) {
+		comment := createValidComment(t, "1", nil)
+		require.NoError(t, comment.Delete("John"))
+
+		snap := comment.GetSnapshot()
+		assert.True(t, comment.IsDeleted())
+		assert.Empty(t, snap.Body)
+		assert.NotNil(t, snap.DeletedAt)
+		assert.ErrorIs(t, comment.Edit("John", "Back", commentAssignees), ErrCommentDeleted)
+	})
+}
+
+func TestCommentThread(t *testing.T) {
+	first := createValidComment(t, "1", nil)
+	reply := createValidComment(t, "2", first)
+	nested := createValidComment(t, "3", reply)
+	second := createValidComment(t, "4", nil)
+
+	thread := CommentThread([]Comment{*first, *reply, *nested, *second})
+	require.Len(t, thread, 2)
+	assert.Equal(t, first.ID(), thread[0].ID)
+	require.Len(t, thread[0].Replies, 1)
+	assert.Equal(t, reply.ID(), thread[0].Replies[0].ID)
+	require.Len(t, thread[0].Replies[0].Replies, 1)
+	assert.Equal(t, nested.ID(), thread[0].Replies[0].Replies[0].ID)
+	assert.Empty(t, thread[1].Replies)
+}
@@ -0,0 +1,458 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type CustomFieldRepository interface {
+	Create(ctx context.Context, field *CustomField) error
+	GetByID(ctx context.Context, id CustomFieldID) (*CustomField, error)
+	BoardCustomFields(ctx context.Context, boardID BoardID) ([]CustomField, error)
+}
+
+type CustomFieldID string
+
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText   CustomFieldType = "text"
+	CustomFieldTypeNumber CustomFieldType = "number"
+	// Dates without a time, like 2026-01-31
+	CustomFieldTypeDate CustomFieldType = "date"
+	// One of the field's options
+	CustomFieldTypeSelect CustomFieldType = "select"
+	// A member of the board
+	CustomFieldTypeUser CustomFieldType = "user"
+)
+
+var validCustomFieldTypes = []CustomFieldType{
+	CustomFieldTypeText,
+	CustomFieldTypeNumber,
+	CustomFieldTypeDate,
+	CustomFieldTypeSelect,
+	CustomFieldTypeUser,
+}
+
+// CustomField is metadata a board tracks on its tasks on top of the built-in fields,
+// e.g. the customer or the environment of a task
+type CustomField struct {
+	id        CustomFieldID
+	boardID   BoardID
+	name      string
+	fieldType CustomFieldType
+	// Values a select field can take, empty for the other types
+	options   []string
+	createdAt time.Time
+}
+
+func NewCustomFieldID() (CustomFieldID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return CustomFieldID(id.String()), nil
+}
+
+const (
+	MaxCustomFieldNameLength   = 30
+	MaxCustomFieldOptions      = 50
+	MaxCustomFieldOptionLength = 50
+	MaxCustomFieldTextLength   = 500
+)
+
+var (
+	ErrCustomFieldNameEmpty = newValidationError(
+		"custom_field_name_empty",
+		"custom field name cannot be empty",
+	)
+	ErrCustomFieldNameTooLong = newValidationError("custom_field_name_too_long", fmt.Sprintf(
+		"custom field name cannot be longer than %d characters",
+		MaxCustomFieldNameLength,
+	))
+	ErrInvalidCustomFieldType = newValidationError(
+		"invalid_custom_field_type",
+		"custom field type must be one of text, number, date, select, user",
+	)
+	ErrCustomFieldOptionsMissing = newValidationError(
+		"custom_field_options_missing",
+		"select fields need at least one option",
+	)
+	ErrCustomFieldOptionsNotAllowed = newValidationError(
+		"custom_field_options_not_allowed",
+		"only select fields can have options",
+	)
+	ErrCustomFieldOptionEmpty = newValidationError(
+		"custom_field_option_empty",
+		"custom field option cannot be empty",
+	)
+	ErrCustomFieldOptionTooLong = newValidationError("custom_field_option_too_long", fmt.Sprintf(
+		"custom field option cannot be longer than %d characters",
+		MaxCustomFieldOptionLength,
+	))
+	ErrTooManyCustomFieldOptions = newValidationError("too_many_custom_field_options", fmt.Sprintf(
+		"custom field cannot have more than %d options",
+		MaxCustomFieldOptions,
+	))
+	ErrDuplicateCustomFieldOption = newValidationError(
+		"duplicate_custom_field_option",
+		"custom field options must be unique",
+	)
+	ErrCustomFieldNameTaken = newConflictError(
+		"custom_field_name_taken",
+		"board already has a custom field with this name",
+	)
+	ErrCustomFieldNotFound = newNotFoundError(
+		"custom_field_not_found",
+		"board has no such custom field",
+	)
+	ErrCustomFieldOtherBoard = newValidationError(
+		"custom_field_other_board",
+		"custom field belongs to another board",
+	)
+	ErrInvalidCustomFieldValue = newValidationError(
+		"invalid_custom_field_value",
+		"invalid custom field value",
+	)
+)
+
+func NewCustomField(
+	id CustomFieldID,
+	boardID BoardID,
+	name string,
+	fieldType CustomFieldType,
+	options []string,
+) (*CustomField, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrCustomFieldNameEmpty
+	}
+
+	if len(name) > MaxCustomFieldNameLength {
+		return nil, ErrCustomFieldNameTooLong
+	}
+
+	if !slices.Contains(validCustomFieldTypes, fieldType) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCustomFieldType, fieldType)
+	}
+
+	validOptions, err := validateCustomFieldOptions(fieldType, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CustomField{
+		id:        id,
+		boardID:   boardID,
+		name:      name,
+		fieldType: fieldType,
+		options:   validOptions,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func validateCustomFieldOptions(fieldType CustomFieldType, options []string) ([]string, error) {
+	if fieldType != CustomFieldTypeSelect {
+		if len(options) > 0 {
+			return nil, ErrCustomFieldOptionsNotAllowed
+		}
+		return []string{}, nil
+	}
+
+	if len(options) == 0 {
+		return nil, ErrCustomFieldOptionsMissing
+	}
+
+	if len(options) > MaxCustomFieldOptions {
+		return nil, ErrTooManyCustomFieldOptions
+	}
+
+	validOptions := make([]string, 0, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return nil, ErrCustomFieldOptionEmpty
+		}
+		if len(option) > MaxCustomFieldOptionLength {
+			return nil, fmt.Errorf("%w: %s", ErrCustomFieldOptionTooLong, option)
+		}
+		duplicate := slices.ContainsFunc(validOptions, func(o string) bool {
+			return strings.EqualFold(o, option)
+		})
+		if duplicate {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateCustomFieldOption, option)
+		}
+		validOptions = append(validOptions, option)
+	}
+	return validOptions, nil
+}
+
+func (f *CustomField) ID() CustomFieldID {
+	return f.id
+}
+
+func (f *CustomField) BoardID() BoardID {
+	return f.boardID
+}
+
+func (f *CustomField) Type() CustomFieldType {
+	return f.fieldType
+}
+
+// Matches reports whether the field is the one referred to by an ID or a name, names are case insensitive
+func (f *CustomField) Matches(idOrName string) bool {
+	return string(f.id) == idOrName || strings.EqualFold(f.name, strings.TrimSpace(idOrName))
+}
+
+// CheckNameAvailable returns an error if another custom field of the board uses the field's name
+func (f *CustomField) CheckNameAvailable(boardFields []CustomField) error {
+	for _, other := range boardFields {
+		if other.id != f.id && strings.EqualFold(other.name, f.name) {
+			return fmt.Errorf("%w: %s", ErrCustomFieldNameTaken, f.name)
+		}
+	}
+	return nil
+}
+
+// FindCustomField looks a custom field up by ID or name among the custom fields of a board
+func FindCustomField(boardFields []CustomField, idOrName string) (*CustomField, error) {
+	for i := range boardFields {
+		if boardFields[i].Matches(idOrName) {
+			return &boardFields[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrCustomFieldNotFound, idOrName)
+}
+
+// ParseValue validates a value given for the field and returns how it's stored:
+// numbers like 3.5, dates like 2026-01-31, select options as they were defined
+// and users as member IDs, the members of the board are only needed for user fields
+func (f *CustomField) ParseValue(value string, boardMembers []Member) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch f.fieldType {
+	case CustomFieldTypeText:
+		if value == "" {
+			return "", fmt.Errorf("%w: %s cannot be empty", ErrInvalidCustomFieldValue, f.name)
+		}
+		if len(value) > MaxCustomFieldTextLength {
+			return "", fmt.Errorf(
+				"%w: %s cannot be longer than %d characters",
+				ErrInvalidCustomFieldValue,
+				f.name,
+				MaxCustomFieldTextLength,
+			)
+		}
+		return value, nil
+
+	case CustomFieldTypeNumber:
+		number, err := parseCustomFieldNumber(value)
+		if err != nil {
+			return "", fmt.Errorf(
+				"%w: %s expects a number, got %q",
+				ErrInvalidCustomFieldValue,
+				f.name,
+				value,
+			)
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+
+	case CustomFieldTypeDate:
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return "", fmt.Errorf(
+				"%w: %s expects a date like 2026-01-31, got %q",
+				ErrInvalidCustomFieldValue,
+				f.name,
+				value,
+			)
+		}
+		return date.Format(time.DateOnly), nil
+
+	case CustomFieldTypeSelect:
+		option, found := lo.Find(f.options, func(o string) bool { return strings.EqualFold(o, value) })
+		if !found {
+			return "", fmt.Errorf(
+				"%w: %s expects one of %s, got %q",
+				ErrInvalidCustomFieldValue,
+				f.name,
+				strings.Join(f.options, ", "),
+				value,
+			)
+		}
+		return option, nil
+
+	case CustomFieldTypeUser:
+		member, err := FindMember(boardMembers, value)
+		if err != nil {
+			return "", fmt.Errorf(
+				"%w: %s expects a board member, got %q (members: %s)",
+				ErrInvalidCustomFieldValue,
+				f.name,
+				value,
+				strings.Join(MemberNames(boardMembers), ", "),
+			)
+		}
+		return string(member.id), nil
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrInvalidCustomFieldType, f.fieldType)
+}
+
+func parseCustomFieldNumber(value string) (float64, error) {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, strconv.ErrRange
+	}
+	return number, nil
+}
+
+type CustomFieldSnapshot struct {
+	ID      CustomFieldID   `json:"id"`
+	BoardID BoardID         `json:"board_id"`
+	Name    string          `json:"name"`
+	Type    CustomFieldType `json:"type"`
+	// Values a select field can take, empty for the other types
+	Options   []string  `json:"options"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Used by the db adapters
+func (f *CustomField) GetSnapshot() CustomFieldSnapshot {
+	return CustomFieldSnapshot{
+		ID:        f.id,
+		BoardID:   f.boardID,
+		Name:      f.name,
+		Type:      f.fieldType,
+		Options:   f.options,
+		CreatedAt: f.createdAt,
+	}
+}
+
+// CustomFieldValue is the value a task has for one of its board's custom fields
+type CustomFieldValue struct {
+	field CustomField
+	// As returned by CustomField.ParseValue
+	value string
+}
+
+// matches compares the value to one from a filter, numbers are compared by value
+// and everything else case insensitively
+func (v CustomFieldValue) matches(other string) bool {
+	other = strings.TrimSpace(other)
+	if v.field.fieldType == CustomFieldTypeNumber {
+		a, errA := parseCustomFieldNumber(v.value)
+		b, errB := parseCustomFieldNumber(other)
+		return errA == nil && errB == nil && a == b
+	}
+	return strings.EqualFold(v.value, other)
+}
+
+// SetCustomFieldValue sets the task's value for one of its board's custom fields,
+// a nil value clears it. The members of the board are only needed for user fields.
+func (t *Task) SetCustomFieldValue(field CustomField, value *string, boardMembers []Member) error {
+	if t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskArchived, t.id)
+	}
+
+	if field.boardID != t.boardID {
+		return fmt.Errorf("%w: %s", ErrCustomFieldOtherBoard, field.name)
+	}
+
+	values := slices.DeleteFunc(t.customFields, func(v CustomFieldValue) bool {
+		return v.field.id == field.id
+	})
+
+	if value != nil {
+		parsed, err := field.ParseValue(*value, boardMembers)
+		if err != nil {
+			return err
+		}
+		values = append(values, CustomFieldValue{field: field, value: parsed})
+		sortCustomFieldValues(values)
+	}
+
+	t.customFields = values
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// CustomFieldValues lists the custom fields the task has a value for, by field name
+func (t *Task) CustomFieldValues() []CustomFieldValue {
+	return t.customFields
+}
+
+// HasCustomFieldValues reports whether the task has every value in values, keyed by field ID or name
+func (t *Task) HasCustomFieldValues(values map[string]string) bool {
+	for idOrName, value := range values {
+		if !slices.ContainsFunc(t.customFields, func(v CustomFieldValue) bool {
+			return v.field.Matches(idOrName) && v.matches(value)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterTasksByCustomFields keeps the tasks that have every value in values, keyed by field ID or name
+func FilterTasksByCustomFields(tasks []Task, values map[string]string) []Task {
+	if len(values) == 0 {
+		return tasks
+	}
+
+	return lo.Filter(tasks, func(t Task, _ int) bool {
+		return t.HasCustomFieldValues(values)
+	})
+}
+
+func sortCustomFieldValues(values []CustomFieldValue) {
+	slices.SortFunc(values, func(a, b CustomFieldValue) int {
+		return strings.Compare(strings.ToLower(a.field.name), strings.ToLower(b.field.name))
+	})
+}
+
+// formatCustomFields is how the custom fields are recorded in the task's history, one per line
+func (t *Task) formatCustomFields() *string {
+	if len(t.customFields) == 0 {
+		return nil
+	}
+
+	lines := lo.Map(t.customFields, func(v CustomFieldValue, _ int) string {
+		return v.field.name + ": " + v.value
+	})
+	return lo.ToPtr(strings.Join(lines, "\n"))
+}
+
+type CustomFieldValueSnapshot struct {
+	FieldID CustomFieldID   `json:"field_id"`
+	Name    string          `json:"name"`
+	Type    CustomFieldType `json:"type"`
+	// Numbers are formatted like 3.5, dates like 2026-01-31 and users are member IDs
+	Value string `json:"value"`
+}
+
+func (v CustomFieldValue) GetSnapshot() CustomFieldValueSnapshot {
+	return CustomFieldValueSnapshot{
+		FieldID: v.field.id,
+		Name:    v.field.name,
+		Type:    v.field.fieldType,
+		Value:   v.value,
+	}
+}
+
+func customFieldValueSnapshots(values []CustomFieldValue) []CustomFieldValueSnapshot {
+	return lo.Map(values, func(v CustomFieldValue, _ int) CustomFieldValueSnapshot {
+		return v.GetSnapshot()
+	})
+}
@@ -0,0 +1,190 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createValidCustomField(
+	t *testing.T,
+	id CustomFieldID,
+	name string,
+	fieldType CustomFieldType,
+	options ...string,
+) CustomField {
+	field, err := NewCustomField(id, DefaultBoardID, name, fieldType, options)
+	require.NoError(t, err)
+	return *field
+}
+
+func TestNewCustomField(t *testing.T) {
+	t.Run("valid field", func(t *testing.T) {
+		field, err := NewCustomField(
+			"1",
+			DefaultBoardID,
+			" environment ",
+			CustomFieldTypeSelect,
+			[]string{"staging", " production "},
+		)
+		require.NoError(t, err)
+
+		snap := field.GetSnapshot()
+		assert.Equal(t, "environment", snap.Name)
+		assert.Equal(t, []string{"staging", "production"}, snap.Options)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		_, err := NewCustomField("1", DefaultBoardID, " ", CustomFieldTypeText, nil)
+		assert.ErrorIs(t, err, ErrCustomFieldNameEmpty)
+	})
+
+	t.Run("name too long", func(t *testing.T) {
+		name := strings.Repeat("a", MaxCustomFieldNameLength+1)
+		_, err := NewCustomField("1", DefaultBoardID, name, CustomFieldTypeText, nil)
+		assert.ErrorIs(t, err, ErrCustomFieldNameTooLong)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := NewCustomField("1", DefaultBoardID, "customer", "boolean", nil)
+		assert.ErrorIs(t, err, ErrInvalidCustomFieldType)
+	})
+
+	t.Run("select fields need options", func(t *testing.T) {
+		_, err := NewCustomField("1", DefaultBoardID, "environment", CustomFieldTypeSelect, nil)
+		assert.ErrorIs(t, err, ErrCustomFieldOptionsMissing)
+
+		_, err = NewCustomField(
+			"1",
+			DefaultBoardID,
+			"environment",
+			CustomFieldTypeSelect,
+			[]string{"staging", "Staging"},
+		)
+		assert.ErrorIs(t, err, ErrDuplicateCustomFieldOption)
+	})
+
+	t.Run("only select fields have options", func(t *testing.T) {
+		_, err := NewCustomField("1", DefaultBoardID, "customer", CustomFieldTypeText, []string{"a"})
+		assert.ErrorIs(t, err, ErrCustomFieldOptionsNotAllowed)
+	})
+
+	t.Run("names are unique per board", func(t *testing.T) {
+		existing := createValidCustomField(t, "1", "Customer", CustomFieldTypeText)
+		field := createValidCustomField(t, "2", "customer", CustomFieldTypeText)
+		assert.ErrorIs(t, field.CheckNameAvailable([]CustomField{existing}), ErrCustomFieldNameTaken)
+	})
+}
+
+func TestCustomFieldParseValue(t *testing.T) {
+	member := createValidMember(t, "member-1", DefaultBoardID, "John Doe", MemberRoleMember)
+
+	tests := []struct {
+		name      string
+		fieldType CustomFieldType
+		value     string
+		want      string
+		wantErr   bool
+	}{
+		{name: "text", fieldType: CustomFieldTypeText, value: " Acme ", want: "Acme"},
+		{name: "empty text", fieldType: CustomFieldTypeText, value: " ", wantErr: true},
+		{name: "number", fieldType: CustomFieldTypeNumber, value: "3.50", want: "3.5"},
+		{name: "not a number", fieldType: CustomFieldTypeNumber, value: "three", wantErr: true},
+		{name: "infinite number", fieldType: CustomFieldTypeNumber, value: "Inf", wantErr: true},
+		{name: "date", fieldType: CustomFieldTypeDate, value: "2026-01-31", want: "2026-01-31"},
+		{name: "not a date", fieldType: CustomFieldTypeDate, value: "31/01/2026", wantErr: true},
+		{name: "option", fieldType: CustomFieldTypeSelect, value: "PRODUCTION", want: "production"},
+		{name: "unknown option", fieldType: CustomFieldTypeSelect, value: "qa", wantErr: true},
+		{name: "user by name", fieldType: CustomFieldTypeUser, value: "john doe", want: "member-1"},
+		{name: "user by ID", fieldType: CustomFieldTypeUser, value: "member-1", want: "member-1"},
+		{name: "unknown user", fieldType: CustomFieldTypeUser, value: "Jane", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var options []string
+			if tt.fieldType == CustomFieldTypeSelect {
+				options = []string{"staging", "production"}
+			}
+			field := createValidCustomField(t, "1", "field", tt.fieldType, options...)
+
+			got, err := field.ParseValue(tt.value, []Member{*member})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCustomFieldValue)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTaskCustomFields(t *testing.T) {
+	customer := createValidCustomField(t, "1", "Customer", CustomFieldTypeText)
+	estimate := createValidCustomField(t, "2", "Estimate", CustomFieldTypeNumber)
+
+	t.Run("set and clear values", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.SetCustomFieldValue(estimate, lo.ToPtr("2.0"), nil))
+		require.NoError(t, task.SetCustomFieldValue(customer, lo.ToPtr("Acme"), nil))
+		require.NoError(t, task.SetCustomFieldValue(customer, lo.ToPtr("Globex"), nil))
+
+		assert.Equal(t, []CustomFieldValueSnapshot{
+			{FieldID: "1", Name: "Customer", Type: CustomFieldTypeText, Value: "Globex"},
+			{FieldID: "2", Name: "Estimate", Type: CustomFieldTypeNumber, Value: "2"},
+		}, task.GetSnapshot().CustomFields)
+
+		require.NoError(t, task.SetCustomFieldValue(customer, nil, nil))
+		assert.Len(t, task.GetSnapshot().CustomFields, 1)
+	})
+
+	t.Run("invalid values aren't set", func(t *testing.T) {
+		task := createValidTask(t)
+		err := task.SetCustomFieldValue(estimate, lo.ToPtr("a lot"), nil)
+		assert.ErrorIs(t, err, ErrInvalidCustomFieldValue)
+		assert.Empty(t, task.GetSnapshot().CustomFields)
+	})
+
+	t.Run("fields of another board", func(t *testing.T) {
+		task := createValidTask(t)
+		other, err := NewCustomField("3", "other-board", "Customer", CustomFieldTypeText, nil)
+		require.NoError(t, err)
+		assert.ErrorIs(
+			t,
+			task.SetCustomFieldValue(*other, lo.ToPtr("Acme"), nil),
+			ErrCustomFieldOtherBoard,
+		)
+	})
+
+	t.Run("filter by values", func(t *testing.T) {
+		acme := createValidTask(t)
+		require.NoError(t, acme.SetCustomFieldValue(customer, lo.ToPtr("Acme"), nil))
+		require.NoError(t, acme.SetCustomFieldValue(estimate, lo.ToPtr("3"), nil))
+		globex := createValidTask(t)
+		require.NoError(t, globex.SetCustomFieldValue(customer, lo.ToPtr("Globex"), nil))
+		tasks := []Task{*acme, *globex}
+
+		assert.Len(t, FilterTasksByCustomFields(tasks, nil), 2)
+		assert.Len(t, FilterTasksByCustomFields(tasks, map[string]string{"customer": "acme"}), 1)
+		assert.Len(t, FilterTasksByCustomFields(tasks, map[string]string{"2": "3.0"}), 1)
+		assert.Empty(t, FilterTasksByCustomFields(tasks, map[string]string{
+			"Customer": "Globex",
+			"Estimate": "3",
+		}))
+	})
+
+	t.Run("recorded in the history", func(t *testing.T) {
+		task := createValidTask(t)
+		before := RecordedFields(task)
+		require.NoError(t, task.SetCustomFieldValue(customer, lo.ToPtr("Acme"), nil))
+
+		activities, err := NewTaskActivities(task.id, Actor{Name: "John"}, before, RecordedFields(task))
+		require.NoError(t, err)
+		require.Len(t, activities, 1)
+		assert.Equal(t, TaskFieldCustom, activities[0].GetSnapshot().Field)
+		assert.Equal(t, lo.ToPtr("Customer: Acme"), activities[0].GetSnapshot().To)
+	})
+}
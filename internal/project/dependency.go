@@ -0,0 +1,217 @@
+package project
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// dependency is what a task knows about a task it's linked to
+type dependency struct {
+	id     TaskID
+	status TaskStatus
+}
+
+var (
+	ErrDependencyOnItself = newValidationError(
+		"dependency_on_itself",
+		"a task cannot block itself",
+	)
+	ErrDependencyOtherBoard = newValidationError(
+		"dependency_other_board",
+		"dependent tasks must be on the same board",
+	)
+	ErrDependencyExists = newConflictError(
+		"dependency_exists",
+		"task is already blocked by this task",
+	)
+	ErrDependencyNotFound = newNotFoundError(
+		"dependency_not_found",
+		"task is not blocked by this task",
+	)
+	ErrDependencyCycle = newConflictError(
+		"dependency_cycle",
+		"dependency would create a cycle",
+	)
+	ErrBlocked = newConflictError(
+		"blocked",
+		"task cannot start while it's blocked by unfinished tasks",
+	)
+)
+
+// AddBlocker records that blocker has to be completed before t can start.
+// boardTasks are the other tasks of the board, they're used to detect cycles.
+func (t *Task) AddBlocker(blocker *Task, boardTasks []Task) error {
+	if blocker.id == t.id {
+		return ErrDependencyOnItself
+	}
+
+	if blocker.boardID != t.boardID {
+		return ErrDependencyOtherBoard
+	}
+
+	if slices.ContainsFunc(t.blockedBy, func(d dependency) bool { return d.id == blocker.id }) {
+		return fmt.Errorf("%w: %s", ErrDependencyExists, blocker.id)
+	}
+
+	blockedBy := make(map[TaskID][]TaskID, len(boardTasks)+1)
+	for _, task := range boardTasks {
+		blockedBy[task.id] = task.blockerIDs()
+	}
+	blockedBy[t.id] = t.blockerIDs()
+
+	// The new link closes a cycle if the blocker already waits on t
+	if path := findDependencyPath(blockedBy, blocker.id, t.id); path != nil {
+		return fmt.Errorf(
+			"%w: %s",
+			ErrDependencyCycle,
+			joinTaskIDs(append(path, blocker.id), " -> "),
+		)
+	}
+
+	t.blockedBy = append(t.blockedBy, dependency{id: blocker.id, status: blocker.status})
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveBlocker removes the link between t and the task that was blocking it
+func (t *Task) RemoveBlocker(blockerID TaskID) error {
+	before := len(t.blockedBy)
+	t.blockedBy = slices.DeleteFunc(t.blockedBy, func(d dependency) bool {
+		return d.id == blockerID
+	})
+	if len(t.blockedBy) == before {
+		return fmt.Errorf("%w: %s", ErrDependencyNotFound, blockerID)
+	}
+
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// IsBlocked reports whether any of the tasks blocking t isn't completed yet
+func (t *Task) IsBlocked() bool {
+	return len(t.openBlockerIDs()) > 0
+}
+
+func (t *Task) openBlockerIDs() []TaskID {
+	var ids []TaskID
+	for _, d := range t.blockedBy {
+		if d.status != TaskStatusCompleted {
+			ids = append(ids, d.id)
+		}
+	}
+	return ids
+}
+
+func (t *Task) blockerIDs() []TaskID {
+	return dependencyIDs(t.blockedBy)
+}
+
+func dependencyIDs(deps []dependency) []TaskID {
+	ids := make([]TaskID, len(deps))
+	for i, d := range deps {
+		ids[i] = d.id
+	}
+	return ids
+}
+
+// findDependencyPath walks the blockers starting from `from` and returns the
+// chain of tasks waiting on each other up to `to` if there's one, nil otherwise
+func findDependencyPath(blockedBy map[TaskID][]TaskID, from TaskID, to TaskID) []TaskID {
+	visited := make(map[TaskID]bool)
+
+	var walk func(id TaskID) []TaskID
+	walk = func(id TaskID) []TaskID {
+		if id == to {
+			return []TaskID{id}
+		}
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+
+		for _, next := range blockedBy[id] {
+			if path := walk(next); path != nil {
+				return append(path, id)
+			}
+		}
+		return nil
+	}
+
+	path := walk(from)
+	slices.Reverse(path)
+	return path
+}
+
+func joinTaskIDs(ids []TaskID, sep string) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = string(id)
+	}
+	return strings.Join(strs, sep)
+}
+
+// CriticalPath returns the longest chain of unfinished tasks that block each other,
+// in the order they have to be done. Every task counts as one unit of work.
+func CriticalPath(tasks []Task) []Task {
+	open := make(map[TaskID]*Task, len(tasks))
+	for i := range tasks {
+		if tasks[i].status != TaskStatusCompleted {
+			open[tasks[i].id] = &tasks[i]
+		}
+	}
+
+	// longest[id] is the length of the longest chain ending with id,
+	// previous[id] is the blocker right before it on that chain
+	longest := make(map[TaskID]int, len(open))
+	previous := make(map[TaskID]TaskID, len(open))
+	visiting := make(map[TaskID]bool)
+
+	var visit func(id TaskID) int
+	visit = func(id TaskID) int {
+		if length, ok := longest[id]; ok {
+			return length
+		}
+		// Cycles can't be created through AddBlocker, skip them if the data has one anyway
+		if visiting[id] {
+			return 0
+		}
+		visiting[id] = true
+
+		length := 1
+		for _, blockerID := range open[id].blockerIDs() {
+			if _, ok := open[blockerID]; !ok {
+				continue
+			}
+			if l := visit(blockerID) + 1; l > length {
+				length = l
+				previous[id] = blockerID
+			}
+		}
+
+		visiting[id] = false
+		longest[id] = length
+		return length
+	}
+
+	var end TaskID
+	best := 0
+	for _, task := range tasks {
+		if _, ok := open[task.id]; !ok {
+			continue
+		}
+		if length := visit(task.id); length > best {
+			best = length
+			end = task.id
+		}
+	}
+
+	path := make([]Task, 0, best)
+	for id, ok := end, best > 0; ok; id, ok = previous[id] {
+		path = append(path, *open[id])
+	}
+	slices.Reverse(path)
+
+	return path
+}
@@ -0,0 +1,141 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskAddBlocker(t *testing.T) {
+	t.Run("valid dependency", func(t *testing.T) {
+		task := newBoardTask(t, "1", DefaultBoardID)
+		blocker := newBoardTask(t, "2", DefaultBoardID)
+
+		require.NoError(t, task.AddBlocker(blocker, nil))
+
+		snap := task.GetSnapshot()
+		assert.Equal(t, []TaskID{"2"}, snap.BlockedBy)
+		assert.True(t, snap.Blocked)
+	})
+
+	t.Run("blocked by itself", func(t *testing.T) {
+		task := newBoardTask(t, "1", DefaultBoardID)
+		assert.ErrorIs(t, task.AddBlocker(task, nil), ErrDependencyOnItself)
+	})
+
+	t.Run("other board", func(t *testing.T) {
+		task := newBoardTask(t, "1", DefaultBoardID)
+		blocker := newBoardTask(t, "2", "board-2")
+		assert.ErrorIs(t, task.AddBlocker(blocker, nil), ErrDependencyOtherBoard)
+	})
+
+	t.Run("already blocked by the task", func(t *testing.T) {
+		task := newBoardTask(t, "1", DefaultBoardID)
+		blocker := newBoardTask(t, "2", DefaultBoardID)
+		require.NoError(t, task.AddBlocker(blocker, nil))
+		assert.ErrorIs(t, task.AddBlocker(blocker, nil), ErrDependencyExists)
+	})
+
+	t.Run("direct cycle", func(t *testing.T) {
+		a := newBoardTask(t, "a", DefaultBoardID)
+		b := newBoardTask(t, "b", DefaultBoardID)
+		require.NoError(t, b.AddBlocker(a, nil))
+
+		err := a.AddBlocker(b, []Task{*a, *b})
+		assert.ErrorIs(t, err, ErrDependencyCycle)
+		assert.Contains(t, err.Error(), "b -> a -> b")
+	})
+
+	t.Run("transitive cycle", func(t *testing.T) {
+		a := newBoardTask(t, "a", DefaultBoardID)
+		b := newBoardTask(t, "b", DefaultBoardID)
+		c := newBoardTask(t, "c", DefaultBoardID)
+		require.NoError(t, b.AddBlocker(a, nil))
+		require.NoError(t, c.AddBlocker(b, nil))
+
+		err := a.AddBlocker(c, []Task{*a, *b, *c})
+		assert.ErrorIs(t, err, ErrDependencyCycle)
+		assert.Contains(t, err.Error(), "c -> b -> a -> c")
+	})
+
+	t.Run("diamond is not a cycle", func(t *testing.T) {
+		a := newBoardTask(t, "a", DefaultBoardID)
+		b := newBoardTask(t, "b", DefaultBoardID)
+		c := newBoardTask(t, "c", DefaultBoardID)
+		d := newBoardTask(t, "d", DefaultBoardID)
+		require.NoError(t, b.AddBlocker(a, nil))
+		require.NoError(t, c.AddBlocker(a, nil))
+		require.NoError(t, d.AddBlocker(b, nil))
+
+		assert.NoError(t, d.AddBlocker(c, []Task{*a, *b, *c, *d}))
+	})
+}
+
+func TestTaskRemoveBlocker(t *testing.T) {
+	task := newBoardTask(t, "1", DefaultBoardID)
+	blocker := newBoardTask(t, "2", DefaultBoardID)
+	require.NoError(t, task.AddBlocker(blocker, nil))
+
+	assert.ErrorIs(t, task.RemoveBlocker("3"), ErrDependencyNotFound)
+
+	require.NoError(t, task.RemoveBlocker("2"))
+	assert.Empty(t, task.GetSnapshot().BlockedBy)
+	assert.False(t, task.IsBlocked())
+}
+
+func TestTaskStartWhileBlocked(t *testing.T) {
+	workflow := DefaultWorkflow()
+
+	t.Run("open blocker", func(t *testing.T) {
+		task := newBoardTask(t, "1", DefaultBoardID)
+		blocker := newBoardTask(t, "2", DefaultBoardID)
+		require.NoError(t, task.AddBlocker(blocker, nil))
+
+		err := task.ChangeStatus(workflow, TaskStatusInProgress)
+		assert.ErrorIs(t, err, ErrBlocked)
+
+		// Other columns aren't affected
+		require.NoError(t, task.ChangeStatus(workflow, TaskStatusInReview))
+	})
+
+	t.Run("completed blocker", func(t *testing.T) {
+		task := newBoardTask(t, "1", DefaultBoardID)
+		blocker := newBoardTask(t, "2", DefaultBoardID)
+		require.NoError(t, blocker.ChangeStatus(workflow, TaskStatusCompleted))
+		require.NoError(t, task.AddBlocker(blocker, nil))
+
+		assert.False(t, task.IsBlocked())
+		assert.NoError(t, task.ChangeStatus(workflow, TaskStatusInProgress))
+	})
+}
+
+func TestCriticalPath(t *testing.T) {
+	workflow := DefaultWorkflow()
+
+	a := newBoardTask(t, "a", DefaultBoardID)
+	b := newBoardTask(t, "b", DefaultBoardID)
+	c := newBoardTask(t, "c", DefaultBoardID)
+	d := newBoardTask(t, "d", DefaultBoardID)
+	e := newBoardTask(t, "e", DefaultBoardID)
+	done := newBoardTask(t, "done", DefaultBoardID)
+	require.NoError(t, done.ChangeStatus(workflow, TaskStatusCompleted))
+
+	// done -> a -> b -> c and a -> d, e is on its own
+	require.NoError(t, a.AddBlocker(done, nil))
+	require.NoError(t, b.AddBlocker(a, nil))
+	require.NoError(t, c.AddBlocker(b, nil))
+	require.NoError(t, d.AddBlocker(a, nil))
+
+	path := CriticalPath([]Task{*e, *d, *c, *b, *a, *done})
+
+	ids := make([]TaskID, len(path))
+	for i, task := range path {
+		ids[i] = task.id
+	}
+	assert.Equal(t, []TaskID{"a", "b", "c"}, ids)
+
+	t.Run("no tasks", func(t *testing.T) {
+		assert.Empty(t, CriticalPath(nil))
+	})
+}
@@ -0,0 +1,206 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type EpicRepository interface {
+	Create(ctx context.Context, epic *Epic) error
+	GetByID(ctx context.Context, id EpicID) (*Epic, error)
+	BoardEpics(ctx context.Context, boardID BoardID) ([]Epic, error)
+}
+
+type EpicID string
+
+// Epic is a deliverable of a board, such as a milestone, that groups tasks across sprints
+type Epic struct {
+	id          EpicID
+	boardID     BoardID
+	name        string
+	description *string
+	targetDate  time.Time
+	createdAt   time.Time
+}
+
+func NewEpicID() (EpicID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return EpicID(id.String()), nil
+}
+
+const MaxEpicNameLength = 100
+
+var (
+	ErrEpicNameEmpty   = newValidationError("epic_name_empty", "epic name cannot be empty")
+	ErrEpicNameTooLong = newValidationError("epic_name_too_long", fmt.Sprintf(
+		"epic name cannot be longer than %d characters",
+		MaxEpicNameLength,
+	))
+	ErrEpicOtherBoard = newValidationError("epic_other_board", "epic belongs to another board")
+	ErrTaskNotInEpic  = newConflictError("task_not_in_epic", "task is not part of the epic")
+	ErrEpicNotFound   = newNotFoundError("epic_not_found", "epic not found")
+)
+
+func NewEpic(
+	id EpicID,
+	boardID BoardID,
+	name string,
+	description *string,
+	targetDate time.Time,
+) (*Epic, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEpicNameEmpty
+	}
+
+	if len(name) > MaxEpicNameLength {
+		return nil, ErrEpicNameTooLong
+	}
+
+	return &Epic{
+		id:          id,
+		boardID:     boardID,
+		name:        name,
+		description: description,
+		targetDate:  targetDate,
+		createdAt:   time.Now(),
+	}, nil
+}
+
+func (e *Epic) ID() EpicID {
+	return e.id
+}
+
+func (e *Epic) BoardID() BoardID {
+	return e.boardID
+}
+
+// FindEpic finds an epic by ID or by name, ignoring case
+func FindEpic(epics []Epic, idOrName string) (*Epic, error) {
+	for i := range epics {
+		if string(epics[i].id) == idOrName || strings.EqualFold(epics[i].name, idOrName) {
+			return &epics[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrEpicNotFound, idOrName)
+}
+
+// AddToEpic links the task to an epic of its board, taking it out of any other epic
+func (t *Task) AddToEpic(epic *Epic) error {
+	if epic.boardID != t.boardID {
+		return fmt.Errorf("%w: %s", ErrEpicOtherBoard, epic.name)
+	}
+
+	t.epicID = &epic.id
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveFromEpic unlinks the task from the epic
+func (t *Task) RemoveFromEpic(epicID EpicID) error {
+	if t.epicID == nil || *t.epicID != epicID {
+		return fmt.Errorf("%w: %s", ErrTaskNotInEpic, epicID)
+	}
+
+	t.epicID = nil
+	t.updatedAt = time.Now()
+	return nil
+}
+
+func (t *Task) EpicID() *EpicID {
+	return t.epicID
+}
+
+// EpicTasks keeps the tasks linked to the epic
+func EpicTasks(tasks []Task, epicID EpicID) []Task {
+	return lo.Filter(tasks, func(t Task, _ int) bool {
+		return t.epicID != nil && *t.epicID == epicID
+	})
+}
+
+// EpicProgress sums up how far along the tasks of an epic are
+type EpicProgress struct {
+	Tasks          int `json:"tasks"`
+	CompletedTasks int `json:"completed_tasks"`
+	OpenTasks      int `json:"open_tasks"`
+	// Open tasks past their due date
+	OverdueTasks int `json:"overdue_tasks"`
+	// Percentage of the tasks that are completed, 0 for epics without tasks
+	PercentComplete float64 `json:"percent_complete"`
+	// When the open tasks would be completed at the pace tasks were completed so far,
+	// null until a task is completed
+	ProjectedFinish *time.Time `json:"projected_finish"`
+	// True when the projected finish is after the target date
+	BehindSchedule bool `json:"behind_schedule"`
+}
+
+// NewEpicProgress rolls up the tasks of the epic as of now
+func NewEpicProgress(epic *Epic, epicTasks []Task, now time.Time) EpicProgress {
+	progress := EpicProgress{Tasks: len(epicTasks)}
+
+	start := epic.createdAt
+	var lastCompleted time.Time
+	for _, task := range epicTasks {
+		if task.createdAt.Before(start) {
+			start = task.createdAt
+		}
+
+		if task.status == TaskStatusCompleted {
+			progress.CompletedTasks++
+			if task.completedAt != nil && task.completedAt.After(lastCompleted) {
+				lastCompleted = *task.completedAt
+			}
+			continue
+		}
+
+		progress.OpenTasks++
+		if task.dueDate != nil && task.dueDate.Before(now) {
+			progress.OverdueTasks++
+		}
+	}
+
+	if progress.Tasks == 0 || progress.CompletedTasks == 0 {
+		return progress
+	}
+
+	progress.PercentComplete = float64(progress.CompletedTasks) * 100 / float64(progress.Tasks)
+
+	finish := lastCompleted
+	if progress.OpenTasks > 0 {
+		perTask := now.Sub(start) / time.Duration(progress.CompletedTasks)
+		finish = now.Add(perTask * time.Duration(progress.OpenTasks))
+	}
+	progress.ProjectedFinish = &finish
+	progress.BehindSchedule = finish.After(epic.targetDate)
+
+	return progress
+}
+
+type EpicSnapshot struct {
+	ID          EpicID    `json:"id"`
+	BoardID     BoardID   `json:"board_id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	TargetDate  time.Time `json:"target_date"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Used by the db adapters
+func (e *Epic) GetSnapshot() *EpicSnapshot {
+	return &EpicSnapshot{
+		ID:          e.id,
+		BoardID:     e.boardID,
+		Name:        e.name,
+		Description: e.description,
+		TargetDate:  e.targetDate,
+		CreatedAt:   e.createdAt,
+	}
+}
@@ -0,0 +1,173 @@
+package project
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createValidEpic(t *testing.T, boardID BoardID) *Epic {
+	t.Helper()
+	epic, err := NewEpic("epic-1", boardID, " KYC ", nil, time.Now().AddDate(0, 3, 0))
+	require.NoError(t, err)
+	return epic
+}
+
+func TestNewEpic(t *testing.T) {
+	t.Run("valid epic", func(t *testing.T) {
+		epic := createValidEpic(t, DefaultBoardID)
+		assert.Equal(t, "KYC", epic.GetSnapshot().Name)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		_, err := NewEpic("epic-1", DefaultBoardID, " ", nil, time.Now())
+		assert.ErrorIs(t, err, ErrEpicNameEmpty)
+	})
+
+	t.Run("name too long", func(t *testing.T) {
+		name := strings.Repeat("a", MaxEpicNameLength+1)
+		_, err := NewEpic("epic-1", DefaultBoardID, name, nil, time.Now())
+		assert.ErrorIs(t, err, ErrEpicNameTooLong)
+	})
+}
+
+func TestTaskEpic(t *testing.T) {
+	t.Run("add and remove", func(t *testing.T) {
+		task := createValidTask(t)
+		epic := createValidEpic(t, DefaultBoardID)
+
+		require.NoError(t, task.AddToEpic(epic))
+		assert.Equal(t, epic.ID(), *task.GetSnapshot().EpicID)
+
+		require.NoError(t, task.RemoveFromEpic(epic.ID()))
+		assert.Nil(t, task.EpicID())
+	})
+
+	t.Run("epic of another board", func(t *testing.T) {
+		err := createValidTask(t).AddToEpic(createValidEpic(t, "other-board"))
+		assert.ErrorIs(t, err, ErrEpicOtherBoard)
+	})
+
+	t.Run("remove from another epic", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.AddToEpic(createValidEpic(t, DefaultBoardID)))
+		assert.ErrorIs(t, task.RemoveFromEpic("epic-2"), ErrTaskNotInEpic)
+	})
+
+	t.Run("recorded in the history", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.AddToEpic(createValidEpic(t, DefaultBoardID)))
+		assert.Equal(t, "epic-1", *RecordedFields(task)[TaskFieldEpic])
+	})
+}
+
+func TestFindEpic(t *testing.T) {
+	epics := []Epic{*createValidEpic(t, DefaultBoardID)}
+
+	byName, err := FindEpic(epics, "kyc")
+	require.NoError(t, err)
+	assert.Equal(t, EpicID("epic-1"), byName.ID())
+
+	byID, err := FindEpic(epics, "epic-1")
+	require.NoError(t, err)
+	assert.Equal(t, EpicID("epic-1"), byID.ID())
+
+	_, err = FindEpic(epics, "biometrics")
+	assert.ErrorIs(t, err, ErrEpicNotFound)
+}
+
+func TestEpicProgress(t *testing.T) {
+	now := time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC)
+	start := now.AddDate(0, 0, -10)
+
+	epic := createValidEpic(t, DefaultBoardID)
+	epic.createdAt = start
+	epic.targetDate = now.AddDate(0, 0, 15)
+
+	newTask := func(status TaskStatus, dueDate *time.Time) Task {
+		task := createValidTask(t)
+		task.createdAt = start
+		task.status = status
+		task.dueDate = dueDate
+		if status == TaskStatusCompleted {
+			task.completedAt = &now
+		}
+		return *task
+	}
+
+	t.Run("without tasks", func(t *testing.T) {
+		progress := NewEpicProgress(epic, nil, now)
+		assert.Equal(t, EpicProgress{}, progress)
+	})
+
+	t.Run("nothing completed yet", func(t *testing.T) {
+		progress := NewEpicProgress(epic, []Task{newTask(TaskStatusPending, nil)}, now)
+		assert.Equal(t, 1, progress.OpenTasks)
+		assert.Zero(t, progress.PercentComplete)
+		assert.Nil(t, progress.ProjectedFinish)
+	})
+
+	t.Run("projects the finish from the pace so far", func(t *testing.T) {
+		yesterday := now.AddDate(0, 0, -1)
+		tasks := []Task{
+			newTask(TaskStatusCompleted, nil),
+			newTask(TaskStatusCompleted, nil),
+			newTask(TaskStatusInProgress, &yesterday),
+			newTask(TaskStatusPending, nil),
+		}
+
+		progress := NewEpicProgress(epic, tasks, now)
+		assert.Equal(t, 4, progress.Tasks)
+		assert.Equal(t, 2, progress.CompletedTasks)
+		assert.Equal(t, 2, progress.OpenTasks)
+		assert.Equal(t, 1, progress.OverdueTasks)
+		assert.Equal(t, 50.0, progress.PercentComplete)
+		// 2 tasks in 10 days, so 10 more days for the 2 open tasks
+		assert.Equal(t, now.AddDate(0, 0, 10), *progress.ProjectedFinish)
+		assert.False(t, progress.BehindSchedule)
+	})
+
+	t.Run("behind schedule", func(t *testing.T) {
+		tasks := []Task{
+			newTask(TaskStatusCompleted, nil),
+			newTask(TaskStatusPending, nil),
+			newTask(TaskStatusPending, nil),
+		}
+
+		progress := NewEpicProgress(epic, tasks, now)
+		assert.Equal(t, now.AddDate(0, 0, 20), *progress.ProjectedFinish)
+		assert.True(t, progress.BehindSchedule)
+	})
+
+	t.Run("all completed", func(t *testing.T) {
+		progress := NewEpicProgress(epic, []Task{newTask(TaskStatusCompleted, nil)}, now)
+		assert.Equal(t, 100.0, progress.PercentComplete)
+		assert.Equal(t, now, *progress.ProjectedFinish)
+	})
+}
+
+func TestEpicProgressOverdueFromDB(t *testing.T) {
+	now := time.Now()
+	epic := createValidEpic(t, DefaultBoardID)
+	yesterday := now.AddDate(0, 0, -1)
+	epicID := string(epic.ID())
+
+	// A task that was due yesterday can't be created, but is loaded once it's overdue
+	task := UnmarshalTaskFromDB(&ent.Task{
+		ID:        "1",
+		Title:     "screen sanctions",
+		Status:    string(TaskStatusPending),
+		DueDate:   &yesterday,
+		EpicID:    &epicID,
+		CreatedAt: now.AddDate(0, 0, -5),
+		Version:   1,
+	})
+
+	progress := NewEpicProgress(epic, EpicTasks([]Task{*task}, epic.ID()), now)
+	assert.Equal(t, 1, progress.OpenTasks)
+	assert.Equal(t, 1, progress.OverdueTasks)
+}
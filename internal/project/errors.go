@@ -0,0 +1,64 @@
+package project
+
+// ErrorKind tells what went wrong regardless of the entity it happened to,
+// so that input adapters can react to every domain error the same way
+type ErrorKind string
+
+const (
+	// The entity doesn't exist
+	ErrorKindNotFound ErrorKind = "not_found"
+	// The input breaks a rule of the domain
+	ErrorKindValidation ErrorKind = "validation"
+	// The current state of the entity doesn't allow the change
+	ErrorKindConflict ErrorKind = "conflict"
+	// The actor isn't allowed to make the change
+	ErrorKindForbidden ErrorKind = "forbidden"
+	// A dependency like the database can't be reached, the same request can be retried later
+	ErrorKindUnavailable ErrorKind = "unavailable"
+)
+
+// Error is an error of the domain, its code is stable so that clients
+// can tell errors apart without parsing their message
+type Error struct {
+	kind    ErrorKind
+	code    string
+	message string
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+func (e *Error) Kind() ErrorKind {
+	return e.kind
+}
+
+func (e *Error) Code() string {
+	return e.code
+}
+
+func newNotFoundError(code, message string) error {
+	return &Error{kind: ErrorKindNotFound, code: code, message: message}
+}
+
+func newValidationError(code, message string) error {
+	return &Error{kind: ErrorKindValidation, code: code, message: message}
+}
+
+func newConflictError(code, message string) error {
+	return &Error{kind: ErrorKindConflict, code: code, message: message}
+}
+
+func newForbiddenError(code, message string) error {
+	return &Error{kind: ErrorKindForbidden, code: code, message: message}
+}
+
+func newUnavailableError(code, message string) error {
+	return &Error{kind: ErrorKindUnavailable, code: code, message: message}
+}
+
+// Returned by the db adapters when the database can't be reached
+var ErrStorageUnavailable = newUnavailableError(
+	"storage_unavailable",
+	"storage is unavailable, try again later",
+)
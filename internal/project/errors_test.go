@@ -0,0 +1,40 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestError(t *testing.T) {
+	t.Run("keeps its kind and code when wrapped", func(t *testing.T) {
+		err := fmt.Errorf("get task: %w", fmt.Errorf("%w: %s", ErrTaskNotFound, "1"))
+
+		var domainErr *Error
+		require.True(t, errors.As(err, &domainErr))
+		assert.Equal(t, ErrorKindNotFound, domainErr.Kind())
+		assert.Equal(t, "task_not_found", domainErr.Code())
+		assert.ErrorIs(t, err, ErrTaskNotFound)
+	})
+
+	t.Run("kinds", func(t *testing.T) {
+		tests := []struct {
+			err  error
+			kind ErrorKind
+		}{
+			{ErrTitleTooLong, ErrorKindValidation},
+			{ErrWIPLimitExceeded, ErrorKindConflict},
+			{ErrMemberNotAnAdmin, ErrorKindForbidden},
+			{ErrStorageUnavailable, ErrorKindUnavailable},
+		}
+
+		for _, tt := range tests {
+			var domainErr *Error
+			require.True(t, errors.As(tt.err, &domainErr))
+			assert.Equal(t, tt.kind, domainErr.Kind(), tt.err.Error())
+		}
+	})
+}
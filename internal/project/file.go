@@ -2,7 +2,6 @@ package project
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"mime"
 	"path/filepath"
@@ -12,10 +11,11 @@ import (
 )
 
 type Document struct {
-	ID      string `json:"id"`
-	Name    string `json:"name"`
-	Content string `json:"content"`
-	TaskID  TaskID `json:"task_id"`
+	ID      string  `json:"id"`
+	Name    string  `json:"name"`
+	Content string  `json:"content"`
+	TaskID  TaskID  `json:"task_id"`
+	BoardID BoardID `json:"board_id"`
 }
 
 type DocumentSimilarity struct {
@@ -34,6 +34,13 @@ type EmbeddingStorage interface {
 		query string,
 	) (*DocumentSimilarity, error)
 	SearchAllDocuments(ctx context.Context, query string) ([]DocumentSimilarity, error)
+	SearchDocumentsForBoard(
+		ctx context.Context,
+		boardID BoardID,
+		query string,
+	) ([]DocumentSimilarity, error)
+	// DeleteDocumentsForTask removes the embeddings of all the files attached to the task
+	DeleteDocumentsForTask(ctx context.Context, taskID TaskID) error
 }
 
 type ImageDescriber interface {
@@ -56,8 +63,8 @@ type File struct {
 }
 
 var (
-	ErrInvalidFileName = fmt.Errorf("invalid file name")
-	ErrInvalidFileSize = fmt.Errorf("invalid file size")
+	ErrInvalidFileName = newValidationError("invalid_file_name", "invalid file name")
+	ErrInvalidFileSize = newValidationError("invalid_file_size", "invalid file size")
 )
 
 func NewFile(name string, size int64) (File, error) {
@@ -0,0 +1,213 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type LabelRepository interface {
+	Create(ctx context.Context, label *Label) error
+	GetByID(ctx context.Context, id LabelID) (*Label, error)
+	BoardLabels(ctx context.Context, boardID BoardID) ([]Label, error)
+}
+
+type LabelID string
+
+// Label categorizes the tasks of a board, e.g. bug, feature, tech-debt
+type Label struct {
+	id        LabelID
+	boardID   BoardID
+	name      string
+	color     string
+	createdAt time.Time
+}
+
+func NewLabelID() (LabelID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return LabelID(id.String()), nil
+}
+
+const MaxLabelNameLength = 30
+
+var (
+	ErrLabelNameEmpty   = newValidationError("label_name_empty", "label name cannot be empty")
+	ErrLabelNameTooLong = newValidationError("label_name_too_long", fmt.Sprintf(
+		"label name cannot be longer than %d characters",
+		MaxLabelNameLength,
+	))
+	ErrLabelNameTaken = newConflictError(
+		"label_name_taken",
+		"board already has a label with this name",
+	)
+	ErrLabelNotFound = newNotFoundError("label_not_found", "board has no such label")
+	ErrInvalidColor  = newValidationError(
+		"invalid_color",
+		"label color must be a hex color like #d73a4a",
+	)
+	ErrLabelOtherBoard   = newValidationError("label_other_board", "label belongs to another board")
+	ErrLabelAlreadyAdded = newConflictError("label_already_added", "task already has this label")
+	ErrLabelNotOnTask    = newConflictError("label_not_on_task", "task doesn't have this label")
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func NewLabel(id LabelID, boardID BoardID, name string, color string) (*Label, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrLabelNameEmpty
+	}
+
+	if len(name) > MaxLabelNameLength {
+		return nil, ErrLabelNameTooLong
+	}
+
+	if !colorPattern.MatchString(color) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidColor, color)
+	}
+
+	return &Label{
+		id:        id,
+		boardID:   boardID,
+		name:      name,
+		color:     strings.ToLower(color),
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (l *Label) ID() LabelID {
+	return l.id
+}
+
+func (l *Label) BoardID() BoardID {
+	return l.boardID
+}
+
+// Matches reports whether the label is the one referred to by an ID or a name, names are case insensitive
+func (l *Label) Matches(idOrName string) bool {
+	return string(l.id) == idOrName || strings.EqualFold(l.name, idOrName)
+}
+
+// CheckNameAvailable returns an error if one of the board's labels already uses the label's name
+func (l *Label) CheckNameAvailable(boardLabels []Label) error {
+	for _, other := range boardLabels {
+		if other.id != l.id && strings.EqualFold(other.name, l.name) {
+			return fmt.Errorf("%w: %s", ErrLabelNameTaken, l.name)
+		}
+	}
+	return nil
+}
+
+// labelIDsOnBoard returns the IDs of the labels without duplicates, or an error if one isn't on the board
+func labelIDsOnBoard(labels []Label, boardID BoardID) ([]LabelID, error) {
+	labelIDs := make([]LabelID, 0, len(labels))
+	for _, label := range labels {
+		if label.boardID != boardID {
+			return nil, fmt.Errorf("%w: %s", ErrLabelOtherBoard, label.name)
+		}
+		if !slices.Contains(labelIDs, label.id) {
+			labelIDs = append(labelIDs, label.id)
+		}
+	}
+	return labelIDs, nil
+}
+
+type LabelSnapshot struct {
+	ID        LabelID   `json:"id"`
+	BoardID   BoardID   `json:"board_id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Used by the db adapters
+func (l *Label) GetSnapshot() LabelSnapshot {
+	return LabelSnapshot{
+		ID:        l.id,
+		BoardID:   l.boardID,
+		Name:      l.name,
+		Color:     l.color,
+		CreatedAt: l.createdAt,
+	}
+}
+
+// AddLabel tags the task with one of its board's labels
+func (t *Task) AddLabel(label Label) error {
+	if label.boardID != t.boardID {
+		return fmt.Errorf("%w: %s", ErrLabelOtherBoard, label.name)
+	}
+
+	if slices.ContainsFunc(t.labels, func(l Label) bool { return l.id == label.id }) {
+		return fmt.Errorf("%w: %s", ErrLabelAlreadyAdded, label.name)
+	}
+
+	t.labels = append(t.labels, label)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+func (t *Task) RemoveLabel(id LabelID) error {
+	before := len(t.labels)
+	t.labels = slices.DeleteFunc(t.labels, func(l Label) bool { return l.id == id })
+	if len(t.labels) == before {
+		return fmt.Errorf("%w: %s", ErrLabelNotOnTask, id)
+	}
+
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// SetLabels replaces all the labels of the task
+func (t *Task) SetLabels(labels []Label) error {
+	updated := make([]Label, 0, len(labels))
+	for _, label := range labels {
+		if label.boardID != t.boardID {
+			return fmt.Errorf("%w: %s", ErrLabelOtherBoard, label.name)
+		}
+		if !slices.ContainsFunc(updated, func(l Label) bool { return l.id == label.id }) {
+			updated = append(updated, label)
+		}
+	}
+
+	t.labels = updated
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// HasLabels reports whether the task has every label in idsOrNames
+func (t *Task) HasLabels(idsOrNames []string) bool {
+	for _, idOrName := range idsOrNames {
+		if !slices.ContainsFunc(t.labels, func(l Label) bool { return l.Matches(idOrName) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *Task) labelSnapshots() []LabelSnapshot {
+	snapshots := make([]LabelSnapshot, len(t.labels))
+	for i := range t.labels {
+		snapshots[i] = t.labels[i].GetSnapshot()
+	}
+	return snapshots
+}
+
+// FilterTasksByLabels keeps the tasks that have every label in idsOrNames
+func FilterTasksByLabels(tasks []Task, idsOrNames []string) []Task {
+	if len(idsOrNames) == 0 {
+		return tasks
+	}
+
+	return lo.Filter(tasks, func(t Task, _ int) bool {
+		return t.HasLabels(idsOrNames)
+	})
+}
@@ -0,0 +1,98 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createValidLabel(t *testing.T, id LabelID, boardID BoardID, name string) Label {
+	label, err := NewLabel(id, boardID, name, "#D73A4A")
+	require.NoError(t, err)
+	return *label
+}
+
+func TestNewLabel(t *testing.T) {
+	t.Run("valid label", func(t *testing.T) {
+		label, err := NewLabel("1", DefaultBoardID, "  bug ", "#D73A4A")
+		require.NoError(t, err)
+
+		snap := label.GetSnapshot()
+		assert.Equal(t, "bug", snap.Name)
+		assert.Equal(t, "#d73a4a", snap.Color)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		_, err := NewLabel("1", DefaultBoardID, " ", "#d73a4a")
+		assert.ErrorIs(t, err, ErrLabelNameEmpty)
+	})
+
+	t.Run("name too long", func(t *testing.T) {
+		_, err := NewLabel("1", DefaultBoardID, strings.Repeat("a", MaxLabelNameLength+1), "#d73a4a")
+		assert.ErrorIs(t, err, ErrLabelNameTooLong)
+	})
+
+	t.Run("invalid color", func(t *testing.T) {
+		_, err := NewLabel("1", DefaultBoardID, "bug", "red")
+		assert.ErrorIs(t, err, ErrInvalidColor)
+	})
+
+	t.Run("name taken on the board", func(t *testing.T) {
+		existing := []Label{createValidLabel(t, "1", DefaultBoardID, "Bug")}
+		label := createValidLabel(t, "2", DefaultBoardID, "bug")
+		assert.ErrorIs(t, label.CheckNameAvailable(existing), ErrLabelNameTaken)
+	})
+}
+
+func TestTaskLabels(t *testing.T) {
+	bug := createValidLabel(t, "1", DefaultBoardID, "bug")
+	frontend := createValidLabel(t, "2", DefaultBoardID, "frontend")
+
+	t.Run("add and remove", func(t *testing.T) {
+		task := newBoardTask(t, "1", DefaultBoardID)
+		require.NoError(t, task.AddLabel(bug))
+		assert.ErrorIs(t, task.AddLabel(bug), ErrLabelAlreadyAdded)
+		require.Len(t, task.GetSnapshot().Labels, 1)
+
+		require.NoError(t, task.RemoveLabel(bug.ID()))
+		assert.ErrorIs(t, task.RemoveLabel(bug.ID()), ErrLabelNotOnTask)
+		assert.Empty(t, task.GetSnapshot().Labels)
+	})
+
+	t.Run("label of another board", func(t *testing.T) {
+		task := newBoardTask(t, "1", DefaultBoardID)
+		other := createValidLabel(t, "3", "board-2", "bug")
+		assert.ErrorIs(t, task.AddLabel(other), ErrLabelOtherBoard)
+		assert.ErrorIs(t, task.SetLabels([]Label{other}), ErrLabelOtherBoard)
+	})
+
+	t.Run("set replaces the labels", func(t *testing.T) {
+		task := newBoardTask(t, "1", DefaultBoardID)
+		require.NoError(t, task.AddLabel(bug))
+		require.NoError(t, task.SetLabels([]Label{frontend, frontend}))
+
+		labels := task.GetSnapshot().Labels
+		require.Len(t, labels, 1)
+		assert.Equal(t, "frontend", labels[0].Name)
+	})
+
+	t.Run("filter by labels", func(t *testing.T) {
+		both := newBoardTask(t, "1", DefaultBoardID)
+		require.NoError(t, both.SetLabels([]Label{bug, frontend}))
+		onlyBug := newBoardTask(t, "2", DefaultBoardID)
+		require.NoError(t, onlyBug.AddLabel(bug))
+		none := newBoardTask(t, "3", DefaultBoardID)
+
+		tasks := []Task{*both, *onlyBug, *none}
+		assert.Len(t, FilterTasksByLabels(tasks, nil), 3)
+
+		filtered := FilterTasksByLabels(tasks, []string{"BUG"})
+		assert.Len(t, filtered, 2)
+
+		filtered = FilterTasksByLabels(tasks, []string{"bug", "2"})
+		require.Len(t, filtered, 1)
+		assert.Equal(t, TaskID("1"), filtered[0].GetSnapshot().ID)
+	})
+}
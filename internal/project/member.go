@@ -0,0 +1,188 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type MemberRepository interface {
+	Create(ctx context.Context, member *Member) error
+	GetByID(ctx context.Context, id MemberID) (*Member, error)
+	BoardMembers(ctx context.Context, boardID BoardID) ([]Member, error)
+}
+
+type MemberID string
+
+type MemberRole string
+
+const (
+	// Admins can force tasks past WIP limits
+	MemberRoleAdmin  MemberRole = "admin"
+	MemberRoleMember MemberRole = "member"
+)
+
+var validMemberRoles = []MemberRole{MemberRoleAdmin, MemberRoleMember}
+
+// Member is someone working on a board, only its members can be assigned to or watch its tasks
+type Member struct {
+	id          MemberID
+	boardID     BoardID
+	displayName string
+	email       *string
+	role        MemberRole
+	createdAt   time.Time
+}
+
+func NewMemberID() (MemberID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return MemberID(id.String()), nil
+}
+
+const MaxMemberNameLength = 50
+
+var (
+	ErrMemberNameEmpty   = newValidationError("member_name_empty", "member name cannot be empty")
+	ErrMemberNameTooLong = newValidationError("member_name_too_long", fmt.Sprintf(
+		"member name cannot be longer than %d characters",
+		MaxMemberNameLength,
+	))
+	ErrInvalidEmail = newValidationError(
+		"invalid_email",
+		"member email is not a valid email address",
+	)
+	ErrInvalidMemberRole = newValidationError("invalid_member_role", "invalid member role")
+	ErrMemberNameTaken   = newConflictError(
+		"member_name_taken",
+		"board already has a member with this name",
+	)
+	ErrMemberEmailTaken = newConflictError(
+		"member_email_taken",
+		"board already has a member with this email",
+	)
+	ErrMemberNotAnAdmin = newForbiddenError(
+		"member_not_an_admin",
+		"member is not an admin of the board",
+	)
+	ErrMemberNotFound = newNotFoundError("member_not_found", "board has no such member")
+)
+
+// NewMember adds someone to a board, the email is optional for members
+// that were migrated from the free-text assignee names
+func NewMember(
+	id MemberID,
+	boardID BoardID,
+	displayName string,
+	email *string,
+	role MemberRole,
+) (*Member, error) {
+	displayName = strings.TrimSpace(displayName)
+	if displayName == "" {
+		return nil, ErrMemberNameEmpty
+	}
+
+	if len(displayName) > MaxMemberNameLength {
+		return nil, ErrMemberNameTooLong
+	}
+
+	if email != nil {
+		address, err := mail.ParseAddress(*email)
+		if err != nil || address.Address != strings.TrimSpace(*email) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidEmail, *email)
+		}
+		email = lo.ToPtr(strings.ToLower(address.Address))
+	}
+
+	if !slices.Contains(validMemberRoles, role) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidMemberRole, role)
+	}
+
+	return &Member{
+		id:          id,
+		boardID:     boardID,
+		displayName: displayName,
+		email:       email,
+		role:        role,
+		createdAt:   time.Now(),
+	}, nil
+}
+
+func (m *Member) ID() MemberID {
+	return m.id
+}
+
+func (m *Member) DisplayName() string {
+	return m.displayName
+}
+
+func (m *Member) IsAdmin() bool {
+	return m.role == MemberRoleAdmin
+}
+
+// Matches returns true if idOrName is the member's ID or display name
+func (m *Member) Matches(idOrName string) bool {
+	return string(m.id) == idOrName || strings.EqualFold(m.displayName, strings.TrimSpace(idOrName))
+}
+
+// CheckAvailable returns an error if another member of the board has the same name or email
+func (m *Member) CheckAvailable(boardMembers []Member) error {
+	for _, other := range boardMembers {
+		if other.id == m.id {
+			continue
+		}
+
+		if strings.EqualFold(other.displayName, m.displayName) {
+			return fmt.Errorf("%w: %s", ErrMemberNameTaken, m.displayName)
+		}
+
+		if m.email != nil && other.email != nil && *other.email == *m.email {
+			return fmt.Errorf("%w: %s", ErrMemberEmailTaken, *m.email)
+		}
+	}
+	return nil
+}
+
+// FindMember looks a member up by ID or display name among the members of a board
+func FindMember(boardMembers []Member, idOrName string) (*Member, error) {
+	for i := range boardMembers {
+		if boardMembers[i].Matches(idOrName) {
+			return &boardMembers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrMemberNotFound, idOrName)
+}
+
+// MemberNames lists the display names of the members
+func MemberNames(members []Member) []string {
+	return lo.Map(members, func(m Member, _ int) string { return m.displayName })
+}
+
+type MemberSnapshot struct {
+	ID          MemberID   `json:"id"`
+	BoardID     BoardID    `json:"board_id"`
+	DisplayName string     `json:"display_name"`
+	Email       *string    `json:"email"`
+	Role        MemberRole `json:"role"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// Used by the db adapters
+func (m *Member) GetSnapshot() MemberSnapshot {
+	return MemberSnapshot{
+		ID:          m.id,
+		BoardID:     m.boardID,
+		DisplayName: m.displayName,
+		Email:       m.email,
+		Role:        m.role,
+		CreatedAt:   m.createdAt,
+	}
+}
@@ -0,0 +1,100 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createValidMember(
+	t *testing.T,
+	id MemberID,
+	boardID BoardID,
+	name string,
+	role MemberRole,
+) *Member {
+	member, err := NewMember(id, boardID, name, nil, role)
+	require.NoError(t, err)
+	return member
+}
+
+func TestNewMember(t *testing.T) {
+	t.Run("valid member", func(t *testing.T) {
+		member, err := NewMember(
+			"1",
+			DefaultBoardID,
+			"  Mary ",
+			lo.ToPtr("Mary@Example.com"),
+			MemberRoleAdmin,
+		)
+		require.NoError(t, err)
+
+		snap := member.GetSnapshot()
+		assert.Equal(t, "Mary", snap.DisplayName)
+		assert.Equal(t, lo.ToPtr("mary@example.com"), snap.Email)
+		assert.True(t, member.IsAdmin())
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		_, err := NewMember("1", DefaultBoardID, " ", nil, MemberRoleMember)
+		assert.ErrorIs(t, err, ErrMemberNameEmpty)
+	})
+
+	t.Run("name too long", func(t *testing.T) {
+		name := strings.Repeat("a", MaxMemberNameLength+1)
+		_, err := NewMember("1", DefaultBoardID, name, nil, MemberRoleMember)
+		assert.ErrorIs(t, err, ErrMemberNameTooLong)
+	})
+
+	t.Run("invalid email", func(t *testing.T) {
+		_, err := NewMember("1", DefaultBoardID, "Mary", lo.ToPtr("Mary <mary"), MemberRoleMember)
+		assert.ErrorIs(t, err, ErrInvalidEmail)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		_, err := NewMember("1", DefaultBoardID, "Mary", nil, "owner")
+		assert.ErrorIs(t, err, ErrInvalidMemberRole)
+	})
+}
+
+func TestMemberCheckAvailable(t *testing.T) {
+	existing, err := NewMember("1", DefaultBoardID, "Mary", lo.ToPtr("mary@example.com"), MemberRoleMember)
+	require.NoError(t, err)
+
+	t.Run("name taken", func(t *testing.T) {
+		member := createValidMember(t, "2", DefaultBoardID, "mary", MemberRoleMember)
+		assert.ErrorIs(t, member.CheckAvailable([]Member{*existing}), ErrMemberNameTaken)
+	})
+
+	t.Run("email taken", func(t *testing.T) {
+		member, err := NewMember("2", DefaultBoardID, "Mary Jane", lo.ToPtr("MARY@example.com"), MemberRoleMember)
+		require.NoError(t, err)
+		assert.ErrorIs(t, member.CheckAvailable([]Member{*existing}), ErrMemberEmailTaken)
+	})
+
+	t.Run("available", func(t *testing.T) {
+		member := createValidMember(t, "2", DefaultBoardID, "John", MemberRoleMember)
+		assert.NoError(t, member.CheckAvailable([]Member{*existing}))
+	})
+}
+
+func TestFindMember(t *testing.T) {
+	members := []Member{
+		*createValidMember(t, "1", DefaultBoardID, "John", MemberRoleMember),
+		*createValidMember(t, "2", DefaultBoardID, "Mary", MemberRoleMember),
+	}
+
+	byID, err := FindMember(members, "2")
+	require.NoError(t, err)
+	assert.Equal(t, "Mary", byID.DisplayName())
+
+	byName, err := FindMember(members, " john")
+	require.NoError(t, err)
+	assert.Equal(t, MemberID("1"), byName.ID())
+
+	_, err = FindMember(members, "Steve")
+	assert.ErrorIs(t, err, ErrMemberNotFound)
+}
@@ -0,0 +1,173 @@
+package project
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Rank orders the tasks of a column, LexoRank style: ranks are compared as strings
+// so a task can always be moved between two others by giving it a rank that sorts between theirs,
+// without renumbering the rest of the column.
+type Rank string
+
+// rankDigits are the digits of a rank, in the order they sort
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+var (
+	ErrInvalidRank = newValidationError(
+		"invalid_rank",
+		"rank must be lowercase letters and digits, not ending with 0",
+	)
+	ErrRankOrder = newValidationError(
+		"rank_order",
+		"the task to move after must come before the task to move before",
+	)
+	ErrMoveNextToItself = newValidationError(
+		"move_next_to_itself",
+		"a task cannot be moved next to itself",
+	)
+	ErrMoveOtherColumn = newValidationError(
+		"move_other_column",
+		"the tasks to move between must be in the target column",
+	)
+	ErrMoveOtherBoard = newValidationError(
+		"move_other_board",
+		"the tasks to move between must be on the same board",
+	)
+)
+
+// RankBetween returns a rank that sorts after prev and before next,
+// an empty prev means the top of the column and an empty next the bottom
+func RankBetween(prev, next Rank) (Rank, error) {
+	if next != "" && prev >= next {
+		return "", fmt.Errorf("%w: %q, %q", ErrRankOrder, prev, next)
+	}
+
+	digit := func(rank Rank, i int) int {
+		if i < len(rank) {
+			return strings.IndexByte(rankDigits, rank[i])
+		}
+		return 0
+	}
+
+	rank := make([]byte, 0, max(len(prev), len(next))+1)
+	bounded := next != ""
+	for i := 0; ; i++ {
+		low := digit(prev, i)
+		high := len(rankDigits)
+		if bounded {
+			if i >= len(next) {
+				return "", fmt.Errorf("%w: %q", ErrInvalidRank, next)
+			}
+			high = digit(next, i)
+		}
+
+		if low == high {
+			rank = append(rank, rankDigits[low])
+			continue
+		}
+
+		if mid := (low + high) / 2; mid > low {
+			return Rank(append(rank, rankDigits[mid])), nil
+		}
+
+		// No digit fits between them, so anything after this one sorts before next
+		rank = append(rank, rankDigits[low])
+		bounded = false
+	}
+}
+
+func (t *Task) Rank() Rank {
+	return t.rank
+}
+
+// Move puts the task in a column between two of its tasks, after is the task right above it
+// and before the one right below it (nil for the top or bottom of the column).
+// Changing the column has to be allowed by the board's workflow.
+func (t *Task) Move(workflow Workflow, status TaskStatus, after *Task, before *Task) error {
+	for _, neighbour := range []*Task{after, before} {
+		if neighbour == nil {
+			continue
+		}
+		if neighbour.id == t.id {
+			return ErrMoveNextToItself
+		}
+		if neighbour.boardID != t.boardID {
+			return fmt.Errorf("%w: %s", ErrMoveOtherBoard, neighbour.id)
+		}
+		if neighbour.status != status {
+			return fmt.Errorf("%w: %s is %s", ErrMoveOtherColumn, neighbour.id, neighbour.status)
+		}
+	}
+
+	var prev, next Rank
+	if after != nil {
+		prev = after.rank
+	}
+	if before != nil {
+		next = before.rank
+	}
+
+	rank, err := RankBetween(prev, next)
+	if err != nil {
+		return err
+	}
+
+	if status != t.status {
+		if err := t.ChangeStatus(workflow, status); err != nil {
+			return err
+		}
+	} else if t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskArchived, t.id)
+	}
+
+	t.rank = rank
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// PlaceLast puts the task at the bottom of its column, below the other board tasks in it
+func (t *Task) PlaceLast(boardTasks []Task) {
+	var last Rank
+	for i := range boardTasks {
+		other := &boardTasks[i]
+		if other.id != t.id && other.status == t.status && other.rank > last {
+			last = other.rank
+		}
+	}
+
+	// Ranks are valid so there's always one after the last
+	t.rank, _ = RankBetween(last, "")
+}
+
+// SortByColumn orders the tasks by board, then by the position of their status in the board's workflow,
+// then by rank. Tasks with a status the workflow doesn't have anymore come after the others.
+func SortByColumn(tasks []Task, boards []Board) {
+	workflows := make(map[BoardID]Workflow, len(boards))
+	for _, board := range boards {
+		workflows[board.id] = board.workflow
+	}
+
+	column := func(t *Task) int {
+		workflow, ok := workflows[t.boardID]
+		if !ok {
+			workflow = DefaultWorkflow()
+		}
+		if i := slices.Index(workflow.statuses, t.status); i >= 0 {
+			return i
+		}
+		return len(workflow.statuses)
+	}
+
+	slices.SortStableFunc(tasks, func(a, b Task) int {
+		return cmp.Or(
+			cmp.Compare(a.boardID, b.boardID),
+			cmp.Compare(column(&a), column(&b)),
+			cmp.Compare(a.rank, b.rank),
+			a.createdAt.Compare(b.createdAt),
+		)
+	})
+}
@@ -0,0 +1,157 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		prev Rank
+		next Rank
+		want Rank
+	}{
+		{name: "empty column", want: "i"},
+		{name: "top of the column", next: "i", want: "9"},
+		{name: "bottom of the column", prev: "i", want: "r"},
+		{name: "between", prev: "a", next: "c", want: "b"},
+		{name: "no digit in between", prev: "a", next: "b", want: "ai"},
+		{name: "prev is longer", prev: "az", next: "b", want: "azi"},
+		{name: "prev is a prefix of next", prev: "a", next: "a1", want: "a0i"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank, err := RankBetween(tt.prev, tt.next)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rank)
+			assert.Greater(t, rank, tt.prev)
+			if tt.next != "" {
+				assert.Less(t, rank, tt.next)
+			}
+		})
+	}
+
+	t.Run("out of order", func(t *testing.T) {
+		_, err := RankBetween("b", "a")
+		assert.ErrorIs(t, err, ErrRankOrder)
+		_, err = RankBetween("a", "a")
+		assert.ErrorIs(t, err, ErrRankOrder)
+	})
+
+	t.Run("always room at the top", func(t *testing.T) {
+		next := Rank("i")
+		for range 100 {
+			rank, err := RankBetween("", next)
+			require.NoError(t, err)
+			require.Less(t, rank, next)
+			next = rank
+		}
+	})
+}
+
+func TestTaskMove(t *testing.T) {
+	first := newRankedTask(t, "first", TaskStatusInProgress, "a")
+	second := newRankedTask(t, "second", TaskStatusInProgress, "b")
+
+	t.Run("between two tasks of another column", func(t *testing.T) {
+		task := newRankedTask(t, "task", TaskStatusPending, "i")
+		require.NoError(t, task.Move(DefaultWorkflow(), TaskStatusInProgress, first, second))
+		assert.Equal(t, TaskStatusInProgress, task.GetSnapshot().Status)
+		assert.Greater(t, task.Rank(), first.Rank())
+		assert.Less(t, task.Rank(), second.Rank())
+	})
+
+	t.Run("to the top of its column", func(t *testing.T) {
+		task := newRankedTask(t, "task", TaskStatusInProgress, "i")
+		require.NoError(t, task.Move(DefaultWorkflow(), TaskStatusInProgress, nil, first))
+		assert.Less(t, task.Rank(), first.Rank())
+	})
+
+	t.Run("invalid neighbours", func(t *testing.T) {
+		task := newRankedTask(t, "task", TaskStatusPending, "i")
+
+		err := task.Move(DefaultWorkflow(), TaskStatusInProgress, task, nil)
+		assert.ErrorIs(t, err, ErrMoveNextToItself)
+
+		err = task.Move(DefaultWorkflow(), TaskStatusPending, first, nil)
+		assert.ErrorIs(t, err, ErrMoveOtherColumn)
+
+		err = task.Move(DefaultWorkflow(), TaskStatusInProgress, second, first)
+		assert.ErrorIs(t, err, ErrRankOrder)
+
+		other := newBoardTask(t, "other", "board-2")
+		err = task.Move(DefaultWorkflow(), TaskStatusPending, other, nil)
+		assert.ErrorIs(t, err, ErrMoveOtherBoard)
+
+		assert.Equal(t, TaskStatusPending, task.GetSnapshot().Status)
+		assert.Equal(t, Rank("i"), task.Rank())
+	})
+
+	t.Run("the workflow has to allow the move", func(t *testing.T) {
+		workflow, err := NewWorkflow(
+			[]TaskStatus{TaskStatusPending, TaskStatusInProgress, TaskStatusCompleted},
+			map[TaskStatus][]TaskStatus{TaskStatusPending: {TaskStatusInProgress}},
+		)
+		require.NoError(t, err)
+
+		task := newRankedTask(t, "task", TaskStatusPending, "i")
+		err = task.Move(workflow, TaskStatusCompleted, nil, nil)
+		assert.ErrorIs(t, err, ErrTransitionNotAllowed)
+		assert.Equal(t, Rank("i"), task.Rank())
+	})
+
+	t.Run("archived tasks can't move", func(t *testing.T) {
+		task := newRankedTask(t, "task", TaskStatusInProgress, "i")
+		require.NoError(t, task.Archive())
+		err := task.Move(DefaultWorkflow(), TaskStatusInProgress, nil, first)
+		assert.ErrorIs(t, err, ErrTaskArchived)
+	})
+}
+
+func TestTaskPlaceLast(t *testing.T) {
+	tasks := []Task{
+		*newRankedTask(t, "1", TaskStatusPending, "c"),
+		*newRankedTask(t, "2", TaskStatusPending, "m"),
+		*newRankedTask(t, "3", TaskStatusInProgress, "x"),
+	}
+
+	task := newRankedTask(t, "4", TaskStatusPending, "i")
+	task.PlaceLast(tasks)
+	assert.Greater(t, task.Rank(), Rank("m"))
+	assert.Less(t, task.Rank(), Rank("x"))
+}
+
+func TestSortByColumn(t *testing.T) {
+	workflow, err := NewWorkflow(
+		[]TaskStatus{TaskStatusPending, "doing", TaskStatusCompleted},
+		nil,
+	)
+	require.NoError(t, err)
+	board, err := NewBoard(DefaultBoardID, "Default")
+	require.NoError(t, err)
+	require.NoError(t, board.ChangeWorkflow(workflow, nil))
+
+	tasks := []Task{
+		*newRankedTask(t, "done", TaskStatusCompleted, "a"),
+		*newRankedTask(t, "second", TaskStatusPending, "m"),
+		*newRankedTask(t, "doing", "doing", "b"),
+		*newRankedTask(t, "first", TaskStatusPending, "c"),
+		*newRankedTask(t, "removed column", TaskStatusInReview, "a"),
+	}
+
+	SortByColumn(tasks, []Board{*board})
+	ids := lo.Map(tasks, func(t Task, _ int) TaskID { return t.id })
+	assert.Equal(t, []TaskID{"first", "second", "doing", "done", "removed column"}, ids)
+}
+
+func newRankedTask(t *testing.T, id TaskID, status TaskStatus, rank Rank) *Task {
+	task := newBoardTask(t, id, DefaultBoardID)
+	task.status = status
+	task.rank = rank
+	return task
+}
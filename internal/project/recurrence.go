@@ -0,0 +1,246 @@
+package project
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurrenceRule is a value object for the subset of iCalendar's RRULE (RFC 5545) that recurring tasks support:
+//
+//	FREQ=DAILY|WEEKLY|MONTHLY, INTERVAL=n, BYDAY=MO,TU,... (weekly only),
+//	BYMONTHDAY=1..31 (monthly only), UNTIL=YYYYMMDD or YYYYMMDDTHHMMSSZ
+//
+// The occurrences start at the rule's start time and keep its time of day.
+// Months that don't have the rule's day are skipped, like in RFC 5545.
+type RecurrenceRule struct {
+	frequency  RecurrenceFrequency
+	interval   int
+	byDay      []time.Weekday
+	byMonthDay int
+	until      *time.Time
+}
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceMonthly RecurrenceFrequency = "MONTHLY"
+)
+
+const MaxRecurrenceInterval = 365
+
+var (
+	ErrInvalidRecurrence     = newValidationError("invalid_recurrence", "invalid recurrence rule")
+	ErrUnsupportedRecurrence = newValidationError(
+		"unsupported_recurrence",
+		"recurrence rule part is not supported, use FREQ, INTERVAL, BYDAY, BYMONTHDAY and UNTIL",
+	)
+)
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ParseRecurrenceRule parses a rule like "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO", the "RRULE:" prefix is optional
+func ParseRecurrenceRule(rule string) (RecurrenceRule, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(strings.ToUpper(rule)), "RRULE:")
+	if rule == "" {
+		return RecurrenceRule{}, fmt.Errorf("%w: rule is empty", ErrInvalidRecurrence)
+	}
+
+	r := RecurrenceRule{interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return RecurrenceRule{}, fmt.Errorf("%w: %q", ErrInvalidRecurrence, part)
+		}
+		if seen[key] {
+			return RecurrenceRule{}, fmt.Errorf("%w: %s is repeated", ErrInvalidRecurrence, key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			r.frequency = RecurrenceFrequency(value)
+			if !slices.Contains(
+				[]RecurrenceFrequency{RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly},
+				r.frequency,
+			) {
+				err = fmt.Errorf("%w: FREQ=%s", ErrUnsupportedRecurrence, value)
+			}
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(value)
+			if err != nil || r.interval < 1 || r.interval > MaxRecurrenceInterval {
+				err = fmt.Errorf("%w: INTERVAL must be between 1 and %d", ErrInvalidRecurrence, MaxRecurrenceInterval)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := rruleWeekdays[day]
+				if !ok {
+					err = fmt.Errorf("%w: BYDAY=%s", ErrInvalidRecurrence, day)
+					break
+				}
+				r.byDay = append(r.byDay, weekday)
+			}
+		case "BYMONTHDAY":
+			r.byMonthDay, err = strconv.Atoi(value)
+			if err != nil || r.byMonthDay < 1 || r.byMonthDay > 31 {
+				err = fmt.Errorf("%w: BYMONTHDAY must be between 1 and 31", ErrInvalidRecurrence)
+			}
+		case "UNTIL":
+			r.until, err = parseRRuleTime(value)
+		default:
+			err = fmt.Errorf("%w: %s", ErrUnsupportedRecurrence, key)
+		}
+		if err != nil {
+			return RecurrenceRule{}, err
+		}
+	}
+
+	if r.frequency == "" {
+		return RecurrenceRule{}, fmt.Errorf("%w: FREQ is required", ErrInvalidRecurrence)
+	}
+	if r.byDay != nil && r.frequency != RecurrenceWeekly {
+		return RecurrenceRule{}, fmt.Errorf("%w: BYDAY only works with FREQ=WEEKLY", ErrUnsupportedRecurrence)
+	}
+	if r.byMonthDay != 0 && r.frequency != RecurrenceMonthly {
+		return RecurrenceRule{}, fmt.Errorf("%w: BYMONTHDAY only works with FREQ=MONTHLY", ErrUnsupportedRecurrence)
+	}
+
+	// Weeks start on Monday, so the days are kept in that order
+	slices.SortFunc(r.byDay, func(a, b time.Weekday) int { return daysSinceMonday(a) - daysSinceMonday(b) })
+	r.byDay = slices.Compact(r.byDay)
+	return r, nil
+}
+
+func parseRRuleTime(value string) (*time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: UNTIL=%s", ErrInvalidRecurrence, value)
+}
+
+// String is the rule in its canonical RRULE form
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.frequency)}
+	if r.interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+	if len(r.byDay) > 0 {
+		days := make([]string, len(r.byDay))
+		for i, weekday := range r.byDay {
+			for name, d := range rruleWeekdays {
+				if d == weekday {
+					days[i] = name
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.byMonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.byMonthDay))
+	}
+	if r.until != nil {
+		parts = append(parts, "UNTIL="+r.until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// maxPeriodsSearched bounds the search for the next occurrence, a monthly rule on the 31st
+// every 12 months starting in a short month would never have one
+const maxPeriodsSearched = 1000
+
+// Next returns the first occurrence after the given time for a rule starting at start,
+// false once the rule has no occurrences left
+func (r RecurrenceRule) Next(start time.Time, after time.Time) (time.Time, bool) {
+	first := max(0, r.periodsBetween(start, after)-1)
+	for period := first; period < first+maxPeriodsSearched; period++ {
+		for _, occurrence := range r.occurrencesIn(start, period) {
+			if occurrence.Before(start) || !occurrence.After(after) {
+				continue
+			}
+			if r.until != nil && occurrence.After(*r.until) {
+				return time.Time{}, false
+			}
+			return occurrence, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// periodsBetween estimates how many periods of the rule fit between start and t, without going over
+func (r RecurrenceRule) periodsBetween(start time.Time, t time.Time) int {
+	if !t.After(start) {
+		return 0
+	}
+
+	days := int(t.Sub(start).Hours() / 24)
+	switch r.frequency {
+	case RecurrenceWeekly:
+		return days / 7 / r.interval
+	case RecurrenceMonthly:
+		months := (t.Year()-start.Year())*12 + int(t.Month()-start.Month())
+		return months / r.interval
+	default:
+		return days / r.interval
+	}
+}
+
+// occurrencesIn lists the occurrences of a period in order, the nth period starts n*interval days,
+// weeks or months after start
+func (r RecurrenceRule) occurrencesIn(start time.Time, period int) []time.Time {
+	steps := period * r.interval
+
+	switch r.frequency {
+	case RecurrenceWeekly:
+		monday := start.AddDate(0, 0, -daysSinceMonday(start.Weekday())+7*steps)
+		days := r.byDay
+		if len(days) == 0 {
+			days = []time.Weekday{start.Weekday()}
+		}
+
+		occurrences := make([]time.Time, len(days))
+		for i, day := range days {
+			occurrences[i] = monday.AddDate(0, 0, daysSinceMonday(day))
+		}
+		return occurrences
+	case RecurrenceMonthly:
+		day := r.byMonthDay
+		if day == 0 {
+			day = start.Day()
+		}
+
+		year, month, _ := start.Date()
+		month += time.Month(steps)
+		occurrence := time.Date(
+			year, month, day,
+			start.Hour(), start.Minute(), start.Second(), 0,
+			start.Location(),
+		)
+		// time.Date normalizes the 31st of a 30 days month into the next month
+		if occurrence.Month() != time.Date(year, month, 1, 0, 0, 0, 0, start.Location()).Month() {
+			return nil
+		}
+		return []time.Time{occurrence}
+	default:
+		return []time.Time{start.AddDate(0, 0, steps)}
+	}
+}
+
+func daysSinceMonday(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
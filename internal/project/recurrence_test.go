@@ -0,0 +1,114 @@
+package project
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	t.Run("canonical form", func(t *testing.T) {
+		rule, err := ParseRecurrenceRule("rrule:freq=weekly;byday=FR,MO,MO;interval=2")
+		require.NoError(t, err)
+		assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", rule.String())
+
+		rule, err = ParseRecurrenceRule("FREQ=MONTHLY;BYMONTHDAY=15;UNTIL=20261231")
+		require.NoError(t, err)
+		assert.Equal(t, "FREQ=MONTHLY;BYMONTHDAY=15;UNTIL=20261231T000000Z", rule.String())
+	})
+
+	invalid := []struct {
+		rule string
+		err  error
+	}{
+		{rule: "", err: ErrInvalidRecurrence},
+		{rule: "INTERVAL=2", err: ErrInvalidRecurrence},
+		{rule: "FREQ=YEARLY", err: ErrUnsupportedRecurrence},
+		{rule: "FREQ=DAILY;COUNT=3", err: ErrUnsupportedRecurrence},
+		{rule: "FREQ=DAILY;INTERVAL=0", err: ErrInvalidRecurrence},
+		{rule: "FREQ=DAILY;FREQ=WEEKLY", err: ErrInvalidRecurrence},
+		{rule: "FREQ=WEEKLY;BYDAY=XX", err: ErrInvalidRecurrence},
+		{rule: "FREQ=DAILY;BYDAY=MO", err: ErrUnsupportedRecurrence},
+		{rule: "FREQ=MONTHLY;BYMONTHDAY=32", err: ErrInvalidRecurrence},
+		{rule: "FREQ=MONTHLY;UNTIL=tomorrow", err: ErrInvalidRecurrence},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.rule, func(t *testing.T) {
+			_, err := ParseRecurrenceRule(tt.rule)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRecurrenceRuleNext(t *testing.T) {
+	// A wednesday
+	start := time.Date(2026, time.January, 7, 9, 0, 0, 0, time.UTC)
+
+	occurrences := func(t *testing.T, rule string, n int) []time.Time {
+		r, err := ParseRecurrenceRule(rule)
+		require.NoError(t, err)
+
+		var got []time.Time
+		after := start.Add(-time.Second)
+		for range n {
+			next, ok := r.Next(start, after)
+			if !ok {
+				break
+			}
+			got = append(got, next)
+			after = next
+		}
+		return got
+	}
+
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 9, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("daily", func(t *testing.T) {
+		assert.Equal(t,
+			[]time.Time{date(time.January, 7), date(time.January, 10), date(time.January, 13)},
+			occurrences(t, "FREQ=DAILY;INTERVAL=3", 3),
+		)
+	})
+
+	t.Run("weekly on the start's day", func(t *testing.T) {
+		assert.Equal(t,
+			[]time.Time{date(time.January, 7), date(time.January, 21)},
+			occurrences(t, "FREQ=WEEKLY;INTERVAL=2", 2),
+		)
+	})
+
+	t.Run("weekly on some days", func(t *testing.T) {
+		// Monday the 5th is before the start
+		assert.Equal(t,
+			[]time.Time{date(time.January, 9), date(time.January, 12), date(time.January, 16)},
+			occurrences(t, "FREQ=WEEKLY;BYDAY=MO,FR", 3),
+		)
+	})
+
+	t.Run("monthly skips months without the day", func(t *testing.T) {
+		assert.Equal(t,
+			[]time.Time{date(time.January, 31), date(time.March, 31), date(time.May, 31)},
+			occurrences(t, "FREQ=MONTHLY;BYMONTHDAY=31", 3),
+		)
+	})
+
+	t.Run("until", func(t *testing.T) {
+		assert.Equal(t,
+			[]time.Time{date(time.January, 7), date(time.January, 8)},
+			occurrences(t, "FREQ=DAILY;UNTIL=20260108T120000Z", 5),
+		)
+	})
+
+	t.Run("long after the start", func(t *testing.T) {
+		rule, err := ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=MO")
+		require.NoError(t, err)
+
+		next, ok := rule.Next(start, time.Date(2030, time.June, 5, 0, 0, 0, 0, time.UTC))
+		require.True(t, ok)
+		assert.Equal(t, time.Date(2030, time.June, 10, 9, 0, 0, 0, time.UTC), next)
+	})
+}
@@ -0,0 +1,225 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecurringTaskRepository interface {
+	Create(ctx context.Context, recurring *RecurringTask) error
+	UpdateRecurringTask(
+		ctx context.Context,
+		id RecurringTaskID,
+		updateFn func(r *RecurringTask) (*RecurringTask, error),
+	) error
+	Delete(ctx context.Context, id RecurringTaskID) error
+	BoardRecurringTasks(ctx context.Context, boardID BoardID) ([]RecurringTask, error)
+	// DueRecurringTasks lists the recurring tasks of every board with an occurrence at or before now
+	DueRecurringTasks(ctx context.Context, now time.Time) ([]RecurringTask, error)
+}
+
+type RecurringTaskID string
+
+// RecurringTask is a template for a chore that repeats, like "rotate on-call" every monday.
+// A task is created from it each time its recurrence rule falls due.
+type RecurringTask struct {
+	id          RecurringTaskID
+	boardID     BoardID
+	title       string
+	description *string
+	assigneeIDs []MemberID
+	labelIDs    []LabelID
+	priority    *TaskPriority
+	storyPoints *int
+	rule        RecurrenceRule
+	startsAt    time.Time
+	// nil once the rule has no occurrences left
+	nextAt *time.Time
+	// The last occurrence a task was created for
+	lastAt    *time.Time
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewRecurringTaskID() (RecurringTaskID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return RecurringTaskID(id.String()), nil
+}
+
+var (
+	ErrRecurringTitleEmpty = newValidationError(
+		"recurring_title_empty",
+		"recurring task title cannot be empty",
+	)
+	ErrRecurrenceEnded = newValidationError(
+		"recurrence_ended",
+		"recurrence rule has no occurrences after it starts",
+	)
+	ErrRecurringNotDue   = newConflictError("recurring_not_due", "recurring task is not due")
+	ErrRecurringNotFound = newNotFoundError("recurring_not_found", "recurring task not found")
+)
+
+// occurrenceNamespace makes the IDs of the tasks created for an occurrence stable
+var occurrenceNamespace = uuid.MustParse("5d3c1a1e-6a43-4c55-9a0e-3f7b7e5f2a10")
+
+func NewRecurringTask(
+	id RecurringTaskID,
+	boardID BoardID,
+	title string,
+	description *string,
+	assignees []Member,
+	labels []Label,
+	priority *TaskPriority,
+	storyPoints *int,
+	rule RecurrenceRule,
+	startsAt time.Time,
+) (*RecurringTask, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, ErrRecurringTitleEmpty
+	}
+
+	if len(title) > MaxTitleLength {
+		return nil, ErrTitleTooLong
+	}
+
+	if err := validateMembers(assignees, boardID, ErrUnknownAssignee); err != nil {
+		return nil, err
+	}
+
+	labelIDs, err := labelIDsOnBoard(labels, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePriority(priority); err != nil {
+		return nil, err
+	}
+
+	if err := validateStoryPoints(storyPoints); err != nil {
+		return nil, err
+	}
+
+	// Occurrences are kept in UTC to the second, so they're the same after a round trip to the db
+	startsAt = startsAt.UTC().Truncate(time.Second)
+
+	// The start is an occurrence itself, unless the rule's days don't include it
+	nextAt, ok := rule.Next(startsAt, startsAt.Add(-time.Second))
+	if !ok {
+		return nil, ErrRecurrenceEnded
+	}
+
+	now := time.Now()
+	assigneeIDs := make([]MemberID, 0, len(assignees))
+	for _, assignee := range uniqueMembers(assignees) {
+		assigneeIDs = append(assigneeIDs, assignee.id)
+	}
+
+	return &RecurringTask{
+		id:          id,
+		boardID:     boardID,
+		title:       title,
+		description: description,
+		assigneeIDs: assigneeIDs,
+		labelIDs:    labelIDs,
+		priority:    priority,
+		storyPoints: storyPoints,
+		rule:        rule,
+		startsAt:    startsAt,
+		nextAt:      &nextAt,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+func (r *RecurringTask) ID() RecurringTaskID {
+	return r.id
+}
+
+func (r *RecurringTask) BoardID() BoardID {
+	return r.boardID
+}
+
+func (r *RecurringTask) IsDue(now time.Time) bool {
+	return r.nextAt != nil && !r.nextAt.After(now)
+}
+
+// DueOccurrence is the latest occurrence at or before now. Occurrences missed while the
+// server was down are skipped: a chore that's due again only needs to be done once.
+func (r *RecurringTask) DueOccurrence(now time.Time) (time.Time, error) {
+	if !r.IsDue(now) {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrRecurringNotDue, r.title)
+	}
+
+	occurrence := *r.nextAt
+	for {
+		next, ok := r.rule.Next(r.startsAt, occurrence)
+		if !ok || next.After(now) {
+			return occurrence, nil
+		}
+		occurrence = next
+	}
+}
+
+// OccurrenceTaskID is the ID of the task created for an occurrence, it's always the same
+// so creating the task twice for one occurrence fails instead of making a duplicate
+func (r *RecurringTask) OccurrenceTaskID(occurrence time.Time) TaskID {
+	key := string(r.id) + "/" + occurrence.UTC().Format(time.RFC3339)
+	return TaskID(uuid.NewSHA1(occurrenceNamespace, []byte(key)).String())
+}
+
+// Advance records that the task for occurrence was created and schedules the next one
+func (r *RecurringTask) Advance(occurrence time.Time) {
+	r.lastAt = &occurrence
+	r.nextAt = nil
+	if next, ok := r.rule.Next(r.startsAt, occurrence); ok {
+		r.nextAt = &next
+	}
+	r.updatedAt = time.Now()
+}
+
+type RecurringTaskSnapshot struct {
+	ID          RecurringTaskID `json:"id"`
+	BoardID     BoardID         `json:"board_id"`
+	Title       string          `json:"title"`
+	Description *string         `json:"description"`
+	AssigneeIDs []MemberID      `json:"assignee_ids"`
+	LabelIDs    []LabelID       `json:"label_ids"`
+	Priority    *TaskPriority   `json:"priority"`
+	StoryPoints *int            `json:"story_points"`
+	// RRULE, e.g. FREQ=WEEKLY;BYDAY=MO
+	Rule     string    `json:"rule"`
+	StartsAt time.Time `json:"starts_at"`
+	// When the next task will be created, null once the rule has ended
+	NextAt    *time.Time `json:"next_at"`
+	LastAt    *time.Time `json:"last_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// Used by the db adapters
+func (r *RecurringTask) GetSnapshot() *RecurringTaskSnapshot {
+	return &RecurringTaskSnapshot{
+		ID:          r.id,
+		BoardID:     r.boardID,
+		Title:       r.title,
+		Description: r.description,
+		AssigneeIDs: r.assigneeIDs,
+		LabelIDs:    r.labelIDs,
+		Priority:    r.priority,
+		StoryPoints: r.storyPoints,
+		Rule:        r.rule.String(),
+		StartsAt:    r.startsAt,
+		NextAt:      r.nextAt,
+		LastAt:      r.lastAt,
+		CreatedAt:   r.createdAt,
+		UpdatedAt:   r.updatedAt,
+	}
+}
@@ -0,0 +1,88 @@
+package project
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newWeeklyRecurringTask(t *testing.T, startsAt time.Time) *RecurringTask {
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=MO")
+	require.NoError(t, err)
+
+	recurring, err := NewRecurringTask("1", DefaultBoardID, "Rotate on-call", nil, nil, nil, nil, nil, rule, startsAt)
+	require.NoError(t, err)
+	return recurring
+}
+
+func TestNewRecurringTask(t *testing.T) {
+	// A wednesday
+	start := time.Date(2026, time.January, 7, 9, 0, 0, 0, time.UTC)
+
+	t.Run("first occurrence on or after the start", func(t *testing.T) {
+		recurring := newWeeklyRecurringTask(t, start)
+		assert.Equal(t, lo.ToPtr(time.Date(2026, time.January, 12, 9, 0, 0, 0, time.UTC)), recurring.GetSnapshot().NextAt)
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO", recurring.GetSnapshot().Rule)
+	})
+
+	t.Run("validates the task", func(t *testing.T) {
+		rule, err := ParseRecurrenceRule("FREQ=DAILY")
+		require.NoError(t, err)
+
+		_, err = NewRecurringTask("1", DefaultBoardID, " ", nil, nil, nil, nil, nil, rule, start)
+		assert.ErrorIs(t, err, ErrRecurringTitleEmpty)
+
+		otherBoardMember := *createValidMember(t, "member-3", "board-2", "Steve", MemberRoleMember)
+		_, err = NewRecurringTask("1", DefaultBoardID, "chore", nil, []Member{otherBoardMember}, nil, nil, nil, rule, start)
+		assert.ErrorIs(t, err, ErrUnknownAssignee)
+
+		_, err = NewRecurringTask("1", DefaultBoardID, "chore", nil, nil, nil, lo.ToPtr(TaskPriority("urgent")), nil, rule, start)
+		assert.ErrorIs(t, err, ErrInvalidPriority)
+	})
+
+	t.Run("rule that ended", func(t *testing.T) {
+		rule, err := ParseRecurrenceRule("FREQ=DAILY;UNTIL=20250101")
+		require.NoError(t, err)
+
+		_, err = NewRecurringTask("1", DefaultBoardID, "chore", nil, nil, nil, nil, nil, rule, start)
+		assert.ErrorIs(t, err, ErrRecurrenceEnded)
+	})
+}
+
+func TestRecurringTaskOccurrences(t *testing.T) {
+	start := time.Date(2026, time.January, 7, 9, 0, 0, 0, time.UTC)
+	firstMonday := time.Date(2026, time.January, 12, 9, 0, 0, 0, time.UTC)
+
+	t.Run("not due before its next occurrence", func(t *testing.T) {
+		recurring := newWeeklyRecurringTask(t, start)
+		assert.False(t, recurring.IsDue(firstMonday.Add(-time.Minute)))
+
+		_, err := recurring.DueOccurrence(firstMonday.Add(-time.Minute))
+		assert.ErrorIs(t, err, ErrRecurringNotDue)
+	})
+
+	t.Run("missed occurrences are skipped", func(t *testing.T) {
+		recurring := newWeeklyRecurringTask(t, start)
+		now := firstMonday.AddDate(0, 0, 15)
+		require.True(t, recurring.IsDue(now))
+
+		occurrence, err := recurring.DueOccurrence(now)
+		require.NoError(t, err)
+		assert.Equal(t, firstMonday.AddDate(0, 0, 14), occurrence)
+
+		recurring.Advance(occurrence)
+		assert.False(t, recurring.IsDue(now))
+		assert.Equal(t, lo.ToPtr(firstMonday.AddDate(0, 0, 21)), recurring.GetSnapshot().NextAt)
+		assert.Equal(t, &occurrence, recurring.GetSnapshot().LastAt)
+	})
+
+	t.Run("the task of an occurrence always has the same ID", func(t *testing.T) {
+		recurring := newWeeklyRecurringTask(t, start)
+		id := recurring.OccurrenceTaskID(firstMonday)
+		assert.Equal(t, id, recurring.OccurrenceTaskID(firstMonday.In(time.FixedZone("UTC+2", 2*60*60))))
+		assert.NotEqual(t, id, recurring.OccurrenceTaskID(firstMonday.AddDate(0, 0, 7)))
+	})
+}
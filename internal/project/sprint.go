@@ -0,0 +1,294 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type SprintRepository interface {
+	Create(ctx context.Context, sprint *Sprint) error
+	GetByID(ctx context.Context, id SprintID) (*Sprint, error)
+	UpdateSprint(
+		ctx context.Context,
+		id SprintID,
+		updateFn func(s *Sprint) (*Sprint, error),
+	) error
+	BoardSprints(ctx context.Context, boardID BoardID) ([]Sprint, error)
+}
+
+type SprintID string
+
+type SprintStatus string
+
+const (
+	SprintStatusPlanned SprintStatus = "planned"
+	SprintStatusActive  SprintStatus = "active"
+	SprintStatusClosed  SprintStatus = "closed"
+)
+
+// Sprint is a timebox the team commits to a set of the board's tasks for.
+// A sprint is planned, then started, then closed, and a board has at most one active sprint.
+type Sprint struct {
+	id        SprintID
+	boardID   BoardID
+	name      string
+	goal      *string
+	startDate time.Time
+	endDate   time.Time
+	status    SprintStatus
+	createdAt time.Time
+	startedAt *time.Time
+	closedAt  *time.Time
+}
+
+func NewSprintID() (SprintID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return SprintID(id.String()), nil
+}
+
+const MaxSprintNameLength = 50
+
+var (
+	ErrSprintNameEmpty   = newValidationError("sprint_name_empty", "sprint name cannot be empty")
+	ErrSprintNameTooLong = newValidationError("sprint_name_too_long", fmt.Sprintf(
+		"sprint name cannot be longer than %d characters",
+		MaxSprintNameLength,
+	))
+	ErrSprintEndBeforeStart = newValidationError(
+		"sprint_end_before_start",
+		"sprint must end after it starts",
+	)
+	ErrSprintNotPlanned = newConflictError(
+		"sprint_not_planned",
+		"only planned sprints can be started",
+	)
+	ErrSprintNotActive = newConflictError(
+		"sprint_not_active",
+		"only active sprints can be closed",
+	)
+	ErrSprintAlreadyActive = newConflictError(
+		"sprint_already_active",
+		"board already has an active sprint",
+	)
+	ErrSprintClosed     = newConflictError("sprint_closed", "sprint is closed")
+	ErrSprintOtherBoard = newValidationError(
+		"sprint_other_board",
+		"sprint belongs to another board",
+	)
+	ErrNoActiveSprint  = newNotFoundError("no_active_sprint", "board has no active sprint")
+	ErrTaskNotInSprint = newConflictError(
+		"task_not_in_sprint",
+		"task is not part of the sprint",
+	)
+	ErrSprintRollOverItself = newValidationError(
+		"sprint_roll_over_itself",
+		"tasks cannot roll over into the sprint being closed",
+	)
+	ErrSprintNotFound = newNotFoundError("sprint_not_found", "sprint not found")
+)
+
+func NewSprint(
+	id SprintID,
+	boardID BoardID,
+	name string,
+	goal *string,
+	startDate time.Time,
+	endDate time.Time,
+) (*Sprint, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrSprintNameEmpty
+	}
+
+	if len(name) > MaxSprintNameLength {
+		return nil, ErrSprintNameTooLong
+	}
+
+	if !endDate.After(startDate) {
+		return nil, ErrSprintEndBeforeStart
+	}
+
+	return &Sprint{
+		id:        id,
+		boardID:   boardID,
+		name:      name,
+		goal:      goal,
+		startDate: startDate,
+		endDate:   endDate,
+		status:    SprintStatusPlanned,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (s *Sprint) ID() SprintID {
+	return s.id
+}
+
+func (s *Sprint) BoardID() BoardID {
+	return s.boardID
+}
+
+func (s *Sprint) Status() SprintStatus {
+	return s.status
+}
+
+// Start makes the sprint the board's active sprint, boardSprints are all the sprints of the board
+func (s *Sprint) Start(boardSprints []Sprint) error {
+	if s.status != SprintStatusPlanned {
+		return fmt.Errorf("%w: %s is %s", ErrSprintNotPlanned, s.name, s.status)
+	}
+
+	if active, err := ActiveSprint(boardSprints); err == nil && active.id != s.id {
+		return fmt.Errorf("%w: %s", ErrSprintAlreadyActive, active.name)
+	}
+
+	s.status = SprintStatusActive
+	s.startedAt = lo.ToPtr(time.Now())
+	return nil
+}
+
+// Close ends the sprint, its unfinished tasks have to be rolled over by the caller
+func (s *Sprint) Close() error {
+	if s.status != SprintStatusActive {
+		return fmt.Errorf("%w: %s is %s", ErrSprintNotActive, s.name, s.status)
+	}
+
+	s.status = SprintStatusClosed
+	s.closedAt = lo.ToPtr(time.Now())
+	return nil
+}
+
+// CanRollOverTo returns an error if the unfinished tasks of s cannot move to next when s closes
+func (s *Sprint) CanRollOverTo(next *Sprint) error {
+	if next.id == s.id {
+		return ErrSprintRollOverItself
+	}
+
+	if next.boardID != s.boardID {
+		return fmt.Errorf("%w: %s", ErrSprintOtherBoard, next.name)
+	}
+
+	return next.CanAddTasks()
+}
+
+// CanAddTasks returns an error if tasks cannot be moved into the sprint
+func (s *Sprint) CanAddTasks() error {
+	if s.status == SprintStatusClosed {
+		return fmt.Errorf("%w: %s", ErrSprintClosed, s.name)
+	}
+	return nil
+}
+
+// ActiveSprint finds the sprint that's currently running among the sprints of a board
+func ActiveSprint(boardSprints []Sprint) (*Sprint, error) {
+	for i := range boardSprints {
+		if boardSprints[i].status == SprintStatusActive {
+			return &boardSprints[i], nil
+		}
+	}
+	return nil, ErrNoActiveSprint
+}
+
+// MoveToSprint plans the task for a sprint of its board, taking it out of any other sprint
+func (t *Task) MoveToSprint(sprint *Sprint) error {
+	if sprint.boardID != t.boardID {
+		return fmt.Errorf("%w: %s", ErrSprintOtherBoard, sprint.name)
+	}
+
+	if err := sprint.CanAddTasks(); err != nil {
+		return err
+	}
+
+	t.sprintID = &sprint.id
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// MoveToBacklog takes the task out of its sprint
+func (t *Task) MoveToBacklog() error {
+	if t.sprintID == nil {
+		return ErrTaskNotInSprint
+	}
+
+	t.sprintID = nil
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// InSprint returns an error if the task isn't planned for the sprint
+func (t *Task) InSprint(sprintID SprintID) error {
+	if t.sprintID == nil || *t.sprintID != sprintID {
+		return fmt.Errorf("%w: %s", ErrTaskNotInSprint, sprintID)
+	}
+	return nil
+}
+
+func (t *Task) SprintID() *SprintID {
+	return t.sprintID
+}
+
+// SprintTasks keeps the tasks planned for the sprint
+func SprintTasks(tasks []Task, sprintID SprintID) []Task {
+	return lo.Filter(tasks, func(t Task, _ int) bool {
+		return t.sprintID != nil && *t.sprintID == sprintID
+	})
+}
+
+// SprintStats sums up how far along the tasks of a sprint are
+type SprintStats struct {
+	Tasks                int `json:"tasks"`
+	CompletedTasks       int `json:"completed_tasks"`
+	StoryPoints          int `json:"story_points"`
+	CompletedStoryPoints int `json:"completed_story_points"`
+}
+
+func NewSprintStats(sprintTasks []Task) SprintStats {
+	var stats SprintStats
+	for _, task := range sprintTasks {
+		points := lo.FromPtr(task.storyPoints)
+		stats.Tasks++
+		stats.StoryPoints += points
+		if task.status == TaskStatusCompleted {
+			stats.CompletedTasks++
+			stats.CompletedStoryPoints += points
+		}
+	}
+	return stats
+}
+
+type SprintSnapshot struct {
+	ID        SprintID     `json:"id"`
+	BoardID   BoardID      `json:"board_id"`
+	Name      string       `json:"name"`
+	Goal      *string      `json:"goal"`
+	StartDate time.Time    `json:"start_date"`
+	EndDate   time.Time    `json:"end_date"`
+	Status    SprintStatus `json:"status"`
+	CreatedAt time.Time    `json:"created_at"`
+	StartedAt *time.Time   `json:"started_at"`
+	ClosedAt  *time.Time   `json:"closed_at"`
+}
+
+// Used by the db adapters
+func (s *Sprint) GetSnapshot() *SprintSnapshot {
+	return &SprintSnapshot{
+		ID:        s.id,
+		BoardID:   s.boardID,
+		Name:      s.name,
+		Goal:      s.goal,
+		StartDate: s.startDate,
+		EndDate:   s.endDate,
+		Status:    s.status,
+		CreatedAt: s.createdAt,
+		StartedAt: s.startedAt,
+		ClosedAt:  s.closedAt,
+	}
+}
@@ -0,0 +1,141 @@
+package project
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createValidSprint(t *testing.T, id SprintID, boardID BoardID) *Sprint {
+	start := time.Now()
+	sprint, err := NewSprint(id, boardID, "Sprint "+string(id), nil, start, start.Add(14*24*time.Hour))
+	require.NoError(t, err)
+	return sprint
+}
+
+func TestNewSprint(t *testing.T) {
+	t.Run("valid sprint", func(t *testing.T) {
+		goal := "Ship the board view"
+		start := time.Now()
+		sprint, err := NewSprint("1", DefaultBoardID, " Sprint 1 ", &goal, start, start.Add(time.Hour))
+		require.NoError(t, err)
+
+		snap := sprint.GetSnapshot()
+		assert.Equal(t, "Sprint 1", snap.Name)
+		assert.Equal(t, &goal, snap.Goal)
+		assert.Equal(t, SprintStatusPlanned, snap.Status)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		start := time.Now()
+		_, err := NewSprint("1", DefaultBoardID, " ", nil, start, start.Add(time.Hour))
+		assert.ErrorIs(t, err, ErrSprintNameEmpty)
+	})
+
+	t.Run("ends before it starts", func(t *testing.T) {
+		start := time.Now()
+		_, err := NewSprint("1", DefaultBoardID, "Sprint 1", nil, start, start.Add(-time.Hour))
+		assert.ErrorIs(t, err, ErrSprintEndBeforeStart)
+	})
+}
+
+func TestSprintLifecycle(t *testing.T) {
+	t.Run("planned, active, closed", func(t *testing.T) {
+		sprint := createValidSprint(t, "1", DefaultBoardID)
+		assert.ErrorIs(t, sprint.Close(), ErrSprintNotActive)
+
+		require.NoError(t, sprint.Start([]Sprint{*sprint}))
+		assert.Equal(t, SprintStatusActive, sprint.Status())
+		assert.NotNil(t, sprint.GetSnapshot().StartedAt)
+		assert.ErrorIs(t, sprint.Start([]Sprint{*sprint}), ErrSprintNotPlanned)
+
+		require.NoError(t, sprint.Close())
+		assert.Equal(t, SprintStatusClosed, sprint.Status())
+		assert.NotNil(t, sprint.GetSnapshot().ClosedAt)
+		assert.ErrorIs(t, sprint.CanAddTasks(), ErrSprintClosed)
+	})
+
+	t.Run("one active sprint per board", func(t *testing.T) {
+		first := createValidSprint(t, "1", DefaultBoardID)
+		second := createValidSprint(t, "2", DefaultBoardID)
+		require.NoError(t, first.Start([]Sprint{*first, *second}))
+
+		err := second.Start([]Sprint{*first, *second})
+		assert.ErrorIs(t, err, ErrSprintAlreadyActive)
+
+		active, err := ActiveSprint([]Sprint{*first, *second})
+		require.NoError(t, err)
+		assert.Equal(t, first.ID(), active.ID())
+	})
+
+	t.Run("no active sprint", func(t *testing.T) {
+		_, err := ActiveSprint([]Sprint{*createValidSprint(t, "1", DefaultBoardID)})
+		assert.ErrorIs(t, err, ErrNoActiveSprint)
+	})
+
+	t.Run("roll over", func(t *testing.T) {
+		current := createValidSprint(t, "1", DefaultBoardID)
+		next := createValidSprint(t, "2", DefaultBoardID)
+		other := createValidSprint(t, "3", "other-board")
+
+		assert.NoError(t, current.CanRollOverTo(next))
+		assert.ErrorIs(t, current.CanRollOverTo(current), ErrSprintRollOverItself)
+		assert.ErrorIs(t, current.CanRollOverTo(other), ErrSprintOtherBoard)
+	})
+}
+
+func TestTaskSprint(t *testing.T) {
+	t.Run("move to a sprint and back to the backlog", func(t *testing.T) {
+		sprint := createValidSprint(t, "1", DefaultBoardID)
+		task := newBoardTask(t, "1", DefaultBoardID)
+		assert.ErrorIs(t, task.MoveToBacklog(), ErrTaskNotInSprint)
+
+		require.NoError(t, task.MoveToSprint(sprint))
+		assert.Equal(t, sprint.ID(), *task.SprintID())
+		assert.NoError(t, task.InSprint(sprint.ID()))
+		assert.ErrorIs(t, task.InSprint("2"), ErrTaskNotInSprint)
+
+		require.NoError(t, task.MoveToBacklog())
+		assert.Nil(t, task.SprintID())
+	})
+
+	t.Run("sprint of another board", func(t *testing.T) {
+		sprint := createValidSprint(t, "1", "other-board")
+		task := newBoardTask(t, "1", DefaultBoardID)
+		assert.ErrorIs(t, task.MoveToSprint(sprint), ErrSprintOtherBoard)
+	})
+
+	t.Run("closed sprint", func(t *testing.T) {
+		sprint := createValidSprint(t, "1", DefaultBoardID)
+		require.NoError(t, sprint.Start(nil))
+		require.NoError(t, sprint.Close())
+
+		task := newBoardTask(t, "1", DefaultBoardID)
+		assert.ErrorIs(t, task.MoveToSprint(sprint), ErrSprintClosed)
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		sprint := createValidSprint(t, "1", DefaultBoardID)
+		points := []int{3, 5, 8}
+		tasks := make([]Task, len(points))
+		for i, p := range points {
+			task := newBoardTask(t, TaskID(fmt.Sprintf("task-%d", i)), DefaultBoardID)
+			task.storyPoints = &p
+			require.NoError(t, task.MoveToSprint(sprint))
+			tasks[i] = *task
+		}
+		require.NoError(t, tasks[0].ChangeStatus(DefaultWorkflow(), TaskStatusCompleted))
+		tasks = append(tasks, *newBoardTask(t, "backlog", DefaultBoardID))
+
+		stats := NewSprintStats(SprintTasks(tasks, sprint.ID()))
+		assert.Equal(t, SprintStats{
+			Tasks:                3,
+			CompletedTasks:       1,
+			StoryPoints:          16,
+			CompletedStoryPoints: 3,
+		}, stats)
+	})
+}
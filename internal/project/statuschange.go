@@ -0,0 +1,77 @@
+package project
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// StatusChange is a move of a task from one column to another, kept to measure how long it sat in each
+type StatusChange struct {
+	from      TaskStatus
+	to        TaskStatus
+	changedAt time.Time
+}
+
+// StatusChanges lists the moves of the task between columns, oldest first
+func (t *Task) StatusChanges() []StatusChange {
+	return t.statusChanges
+}
+
+func (t *Task) recordStatusChange(to TaskStatus, changedAt time.Time) {
+	if to == t.status {
+		return
+	}
+
+	t.statusChanges = append(t.statusChanges, StatusChange{
+		from:      t.status,
+		to:        to,
+		changedAt: changedAt,
+	})
+}
+
+// TimeInStatus is the time the task spent in each of its statuses up to now, counting from its creation.
+// Tasks created before the changes were recorded start in the status of their first recorded change.
+func (t *Task) TimeInStatus(now time.Time) map[TaskStatus]time.Duration {
+	spent := make(map[TaskStatus]time.Duration)
+
+	status := t.status
+	if len(t.statusChanges) > 0 {
+		status = t.statusChanges[0].from
+	}
+
+	since := t.createdAt
+	for _, change := range t.statusChanges {
+		spent[status] += max(change.changedAt.Sub(since), 0)
+		status, since = change.to, change.changedAt
+	}
+	spent[status] += max(now.Sub(since), 0)
+
+	return spent
+}
+
+func (t *Task) timeInStatusSeconds() map[TaskStatus]int {
+	return lo.MapValues(t.TimeInStatus(time.Now()), func(d time.Duration, _ TaskStatus) int {
+		return int(d / time.Second)
+	})
+}
+
+type StatusChangeSnapshot struct {
+	From      TaskStatus `json:"from"`
+	To        TaskStatus `json:"to"`
+	ChangedAt time.Time  `json:"changed_at"`
+}
+
+func (c StatusChange) GetSnapshot() StatusChangeSnapshot {
+	return StatusChangeSnapshot{
+		From:      c.from,
+		To:        c.to,
+		ChangedAt: c.changedAt,
+	}
+}
+
+func statusChangeSnapshots(changes []StatusChange) []StatusChangeSnapshot {
+	return lo.Map(changes, func(c StatusChange, _ int) StatusChangeSnapshot {
+		return c.GetSnapshot()
+	})
+}
@@ -0,0 +1,80 @@
+package project
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusChanges(t *testing.T) {
+	workflow := DefaultWorkflow()
+
+	t.Run("records changes", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.ChangeStatus(workflow, TaskStatusInProgress))
+		require.NoError(t, task.ChangeStatus(workflow, TaskStatusCompleted))
+
+		changes := task.GetSnapshot().StatusChanges
+		require.Len(t, changes, 2)
+		assert.Equal(t, TaskStatusPending, changes[0].From)
+		assert.Equal(t, TaskStatusInProgress, changes[0].To)
+		assert.Equal(t, TaskStatusInProgress, changes[1].From)
+		assert.Equal(t, TaskStatusCompleted, changes[1].To)
+		assert.False(t, changes[1].ChangedAt.Before(changes[0].ChangedAt))
+	})
+
+	t.Run("same status is not a change", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.ChangeStatus(workflow, TaskStatusPending))
+		assert.Empty(t, task.StatusChanges())
+	})
+
+	t.Run("rejected change is not recorded", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.Archive())
+		assert.Error(t, task.ChangeStatus(workflow, TaskStatusInProgress))
+		assert.Empty(t, task.StatusChanges())
+	})
+}
+
+func TestTimeInStatus(t *testing.T) {
+	created := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := created.Add(10 * time.Hour)
+
+	t.Run("no changes", func(t *testing.T) {
+		task := createValidTask(t)
+		task.createdAt = created
+
+		assert.Equal(t, map[TaskStatus]time.Duration{
+			TaskStatusPending: 10 * time.Hour,
+		}, task.TimeInStatus(now))
+	})
+
+	t.Run("sums the time of each visit", func(t *testing.T) {
+		task := createValidTask(t)
+		task.createdAt = created
+		task.status = TaskStatusInReview
+		task.statusChanges = []StatusChange{
+			{from: TaskStatusPending, to: TaskStatusInProgress, changedAt: created.Add(time.Hour)},
+			{from: TaskStatusInProgress, to: TaskStatusInReview, changedAt: created.Add(4 * time.Hour)},
+			{from: TaskStatusInReview, to: TaskStatusInProgress, changedAt: created.Add(5 * time.Hour)},
+			{from: TaskStatusInProgress, to: TaskStatusInReview, changedAt: created.Add(7 * time.Hour)},
+		}
+
+		assert.Equal(t, map[TaskStatus]time.Duration{
+			TaskStatusPending:    time.Hour,
+			TaskStatusInProgress: 5 * time.Hour,
+			TaskStatusInReview:   4 * time.Hour,
+		}, task.TimeInStatus(now))
+	})
+
+	t.Run("in seconds on the snapshot", func(t *testing.T) {
+		task := createValidTask(t)
+		task.createdAt = time.Now().Add(-90 * time.Second)
+
+		seconds := task.GetSnapshot().TimeInStatusSeconds
+		assert.GreaterOrEqual(t, seconds[TaskStatusPending], 90)
+	})
+}
@@ -0,0 +1,156 @@
+package project
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// subtask is what a parent task knows about each of its children
+type subtask struct {
+	id     TaskID
+	status TaskStatus
+}
+
+var (
+	ErrSubtaskOfItself = newValidationError(
+		"subtask_of_itself",
+		"a task cannot be a subtask of itself",
+	)
+	ErrSubtaskHasParent = newConflictError(
+		"subtask_has_parent",
+		"task is already a subtask of another task",
+	)
+	ErrSubtaskNesting = newValidationError(
+		"subtask_nesting",
+		"subtasks cannot have subtasks of their own",
+	)
+	ErrSubtaskOtherBoard = newValidationError(
+		"subtask_other_board",
+		"subtask must be on the same board as its parent",
+	)
+	ErrNotASubtask     = newConflictError("not_a_subtask", "task is not a subtask of this task")
+	ErrParentCompleted = newConflictError(
+		"parent_completed",
+		"a completed task cannot have open subtasks",
+	)
+	ErrOpenSubtasks = newConflictError(
+		"open_subtasks",
+		"task cannot be completed while it has open subtasks",
+	)
+)
+
+// AddSubtask makes child a subtask of t. Only one level of nesting is allowed:
+// stories break down into subtasks, subtasks don't break down further.
+func (t *Task) AddSubtask(child *Task) error {
+	if child.id == t.id {
+		return ErrSubtaskOfItself
+	}
+
+	if child.boardID != t.boardID {
+		return ErrSubtaskOtherBoard
+	}
+
+	if child.parentID != nil {
+		return fmt.Errorf("%w: %s", ErrSubtaskHasParent, *child.parentID)
+	}
+
+	if t.parentID != nil || len(child.children) > 0 {
+		return ErrSubtaskNesting
+	}
+
+	if t.status == TaskStatusCompleted && child.status != TaskStatusCompleted {
+		return ErrParentCompleted
+	}
+
+	now := time.Now()
+	parentStatus := t.status
+	child.parentID = &t.id
+	child.parentStatus = &parentStatus
+	child.updatedAt = now
+	t.children = append(t.children, subtask{id: child.id, status: child.status})
+	t.updatedAt = now
+	return nil
+}
+
+// RemoveSubtask turns child back into a standalone task
+func (t *Task) RemoveSubtask(child *Task) error {
+	if child.parentID == nil || *child.parentID != t.id {
+		return ErrNotASubtask
+	}
+
+	now := time.Now()
+	child.parentID = nil
+	child.parentStatus = nil
+	child.updatedAt = now
+	t.children = slices.DeleteFunc(t.children, func(s subtask) bool {
+		return s.id == child.id
+	})
+	t.updatedAt = now
+	return nil
+}
+
+func (t *Task) hasOpenSubtasks() bool {
+	return slices.ContainsFunc(t.children, func(s subtask) bool {
+		return s.status != TaskStatusCompleted
+	})
+}
+
+// progress is the ratio of completed subtasks, nil for tasks without subtasks
+func (t *Task) progress() *float64 {
+	if len(t.children) == 0 {
+		return nil
+	}
+
+	completed := 0
+	for _, child := range t.children {
+		if child.status == TaskStatusCompleted {
+			completed++
+		}
+	}
+
+	ratio := float64(completed) / float64(len(t.children))
+	return &ratio
+}
+
+func (t *Task) childIDs() []TaskID {
+	ids := make([]TaskID, len(t.children))
+	for i, child := range t.children {
+		ids[i] = child.id
+	}
+	return ids
+}
+
+// TaskNode is a task with its subtasks nested under it
+type TaskNode struct {
+	*TaskSnapshot
+	Subtasks []TaskNode `json:"subtasks"`
+}
+
+// TaskTree nests the subtasks under their parents. Subtasks whose parent
+// isn't part of tasks are returned at the top level.
+func TaskTree(tasks []Task) []TaskNode {
+	present := make(map[TaskID]bool, len(tasks))
+	for _, task := range tasks {
+		present[task.id] = true
+	}
+
+	childrenOf := make(map[TaskID][]TaskNode)
+	roots := make([]TaskNode, 0, len(tasks))
+	for _, task := range tasks {
+		node := TaskNode{TaskSnapshot: task.GetSnapshot(), Subtasks: []TaskNode{}}
+		if task.parentID != nil && present[*task.parentID] {
+			childrenOf[*task.parentID] = append(childrenOf[*task.parentID], node)
+			continue
+		}
+		roots = append(roots, node)
+	}
+
+	for i := range roots {
+		if children, ok := childrenOf[roots[i].ID]; ok {
+			roots[i].Subtasks = children
+		}
+	}
+
+	return roots
+}
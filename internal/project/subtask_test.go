@@ -0,0 +1,149 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBoardTask(t *testing.T, id TaskID, boardID BoardID) *Task {
+	task, err := NewTask(id, boardID, "task "+string(id), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	return task
+}
+
+func TestTaskAddSubtask(t *testing.T) {
+	t.Run("valid subtask", func(t *testing.T) {
+		parent := newBoardTask(t, "1", DefaultBoardID)
+		child := newBoardTask(t, "2", DefaultBoardID)
+
+		require.NoError(t, parent.AddSubtask(child))
+
+		assert.Equal(t, []TaskID{"2"}, parent.GetSnapshot().ChildIDs)
+		require.NotNil(t, child.GetSnapshot().ParentID)
+		assert.Equal(t, TaskID("1"), *child.GetSnapshot().ParentID)
+	})
+
+	t.Run("subtask of itself", func(t *testing.T) {
+		parent := newBoardTask(t, "1", DefaultBoardID)
+		assert.ErrorIs(t, parent.AddSubtask(parent), ErrSubtaskOfItself)
+	})
+
+	t.Run("other board", func(t *testing.T) {
+		parent := newBoardTask(t, "1", DefaultBoardID)
+		child := newBoardTask(t, "2", "board-2")
+		assert.ErrorIs(t, parent.AddSubtask(child), ErrSubtaskOtherBoard)
+	})
+
+	t.Run("already has a parent", func(t *testing.T) {
+		first := newBoardTask(t, "1", DefaultBoardID)
+		second := newBoardTask(t, "2", DefaultBoardID)
+		child := newBoardTask(t, "3", DefaultBoardID)
+
+		require.NoError(t, first.AddSubtask(child))
+		assert.ErrorIs(t, second.AddSubtask(child), ErrSubtaskHasParent)
+	})
+
+	t.Run("only one level of nesting", func(t *testing.T) {
+		parent := newBoardTask(t, "1", DefaultBoardID)
+		child := newBoardTask(t, "2", DefaultBoardID)
+		grandchild := newBoardTask(t, "3", DefaultBoardID)
+		require.NoError(t, parent.AddSubtask(child))
+
+		assert.ErrorIs(t, child.AddSubtask(grandchild), ErrSubtaskNesting)
+
+		other := newBoardTask(t, "4", DefaultBoardID)
+		assert.ErrorIs(t, other.AddSubtask(parent), ErrSubtaskNesting)
+	})
+
+	t.Run("open subtask on a completed parent", func(t *testing.T) {
+		parent := newBoardTask(t, "1", DefaultBoardID)
+		require.NoError(t, parent.ChangeStatus(DefaultWorkflow(), TaskStatusCompleted))
+		child := newBoardTask(t, "2", DefaultBoardID)
+
+		assert.ErrorIs(t, parent.AddSubtask(child), ErrParentCompleted)
+	})
+}
+
+func TestTaskReopenSubtask(t *testing.T) {
+	parentID := "1"
+	subtaskOf := func(parentStatus TaskStatus) *Task {
+		return UnmarshalTaskFromDB(&ent.Task{
+			ID:       "2",
+			Title:    "subtask",
+			Status:   string(TaskStatusCompleted),
+			ParentID: &parentID,
+			Edges: ent.TaskEdges{
+				Parent: &ent.Task{ID: parentID, Status: string(parentStatus)},
+			},
+		})
+	}
+
+	t.Run("of a completed parent", func(t *testing.T) {
+		child := subtaskOf(TaskStatusCompleted)
+		err := child.ChangeStatus(DefaultWorkflow(), TaskStatusInProgress)
+		assert.ErrorIs(t, err, ErrParentCompleted)
+		assert.Equal(t, TaskStatusCompleted, child.GetSnapshot().Status)
+	})
+
+	t.Run("of an open parent", func(t *testing.T) {
+		child := subtaskOf(TaskStatusInProgress)
+		assert.NoError(t, child.ChangeStatus(DefaultWorkflow(), TaskStatusInProgress))
+	})
+}
+
+func TestTaskRemoveSubtask(t *testing.T) {
+	parent := newBoardTask(t, "1", DefaultBoardID)
+	child := newBoardTask(t, "2", DefaultBoardID)
+	other := newBoardTask(t, "3", DefaultBoardID)
+	require.NoError(t, parent.AddSubtask(child))
+
+	assert.ErrorIs(t, parent.RemoveSubtask(other), ErrNotASubtask)
+
+	require.NoError(t, parent.RemoveSubtask(child))
+	assert.Empty(t, parent.GetSnapshot().ChildIDs)
+	assert.Nil(t, child.GetSnapshot().ParentID)
+}
+
+func TestTaskCompleteWithSubtasks(t *testing.T) {
+	parent := newBoardTask(t, "1", DefaultBoardID)
+	done := newBoardTask(t, "2", DefaultBoardID)
+	open := newBoardTask(t, "3", DefaultBoardID)
+	require.NoError(t, done.ChangeStatus(DefaultWorkflow(), TaskStatusCompleted))
+	require.NoError(t, parent.AddSubtask(done))
+	require.NoError(t, parent.AddSubtask(open))
+
+	progress := parent.GetSnapshot().Progress
+	require.NotNil(t, progress)
+	assert.InDelta(t, 0.5, *progress, 0.001)
+
+	err := parent.ChangeStatus(DefaultWorkflow(), TaskStatusCompleted)
+	assert.ErrorIs(t, err, ErrOpenSubtasks)
+
+	require.NoError(t, parent.ChangeStatus(DefaultWorkflow(), TaskStatusInProgress))
+}
+
+func TestTaskTree(t *testing.T) {
+	parent := newBoardTask(t, "1", DefaultBoardID)
+	child := newBoardTask(t, "2", DefaultBoardID)
+	standalone := newBoardTask(t, "3", DefaultBoardID)
+	require.NoError(t, parent.AddSubtask(child))
+
+	tree := TaskTree([]Task{*child, *parent, *standalone})
+	require.Len(t, tree, 2)
+
+	assert.Equal(t, TaskID("1"), tree[0].ID)
+	require.Len(t, tree[0].Subtasks, 1)
+	assert.Equal(t, TaskID("2"), tree[0].Subtasks[0].ID)
+
+	assert.Equal(t, TaskID("3"), tree[1].ID)
+	assert.Empty(t, tree[1].Subtasks)
+
+	t.Run("orphaned subtask stays at the top level", func(t *testing.T) {
+		tree := TaskTree([]Task{*child})
+		require.Len(t, tree, 1)
+		assert.Equal(t, TaskID("2"), tree[0].ID)
+	})
+}
@@ -2,8 +2,8 @@ package project
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,11 +11,33 @@ import (
 )
 
 type TaskRepository interface {
+	// Create returns ErrTaskAlreadyExists if the task's ID is taken
 	Create(ctx context.Context, task *Task) error
+	// GetByID returns ErrTaskNotFound if there's no such task
 	GetByID(ctx context.Context, id TaskID) (*Task, error)
+	// UpdateTask bumps the task's version, it returns ErrTaskChangedConcurrently
+	// if the task was saved by someone else while updateFn ran.
+	// Updates of the tasks of the same board run one at a time, so updateFn can count on the board's tasks
 	UpdateTask(ctx context.Context, id TaskID, updateFn func(t *Task) (*Task, error)) error
-	AllTasks(ctx context.Context) ([]Task, error)
+	// Delete removes the task for good, with its files, comments and history
+	Delete(ctx context.Context, id TaskID) error
+	// BoardTasks leaves out the archived tasks, it returns ErrBoardNotFound if there's no such board
+	BoardTasks(ctx context.Context, boardID BoardID) ([]Task, error)
+	// BoardTasksWithArchived keeps the archived tasks, they still take part in the links between tasks
+	BoardTasksWithArchived(ctx context.Context, boardID BoardID) ([]Task, error)
+	// ListTasks returns up to limit tasks matching the filter in the order of sort,
+	// starting right after the cursor if there's one
+	ListTasks(
+		ctx context.Context,
+		filter TaskFilter,
+		sort TaskSort,
+		after *TaskCursor,
+		limit int,
+	) ([]Task, error)
+	ArchivedTasks(ctx context.Context) ([]Task, error)
 	AddFiles(ctx context.Context, taskID TaskID, files []File) error
+	// TaskHistory lists the changes made to the task since the given time (if any), oldest first
+	TaskHistory(ctx context.Context, id TaskID, since *time.Time) ([]Activity, error)
 }
 
 type TaskID string
@@ -29,17 +51,61 @@ const (
 	TaskStatusCompleted  TaskStatus = "completed"
 )
 
+type TaskPriority string
+
+// From the most urgent to the least urgent
+const (
+	TaskPriorityP0 TaskPriority = "p0"
+	TaskPriorityP1 TaskPriority = "p1"
+	TaskPriorityP2 TaskPriority = "p2"
+	TaskPriorityP3 TaskPriority = "p3"
+)
+
+var validTaskPriorities = []TaskPriority{
+	TaskPriorityP0,
+	TaskPriorityP1,
+	TaskPriorityP2,
+	TaskPriorityP3,
+}
+
 type Task struct {
-	id           TaskID
-	title        string
-	description  *string
-	dueDate      *time.Time
-	assigneeName *string
-	createdAt    time.Time
-	updatedAt    time.Time
-	completedAt  *time.Time
-	status       TaskStatus
-	files        []File
+	id          TaskID
+	boardID     BoardID
+	parentID    *TaskID
+	sprintID    *SprintID
+	epicID      *EpicID
+	children    []subtask
+	blockedBy   []dependency
+	blocks      []dependency
+	labels      []Label
+	title       string
+	description *string
+	dueDate     *time.Time
+	assignees   []Member
+	watchers    []Member
+	createdAt   time.Time
+	updatedAt   time.Time
+	completedAt *time.Time
+	// Archived tasks are hidden from the board until they're restored
+	archivedAt *time.Time
+	status     TaskStatus
+	// Position of the task in its status column
+	rank        Rank
+	priority    *TaskPriority
+	storyPoints *int
+	wipOverride *WIPOverride
+	files       []File
+	checklist   []ChecklistItem
+	// Values of the board's custom fields, sorted by field name
+	customFields []CustomFieldValue
+	// Sum of the task's worklogs
+	timeSpent time.Duration
+	// Moves between columns, oldest first
+	statusChanges []StatusChange
+	// Bumped every time the task is saved
+	version int
+	// Status of the parent task, nil for tasks that aren't subtasks
+	parentStatus *TaskStatus
 }
 
 func NewTaskID() (TaskID, error) {
@@ -50,27 +116,48 @@ func NewTaskID() (TaskID, error) {
 	return TaskID(id.String()), nil
 }
 
-const MaxTitleLength = 50
+const (
+	MaxTitleLength = 50
+	MaxStoryPoints = 100
+)
 
 var (
-	ErrTitleTooLong  = fmt.Errorf("title cannot be longer than %d characters", MaxTitleLength)
-	ErrDueDateInPast = errors.New("due date cannot be in the past")
-	ErrInvalidStatus = errors.New("invalid task status")
+	ErrTitleTooLong = newValidationError(
+		"title_too_long",
+		fmt.Sprintf("title cannot be longer than %d characters", MaxTitleLength),
+	)
+	ErrDueDateInPast   = newValidationError("due_date_in_past", "due date cannot be in the past")
+	ErrInvalidStatus   = newValidationError("invalid_status", "invalid task status")
+	ErrInvalidPriority = newValidationError(
+		"invalid_priority",
+		"priority must be one of p0, p1, p2, p3",
+	)
+	ErrInvalidStoryPoints = newValidationError(
+		"invalid_story_points",
+		fmt.Sprintf("story points must be between 0 and %d", MaxStoryPoints),
+	)
+	ErrTaskArchived       = newConflictError("task_archived", "task is archived, restore it first")
+	ErrTaskNotArchived    = newConflictError("task_not_archived", "task is not archived")
+	ErrDeleteWithSubtasks = newConflictError(
+		"delete_with_subtasks",
+		"task has subtasks, delete or remove them first",
+	)
+	ErrTaskAlreadyExists = newConflictError(
+		"task_already_exists",
+		"a task with this ID already exists",
+	)
+	ErrTaskNotFound = newNotFoundError("task_not_found", "task not found")
 )
 
-var validTaskStatuses = map[TaskStatus]bool{
-	TaskStatusPending:    true,
-	TaskStatusInProgress: true,
-	TaskStatusInReview:   true,
-	TaskStatusCompleted:  true,
-}
-
 func NewTask(
 	id TaskID,
+	boardID BoardID,
 	title string,
 	description *string,
 	dueDate *time.Time,
-	assigneeName *string,
+	assignees []Member,
+	priority *TaskPriority,
+	storyPoints *int,
 ) (*Task, error) {
 	if len(title) > 50 {
 		return nil, ErrTitleTooLong
@@ -80,19 +167,102 @@ func NewTask(
 		return nil, ErrDueDateInPast
 	}
 
+	if err := validateMembers(assignees, boardID, ErrUnknownAssignee); err != nil {
+		return nil, err
+	}
+
+	if err := validatePriority(priority); err != nil {
+		return nil, err
+	}
+
+	if err := validateStoryPoints(storyPoints); err != nil {
+		return nil, err
+	}
+
+	return newTask(
+		id,
+		boardID,
+		title,
+		description,
+		dueDate,
+		assignees,
+		priority,
+		storyPoints,
+	), nil
+}
+
+// newTask builds a task without validating it, tasks loaded from the db were validated when
+// they were created and may no longer pass, like a due date that has since passed
+func newTask(
+	id TaskID,
+	boardID BoardID,
+	title string,
+	description *string,
+	dueDate *time.Time,
+	assignees []Member,
+	priority *TaskPriority,
+	storyPoints *int,
+) *Task {
 	now := time.Now()
 	task := &Task{
-		id:           id,
-		createdAt:    now,
-		updatedAt:    now,
-		dueDate:      dueDate,
-		assigneeName: assigneeName,
-		title:        title,
-		description:  description,
-		status:       TaskStatusPending,
-		files:        make([]File, 0),
+		id:            id,
+		boardID:       boardID,
+		createdAt:     now,
+		updatedAt:     now,
+		dueDate:       dueDate,
+		assignees:     uniqueMembers(assignees),
+		watchers:      make([]Member, 0),
+		title:         title,
+		description:   description,
+		status:        TaskStatusPending,
+		rank:          lo.Must(RankBetween("", "")),
+		priority:      priority,
+		storyPoints:   storyPoints,
+		files:         make([]File, 0),
+		children:      make([]subtask, 0),
+		blockedBy:     make([]dependency, 0),
+		blocks:        make([]dependency, 0),
+		labels:        make([]Label, 0),
+		checklist:     make([]ChecklistItem, 0),
+		customFields:  make([]CustomFieldValue, 0),
+		statusChanges: make([]StatusChange, 0),
+		version:       1,
+	}
+	return task
+}
+
+func validatePriority(priority *TaskPriority) error {
+	if priority != nil && !slices.Contains(validTaskPriorities, *priority) {
+		return fmt.Errorf("%w: %q", ErrInvalidPriority, *priority)
+	}
+	return nil
+}
+
+func validateStoryPoints(storyPoints *int) error {
+	if storyPoints != nil && (*storyPoints < 0 || *storyPoints > MaxStoryPoints) {
+		return ErrInvalidStoryPoints
+	}
+	return nil
+}
+
+// firstAssigneeName is what clients from before multiple assignees show as the assignee
+func (t *Task) firstAssigneeName() *string {
+	if len(t.assignees) == 0 {
+		return nil
+	}
+	return &t.assignees[0].displayName
+}
+
+func (t *Task) BoardID() BoardID {
+	return t.boardID
+}
+
+// BelongsTo returns an error if the task isn't part of the board
+func (t *Task) BelongsTo(boardID BoardID) error {
+	if t.boardID != boardID {
+		return fmt.Errorf("%w: task %s, board %s", ErrTaskNotOnBoard, t.id, boardID)
 	}
-	return task, nil
+	return nil
 }
 
 func (t *Task) AddFile(file File) {
@@ -105,12 +275,31 @@ func (t *Task) Files() []File {
 }
 
 func (t *Task) Edit(
+	workflow Workflow,
 	title *string,
 	description *string,
 	dueDate *time.Time,
-	assigneeName *string,
+	assignees []Member,
 	status *TaskStatus,
+	priority *TaskPriority,
+	storyPoints *int,
 ) error {
+	if t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskArchived, t.id)
+	}
+
+	if err := validateMembers(assignees, t.boardID, ErrUnknownAssignee); err != nil {
+		return err
+	}
+
+	if err := validatePriority(priority); err != nil {
+		return err
+	}
+
+	if err := validateStoryPoints(storyPoints); err != nil {
+		return err
+	}
+
 	if title != nil {
 		if len(*title) > MaxTitleLength {
 			return ErrTitleTooLong
@@ -129,12 +318,21 @@ func (t *Task) Edit(
 		t.description = description
 	}
 
-	if assigneeName != nil {
-		t.assigneeName = assigneeName
+	// An empty list unassigns everyone
+	if assignees != nil {
+		t.setAssignees(assignees)
+	}
+
+	if priority != nil {
+		t.priority = priority
+	}
+
+	if storyPoints != nil {
+		t.storyPoints = storyPoints
 	}
 
 	if status != nil {
-		if err := t.ChangeStatus(*status); err != nil {
+		if err := t.ChangeStatus(workflow, *status); err != nil {
 			return err
 		}
 	}
@@ -143,46 +341,180 @@ func (t *Task) Edit(
 	return nil
 }
 
-func (t *Task) ChangeStatus(status TaskStatus) error {
-	if !validTaskStatuses[status] {
-		return fmt.Errorf("%w: %s", ErrInvalidStatus, status)
+// ChangeStatus moves the task to another column, the move has to be allowed by the board's workflow
+func (t *Task) ChangeStatus(workflow Workflow, status TaskStatus) error {
+	if t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskArchived, t.id)
 	}
 
+	if err := workflow.CanTransition(t.status, status); err != nil {
+		return err
+	}
+
+	if status == TaskStatusCompleted && t.hasOpenSubtasks() {
+		return ErrOpenSubtasks
+	}
+
+	if status != TaskStatusCompleted && t.parentStatus != nil && *t.parentStatus == TaskStatusCompleted {
+		return fmt.Errorf("%w: %s", ErrParentCompleted, *t.parentID)
+	}
+
+	if status == TaskStatusInProgress && t.status != TaskStatusInProgress && t.IsBlocked() {
+		return fmt.Errorf("%w: %s", ErrBlocked, joinTaskIDs(t.openBlockerIDs(), ", "))
+	}
+
+	now := time.Now()
 	if status == TaskStatusCompleted {
-		t.completedAt = lo.ToPtr(time.Now())
+		t.completedAt = &now
 	} else {
 		t.completedAt = nil
 	}
+	t.recordStatusChange(status, now)
 	t.status = status
+	t.updatedAt = now
+
+	// An override only holds in the column the task was forced into
+	if t.wipOverride != nil && t.wipOverride.Status != status {
+		t.wipOverride = nil
+	}
+	return nil
+}
+
+func (t *Task) IsArchived() bool {
+	return t.archivedAt != nil
+}
+
+// Archive hides the task from the board, it can be restored later
+func (t *Task) Archive() error {
+	if t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskArchived, t.id)
+	}
+
+	now := time.Now()
+	t.archivedAt = &now
+	t.updatedAt = now
+	return nil
+}
+
+// Restore puts an archived task back on the board
+func (t *Task) Restore() error {
+	if !t.IsArchived() {
+		return fmt.Errorf("%w: %s", ErrTaskNotArchived, t.id)
+	}
+
+	t.archivedAt = nil
 	t.updatedAt = time.Now()
 	return nil
 }
 
+// CloneDueDate is the due date a clone of the task starts with,
+// the clone of an overdue task isn't due since it can't be created with a past due date
+func (t *Task) CloneDueDate(now time.Time) *time.Time {
+	if t.dueDate == nil || t.dueDate.Before(now) {
+		return nil
+	}
+	dueDate := *t.dueDate
+	return &dueDate
+}
+
+// CanDelete returns an error if deleting the task for good would leave its subtasks without a parent
+func (t *Task) CanDelete() error {
+	if len(t.children) > 0 {
+		return fmt.Errorf("%w: %s", ErrDeleteWithSubtasks, joinTaskIDs(t.childIDs(), ", "))
+	}
+	return nil
+}
+
 type TaskSnapshot struct {
-	ID          TaskID     `json:"id"`
-	Title       string     `json:"title"`
-	Description *string    `json:"description"`
-	DueDate     *time.Time `json:"due_date"`
+	ID       TaskID   `json:"id"`
+	BoardID  BoardID  `json:"board_id"`
+	ParentID *TaskID  `json:"parent_id"`
+	ChildIDs []TaskID `json:"child_ids"`
+	// Null while the task is in the backlog
+	SprintID *SprintID `json:"sprint_id"`
+	// Null unless the task is part of an epic
+	EpicID *EpicID `json:"epic_id"`
+	// Ratio of completed subtasks, null for tasks without subtasks
+	Progress  *float64 `json:"progress"`
+	BlockedBy []TaskID `json:"blocked_by"`
+	Blocks    []TaskID `json:"blocks"`
+	// True while any of the tasks in blocked_by isn't completed
+	Blocked     bool             `json:"blocked"`
+	Labels      []LabelSnapshot  `json:"labels"`
+	Title       string           `json:"title"`
+	Description *string          `json:"description"`
+	DueDate     *time.Time       `json:"due_date"`
+	Assignees   []MemberSnapshot `json:"assignees"`
+	// Watchers are notified about the task without being assigned to it
+	Watchers []MemberSnapshot `json:"watchers"`
+	// Deprecated: the first assignee's display name, use assignees
 	Assignee    *string    `json:"assignee"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 	CompletedAt *time.Time `json:"completed_at"`
-	Status      TaskStatus `json:"status"`
-	Files       []File     `json:"files"`
+	// Null unless the task is archived
+	ArchivedAt *time.Time `json:"archived_at"`
+	Status     TaskStatus `json:"status"`
+	// Tasks of the same status are shown sorted by rank
+	Rank Rank `json:"rank"`
+	// From p0 (most urgent) to p3, null when not prioritized
+	Priority *TaskPriority `json:"priority"`
+	// Null when not estimated
+	StoryPoints *int `json:"story_points"`
+	// Set when the task was forced into its status past the column's WIP limit
+	WIPOverride *WIPOverride            `json:"wip_override"`
+	Files       []File                  `json:"files"`
+	Checklist   []ChecklistItemSnapshot `json:"checklist"`
+	// Percentage of the checklist's items that are done, null for tasks without a checklist
+	ChecklistProgress *float64 `json:"checklist_progress"`
+	// Only the custom fields the task has a value for
+	CustomFields []CustomFieldValueSnapshot `json:"custom_fields"`
+	// Sum of the time logged on the task
+	TimeSpentMinutes int `json:"time_spent_minutes"`
+	// Moves between columns, oldest first
+	StatusChanges []StatusChangeSnapshot `json:"status_changes"`
+	// Seconds spent in each status since the task was created, including the current one so far
+	TimeInStatusSeconds map[TaskStatus]int `json:"time_in_status_seconds"`
+	// Bumped every time the task is saved, an edit made from an older version is rejected
+	Version int `json:"version"`
 }
 
 // Used by the db adapters
 func (t *Task) GetSnapshot() *TaskSnapshot {
 	return &TaskSnapshot{
-		ID:          t.id,
-		Title:       t.title,
-		Description: t.description,
-		DueDate:     t.dueDate,
-		Assignee:    t.assigneeName,
-		CreatedAt:   t.createdAt,
-		UpdatedAt:   t.updatedAt,
-		CompletedAt: t.completedAt,
-		Status:      t.status,
-		Files:       t.files,
+		ID:                  t.id,
+		BoardID:             t.boardID,
+		ParentID:            t.parentID,
+		ChildIDs:            t.childIDs(),
+		SprintID:            t.sprintID,
+		EpicID:              t.epicID,
+		Progress:            t.progress(),
+		BlockedBy:           t.blockerIDs(),
+		Blocks:              dependencyIDs(t.blocks),
+		Blocked:             t.IsBlocked(),
+		Labels:              t.labelSnapshots(),
+		Title:               t.title,
+		Description:         t.description,
+		DueDate:             t.dueDate,
+		Assignees:           memberSnapshots(t.assignees),
+		Watchers:            memberSnapshots(t.watchers),
+		Assignee:            t.firstAssigneeName(),
+		CreatedAt:           t.createdAt,
+		UpdatedAt:           t.updatedAt,
+		CompletedAt:         t.completedAt,
+		ArchivedAt:          t.archivedAt,
+		Status:              t.status,
+		Rank:                t.rank,
+		Priority:            t.priority,
+		StoryPoints:         t.storyPoints,
+		WIPOverride:         t.wipOverride,
+		Files:               t.files,
+		Checklist:           checklistSnapshots(t.checklist),
+		ChecklistProgress:   t.checklistProgress(),
+		CustomFields:        customFieldValueSnapshots(t.customFields),
+		TimeSpentMinutes:    int(t.timeSpent / time.Minute),
+		StatusChanges:       statusChangeSnapshots(t.statusChanges),
+		TimeInStatusSeconds: t.timeInStatusSeconds(),
+		Version:             t.version,
 	}
 }
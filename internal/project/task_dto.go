@@ -1,22 +1,97 @@
 package project
 
 import (
+	"slices"
+	"time"
+
 	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/samber/lo"
 )
 
 // Out DTOs - for output adapters: e.g. postgres
 // Adapters use GetSnapshot
 
-func UnmarshalTaskFromDB(t *ent.Task) (*Task, error) {
-	task, err := NewTask(TaskID(t.ID), t.Title, t.Description, t.DueDate, t.AssigneeName)
-	if err != nil {
-		return nil, err
+func UnmarshalTaskFromDB(t *ent.Task) *Task {
+	// Tasks created before boards existed don't have a board yet
+	boardID := DefaultBoardID
+	if t.BoardID != nil {
+		boardID = BoardID(*t.BoardID)
 	}
 
+	var priority *TaskPriority
+	if t.Priority != nil {
+		priority = lo.ToPtr(TaskPriority(*t.Priority))
+	}
+
+	task := newTask(
+		TaskID(t.ID),
+		boardID,
+		t.Title,
+		t.Description,
+		t.DueDate,
+		nil,
+		priority,
+		t.StoryPoints,
+	)
+
 	task.createdAt = t.CreatedAt
 	task.completedAt = t.CompletedAt
+	task.archivedAt = t.ArchivedAt
 	task.updatedAt = t.UpdatedAt
 	task.status = TaskStatus(t.Status)
+	task.rank = Rank(t.Rank)
+	task.version = t.Version
+
+	if t.WipOverrideBy != nil && t.WipOverrideStatus != nil && t.WipOverrideAt != nil {
+		task.wipOverride = &WIPOverride{
+			ForcedBy: *t.WipOverrideBy,
+			Status:   TaskStatus(*t.WipOverrideStatus),
+			ForcedAt: *t.WipOverrideAt,
+		}
+	}
+
+	if t.ParentID != nil {
+		task.parentID = lo.ToPtr(TaskID(*t.ParentID))
+	}
+
+	if t.Edges.Parent != nil {
+		task.parentStatus = lo.ToPtr(TaskStatus(t.Edges.Parent.Status))
+	}
+
+	if t.SprintID != nil {
+		task.sprintID = lo.ToPtr(SprintID(*t.SprintID))
+	}
+
+	if t.EpicID != nil {
+		task.epicID = lo.ToPtr(EpicID(*t.EpicID))
+	}
+
+	task.assignees = unmarshalMembersFromDB(t.Edges.Assignees)
+	task.watchers = unmarshalMembersFromDB(t.Edges.Watchers)
+
+	children := make([]subtask, len(t.Edges.Children))
+	for i, child := range t.Edges.Children {
+		children[i] = subtask{id: TaskID(child.ID), status: TaskStatus(child.Status)}
+	}
+	task.children = children
+
+	blockedBy := make([]dependency, len(t.Edges.BlockedBy))
+	for i, blocker := range t.Edges.BlockedBy {
+		blockedBy[i] = dependency{id: TaskID(blocker.ID), status: TaskStatus(blocker.Status)}
+	}
+	task.blockedBy = blockedBy
+
+	blocks := make([]dependency, len(t.Edges.Blocks))
+	for i, blocked := range t.Edges.Blocks {
+		blocks[i] = dependency{id: TaskID(blocked.ID), status: TaskStatus(blocked.Status)}
+	}
+	task.blocks = blocks
+
+	labels := make([]Label, len(t.Edges.Labels))
+	for i, l := range t.Edges.Labels {
+		labels[i] = *UnmarshalLabelFromDB(l)
+	}
+	task.labels = labels
 
 	files := make([]File, len(t.Edges.Files))
 	for i, f := range t.Edges.Files {
@@ -24,7 +99,72 @@ func UnmarshalTaskFromDB(t *ent.Task) (*Task, error) {
 	}
 	task.files = files
 
-	return task, nil
+	items := slices.SortedFunc(
+		slices.Values(t.Edges.ChecklistItems),
+		func(a, b *ent.ChecklistItem) int { return a.Position - b.Position },
+	)
+	checklist := make([]ChecklistItem, len(items))
+	for i, item := range items {
+		checklist[i] = ChecklistItem{
+			id:   ChecklistItemID(item.ID),
+			text: item.Text,
+			done: item.Done,
+		}
+	}
+	task.checklist = checklist
+
+	customFields := make([]CustomFieldValue, 0, len(t.Edges.CustomFieldValues))
+	for _, v := range t.Edges.CustomFieldValues {
+		if v.Edges.CustomField == nil {
+			continue
+		}
+		customFields = append(customFields, CustomFieldValue{
+			field: *UnmarshalCustomFieldFromDB(v.Edges.CustomField),
+			value: v.Value,
+		})
+	}
+	sortCustomFieldValues(customFields)
+	task.customFields = customFields
+
+	for _, w := range t.Edges.Worklogs {
+		task.timeSpent += time.Duration(w.DurationMinutes) * time.Minute
+	}
+
+	changes := slices.SortedFunc(
+		slices.Values(t.Edges.StatusChanges),
+		func(a, b *ent.StatusChange) int { return a.ChangedAt.Compare(b.ChangedAt) },
+	)
+	statusChanges := make([]StatusChange, len(changes))
+	for i, c := range changes {
+		statusChanges[i] = StatusChange{
+			from:      TaskStatus(c.FromStatus),
+			to:        TaskStatus(c.ToStatus),
+			changedAt: c.ChangedAt,
+		}
+	}
+	task.statusChanges = statusChanges
+
+	return task
+}
+
+func UnmarshalWorklogFromDB(w *ent.Worklog) *Worklog {
+	return &Worklog{
+		id:        WorklogID(w.ID),
+		taskID:    TaskID(w.TaskID),
+		memberID:  MemberID(w.MemberID),
+		duration:  time.Duration(w.DurationMinutes) * time.Minute,
+		date:      Day(w.Date.UTC()),
+		note:      w.Note,
+		createdAt: w.CreatedAt,
+	}
+}
+
+func UnmarshalTimerFromDB(t *ent.Timer) *Timer {
+	return &Timer{
+		taskID:    TaskID(t.TaskID),
+		memberID:  MemberID(t.MemberID),
+		startedAt: t.StartedAt,
+	}
 }
 
 func UnmarshalFileFromDB(f *ent.File) File {
@@ -36,3 +176,223 @@ func UnmarshalFileFromDB(f *ent.File) File {
 		uploadedAt: f.UploadedAt,
 	}
 }
+
+func UnmarshalLabelFromDB(l *ent.Label) *Label {
+	return &Label{
+		id:        LabelID(l.ID),
+		boardID:   BoardID(l.BoardID),
+		name:      l.Name,
+		color:     l.Color,
+		createdAt: l.CreatedAt,
+	}
+}
+
+func UnmarshalCustomFieldFromDB(f *ent.CustomField) *CustomField {
+	options := f.Options
+	if options == nil {
+		options = []string{}
+	}
+
+	return &CustomField{
+		id:        CustomFieldID(f.ID),
+		boardID:   BoardID(f.BoardID),
+		name:      f.Name,
+		fieldType: CustomFieldType(f.Type),
+		options:   options,
+		createdAt: f.CreatedAt,
+	}
+}
+
+func UnmarshalMemberFromDB(m *ent.Member) *Member {
+	return &Member{
+		id:          MemberID(m.ID),
+		boardID:     BoardID(m.BoardID),
+		displayName: m.DisplayName,
+		email:       m.Email,
+		role:        MemberRole(m.Role),
+		createdAt:   m.CreatedAt,
+	}
+}
+
+func unmarshalMembersFromDB(entMembers []*ent.Member) []Member {
+	members := make([]Member, len(entMembers))
+	for i, m := range entMembers {
+		members[i] = *UnmarshalMemberFromDB(m)
+	}
+	return members
+}
+
+func UnmarshalSprintFromDB(s *ent.Sprint) (*Sprint, error) {
+	sprint, err := NewSprint(
+		SprintID(s.ID),
+		BoardID(s.BoardID),
+		s.Name,
+		s.Goal,
+		s.StartDate,
+		s.EndDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sprint.status = SprintStatus(s.Status)
+	sprint.createdAt = s.CreatedAt
+	sprint.startedAt = s.StartedAt
+	sprint.closedAt = s.ClosedAt
+
+	return sprint, nil
+}
+
+func UnmarshalEpicFromDB(e *ent.Epic) (*Epic, error) {
+	epic, err := NewEpic(
+		EpicID(e.ID),
+		BoardID(e.BoardID),
+		e.Name,
+		e.Description,
+		e.TargetDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	epic.createdAt = e.CreatedAt
+
+	return epic, nil
+}
+
+func UnmarshalCommentFromDB(c *ent.Comment) *Comment {
+	var parentID *CommentID
+	if c.ParentID != nil {
+		parentID = lo.ToPtr(CommentID(*c.ParentID))
+	}
+
+	mentions := c.Mentions
+	if mentions == nil {
+		mentions = []string{}
+	}
+
+	return &Comment{
+		id:        CommentID(c.ID),
+		taskID:    TaskID(c.TaskID),
+		parentID:  parentID,
+		author:    c.Author,
+		body:      c.Body,
+		mentions:  mentions,
+		createdAt: c.CreatedAt,
+		updatedAt: c.UpdatedAt,
+		editedAt:  c.EditedAt,
+		deletedAt: c.DeletedAt,
+	}
+}
+
+func UnmarshalActivityFromDB(a *ent.Activity) Activity {
+	return Activity{
+		id:        ActivityID(a.ID),
+		taskID:    TaskID(a.TaskID),
+		actor:     Actor{Name: a.Actor, Source: ChangeSource(a.Source)},
+		field:     TaskField(a.Field),
+		from:      a.FromValue,
+		to:        a.ToValue,
+		changedAt: a.ChangedAt,
+	}
+}
+
+func UnmarshalBoardFromDB(b *ent.Board) (*Board, error) {
+	board, err := NewBoard(BoardID(b.ID), b.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Boards created before workflows existed use the default one
+	if len(b.Statuses) > 0 {
+		statuses := make([]TaskStatus, len(b.Statuses))
+		for i, status := range b.Statuses {
+			statuses[i] = TaskStatus(status)
+		}
+
+		transitions := make(map[TaskStatus][]TaskStatus, len(b.Transitions))
+		for from, targets := range b.Transitions {
+			transitions[TaskStatus(from)] = make([]TaskStatus, len(targets))
+			for i, to := range targets {
+				transitions[TaskStatus(from)][i] = TaskStatus(to)
+			}
+		}
+
+		workflow, err := NewWorkflow(statuses, transitions)
+		if err != nil {
+			return nil, err
+		}
+		board.workflow = workflow
+	}
+
+	for status, limit := range b.WipLimits {
+		board.wipLimits[TaskStatus(status)] = WIPLimit{Board: limit}
+	}
+	for status, limit := range b.AssigneeWipLimits {
+		wipLimit := board.wipLimits[TaskStatus(status)]
+		wipLimit.PerAssignee = limit
+		board.wipLimits[TaskStatus(status)] = wipLimit
+	}
+
+	board.createdAt = b.CreatedAt
+	board.updatedAt = b.UpdatedAt
+	board.archivedAt = b.ArchivedAt
+
+	return board, nil
+}
+
+func UnmarshalRecurringTaskFromDB(r *ent.RecurringTask) (*RecurringTask, error) {
+	rule, err := ParseRecurrenceRule(r.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	var priority *TaskPriority
+	if r.Priority != nil {
+		priority = lo.ToPtr(TaskPriority(*r.Priority))
+	}
+
+	utc := func(t *time.Time) *time.Time {
+		if t == nil {
+			return nil
+		}
+		return lo.ToPtr(t.UTC())
+	}
+
+	return &RecurringTask{
+		id:          RecurringTaskID(r.ID),
+		boardID:     BoardID(r.BoardID),
+		title:       r.Title,
+		description: r.Description,
+		assigneeIDs: lo.Map(r.AssigneeIds, func(id string, _ int) MemberID { return MemberID(id) }),
+		labelIDs:    lo.Map(r.LabelIds, func(id string, _ int) LabelID { return LabelID(id) }),
+		priority:    priority,
+		storyPoints: r.StoryPoints,
+		rule:        rule,
+		startsAt:    r.StartsAt.UTC(),
+		nextAt:      utc(r.NextAt),
+		lastAt:      utc(r.LastAt),
+		createdAt:   r.CreatedAt,
+		updatedAt:   r.UpdatedAt,
+	}, nil
+}
+
+func UnmarshalTaskTemplateFromDB(t *ent.TaskTemplate) *TaskTemplate {
+	files := make([]File, len(t.Edges.Files))
+	for i, f := range t.Edges.Files {
+		files[i] = UnmarshalFileFromDB(f)
+	}
+
+	return &TaskTemplate{
+		id:                TaskTemplateID(t.ID),
+		boardID:           BoardID(t.BoardID),
+		title:             t.Title,
+		description:       t.Description,
+		labelIDs:          lo.Map(t.LabelIds, func(id string, _ int) LabelID { return LabelID(id) }),
+		defaultAssigneeID: (*MemberID)(t.DefaultAssigneeID),
+		subtasks:          lo.Ternary(t.Subtasks == nil, []string{}, t.Subtasks),
+		files:             files,
+		createdAt:         t.CreatedAt,
+		updatedAt:         t.UpdatedAt,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/DeluxeOwl/cogniboard/internal/postgres/ent"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -14,15 +16,16 @@ func TestNewTask(t *testing.T) {
 		title := "Test Task"
 		description := "Test Description"
 		dueDate := time.Now().Add(24 * time.Hour)
-		assigneeName := "John Doe"
+		assignee := createValidMember(t, "member-1", DefaultBoardID, "John Doe", MemberRoleMember)
 
-		task, err := NewTask(id, title, &description, &dueDate, &assigneeName)
+		task, err := NewTask(id, DefaultBoardID, title, &description, &dueDate, []Member{*assignee}, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, id, task.id)
 		assert.Equal(t, title, task.title)
 		assert.Equal(t, &description, task.description)
 		assert.Equal(t, &dueDate, task.dueDate)
-		assert.Equal(t, &assigneeName, task.assigneeName)
+		assert.Equal(t, []Member{*assignee}, task.assignees)
+		assert.Equal(t, lo.ToPtr("John Doe"), task.GetSnapshot().Assignee)
 		assert.Equal(t, TaskStatusPending, task.status)
 	})
 
@@ -31,9 +34,7 @@ func TestNewTask(t *testing.T) {
 		title := "This is a very long title that exceeds the maximum length allowed"
 		description := "Test Description"
 		dueDate := time.Now().Add(24 * time.Hour)
-		assigneeName := "John Doe"
-
-		task, err := NewTask(id, title, &description, &dueDate, &assigneeName)
+		task, err := NewTask(id, DefaultBoardID, title, &description, &dueDate, nil, nil, nil)
 		assert.ErrorIs(t, err, ErrTitleTooLong)
 		assert.Nil(t, task)
 	})
@@ -43,18 +44,42 @@ func TestNewTask(t *testing.T) {
 		title := "Test Task"
 		description := "Test Description"
 		dueDate := time.Now().Add(-24 * time.Hour)
-		assigneeName := "John Doe"
-
-		task, err := NewTask(id, title, &description, &dueDate, &assigneeName)
+		task, err := NewTask(id, DefaultBoardID, title, &description, &dueDate, nil, nil, nil)
 		assert.ErrorIs(t, err, ErrDueDateInPast)
 		assert.Nil(t, task)
 	})
+
+	t.Run("priority and story points", func(t *testing.T) {
+		priority := TaskPriorityP1
+		storyPoints := 5
+
+		task, err := NewTask("123", DefaultBoardID, "Test Task", nil, nil, nil, &priority, &storyPoints)
+		require.NoError(t, err)
+		assert.Equal(t, &priority, task.GetSnapshot().Priority)
+		assert.Equal(t, &storyPoints, task.GetSnapshot().StoryPoints)
+	})
+
+	t.Run("invalid priority", func(t *testing.T) {
+		priority := TaskPriority("p9")
+
+		task, err := NewTask("123", DefaultBoardID, "Test Task", nil, nil, nil, &priority, nil)
+		assert.ErrorIs(t, err, ErrInvalidPriority)
+		assert.Nil(t, task)
+	})
+
+	t.Run("invalid story points", func(t *testing.T) {
+		for _, storyPoints := range []int{-1, MaxStoryPoints + 1} {
+			task, err := NewTask("123", DefaultBoardID, "Test Task", nil, nil, nil, nil, &storyPoints)
+			assert.ErrorIs(t, err, ErrInvalidStoryPoints)
+			assert.Nil(t, task)
+		}
+	})
 }
 
 func TestTaskChangeStatus(t *testing.T) {
 	t.Run("valid status change", func(t *testing.T) {
 		task := createValidTask(t)
-		err := task.ChangeStatus(TaskStatusInProgress)
+		err := task.ChangeStatus(DefaultWorkflow(), TaskStatusInProgress)
 		require.NoError(t, err)
 		assert.Equal(t, TaskStatusInProgress, task.status)
 		assert.Nil(t, task.completedAt)
@@ -62,22 +87,36 @@ func TestTaskChangeStatus(t *testing.T) {
 
 	t.Run("invalid status", func(t *testing.T) {
 		task := createValidTask(t)
-		err := task.ChangeStatus("invalid")
+		err := task.ChangeStatus(DefaultWorkflow(), "invalid")
 		assert.ErrorIs(t, err, ErrInvalidStatus)
 	})
 
 	t.Run("completed status sets completedAt", func(t *testing.T) {
 		task := createValidTask(t)
-		err := task.ChangeStatus(TaskStatusCompleted)
+		err := task.ChangeStatus(DefaultWorkflow(), TaskStatusCompleted)
 		require.NoError(t, err)
 		assert.Equal(t, TaskStatusCompleted, task.status)
 		assert.NotNil(t, task.completedAt)
 	})
 
+	t.Run("transition not allowed by the workflow", func(t *testing.T) {
+		task := createValidTask(t)
+		workflow, err := NewWorkflow(
+			[]TaskStatus{TaskStatusPending, TaskStatusInProgress, TaskStatusCompleted},
+			map[TaskStatus][]TaskStatus{TaskStatusPending: {TaskStatusInProgress}},
+		)
+		require.NoError(t, err)
+
+		err = task.ChangeStatus(workflow, TaskStatusCompleted)
+		assert.ErrorIs(t, err, ErrTransitionNotAllowed)
+		assert.Equal(t, TaskStatusPending, task.status)
+		assert.Nil(t, task.completedAt)
+	})
+
 	t.Run("changing from completed clears completedAt", func(t *testing.T) {
 		task := createValidTask(t)
-		_ = task.ChangeStatus(TaskStatusCompleted)
-		err := task.ChangeStatus(TaskStatusInProgress)
+		_ = task.ChangeStatus(DefaultWorkflow(), TaskStatusCompleted)
+		err := task.ChangeStatus(DefaultWorkflow(), TaskStatusInProgress)
 		require.NoError(t, err)
 		assert.Equal(t, TaskStatusInProgress, task.status)
 		assert.Nil(t, task.completedAt)
@@ -90,16 +129,16 @@ func TestTaskEdit(t *testing.T) {
 		newTitle := "Updated Title"
 		newDesc := "Updated Description"
 		newDueDate := time.Now().Add(48 * time.Hour)
-		newAssignee := "Jane Doe"
+		newAssignee := createValidMember(t, "member-2", DefaultBoardID, "Jane Doe", MemberRoleMember)
 		newStatus := TaskStatusInProgress
 
-		err := task.Edit(&newTitle, &newDesc, &newDueDate, &newAssignee, &newStatus)
+		err := task.Edit(DefaultWorkflow(), &newTitle, &newDesc, &newDueDate, []Member{*newAssignee}, &newStatus, nil, nil)
 		require.NoError(t, err)
 
 		assert.Equal(t, newTitle, task.title)
 		assert.Equal(t, &newDesc, task.description)
 		assert.Equal(t, &newDueDate, task.dueDate)
-		assert.Equal(t, &newAssignee, task.assigneeName)
+		assert.Equal(t, []Member{*newAssignee}, task.assignees)
 		assert.Equal(t, newStatus, task.status)
 	})
 
@@ -107,40 +146,121 @@ func TestTaskEdit(t *testing.T) {
 		task := createValidTask(t)
 		originalDesc := task.description
 		originalDueDate := task.dueDate
-		originalAssignee := task.assigneeName
+		originalAssignees := task.assignees
 		originalStatus := task.status
 
 		newTitle := "Updated Title"
-		err := task.Edit(&newTitle, nil, nil, nil, nil)
+		err := task.Edit(DefaultWorkflow(), &newTitle, nil, nil, nil, nil, nil, nil)
 		require.NoError(t, err)
 
 		assert.Equal(t, newTitle, task.title)
 		assert.Equal(t, originalDesc, task.description)
 		assert.Equal(t, originalDueDate, task.dueDate)
-		assert.Equal(t, originalAssignee, task.assigneeName)
+		assert.Equal(t, originalAssignees, task.assignees)
 		assert.Equal(t, originalStatus, task.status)
 	})
 
 	t.Run("edit with invalid title", func(t *testing.T) {
 		task := createValidTask(t)
 		longTitle := "This is a very long title that exceeds the maximum length allowed"
-		err := task.Edit(&longTitle, nil, nil, nil, nil)
+		err := task.Edit(DefaultWorkflow(), &longTitle, nil, nil, nil, nil, nil, nil)
 		assert.ErrorIs(t, err, ErrTitleTooLong)
 	})
 
 	t.Run("edit with past due date", func(t *testing.T) {
 		task := createValidTask(t)
 		pastDate := time.Now().Add(-24 * time.Hour)
-		err := task.Edit(nil, nil, &pastDate, nil, nil)
+		err := task.Edit(DefaultWorkflow(), nil, nil, &pastDate, nil, nil, nil, nil)
 		assert.ErrorIs(t, err, ErrDueDateInPast)
 	})
 
 	t.Run("edit with invalid status", func(t *testing.T) {
 		task := createValidTask(t)
 		invalidStatus := TaskStatus("invalid")
-		err := task.Edit(nil, nil, nil, nil, &invalidStatus)
+		err := task.Edit(DefaultWorkflow(), nil, nil, nil, nil, &invalidStatus, nil, nil)
 		assert.ErrorIs(t, err, ErrInvalidStatus)
 	})
+
+	t.Run("edit priority and story points", func(t *testing.T) {
+		task := createValidTask(t)
+		priority := TaskPriorityP0
+		storyPoints := 8
+
+		err := task.Edit(DefaultWorkflow(), nil, nil, nil, nil, nil, &priority, &storyPoints)
+		require.NoError(t, err)
+		assert.Equal(t, &priority, task.priority)
+		assert.Equal(t, &storyPoints, task.storyPoints)
+	})
+
+	t.Run("edit with invalid priority and story points", func(t *testing.T) {
+		task := createValidTask(t)
+		priority := TaskPriority("urgent")
+		storyPoints := -3
+
+		err := task.Edit(DefaultWorkflow(), nil, nil, nil, nil, nil, &priority, nil)
+		assert.ErrorIs(t, err, ErrInvalidPriority)
+
+		err = task.Edit(DefaultWorkflow(), nil, nil, nil, nil, nil, nil, &storyPoints)
+		assert.ErrorIs(t, err, ErrInvalidStoryPoints)
+		assert.Nil(t, task.priority)
+		assert.Nil(t, task.storyPoints)
+	})
+}
+
+func TestTaskArchive(t *testing.T) {
+	t.Run("archive and restore", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.Archive())
+		assert.True(t, task.IsArchived())
+		assert.NotNil(t, task.GetSnapshot().ArchivedAt)
+		assert.ErrorIs(t, task.Archive(), ErrTaskArchived)
+
+		require.NoError(t, task.Restore())
+		assert.False(t, task.IsArchived())
+		assert.ErrorIs(t, task.Restore(), ErrTaskNotArchived)
+	})
+
+	t.Run("archived tasks can't be changed", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.Archive())
+
+		err := task.Edit(DefaultWorkflow(), lo.ToPtr("New title"), nil, nil, nil, nil, nil, nil)
+		assert.ErrorIs(t, err, ErrTaskArchived)
+		assert.ErrorIs(t, task.ChangeStatus(DefaultWorkflow(), TaskStatusInProgress), ErrTaskArchived)
+		assert.Equal(t, "Test Task", task.GetSnapshot().Title)
+	})
+
+	t.Run("can't delete a task with subtasks", func(t *testing.T) {
+		parent := createValidTask(t)
+		require.NoError(t, parent.CanDelete())
+
+		child, err := NewTask("456", DefaultBoardID, "child", nil, nil, nil, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, parent.AddSubtask(child))
+		assert.ErrorIs(t, parent.CanDelete(), ErrDeleteWithSubtasks)
+		assert.NoError(t, child.CanDelete())
+	})
+}
+
+func TestTaskCloneDueDate(t *testing.T) {
+	now := time.Now()
+
+	t.Run("keeps a due date in the future", func(t *testing.T) {
+		task := createValidTask(t)
+		assert.Equal(t, task.dueDate, task.CloneDueDate(now))
+	})
+
+	t.Run("drops the due date of an overdue task", func(t *testing.T) {
+		yesterday := now.AddDate(0, 0, -1)
+		task := UnmarshalTaskFromDB(&ent.Task{ID: "1", Title: "overdue", DueDate: &yesterday})
+		assert.Nil(t, task.CloneDueDate(now))
+	})
+
+	t.Run("without a due date", func(t *testing.T) {
+		task, err := NewTask("1", DefaultBoardID, "no due date", nil, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, task.CloneDueDate(now))
+	})
 }
 
 func createValidTask(t *testing.T) *Task {
@@ -148,9 +268,9 @@ func createValidTask(t *testing.T) *Task {
 	title := "Test Task"
 	description := "Test Description"
 	dueDate := time.Now().Add(24 * time.Hour)
-	assigneeName := "John Doe"
+	assignee := createValidMember(t, "member-1", DefaultBoardID, "John Doe", MemberRoleMember)
 
-	task, err := NewTask(id, title, &description, &dueDate, &assigneeName)
+	task, err := NewTask(id, DefaultBoardID, title, &description, &dueDate, []Member{*assignee}, nil, nil)
 	require.NoError(t, err)
 	return task
 }
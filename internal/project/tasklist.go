@@ -0,0 +1,209 @@
+package project
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TaskFilter narrows down a list of tasks, its zero value keeps every task that isn't archived
+type TaskFilter struct {
+	BoardID *BoardID
+	// Tasks in any of these statuses
+	Statuses []TaskStatus
+	// IDs or display names of members, tasks assigned to any of them
+	Assignees []string
+	// Tasks due from one day to another, both included
+	DueFrom *time.Time
+	DueTo   *time.Time
+	// Searched in the title and the description, ignoring case
+	Text string
+	// IDs or names of labels, tasks that have all of them
+	Labels []string
+	// Values of custom fields keyed by field ID or name, tasks that have all of them
+	CustomFields map[string]string
+	// Tasks changed since then
+	UpdatedSince *time.Time
+}
+
+// CustomFieldFilterValues are the ways a value given to filter on could be stored,
+// numbers are stored normalized
+func CustomFieldFilterValues(value string) []string {
+	value = strings.TrimSpace(value)
+	values := []string{value}
+	if number, err := parseCustomFieldNumber(value); err == nil {
+		if normalized := strconv.FormatFloat(number, 'f', -1, 64); normalized != value {
+			values = append(values, normalized)
+		}
+	}
+	return values
+}
+
+type TaskSortField string
+
+const (
+	// By board, then by the position of the status in the board's workflow, then by rank,
+	// the order of the tasks on the board
+	TaskSortColumn      TaskSortField = "column"
+	TaskSortTitle       TaskSortField = "title"
+	TaskSortStatus      TaskSortField = "status"
+	TaskSortRank        TaskSortField = "rank"
+	TaskSortPriority    TaskSortField = "priority"
+	TaskSortStoryPoints TaskSortField = "story_points"
+	TaskSortDueDate     TaskSortField = "due_date"
+	TaskSortCreatedAt   TaskSortField = "created_at"
+	TaskSortUpdatedAt   TaskSortField = "updated_at"
+	TaskSortCompletedAt TaskSortField = "completed_at"
+)
+
+var TaskSortFields = []TaskSortField{
+	TaskSortColumn,
+	TaskSortTitle,
+	TaskSortStatus,
+	TaskSortRank,
+	TaskSortPriority,
+	TaskSortStoryPoints,
+	TaskSortDueDate,
+	TaskSortCreatedAt,
+	TaskSortUpdatedAt,
+	TaskSortCompletedAt,
+}
+
+// TaskSort orders a list of tasks on one of their columns, tasks without a value come last
+// and ties are broken by ID. Statuses are in the order of the board's workflow.
+type TaskSort struct {
+	Field      TaskSortField `json:"field"`
+	Descending bool          `json:"descending"`
+}
+
+const (
+	DefaultTaskPageSize = 50
+	MaxTaskPageSize     = 200
+)
+
+var (
+	ErrInvalidTaskSort = newValidationError(
+		"invalid_task_sort",
+		"tasks cannot be sorted on this field",
+	)
+	ErrInvalidTaskCursor = newValidationError(
+		"invalid_task_cursor",
+		"cursor is invalid or was made for another sort",
+	)
+	ErrInvalidPageSize = newValidationError(
+		"invalid_page_size",
+		fmt.Sprintf("page size must be between 1 and %d", MaxTaskPageSize),
+	)
+)
+
+// NewTaskSort sorts on the field, in the order of the board if it's empty
+func NewTaskSort(field string, descending bool) (TaskSort, error) {
+	if field == "" {
+		return TaskSort{Field: TaskSortColumn, Descending: descending}, nil
+	}
+
+	sortField := TaskSortField(strings.ToLower(field))
+	if !slices.Contains(TaskSortFields, sortField) {
+		return TaskSort{}, fmt.Errorf("%w: %s", ErrInvalidTaskSort, field)
+	}
+
+	return TaskSort{Field: sortField, Descending: descending}, nil
+}
+
+// PageSize checks the number of tasks asked for a page, 0 is the default page size
+func PageSize(limit int) (int, error) {
+	if limit == 0 {
+		return DefaultTaskPageSize, nil
+	}
+	if limit < 0 || limit > MaxTaskPageSize {
+		return 0, ErrInvalidPageSize
+	}
+	return limit, nil
+}
+
+// TaskCursor points right after a task in a sorted list of tasks, the next page starts there
+type TaskCursor struct {
+	Sort TaskSort `json:"sort"`
+	// The task's value for the sorted field, null when it has none
+	Value *string `json:"value"`
+	ID    TaskID  `json:"id"`
+	// Where the task was, sorts in the order of the workflow need them
+	BoardID BoardID    `json:"board_id"`
+	Status  TaskStatus `json:"status"`
+}
+
+// NewTaskCursor points right after the task
+func NewTaskCursor(sort TaskSort, after *Task) TaskCursor {
+	return TaskCursor{
+		Sort:    sort,
+		Value:   after.sortValue(sort.Field),
+		ID:      after.id,
+		BoardID: after.boardID,
+		Status:  after.status,
+	}
+}
+
+// Encode makes the cursor opaque to clients
+func (c TaskCursor) Encode() string {
+	marshaled, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(marshaled)
+}
+
+// DecodeTaskCursor reads a cursor made by Encode, it has to be used with the sort it was made for
+func DecodeTaskCursor(encoded string, sort TaskSort) (*TaskCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidTaskCursor
+	}
+
+	var cursor TaskCursor
+	if err := json.Unmarshal(decoded, &cursor); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidTaskCursor
+	}
+
+	if cursor.Sort != sort {
+		return nil, ErrInvalidTaskCursor
+	}
+
+	return &cursor, nil
+}
+
+// sortValue is the task's value for the field as it's kept in a cursor, times are in RFC 3339
+func (t *Task) sortValue(field TaskSortField) *string {
+	formatTime := func(t *time.Time) *string {
+		if t == nil {
+			return nil
+		}
+		formatted := t.UTC().Format(time.RFC3339Nano)
+		return &formatted
+	}
+
+	switch field {
+	case TaskSortTitle:
+		return &t.title
+	case TaskSortStatus:
+		return (*string)(&t.status)
+	case TaskSortRank, TaskSortColumn:
+		return (*string)(&t.rank)
+	case TaskSortPriority:
+		return (*string)(t.priority)
+	case TaskSortStoryPoints:
+		if t.storyPoints == nil {
+			return nil
+		}
+		points := strconv.Itoa(*t.storyPoints)
+		return &points
+	case TaskSortDueDate:
+		return formatTime(t.dueDate)
+	case TaskSortUpdatedAt:
+		return formatTime(&t.updatedAt)
+	case TaskSortCompletedAt:
+		return formatTime(t.completedAt)
+	default:
+		return formatTime(&t.createdAt)
+	}
+}
@@ -0,0 +1,89 @@
+package project
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTaskSort(t *testing.T) {
+	t.Run("defaults to the order of the board", func(t *testing.T) {
+		sort, err := NewTaskSort("", true)
+		require.NoError(t, err)
+		assert.Equal(t, TaskSort{Field: TaskSortColumn, Descending: true}, sort)
+	})
+
+	t.Run("ignores case", func(t *testing.T) {
+		sort, err := NewTaskSort("Due_Date", false)
+		require.NoError(t, err)
+		assert.Equal(t, TaskSortDueDate, sort.Field)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := NewTaskSort("assignee", false)
+		assert.ErrorIs(t, err, ErrInvalidTaskSort)
+	})
+}
+
+func TestPageSize(t *testing.T) {
+	size, err := PageSize(0)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTaskPageSize, size)
+
+	size, err = PageSize(10)
+	require.NoError(t, err)
+	assert.Equal(t, 10, size)
+
+	_, err = PageSize(-1)
+	assert.ErrorIs(t, err, ErrInvalidPageSize)
+
+	_, err = PageSize(MaxTaskPageSize + 1)
+	assert.ErrorIs(t, err, ErrInvalidPageSize)
+}
+
+func TestTaskCursor(t *testing.T) {
+	byDueDate := TaskSort{Field: TaskSortDueDate}
+
+	t.Run("round trip", func(t *testing.T) {
+		task := createValidTask(t)
+		cursor := NewTaskCursor(byDueDate, task)
+
+		decoded, err := DecodeTaskCursor(cursor.Encode(), byDueDate)
+		require.NoError(t, err)
+		assert.Equal(t, cursor, *decoded)
+		assert.Equal(t, task.dueDate.UTC().Format(time.RFC3339Nano), *decoded.Value)
+	})
+
+	t.Run("task without a value", func(t *testing.T) {
+		task := createValidTask(t)
+		task.dueDate = nil
+		assert.Nil(t, NewTaskCursor(byDueDate, task).Value)
+	})
+
+	t.Run("story points", func(t *testing.T) {
+		task := createValidTask(t)
+		task.storyPoints = lo.ToPtr(5)
+		cursor := NewTaskCursor(TaskSort{Field: TaskSortStoryPoints}, task)
+		assert.Equal(t, "5", *cursor.Value)
+	})
+
+	t.Run("made for another sort", func(t *testing.T) {
+		cursor := NewTaskCursor(byDueDate, createValidTask(t)).Encode()
+		_, err := DecodeTaskCursor(cursor, TaskSort{Field: TaskSortDueDate, Descending: true})
+		assert.ErrorIs(t, err, ErrInvalidTaskCursor)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		_, err := DecodeTaskCursor("not a cursor", byDueDate)
+		assert.ErrorIs(t, err, ErrInvalidTaskCursor)
+	})
+}
+
+func TestCustomFieldFilterValues(t *testing.T) {
+	assert.Equal(t, []string{"High"}, CustomFieldFilterValues(" High "))
+	assert.Equal(t, []string{"3"}, CustomFieldFilterValues("3"))
+	assert.Equal(t, []string{"3.50", "3.5"}, CustomFieldFilterValues("3.50"))
+}
@@ -0,0 +1,178 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TaskTemplateRepository interface {
+	Create(ctx context.Context, template *TaskTemplate) error
+	GetByID(ctx context.Context, id TaskTemplateID) (*TaskTemplate, error)
+	// Delete removes the template with its files
+	Delete(ctx context.Context, id TaskTemplateID) error
+	BoardTemplates(ctx context.Context, boardID BoardID) ([]TaskTemplate, error)
+}
+
+type TaskTemplateID string
+
+// TaskTemplate is a task that's created over and over, like the "release checklist" of every sprint.
+// Tasks created from it get its title, description, labels and default assignee,
+// a subtask for each of its subtask titles and a copy of its files.
+type TaskTemplate struct {
+	id                TaskTemplateID
+	boardID           BoardID
+	title             string
+	description       *string
+	labelIDs          []LabelID
+	defaultAssigneeID *MemberID
+	// Titles of the subtasks created with each task
+	subtasks  []string
+	files     []File
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewTaskTemplateID() (TaskTemplateID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return TaskTemplateID(id.String()), nil
+}
+
+const MaxTemplateSubtasks = 50
+
+var (
+	ErrTemplateTitleEmpty = newValidationError(
+		"template_title_empty",
+		"template title cannot be empty",
+	)
+	ErrTemplateSubtaskEmpty = newValidationError(
+		"template_subtask_empty",
+		"template subtask title cannot be empty",
+	)
+	ErrTooManyTemplateSubtasks = newValidationError("too_many_template_subtasks", fmt.Sprintf(
+		"template cannot have more than %d subtasks",
+		MaxTemplateSubtasks,
+	))
+	ErrTemplateNotFound = newNotFoundError("template_not_found", "template not found")
+)
+
+func NewTaskTemplate(
+	id TaskTemplateID,
+	boardID BoardID,
+	title string,
+	description *string,
+	labels []Label,
+	defaultAssignee *Member,
+	subtasks []string,
+) (*TaskTemplate, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, ErrTemplateTitleEmpty
+	}
+
+	if len(title) > MaxTitleLength {
+		return nil, ErrTitleTooLong
+	}
+
+	labelIDs, err := labelIDsOnBoard(labels, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaultAssigneeID *MemberID
+	if defaultAssignee != nil {
+		if err := validateMembers([]Member{*defaultAssignee}, boardID, ErrUnknownAssignee); err != nil {
+			return nil, err
+		}
+		defaultAssigneeID = &defaultAssignee.id
+	}
+
+	if len(subtasks) > MaxTemplateSubtasks {
+		return nil, ErrTooManyTemplateSubtasks
+	}
+
+	subtaskTitles := make([]string, len(subtasks))
+	for i, subtask := range subtasks {
+		subtask = strings.TrimSpace(subtask)
+		if subtask == "" {
+			return nil, ErrTemplateSubtaskEmpty
+		}
+		if len(subtask) > MaxTitleLength {
+			return nil, fmt.Errorf("%w: %s", ErrTitleTooLong, subtask)
+		}
+		subtaskTitles[i] = subtask
+	}
+
+	now := time.Now()
+	return &TaskTemplate{
+		id:                id,
+		boardID:           boardID,
+		title:             title,
+		description:       description,
+		labelIDs:          labelIDs,
+		defaultAssigneeID: defaultAssigneeID,
+		subtasks:          subtaskTitles,
+		files:             make([]File, 0),
+		createdAt:         now,
+		updatedAt:         now,
+	}, nil
+}
+
+func (t *TaskTemplate) ID() TaskTemplateID {
+	return t.id
+}
+
+func (t *TaskTemplate) BoardID() BoardID {
+	return t.boardID
+}
+
+// FilesOwner is the ID the template's files are stored under in the FileStorage,
+// template and task IDs are both UUIDs so they can't collide
+func (t *TaskTemplate) FilesOwner() TaskID {
+	return TaskID(t.id)
+}
+
+func (t *TaskTemplate) AddFile(file File) {
+	t.files = append(t.files, file)
+	t.updatedAt = time.Now()
+}
+
+func (t *TaskTemplate) Files() []File {
+	return t.files
+}
+
+type TaskTemplateSnapshot struct {
+	ID                TaskTemplateID `json:"id"`
+	BoardID           BoardID        `json:"board_id"`
+	Title             string         `json:"title"`
+	Description       *string        `json:"description"`
+	LabelIDs          []LabelID      `json:"label_ids"`
+	DefaultAssigneeID *MemberID      `json:"default_assignee_id"`
+	// Titles of the subtasks created with each task
+	Subtasks  []string  `json:"subtasks"`
+	Files     []File    `json:"files"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Used by the db adapters
+func (t *TaskTemplate) GetSnapshot() *TaskTemplateSnapshot {
+	return &TaskTemplateSnapshot{
+		ID:                t.id,
+		BoardID:           t.boardID,
+		Title:             t.title,
+		Description:       t.description,
+		LabelIDs:          t.labelIDs,
+		DefaultAssigneeID: t.defaultAssigneeID,
+		Subtasks:          t.subtasks,
+		Files:             t.files,
+		CreatedAt:         t.createdAt,
+		UpdatedAt:         t.updatedAt,
+	}
+}
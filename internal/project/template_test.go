@@ -0,0 +1,69 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTaskTemplate(t *testing.T) {
+	bug := createValidLabel(t, "label-1", DefaultBoardID, "bug")
+	mary := createValidMember(t, "member-1", DefaultBoardID, "Mary", MemberRoleMember)
+
+	t.Run("valid template", func(t *testing.T) {
+		template, err := NewTaskTemplate(
+			"1",
+			DefaultBoardID,
+			" Release checklist ",
+			lo.ToPtr("Steps to ship a release"),
+			[]Label{bug, bug},
+			mary,
+			[]string{" Tag the release", "Write the changelog"},
+		)
+		require.NoError(t, err)
+
+		snap := template.GetSnapshot()
+		assert.Equal(t, "Release checklist", snap.Title)
+		assert.Equal(t, []LabelID{"label-1"}, snap.LabelIDs)
+		assert.Equal(t, lo.ToPtr(MemberID("member-1")), snap.DefaultAssigneeID)
+		assert.Equal(t, []string{"Tag the release", "Write the changelog"}, snap.Subtasks)
+		assert.Empty(t, snap.Files)
+	})
+
+	t.Run("validates the template", func(t *testing.T) {
+		_, err := NewTaskTemplate("1", DefaultBoardID, " ", nil, nil, nil, nil)
+		assert.ErrorIs(t, err, ErrTemplateTitleEmpty)
+
+		_, err = NewTaskTemplate("1", DefaultBoardID, strings.Repeat("a", MaxTitleLength+1), nil, nil, nil, nil)
+		assert.ErrorIs(t, err, ErrTitleTooLong)
+
+		otherBoardLabel := createValidLabel(t, "label-2", "board-2", "feature")
+		_, err = NewTaskTemplate("1", DefaultBoardID, "chore", nil, []Label{otherBoardLabel}, nil, nil)
+		assert.ErrorIs(t, err, ErrLabelOtherBoard)
+
+		otherBoardMember := createValidMember(t, "member-2", "board-2", "Steve", MemberRoleMember)
+		_, err = NewTaskTemplate("1", DefaultBoardID, "chore", nil, nil, otherBoardMember, nil)
+		assert.ErrorIs(t, err, ErrUnknownAssignee)
+
+		_, err = NewTaskTemplate("1", DefaultBoardID, "chore", nil, nil, nil, []string{"step", " "})
+		assert.ErrorIs(t, err, ErrTemplateSubtaskEmpty)
+
+		_, err = NewTaskTemplate("1", DefaultBoardID, "chore", nil, nil, nil, make([]string, MaxTemplateSubtasks+1))
+		assert.ErrorIs(t, err, ErrTooManyTemplateSubtasks)
+	})
+
+	t.Run("files are stored under the template's ID", func(t *testing.T) {
+		template, err := NewTaskTemplate("template-1", DefaultBoardID, "chore", nil, nil, nil, nil)
+		require.NoError(t, err)
+
+		file, err := NewFile("checklist.md", 10)
+		require.NoError(t, err)
+		template.AddFile(file)
+
+		assert.Equal(t, TaskID("template-1"), template.FilesOwner())
+		assert.Len(t, template.Files(), 1)
+	})
+}
@@ -0,0 +1,33 @@
+package project
+
+import "fmt"
+
+var (
+	ErrTaskVersionMismatch = newConflictError(
+		"task_version_mismatch",
+		"task was changed since this version, get it again",
+	)
+	ErrTaskChangedConcurrently = newConflictError(
+		"task_changed_concurrently",
+		"task was changed by someone else at the same time, try again",
+	)
+)
+
+func (t *Task) Version() int {
+	return t.version
+}
+
+// CheckVersion makes sure the task is still at the version a change was made from,
+// so that the change doesn't silently overwrite the ones made since
+func (t *Task) CheckVersion(version int) error {
+	if t.version != version {
+		return fmt.Errorf(
+			"%w: task %s is at version %d, not %d",
+			ErrTaskVersionMismatch,
+			t.id,
+			t.version,
+			version,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskVersion(t *testing.T) {
+	task := createValidTask(t)
+	assert.Equal(t, 1, task.Version())
+
+	assert.NoError(t, task.CheckVersion(1))
+	assert.ErrorIs(t, task.CheckVersion(2), ErrTaskVersionMismatch)
+}
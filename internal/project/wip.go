@@ -0,0 +1,153 @@
+package project
+
+import (
+	"fmt"
+	"time"
+)
+
+// WIPLimit caps how many tasks can sit in a status column at once, zero means no limit
+type WIPLimit struct {
+	Board       int `json:"board"`
+	PerAssignee int `json:"per_assignee"`
+}
+
+func (l WIPLimit) isEmpty() bool {
+	return l.Board == 0 && l.PerAssignee == 0
+}
+
+// WIPOverride records who forced a task into a column past its WIP limit
+type WIPOverride struct {
+	ForcedBy string     `json:"forced_by"`
+	Status   TaskStatus `json:"status"`
+	ForcedAt time.Time  `json:"forced_at"`
+}
+
+var (
+	ErrInvalidWIPLimit = newValidationError(
+		"invalid_wip_limit",
+		"wip limit cannot be negative",
+	)
+	ErrWIPLimitExceeded          = newConflictError("wip_limit_exceeded", "wip limit exceeded")
+	ErrWIPOverrideRequiresAuthor = newValidationError(
+		"wip_override_requires_author",
+		"forcing past a wip limit requires who forced it",
+	)
+)
+
+// WIPLimitError is returned when a move would put more tasks in a column than its limit allows
+type WIPLimitError struct {
+	Status   TaskStatus
+	Limit    int
+	Assignee *string
+}
+
+func (e *WIPLimitError) Error() string {
+	if e.Assignee != nil {
+		return fmt.Sprintf(
+			"%s: %s already has %d tasks in %s",
+			ErrWIPLimitExceeded,
+			*e.Assignee,
+			e.Limit,
+			e.Status,
+		)
+	}
+	return fmt.Sprintf("%s: %s already has %d tasks", ErrWIPLimitExceeded, e.Status, e.Limit)
+}
+
+// Unwrap gives the kind and code of ErrWIPLimitExceeded to the error
+func (e *WIPLimitError) Unwrap() error {
+	return ErrWIPLimitExceeded
+}
+
+// SetWIPLimit changes the limit of a status column, a limit of zero removes it
+func (b *Board) SetWIPLimit(status TaskStatus, limit WIPLimit) error {
+	if b.IsArchived() {
+		return ErrBoardArchived
+	}
+
+	if !b.workflow.HasStatus(status) {
+		return fmt.Errorf("%w: %s", ErrInvalidStatus, status)
+	}
+
+	if limit.Board < 0 || limit.PerAssignee < 0 {
+		return ErrInvalidWIPLimit
+	}
+
+	if limit.isEmpty() {
+		delete(b.wipLimits, status)
+	} else {
+		b.wipLimits[status] = limit
+	}
+
+	b.updatedAt = time.Now()
+	return nil
+}
+
+func (b *Board) WIPLimit(status TaskStatus) WIPLimit {
+	return b.wipLimits[status]
+}
+
+// CheckWIPLimit returns an error if the task, in its current status and with its current assignees,
+// doesn't fit in the column next to the other tasks of the board
+func (b *Board) CheckWIPLimit(task *Task, boardTasks []Task) error {
+	limit, ok := b.wipLimits[task.status]
+	if !ok {
+		return nil
+	}
+
+	inStatus := 0
+	assignedInStatus := make(map[MemberID]int, len(task.assignees))
+	for _, other := range boardTasks {
+		if other.id == task.id || other.status != task.status {
+			continue
+		}
+
+		inStatus++
+		for _, assignee := range task.assignees {
+			if other.IsAssignedTo(assignee.id) {
+				assignedInStatus[assignee.id]++
+			}
+		}
+	}
+
+	if limit.Board > 0 && inStatus >= limit.Board {
+		return &WIPLimitError{Status: task.status, Limit: limit.Board}
+	}
+
+	if limit.PerAssignee == 0 {
+		return nil
+	}
+
+	for _, assignee := range task.assignees {
+		if assignedInStatus[assignee.id] >= limit.PerAssignee {
+			return &WIPLimitError{
+				Status:   task.status,
+				Limit:    limit.PerAssignee,
+				Assignee: &assignee.displayName,
+			}
+		}
+	}
+
+	return nil
+}
+
+// OverrideWIPLimit records that the task's current status was forced past the column's limit,
+// only admins of the board can force it
+func (t *Task) OverrideWIPLimit(forcedBy *Member) error {
+	if forcedBy == nil {
+		return ErrWIPOverrideRequiresAuthor
+	}
+
+	if forcedBy.boardID != t.boardID || !forcedBy.IsAdmin() {
+		return fmt.Errorf("%w: %s", ErrMemberNotAnAdmin, forcedBy.displayName)
+	}
+
+	now := time.Now()
+	t.wipOverride = &WIPOverride{
+		ForcedBy: forcedBy.displayName,
+		Status:   t.status,
+		ForcedAt: now,
+	}
+	t.updatedAt = now
+	return nil
+}
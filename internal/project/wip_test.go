@@ -0,0 +1,157 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardSetWIPLimit(t *testing.T) {
+	t.Run("valid limit", func(t *testing.T) {
+		board := createValidBoard(t)
+		err := board.SetWIPLimit(TaskStatusInProgress, WIPLimit{Board: 3, PerAssignee: 1})
+		require.NoError(t, err)
+		assert.Equal(t, WIPLimit{Board: 3, PerAssignee: 1}, board.WIPLimit(TaskStatusInProgress))
+	})
+
+	t.Run("zero removes the limit", func(t *testing.T) {
+		board := createValidBoard(t)
+		require.NoError(t, board.SetWIPLimit(TaskStatusInProgress, WIPLimit{Board: 3}))
+		require.NoError(t, board.SetWIPLimit(TaskStatusInProgress, WIPLimit{}))
+		assert.NotContains(t, board.GetSnapshot().WIPLimits, TaskStatusInProgress)
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		board := createValidBoard(t)
+		err := board.SetWIPLimit(TaskStatusInProgress, WIPLimit{Board: -1})
+		assert.ErrorIs(t, err, ErrInvalidWIPLimit)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		board := createValidBoard(t)
+		err := board.SetWIPLimit("qa", WIPLimit{Board: 1})
+		assert.ErrorIs(t, err, ErrInvalidStatus)
+	})
+}
+
+func TestBoardCheckWIPLimit(t *testing.T) {
+	john := createValidMember(t, "member-1", "board-1", "John", MemberRoleMember)
+	mary := createValidMember(t, "member-2", "board-1", "Mary", MemberRoleMember)
+
+	newTaskIn := func(t *testing.T, id TaskID, status TaskStatus, assignees ...Member) Task {
+		task, err := NewTask(id, "board-1", "task", nil, nil, assignees, nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, task.ChangeStatus(DefaultWorkflow(), status))
+		return *task
+	}
+
+	t.Run("board limit reached", func(t *testing.T) {
+		board := createValidBoard(t)
+		require.NoError(t, board.SetWIPLimit(TaskStatusInProgress, WIPLimit{Board: 2}))
+
+		others := []Task{
+			newTaskIn(t, "1", TaskStatusInProgress, *john),
+			newTaskIn(t, "2", TaskStatusInProgress, *mary),
+		}
+		moved := newTaskIn(t, "3", TaskStatusInProgress)
+
+		err := board.CheckWIPLimit(&moved, others)
+		assert.ErrorIs(t, err, ErrWIPLimitExceeded)
+
+		var wipErr *WIPLimitError
+		require.ErrorAs(t, err, &wipErr)
+		assert.Equal(t, 2, wipErr.Limit)
+		assert.Nil(t, wipErr.Assignee)
+	})
+
+	t.Run("the moved task isn't counted twice", func(t *testing.T) {
+		board := createValidBoard(t)
+		require.NoError(t, board.SetWIPLimit(TaskStatusInProgress, WIPLimit{Board: 2}))
+
+		moved := newTaskIn(t, "2", TaskStatusInProgress)
+		others := []Task{newTaskIn(t, "1", TaskStatusInProgress, *john), moved}
+
+		assert.NoError(t, board.CheckWIPLimit(&moved, others))
+	})
+
+	t.Run("per assignee limit reached", func(t *testing.T) {
+		board := createValidBoard(t)
+		require.NoError(t, board.SetWIPLimit(TaskStatusInProgress, WIPLimit{PerAssignee: 1}))
+
+		others := []Task{
+			newTaskIn(t, "1", TaskStatusInProgress, *john),
+			newTaskIn(t, "2", TaskStatusPending, *mary),
+		}
+
+		forJohn := newTaskIn(t, "3", TaskStatusInProgress, *john)
+		err := board.CheckWIPLimit(&forJohn, others)
+
+		var wipErr *WIPLimitError
+		require.ErrorAs(t, err, &wipErr)
+		assert.Equal(t, lo.ToPtr("John"), wipErr.Assignee)
+
+		forMary := newTaskIn(t, "4", TaskStatusInProgress, *mary)
+		assert.NoError(t, board.CheckWIPLimit(&forMary, others))
+	})
+
+	t.Run("every assignee of a shared task counts", func(t *testing.T) {
+		board := createValidBoard(t)
+		require.NoError(t, board.SetWIPLimit(TaskStatusInProgress, WIPLimit{PerAssignee: 1}))
+
+		others := []Task{newTaskIn(t, "1", TaskStatusInProgress, *mary)}
+		paired := newTaskIn(t, "2", TaskStatusInProgress, *john, *mary)
+		err := board.CheckWIPLimit(&paired, others)
+
+		var wipErr *WIPLimitError
+		require.ErrorAs(t, err, &wipErr)
+		assert.Equal(t, lo.ToPtr("Mary"), wipErr.Assignee)
+	})
+
+	t.Run("column without a limit", func(t *testing.T) {
+		board := createValidBoard(t)
+		moved := newTaskIn(t, "1", TaskStatusInReview)
+		assert.NoError(t, board.CheckWIPLimit(&moved, []Task{moved}))
+	})
+}
+
+func TestTaskOverrideWIPLimit(t *testing.T) {
+	t.Run("records who forced the move", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.ChangeStatus(DefaultWorkflow(), TaskStatusInProgress))
+		laura := createValidMember(t, "member-1", DefaultBoardID, "Laura", MemberRoleAdmin)
+		require.NoError(t, task.OverrideWIPLimit(laura))
+
+		override := task.GetSnapshot().WIPOverride
+		require.NotNil(t, override)
+		assert.Equal(t, "Laura", override.ForcedBy)
+		assert.Equal(t, TaskStatusInProgress, override.Status)
+	})
+
+	t.Run("dropped once the task leaves the column", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.ChangeStatus(DefaultWorkflow(), TaskStatusInProgress))
+		laura := createValidMember(t, "member-1", DefaultBoardID, "Laura", MemberRoleAdmin)
+		require.NoError(t, task.OverrideWIPLimit(laura))
+
+		require.NoError(t, task.ChangeStatus(DefaultWorkflow(), TaskStatusInReview))
+		assert.Nil(t, task.GetSnapshot().WIPOverride)
+	})
+
+	t.Run("requires who forced it", func(t *testing.T) {
+		task := createValidTask(t)
+		assert.ErrorIs(t, task.OverrideWIPLimit(nil), ErrWIPOverrideRequiresAuthor)
+		assert.Nil(t, task.GetSnapshot().WIPOverride)
+	})
+
+	t.Run("only admins can force it", func(t *testing.T) {
+		task := createValidTask(t)
+		steve := createValidMember(t, "member-1", DefaultBoardID, "Steve", MemberRoleMember)
+		assert.ErrorIs(t, task.OverrideWIPLimit(steve), ErrMemberNotAnAdmin)
+
+		otherBoardAdmin := createValidMember(t, "member-2", "board-2", "Alex", MemberRoleAdmin)
+		assert.ErrorIs(t, task.OverrideWIPLimit(otherBoardAdmin), ErrMemberNotAnAdmin)
+		assert.Nil(t, task.GetSnapshot().WIPOverride)
+	})
+}
@@ -0,0 +1,179 @@
+package project
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Workflow is a value object that describes the columns of a board and
+// which moves between them are allowed.
+//
+// Every workflow contains pending (where new tasks land) and completed (which marks a task as done).
+// A status that has no entry in the transitions can move to any other column.
+type Workflow struct {
+	statuses    []TaskStatus
+	transitions map[TaskStatus][]TaskStatus
+}
+
+const MaxStatusLength = 30
+
+var (
+	ErrWorkflowEmpty = newValidationError(
+		"workflow_empty",
+		"workflow must have at least one status",
+	)
+	ErrWorkflowDuplicateStatus = newValidationError(
+		"workflow_duplicate_status",
+		"workflow status is duplicated",
+	)
+	ErrWorkflowMissingStatus = newValidationError(
+		"workflow_missing_status",
+		"workflow must contain the pending and completed statuses",
+	)
+	ErrWorkflowUnknownStatus = newValidationError(
+		"workflow_unknown_status",
+		"workflow transition references an unknown status",
+	)
+	ErrInvalidStatusName = newValidationError(
+		"invalid_status_name",
+		"status must be lowercase letters, digits and underscores",
+	)
+	ErrTransitionNotAllowed = newConflictError(
+		"transition_not_allowed",
+		"status transition is not allowed",
+	)
+	ErrWorkflowStatusStillInUse = newConflictError(
+		"workflow_status_still_in_use",
+		"status is still used by tasks on the board",
+	)
+)
+
+var statusNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// TransitionError is returned when the workflow doesn't allow moving a task between two statuses
+type TransitionError struct {
+	From    TaskStatus
+	To      TaskStatus
+	Allowed []TaskStatus
+}
+
+func (e *TransitionError) Error() string {
+	allowed := make([]string, len(e.Allowed))
+	for i, s := range e.Allowed {
+		allowed[i] = string(s)
+	}
+
+	return fmt.Sprintf(
+		"%s: %s -> %s (allowed: %s)",
+		ErrTransitionNotAllowed,
+		e.From,
+		e.To,
+		strings.Join(allowed, ", "),
+	)
+}
+
+// Unwrap gives the kind and code of ErrTransitionNotAllowed to the error
+func (e *TransitionError) Unwrap() error {
+	return ErrTransitionNotAllowed
+}
+
+// DefaultWorkflow has the four classic kanban columns and allows any move between them
+func DefaultWorkflow() Workflow {
+	return Workflow{
+		statuses: []TaskStatus{
+			TaskStatusPending,
+			TaskStatusInProgress,
+			TaskStatusInReview,
+			TaskStatusCompleted,
+		},
+		transitions: map[TaskStatus][]TaskStatus{},
+	}
+}
+
+func NewWorkflow(
+	statuses []TaskStatus,
+	transitions map[TaskStatus][]TaskStatus,
+) (Workflow, error) {
+	if len(statuses) == 0 {
+		return Workflow{}, ErrWorkflowEmpty
+	}
+
+	seen := make(map[TaskStatus]bool, len(statuses))
+	for _, status := range statuses {
+		if len(status) > MaxStatusLength || !statusNamePattern.MatchString(string(status)) {
+			return Workflow{}, fmt.Errorf("%w: %q", ErrInvalidStatusName, status)
+		}
+		if seen[status] {
+			return Workflow{}, fmt.Errorf("%w: %s", ErrWorkflowDuplicateStatus, status)
+		}
+		seen[status] = true
+	}
+
+	if !seen[TaskStatusPending] || !seen[TaskStatusCompleted] {
+		return Workflow{}, ErrWorkflowMissingStatus
+	}
+
+	copied := make(map[TaskStatus][]TaskStatus, len(transitions))
+	for from, targets := range transitions {
+		if !seen[from] {
+			return Workflow{}, fmt.Errorf("%w: %s", ErrWorkflowUnknownStatus, from)
+		}
+		for _, to := range targets {
+			if !seen[to] {
+				return Workflow{}, fmt.Errorf("%w: %s", ErrWorkflowUnknownStatus, to)
+			}
+		}
+		copied[from] = slices.Clone(targets)
+	}
+
+	return Workflow{
+		statuses:    slices.Clone(statuses),
+		transitions: copied,
+	}, nil
+}
+
+// Statuses returns the columns of the workflow, in order
+func (w Workflow) Statuses() []TaskStatus {
+	return slices.Clone(w.statuses)
+}
+
+func (w Workflow) HasStatus(status TaskStatus) bool {
+	return slices.Contains(w.statuses, status)
+}
+
+// CanTransition returns an error if a task isn't allowed to move from one status to another
+func (w Workflow) CanTransition(from TaskStatus, to TaskStatus) error {
+	if !w.HasStatus(to) {
+		return fmt.Errorf("%w: %s", ErrInvalidStatus, to)
+	}
+
+	if from == to {
+		return nil
+	}
+
+	allowed, restricted := w.transitions[from]
+	if !restricted || slices.Contains(allowed, to) {
+		return nil
+	}
+
+	return &TransitionError{From: from, To: to, Allowed: slices.Clone(allowed)}
+}
+
+type WorkflowSnapshot struct {
+	Statuses    []TaskStatus                `json:"statuses"`
+	Transitions map[TaskStatus][]TaskStatus `json:"transitions"`
+}
+
+func (w Workflow) GetSnapshot() WorkflowSnapshot {
+	transitions := make(map[TaskStatus][]TaskStatus, len(w.transitions))
+	for from, targets := range w.transitions {
+		transitions[from] = slices.Clone(targets)
+	}
+
+	return WorkflowSnapshot{
+		Statuses:    w.Statuses(),
+		Transitions: transitions,
+	}
+}
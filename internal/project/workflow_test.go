@@ -0,0 +1,105 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkflow(t *testing.T) {
+	t.Run("valid workflow with custom columns", func(t *testing.T) {
+		workflow, err := NewWorkflow(
+			[]TaskStatus{"pending", "in_progress", "blocked", "qa", "completed"},
+			map[TaskStatus][]TaskStatus{"qa": {"completed", "in_progress"}},
+		)
+		require.NoError(t, err)
+		assert.True(t, workflow.HasStatus("blocked"))
+		assert.Len(t, workflow.Statuses(), 5)
+	})
+
+	t.Run("empty workflow", func(t *testing.T) {
+		_, err := NewWorkflow(nil, nil)
+		assert.ErrorIs(t, err, ErrWorkflowEmpty)
+	})
+
+	t.Run("missing pending or completed", func(t *testing.T) {
+		_, err := NewWorkflow([]TaskStatus{"todo", "done"}, nil)
+		assert.ErrorIs(t, err, ErrWorkflowMissingStatus)
+	})
+
+	t.Run("duplicated status", func(t *testing.T) {
+		_, err := NewWorkflow([]TaskStatus{"pending", "pending", "completed"}, nil)
+		assert.ErrorIs(t, err, ErrWorkflowDuplicateStatus)
+	})
+
+	t.Run("invalid status name", func(t *testing.T) {
+		_, err := NewWorkflow([]TaskStatus{"pending", "In Review", "completed"}, nil)
+		assert.ErrorIs(t, err, ErrInvalidStatusName)
+	})
+
+	t.Run("transition to unknown status", func(t *testing.T) {
+		_, err := NewWorkflow(
+			[]TaskStatus{"pending", "completed"},
+			map[TaskStatus][]TaskStatus{"pending": {"qa"}},
+		)
+		assert.ErrorIs(t, err, ErrWorkflowUnknownStatus)
+	})
+}
+
+func TestWorkflowCanTransition(t *testing.T) {
+	workflow, err := NewWorkflow(
+		[]TaskStatus{"pending", "in_progress", "in_review", "completed"},
+		map[TaskStatus][]TaskStatus{
+			"in_review": {"completed", "in_progress"},
+			"completed": {},
+		},
+	)
+	require.NoError(t, err)
+
+	t.Run("unrestricted status can move anywhere", func(t *testing.T) {
+		assert.NoError(t, workflow.CanTransition("pending", "completed"))
+	})
+
+	t.Run("restricted status can only move to its targets", func(t *testing.T) {
+		assert.NoError(t, workflow.CanTransition("in_review", "in_progress"))
+
+		err := workflow.CanTransition("in_review", "pending")
+		assert.ErrorIs(t, err, ErrTransitionNotAllowed)
+
+		var transitionErr *TransitionError
+		require.ErrorAs(t, err, &transitionErr)
+		assert.Equal(t, TaskStatus("in_review"), transitionErr.From)
+		assert.Equal(t, TaskStatus("pending"), transitionErr.To)
+	})
+
+	t.Run("status without targets cannot move", func(t *testing.T) {
+		assert.ErrorIs(t, workflow.CanTransition("completed", "pending"), ErrTransitionNotAllowed)
+	})
+
+	t.Run("staying in the same status is allowed", func(t *testing.T) {
+		assert.NoError(t, workflow.CanTransition("completed", "completed"))
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		assert.ErrorIs(t, workflow.CanTransition("pending", "qa"), ErrInvalidStatus)
+	})
+}
+
+func TestBoardChangeWorkflow(t *testing.T) {
+	workflow, err := NewWorkflow([]TaskStatus{"pending", "qa", "completed"}, nil)
+	require.NoError(t, err)
+
+	t.Run("replaces the workflow", func(t *testing.T) {
+		board := createValidBoard(t)
+		require.NoError(t, board.ChangeWorkflow(workflow, []TaskStatus{"pending"}))
+		assert.True(t, board.Workflow().HasStatus("qa"))
+	})
+
+	t.Run("cannot remove a status that is in use", func(t *testing.T) {
+		board := createValidBoard(t)
+		err := board.ChangeWorkflow(workflow, []TaskStatus{"in_review"})
+		assert.ErrorIs(t, err, ErrWorkflowStatusStillInUse)
+		assert.True(t, board.Workflow().HasStatus("in_review"))
+	})
+}
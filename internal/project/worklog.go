@@ -0,0 +1,285 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type WorklogRepository interface {
+	Create(ctx context.Context, worklog *Worklog) error
+	TaskWorklogs(ctx context.Context, taskID TaskID) ([]Worklog, error)
+	// MemberWorklogs lists the member's worklogs dated from one day to another, both included
+	MemberWorklogs(ctx context.Context, memberID MemberID, from, to time.Time) ([]Worklog, error)
+	// StartTimer returns ErrTimerAlreadyRunning if the member has a running timer
+	StartTimer(ctx context.Context, timer *Timer) error
+	// StopTimer turns the member's running timer into the worklog returned by stopFn,
+	// it returns ErrNoRunningTimer if the member has no running timer
+	StopTimer(
+		ctx context.Context,
+		memberID MemberID,
+		stopFn func(t *Timer) (*Worklog, error),
+	) error
+}
+
+type WorklogID string
+
+// Worklog is time a member spent on a task on a given day
+type Worklog struct {
+	id       WorklogID
+	taskID   TaskID
+	memberID MemberID
+	duration time.Duration
+	// The day the work was done, at midnight UTC
+	date      time.Time
+	note      *string
+	createdAt time.Time
+}
+
+func NewWorklogID() (WorklogID, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return WorklogID(id.String()), nil
+}
+
+const (
+	MaxWorklogNoteLength = 500
+	MaxTimesheetDays     = 366
+)
+
+var (
+	ErrWorklogDurationInvalid = newValidationError(
+		"worklog_duration_invalid",
+		"worklog duration must be at least a minute",
+	)
+	ErrWorklogInFuture = newValidationError(
+		"worklog_in_future",
+		"worklog date cannot be in the future",
+	)
+	ErrWorklogNoteTooLong = newValidationError("worklog_note_too_long", fmt.Sprintf(
+		"worklog note cannot be longer than %d characters",
+		MaxWorklogNoteLength,
+	))
+	ErrTimerAlreadyRunning = newConflictError(
+		"timer_already_running",
+		"member already has a running timer, stop it first",
+	)
+	ErrNoRunningTimer = newConflictError(
+		"no_running_timer",
+		"member has no running timer on this task",
+	)
+	ErrInvalidDateRange = newValidationError(
+		"invalid_date_range",
+		"date range must end after it starts",
+	)
+	ErrDateRangeTooLong = newValidationError(
+		"date_range_too_long",
+		fmt.Sprintf("date range cannot be longer than %d days", MaxTimesheetDays),
+	)
+)
+
+// NewWorklog logs time a member of the task's board spent on it, durations are kept to the minute
+func NewWorklog(
+	id WorklogID,
+	task *Task,
+	member Member,
+	duration time.Duration,
+	date time.Time,
+	note *string,
+) (*Worklog, error) {
+	if task.IsArchived() {
+		return nil, fmt.Errorf("%w: %s", ErrTaskArchived, task.id)
+	}
+
+	if err := validateMembers([]Member{member}, task.boardID, ErrMemberNotFound); err != nil {
+		return nil, err
+	}
+
+	duration = duration.Truncate(time.Minute)
+	if duration < time.Minute {
+		return nil, ErrWorklogDurationInvalid
+	}
+
+	date = Day(date)
+	if date.After(Day(time.Now())) {
+		return nil, ErrWorklogInFuture
+	}
+
+	if note != nil {
+		trimmed := strings.TrimSpace(*note)
+		if len(trimmed) > MaxWorklogNoteLength {
+			return nil, ErrWorklogNoteTooLong
+		}
+		note = lo.EmptyableToPtr(trimmed)
+	}
+
+	return &Worklog{
+		id:        id,
+		taskID:    task.id,
+		memberID:  member.id,
+		duration:  duration,
+		date:      date,
+		note:      note,
+		createdAt: time.Now(),
+	}, nil
+}
+
+// Day is the day of t, at midnight UTC
+func Day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func (w *Worklog) TaskID() TaskID {
+	return w.taskID
+}
+
+func (w *Worklog) Duration() time.Duration {
+	return w.duration
+}
+
+type WorklogSnapshot struct {
+	ID              WorklogID `json:"id"`
+	TaskID          TaskID    `json:"task_id"`
+	MemberID        MemberID  `json:"member_id"`
+	DurationMinutes int       `json:"duration_minutes"`
+	// The day the work was done
+	Date      time.Time `json:"date"`
+	Note      *string   `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Used by the db adapters
+func (w *Worklog) GetSnapshot() WorklogSnapshot {
+	return WorklogSnapshot{
+		ID:              w.id,
+		TaskID:          w.taskID,
+		MemberID:        w.memberID,
+		DurationMinutes: int(w.duration / time.Minute),
+		Date:            w.date,
+		Note:            w.note,
+		CreatedAt:       w.createdAt,
+	}
+}
+
+// Timer measures the time a member spends on a task until it's stopped and logged,
+// a member runs at most one timer at a time
+type Timer struct {
+	taskID    TaskID
+	memberID  MemberID
+	startedAt time.Time
+}
+
+// StartTimer starts measuring the time a member of the task's board spends on it
+func StartTimer(task *Task, member Member) (*Timer, error) {
+	if task.IsArchived() {
+		return nil, fmt.Errorf("%w: %s", ErrTaskArchived, task.id)
+	}
+
+	if err := validateMembers([]Member{member}, task.boardID, ErrMemberNotFound); err != nil {
+		return nil, err
+	}
+
+	return &Timer{
+		taskID:    task.id,
+		memberID:  member.id,
+		startedAt: time.Now(),
+	}, nil
+}
+
+func (t *Timer) TaskID() TaskID {
+	return t.taskID
+}
+
+// Stop logs the time since the timer started on the day it started, rounded up to the minute
+func (t *Timer) Stop(id WorklogID, task *Task, member Member, note *string) (*Worklog, error) {
+	if task.id != t.taskID || member.id != t.memberID {
+		return nil, fmt.Errorf("%w: %s", ErrNoRunningTimer, task.id)
+	}
+
+	elapsed := time.Since(t.startedAt)
+	duration := max((elapsed + time.Minute - 1).Truncate(time.Minute), time.Minute)
+
+	return NewWorklog(id, task, member, duration, t.startedAt, note)
+}
+
+type TimerSnapshot struct {
+	TaskID    TaskID    `json:"task_id"`
+	MemberID  MemberID  `json:"member_id"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// Used by the db adapters
+func (t *Timer) GetSnapshot() TimerSnapshot {
+	return TimerSnapshot{
+		TaskID:    t.taskID,
+		MemberID:  t.memberID,
+		StartedAt: t.startedAt,
+	}
+}
+
+// Timesheet is the time a member logged on each day of a date range
+type Timesheet struct {
+	MemberID MemberID
+	From     time.Time
+	To       time.Time
+	Days     []TimesheetDay
+	Worklogs []Worklog
+}
+
+type TimesheetDay struct {
+	Date    time.Time `json:"date"`
+	Minutes int       `json:"minutes"`
+}
+
+// NewTimesheet sums the worklogs of a member per day, from one day to another (both included).
+// Days without worklogs are listed with 0 minutes.
+func NewTimesheet(memberID MemberID, from, to time.Time, worklogs []Worklog) (*Timesheet, error) {
+	from, to = Day(from), Day(to)
+	if to.Before(from) {
+		return nil, ErrInvalidDateRange
+	}
+
+	if to.Sub(from) >= MaxTimesheetDays*24*time.Hour {
+		return nil, ErrDateRangeTooLong
+	}
+
+	minutes := make(map[string]int)
+	for _, w := range worklogs {
+		minutes[w.date.Format(time.DateOnly)] += int(w.duration / time.Minute)
+	}
+
+	days := make([]TimesheetDay, 0)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		days = append(days, TimesheetDay{Date: day, Minutes: minutes[day.Format(time.DateOnly)]})
+	}
+
+	sorted := slices.SortedFunc(slices.Values(worklogs), func(a, b Worklog) int {
+		return a.date.Compare(b.date)
+	})
+
+	return &Timesheet{
+		MemberID: memberID,
+		From:     from,
+		To:       to,
+		Days:     days,
+		Worklogs: sorted,
+	}, nil
+}
+
+// TotalMinutes is the time logged over the whole date range
+func (t *Timesheet) TotalMinutes() int {
+	return lo.SumBy(t.Days, func(d TimesheetDay) int { return d.Minutes })
+}
+
+// TimeSpent is the sum of the time logged on the task
+func (t *Task) TimeSpent() time.Duration {
+	return t.timeSpent
+}
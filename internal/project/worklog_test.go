@@ -0,0 +1,131 @@
+package project
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorklog(t *testing.T) {
+	member := createValidMember(t, "member-1", DefaultBoardID, "John Doe", MemberRoleMember)
+	yesterday := time.Now().AddDate(0, 0, -1)
+
+	t.Run("valid worklog", func(t *testing.T) {
+		task := createValidTask(t)
+		duration := 90*time.Minute + 30*time.Second
+		worklog, err := NewWorklog("1", task, *member, duration, yesterday, lo.ToPtr(" review "))
+		require.NoError(t, err)
+
+		snap := worklog.GetSnapshot()
+		assert.Equal(t, 90, snap.DurationMinutes)
+		assert.Equal(t, Day(yesterday), snap.Date)
+		assert.Equal(t, lo.ToPtr("review"), snap.Note)
+	})
+
+	t.Run("less than a minute", func(t *testing.T) {
+		_, err := NewWorklog("1", createValidTask(t), *member, 59*time.Second, yesterday, nil)
+		assert.ErrorIs(t, err, ErrWorklogDurationInvalid)
+	})
+
+	t.Run("in the future", func(t *testing.T) {
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		_, err := NewWorklog("1", createValidTask(t), *member, time.Hour, tomorrow, nil)
+		assert.ErrorIs(t, err, ErrWorklogInFuture)
+	})
+
+	t.Run("note too long", func(t *testing.T) {
+		note := strings.Repeat("a", MaxWorklogNoteLength+1)
+		_, err := NewWorklog("1", createValidTask(t), *member, time.Hour, yesterday, &note)
+		assert.ErrorIs(t, err, ErrWorklogNoteTooLong)
+	})
+
+	t.Run("member of another board", func(t *testing.T) {
+		other := createValidMember(t, "member-2", "other-board", "Jane Doe", MemberRoleMember)
+		_, err := NewWorklog("1", createValidTask(t), *other, time.Hour, yesterday, nil)
+		assert.ErrorIs(t, err, ErrMemberNotFound)
+	})
+
+	t.Run("archived task", func(t *testing.T) {
+		task := createValidTask(t)
+		require.NoError(t, task.Archive())
+		_, err := NewWorklog("1", task, *member, time.Hour, yesterday, nil)
+		assert.ErrorIs(t, err, ErrTaskArchived)
+	})
+}
+
+func TestTimer(t *testing.T) {
+	member := createValidMember(t, "member-1", DefaultBoardID, "John Doe", MemberRoleMember)
+
+	t.Run("stopping logs the time rounded up to the minute", func(t *testing.T) {
+		task := createValidTask(t)
+		timer, err := StartTimer(task, *member)
+		require.NoError(t, err)
+		timer.startedAt = time.Now().Add(-(25*time.Minute + 10*time.Second))
+
+		worklog, err := timer.Stop("1", task, *member, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 26, worklog.GetSnapshot().DurationMinutes)
+		assert.Equal(t, Day(timer.startedAt), worklog.GetSnapshot().Date)
+	})
+
+	t.Run("a short timer logs a minute", func(t *testing.T) {
+		task := createValidTask(t)
+		timer, err := StartTimer(task, *member)
+		require.NoError(t, err)
+
+		worklog, err := timer.Stop("1", task, *member, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, worklog.GetSnapshot().DurationMinutes)
+	})
+
+	t.Run("stopping on another task", func(t *testing.T) {
+		timer, err := StartTimer(createValidTask(t), *member)
+		require.NoError(t, err)
+
+		other, err := NewTask("456", DefaultBoardID, "Other", nil, nil, nil, nil, nil)
+		require.NoError(t, err)
+		_, err = timer.Stop("1", other, *member, nil)
+		assert.ErrorIs(t, err, ErrNoRunningTimer)
+	})
+}
+
+func TestNewTimesheet(t *testing.T) {
+	member := createValidMember(t, "member-1", DefaultBoardID, "John Doe", MemberRoleMember)
+	task := createValidTask(t)
+	from := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	logWork := func(date time.Time, minutes int) Worklog {
+		worklog, err := NewWorklog("1", task, *member, time.Duration(minutes)*time.Minute, date, nil)
+		require.NoError(t, err)
+		return *worklog
+	}
+
+	t.Run("sums the worklogs per day", func(t *testing.T) {
+		timesheet, err := NewTimesheet(member.ID(), from, from.AddDate(0, 0, 2), []Worklog{
+			logWork(from.AddDate(0, 0, 2), 30),
+			logWork(from, 60),
+			logWork(from.Add(15*time.Hour), 45),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []TimesheetDay{
+			{Date: from, Minutes: 105},
+			{Date: from.AddDate(0, 0, 1), Minutes: 0},
+			{Date: from.AddDate(0, 0, 2), Minutes: 30},
+		}, timesheet.Days)
+		assert.Equal(t, 135, timesheet.TotalMinutes())
+		assert.Equal(t, from.AddDate(0, 0, 2), timesheet.Worklogs[2].GetSnapshot().Date)
+	})
+
+	t.Run("invalid date ranges", func(t *testing.T) {
+		_, err := NewTimesheet(member.ID(), from, from.AddDate(0, 0, -1), nil)
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+
+		_, err = NewTimesheet(member.ID(), from, from.AddDate(0, 0, MaxTimesheetDays), nil)
+		assert.ErrorIs(t, err, ErrDateRangeTooLong)
+	})
+}
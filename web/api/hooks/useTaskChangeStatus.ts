@@ -1,5 +1,5 @@
 import client from '@kubb/plugin-client/clients/axios'
-import type { TaskChangeStatusMutationRequest, TaskChangeStatusMutationResponse, TaskChangeStatusPathParams } from '../types/TaskChangeStatus.ts'
+import type { TaskChangeStatusMutationRequest, TaskChangeStatusMutationResponse, TaskChangeStatusPathParams, TaskChangeStatusHeaderParams } from '../types/TaskChangeStatus.ts'
 import type { RequestConfig, ResponseErrorConfig } from '@kubb/plugin-client/clients/axios'
 import type { UseMutationOptions } from '@tanstack/react-query'
 import { useMutation } from '@tanstack/react-query'
@@ -15,6 +15,7 @@ export type TaskChangeStatusMutationKey = ReturnType<typeof taskChangeStatusMuta
 export async function taskChangeStatus(
   taskId: TaskChangeStatusPathParams['taskId'],
   data: TaskChangeStatusMutationRequest,
+  headers?: TaskChangeStatusHeaderParams,
   config: Partial<RequestConfig<TaskChangeStatusMutationRequest>> & { client?: typeof client } = {},
 ) {
   const { client: request = client, ...requestConfig } = config
@@ -24,6 +25,7 @@ export async function taskChangeStatus(
     url: `/tasks/${taskId}/status`,
     baseURL: 'http://127.0.0.1:8888/v1/api',
     data,
+    headers: { ...headers, ...requestConfig.headers },
     ...requestConfig,
   })
   return res.data
@@ -38,7 +40,7 @@ export function useTaskChangeStatus(
     mutation?: UseMutationOptions<
       TaskChangeStatusMutationResponse,
       ResponseErrorConfig<Error>,
-      { taskId: TaskChangeStatusPathParams['taskId']; data: TaskChangeStatusMutationRequest }
+      { taskId: TaskChangeStatusPathParams['taskId']; data: TaskChangeStatusMutationRequest; headers?: TaskChangeStatusHeaderParams }
     >
     client?: Partial<RequestConfig<TaskChangeStatusMutationRequest>> & { client?: typeof client }
   } = {},
@@ -49,10 +51,10 @@ export function useTaskChangeStatus(
   return useMutation<
     TaskChangeStatusMutationResponse,
     ResponseErrorConfig<Error>,
-    { taskId: TaskChangeStatusPathParams['taskId']; data: TaskChangeStatusMutationRequest }
+    { taskId: TaskChangeStatusPathParams['taskId']; data: TaskChangeStatusMutationRequest; headers?: TaskChangeStatusHeaderParams }
   >({
-    mutationFn: async ({ taskId, data }) => {
-      return taskChangeStatus(taskId, data, config)
+    mutationFn: async ({ taskId, data, headers }) => {
+      return taskChangeStatus(taskId, data, headers, config)
     },
     mutationKey,
     ...mutationOptions,
@@ -1,5 +1,5 @@
 import client from '@kubb/plugin-client/clients/axios'
-import type { TaskEditMutationRequest, TaskEditMutationResponse, TaskEditPathParams } from '../types/TaskEdit.ts'
+import type { TaskEditMutationRequest, TaskEditMutationResponse, TaskEditPathParams, TaskEditHeaderParams } from '../types/TaskEdit.ts'
 import type { RequestConfig, ResponseErrorConfig } from '@kubb/plugin-client/clients/axios'
 import type { UseMutationOptions } from '@tanstack/react-query'
 import { useMutation } from '@tanstack/react-query'
@@ -15,6 +15,7 @@ export type TaskEditMutationKey = ReturnType<typeof taskEditMutationKey>
 export async function taskEdit(
   taskId: TaskEditPathParams['taskId'],
   data: TaskEditMutationRequest,
+  headers?: TaskEditHeaderParams,
   config: Partial<RequestConfig<TaskEditMutationRequest>> & { client?: typeof client } = {},
 ) {
   const { client: request = client, ...requestConfig } = config
@@ -33,7 +34,7 @@ export async function taskEdit(
     url: `/tasks/${taskId}/edit`,
     baseURL: 'http://127.0.0.1:8888/v1/api',
     data: formData,
-    headers: { 'Content-Type': 'multipart/form-data', ...requestConfig.headers },
+    headers: { 'Content-Type': 'multipart/form-data', ...headers, ...requestConfig.headers },
     ...requestConfig,
   })
   return res.data
@@ -45,16 +46,16 @@ export async function taskEdit(
  */
 export function useTaskEdit(
   options: {
-    mutation?: UseMutationOptions<TaskEditMutationResponse, ResponseErrorConfig<Error>, { taskId: TaskEditPathParams['taskId']; data: TaskEditMutationRequest }>
+    mutation?: UseMutationOptions<TaskEditMutationResponse, ResponseErrorConfig<Error>, { taskId: TaskEditPathParams['taskId']; data: TaskEditMutationRequest; headers?: TaskEditHeaderParams }>
     client?: Partial<RequestConfig<TaskEditMutationRequest>> & { client?: typeof client }
   } = {},
 ) {
   const { mutation: mutationOptions, client: config = {} } = options ?? {}
   const mutationKey = mutationOptions?.mutationKey ?? taskEditMutationKey()
 
-  return useMutation<TaskEditMutationResponse, ResponseErrorConfig<Error>, { taskId: TaskEditPathParams['taskId']; data: TaskEditMutationRequest }>({
-    mutationFn: async ({ taskId, data }) => {
-      return taskEdit(taskId, data, config)
+  return useMutation<TaskEditMutationResponse, ResponseErrorConfig<Error>, { taskId: TaskEditPathParams['taskId']; data: TaskEditMutationRequest; headers?: TaskEditHeaderParams }>({
+    mutationFn: async ({ taskId, data, headers }) => {
+      return taskEdit(taskId, data, headers, config)
     },
     mutationKey,
     ...mutationOptions,
@@ -16,6 +16,7 @@ export type { ProjectChat200, ProjectChatError, ProjectChatMutationRequest, Proj
 export type { Task } from './types/Task.ts'
 export type {
   TaskChangeStatusPathParams,
+  TaskChangeStatusHeaderParams,
   TaskChangeStatus204,
   TaskChangeStatusError,
   TaskChangeStatusMutationRequest,
@@ -23,8 +24,17 @@ export type {
   TaskChangeStatusMutation,
 } from './types/TaskChangeStatus.ts'
 export type { TaskCreate204, TaskCreateError, TaskCreateMutationRequest, TaskCreateMutationResponse, TaskCreateMutation } from './types/TaskCreate.ts'
-export type { TaskEditPathParams, TaskEdit204, TaskEditError, TaskEditMutationRequest, TaskEditMutationResponse, TaskEditMutation } from './types/TaskEdit.ts'
-export type { Tasks200, TasksError, TasksQueryResponse, TasksQuery } from './types/Tasks.ts'
+export type { TaskPage } from './types/TaskPage.ts'
+export type { TaskEditPathParams, TaskEditHeaderParams, TaskEdit204, TaskEditError, TaskEditMutationRequest, TaskEditMutationResponse, TaskEditMutation } from './types/TaskEdit.ts'
+export type {
+  TasksQueryParamsSortEnum,
+  TasksQueryParamsOrderEnum,
+  TasksQueryParams,
+  Tasks200,
+  TasksError,
+  TasksQueryResponse,
+  TasksQuery,
+} from './types/Tasks.ts'
 export { projectChatMutationKey, projectChat, useProjectChat } from './hooks/useProjectChat.ts'
 export { taskChangeStatusMutationKey, taskChangeStatus, useTaskChangeStatus } from './hooks/useTaskChangeStatus.ts'
 export { taskCreateMutationKey, taskCreate, useTaskCreate } from './hooks/useTaskCreate.ts'
@@ -42,6 +52,7 @@ export { messageSchema } from './zod/messageSchema.ts'
 export { projectChat200Schema, projectChatErrorSchema, projectChatMutationRequestSchema, projectChatMutationResponseSchema } from './zod/projectChatSchema.ts'
 export {
   taskChangeStatusPathParamsSchema,
+  taskChangeStatusHeaderParamsSchema,
   taskChangeStatus204Schema,
   taskChangeStatusErrorSchema,
   taskChangeStatusMutationRequestSchema,
@@ -50,10 +61,13 @@ export {
 export { taskCreate204Schema, taskCreateErrorSchema, taskCreateMutationRequestSchema, taskCreateMutationResponseSchema } from './zod/taskCreateSchema.ts'
 export {
   taskEditPathParamsSchema,
+  taskEditHeaderParamsSchema,
   taskEdit204Schema,
   taskEditErrorSchema,
   taskEditMutationRequestSchema,
   taskEditMutationResponseSchema,
 } from './zod/taskEditSchema.ts'
+export { taskPageSchema } from './zod/taskPageSchema.ts'
 export { taskSchema } from './zod/taskSchema.ts'
-export { tasks200Schema, tasksErrorSchema, tasksQueryResponseSchema } from './zod/tasksSchema.ts'
\ No newline at end of file
+export { tasksQueryParamsSchema, tasks200Schema, tasksErrorSchema, tasksQueryResponseSchema } from './zod/tasksSchema.ts'
+export { tasksQueryParamsSortEnum, tasksQueryParamsOrderEnum } from './types/Tasks.ts'
\ No newline at end of file
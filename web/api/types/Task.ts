@@ -41,4 +41,9 @@ export type Task = {
    * @type string, date-time
    */
   updated_at: string
+  /**
+   * @description Bumped every time the task is saved, its ETag
+   * @type integer, int64
+   */
+  version: number
 }
\ No newline at end of file
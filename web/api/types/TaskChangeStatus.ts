@@ -8,6 +8,14 @@ export type TaskChangeStatusPathParams = {
   taskId: string
 }
 
+export type TaskChangeStatusHeaderParams = {
+  /**
+   * @description Required, the ETag of the task the change was made from, * to change it whatever its version
+   * @type string | undefined
+   */
+  'If-Match'?: string
+}
+
 /**
  * @description No Content
  */
@@ -26,5 +34,6 @@ export type TaskChangeStatusMutation = {
   Response: TaskChangeStatus204
   Request: TaskChangeStatusMutationRequest
   PathParams: TaskChangeStatusPathParams
+  HeaderParams: TaskChangeStatusHeaderParams
   Errors: any
 }
\ No newline at end of file
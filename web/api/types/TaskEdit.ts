@@ -7,6 +7,14 @@ export type TaskEditPathParams = {
   taskId: string
 }
 
+export type TaskEditHeaderParams = {
+  /**
+   * @description Required, the ETag of the task the edit was made from, * to edit it whatever its version
+   * @type string | undefined
+   */
+  'If-Match'?: string
+}
+
 /**
  * @description No Content
  */
@@ -52,5 +60,6 @@ export type TaskEditMutation = {
   Response: TaskEdit204
   Request: TaskEditMutationRequest
   PathParams: TaskEditPathParams
+  HeaderParams: TaskEditHeaderParams
   Errors: any
 }
\ No newline at end of file
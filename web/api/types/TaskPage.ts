@@ -0,0 +1,18 @@
+import type { Task } from './Task.ts'
+
+export type TaskPage = {
+  /**
+   * @description A URL to the JSON Schema for this object.
+   * @type string | undefined, uri
+   */
+  readonly $schema?: string
+  /**
+   * @description Pass it as cursor to get the next page, null on the last page
+   * @type string
+   */
+  next_cursor: string | null
+  /**
+   * @type array
+   */
+  tasks: Task[] | null
+}
@@ -1,10 +1,105 @@
 import type { ErrorModel } from './ErrorModel.ts'
-import type { ListTasks } from './ListTasks.ts'
+import type { TaskPage } from './TaskPage.ts'
+
+export const tasksQueryParamsSortEnum = {
+  column: 'column',
+  title: 'title',
+  status: 'status',
+  rank: 'rank',
+  priority: 'priority',
+  story_points: 'story_points',
+  due_date: 'due_date',
+  created_at: 'created_at',
+  updated_at: 'updated_at',
+  completed_at: 'completed_at',
+} as const
+
+export type TasksQueryParamsSortEnum = (typeof tasksQueryParamsSortEnum)[keyof typeof tasksQueryParamsSortEnum]
+
+export const tasksQueryParamsOrderEnum = {
+  asc: 'asc',
+  desc: 'desc',
+} as const
+
+export type TasksQueryParamsOrderEnum = (typeof tasksQueryParamsOrderEnum)[keyof typeof tasksQueryParamsOrderEnum]
+
+export type TasksQueryParams = {
+  /**
+   * @description Only return the tasks of this board, by ID
+   * @type string | undefined
+   */
+  board?: string
+  /**
+   * @description Only return the tasks in any of these statuses
+   * @type array | undefined
+   */
+  status?: string[]
+  /**
+   * @description Only return the tasks assigned to any of these members, by ID or display name
+   * @type array | undefined
+   */
+  assignee?: string[]
+  /**
+   * @description Only return the tasks due on or after this day, e.g. 2026-01-01
+   * @type string | undefined, date
+   */
+  due_from?: string
+  /**
+   * @description Only return the tasks due on or before this day, e.g. 2026-01-31
+   * @type string | undefined, date
+   */
+  due_to?: string
+  /**
+   * @description Only return the tasks with this text in their title or description, ignoring case
+   * @type string | undefined
+   */
+  q?: string
+  /**
+   * @description Only return the tasks that have all of these labels, by ID or name
+   * @type array | undefined
+   */
+  label?: string[]
+  /**
+   * @description Only return the tasks that have all of these custom field values, as name=value
+   * @type array | undefined
+   */
+  field?: string[]
+  /**
+   * @description Only return the tasks changed since then
+   * @type string | undefined, date-time
+   */
+  updated_since?: string
+  /**
+   * @description Column to sort on, column for the order of the board, empty values come last
+   * @default "column"
+   * @type string | undefined
+   */
+  sort?: TasksQueryParamsSortEnum
+  /**
+   * @description Sort order
+   * @default "asc"
+   * @type string | undefined
+   */
+  order?: TasksQueryParamsOrderEnum
+  /**
+   * @description The next_cursor of the previous page, empty for the first page
+   * @type string | undefined
+   */
+  cursor?: string
+  /**
+   * @description Number of tasks per page
+   * @minimum 1
+   * @maximum 200
+   * @default 50
+   * @type integer | undefined, int64
+   */
+  limit?: number
+}
 
 /**
  * @description OK
  */
-export type Tasks200 = ListTasks
+export type Tasks200 = TaskPage
 
 /**
  * @description Error
@@ -15,5 +110,6 @@ export type TasksQueryResponse = Tasks200
 
 export type TasksQuery = {
   Response: Tasks200
+  QueryParams: TasksQueryParams
   Errors: any
-}
\ No newline at end of file
+}
@@ -10,6 +10,7 @@ export type { ProjectChat200, ProjectChatError, ProjectChatMutationRequest, Proj
 export type { Task } from './Task.ts'
 export type {
   TaskChangeStatusPathParams,
+  TaskChangeStatusHeaderParams,
   TaskChangeStatus204,
   TaskChangeStatusError,
   TaskChangeStatusMutationRequest,
@@ -17,5 +18,15 @@ export type {
   TaskChangeStatusMutation,
 } from './TaskChangeStatus.ts'
 export type { TaskCreate204, TaskCreateError, TaskCreateMutationRequest, TaskCreateMutationResponse, TaskCreateMutation } from './TaskCreate.ts'
-export type { TaskEditPathParams, TaskEdit204, TaskEditError, TaskEditMutationRequest, TaskEditMutationResponse, TaskEditMutation } from './TaskEdit.ts'
-export type { Tasks200, TasksError, TasksQueryResponse, TasksQuery } from './Tasks.ts'
\ No newline at end of file
+export type { TaskPage } from './TaskPage.ts'
+export type { TaskEditPathParams, TaskEditHeaderParams, TaskEdit204, TaskEditError, TaskEditMutationRequest, TaskEditMutationResponse, TaskEditMutation } from './TaskEdit.ts'
+export type {
+  TasksQueryParamsSortEnum,
+  TasksQueryParamsOrderEnum,
+  TasksQueryParams,
+  Tasks200,
+  TasksError,
+  TasksQueryResponse,
+  TasksQuery,
+} from './Tasks.ts'
+export { tasksQueryParamsSortEnum, tasksQueryParamsOrderEnum } from './Tasks.ts'
\ No newline at end of file
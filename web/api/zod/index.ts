@@ -9,6 +9,7 @@ export { messageSchema } from './messageSchema.ts'
 export { projectChat200Schema, projectChatErrorSchema, projectChatMutationRequestSchema, projectChatMutationResponseSchema } from './projectChatSchema.ts'
 export {
   taskChangeStatusPathParamsSchema,
+  taskChangeStatusHeaderParamsSchema,
   taskChangeStatus204Schema,
   taskChangeStatusErrorSchema,
   taskChangeStatusMutationRequestSchema,
@@ -17,10 +18,12 @@ export {
 export { taskCreate204Schema, taskCreateErrorSchema, taskCreateMutationRequestSchema, taskCreateMutationResponseSchema } from './taskCreateSchema.ts'
 export {
   taskEditPathParamsSchema,
+  taskEditHeaderParamsSchema,
   taskEdit204Schema,
   taskEditErrorSchema,
   taskEditMutationRequestSchema,
   taskEditMutationResponseSchema,
 } from './taskEditSchema.ts'
+export { taskPageSchema } from './taskPageSchema.ts'
 export { taskSchema } from './taskSchema.ts'
-export { tasks200Schema, tasksErrorSchema, tasksQueryResponseSchema } from './tasksSchema.ts'
\ No newline at end of file
+export { tasksQueryParamsSchema, tasks200Schema, tasksErrorSchema, tasksQueryResponseSchema } from './tasksSchema.ts'
\ No newline at end of file
@@ -6,6 +6,12 @@ export const taskChangeStatusPathParamsSchema = z.object({
   taskId: z.string(),
 })
 
+export const taskChangeStatusHeaderParamsSchema = z
+  .object({
+    'If-Match': z.string().describe('Required, the ETag of the task the change was made from, * to change it whatever its version').optional(),
+  })
+  .optional()
+
 /**
  * @description No Content
  */
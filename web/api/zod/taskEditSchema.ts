@@ -5,6 +5,12 @@ export const taskEditPathParamsSchema = z.object({
   taskId: z.string(),
 })
 
+export const taskEditHeaderParamsSchema = z
+  .object({
+    'If-Match': z.string().describe('Required, the ETag of the task the edit was made from, * to edit it whatever its version').optional(),
+  })
+  .optional()
+
 /**
  * @description No Content
  */
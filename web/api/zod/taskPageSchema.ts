@@ -0,0 +1,8 @@
+import { taskSchema } from './taskSchema.ts'
+import { z } from 'zod'
+
+export const taskPageSchema = z.object({
+  $schema: z.string().url().describe('A URL to the JSON Schema for this object.').optional(),
+  next_cursor: z.string().describe('Pass it as cursor to get the next page, null on the last page').nullable(),
+  tasks: z.array(z.lazy(() => taskSchema)).nullable(),
+})
@@ -12,4 +12,5 @@ export const taskSchema = z.object({
   status: z.string(),
   title: z.string(),
   updated_at: z.string().datetime(),
+  version: z.number().int().describe('Bumped every time the task is saved, its ETag'),
 })
\ No newline at end of file
@@ -1,15 +1,36 @@
 import { errorModelSchema } from './errorModelSchema.ts'
-import { listTasksSchema } from './listTasksSchema.ts'
+import { taskPageSchema } from './taskPageSchema.ts'
 import { z } from 'zod'
 
+export const tasksQueryParamsSchema = z
+  .object({
+    board: z.string().describe('Only return the tasks of this board, by ID').optional(),
+    status: z.array(z.string()).describe('Only return the tasks in any of these statuses').optional(),
+    assignee: z.array(z.string()).describe('Only return the tasks assigned to any of these members, by ID or display name').optional(),
+    due_from: z.string().date().describe('Only return the tasks due on or after this day, e.g. 2026-01-01').optional(),
+    due_to: z.string().date().describe('Only return the tasks due on or before this day, e.g. 2026-01-31').optional(),
+    q: z.string().describe('Only return the tasks with this text in their title or description, ignoring case').optional(),
+    label: z.array(z.string()).describe('Only return the tasks that have all of these labels, by ID or name').optional(),
+    field: z.array(z.string()).describe('Only return the tasks that have all of these custom field values, as name=value').optional(),
+    updated_since: z.string().datetime().describe('Only return the tasks changed since then').optional(),
+    sort: z
+      .enum(['column', 'title', 'status', 'rank', 'priority', 'story_points', 'due_date', 'created_at', 'updated_at', 'completed_at'])
+      .default('column')
+      .describe('Column to sort on, column for the order of the board, empty values come last'),
+    order: z.enum(['asc', 'desc']).default('asc').describe('Sort order'),
+    cursor: z.string().describe('The next_cursor of the previous page, empty for the first page').optional(),
+    limit: z.number().int().min(1).max(200).default(50).describe('Number of tasks per page'),
+  })
+  .optional()
+
 /**
  * @description OK
  */
-export const tasks200Schema = z.lazy(() => listTasksSchema)
+export const tasks200Schema = z.lazy(() => taskPageSchema)
 
 /**
  * @description Error
  */
 export const tasksErrorSchema = z.lazy(() => errorModelSchema)
 
-export const tasksQueryResponseSchema = z.lazy(() => tasks200Schema)
\ No newline at end of file
+export const tasksQueryResponseSchema = z.lazy(() => tasks200Schema)
@@ -20,6 +20,8 @@ import {
 	SheetTitle,
 	SheetTrigger,
 } from "@/components/ui/sheet.tsx";
+import { ifMatchTask } from "@/lib/etag";
+import { allTasks } from "@/lib/tasks";
 import { LucideBot } from "lucide-react";
 import Chat from "./project/chat.tsx";
 
@@ -182,7 +184,10 @@ export const assignees = ["John", "Mary", "Steve", "Laura", "Alex"];
 function useKanbanBoard() {
 	const queryClient = useQueryClient();
 
-	const { data, isLoading, isError, error } = useTasks();
+	// The board shows every task, not just the first page
+	const { data, isLoading, isError, error } = useTasks(undefined, {
+		query: { queryFn: ({ signal }) => allTasks({}, signal) },
+	});
 
 	const mutation = useTaskChangeStatus();
 
@@ -209,14 +214,14 @@ function useKanbanBoard() {
 
 		// Find the task's current status
 		const currentTask = previousTasks?.find((task: any) => task.id === taskId);
-		if (currentTask?.status === newStatus) {
+		if (!currentTask || currentTask.status === newStatus) {
 			return; // Skip if status hasn't changed
 		}
 
 		// Optimistically update the task status
 		queryClient.setQueryData(queryKey, (old: DataType) => {
 			return {
-				$schema: old?.$schema,
+				...old,
 				tasks: old?.tasks?.map((task: Task) =>
 					task.id === taskId ? { ...task, status: newStatus } : task
 				),
@@ -224,15 +229,16 @@ function useKanbanBoard() {
 		});
 
 		mutation.mutate(
-			{ taskId, data: { status: newStatus } },
+			{ taskId, data: { status: newStatus }, headers: ifMatchTask(currentTask.version) },
 			{
 				// Still invalidate on success to ensure we have the latest data
 				onSuccess: () => {
 					queryClient.invalidateQueries({ queryKey: queryKey });
 				},
-				// Revert to previous state on error
+				// Revert to previous state on error, and refetch in case the task changed meanwhile
 				onError: () => {
 					queryClient.setQueryData(queryKey, previousTasks);
+					queryClient.invalidateQueries({ queryKey: queryKey });
 				},
 			}
 		);
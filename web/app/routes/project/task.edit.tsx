@@ -19,6 +19,7 @@ import {
 	SelectTrigger,
 	SelectValue,
 } from "@/components/ui/select";
+import { ifMatchTask } from "@/lib/etag";
 import { assignees } from "../home";
 import { zodResolver } from "@hookform/resolvers/zod";
 import { useQueryClient } from "@tanstack/react-query";
@@ -235,6 +236,7 @@ export function useEditTask({ task, onSuccess }: UseEditTaskProps) {
 		mutation.mutate(
 			{
 				taskId: task.id,
+				headers: ifMatchTask(task.version),
 				data: {
 					title: data.title,
 					description: data.description || undefined,
@@ -0,0 +1,10 @@
+/**
+ * Builds the If-Match header of a change made from the given version of a task,
+ * the API refuses the change if the task was saved by someone else since.
+ *
+ * @param version The version of the task the change was made from
+ * @returns The headers to send with the change
+ */
+export function ifMatchTask(version: number): { "If-Match": string } {
+	return { "If-Match": `"${version}"` };
+}
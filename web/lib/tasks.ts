@@ -0,0 +1,28 @@
+import { type TaskPage, type TasksQueryParams, tasks } from "@/api";
+
+/**
+ * Gets every task matching the params, following next_cursor from one page to the next,
+ * so that a board with more tasks than fit on a page isn't cut short.
+ *
+ * @param params The filters and sort of the list, the cursor is set page after page
+ * @param signal Aborts the pages still to get
+ * @returns A single page with all the tasks, its next_cursor is null
+ */
+export async function allTasks(
+	params: Omit<TasksQueryParams, "cursor"> = {},
+	signal?: AbortSignal
+): Promise<TaskPage> {
+	const all: NonNullable<TaskPage["tasks"]> = [];
+	let cursor: string | undefined;
+
+	do {
+		const page = await tasks(
+			{ ...params, limit: 200, cursor },
+			{ signal }
+		);
+		all.push(...(page.tasks ?? []));
+		cursor = page.next_cursor ?? undefined;
+	} while (cursor);
+
+	return { tasks: all, next_cursor: null };
+}